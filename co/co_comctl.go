@@ -667,6 +667,17 @@ const (
 	LVSIL_GROUPHEADER LVSIL = 3 // Group header icons (16 x 16).
 )
 
+// [LVM_SETITEMCOUNT] flags.
+//
+// [LVM_SETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setitemcount
+type LVSICF uint32
+
+const (
+	LVSICF_NONE            LVSICF = 0
+	LVSICF_NOINVALIDATEALL LVSICF = 0x0000_0001
+	LVSICF_NOSCROLL        LVSICF = 0x0000_0002
+)
+
 // SysLink control [styles].
 //
 // [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/syslink-control-styles
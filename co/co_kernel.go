@@ -645,6 +645,75 @@ const (
 	LMEM_LOCKCOUNT      LMEM = 0x00ff
 )
 
+// [GetLocaleInfoEx] LCType. Also includes [LOCALE_NOUSEROVERRIDE] and other
+// flags.
+//
+// [GetLocaleInfoEx]: https://learn.microsoft.com/en-us/windows/win32/api/winnls/nf-winnls-getlocaleinfoex
+// [LOCALE_NOUSEROVERRIDE]: https://learn.microsoft.com/en-us/windows/win32/intl/locale-nouseroverride
+type LOCALE uint32
+
+const (
+	LOCALE_NOUSEROVERRIDE        LOCALE = 0x8000_0000
+	LOCALE_USE_CP_ACP            LOCALE = 0x4000_0000
+	LOCALE_RETURN_NUMBER         LOCALE = 0x2000_0000
+	LOCALE_RETURN_GENITIVE_NAMES LOCALE = 0x1000_0000
+	LOCALE_ALLOW_NEUTRAL_NAMES   LOCALE = 0x0800_0000
+
+	LOCALE_ILANGUAGE            LOCALE = 0x0000_0001
+	LOCALE_SLIST                LOCALE = 0x0000_000c
+	LOCALE_SDECIMAL             LOCALE = 0x0000_000e
+	LOCALE_STHOUSAND            LOCALE = 0x0000_000f
+	LOCALE_SGROUPING            LOCALE = 0x0000_0010
+	LOCALE_SSHORTDATE           LOCALE = 0x0000_001f
+	LOCALE_SLONGDATE            LOCALE = 0x0000_0020
+	LOCALE_SDAYNAME1            LOCALE = 0x0000_002a
+	LOCALE_SDAYNAME2            LOCALE = 0x0000_002b
+	LOCALE_SDAYNAME3            LOCALE = 0x0000_002c
+	LOCALE_SDAYNAME4            LOCALE = 0x0000_002d
+	LOCALE_SDAYNAME5            LOCALE = 0x0000_002e
+	LOCALE_SDAYNAME6            LOCALE = 0x0000_002f
+	LOCALE_SDAYNAME7            LOCALE = 0x0000_0030
+	LOCALE_SABBREVDAYNAME1      LOCALE = 0x0000_0031
+	LOCALE_SABBREVDAYNAME2      LOCALE = 0x0000_0032
+	LOCALE_SABBREVDAYNAME3      LOCALE = 0x0000_0033
+	LOCALE_SABBREVDAYNAME4      LOCALE = 0x0000_0034
+	LOCALE_SABBREVDAYNAME5      LOCALE = 0x0000_0035
+	LOCALE_SABBREVDAYNAME6      LOCALE = 0x0000_0036
+	LOCALE_SABBREVDAYNAME7      LOCALE = 0x0000_0037
+	LOCALE_SMONTHNAME1          LOCALE = 0x0000_0038
+	LOCALE_SMONTHNAME2          LOCALE = 0x0000_0039
+	LOCALE_SMONTHNAME3          LOCALE = 0x0000_003a
+	LOCALE_SMONTHNAME4          LOCALE = 0x0000_003b
+	LOCALE_SMONTHNAME5          LOCALE = 0x0000_003c
+	LOCALE_SMONTHNAME6          LOCALE = 0x0000_003d
+	LOCALE_SMONTHNAME7          LOCALE = 0x0000_003e
+	LOCALE_SMONTHNAME8          LOCALE = 0x0000_003f
+	LOCALE_SMONTHNAME9          LOCALE = 0x0000_0040
+	LOCALE_SMONTHNAME10         LOCALE = 0x0000_0041
+	LOCALE_SMONTHNAME11         LOCALE = 0x0000_0042
+	LOCALE_SMONTHNAME12         LOCALE = 0x0000_0043
+	LOCALE_SABBREVMONTHNAME1    LOCALE = 0x0000_0044
+	LOCALE_SABBREVMONTHNAME2    LOCALE = 0x0000_0045
+	LOCALE_SABBREVMONTHNAME3    LOCALE = 0x0000_0046
+	LOCALE_SABBREVMONTHNAME4    LOCALE = 0x0000_0047
+	LOCALE_SABBREVMONTHNAME5    LOCALE = 0x0000_0048
+	LOCALE_SABBREVMONTHNAME6    LOCALE = 0x0000_0049
+	LOCALE_SABBREVMONTHNAME7    LOCALE = 0x0000_004a
+	LOCALE_SABBREVMONTHNAME8    LOCALE = 0x0000_004b
+	LOCALE_SABBREVMONTHNAME9    LOCALE = 0x0000_004c
+	LOCALE_SABBREVMONTHNAME10   LOCALE = 0x0000_004d
+	LOCALE_SABBREVMONTHNAME11   LOCALE = 0x0000_004e
+	LOCALE_SABBREVMONTHNAME12   LOCALE = 0x0000_004f
+	LOCALE_SNEGATIVESIGN        LOCALE = 0x0000_0051
+	LOCALE_SNAME                LOCALE = 0x0000_005c
+	LOCALE_SENGLISHDISPLAYNAME  LOCALE = 0x0000_0072
+	LOCALE_SNATIVEDISPLAYNAME   LOCALE = 0x0000_0073
+	LOCALE_SSHORTTIME           LOCALE = 0x0000_0079
+	LOCALE_SENGLISHLANGUAGENAME LOCALE = 0x0000_1001
+	LOCALE_SENGLISHCOUNTRYNAME  LOCALE = 0x0000_1002
+	LOCALE_STIMEFORMAT          LOCALE = 0x0000_1003
+)
+
 // [LockFileEx] dwFlags.
 //
 // [LockFileEx]: https://learn.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-lockfileex
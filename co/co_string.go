@@ -0,0 +1,12013 @@
+// Code generated by internal/cogen; DO NOT EDIT.
+
+//go:build windows
+
+package co
+
+import "github.com/rodrigocfd/windigo/internal/enum"
+
+var _KEY_names = enum.Flags[KEY]{
+	Type: "KEY",
+	Values: []enum.Name[KEY]{
+		{Val: KEY_ALL_ACCESS, Name: "KEY_ALL_ACCESS"},
+		{Val: KEY_READ, Name: "KEY_READ"},
+		{Val: KEY_EXECUTE, Name: "KEY_EXECUTE"},
+		{Val: KEY_WRITE, Name: "KEY_WRITE"},
+		{Val: KEY_WOW64_RES, Name: "KEY_WOW64_RES"},
+		{Val: KEY_QUERY_VALUE, Name: "KEY_QUERY_VALUE"},
+		{Val: KEY_SET_VALUE, Name: "KEY_SET_VALUE"},
+		{Val: KEY_CREATE_SUB_KEY, Name: "KEY_CREATE_SUB_KEY"},
+		{Val: KEY_ENUMERATE_SUB_KEYS, Name: "KEY_ENUMERATE_SUB_KEYS"},
+		{Val: KEY_NOTIFY, Name: "KEY_NOTIFY"},
+		{Val: KEY_CREATE_LINK, Name: "KEY_CREATE_LINK"},
+		{Val: KEY_WOW64_32KEY, Name: "KEY_WOW64_32KEY"},
+		{Val: KEY_WOW64_64KEY, Name: "KEY_WOW64_64KEY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "KEY_QUERY_VALUE|KEY_SET_VALUE".
+func (v KEY) String() string {
+	return _KEY_names.String(v)
+}
+
+// Parses names of [KEY] flags joined by "|", or numbers.
+func ParseKEY(s string) (KEY, error) {
+	return _KEY_names.Parse(s)
+}
+
+var _REG_names = enum.Enum[REG]{
+	Type: "REG",
+	Values: []enum.Name[REG]{
+		{Val: REG_NONE, Name: "REG_NONE"},
+		{Val: REG_SZ, Name: "REG_SZ"},
+		{Val: REG_EXPAND_SZ, Name: "REG_EXPAND_SZ"},
+		{Val: REG_BINARY, Name: "REG_BINARY"},
+		{Val: REG_DWORD, Name: "REG_DWORD"},
+		{Val: REG_DWORD_LITTLE_ENDIAN, Name: "REG_DWORD_LITTLE_ENDIAN"},
+		{Val: REG_DWORD_BIG_ENDIAN, Name: "REG_DWORD_BIG_ENDIAN"},
+		{Val: REG_LINK, Name: "REG_LINK"},
+		{Val: REG_MULTI_SZ, Name: "REG_MULTI_SZ"},
+		{Val: REG_RESOURCE_LIST, Name: "REG_RESOURCE_LIST"},
+		{Val: REG_FULL_RESOURCE_DESCRIPTOR, Name: "REG_FULL_RESOURCE_DESCRIPTOR"},
+		{Val: REG_RESOURCE_REQUIREMENTS_LIST, Name: "REG_RESOURCE_REQUIREMENTS_LIST"},
+		{Val: REG_QWORD, Name: "REG_QWORD"},
+		{Val: REG_QWORD_LITTLE_ENDIAN, Name: "REG_QWORD_LITTLE_ENDIAN"},
+	},
+}
+
+// Returns the name of the constant, like "REG_NONE".
+func (v REG) String() string {
+	return _REG_names.String(v)
+}
+
+// Parses the name of a [REG] constant, or a number.
+func ParseREG(s string) (REG, error) {
+	return _REG_names.Parse(s)
+}
+
+var _REG_DISPOSITION_names = enum.Enum[REG_DISPOSITION]{
+	Type: "REG_DISPOSITION",
+	Values: []enum.Name[REG_DISPOSITION]{
+		{Val: REG_DISPOSITION_NONE, Name: "REG_DISPOSITION_NONE"},
+		{Val: REG_DISPOSITION_CREATED_NEW, Name: "REG_DISPOSITION_CREATED_NEW"},
+		{Val: REG_DISPOSITION_EXISTING, Name: "REG_DISPOSITION_EXISTING"},
+	},
+}
+
+// Returns the name of the constant, like "REG_DISPOSITION_NONE".
+func (v REG_DISPOSITION) String() string {
+	return _REG_DISPOSITION_names.String(v)
+}
+
+// Parses the name of a [REG_DISPOSITION] constant, or a number.
+func ParseREG_DISPOSITION(s string) (REG_DISPOSITION, error) {
+	return _REG_DISPOSITION_names.Parse(s)
+}
+
+var _REG_OPTION_names = enum.Flags[REG_OPTION]{
+	Type: "REG_OPTION",
+	Values: []enum.Name[REG_OPTION]{
+		{Val: REG_OPTION_VOLATILE, Name: "REG_OPTION_VOLATILE"},
+		{Val: REG_OPTION_CREATE_LINK, Name: "REG_OPTION_CREATE_LINK"},
+		{Val: REG_OPTION_BACKUP_RESTORE, Name: "REG_OPTION_BACKUP_RESTORE"},
+		{Val: REG_OPTION_OPEN_LINK, Name: "REG_OPTION_OPEN_LINK"},
+		{Val: REG_OPTION_DONT_VIRTUALIZE, Name: "REG_OPTION_DONT_VIRTUALIZE"},
+		{Val: REG_OPTION_NONE, Name: "REG_OPTION_NONE"},
+		{Val: REG_OPTION_RESERVED, Name: "REG_OPTION_RESERVED"},
+		{Val: REG_OPTION_NON_VOLATILE, Name: "REG_OPTION_NON_VOLATILE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "REG_OPTION_VOLATILE|REG_OPTION_CREATE_LINK".
+func (v REG_OPTION) String() string {
+	return _REG_OPTION_names.String(v)
+}
+
+// Parses names of [REG_OPTION] flags joined by "|", or numbers.
+func ParseREG_OPTION(s string) (REG_OPTION, error) {
+	return _REG_OPTION_names.Parse(s)
+}
+
+var _REG_RESTORE_names = enum.Flags[REG_RESTORE]{
+	Type: "REG_RESTORE",
+	Values: []enum.Name[REG_RESTORE]{
+		{Val: REG_RESTORE_FORCE, Name: "REG_RESTORE_FORCE"},
+		{Val: REG_RESTORE_WHOLE_HIVE_VOLATILE, Name: "REG_RESTORE_WHOLE_HIVE_VOLATILE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "REG_RESTORE_FORCE|REG_RESTORE_WHOLE_HIVE_VOLATILE".
+func (v REG_RESTORE) String() string {
+	return _REG_RESTORE_names.String(v)
+}
+
+// Parses names of [REG_RESTORE] flags joined by "|", or numbers.
+func ParseREG_RESTORE(s string) (REG_RESTORE, error) {
+	return _REG_RESTORE_names.Parse(s)
+}
+
+var _REG_SAVE_names = enum.Enum[REG_SAVE]{
+	Type: "REG_SAVE",
+	Values: []enum.Name[REG_SAVE]{
+		{Val: REG_SAVE_STANDARD_FORMAT, Name: "REG_SAVE_STANDARD_FORMAT"},
+		{Val: REG_SAVE_LATEST_FORMAT, Name: "REG_SAVE_LATEST_FORMAT"},
+		{Val: REG_SAVE_NO_COMPRESSION, Name: "REG_SAVE_NO_COMPRESSION"},
+	},
+}
+
+// Returns the name of the constant, like "REG_SAVE_STANDARD_FORMAT".
+func (v REG_SAVE) String() string {
+	return _REG_SAVE_names.String(v)
+}
+
+// Parses the name of a [REG_SAVE] constant, or a number.
+func ParseREG_SAVE(s string) (REG_SAVE, error) {
+	return _REG_SAVE_names.Parse(s)
+}
+
+var _RRF_names = enum.Flags[RRF]{
+	Type: "RRF",
+	Values: []enum.Name[RRF]{
+		{Val: RRF_RT_ANY, Name: "RRF_RT_ANY"},
+		{Val: RRF_RT_DWORD, Name: "RRF_RT_DWORD"},
+		{Val: RRF_RT_QWORD, Name: "RRF_RT_QWORD"},
+		{Val: RRF_RT_REG_NONE, Name: "RRF_RT_REG_NONE"},
+		{Val: RRF_RT_REG_SZ, Name: "RRF_RT_REG_SZ"},
+		{Val: RRF_RT_REG_EXPAND_SZ, Name: "RRF_RT_REG_EXPAND_SZ"},
+		{Val: RRF_RT_REG_BINARY, Name: "RRF_RT_REG_BINARY"},
+		{Val: RRF_RT_REG_DWORD, Name: "RRF_RT_REG_DWORD"},
+		{Val: RRF_RT_REG_MULTI_SZ, Name: "RRF_RT_REG_MULTI_SZ"},
+		{Val: RRF_RT_REG_QWORD, Name: "RRF_RT_REG_QWORD"},
+		{Val: RRF_SUBKEY_WOW6464KEY, Name: "RRF_SUBKEY_WOW6464KEY"},
+		{Val: RRF_SUBKEY_WOW6432KEY, Name: "RRF_SUBKEY_WOW6432KEY"},
+		{Val: RRF_NOEXPAND, Name: "RRF_NOEXPAND"},
+		{Val: RRF_ZEROONFAILURE, Name: "RRF_ZEROONFAILURE"},
+	},
+	ParseOnly: []enum.Name[RRF]{
+		{Val: RRF_WOW64_MASK, Name: "RRF_WOW64_MASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "RRF_RT_REG_NONE|RRF_RT_REG_SZ".
+func (v RRF) String() string {
+	return _RRF_names.String(v)
+}
+
+// Parses names of [RRF] flags joined by "|", or numbers.
+func ParseRRF(s string) (RRF, error) {
+	return _RRF_names.Parse(s)
+}
+
+var _TRANSACTION_names = enum.Flags[TRANSACTION]{
+	Type: "TRANSACTION",
+	Values: []enum.Name[TRANSACTION]{
+		{Val: TRANSACTION_ALL_ACCESS, Name: "TRANSACTION_ALL_ACCESS"},
+		{Val: TRANSACTION_GENERIC_WRITE, Name: "TRANSACTION_GENERIC_WRITE"},
+		{Val: TRANSACTION_RESOURCE_MANAGER_RIGHTS, Name: "TRANSACTION_RESOURCE_MANAGER_RIGHTS"},
+		{Val: TRANSACTION_GENERIC_EXECUTE, Name: "TRANSACTION_GENERIC_EXECUTE"},
+		{Val: TRANSACTION_GENERIC_READ, Name: "TRANSACTION_GENERIC_READ"},
+		{Val: TRANSACTION_QUERY_INFORMATION, Name: "TRANSACTION_QUERY_INFORMATION"},
+		{Val: TRANSACTION_SET_INFORMATION, Name: "TRANSACTION_SET_INFORMATION"},
+		{Val: TRANSACTION_ENLIST, Name: "TRANSACTION_ENLIST"},
+		{Val: TRANSACTION_COMMIT, Name: "TRANSACTION_COMMIT"},
+		{Val: TRANSACTION_ROLLBACK, Name: "TRANSACTION_ROLLBACK"},
+		{Val: TRANSACTION_PROPAGATE, Name: "TRANSACTION_PROPAGATE"},
+		{Val: TRANSACTION_RIGHT_RESERVED1, Name: "TRANSACTION_RIGHT_RESERVED1"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TRANSACTION_QUERY_INFORMATION|TRANSACTION_SET_INFORMATION".
+func (v TRANSACTION) String() string {
+	return _TRANSACTION_names.String(v)
+}
+
+// Parses names of [TRANSACTION] flags joined by "|", or numbers.
+func ParseTRANSACTION(s string) (TRANSACTION, error) {
+	return _TRANSACTION_names.Parse(s)
+}
+
+var _TRANSACTION_OPT_names = enum.Enum[TRANSACTION_OPT]{
+	Type: "TRANSACTION_OPT",
+	Values: []enum.Name[TRANSACTION_OPT]{
+		{Val: TRANSACTION_OPT_NONE, Name: "TRANSACTION_OPT_NONE"},
+		{Val: TRANSACTION_OPT_DO_NOT_PROMOTE, Name: "TRANSACTION_OPT_DO_NOT_PROMOTE"},
+	},
+}
+
+// Returns the name of the constant, like "TRANSACTION_OPT_NONE".
+func (v TRANSACTION_OPT) String() string {
+	return _TRANSACTION_OPT_names.String(v)
+}
+
+// Parses the name of a [TRANSACTION_OPT] constant, or a number.
+func ParseTRANSACTION_OPT(s string) (TRANSACTION_OPT, error) {
+	return _TRANSACTION_OPT_names.Parse(s)
+}
+
+var _ADRF_names = enum.Enum[ADRF]{
+	Type: "ADRF",
+	Values: []enum.Name[ADRF]{
+		{Val: ADRF_DRAWSYNC, Name: "ADRF_DRAWSYNC"},
+		{Val: ADRF_DRAWNOTHING, Name: "ADRF_DRAWNOTHING"},
+		{Val: ADRF_DRAWFALLBACK, Name: "ADRF_DRAWFALLBACK"},
+		{Val: ADRF_DRAWIMAGE, Name: "ADRF_DRAWIMAGE"},
+	},
+}
+
+// Returns the name of the constant, like "ADRF_DRAWSYNC".
+func (v ADRF) String() string {
+	return _ADRF_names.String(v)
+}
+
+// Parses the name of a [ADRF] constant, or a number.
+func ParseADRF(s string) (ADRF, error) {
+	return _ADRF_names.Parse(s)
+}
+
+var _BTNS_names = enum.Flags[BTNS]{
+	Type: "BTNS",
+	Values: []enum.Name[BTNS]{
+		{Val: BTNS_CHECKGROUP, Name: "BTNS_CHECKGROUP"},
+		{Val: BTNS_SEP, Name: "BTNS_SEP"},
+		{Val: BTNS_CHECK, Name: "BTNS_CHECK"},
+		{Val: BTNS_GROUP, Name: "BTNS_GROUP"},
+		{Val: BTNS_DROPDOWN, Name: "BTNS_DROPDOWN"},
+		{Val: BTNS_AUTOSIZE, Name: "BTNS_AUTOSIZE"},
+		{Val: BTNS_NOPREFIX, Name: "BTNS_NOPREFIX"},
+		{Val: BTNS_SHOWTEXT, Name: "BTNS_SHOWTEXT"},
+		{Val: BTNS_WHOLEDROPDOWN, Name: "BTNS_WHOLEDROPDOWN"},
+		{Val: BTNS_BUTTON, Name: "BTNS_BUTTON"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "BTNS_SEP|BTNS_CHECK".
+func (v BTNS) String() string {
+	return _BTNS_names.String(v)
+}
+
+// Parses names of [BTNS] flags joined by "|", or numbers.
+func ParseBTNS(s string) (BTNS, error) {
+	return _BTNS_names.Parse(s)
+}
+
+var _CDDS_names = enum.Flags[CDDS]{
+	Type: "CDDS",
+	Values: []enum.Name[CDDS]{
+		{Val: CDDS_ITEMPREERASE, Name: "CDDS_ITEMPREERASE"},
+		{Val: CDDS_PREERASE, Name: "CDDS_PREERASE"},
+		{Val: CDDS_ITEMPREPAINT, Name: "CDDS_ITEMPREPAINT"},
+		{Val: CDDS_ITEMPOSTPAINT, Name: "CDDS_ITEMPOSTPAINT"},
+		{Val: CDDS_ITEMPOSTERASE, Name: "CDDS_ITEMPOSTERASE"},
+		{Val: CDDS_PREPAINT, Name: "CDDS_PREPAINT"},
+		{Val: CDDS_POSTPAINT, Name: "CDDS_POSTPAINT"},
+		{Val: CDDS_POSTERASE, Name: "CDDS_POSTERASE"},
+		{Val: CDDS_ITEM, Name: "CDDS_ITEM"},
+		{Val: CDDS_SUBITEM, Name: "CDDS_SUBITEM"},
+	},
+	Fields: []CDDS{0xffff},
+}
+
+// Returns the names of the flags joined by "|", like "CDDS_ITEM|CDDS_SUBITEM".
+func (v CDDS) String() string {
+	return _CDDS_names.String(v)
+}
+
+// Parses names of [CDDS] flags joined by "|", or numbers.
+func ParseCDDS(s string) (CDDS, error) {
+	return _CDDS_names.Parse(s)
+}
+
+var _CDIS_names = enum.Flags[CDIS]{
+	Type: "CDIS",
+	Values: []enum.Name[CDIS]{
+		{Val: CDIS_SELECTED, Name: "CDIS_SELECTED"},
+		{Val: CDIS_GRAYED, Name: "CDIS_GRAYED"},
+		{Val: CDIS_DISABLED, Name: "CDIS_DISABLED"},
+		{Val: CDIS_CHECKED, Name: "CDIS_CHECKED"},
+		{Val: CDIS_FOCUS, Name: "CDIS_FOCUS"},
+		{Val: CDIS_DEFAULT, Name: "CDIS_DEFAULT"},
+		{Val: CDIS_HOT, Name: "CDIS_HOT"},
+		{Val: CDIS_MARKED, Name: "CDIS_MARKED"},
+		{Val: CDIS_INDETERMINATE, Name: "CDIS_INDETERMINATE"},
+		{Val: CDIS_SHOWKEYBOARDCUES, Name: "CDIS_SHOWKEYBOARDCUES"},
+		{Val: CDIS_NEARHOT, Name: "CDIS_NEARHOT"},
+		{Val: CDIS_OTHERSIDEHOT, Name: "CDIS_OTHERSIDEHOT"},
+		{Val: CDIS_DROPHILITED, Name: "CDIS_DROPHILITED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CDIS_SELECTED|CDIS_GRAYED".
+func (v CDIS) String() string {
+	return _CDIS_names.String(v)
+}
+
+// Parses names of [CDIS] flags joined by "|", or numbers.
+func ParseCDIS(s string) (CDIS, error) {
+	return _CDIS_names.Parse(s)
+}
+
+var _CDRF_names = enum.Flags[CDRF]{
+	Type: "CDRF",
+	Values: []enum.Name[CDRF]{
+		{Val: CDRF_NEWFONT, Name: "CDRF_NEWFONT"},
+		{Val: CDRF_SKIPDEFAULT, Name: "CDRF_SKIPDEFAULT"},
+		{Val: CDRF_DOERASE, Name: "CDRF_DOERASE"},
+		{Val: CDRF_SKIPPOSTPAINT, Name: "CDRF_SKIPPOSTPAINT"},
+		{Val: CDRF_NOTIFYPOSTPAINT, Name: "CDRF_NOTIFYPOSTPAINT"},
+		{Val: CDRF_NOTIFYITEMDRAW, Name: "CDRF_NOTIFYITEMDRAW"},
+		{Val: CDRF_NOTIFYSUBITEMDRAW, Name: "CDRF_NOTIFYSUBITEMDRAW"},
+		{Val: CDRF_NOTIFYPOSTERASE, Name: "CDRF_NOTIFYPOSTERASE"},
+		{Val: CDRF_DODEFAULT, Name: "CDRF_DODEFAULT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CDRF_NEWFONT|CDRF_SKIPDEFAULT".
+func (v CDRF) String() string {
+	return _CDRF_names.String(v)
+}
+
+// Parses names of [CDRF] flags joined by "|", or numbers.
+func ParseCDRF(s string) (CDRF, error) {
+	return _CDRF_names.Parse(s)
+}
+
+var _DTS_names = enum.Flags[DTS]{
+	Type: "DTS",
+	Values: []enum.Name[DTS]{
+		{Val: DTS_SHORTDATECENTURYFORMAT, Name: "DTS_SHORTDATECENTURYFORMAT"},
+		{Val: DTS_TIMEFORMAT, Name: "DTS_TIMEFORMAT"},
+		{Val: DTS_UPDOWN, Name: "DTS_UPDOWN"},
+		{Val: DTS_SHOWNONE, Name: "DTS_SHOWNONE"},
+		{Val: DTS_LONGDATEFORMAT, Name: "DTS_LONGDATEFORMAT"},
+		{Val: DTS_APPCANPARSE, Name: "DTS_APPCANPARSE"},
+		{Val: DTS_RIGHTALIGN, Name: "DTS_RIGHTALIGN"},
+		{Val: DTS_NONE, Name: "DTS_NONE"},
+		{Val: DTS_SHORTDATEFORMAT, Name: "DTS_SHORTDATEFORMAT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DTS_UPDOWN|DTS_SHOWNONE".
+func (v DTS) String() string {
+	return _DTS_names.String(v)
+}
+
+// Parses names of [DTS] flags joined by "|", or numbers.
+func ParseDTS(s string) (DTS, error) {
+	return _DTS_names.Parse(s)
+}
+
+var _EMF_names = enum.Enum[EMF]{
+	Type: "EMF",
+	Values: []enum.Name[EMF]{
+		{Val: EMF_NULL, Name: "EMF_NULL"},
+		{Val: EMF_CENTERED, Name: "EMF_CENTERED"},
+	},
+}
+
+// Returns the name of the constant, like "EMF_NULL".
+func (v EMF) String() string {
+	return _EMF_names.String(v)
+}
+
+// Parses the name of a [EMF] constant, or a number.
+func ParseEMF(s string) (EMF, error) {
+	return _EMF_names.Parse(s)
+}
+
+var _GDT_names = enum.Enum[GDT]{
+	Type: "GDT",
+	Values: []enum.Name[GDT]{
+		{Val: GDT_VALID, Name: "GDT_VALID"},
+		{Val: GDT_NONE, Name: "GDT_NONE"},
+	},
+}
+
+// Returns the name of the constant, like "GDT_VALID".
+func (v GDT) String() string {
+	return _GDT_names.String(v)
+}
+
+// Parses the name of a [GDT] constant, or a number.
+func ParseGDT(s string) (GDT, error) {
+	return _GDT_names.Parse(s)
+}
+
+var _HDF_names = enum.Flags[HDF]{
+	Type: "HDF",
+	Values: []enum.Name[HDF]{
+		{Val: HDF_RIGHT, Name: "HDF_RIGHT"},
+		{Val: HDF_CENTER, Name: "HDF_CENTER"},
+		{Val: HDF_RTLREADING, Name: "HDF_RTLREADING"},
+		{Val: HDF_BITMAP, Name: "HDF_BITMAP"},
+		{Val: HDF_STRING, Name: "HDF_STRING"},
+		{Val: HDF_OWNERDRAW, Name: "HDF_OWNERDRAW"},
+		{Val: HDF_IMAGE, Name: "HDF_IMAGE"},
+		{Val: HDF_BITMAP_ON_RIGHT, Name: "HDF_BITMAP_ON_RIGHT"},
+		{Val: HDF_SORTUP, Name: "HDF_SORTUP"},
+		{Val: HDF_SORTDOWN, Name: "HDF_SORTDOWN"},
+		{Val: HDF_CHECKBOX, Name: "HDF_CHECKBOX"},
+		{Val: HDF_CHECKED, Name: "HDF_CHECKED"},
+		{Val: HDF_FIXEDWIDTH, Name: "HDF_FIXEDWIDTH"},
+		{Val: HDF_SPLITBUTTON, Name: "HDF_SPLITBUTTON"},
+		{Val: HDF_NONE, Name: "HDF_NONE"},
+		{Val: HDF_LEFT, Name: "HDF_LEFT"},
+	},
+	ParseOnly: []enum.Name[HDF]{
+		{Val: HDF_JUSTIFYMASK, Name: "HDF_JUSTIFYMASK"},
+	},
+	Fields: []HDF{0x3},
+}
+
+// Returns the names of the flags joined by "|", like "HDF_RTLREADING|HDF_BITMAP".
+func (v HDF) String() string {
+	return _HDF_names.String(v)
+}
+
+// Parses names of [HDF] flags joined by "|", or numbers.
+func ParseHDF(s string) (HDF, error) {
+	return _HDF_names.Parse(s)
+}
+
+var _HDFT_names = enum.Flags[HDFT]{
+	Type: "HDFT",
+	Values: []enum.Name[HDFT]{
+		{Val: HDFT_ISNUMBER, Name: "HDFT_ISNUMBER"},
+		{Val: HDFT_ISDATE, Name: "HDFT_ISDATE"},
+		{Val: HDFT_HASNOVALUE, Name: "HDFT_HASNOVALUE"},
+		{Val: HDFT_ISSTRING, Name: "HDFT_ISSTRING"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HDFT_ISNUMBER|HDFT_ISDATE".
+func (v HDFT) String() string {
+	return _HDFT_names.String(v)
+}
+
+// Parses names of [HDFT] flags joined by "|", or numbers.
+func ParseHDFT(s string) (HDFT, error) {
+	return _HDFT_names.Parse(s)
+}
+
+var _HDI_names = enum.Flags[HDI]{
+	Type: "HDI",
+	Values: []enum.Name[HDI]{
+		{Val: HDI_WIDTH, Name: "HDI_WIDTH"},
+		{Val: HDI_TEXT, Name: "HDI_TEXT"},
+		{Val: HDI_FORMAT, Name: "HDI_FORMAT"},
+		{Val: HDI_LPARAM, Name: "HDI_LPARAM"},
+		{Val: HDI_BITMAP, Name: "HDI_BITMAP"},
+		{Val: HDI_IMAGE, Name: "HDI_IMAGE"},
+		{Val: HDI_DI_SETITEM, Name: "HDI_DI_SETITEM"},
+		{Val: HDI_ORDER, Name: "HDI_ORDER"},
+		{Val: HDI_FILTER, Name: "HDI_FILTER"},
+		{Val: HDI_STATE, Name: "HDI_STATE"},
+		{Val: HDI_HEIGHT, Name: "HDI_HEIGHT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HDI_WIDTH|HDI_TEXT".
+func (v HDI) String() string {
+	return _HDI_names.String(v)
+}
+
+// Parses names of [HDI] flags joined by "|", or numbers.
+func ParseHDI(s string) (HDI, error) {
+	return _HDI_names.Parse(s)
+}
+
+var _HDIS_names = enum.Enum[HDIS]{
+	Type: "HDIS",
+	Values: []enum.Name[HDIS]{
+		{Val: HDIS_NONE, Name: "HDIS_NONE"},
+		{Val: HDIS_FOCUSED, Name: "HDIS_FOCUSED"},
+	},
+}
+
+// Returns the name of the constant, like "HDIS_NONE".
+func (v HDIS) String() string {
+	return _HDIS_names.String(v)
+}
+
+// Parses the name of a [HDIS] constant, or a number.
+func ParseHDIS(s string) (HDIS, error) {
+	return _HDIS_names.Parse(s)
+}
+
+var _HEADER_BTN_names = enum.Enum[HEADER_BTN]{
+	Type: "HEADER_BTN",
+	Values: []enum.Name[HEADER_BTN]{
+		{Val: HEADER_BTN_LEFT, Name: "HEADER_BTN_LEFT"},
+		{Val: HEADER_BTN_RIGHT, Name: "HEADER_BTN_RIGHT"},
+		{Val: HEADER_BTN_MIDDLE, Name: "HEADER_BTN_MIDDLE"},
+	},
+}
+
+// Returns the name of the constant, like "HEADER_BTN_LEFT".
+func (v HEADER_BTN) String() string {
+	return _HEADER_BTN_names.String(v)
+}
+
+// Parses the name of a [HEADER_BTN] constant, or a number.
+func ParseHEADER_BTN(s string) (HEADER_BTN, error) {
+	return _HEADER_BTN_names.Parse(s)
+}
+
+var _HDS_names = enum.Flags[HDS]{
+	Type: "HDS",
+	Values: []enum.Name[HDS]{
+		{Val: HDS_BUTTONS, Name: "HDS_BUTTONS"},
+		{Val: HDS_HOTTRACK, Name: "HDS_HOTTRACK"},
+		{Val: HDS_HIDDEN, Name: "HDS_HIDDEN"},
+		{Val: HDS_DRAGDROP, Name: "HDS_DRAGDROP"},
+		{Val: HDS_FULLDRAG, Name: "HDS_FULLDRAG"},
+		{Val: HDS_FILTERBAR, Name: "HDS_FILTERBAR"},
+		{Val: HDS_FLAT, Name: "HDS_FLAT"},
+		{Val: HDS_CHECKBOXES, Name: "HDS_CHECKBOXES"},
+		{Val: HDS_NOSIZING, Name: "HDS_NOSIZING"},
+		{Val: HDS_OVERFLOW, Name: "HDS_OVERFLOW"},
+		{Val: HDS_NONE, Name: "HDS_NONE"},
+		{Val: HDS_HORZ, Name: "HDS_HORZ"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HDS_BUTTONS|HDS_HOTTRACK".
+func (v HDS) String() string {
+	return _HDS_names.String(v)
+}
+
+// Parses names of [HDS] flags joined by "|", or numbers.
+func ParseHDS(s string) (HDS, error) {
+	return _HDS_names.Parse(s)
+}
+
+var _HDSIL_names = enum.Enum[HDSIL]{
+	Type: "HDSIL",
+	Values: []enum.Name[HDSIL]{
+		{Val: HDSIL_NORMAL, Name: "HDSIL_NORMAL"},
+		{Val: HDSIL_STATE, Name: "HDSIL_STATE"},
+	},
+}
+
+// Returns the name of the constant, like "HDSIL_NORMAL".
+func (v HDSIL) String() string {
+	return _HDSIL_names.String(v)
+}
+
+// Parses the name of a [HDSIL] constant, or a number.
+func ParseHDSIL(s string) (HDSIL, error) {
+	return _HDSIL_names.Parse(s)
+}
+
+var _HICF_names = enum.Flags[HICF]{
+	Type: "HICF",
+	Values: []enum.Name[HICF]{
+		{Val: HICF_MOUSE, Name: "HICF_MOUSE"},
+		{Val: HICF_ARROWKEYS, Name: "HICF_ARROWKEYS"},
+		{Val: HICF_ACCELERATOR, Name: "HICF_ACCELERATOR"},
+		{Val: HICF_DUPACCEL, Name: "HICF_DUPACCEL"},
+		{Val: HICF_ENTERING, Name: "HICF_ENTERING"},
+		{Val: HICF_LEAVING, Name: "HICF_LEAVING"},
+		{Val: HICF_RESELECT, Name: "HICF_RESELECT"},
+		{Val: HICF_LMOUSE, Name: "HICF_LMOUSE"},
+		{Val: HICF_TOGGLEDROPDOWN, Name: "HICF_TOGGLEDROPDOWN"},
+		{Val: HICF_OTHER, Name: "HICF_OTHER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HICF_MOUSE|HICF_ARROWKEYS".
+func (v HICF) String() string {
+	return _HICF_names.String(v)
+}
+
+// Parses names of [HICF] flags joined by "|", or numbers.
+func ParseHICF(s string) (HICF, error) {
+	return _HICF_names.Parse(s)
+}
+
+var _ICC_names = enum.Flags[ICC]{
+	Type: "ICC",
+	Values: []enum.Name[ICC]{
+		{Val: ICC_WIN95_CLASSES, Name: "ICC_WIN95_CLASSES"},
+		{Val: ICC_ANIMATE_CLASS, Name: "ICC_ANIMATE_CLASS"},
+		{Val: ICC_BAR_CLASSES, Name: "ICC_BAR_CLASSES"},
+		{Val: ICC_COOL_CLASSES, Name: "ICC_COOL_CLASSES"},
+		{Val: ICC_DATE_CLASSES, Name: "ICC_DATE_CLASSES"},
+		{Val: ICC_HOTKEY_CLASS, Name: "ICC_HOTKEY_CLASS"},
+		{Val: ICC_INTERNET_CLASSES, Name: "ICC_INTERNET_CLASSES"},
+		{Val: ICC_LINK_CLASS, Name: "ICC_LINK_CLASS"},
+		{Val: ICC_LISTVIEW_CLASSES, Name: "ICC_LISTVIEW_CLASSES"},
+		{Val: ICC_NATIVEFNTCTL_CLASS, Name: "ICC_NATIVEFNTCTL_CLASS"},
+		{Val: ICC_PAGESCROLLER_CLASS, Name: "ICC_PAGESCROLLER_CLASS"},
+		{Val: ICC_PROGRESS_CLASS, Name: "ICC_PROGRESS_CLASS"},
+		{Val: ICC_STANDARD_CLASSES, Name: "ICC_STANDARD_CLASSES"},
+		{Val: ICC_TAB_CLASSES, Name: "ICC_TAB_CLASSES"},
+		{Val: ICC_TREEVIEW_CLASSES, Name: "ICC_TREEVIEW_CLASSES"},
+		{Val: ICC_UPDOWN_CLASS, Name: "ICC_UPDOWN_CLASS"},
+		{Val: ICC_USEREX_CLASSES, Name: "ICC_USEREX_CLASSES"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ICC_ANIMATE_CLASS|ICC_BAR_CLASSES".
+func (v ICC) String() string {
+	return _ICC_names.String(v)
+}
+
+// Parses names of [ICC] flags joined by "|", or numbers.
+func ParseICC(s string) (ICC, error) {
+	return _ICC_names.Parse(s)
+}
+
+var _ILC_names = enum.Flags[ILC]{
+	Type: "ILC",
+	Values: []enum.Name[ILC]{
+		{Val: ILC_COLORDDB, Name: "ILC_COLORDDB"},
+		{Val: ILC_COLOR24, Name: "ILC_COLOR24"},
+		{Val: ILC_COLOR4, Name: "ILC_COLOR4"},
+		{Val: ILC_COLOR8, Name: "ILC_COLOR8"},
+		{Val: ILC_COLOR16, Name: "ILC_COLOR16"},
+		{Val: ILC_COLOR32, Name: "ILC_COLOR32"},
+		{Val: ILC_PALETTE, Name: "ILC_PALETTE"},
+		{Val: ILC_MIRROR, Name: "ILC_MIRROR"},
+		{Val: ILC_PERITEMMIRROR, Name: "ILC_PERITEMMIRROR"},
+		{Val: ILC_ORIGINALSIZE, Name: "ILC_ORIGINALSIZE"},
+		{Val: ILC_HIGHQUALITYSCALE, Name: "ILC_HIGHQUALITYSCALE"},
+		{Val: ILC_COLOR, Name: "ILC_COLOR"},
+	},
+	ParseOnly: []enum.Name[ILC]{
+		{Val: ILC_MASK, Name: "ILC_MASK"},
+	},
+	Fields: []ILC{0xfe},
+}
+
+// Returns the names of the flags joined by "|", like "ILC_PALETTE|ILC_MIRROR".
+func (v ILC) String() string {
+	return _ILC_names.String(v)
+}
+
+// Parses names of [ILC] flags joined by "|", or numbers.
+func ParseILC(s string) (ILC, error) {
+	return _ILC_names.Parse(s)
+}
+
+var _ILD_names = enum.Flags[ILD]{
+	Type: "ILD",
+	Values: []enum.Name[ILD]{
+		{Val: ILD_TRANSPARENT, Name: "ILD_TRANSPARENT"},
+		{Val: ILD_IMAGE, Name: "ILD_IMAGE"},
+		{Val: ILD_ROP, Name: "ILD_ROP"},
+		{Val: ILD_BLEND25, Name: "ILD_BLEND25"},
+		{Val: ILD_BLEND50, Name: "ILD_BLEND50"},
+		{Val: ILD_PRESERVEALPHA, Name: "ILD_PRESERVEALPHA"},
+		{Val: ILD_SCALE, Name: "ILD_SCALE"},
+		{Val: ILD_DPISCALE, Name: "ILD_DPISCALE"},
+		{Val: ILD_ASYNC, Name: "ILD_ASYNC"},
+		{Val: ILD_NORMAL, Name: "ILD_NORMAL"},
+		{Val: ILD_SELECTED, Name: "ILD_SELECTED"},
+		{Val: ILD_FOCUS, Name: "ILD_FOCUS"},
+		{Val: ILD_BLEND, Name: "ILD_BLEND"},
+	},
+	ParseOnly: []enum.Name[ILD]{
+		{Val: ILD_MASK, Name: "ILD_MASK"},
+		{Val: ILD_OVERLAYMASK, Name: "ILD_OVERLAYMASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ILD_TRANSPARENT|ILD_IMAGE".
+func (v ILD) String() string {
+	return _ILD_names.String(v)
+}
+
+// Parses names of [ILD] flags joined by "|", or numbers.
+func ParseILD(s string) (ILD, error) {
+	return _ILD_names.Parse(s)
+}
+
+var _ILS_names = enum.Flags[ILS]{
+	Type: "ILS",
+	Values: []enum.Name[ILS]{
+		{Val: ILS_GLOW, Name: "ILS_GLOW"},
+		{Val: ILS_SHADOW, Name: "ILS_SHADOW"},
+		{Val: ILS_SATURATE, Name: "ILS_SATURATE"},
+		{Val: ILS_ALPHA, Name: "ILS_ALPHA"},
+		{Val: ILS_NORMAL, Name: "ILS_NORMAL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ILS_GLOW|ILS_SHADOW".
+func (v ILS) String() string {
+	return _ILS_names.String(v)
+}
+
+// Parses names of [ILS] flags joined by "|", or numbers.
+func ParseILS(s string) (ILS, error) {
+	return _ILS_names.Parse(s)
+}
+
+var _LIF_names = enum.Flags[LIF]{
+	Type: "LIF",
+	Values: []enum.Name[LIF]{
+		{Val: LIF_ITEMINDEX, Name: "LIF_ITEMINDEX"},
+		{Val: LIF_STATE, Name: "LIF_STATE"},
+		{Val: LIF_ITEMID, Name: "LIF_ITEMID"},
+		{Val: LIF_URL, Name: "LIF_URL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LIF_ITEMINDEX|LIF_STATE".
+func (v LIF) String() string {
+	return _LIF_names.String(v)
+}
+
+// Parses names of [LIF] flags joined by "|", or numbers.
+func ParseLIF(s string) (LIF, error) {
+	return _LIF_names.Parse(s)
+}
+
+var _LIS_names = enum.Flags[LIS]{
+	Type: "LIS",
+	Values: []enum.Name[LIS]{
+		{Val: LIS_FOCUSED, Name: "LIS_FOCUSED"},
+		{Val: LIS_ENABLED, Name: "LIS_ENABLED"},
+		{Val: LIS_VISITED, Name: "LIS_VISITED"},
+		{Val: LIS_HOTTRACK, Name: "LIS_HOTTRACK"},
+		{Val: LIS_DEFAULTCOLORS, Name: "LIS_DEFAULTCOLORS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LIS_FOCUSED|LIS_ENABLED".
+func (v LIS) String() string {
+	return _LIS_names.String(v)
+}
+
+// Parses names of [LIS] flags joined by "|", or numbers.
+func ParseLIS(s string) (LIS, error) {
+	return _LIS_names.Parse(s)
+}
+
+var _LV_VIEW_names = enum.Enum[LV_VIEW]{
+	Type: "LV_VIEW",
+	Values: []enum.Name[LV_VIEW]{
+		{Val: LV_VIEW_ICON, Name: "LV_VIEW_ICON"},
+		{Val: LV_VIEW_DETAILS, Name: "LV_VIEW_DETAILS"},
+		{Val: LV_VIEW_SMALLICON, Name: "LV_VIEW_SMALLICON"},
+		{Val: LV_VIEW_LIST, Name: "LV_VIEW_LIST"},
+		{Val: LV_VIEW_TILE, Name: "LV_VIEW_TILE"},
+	},
+}
+
+// Returns the name of the constant, like "LV_VIEW_ICON".
+func (v LV_VIEW) String() string {
+	return _LV_VIEW_names.String(v)
+}
+
+// Parses the name of a [LV_VIEW] constant, or a number.
+func ParseLV_VIEW(s string) (LV_VIEW, error) {
+	return _LV_VIEW_names.Parse(s)
+}
+
+var _LVCDI_names = enum.Enum[LVCDI]{
+	Type: "LVCDI",
+	Values: []enum.Name[LVCDI]{
+		{Val: LVCDI_ITEM, Name: "LVCDI_ITEM"},
+		{Val: LVCDI_GROUP, Name: "LVCDI_GROUP"},
+		{Val: LVCDI_TEMSLIST, Name: "LVCDI_TEMSLIST"},
+	},
+}
+
+// Returns the name of the constant, like "LVCDI_ITEM".
+func (v LVCDI) String() string {
+	return _LVCDI_names.String(v)
+}
+
+// Parses the name of a [LVCDI] constant, or a number.
+func ParseLVCDI(s string) (LVCDI, error) {
+	return _LVCDI_names.Parse(s)
+}
+
+var _LVCF_names = enum.Flags[LVCF]{
+	Type: "LVCF",
+	Values: []enum.Name[LVCF]{
+		{Val: LVCF_DEFAULTWIDTH, Name: "LVCF_DEFAULTWIDTH"},
+		{Val: LVCF_FMT, Name: "LVCF_FMT"},
+		{Val: LVCF_IDEALWIDTH, Name: "LVCF_IDEALWIDTH"},
+		{Val: LVCF_IMAGE, Name: "LVCF_IMAGE"},
+		{Val: LVCF_MINWIDTH, Name: "LVCF_MINWIDTH"},
+		{Val: LVCF_ORDER, Name: "LVCF_ORDER"},
+		{Val: LVCF_SUBITEM, Name: "LVCF_SUBITEM"},
+		{Val: LVCF_TEXT, Name: "LVCF_TEXT"},
+		{Val: LVCF_WIDTH, Name: "LVCF_WIDTH"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVCF_DEFAULTWIDTH|LVCF_FMT".
+func (v LVCF) String() string {
+	return _LVCF_names.String(v)
+}
+
+// Parses names of [LVCF] flags joined by "|", or numbers.
+func ParseLVCF(s string) (LVCF, error) {
+	return _LVCF_names.Parse(s)
+}
+
+var _LVCFMT_C_names = enum.Flags[LVCFMT_C]{
+	Type: "LVCFMT_C",
+	Values: []enum.Name[LVCFMT_C]{
+		{Val: LVCFMT_C_RIGHT, Name: "LVCFMT_C_RIGHT"},
+		{Val: LVCFMT_C_CENTER, Name: "LVCFMT_C_CENTER"},
+		{Val: LVCFMT_C_IMAGE, Name: "LVCFMT_C_IMAGE"},
+		{Val: LVCFMT_C_BITMAP_ON_RIGHT, Name: "LVCFMT_C_BITMAP_ON_RIGHT"},
+		{Val: LVCFMT_C_COL_HAS_IMAGES, Name: "LVCFMT_C_COL_HAS_IMAGES"},
+		{Val: LVCFMT_C_FIXED_WIDTH, Name: "LVCFMT_C_FIXED_WIDTH"},
+		{Val: LVCFMT_C_NO_DPI_SCALE, Name: "LVCFMT_C_NO_DPI_SCALE"},
+		{Val: LVCFMT_C_FIXED_RATIO, Name: "LVCFMT_C_FIXED_RATIO"},
+		{Val: LVCFMT_C_SPLITBUTTON, Name: "LVCFMT_C_SPLITBUTTON"},
+		{Val: LVCFMT_C_LEFT, Name: "LVCFMT_C_LEFT"},
+	},
+	ParseOnly: []enum.Name[LVCFMT_C]{
+		{Val: LVCFMT_C_JUSTIFYMASK, Name: "LVCFMT_C_JUSTIFYMASK"},
+	},
+	Fields: []LVCFMT_C{0x3},
+}
+
+// Returns the names of the flags joined by "|", like "LVCFMT_C_IMAGE|LVCFMT_C_BITMAP_ON_RIGHT".
+func (v LVCFMT_C) String() string {
+	return _LVCFMT_C_names.String(v)
+}
+
+// Parses names of [LVCFMT_C] flags joined by "|", or numbers.
+func ParseLVCFMT_C(s string) (LVCFMT_C, error) {
+	return _LVCFMT_C_names.Parse(s)
+}
+
+var _LVCFMT_I_names = enum.Flags[LVCFMT_I]{
+	Type: "LVCFMT_I",
+	Values: []enum.Name[LVCFMT_I]{
+		{Val: LVCFMT_I_LINE_BREAK, Name: "LVCFMT_I_LINE_BREAK"},
+		{Val: LVCFMT_I_FILL, Name: "LVCFMT_I_FILL"},
+		{Val: LVCFMT_I_WRAP, Name: "LVCFMT_I_WRAP"},
+		{Val: LVCFMT_I_NO_TITLE, Name: "LVCFMT_I_NO_TITLE"},
+	},
+	ParseOnly: []enum.Name[LVCFMT_I]{
+		{Val: LVCFMT_I_TILE_PLACEMENTMASK, Name: "LVCFMT_I_TILE_PLACEMENTMASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVCFMT_I_LINE_BREAK|LVCFMT_I_FILL".
+func (v LVCFMT_I) String() string {
+	return _LVCFMT_I_names.String(v)
+}
+
+// Parses names of [LVCFMT_I] flags joined by "|", or numbers.
+func ParseLVCFMT_I(s string) (LVCFMT_I, error) {
+	return _LVCFMT_I_names.Parse(s)
+}
+
+var _LVFI_names = enum.Flags[LVFI]{
+	Type: "LVFI",
+	Values: []enum.Name[LVFI]{
+		{Val: LVFI_PARAM, Name: "LVFI_PARAM"},
+		{Val: LVFI_STRING, Name: "LVFI_STRING"},
+		{Val: LVFI_SUBSTRING, Name: "LVFI_SUBSTRING"},
+		{Val: LVFI_PARTIAL, Name: "LVFI_PARTIAL"},
+		{Val: LVFI_WRAP, Name: "LVFI_WRAP"},
+		{Val: LVFI_NEARESTXY, Name: "LVFI_NEARESTXY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVFI_PARAM|LVFI_STRING".
+func (v LVFI) String() string {
+	return _LVFI_names.String(v)
+}
+
+// Parses names of [LVFI] flags joined by "|", or numbers.
+func ParseLVFI(s string) (LVFI, error) {
+	return _LVFI_names.Parse(s)
+}
+
+var _LVGA_HEADER_names = enum.Enum[LVGA_HEADER]{
+	Type: "LVGA_HEADER",
+	Values: []enum.Name[LVGA_HEADER]{
+		{Val: LVGA_HEADER_LEFT, Name: "LVGA_HEADER_LEFT"},
+		{Val: LVGA_HEADER_CENTER, Name: "LVGA_HEADER_CENTER"},
+		{Val: LVGA_HEADER_RIGHT, Name: "LVGA_HEADER_RIGHT"},
+	},
+}
+
+// Returns the name of the constant, like "LVGA_HEADER_LEFT".
+func (v LVGA_HEADER) String() string {
+	return _LVGA_HEADER_names.String(v)
+}
+
+// Parses the name of a [LVGA_HEADER] constant, or a number.
+func ParseLVGA_HEADER(s string) (LVGA_HEADER, error) {
+	return _LVGA_HEADER_names.Parse(s)
+}
+
+var _LVGIT_names = enum.Enum[LVGIT]{
+	Type: "LVGIT",
+	Values: []enum.Name[LVGIT]{
+		{Val: LVGIT_ZERO, Name: "LVGIT_ZERO"},
+		{Val: LVGIT_UNFOLDED, Name: "LVGIT_UNFOLDED"},
+	},
+}
+
+// Returns the name of the constant, like "LVGIT_ZERO".
+func (v LVGIT) String() string {
+	return _LVGIT_names.String(v)
+}
+
+// Parses the name of a [LVGIT] constant, or a number.
+func ParseLVGIT(s string) (LVGIT, error) {
+	return _LVGIT_names.Parse(s)
+}
+
+var _LVHT_names = enum.Flags[LVHT]{
+	Type: "LVHT",
+	Values: []enum.Name[LVHT]{
+		{Val: LVHT_EX_GROUP, Name: "LVHT_EX_GROUP"},
+		{Val: LVHT_ONITEM, Name: "LVHT_ONITEM"},
+		{Val: LVHT_NOWHERE, Name: "LVHT_NOWHERE"},
+		{Val: LVHT_ONITEMICON, Name: "LVHT_ONITEMICON"},
+		{Val: LVHT_ONITEMLABEL, Name: "LVHT_ONITEMLABEL"},
+		{Val: LVHT_ONITEMSTATEICON, Name: "LVHT_ONITEMSTATEICON"},
+		{Val: LVHT_ABOVE, Name: "LVHT_ABOVE"},
+		{Val: LVHT_BELOW, Name: "LVHT_BELOW"},
+		{Val: LVHT_TORIGHT, Name: "LVHT_TORIGHT"},
+		{Val: LVHT_TOLEFT, Name: "LVHT_TOLEFT"},
+		{Val: LVHT_EX_GROUP_HEADER, Name: "LVHT_EX_GROUP_HEADER"},
+		{Val: LVHT_EX_GROUP_FOOTER, Name: "LVHT_EX_GROUP_FOOTER"},
+		{Val: LVHT_EX_GROUP_COLLAPSE, Name: "LVHT_EX_GROUP_COLLAPSE"},
+		{Val: LVHT_EX_GROUP_BACKGROUND, Name: "LVHT_EX_GROUP_BACKGROUND"},
+		{Val: LVHT_EX_GROUP_STATEICON, Name: "LVHT_EX_GROUP_STATEICON"},
+		{Val: LVHT_EX_GROUP_SUBSETLINK, Name: "LVHT_EX_GROUP_SUBSETLINK"},
+		{Val: LVHT_EX_ONCONTENTS, Name: "LVHT_EX_ONCONTENTS"},
+		{Val: LVHT_EX_FOOTER, Name: "LVHT_EX_FOOTER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVHT_NOWHERE|LVHT_ONITEMICON".
+func (v LVHT) String() string {
+	return _LVHT_names.String(v)
+}
+
+// Parses names of [LVHT] flags joined by "|", or numbers.
+func ParseLVHT(s string) (LVHT, error) {
+	return _LVHT_names.Parse(s)
+}
+
+var _LVI_GROUPID_names = enum.Enum[LVI_GROUPID]{
+	Type: "LVI_GROUPID",
+	Values: []enum.Name[LVI_GROUPID]{
+		{Val: LVI_GROUPID_I_GROUPIDCALLBACK, Name: "LVI_GROUPID_I_GROUPIDCALLBACK"},
+		{Val: LVI_GROUPID_I_GROUPIDNONE, Name: "LVI_GROUPID_I_GROUPIDNONE"},
+	},
+}
+
+// Returns the name of the constant, like "LVI_GROUPID_I_GROUPIDCALLBACK".
+func (v LVI_GROUPID) String() string {
+	return _LVI_GROUPID_names.String(v)
+}
+
+// Parses the name of a [LVI_GROUPID] constant, or a number.
+func ParseLVI_GROUPID(s string) (LVI_GROUPID, error) {
+	return _LVI_GROUPID_names.Parse(s)
+}
+
+var _LVIF_names = enum.Flags[LVIF]{
+	Type: "LVIF",
+	Values: []enum.Name[LVIF]{
+		{Val: LVIF_COLFMT, Name: "LVIF_COLFMT"},
+		{Val: LVIF_COLUMNS, Name: "LVIF_COLUMNS"},
+		{Val: LVIF_GROUPID, Name: "LVIF_GROUPID"},
+		{Val: LVIF_IMAGE, Name: "LVIF_IMAGE"},
+		{Val: LVIF_INDENT, Name: "LVIF_INDENT"},
+		{Val: LVIF_NORECOMPUTE, Name: "LVIF_NORECOMPUTE"},
+		{Val: LVIF_PARAM, Name: "LVIF_PARAM"},
+		{Val: LVIF_STATE, Name: "LVIF_STATE"},
+		{Val: LVIF_TEXT, Name: "LVIF_TEXT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVIF_COLFMT|LVIF_COLUMNS".
+func (v LVIF) String() string {
+	return _LVIF_names.String(v)
+}
+
+// Parses names of [LVIF] flags joined by "|", or numbers.
+func ParseLVIF(s string) (LVIF, error) {
+	return _LVIF_names.Parse(s)
+}
+
+var _LVIR_names = enum.Enum[LVIR]{
+	Type: "LVIR",
+	Values: []enum.Name[LVIR]{
+		{Val: LVIR_BOUNDS, Name: "LVIR_BOUNDS"},
+		{Val: LVIR_ICON, Name: "LVIR_ICON"},
+		{Val: LVIR_LABEL, Name: "LVIR_LABEL"},
+		{Val: LVIR_SELECTBOUNDS, Name: "LVIR_SELECTBOUNDS"},
+	},
+}
+
+// Returns the name of the constant, like "LVIR_BOUNDS".
+func (v LVIR) String() string {
+	return _LVIR_names.String(v)
+}
+
+// Parses the name of a [LVIR] constant, or a number.
+func ParseLVIR(s string) (LVIR, error) {
+	return _LVIR_names.Parse(s)
+}
+
+var _LVIS_names = enum.Flags[LVIS]{
+	Type: "LVIS",
+	Values: []enum.Name[LVIS]{
+		{Val: LVIS_FOCUSED, Name: "LVIS_FOCUSED"},
+		{Val: LVIS_SELECTED, Name: "LVIS_SELECTED"},
+		{Val: LVIS_CUT, Name: "LVIS_CUT"},
+		{Val: LVIS_DROPHILITED, Name: "LVIS_DROPHILITED"},
+		{Val: LVIS_GLOW, Name: "LVIS_GLOW"},
+		{Val: LVIS_ACTIVATING, Name: "LVIS_ACTIVATING"},
+		{Val: LVIS_NONE, Name: "LVIS_NONE"},
+	},
+	ParseOnly: []enum.Name[LVIS]{
+		{Val: LVIS_OVERLAYMASK, Name: "LVIS_OVERLAYMASK"},
+		{Val: LVIS_STATEIMAGEMASK, Name: "LVIS_STATEIMAGEMASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVIS_FOCUSED|LVIS_SELECTED".
+func (v LVIS) String() string {
+	return _LVIS_names.String(v)
+}
+
+// Parses names of [LVIS] flags joined by "|", or numbers.
+func ParseLVIS(s string) (LVIS, error) {
+	return _LVIS_names.Parse(s)
+}
+
+var _LVKF_names = enum.Flags[LVKF]{
+	Type: "LVKF",
+	Values: []enum.Name[LVKF]{
+		{Val: LVKF_ALT, Name: "LVKF_ALT"},
+		{Val: LVKF_CONTROL, Name: "LVKF_CONTROL"},
+		{Val: LVKF_SHIFT, Name: "LVKF_SHIFT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVKF_ALT|LVKF_CONTROL".
+func (v LVKF) String() string {
+	return _LVKF_names.String(v)
+}
+
+// Parses names of [LVKF] flags joined by "|", or numbers.
+func ParseLVKF(s string) (LVKF, error) {
+	return _LVKF_names.Parse(s)
+}
+
+var _LVNI_names = enum.Flags[LVNI]{
+	Type: "LVNI",
+	Values: []enum.Name[LVNI]{
+		{Val: LVNI_FOCUSED, Name: "LVNI_FOCUSED"},
+		{Val: LVNI_SELECTED, Name: "LVNI_SELECTED"},
+		{Val: LVNI_CUT, Name: "LVNI_CUT"},
+		{Val: LVNI_DROPHILITED, Name: "LVNI_DROPHILITED"},
+		{Val: LVNI_VISIBLEORDER, Name: "LVNI_VISIBLEORDER"},
+		{Val: LVNI_PREVIOUS, Name: "LVNI_PREVIOUS"},
+		{Val: LVNI_VISIBLEONLY, Name: "LVNI_VISIBLEONLY"},
+		{Val: LVNI_SAMEGROUPONLY, Name: "LVNI_SAMEGROUPONLY"},
+		{Val: LVNI_ABOVE, Name: "LVNI_ABOVE"},
+		{Val: LVNI_BELOW, Name: "LVNI_BELOW"},
+		{Val: LVNI_TOLEFT, Name: "LVNI_TOLEFT"},
+		{Val: LVNI_TORIGHT, Name: "LVNI_TORIGHT"},
+		{Val: LVNI_ALL, Name: "LVNI_ALL"},
+	},
+	ParseOnly: []enum.Name[LVNI]{
+		{Val: LVNI_STATEMASK, Name: "LVNI_STATEMASK"},
+		{Val: LVNI_DIRECTIONMASK, Name: "LVNI_DIRECTIONMASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVNI_FOCUSED|LVNI_SELECTED".
+func (v LVNI) String() string {
+	return _LVNI_names.String(v)
+}
+
+// Parses names of [LVNI] flags joined by "|", or numbers.
+func ParseLVNI(s string) (LVNI, error) {
+	return _LVNI_names.Parse(s)
+}
+
+var _LVS_names = enum.Flags[LVS]{
+	Type: "LVS",
+	Values: []enum.Name[LVS]{
+		{Val: LVS_LIST, Name: "LVS_LIST"},
+		{Val: LVS_ALIGNLEFT, Name: "LVS_ALIGNLEFT"},
+		{Val: LVS_AUTOARRANGE, Name: "LVS_AUTOARRANGE"},
+		{Val: LVS_EDITLABELS, Name: "LVS_EDITLABELS"},
+		{Val: LVS_NOCOLUMNHEADER, Name: "LVS_NOCOLUMNHEADER"},
+		{Val: LVS_NOLABELWRAP, Name: "LVS_NOLABELWRAP"},
+		{Val: LVS_NOSCROLL, Name: "LVS_NOSCROLL"},
+		{Val: LVS_NOSORTHEADER, Name: "LVS_NOSORTHEADER"},
+		{Val: LVS_OWNERDATA, Name: "LVS_OWNERDATA"},
+		{Val: LVS_OWNERDRAWFIXED, Name: "LVS_OWNERDRAWFIXED"},
+		{Val: LVS_REPORT, Name: "LVS_REPORT"},
+		{Val: LVS_SHAREIMAGELISTS, Name: "LVS_SHAREIMAGELISTS"},
+		{Val: LVS_SHOWSELALWAYS, Name: "LVS_SHOWSELALWAYS"},
+		{Val: LVS_SINGLESEL, Name: "LVS_SINGLESEL"},
+		{Val: LVS_SMALLICON, Name: "LVS_SMALLICON"},
+		{Val: LVS_SORTASCENDING, Name: "LVS_SORTASCENDING"},
+		{Val: LVS_SORTDESCENDING, Name: "LVS_SORTDESCENDING"},
+		{Val: LVS_NONE, Name: "LVS_NONE"},
+		{Val: LVS_ALIGNTOP, Name: "LVS_ALIGNTOP"},
+		{Val: LVS_ICON, Name: "LVS_ICON"},
+	},
+	ParseOnly: []enum.Name[LVS]{
+		{Val: LVS_ALIGNMASK, Name: "LVS_ALIGNMASK"},
+		{Val: LVS_TYPEMASK, Name: "LVS_TYPEMASK"},
+		{Val: LVS_TYPESTYLEMASK, Name: "LVS_TYPESTYLEMASK"},
+	},
+	Fields: []LVS{0x3},
+}
+
+// Returns the names of the flags joined by "|", like "LVS_ALIGNLEFT|LVS_AUTOARRANGE".
+func (v LVS) String() string {
+	return _LVS_names.String(v)
+}
+
+// Parses names of [LVS] flags joined by "|", or numbers.
+func ParseLVS(s string) (LVS, error) {
+	return _LVS_names.Parse(s)
+}
+
+var _LVS_EX_names = enum.Flags[LVS_EX]{
+	Type: "LVS_EX",
+	Values: []enum.Name[LVS_EX]{
+		{Val: LVS_EX_AUTOAUTOARRANGE, Name: "LVS_EX_AUTOAUTOARRANGE"},
+		{Val: LVS_EX_AUTOCHECKSELECT, Name: "LVS_EX_AUTOCHECKSELECT"},
+		{Val: LVS_EX_AUTOSIZECOLUMNS, Name: "LVS_EX_AUTOSIZECOLUMNS"},
+		{Val: LVS_EX_BORDERSELECT, Name: "LVS_EX_BORDERSELECT"},
+		{Val: LVS_EX_CHECKBOXES, Name: "LVS_EX_CHECKBOXES"},
+		{Val: LVS_EX_COLUMNOVERFLOW, Name: "LVS_EX_COLUMNOVERFLOW"},
+		{Val: LVS_EX_COLUMNSNAPPOINTS, Name: "LVS_EX_COLUMNSNAPPOINTS"},
+		{Val: LVS_EX_DOUBLEBUFFER, Name: "LVS_EX_DOUBLEBUFFER"},
+		{Val: LVS_EX_FLATSB, Name: "LVS_EX_FLATSB"},
+		{Val: LVS_EX_FULLROWSELECT, Name: "LVS_EX_FULLROWSELECT"},
+		{Val: LVS_EX_GRIDLINES, Name: "LVS_EX_GRIDLINES"},
+		{Val: LVS_EX_HEADERDRAGDROP, Name: "LVS_EX_HEADERDRAGDROP"},
+		{Val: LVS_EX_HEADERINALLVIEWS, Name: "LVS_EX_HEADERINALLVIEWS"},
+		{Val: LVS_EX_HIDELABELS, Name: "LVS_EX_HIDELABELS"},
+		{Val: LVS_EX_INFOTIP, Name: "LVS_EX_INFOTIP"},
+		{Val: LVS_EX_JUSTIFYCOLUMNS, Name: "LVS_EX_JUSTIFYCOLUMNS"},
+		{Val: LVS_EX_LABELTIP, Name: "LVS_EX_LABELTIP"},
+		{Val: LVS_EX_MULTIWORKAREAS, Name: "LVS_EX_MULTIWORKAREAS"},
+		{Val: LVS_EX_ONECLICKACTIVATE, Name: "LVS_EX_ONECLICKACTIVATE"},
+		{Val: LVS_EX_REGIONAL, Name: "LVS_EX_REGIONAL"},
+		{Val: LVS_EX_SIMPLESELECT, Name: "LVS_EX_SIMPLESELECT"},
+		{Val: LVS_EX_SINGLEROW, Name: "LVS_EX_SINGLEROW"},
+		{Val: LVS_EX_SNAPTOGRID, Name: "LVS_EX_SNAPTOGRID"},
+		{Val: LVS_EX_SUBITEMIMAGES, Name: "LVS_EX_SUBITEMIMAGES"},
+		{Val: LVS_EX_TRACKSELECT, Name: "LVS_EX_TRACKSELECT"},
+		{Val: LVS_EX_TRANSPARENTBKGND, Name: "LVS_EX_TRANSPARENTBKGND"},
+		{Val: LVS_EX_TRANSPARENTSHADOWTEXT, Name: "LVS_EX_TRANSPARENTSHADOWTEXT"},
+		{Val: LVS_EX_TWOCLICKACTIVATE, Name: "LVS_EX_TWOCLICKACTIVATE"},
+		{Val: LVS_EX_UNDERLINECOLD, Name: "LVS_EX_UNDERLINECOLD"},
+		{Val: LVS_EX_UNDERLINEHOT, Name: "LVS_EX_UNDERLINEHOT"},
+		{Val: LVS_EX_NONE, Name: "LVS_EX_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVS_EX_AUTOAUTOARRANGE|LVS_EX_AUTOCHECKSELECT".
+func (v LVS_EX) String() string {
+	return _LVS_EX_names.String(v)
+}
+
+// Parses names of [LVS_EX] flags joined by "|", or numbers.
+func ParseLVS_EX(s string) (LVS_EX, error) {
+	return _LVS_EX_names.Parse(s)
+}
+
+var _LVSIL_names = enum.Enum[LVSIL]{
+	Type: "LVSIL",
+	Values: []enum.Name[LVSIL]{
+		{Val: LVSIL_NORMAL, Name: "LVSIL_NORMAL"},
+		{Val: LVSIL_SMALL, Name: "LVSIL_SMALL"},
+		{Val: LVSIL_STATE, Name: "LVSIL_STATE"},
+		{Val: LVSIL_GROUPHEADER, Name: "LVSIL_GROUPHEADER"},
+	},
+}
+
+// Returns the name of the constant, like "LVSIL_NORMAL".
+func (v LVSIL) String() string {
+	return _LVSIL_names.String(v)
+}
+
+// Parses the name of a [LVSIL] constant, or a number.
+func ParseLVSIL(s string) (LVSIL, error) {
+	return _LVSIL_names.Parse(s)
+}
+
+var _LVSICF_names = enum.Flags[LVSICF]{
+	Type: "LVSICF",
+	Values: []enum.Name[LVSICF]{
+		{Val: LVSICF_NOINVALIDATEALL, Name: "LVSICF_NOINVALIDATEALL"},
+		{Val: LVSICF_NOSCROLL, Name: "LVSICF_NOSCROLL"},
+		{Val: LVSICF_NONE, Name: "LVSICF_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LVSICF_NOINVALIDATEALL|LVSICF_NOSCROLL".
+func (v LVSICF) String() string {
+	return _LVSICF_names.String(v)
+}
+
+// Parses names of [LVSICF] flags joined by "|", or numbers.
+func ParseLVSICF(s string) (LVSICF, error) {
+	return _LVSICF_names.Parse(s)
+}
+
+var _LWS_names = enum.Flags[LWS]{
+	Type: "LWS",
+	Values: []enum.Name[LWS]{
+		{Val: LWS_TRANSPARENT, Name: "LWS_TRANSPARENT"},
+		{Val: LWS_IGNORERETURN, Name: "LWS_IGNORERETURN"},
+		{Val: LWS_NOPREFIX, Name: "LWS_NOPREFIX"},
+		{Val: LWS_USEVISUALSTYLE, Name: "LWS_USEVISUALSTYLE"},
+		{Val: LWS_USECUSTOMTEXT, Name: "LWS_USECUSTOMTEXT"},
+		{Val: LWS_RIGHT, Name: "LWS_RIGHT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LWS_TRANSPARENT|LWS_IGNORERETURN".
+func (v LWS) String() string {
+	return _LWS_names.String(v)
+}
+
+// Parses names of [LWS] flags joined by "|", or numbers.
+func ParseLWS(s string) (LWS, error) {
+	return _LWS_names.Parse(s)
+}
+
+var _MCMV_names = enum.Enum[MCMV]{
+	Type: "MCMV",
+	Values: []enum.Name[MCMV]{
+		{Val: MCMV_MONTH, Name: "MCMV_MONTH"},
+		{Val: MCMV_YEAR, Name: "MCMV_YEAR"},
+		{Val: MCMV_DECADE, Name: "MCMV_DECADE"},
+		{Val: MCMV_CENTURY, Name: "MCMV_CENTURY"},
+	},
+}
+
+// Returns the name of the constant, like "MCMV_MONTH".
+func (v MCMV) String() string {
+	return _MCMV_names.String(v)
+}
+
+// Parses the name of a [MCMV] constant, or a number.
+func ParseMCMV(s string) (MCMV, error) {
+	return _MCMV_names.Parse(s)
+}
+
+var _MCS_names = enum.Flags[MCS]{
+	Type: "MCS",
+	Values: []enum.Name[MCS]{
+		{Val: MCS_DAYSTATE, Name: "MCS_DAYSTATE"},
+		{Val: MCS_MULTISELECT, Name: "MCS_MULTISELECT"},
+		{Val: MCS_WEEKNUMBERS, Name: "MCS_WEEKNUMBERS"},
+		{Val: MCS_NOTODAYCIRCLE, Name: "MCS_NOTODAYCIRCLE"},
+		{Val: MCS_NOTODAY, Name: "MCS_NOTODAY"},
+		{Val: MCS_NOTRAILINGDATES, Name: "MCS_NOTRAILINGDATES"},
+		{Val: MCS_SHORTDAYSOFWEEK, Name: "MCS_SHORTDAYSOFWEEK"},
+		{Val: MCS_NOSELCHANGEONNAV, Name: "MCS_NOSELCHANGEONNAV"},
+		{Val: MCS_NONE, Name: "MCS_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MCS_DAYSTATE|MCS_MULTISELECT".
+func (v MCS) String() string {
+	return _MCS_names.String(v)
+}
+
+// Parses names of [MCS] flags joined by "|", or numbers.
+func ParseMCS(s string) (MCS, error) {
+	return _MCS_names.Parse(s)
+}
+
+var _PBS_names = enum.Flags[PBS]{
+	Type: "PBS",
+	Values: []enum.Name[PBS]{
+		{Val: PBS_SMOOTH, Name: "PBS_SMOOTH"},
+		{Val: PBS_VERTICAL, Name: "PBS_VERTICAL"},
+		{Val: PBS_MARQUEE, Name: "PBS_MARQUEE"},
+		{Val: PBS_SMOOTHREVERSE, Name: "PBS_SMOOTHREVERSE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PBS_SMOOTH|PBS_VERTICAL".
+func (v PBS) String() string {
+	return _PBS_names.String(v)
+}
+
+// Parses names of [PBS] flags joined by "|", or numbers.
+func ParsePBS(s string) (PBS, error) {
+	return _PBS_names.Parse(s)
+}
+
+var _PBST_names = enum.Enum[PBST]{
+	Type: "PBST",
+	Values: []enum.Name[PBST]{
+		{Val: PBST_NORMAL, Name: "PBST_NORMAL"},
+		{Val: PBST_ERROR, Name: "PBST_ERROR"},
+		{Val: PBST_PAUSED, Name: "PBST_PAUSED"},
+	},
+}
+
+// Returns the name of the constant, like "PBST_NORMAL".
+func (v PBST) String() string {
+	return _PBST_names.String(v)
+}
+
+// Parses the name of a [PBST] constant, or a number.
+func ParsePBST(s string) (PBST, error) {
+	return _PBST_names.Parse(s)
+}
+
+var _SBARS_names = enum.Flags[SBARS]{
+	Type: "SBARS",
+	Values: []enum.Name[SBARS]{
+		{Val: SBARS_SIZEGRIP, Name: "SBARS_SIZEGRIP"},
+		{Val: SBARS_TOOLTIPS, Name: "SBARS_TOOLTIPS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SBARS_SIZEGRIP|SBARS_TOOLTIPS".
+func (v SBARS) String() string {
+	return _SBARS_names.String(v)
+}
+
+// Parses names of [SBARS] flags joined by "|", or numbers.
+func ParseSBARS(s string) (SBARS, error) {
+	return _SBARS_names.Parse(s)
+}
+
+var _TBDDRET_names = enum.Enum[TBDDRET]{
+	Type: "TBDDRET",
+	Values: []enum.Name[TBDDRET]{
+		{Val: TBDDRET_DEFAULT, Name: "TBDDRET_DEFAULT"},
+		{Val: TBDDRET_NODEFAULT, Name: "TBDDRET_NODEFAULT"},
+		{Val: TBDDRET_TREATPRESSED, Name: "TBDDRET_TREATPRESSED"},
+	},
+}
+
+// Returns the name of the constant, like "TBDDRET_DEFAULT".
+func (v TBDDRET) String() string {
+	return _TBDDRET_names.String(v)
+}
+
+// Parses the name of a [TBDDRET] constant, or a number.
+func ParseTBDDRET(s string) (TBDDRET, error) {
+	return _TBDDRET_names.Parse(s)
+}
+
+var _TBNF_names = enum.Flags[TBNF]{
+	Type: "TBNF",
+	Values: []enum.Name[TBNF]{
+		{Val: TBNF_IMAGE, Name: "TBNF_IMAGE"},
+		{Val: TBNF_TEXT, Name: "TBNF_TEXT"},
+		{Val: TBNF_DI_SETITEM, Name: "TBNF_DI_SETITEM"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TBNF_IMAGE|TBNF_TEXT".
+func (v TBNF) String() string {
+	return _TBNF_names.String(v)
+}
+
+// Parses names of [TBNF] flags joined by "|", or numbers.
+func ParseTBNF(s string) (TBNF, error) {
+	return _TBNF_names.Parse(s)
+}
+
+var _TBNRF_names = enum.Flags[TBNRF]{
+	Type: "TBNRF",
+	Values: []enum.Name[TBNRF]{
+		{Val: TBNRF_HIDEHELP, Name: "TBNRF_HIDEHELP"},
+		{Val: TBNRF_ENDCUSTOMIZE, Name: "TBNRF_ENDCUSTOMIZE"},
+		{Val: TBNRF_NONE, Name: "TBNRF_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TBNRF_HIDEHELP|TBNRF_ENDCUSTOMIZE".
+func (v TBNRF) String() string {
+	return _TBNRF_names.String(v)
+}
+
+// Parses names of [TBNRF] flags joined by "|", or numbers.
+func ParseTBNRF(s string) (TBNRF, error) {
+	return _TBNRF_names.Parse(s)
+}
+
+var _TBS_names = enum.Flags[TBS]{
+	Type: "TBS",
+	Values: []enum.Name[TBS]{
+		{Val: TBS_AUTOTICKS, Name: "TBS_AUTOTICKS"},
+		{Val: TBS_VERT, Name: "TBS_VERT"},
+		{Val: TBS_TOP, Name: "TBS_TOP"},
+		{Val: TBS_LEFT, Name: "TBS_LEFT"},
+		{Val: TBS_BOTH, Name: "TBS_BOTH"},
+		{Val: TBS_NOTICKS, Name: "TBS_NOTICKS"},
+		{Val: TBS_ENABLESELRANGE, Name: "TBS_ENABLESELRANGE"},
+		{Val: TBS_FIXEDLENGTH, Name: "TBS_FIXEDLENGTH"},
+		{Val: TBS_NOTHUMB, Name: "TBS_NOTHUMB"},
+		{Val: TBS_TOOLTIPS, Name: "TBS_TOOLTIPS"},
+		{Val: TBS_REVERSED, Name: "TBS_REVERSED"},
+		{Val: TBS_DOWNISLEFT, Name: "TBS_DOWNISLEFT"},
+		{Val: TBS_NOTIFYBEFOREMOVE, Name: "TBS_NOTIFYBEFOREMOVE"},
+		{Val: TBS_TRANSPARENTBKGND, Name: "TBS_TRANSPARENTBKGND"},
+		{Val: TBS_HORZ, Name: "TBS_HORZ"},
+		{Val: TBS_BOTTOM, Name: "TBS_BOTTOM"},
+		{Val: TBS_RIGHT, Name: "TBS_RIGHT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TBS_AUTOTICKS|TBS_VERT".
+func (v TBS) String() string {
+	return _TBS_names.String(v)
+}
+
+// Parses names of [TBS] flags joined by "|", or numbers.
+func ParseTBS(s string) (TBS, error) {
+	return _TBS_names.Parse(s)
+}
+
+var _TBSTATE_names = enum.Flags[TBSTATE]{
+	Type: "TBSTATE",
+	Values: []enum.Name[TBSTATE]{
+		{Val: TBSTATE_CHECKED, Name: "TBSTATE_CHECKED"},
+		{Val: TBSTATE_PRESSED, Name: "TBSTATE_PRESSED"},
+		{Val: TBSTATE_ENABLED, Name: "TBSTATE_ENABLED"},
+		{Val: TBSTATE_HIDDEN, Name: "TBSTATE_HIDDEN"},
+		{Val: TBSTATE_INDETERMINATE, Name: "TBSTATE_INDETERMINATE"},
+		{Val: TBSTATE_WRAP, Name: "TBSTATE_WRAP"},
+		{Val: TBSTATE_ELLIPSES, Name: "TBSTATE_ELLIPSES"},
+		{Val: TBSTATE_MARKED, Name: "TBSTATE_MARKED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TBSTATE_CHECKED|TBSTATE_PRESSED".
+func (v TBSTATE) String() string {
+	return _TBSTATE_names.String(v)
+}
+
+// Parses names of [TBSTATE] flags joined by "|", or numbers.
+func ParseTBSTATE(s string) (TBSTATE, error) {
+	return _TBSTATE_names.Parse(s)
+}
+
+var _TBSTYLE_names = enum.Flags[TBSTYLE]{
+	Type: "TBSTYLE",
+	Values: []enum.Name[TBSTYLE]{
+		{Val: TBSTYLE_CHECKGROUP, Name: "TBSTYLE_CHECKGROUP"},
+		{Val: TBSTYLE_SEP, Name: "TBSTYLE_SEP"},
+		{Val: TBSTYLE_CHECK, Name: "TBSTYLE_CHECK"},
+		{Val: TBSTYLE_GROUP, Name: "TBSTYLE_GROUP"},
+		{Val: TBSTYLE_DROPDOWN, Name: "TBSTYLE_DROPDOWN"},
+		{Val: TBSTYLE_AUTOSIZE, Name: "TBSTYLE_AUTOSIZE"},
+		{Val: TBSTYLE_NOPREFIX, Name: "TBSTYLE_NOPREFIX"},
+		{Val: TBSTYLE_TOOLTIPS, Name: "TBSTYLE_TOOLTIPS"},
+		{Val: TBSTYLE_WRAPABLE, Name: "TBSTYLE_WRAPABLE"},
+		{Val: TBSTYLE_ALTDRAG, Name: "TBSTYLE_ALTDRAG"},
+		{Val: TBSTYLE_FLAT, Name: "TBSTYLE_FLAT"},
+		{Val: TBSTYLE_LIST, Name: "TBSTYLE_LIST"},
+		{Val: TBSTYLE_CUSTOMERASE, Name: "TBSTYLE_CUSTOMERASE"},
+		{Val: TBSTYLE_REGISTERDROP, Name: "TBSTYLE_REGISTERDROP"},
+		{Val: TBSTYLE_TRANSPARENT, Name: "TBSTYLE_TRANSPARENT"},
+		{Val: TBSTYLE_BUTTON, Name: "TBSTYLE_BUTTON"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TBSTYLE_SEP|TBSTYLE_CHECK".
+func (v TBSTYLE) String() string {
+	return _TBSTYLE_names.String(v)
+}
+
+// Parses names of [TBSTYLE] flags joined by "|", or numbers.
+func ParseTBSTYLE(s string) (TBSTYLE, error) {
+	return _TBSTYLE_names.Parse(s)
+}
+
+var _TBSTYLE_EX_names = enum.Flags[TBSTYLE_EX]{
+	Type: "TBSTYLE_EX",
+	Values: []enum.Name[TBSTYLE_EX]{
+		{Val: TBSTYLE_EX_DRAWDDARROWS, Name: "TBSTYLE_EX_DRAWDDARROWS"},
+		{Val: TBSTYLE_EX_MIXEDBUTTONS, Name: "TBSTYLE_EX_MIXEDBUTTONS"},
+		{Val: TBSTYLE_EX_HIDECLIPPEDBUTTONS, Name: "TBSTYLE_EX_HIDECLIPPEDBUTTONS"},
+		{Val: TBSTYLE_EX_MULTICOLUMN, Name: "TBSTYLE_EX_MULTICOLUMN"},
+		{Val: TBSTYLE_EX_VERTICAL, Name: "TBSTYLE_EX_VERTICAL"},
+		{Val: TBSTYLE_EX_DOUBLEBUFFER, Name: "TBSTYLE_EX_DOUBLEBUFFER"},
+		{Val: TBSTYLE_EX_NONE, Name: "TBSTYLE_EX_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TBSTYLE_EX_DRAWDDARROWS|TBSTYLE_EX_MIXEDBUTTONS".
+func (v TBSTYLE_EX) String() string {
+	return _TBSTYLE_EX_names.String(v)
+}
+
+// Parses names of [TBSTYLE_EX] flags joined by "|", or numbers.
+func ParseTBSTYLE_EX(s string) (TBSTYLE_EX, error) {
+	return _TBSTYLE_EX_names.Parse(s)
+}
+
+var _TCIF_names = enum.Flags[TCIF]{
+	Type: "TCIF",
+	Values: []enum.Name[TCIF]{
+		{Val: TCIF_TEXT, Name: "TCIF_TEXT"},
+		{Val: TCIF_IMAGE, Name: "TCIF_IMAGE"},
+		{Val: TCIF_RTLREADING, Name: "TCIF_RTLREADING"},
+		{Val: TCIF_PARAM, Name: "TCIF_PARAM"},
+		{Val: TCIF_STATE, Name: "TCIF_STATE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TCIF_TEXT|TCIF_IMAGE".
+func (v TCIF) String() string {
+	return _TCIF_names.String(v)
+}
+
+// Parses names of [TCIF] flags joined by "|", or numbers.
+func ParseTCIF(s string) (TCIF, error) {
+	return _TCIF_names.Parse(s)
+}
+
+var _TCIS_names = enum.Flags[TCIS]{
+	Type: "TCIS",
+	Values: []enum.Name[TCIS]{
+		{Val: TCIS_BUTTONPRESSED, Name: "TCIS_BUTTONPRESSED"},
+		{Val: TCIS_HIGHLIGHTED, Name: "TCIS_HIGHLIGHTED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TCIS_BUTTONPRESSED|TCIS_HIGHLIGHTED".
+func (v TCIS) String() string {
+	return _TCIS_names.String(v)
+}
+
+// Parses names of [TCIS] flags joined by "|", or numbers.
+func ParseTCIS(s string) (TCIS, error) {
+	return _TCIS_names.Parse(s)
+}
+
+var _TCS_names = enum.Flags[TCS]{
+	Type: "TCS",
+	Values: []enum.Name[TCS]{
+		{Val: TCS_SCROLLOPPOSITE, Name: "TCS_SCROLLOPPOSITE"},
+		{Val: TCS_BOTTOM, Name: "TCS_BOTTOM"},
+		{Val: TCS_RIGHT, Name: "TCS_RIGHT"},
+		{Val: TCS_MULTISELECT, Name: "TCS_MULTISELECT"},
+		{Val: TCS_FLATBUTTONS, Name: "TCS_FLATBUTTONS"},
+		{Val: TCS_FORCEICONLEFT, Name: "TCS_FORCEICONLEFT"},
+		{Val: TCS_FORCELABELLEFT, Name: "TCS_FORCELABELLEFT"},
+		{Val: TCS_HOTTRACK, Name: "TCS_HOTTRACK"},
+		{Val: TCS_VERTICAL, Name: "TCS_VERTICAL"},
+		{Val: TCS_BUTTONS, Name: "TCS_BUTTONS"},
+		{Val: TCS_MULTILINE, Name: "TCS_MULTILINE"},
+		{Val: TCS_FIXEDWIDTH, Name: "TCS_FIXEDWIDTH"},
+		{Val: TCS_RAGGEDRIGHT, Name: "TCS_RAGGEDRIGHT"},
+		{Val: TCS_FOCUSONBUTTONDOWN, Name: "TCS_FOCUSONBUTTONDOWN"},
+		{Val: TCS_OWNERDRAWFIXED, Name: "TCS_OWNERDRAWFIXED"},
+		{Val: TCS_TOOLTIPS, Name: "TCS_TOOLTIPS"},
+		{Val: TCS_FOCUSNEVER, Name: "TCS_FOCUSNEVER"},
+		{Val: TCS_TABS, Name: "TCS_TABS"},
+		{Val: TCS_SINGLELINE, Name: "TCS_SINGLELINE"},
+		{Val: TCS_RIGHTJUSTIFY, Name: "TCS_RIGHTJUSTIFY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TCS_SCROLLOPPOSITE|TCS_BOTTOM".
+func (v TCS) String() string {
+	return _TCS_names.String(v)
+}
+
+// Parses names of [TCS] flags joined by "|", or numbers.
+func ParseTCS(s string) (TCS, error) {
+	return _TCS_names.Parse(s)
+}
+
+var _TCS_EX_names = enum.Flags[TCS_EX]{
+	Type: "TCS_EX",
+	Values: []enum.Name[TCS_EX]{
+		{Val: TCS_EX_FLATSEPARATORS, Name: "TCS_EX_FLATSEPARATORS"},
+		{Val: TCS_EX_REGISTERDROP, Name: "TCS_EX_REGISTERDROP"},
+		{Val: TCS_EX_NONE, Name: "TCS_EX_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TCS_EX_FLATSEPARATORS|TCS_EX_REGISTERDROP".
+func (v TCS_EX) String() string {
+	return _TCS_EX_names.String(v)
+}
+
+// Parses names of [TCS_EX] flags joined by "|", or numbers.
+func ParseTCS_EX(s string) (TCS_EX, error) {
+	return _TCS_EX_names.Parse(s)
+}
+
+var _TDCBF_names = enum.Flags[TDCBF]{
+	Type: "TDCBF",
+	Values: []enum.Name[TDCBF]{
+		{Val: TDCBF_OK, Name: "TDCBF_OK"},
+		{Val: TDCBF_YES, Name: "TDCBF_YES"},
+		{Val: TDCBF_NO, Name: "TDCBF_NO"},
+		{Val: TDCBF_CANCEL, Name: "TDCBF_CANCEL"},
+		{Val: TDCBF_RETRY, Name: "TDCBF_RETRY"},
+		{Val: TDCBF_CLOSE, Name: "TDCBF_CLOSE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TDCBF_OK|TDCBF_YES".
+func (v TDCBF) String() string {
+	return _TDCBF_names.String(v)
+}
+
+// Parses names of [TDCBF] flags joined by "|", or numbers.
+func ParseTDCBF(s string) (TDCBF, error) {
+	return _TDCBF_names.Parse(s)
+}
+
+var _TDICON_names = enum.Enum[TDICON]{
+	Type: "TDICON",
+	Values: []enum.Name[TDICON]{
+		{Val: TDICON_WARNING, Name: "TDICON_WARNING"},
+		{Val: TDICON_ERROR, Name: "TDICON_ERROR"},
+		{Val: TDICON_INFORMATION, Name: "TDICON_INFORMATION"},
+		{Val: TDICON_SHIELD, Name: "TDICON_SHIELD"},
+	},
+}
+
+// Returns the name of the constant, like "TDICON_WARNING".
+func (v TDICON) String() string {
+	return _TDICON_names.String(v)
+}
+
+// Parses the name of a [TDICON] constant, or a number.
+func ParseTDICON(s string) (TDICON, error) {
+	return _TDICON_names.Parse(s)
+}
+
+var _TDF_names = enum.Flags[TDF]{
+	Type: "TDF",
+	Values: []enum.Name[TDF]{
+		{Val: TDF_ENABLE_HYPERLINKS, Name: "TDF_ENABLE_HYPERLINKS"},
+		{Val: TDF_USE_HICON_MAIN, Name: "TDF_USE_HICON_MAIN"},
+		{Val: TDF_USE_HICON_FOOTER, Name: "TDF_USE_HICON_FOOTER"},
+		{Val: TDF_ALLOW_DIALOG_CANCELLATION, Name: "TDF_ALLOW_DIALOG_CANCELLATION"},
+		{Val: TDF_USE_COMMAND_LINKS, Name: "TDF_USE_COMMAND_LINKS"},
+		{Val: TDF_USE_COMMAND_LINKS_NO_ICON, Name: "TDF_USE_COMMAND_LINKS_NO_ICON"},
+		{Val: TDF_EXPAND_FOOTER_AREA, Name: "TDF_EXPAND_FOOTER_AREA"},
+		{Val: TDF_EXPANDED_BY_DEFAULT, Name: "TDF_EXPANDED_BY_DEFAULT"},
+		{Val: TDF_VERIFICATION_FLAG_CHECKED, Name: "TDF_VERIFICATION_FLAG_CHECKED"},
+		{Val: TDF_SHOW_PROGRESS_BAR, Name: "TDF_SHOW_PROGRESS_BAR"},
+		{Val: TDF_SHOW_MARQUEE_PROGRESS_BAR, Name: "TDF_SHOW_MARQUEE_PROGRESS_BAR"},
+		{Val: TDF_CALLBACK_TIMER, Name: "TDF_CALLBACK_TIMER"},
+		{Val: TDF_POSITION_RELATIVE_TO_WINDOW, Name: "TDF_POSITION_RELATIVE_TO_WINDOW"},
+		{Val: TDF_RTL_LAYOUT, Name: "TDF_RTL_LAYOUT"},
+		{Val: TDF_NO_DEFAULT_RADIO_BUTTON, Name: "TDF_NO_DEFAULT_RADIO_BUTTON"},
+		{Val: TDF_CAN_BE_MINIMIZED, Name: "TDF_CAN_BE_MINIMIZED"},
+		{Val: TDF_NO_SET_FOREGROUND, Name: "TDF_NO_SET_FOREGROUND"},
+		{Val: TDF_SIZE_TO_CONTENT, Name: "TDF_SIZE_TO_CONTENT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TDF_ENABLE_HYPERLINKS|TDF_USE_HICON_MAIN".
+func (v TDF) String() string {
+	return _TDF_names.String(v)
+}
+
+// Parses names of [TDF] flags joined by "|", or numbers.
+func ParseTDF(s string) (TDF, error) {
+	return _TDF_names.Parse(s)
+}
+
+var _TTI_names = enum.Enum[TTI]{
+	Type: "TTI",
+	Values: []enum.Name[TTI]{
+		{Val: TTI_ERROR, Name: "TTI_ERROR"},
+		{Val: TTI_INFO, Name: "TTI_INFO"},
+		{Val: TTI_NONE, Name: "TTI_NONE"},
+		{Val: TTI_WARNING, Name: "TTI_WARNING"},
+		{Val: TTI_INFO_LARGE, Name: "TTI_INFO_LARGE"},
+		{Val: TTI_WARNING_LARGE, Name: "TTI_WARNING_LARGE"},
+		{Val: TTI_ERROR_LARGE, Name: "TTI_ERROR_LARGE"},
+	},
+}
+
+// Returns the name of the constant, like "TTI_ERROR".
+func (v TTI) String() string {
+	return _TTI_names.String(v)
+}
+
+// Parses the name of a [TTI] constant, or a number.
+func ParseTTI(s string) (TTI, error) {
+	return _TTI_names.Parse(s)
+}
+
+var _TVE_names = enum.Flags[TVE]{
+	Type: "TVE",
+	Values: []enum.Name[TVE]{
+		{Val: TVE_TOGGLE, Name: "TVE_TOGGLE"},
+		{Val: TVE_COLLAPSE, Name: "TVE_COLLAPSE"},
+		{Val: TVE_EXPAND, Name: "TVE_EXPAND"},
+		{Val: TVE_EXPANDPARTIAL, Name: "TVE_EXPANDPARTIAL"},
+		{Val: TVE_COLLAPSERESET, Name: "TVE_COLLAPSERESET"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TVE_COLLAPSE|TVE_EXPAND".
+func (v TVE) String() string {
+	return _TVE_names.String(v)
+}
+
+// Parses names of [TVE] flags joined by "|", or numbers.
+func ParseTVE(s string) (TVE, error) {
+	return _TVE_names.Parse(s)
+}
+
+var _TVGN_names = enum.Enum[TVGN]{
+	Type: "TVGN",
+	Values: []enum.Name[TVGN]{
+		{Val: TVGN_ROOT, Name: "TVGN_ROOT"},
+		{Val: TVGN_NEXT, Name: "TVGN_NEXT"},
+		{Val: TVGN_PREVIOUS, Name: "TVGN_PREVIOUS"},
+		{Val: TVGN_PARENT, Name: "TVGN_PARENT"},
+		{Val: TVGN_CHILD, Name: "TVGN_CHILD"},
+		{Val: TVGN_FIRSTVISIBLE, Name: "TVGN_FIRSTVISIBLE"},
+		{Val: TVGN_NEXTVISIBLE, Name: "TVGN_NEXTVISIBLE"},
+		{Val: TVGN_PREVIOUSVISIBLE, Name: "TVGN_PREVIOUSVISIBLE"},
+		{Val: TVGN_DROPHILITE, Name: "TVGN_DROPHILITE"},
+		{Val: TVGN_CARET, Name: "TVGN_CARET"},
+		{Val: TVGN_LASTVISIBLE, Name: "TVGN_LASTVISIBLE"},
+		{Val: TVGN_NEXTSELECTED, Name: "TVGN_NEXTSELECTED"},
+	},
+}
+
+// Returns the name of the constant, like "TVGN_ROOT".
+func (v TVGN) String() string {
+	return _TVGN_names.String(v)
+}
+
+// Parses the name of a [TVGN] constant, or a number.
+func ParseTVGN(s string) (TVGN, error) {
+	return _TVGN_names.Parse(s)
+}
+
+var _TVI_CHILDREN_names = enum.Enum[TVI_CHILDREN]{
+	Type: "TVI_CHILDREN",
+	Values: []enum.Name[TVI_CHILDREN]{
+		{Val: TVI_CHILDREN_ZERO, Name: "TVI_CHILDREN_ZERO"},
+		{Val: TVI_CHILDREN_ONE, Name: "TVI_CHILDREN_ONE"},
+		{Val: TVI_CHILDREN_CALLBACK, Name: "TVI_CHILDREN_CALLBACK"},
+		{Val: TVI_CHILDREN_AUTO, Name: "TVI_CHILDREN_AUTO"},
+	},
+}
+
+// Returns the name of the constant, like "TVI_CHILDREN_ZERO".
+func (v TVI_CHILDREN) String() string {
+	return _TVI_CHILDREN_names.String(v)
+}
+
+// Parses the name of a [TVI_CHILDREN] constant, or a number.
+func ParseTVI_CHILDREN(s string) (TVI_CHILDREN, error) {
+	return _TVI_CHILDREN_names.Parse(s)
+}
+
+var _TVIF_names = enum.Flags[TVIF]{
+	Type: "TVIF",
+	Values: []enum.Name[TVIF]{
+		{Val: TVIF_TEXT, Name: "TVIF_TEXT"},
+		{Val: TVIF_IMAGE, Name: "TVIF_IMAGE"},
+		{Val: TVIF_PARAM, Name: "TVIF_PARAM"},
+		{Val: TVIF_STATE, Name: "TVIF_STATE"},
+		{Val: TVIF_HANDLE, Name: "TVIF_HANDLE"},
+		{Val: TVIF_SELECTEDIMAGE, Name: "TVIF_SELECTEDIMAGE"},
+		{Val: TVIF_CHILDREN, Name: "TVIF_CHILDREN"},
+		{Val: TVIF_INTEGRAL, Name: "TVIF_INTEGRAL"},
+		{Val: TVIF_STATEEX, Name: "TVIF_STATEEX"},
+		{Val: TVIF_EXPANDEDIMAGE, Name: "TVIF_EXPANDEDIMAGE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TVIF_TEXT|TVIF_IMAGE".
+func (v TVIF) String() string {
+	return _TVIF_names.String(v)
+}
+
+// Parses names of [TVIF] flags joined by "|", or numbers.
+func ParseTVIF(s string) (TVIF, error) {
+	return _TVIF_names.Parse(s)
+}
+
+var _TVIS_names = enum.Flags[TVIS]{
+	Type: "TVIS",
+	Values: []enum.Name[TVIS]{
+		{Val: TVIS_SELECTED, Name: "TVIS_SELECTED"},
+		{Val: TVIS_CUT, Name: "TVIS_CUT"},
+		{Val: TVIS_DROPHILITED, Name: "TVIS_DROPHILITED"},
+		{Val: TVIS_BOLD, Name: "TVIS_BOLD"},
+		{Val: TVIS_EXPANDED, Name: "TVIS_EXPANDED"},
+		{Val: TVIS_EXPANDEDONCE, Name: "TVIS_EXPANDEDONCE"},
+		{Val: TVIS_EXPANDPARTIAL, Name: "TVIS_EXPANDPARTIAL"},
+	},
+	ParseOnly: []enum.Name[TVIS]{
+		{Val: TVIS_OVERLAYMASK, Name: "TVIS_OVERLAYMASK"},
+		{Val: TVIS_STATEIMAGEMASK, Name: "TVIS_STATEIMAGEMASK"},
+		{Val: TVIS_USERMASK, Name: "TVIS_USERMASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TVIS_SELECTED|TVIS_CUT".
+func (v TVIS) String() string {
+	return _TVIS_names.String(v)
+}
+
+// Parses names of [TVIS] flags joined by "|", or numbers.
+func ParseTVIS(s string) (TVIS, error) {
+	return _TVIS_names.Parse(s)
+}
+
+var _TVIS_EX_names = enum.Flags[TVIS_EX]{
+	Type: "TVIS_EX",
+	Values: []enum.Name[TVIS_EX]{
+		{Val: TVIS_EX_FLAT, Name: "TVIS_EX_FLAT"},
+		{Val: TVIS_EX_DISABLED, Name: "TVIS_EX_DISABLED"},
+		{Val: TVIS_EX_ALL, Name: "TVIS_EX_ALL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TVIS_EX_FLAT|TVIS_EX_DISABLED".
+func (v TVIS_EX) String() string {
+	return _TVIS_EX_names.String(v)
+}
+
+// Parses names of [TVIS_EX] flags joined by "|", or numbers.
+func ParseTVIS_EX(s string) (TVIS_EX, error) {
+	return _TVIS_EX_names.Parse(s)
+}
+
+var _TVNRET_names = enum.Enum[TVNRET]{
+	Type: "TVNRET",
+	Values: []enum.Name[TVNRET]{
+		{Val: TVNRET_DEFAULT, Name: "TVNRET_DEFAULT"},
+		{Val: TVNRET_SKIPOLD, Name: "TVNRET_SKIPOLD"},
+		{Val: TVNRET_SKIPNEW, Name: "TVNRET_SKIPNEW"},
+	},
+}
+
+// Returns the name of the constant, like "TVNRET_DEFAULT".
+func (v TVNRET) String() string {
+	return _TVNRET_names.String(v)
+}
+
+// Parses the name of a [TVNRET] constant, or a number.
+func ParseTVNRET(s string) (TVNRET, error) {
+	return _TVNRET_names.Parse(s)
+}
+
+var _TVS_names = enum.Flags[TVS]{
+	Type: "TVS",
+	Values: []enum.Name[TVS]{
+		{Val: TVS_HASBUTTONS, Name: "TVS_HASBUTTONS"},
+		{Val: TVS_HASLINES, Name: "TVS_HASLINES"},
+		{Val: TVS_LINESATROOT, Name: "TVS_LINESATROOT"},
+		{Val: TVS_EDITLABELS, Name: "TVS_EDITLABELS"},
+		{Val: TVS_DISABLEDRAGDROP, Name: "TVS_DISABLEDRAGDROP"},
+		{Val: TVS_SHOWSELALWAYS, Name: "TVS_SHOWSELALWAYS"},
+		{Val: TVS_RTLREADING, Name: "TVS_RTLREADING"},
+		{Val: TVS_NOTOOLTIPS, Name: "TVS_NOTOOLTIPS"},
+		{Val: TVS_CHECKBOXES, Name: "TVS_CHECKBOXES"},
+		{Val: TVS_TRACKSELECT, Name: "TVS_TRACKSELECT"},
+		{Val: TVS_SINGLEEXPAND, Name: "TVS_SINGLEEXPAND"},
+		{Val: TVS_INFOTIP, Name: "TVS_INFOTIP"},
+		{Val: TVS_FULLROWSELECT, Name: "TVS_FULLROWSELECT"},
+		{Val: TVS_NOSCROLL, Name: "TVS_NOSCROLL"},
+		{Val: TVS_NONEVENHEIGHT, Name: "TVS_NONEVENHEIGHT"},
+		{Val: TVS_NOHSCROLL, Name: "TVS_NOHSCROLL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TVS_HASBUTTONS|TVS_HASLINES".
+func (v TVS) String() string {
+	return _TVS_names.String(v)
+}
+
+// Parses names of [TVS] flags joined by "|", or numbers.
+func ParseTVS(s string) (TVS, error) {
+	return _TVS_names.Parse(s)
+}
+
+var _TVS_EX_names = enum.Flags[TVS_EX]{
+	Type: "TVS_EX",
+	Values: []enum.Name[TVS_EX]{
+		{Val: TVS_EX_NOSINGLECOLLAPSE, Name: "TVS_EX_NOSINGLECOLLAPSE"},
+		{Val: TVS_EX_MULTISELECT, Name: "TVS_EX_MULTISELECT"},
+		{Val: TVS_EX_DOUBLEBUFFER, Name: "TVS_EX_DOUBLEBUFFER"},
+		{Val: TVS_EX_NOINDENTSTATE, Name: "TVS_EX_NOINDENTSTATE"},
+		{Val: TVS_EX_RICHTOOLTIP, Name: "TVS_EX_RICHTOOLTIP"},
+		{Val: TVS_EX_AUTOHSCROLL, Name: "TVS_EX_AUTOHSCROLL"},
+		{Val: TVS_EX_FADEINOUTEXPANDOS, Name: "TVS_EX_FADEINOUTEXPANDOS"},
+		{Val: TVS_EX_PARTIALCHECKBOXES, Name: "TVS_EX_PARTIALCHECKBOXES"},
+		{Val: TVS_EX_EXCLUSIONCHECKBOXES, Name: "TVS_EX_EXCLUSIONCHECKBOXES"},
+		{Val: TVS_EX_DIMMEDCHECKBOXES, Name: "TVS_EX_DIMMEDCHECKBOXES"},
+		{Val: TVS_EX_DRAWIMAGEASYNC, Name: "TVS_EX_DRAWIMAGEASYNC"},
+		{Val: TVS_EX_NONE, Name: "TVS_EX_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TVS_EX_NOSINGLECOLLAPSE|TVS_EX_MULTISELECT".
+func (v TVS_EX) String() string {
+	return _TVS_EX_names.String(v)
+}
+
+// Parses names of [TVS_EX] flags joined by "|", or numbers.
+func ParseTVS_EX(s string) (TVS_EX, error) {
+	return _TVS_EX_names.Parse(s)
+}
+
+var _TVSIL_names = enum.Enum[TVSIL]{
+	Type: "TVSIL",
+	Values: []enum.Name[TVSIL]{
+		{Val: TVSIL_NORMAL, Name: "TVSIL_NORMAL"},
+		{Val: TVSIL_STATE, Name: "TVSIL_STATE"},
+	},
+}
+
+// Returns the name of the constant, like "TVSIL_NORMAL".
+func (v TVSIL) String() string {
+	return _TVSIL_names.String(v)
+}
+
+// Parses the name of a [TVSIL] constant, or a number.
+func ParseTVSIL(s string) (TVSIL, error) {
+	return _TVSIL_names.Parse(s)
+}
+
+var _UDS_names = enum.Flags[UDS]{
+	Type: "UDS",
+	Values: []enum.Name[UDS]{
+		{Val: UDS_RAP, Name: "UDS_RAP"},
+		{Val: UDS_SETBUDDYINT, Name: "UDS_SETBUDDYINT"},
+		{Val: UDS_ALIGNRIGHT, Name: "UDS_ALIGNRIGHT"},
+		{Val: UDS_ALIGNLEFT, Name: "UDS_ALIGNLEFT"},
+		{Val: UDS_AUTOBUDDY, Name: "UDS_AUTOBUDDY"},
+		{Val: UDS_ARROWKEYS, Name: "UDS_ARROWKEYS"},
+		{Val: UDS_HORZ, Name: "UDS_HORZ"},
+		{Val: UDS_NOTHOUSANDS, Name: "UDS_NOTHOUSANDS"},
+		{Val: UDS_HOTTRACK, Name: "UDS_HOTTRACK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "UDS_RAP|UDS_SETBUDDYINT".
+func (v UDS) String() string {
+	return _UDS_names.String(v)
+}
+
+// Parses names of [UDS] flags joined by "|", or numbers.
+func ParseUDS(s string) (UDS, error) {
+	return _UDS_names.Parse(s)
+}
+
+var _VS_names = enum.Enum[VS]{
+	Type: "VS",
+	Values: []enum.Name[VS]{
+		{Val: VS_AEROWIZARD_TITLEBAR_S_TITLEBAR_ACTIVE, Name: "VS_AEROWIZARD_TITLEBAR_S_TITLEBAR_ACTIVE"},
+		{Val: VS_AEROWIZARD_TITLEBAR_S_TITLEBAR_INACTIVE, Name: "VS_AEROWIZARD_TITLEBAR_S_TITLEBAR_INACTIVE"},
+		{Val: VS_AEROWIZARD_HEADERAREA_S_HEADERAREA_NOMARGIN, Name: "VS_AEROWIZARD_HEADERAREA_S_HEADERAREA_NOMARGIN"},
+		{Val: VS_AEROWIZARD_CONTENTAREA_S_CONTENTAREA_NOMARGIN, Name: "VS_AEROWIZARD_CONTENTAREA_S_CONTENTAREA_NOMARGIN"},
+		{Val: VS_AEROWIZARD_COMMANDAREA, Name: "VS_AEROWIZARD_COMMANDAREA"},
+		{Val: VS_AEROWIZARD_BUTTON, Name: "VS_AEROWIZARD_BUTTON"},
+		{Val: VS_BUTTON_PUSHBUTTON_NORMAL, Name: "VS_BUTTON_PUSHBUTTON_NORMAL"},
+		{Val: VS_BUTTON_PUSHBUTTON_HOT, Name: "VS_BUTTON_PUSHBUTTON_HOT"},
+		{Val: VS_BUTTON_PUSHBUTTON_PRESSED, Name: "VS_BUTTON_PUSHBUTTON_PRESSED"},
+		{Val: VS_BUTTON_PUSHBUTTON_DISABLED, Name: "VS_BUTTON_PUSHBUTTON_DISABLED"},
+		{Val: VS_BUTTON_PUSHBUTTON_DEFAULTED, Name: "VS_BUTTON_PUSHBUTTON_DEFAULTED"},
+		{Val: VS_BUTTON_PUSHBUTTON_DEFAULTED_ANIMATING, Name: "VS_BUTTON_PUSHBUTTON_DEFAULTED_ANIMATING"},
+		{Val: VS_BUTTON_RADIOBUTTON_UNCHECKEDNORMAL, Name: "VS_BUTTON_RADIOBUTTON_UNCHECKEDNORMAL"},
+		{Val: VS_BUTTON_RADIOBUTTON_UNCHECKEDHOT, Name: "VS_BUTTON_RADIOBUTTON_UNCHECKEDHOT"},
+		{Val: VS_BUTTON_RADIOBUTTON_UNCHECKEDPRESSED, Name: "VS_BUTTON_RADIOBUTTON_UNCHECKEDPRESSED"},
+		{Val: VS_BUTTON_RADIOBUTTON_UNCHECKEDDISABLED, Name: "VS_BUTTON_RADIOBUTTON_UNCHECKEDDISABLED"},
+		{Val: VS_BUTTON_RADIOBUTTON_CHECKEDNORMAL, Name: "VS_BUTTON_RADIOBUTTON_CHECKEDNORMAL"},
+		{Val: VS_BUTTON_RADIOBUTTON_CHECKEDHOT, Name: "VS_BUTTON_RADIOBUTTON_CHECKEDHOT"},
+		{Val: VS_BUTTON_RADIOBUTTON_CHECKEDPRESSED, Name: "VS_BUTTON_RADIOBUTTON_CHECKEDPRESSED"},
+		{Val: VS_BUTTON_RADIOBUTTON_CHECKEDDISABLED, Name: "VS_BUTTON_RADIOBUTTON_CHECKEDDISABLED"},
+		{Val: VS_BUTTON_CHECKBOX_UNCHECKEDNORMAL, Name: "VS_BUTTON_CHECKBOX_UNCHECKEDNORMAL"},
+		{Val: VS_BUTTON_CHECKBOX_UNCHECKEDHOT, Name: "VS_BUTTON_CHECKBOX_UNCHECKEDHOT"},
+		{Val: VS_BUTTON_CHECKBOX_UNCHECKEDPRESSED, Name: "VS_BUTTON_CHECKBOX_UNCHECKEDPRESSED"},
+		{Val: VS_BUTTON_CHECKBOX_UNCHECKEDDISABLED, Name: "VS_BUTTON_CHECKBOX_UNCHECKEDDISABLED"},
+		{Val: VS_BUTTON_CHECKBOX_CHECKEDNORMAL, Name: "VS_BUTTON_CHECKBOX_CHECKEDNORMAL"},
+		{Val: VS_BUTTON_CHECKBOX_CHECKEDHOT, Name: "VS_BUTTON_CHECKBOX_CHECKEDHOT"},
+		{Val: VS_BUTTON_CHECKBOX_CHECKEDPRESSED, Name: "VS_BUTTON_CHECKBOX_CHECKEDPRESSED"},
+		{Val: VS_BUTTON_CHECKBOX_CHECKEDDISABLED, Name: "VS_BUTTON_CHECKBOX_CHECKEDDISABLED"},
+		{Val: VS_BUTTON_CHECKBOX_MIXEDNORMAL, Name: "VS_BUTTON_CHECKBOX_MIXEDNORMAL"},
+		{Val: VS_BUTTON_CHECKBOX_MIXEDHOT, Name: "VS_BUTTON_CHECKBOX_MIXEDHOT"},
+		{Val: VS_BUTTON_CHECKBOX_MIXEDPRESSED, Name: "VS_BUTTON_CHECKBOX_MIXEDPRESSED"},
+		{Val: VS_BUTTON_CHECKBOX_MIXEDDISABLED, Name: "VS_BUTTON_CHECKBOX_MIXEDDISABLED"},
+		{Val: VS_BUTTON_CHECKBOX_IMPLICITNORMAL, Name: "VS_BUTTON_CHECKBOX_IMPLICITNORMAL"},
+		{Val: VS_BUTTON_CHECKBOX_IMPLICITHOT, Name: "VS_BUTTON_CHECKBOX_IMPLICITHOT"},
+		{Val: VS_BUTTON_CHECKBOX_IMPLICITPRESSED, Name: "VS_BUTTON_CHECKBOX_IMPLICITPRESSED"},
+		{Val: VS_BUTTON_CHECKBOX_IMPLICITDISABLED, Name: "VS_BUTTON_CHECKBOX_IMPLICITDISABLED"},
+		{Val: VS_BUTTON_CHECKBOX_EXCLUDEDNORMAL, Name: "VS_BUTTON_CHECKBOX_EXCLUDEDNORMAL"},
+		{Val: VS_BUTTON_CHECKBOX_EXCLUDEDHOT, Name: "VS_BUTTON_CHECKBOX_EXCLUDEDHOT"},
+		{Val: VS_BUTTON_CHECKBOX_EXCLUDEDPRESSED, Name: "VS_BUTTON_CHECKBOX_EXCLUDEDPRESSED"},
+		{Val: VS_BUTTON_CHECKBOX_EXCLUDEDDISABLED, Name: "VS_BUTTON_CHECKBOX_EXCLUDEDDISABLED"},
+		{Val: VS_BUTTON_GROUPBOX_NORMAL, Name: "VS_BUTTON_GROUPBOX_NORMAL"},
+		{Val: VS_BUTTON_GROUPBOX_DISABLED, Name: "VS_BUTTON_GROUPBOX_DISABLED"},
+		{Val: VS_BUTTON_USERBUTTON, Name: "VS_BUTTON_USERBUTTON"},
+		{Val: VS_BUTTON_COMMANDLINK_NORMAL, Name: "VS_BUTTON_COMMANDLINK_NORMAL"},
+		{Val: VS_BUTTON_COMMANDLINK_HOT, Name: "VS_BUTTON_COMMANDLINK_HOT"},
+		{Val: VS_BUTTON_COMMANDLINK_PRESSED, Name: "VS_BUTTON_COMMANDLINK_PRESSED"},
+		{Val: VS_BUTTON_COMMANDLINK_DISABLED, Name: "VS_BUTTON_COMMANDLINK_DISABLED"},
+		{Val: VS_BUTTON_COMMANDLINK_DEFAULTED, Name: "VS_BUTTON_COMMANDLINK_DEFAULTED"},
+		{Val: VS_BUTTON_COMMANDLINK_DEFAULTED_ANIMATING, Name: "VS_BUTTON_COMMANDLINK_DEFAULTED_ANIMATING"},
+		{Val: VS_BUTTON_COMMANDLINKGLYPH_NORMAL, Name: "VS_BUTTON_COMMANDLINKGLYPH_NORMAL"},
+		{Val: VS_BUTTON_COMMANDLINKGLYPH_HOT, Name: "VS_BUTTON_COMMANDLINKGLYPH_HOT"},
+		{Val: VS_BUTTON_COMMANDLINKGLYPH_PRESSED, Name: "VS_BUTTON_COMMANDLINKGLYPH_PRESSED"},
+		{Val: VS_BUTTON_COMMANDLINKGLYPH_DISABLED, Name: "VS_BUTTON_COMMANDLINKGLYPH_DISABLED"},
+		{Val: VS_BUTTON_COMMANDLINKGLYPH_DEFAULTED, Name: "VS_BUTTON_COMMANDLINKGLYPH_DEFAULTED"},
+		{Val: VS_BUTTON_RADIOBUTTON_HCDISABLED, Name: "VS_BUTTON_RADIOBUTTON_HCDISABLED"},
+		{Val: VS_BUTTON_CHECKBOX_HCDISABLED, Name: "VS_BUTTON_CHECKBOX_HCDISABLED"},
+		{Val: VS_BUTTON_GROUPBOX_HCDISABLED, Name: "VS_BUTTON_GROUPBOX_HCDISABLED"},
+		{Val: VS_BUTTON_PUSHBUTTONDROPDOWN_NORMAL, Name: "VS_BUTTON_PUSHBUTTONDROPDOWN_NORMAL"},
+		{Val: VS_BUTTON_PUSHBUTTONDROPDOWN_DISABLED, Name: "VS_BUTTON_PUSHBUTTONDROPDOWN_DISABLED"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTON_NORMAL, Name: "VS_COMBOBOX_DROPDOWNBUTTON_NORMAL"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTON_HOT, Name: "VS_COMBOBOX_DROPDOWNBUTTON_HOT"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTON_PRESSED, Name: "VS_COMBOBOX_DROPDOWNBUTTON_PRESSED"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTON_DISABLED, Name: "VS_COMBOBOX_DROPDOWNBUTTON_DISABLED"},
+		{Val: VS_COMBOBOX_BACKGROUND, Name: "VS_COMBOBOX_BACKGROUND"},
+		{Val: VS_COMBOBOX_TRANSPARENTBACKGROUND_NORMAL, Name: "VS_COMBOBOX_TRANSPARENTBACKGROUND_NORMAL"},
+		{Val: VS_COMBOBOX_TRANSPARENTBACKGROUND_HOT, Name: "VS_COMBOBOX_TRANSPARENTBACKGROUND_HOT"},
+		{Val: VS_COMBOBOX_TRANSPARENTBACKGROUND_DISABLED, Name: "VS_COMBOBOX_TRANSPARENTBACKGROUND_DISABLED"},
+		{Val: VS_COMBOBOX_TRANSPARENTBACKGROUND_FOCUSED, Name: "VS_COMBOBOX_TRANSPARENTBACKGROUND_FOCUSED"},
+		{Val: VS_COMBOBOX_BORDER_NORMAL, Name: "VS_COMBOBOX_BORDER_NORMAL"},
+		{Val: VS_COMBOBOX_BORDER_HOT, Name: "VS_COMBOBOX_BORDER_HOT"},
+		{Val: VS_COMBOBOX_BORDER_FOCUSED, Name: "VS_COMBOBOX_BORDER_FOCUSED"},
+		{Val: VS_COMBOBOX_BORDER_DISABLED, Name: "VS_COMBOBOX_BORDER_DISABLED"},
+		{Val: VS_COMBOBOX_READONLY_NORMAL, Name: "VS_COMBOBOX_READONLY_NORMAL"},
+		{Val: VS_COMBOBOX_READONLY_HOT, Name: "VS_COMBOBOX_READONLY_HOT"},
+		{Val: VS_COMBOBOX_READONLY_PRESSED, Name: "VS_COMBOBOX_READONLY_PRESSED"},
+		{Val: VS_COMBOBOX_READONLY_DISABLED, Name: "VS_COMBOBOX_READONLY_DISABLED"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONRIGHT_NORMAL, Name: "VS_COMBOBOX_DROPDOWNBUTTONRIGHT_NORMAL"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONRIGHT_HOT, Name: "VS_COMBOBOX_DROPDOWNBUTTONRIGHT_HOT"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONRIGHT_PRESSED, Name: "VS_COMBOBOX_DROPDOWNBUTTONRIGHT_PRESSED"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONRIGHT_DISABLED, Name: "VS_COMBOBOX_DROPDOWNBUTTONRIGHT_DISABLED"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONLEFT_NORMAL, Name: "VS_COMBOBOX_DROPDOWNBUTTONLEFT_NORMAL"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONLEFT_HOT, Name: "VS_COMBOBOX_DROPDOWNBUTTONLEFT_HOT"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONLEFT_PRESSED, Name: "VS_COMBOBOX_DROPDOWNBUTTONLEFT_PRESSED"},
+		{Val: VS_COMBOBOX_DROPDOWNBUTTONLEFT_DISABLED, Name: "VS_COMBOBOX_DROPDOWNBUTTONLEFT_DISABLED"},
+		{Val: VS_COMBOBOX_CUEBANNER_NORMAL, Name: "VS_COMBOBOX_CUEBANNER_NORMAL"},
+		{Val: VS_COMBOBOX_CUEBANNER_HOT, Name: "VS_COMBOBOX_CUEBANNER_HOT"},
+		{Val: VS_COMBOBOX_CUEBANNER_PRESSED, Name: "VS_COMBOBOX_CUEBANNER_PRESSED"},
+		{Val: VS_COMBOBOX_CUEBANNER_DISABLED, Name: "VS_COMBOBOX_CUEBANNER_DISABLED"},
+		{Val: VS_COMBOBOX_DROPDOWNITEM_NORMAL, Name: "VS_COMBOBOX_DROPDOWNITEM_NORMAL"},
+		{Val: VS_COMBOBOX_DROPDOWNITEM_HIGHLIGHTED, Name: "VS_COMBOBOX_DROPDOWNITEM_HIGHLIGHTED"},
+		{Val: VS_COMMUNICATIONS_TAB_NORMAL, Name: "VS_COMMUNICATIONS_TAB_NORMAL"},
+		{Val: VS_COMMUNICATIONS_TAB_HOT, Name: "VS_COMMUNICATIONS_TAB_HOT"},
+		{Val: VS_COMMUNICATIONS_TAB_SELECTED, Name: "VS_COMMUNICATIONS_TAB_SELECTED"},
+		{Val: VS_CONTROLPANEL_NAVIGATIONPANE, Name: "VS_CONTROLPANEL_NAVIGATIONPANE"},
+		{Val: VS_CONTROLPANEL_CONTENTPANE, Name: "VS_CONTROLPANEL_CONTENTPANE"},
+		{Val: VS_CONTROLPANEL_NAVIGATIONPANELABEL, Name: "VS_CONTROLPANEL_NAVIGATIONPANELABEL"},
+		{Val: VS_CONTROLPANEL_CONTENTPANELABEL, Name: "VS_CONTROLPANEL_CONTENTPANELABEL"},
+		{Val: VS_CONTROLPANEL_TITLE, Name: "VS_CONTROLPANEL_TITLE"},
+		{Val: VS_CONTROLPANEL_BODYTEXT, Name: "VS_CONTROLPANEL_BODYTEXT"},
+		{Val: VS_CONTROLPANEL_HELPLINK_NORMAL, Name: "VS_CONTROLPANEL_HELPLINK_NORMAL"},
+		{Val: VS_CONTROLPANEL_HELPLINK_HOT, Name: "VS_CONTROLPANEL_HELPLINK_HOT"},
+		{Val: VS_CONTROLPANEL_HELPLINK_PRESSED, Name: "VS_CONTROLPANEL_HELPLINK_PRESSED"},
+		{Val: VS_CONTROLPANEL_HELPLINK_DISABLED, Name: "VS_CONTROLPANEL_HELPLINK_DISABLED"},
+		{Val: VS_CONTROLPANEL_TASKLINK_NORMAL, Name: "VS_CONTROLPANEL_TASKLINK_NORMAL"},
+		{Val: VS_CONTROLPANEL_TASKLINK_HOT, Name: "VS_CONTROLPANEL_TASKLINK_HOT"},
+		{Val: VS_CONTROLPANEL_TASKLINK_PRESSED, Name: "VS_CONTROLPANEL_TASKLINK_PRESSED"},
+		{Val: VS_CONTROLPANEL_TASKLINK_DISABLED, Name: "VS_CONTROLPANEL_TASKLINK_DISABLED"},
+		{Val: VS_CONTROLPANEL_TASKLINK_PAGE, Name: "VS_CONTROLPANEL_TASKLINK_PAGE"},
+		{Val: VS_CONTROLPANEL_GROUPTEXT, Name: "VS_CONTROLPANEL_GROUPTEXT"},
+		{Val: VS_CONTROLPANEL_CONTENTLINK_NORMAL, Name: "VS_CONTROLPANEL_CONTENTLINK_NORMAL"},
+		{Val: VS_CONTROLPANEL_CONTENTLINK_HOT, Name: "VS_CONTROLPANEL_CONTENTLINK_HOT"},
+		{Val: VS_CONTROLPANEL_CONTENTLINK_PRESSED, Name: "VS_CONTROLPANEL_CONTENTLINK_PRESSED"},
+		{Val: VS_CONTROLPANEL_CONTENTLINK_DISABLED, Name: "VS_CONTROLPANEL_CONTENTLINK_DISABLED"},
+		{Val: VS_CONTROLPANEL_SECTIONTITLELINK_NORMAL, Name: "VS_CONTROLPANEL_SECTIONTITLELINK_NORMAL"},
+		{Val: VS_CONTROLPANEL_SECTIONTITLELINK_HOT, Name: "VS_CONTROLPANEL_SECTIONTITLELINK_HOT"},
+		{Val: VS_CONTROLPANEL_LARGECOMMANDAREA, Name: "VS_CONTROLPANEL_LARGECOMMANDAREA"},
+		{Val: VS_CONTROLPANEL_SMALLCOMMANDAREA, Name: "VS_CONTROLPANEL_SMALLCOMMANDAREA"},
+		{Val: VS_CONTROLPANEL_BUTTON, Name: "VS_CONTROLPANEL_BUTTON"},
+		{Val: VS_CONTROLPANEL_MESSAGETEXT, Name: "VS_CONTROLPANEL_MESSAGETEXT"},
+		{Val: VS_CONTROLPANEL_NAVIGATIONPANELINE, Name: "VS_CONTROLPANEL_NAVIGATIONPANELINE"},
+		{Val: VS_CONTROLPANEL_CONTENTPANELINE, Name: "VS_CONTROLPANEL_CONTENTPANELINE"},
+		{Val: VS_CONTROLPANEL_BANNERAREA, Name: "VS_CONTROLPANEL_BANNERAREA"},
+		{Val: VS_CONTROLPANEL_BODYTITLE, Name: "VS_CONTROLPANEL_BODYTITLE"},
+		{Val: VS_DATEPICKER_DATETEXT_NORMAL, Name: "VS_DATEPICKER_DATETEXT_NORMAL"},
+		{Val: VS_DATEPICKER_DATETEXT_DISABLED, Name: "VS_DATEPICKER_DATETEXT_DISABLED"},
+		{Val: VS_DATEPICKER_DATETEXT_SELECTED, Name: "VS_DATEPICKER_DATETEXT_SELECTED"},
+		{Val: VS_DATEPICKER_DATEBORDER_NORMAL, Name: "VS_DATEPICKER_DATEBORDER_NORMAL"},
+		{Val: VS_DATEPICKER_DATEBORDER_HOT, Name: "VS_DATEPICKER_DATEBORDER_HOT"},
+		{Val: VS_DATEPICKER_DATEBORDER_FOCUSED, Name: "VS_DATEPICKER_DATEBORDER_FOCUSED"},
+		{Val: VS_DATEPICKER_DATEBORDER_DISABLED, Name: "VS_DATEPICKER_DATEBORDER_DISABLED"},
+		{Val: VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_NORMAL, Name: "VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_NORMAL"},
+		{Val: VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_HOT, Name: "VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_HOT"},
+		{Val: VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_PRESSED, Name: "VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_PRESSED"},
+		{Val: VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_DISABLED, Name: "VS_DATEPICKER_SHOWCALENDARBUTTONRIGHT_DISABLED"},
+		{Val: VS_DRAGDROP_COPY_HIGHLIGHT, Name: "VS_DRAGDROP_COPY_HIGHLIGHT"},
+		{Val: VS_DRAGDROP_COPY_NOHIGHLIGHT, Name: "VS_DRAGDROP_COPY_NOHIGHLIGHT"},
+		{Val: VS_DRAGDROP_MOVE_HIGHLIGHT, Name: "VS_DRAGDROP_MOVE_HIGHLIGHT"},
+		{Val: VS_DRAGDROP_MOVE_NOHIGHLIGHT, Name: "VS_DRAGDROP_MOVE_NOHIGHLIGHT"},
+		{Val: VS_DRAGDROP_UPDATEMETADATA_HIGHLIGHT, Name: "VS_DRAGDROP_UPDATEMETADATA_HIGHLIGHT"},
+		{Val: VS_DRAGDROP_UPDATEMETADATA_NOHIGHLIGHT, Name: "VS_DRAGDROP_UPDATEMETADATA_NOHIGHLIGHT"},
+		{Val: VS_DRAGDROP_CREATELINK_HIGHLIGHT, Name: "VS_DRAGDROP_CREATELINK_HIGHLIGHT"},
+		{Val: VS_DRAGDROP_CREATELINK_NOHIGHLIGHT, Name: "VS_DRAGDROP_CREATELINK_NOHIGHLIGHT"},
+		{Val: VS_DRAGDROP_WARNING_HIGHLIGHT, Name: "VS_DRAGDROP_WARNING_HIGHLIGHT"},
+		{Val: VS_DRAGDROP_WARNING_NOHIGHLIGHT, Name: "VS_DRAGDROP_WARNING_NOHIGHLIGHT"},
+		{Val: VS_DRAGDROP_NONE_HIGHLIGHT, Name: "VS_DRAGDROP_NONE_HIGHLIGHT"},
+		{Val: VS_DRAGDROP_NONE_NOHIGHLIGHT, Name: "VS_DRAGDROP_NONE_NOHIGHLIGHT"},
+		{Val: VS_DRAGDROP_IMAGEBG, Name: "VS_DRAGDROP_IMAGEBG"},
+		{Val: VS_DRAGDROP_TEXTBG, Name: "VS_DRAGDROP_TEXTBG"},
+		{Val: VS_EDIT_EDITTEXT_NORMAL, Name: "VS_EDIT_EDITTEXT_NORMAL"},
+		{Val: VS_EDIT_EDITTEXT_HOT, Name: "VS_EDIT_EDITTEXT_HOT"},
+		{Val: VS_EDIT_EDITTEXT_SELECTED, Name: "VS_EDIT_EDITTEXT_SELECTED"},
+		{Val: VS_EDIT_EDITTEXT_DISABLED, Name: "VS_EDIT_EDITTEXT_DISABLED"},
+		{Val: VS_EDIT_EDITTEXT_FOCUSED, Name: "VS_EDIT_EDITTEXT_FOCUSED"},
+		{Val: VS_EDIT_EDITTEXT_READONLY, Name: "VS_EDIT_EDITTEXT_READONLY"},
+		{Val: VS_EDIT_EDITTEXT_ASSIST, Name: "VS_EDIT_EDITTEXT_ASSIST"},
+		{Val: VS_EDIT_EDITTEXT_CUEBANNER, Name: "VS_EDIT_EDITTEXT_CUEBANNER"},
+		{Val: VS_EDIT_CARET, Name: "VS_EDIT_CARET"},
+		{Val: VS_EDIT_BACKGROUND_NORMAL, Name: "VS_EDIT_BACKGROUND_NORMAL"},
+		{Val: VS_EDIT_BACKGROUND_HOT, Name: "VS_EDIT_BACKGROUND_HOT"},
+		{Val: VS_EDIT_BACKGROUND_DISABLED, Name: "VS_EDIT_BACKGROUND_DISABLED"},
+		{Val: VS_EDIT_BACKGROUND_FOCUSED, Name: "VS_EDIT_BACKGROUND_FOCUSED"},
+		{Val: VS_EDIT_BACKGROUND_READONLY, Name: "VS_EDIT_BACKGROUND_READONLY"},
+		{Val: VS_EDIT_BACKGROUND_ASSIST, Name: "VS_EDIT_BACKGROUND_ASSIST"},
+		{Val: VS_EDIT_PASSWORD, Name: "VS_EDIT_PASSWORD"},
+		{Val: VS_EDIT_BACKGROUNDWITHBORDER_NORMAL, Name: "VS_EDIT_BACKGROUNDWITHBORDER_NORMAL"},
+		{Val: VS_EDIT_BACKGROUNDWITHBORDER_HOT, Name: "VS_EDIT_BACKGROUNDWITHBORDER_HOT"},
+		{Val: VS_EDIT_BACKGROUNDWITHBORDER_DISABLED, Name: "VS_EDIT_BACKGROUNDWITHBORDER_DISABLED"},
+		{Val: VS_EDIT_BACKGROUNDWITHBORDER_FOCUSED, Name: "VS_EDIT_BACKGROUNDWITHBORDER_FOCUSED"},
+		{Val: VS_EDIT_EDITBORDER_NOSCROLL_NORMAL, Name: "VS_EDIT_EDITBORDER_NOSCROLL_NORMAL"},
+		{Val: VS_EDIT_EDITBORDER_NOSCROLL_HOT, Name: "VS_EDIT_EDITBORDER_NOSCROLL_HOT"},
+		{Val: VS_EDIT_EDITBORDER_NOSCROLL_FOCUSED, Name: "VS_EDIT_EDITBORDER_NOSCROLL_FOCUSED"},
+		{Val: VS_EDIT_EDITBORDER_NOSCROLL_DISABLED, Name: "VS_EDIT_EDITBORDER_NOSCROLL_DISABLED"},
+		{Val: VS_EDIT_EDITBORDER_HSCROLL_NORMAL, Name: "VS_EDIT_EDITBORDER_HSCROLL_NORMAL"},
+		{Val: VS_EDIT_EDITBORDER_HSCROLL_HOT, Name: "VS_EDIT_EDITBORDER_HSCROLL_HOT"},
+		{Val: VS_EDIT_EDITBORDER_HSCROLL_FOCUSED, Name: "VS_EDIT_EDITBORDER_HSCROLL_FOCUSED"},
+		{Val: VS_EDIT_EDITBORDER_HSCROLL_DISABLED, Name: "VS_EDIT_EDITBORDER_HSCROLL_DISABLED"},
+		{Val: VS_EDIT_EDITBORDER_VSCROLL_NORMAL, Name: "VS_EDIT_EDITBORDER_VSCROLL_NORMAL"},
+		{Val: VS_EDIT_EDITBORDER_VSCROLL_HOT, Name: "VS_EDIT_EDITBORDER_VSCROLL_HOT"},
+		{Val: VS_EDIT_EDITBORDER_VSCROLL_FOCUSED, Name: "VS_EDIT_EDITBORDER_VSCROLL_FOCUSED"},
+		{Val: VS_EDIT_EDITBORDER_VSCROLL_DISABLED, Name: "VS_EDIT_EDITBORDER_VSCROLL_DISABLED"},
+		{Val: VS_EDIT_EDITBORDER_HVSCROLL_NORMAL, Name: "VS_EDIT_EDITBORDER_HVSCROLL_NORMAL"},
+		{Val: VS_EDIT_EDITBORDER_HVSCROLL_HOT, Name: "VS_EDIT_EDITBORDER_HVSCROLL_HOT"},
+		{Val: VS_EDIT_EDITBORDER_HVSCROLL_FOCUSED, Name: "VS_EDIT_EDITBORDER_HVSCROLL_FOCUSED"},
+		{Val: VS_EDIT_EDITBORDER_HVSCROLL_DISABLED, Name: "VS_EDIT_EDITBORDER_HVSCROLL_DISABLED"},
+		{Val: VS_EXPLORERBAR_HEADERBACKGROUND, Name: "VS_EXPLORERBAR_HEADERBACKGROUND"},
+		{Val: VS_EXPLORERBAR_HEADERCLOSE_NORMAL, Name: "VS_EXPLORERBAR_HEADERCLOSE_NORMAL"},
+		{Val: VS_EXPLORERBAR_HEADERCLOSE_HOT, Name: "VS_EXPLORERBAR_HEADERCLOSE_HOT"},
+		{Val: VS_EXPLORERBAR_HEADERCLOSE_PRESSED, Name: "VS_EXPLORERBAR_HEADERCLOSE_PRESSED"},
+		{Val: VS_EXPLORERBAR_HEADERPIN_NORMAL, Name: "VS_EXPLORERBAR_HEADERPIN_NORMAL"},
+		{Val: VS_EXPLORERBAR_HEADERPIN_HOT, Name: "VS_EXPLORERBAR_HEADERPIN_HOT"},
+		{Val: VS_EXPLORERBAR_HEADERPIN_PRESSED, Name: "VS_EXPLORERBAR_HEADERPIN_PRESSED"},
+		{Val: VS_EXPLORERBAR_HEADERPIN_SELECTEDNORMAL, Name: "VS_EXPLORERBAR_HEADERPIN_SELECTEDNORMAL"},
+		{Val: VS_EXPLORERBAR_HEADERPIN_SELECTEDHOT, Name: "VS_EXPLORERBAR_HEADERPIN_SELECTEDHOT"},
+		{Val: VS_EXPLORERBAR_HEADERPIN_SELECTEDPRESSED, Name: "VS_EXPLORERBAR_HEADERPIN_SELECTEDPRESSED"},
+		{Val: VS_EXPLORERBAR_IEBARMENU_NORMAL, Name: "VS_EXPLORERBAR_IEBARMENU_NORMAL"},
+		{Val: VS_EXPLORERBAR_IEBARMENU_HOT, Name: "VS_EXPLORERBAR_IEBARMENU_HOT"},
+		{Val: VS_EXPLORERBAR_IEBARMENU_PRESSED, Name: "VS_EXPLORERBAR_IEBARMENU_PRESSED"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPBACKGROUND, Name: "VS_EXPLORERBAR_NORMALGROUPBACKGROUND"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPCOLLAPSE_NORMAL, Name: "VS_EXPLORERBAR_NORMALGROUPCOLLAPSE_NORMAL"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPCOLLAPSE_HOT, Name: "VS_EXPLORERBAR_NORMALGROUPCOLLAPSE_HOT"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPCOLLAPSE_PRESSED, Name: "VS_EXPLORERBAR_NORMALGROUPCOLLAPSE_PRESSED"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPEXPAND_NORMAL, Name: "VS_EXPLORERBAR_NORMALGROUPEXPAND_NORMAL"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPEXPAND_HOT, Name: "VS_EXPLORERBAR_NORMALGROUPEXPAND_HOT"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPEXPAND_PRESSED, Name: "VS_EXPLORERBAR_NORMALGROUPEXPAND_PRESSED"},
+		{Val: VS_EXPLORERBAR_NORMALGROUPHEAD, Name: "VS_EXPLORERBAR_NORMALGROUPHEAD"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPBACKGROUND, Name: "VS_EXPLORERBAR_SPECIALGROUPBACKGROUND"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPCOLLAPSE_NORMAL, Name: "VS_EXPLORERBAR_SPECIALGROUPCOLLAPSE_NORMAL"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPCOLLAPSE_HOT, Name: "VS_EXPLORERBAR_SPECIALGROUPCOLLAPSE_HOT"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPCOLLAPSE_PRESSED, Name: "VS_EXPLORERBAR_SPECIALGROUPCOLLAPSE_PRESSED"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPEXPAND_NORMAL, Name: "VS_EXPLORERBAR_SPECIALGROUPEXPAND_NORMAL"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPEXPAND_HOT, Name: "VS_EXPLORERBAR_SPECIALGROUPEXPAND_HOT"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPEXPAND_PRESSED, Name: "VS_EXPLORERBAR_SPECIALGROUPEXPAND_PRESSED"},
+		{Val: VS_EXPLORERBAR_SPECIALGROUPHEAD, Name: "VS_EXPLORERBAR_SPECIALGROUPHEAD"},
+		{Val: VS_FLYOUT_HEADER, Name: "VS_FLYOUT_HEADER"},
+		{Val: VS_FLYOUT_BODY_NORMAL, Name: "VS_FLYOUT_BODY_NORMAL"},
+		{Val: VS_FLYOUT_BODY_EMPHASIZED, Name: "VS_FLYOUT_BODY_EMPHASIZED"},
+		{Val: VS_FLYOUT_LABEL_NORMAL, Name: "VS_FLYOUT_LABEL_NORMAL"},
+		{Val: VS_FLYOUT_LABEL_SELECTED, Name: "VS_FLYOUT_LABEL_SELECTED"},
+		{Val: VS_FLYOUT_LABEL_EMPHASIZED, Name: "VS_FLYOUT_LABEL_EMPHASIZED"},
+		{Val: VS_FLYOUT_LABEL_DISABLED, Name: "VS_FLYOUT_LABEL_DISABLED"},
+		{Val: VS_FLYOUT_LINK_NORMAL, Name: "VS_FLYOUT_LINK_NORMAL"},
+		{Val: VS_FLYOUT_LINK_HOVER, Name: "VS_FLYOUT_LINK_HOVER"},
+		{Val: VS_FLYOUT_DIVIDER, Name: "VS_FLYOUT_DIVIDER"},
+		{Val: VS_FLYOUT_WINDOW, Name: "VS_FLYOUT_WINDOW"},
+		{Val: VS_FLYOUT_LINKAREA, Name: "VS_FLYOUT_LINKAREA"},
+		{Val: VS_FLYOUT_LINKHEADER_NORMAL, Name: "VS_FLYOUT_LINKHEADER_NORMAL"},
+		{Val: VS_FLYOUT_LINKHEADER_HOVER, Name: "VS_FLYOUT_LINKHEADER_HOVER"},
+		{Val: VS_HEADER_HEADERITEM_NORMAL, Name: "VS_HEADER_HEADERITEM_NORMAL"},
+		{Val: VS_HEADER_HEADERITEM_HOT, Name: "VS_HEADER_HEADERITEM_HOT"},
+		{Val: VS_HEADER_HEADERITEM_PRESSED, Name: "VS_HEADER_HEADERITEM_PRESSED"},
+		{Val: VS_HEADER_HEADERITEM_SORTEDNORMAL, Name: "VS_HEADER_HEADERITEM_SORTEDNORMAL"},
+		{Val: VS_HEADER_HEADERITEM_SORTEDHOT, Name: "VS_HEADER_HEADERITEM_SORTEDHOT"},
+		{Val: VS_HEADER_HEADERITEM_SORTEDPRESSED, Name: "VS_HEADER_HEADERITEM_SORTEDPRESSED"},
+		{Val: VS_HEADER_HEADERITEM_ICONNORMAL, Name: "VS_HEADER_HEADERITEM_ICONNORMAL"},
+		{Val: VS_HEADER_HEADERITEM_ICONHOT, Name: "VS_HEADER_HEADERITEM_ICONHOT"},
+		{Val: VS_HEADER_HEADERITEM_ICONPRESSED, Name: "VS_HEADER_HEADERITEM_ICONPRESSED"},
+		{Val: VS_HEADER_HEADERITEM_ICONSORTEDNORMAL, Name: "VS_HEADER_HEADERITEM_ICONSORTEDNORMAL"},
+		{Val: VS_HEADER_HEADERITEM_ICONSORTEDHOT, Name: "VS_HEADER_HEADERITEM_ICONSORTEDHOT"},
+		{Val: VS_HEADER_HEADERITEM_ICONSORTEDPRESSED, Name: "VS_HEADER_HEADERITEM_ICONSORTEDPRESSED"},
+		{Val: VS_HEADER_HEADERITEMLEFT_NORMAL, Name: "VS_HEADER_HEADERITEMLEFT_NORMAL"},
+		{Val: VS_HEADER_HEADERITEMLEFT_HOT, Name: "VS_HEADER_HEADERITEMLEFT_HOT"},
+		{Val: VS_HEADER_HEADERITEMLEFT_PRESSED, Name: "VS_HEADER_HEADERITEMLEFT_PRESSED"},
+		{Val: VS_HEADER_HEADERITEMRIGHT_NORMAL, Name: "VS_HEADER_HEADERITEMRIGHT_NORMAL"},
+		{Val: VS_HEADER_HEADERITEMRIGHT_HOT, Name: "VS_HEADER_HEADERITEMRIGHT_HOT"},
+		{Val: VS_HEADER_HEADERITEMRIGHT_PRESSED, Name: "VS_HEADER_HEADERITEMRIGHT_PRESSED"},
+		{Val: VS_HEADER_HEADERSORTARROW_SORTEDUP, Name: "VS_HEADER_HEADERSORTARROW_SORTEDUP"},
+		{Val: VS_HEADER_HEADERSORTARROW_SORTEDDOWN, Name: "VS_HEADER_HEADERSORTARROW_SORTEDDOWN"},
+		{Val: VS_HEADER_HEADERDROPDOWN_NORMAL, Name: "VS_HEADER_HEADERDROPDOWN_NORMAL"},
+		{Val: VS_HEADER_HEADERDROPDOWN_SOFTHOT, Name: "VS_HEADER_HEADERDROPDOWN_SOFTHOT"},
+		{Val: VS_HEADER_HEADERDROPDOWN_HOT, Name: "VS_HEADER_HEADERDROPDOWN_HOT"},
+		{Val: VS_HEADER_HEADERDROPDOWNFILTER_NORMAL, Name: "VS_HEADER_HEADERDROPDOWNFILTER_NORMAL"},
+		{Val: VS_HEADER_HEADERDROPDOWNFILTER_SOFTHOT, Name: "VS_HEADER_HEADERDROPDOWNFILTER_SOFTHOT"},
+		{Val: VS_HEADER_HEADERDROPDOWNFILTER_HOT, Name: "VS_HEADER_HEADERDROPDOWNFILTER_HOT"},
+		{Val: VS_HEADER_HEADEROVERFLOW_NORMAL, Name: "VS_HEADER_HEADEROVERFLOW_NORMAL"},
+		{Val: VS_HEADER_HEADEROVERFLOW_HOT, Name: "VS_HEADER_HEADEROVERFLOW_HOT"},
+		{Val: VS_LISTBOX_BORDER_HSCROLL_NORMAL, Name: "VS_LISTBOX_BORDER_HSCROLL_NORMAL"},
+		{Val: VS_LISTBOX_BORDER_HSCROLL_FOCUSED, Name: "VS_LISTBOX_BORDER_HSCROLL_FOCUSED"},
+		{Val: VS_LISTBOX_BORDER_HSCROLL_HOT, Name: "VS_LISTBOX_BORDER_HSCROLL_HOT"},
+		{Val: VS_LISTBOX_BORDER_HSCROLL_DISABLED, Name: "VS_LISTBOX_BORDER_HSCROLL_DISABLED"},
+		{Val: VS_LISTBOX_BORDER_HVSCROLL_NORMAL, Name: "VS_LISTBOX_BORDER_HVSCROLL_NORMAL"},
+		{Val: VS_LISTBOX_BORDER_HVSCROLL_FOCUSED, Name: "VS_LISTBOX_BORDER_HVSCROLL_FOCUSED"},
+		{Val: VS_LISTBOX_BORDER_HVSCROLL_HOT, Name: "VS_LISTBOX_BORDER_HVSCROLL_HOT"},
+		{Val: VS_LISTBOX_BORDER_HVSCROLL_DISABLED, Name: "VS_LISTBOX_BORDER_HVSCROLL_DISABLED"},
+		{Val: VS_LISTBOX_BORDER_NOSCROLL_NORMAL, Name: "VS_LISTBOX_BORDER_NOSCROLL_NORMAL"},
+		{Val: VS_LISTBOX_BORDER_NOSCROLL_FOCUSED, Name: "VS_LISTBOX_BORDER_NOSCROLL_FOCUSED"},
+		{Val: VS_LISTBOX_BORDER_NOSCROLL_HOT, Name: "VS_LISTBOX_BORDER_NOSCROLL_HOT"},
+		{Val: VS_LISTBOX_BORDER_NOSCROLL_DISABLED, Name: "VS_LISTBOX_BORDER_NOSCROLL_DISABLED"},
+		{Val: VS_LISTBOX_BORDER_VSCROLL_NORMAL, Name: "VS_LISTBOX_BORDER_VSCROLL_NORMAL"},
+		{Val: VS_LISTBOX_BORDER_VSCROLL_FOCUSED, Name: "VS_LISTBOX_BORDER_VSCROLL_FOCUSED"},
+		{Val: VS_LISTBOX_BORDER_VSCROLL_HOT, Name: "VS_LISTBOX_BORDER_VSCROLL_HOT"},
+		{Val: VS_LISTBOX_BORDER_VSCROLL_DISABLED, Name: "VS_LISTBOX_BORDER_VSCROLL_DISABLED"},
+		{Val: VS_LISTBOX_ITEM_HOT, Name: "VS_LISTBOX_ITEM_HOT"},
+		{Val: VS_LISTBOX_ITEM_HOTSELECTED, Name: "VS_LISTBOX_ITEM_HOTSELECTED"},
+		{Val: VS_LISTBOX_ITEM_SELECTED, Name: "VS_LISTBOX_ITEM_SELECTED"},
+		{Val: VS_LISTBOX_ITEM_SELECTEDNOTFOCUS, Name: "VS_LISTBOX_ITEM_SELECTEDNOTFOCUS"},
+		{Val: VS_LISTVIEW_LISTITEM_NORMAL, Name: "VS_LISTVIEW_LISTITEM_NORMAL"},
+		{Val: VS_LISTVIEW_LISTITEM_HOT, Name: "VS_LISTVIEW_LISTITEM_HOT"},
+		{Val: VS_LISTVIEW_LISTITEM_SELECTED, Name: "VS_LISTVIEW_LISTITEM_SELECTED"},
+		{Val: VS_LISTVIEW_LISTITEM_DISABLED, Name: "VS_LISTVIEW_LISTITEM_DISABLED"},
+		{Val: VS_LISTVIEW_LISTITEM_SELECTEDNOTFOCUS, Name: "VS_LISTVIEW_LISTITEM_SELECTEDNOTFOCUS"},
+		{Val: VS_LISTVIEW_LISTITEM_HOTSELECTED, Name: "VS_LISTVIEW_LISTITEM_HOTSELECTED"},
+		{Val: VS_LISTVIEW_LISTGROUP, Name: "VS_LISTVIEW_LISTGROUP"},
+		{Val: VS_LISTVIEW_LISTDETAIL, Name: "VS_LISTVIEW_LISTDETAIL"},
+		{Val: VS_LISTVIEW_LISTSORTEDDETAIL, Name: "VS_LISTVIEW_LISTSORTEDDETAIL"},
+		{Val: VS_LISTVIEW_EMPTYTEXT, Name: "VS_LISTVIEW_EMPTYTEXT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPEN, Name: "VS_LISTVIEW_GROUPHEADER_OPEN"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPENHOT, Name: "VS_LISTVIEW_GROUPHEADER_OPENHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPENSELECTED, Name: "VS_LISTVIEW_GROUPHEADER_OPENSELECTED"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPENSELECTEDHOT, Name: "VS_LISTVIEW_GROUPHEADER_OPENSELECTEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPENSELECTEDNOTFOCUSED, Name: "VS_LISTVIEW_GROUPHEADER_OPENSELECTEDNOTFOCUSED"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPENSELECTEDNOTFOCUSEDHOT, Name: "VS_LISTVIEW_GROUPHEADER_OPENSELECTEDNOTFOCUSEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPENMIXEDSELECTION, Name: "VS_LISTVIEW_GROUPHEADER_OPENMIXEDSELECTION"},
+		{Val: VS_LISTVIEW_GROUPHEADER_OPENMIXEDSELECTIONHOT, Name: "VS_LISTVIEW_GROUPHEADER_OPENMIXEDSELECTIONHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSE, Name: "VS_LISTVIEW_GROUPHEADER_CLOSE"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSEHOT, Name: "VS_LISTVIEW_GROUPHEADER_CLOSEHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSESELECTED, Name: "VS_LISTVIEW_GROUPHEADER_CLOSESELECTED"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSESELECTEDHOT, Name: "VS_LISTVIEW_GROUPHEADER_CLOSESELECTEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSESELECTEDNOTFOCUSED, Name: "VS_LISTVIEW_GROUPHEADER_CLOSESELECTEDNOTFOCUSED"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSESELECTEDNOTFOCUSEDHOT, Name: "VS_LISTVIEW_GROUPHEADER_CLOSESELECTEDNOTFOCUSEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSEMIXEDSELECTION, Name: "VS_LISTVIEW_GROUPHEADER_CLOSEMIXEDSELECTION"},
+		{Val: VS_LISTVIEW_GROUPHEADER_CLOSEMIXEDSELECTIONHOT, Name: "VS_LISTVIEW_GROUPHEADER_CLOSEMIXEDSELECTIONHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPEN, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPEN"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPENHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPENHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTED, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTED"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTEDHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTEDNOTFOCUSED, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTEDNOTFOCUSED"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTEDNOTFOCUSEDHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPENSELECTEDNOTFOCUSEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPENMIXEDSELECTION, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPENMIXEDSELECTION"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_OPENMIXEDSELECTIONHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_OPENMIXEDSELECTIONHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSE, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSE"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSEHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSEHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTED, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTED"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTEDHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTEDNOTFOCUSED, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTEDNOTFOCUSED"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTEDNOTFOCUSEDHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSESELECTEDNOTFOCUSEDHOT"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSEMIXEDSELECTION, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSEMIXEDSELECTION"},
+		{Val: VS_LISTVIEW_GROUPHEADERLINE_CLOSEMIXEDSELECTIONHOT, Name: "VS_LISTVIEW_GROUPHEADERLINE_CLOSEMIXEDSELECTIONHOT"},
+		{Val: VS_LISTVIEW_EXPANDBUTTON_NORMAL, Name: "VS_LISTVIEW_EXPANDBUTTON_NORMAL"},
+		{Val: VS_LISTVIEW_EXPANDBUTTON_HOVER, Name: "VS_LISTVIEW_EXPANDBUTTON_HOVER"},
+		{Val: VS_LISTVIEW_EXPANDBUTTON_PUSHED, Name: "VS_LISTVIEW_EXPANDBUTTON_PUSHED"},
+		{Val: VS_LISTVIEW_COLLAPSEBUTTON_NORMAL, Name: "VS_LISTVIEW_COLLAPSEBUTTON_NORMAL"},
+		{Val: VS_LISTVIEW_COLLAPSEBUTTON_HOVER, Name: "VS_LISTVIEW_COLLAPSEBUTTON_HOVER"},
+		{Val: VS_LISTVIEW_COLLAPSEBUTTON_PUSHED, Name: "VS_LISTVIEW_COLLAPSEBUTTON_PUSHED"},
+		{Val: VS_LISTVIEW_COLUMNDETAIL, Name: "VS_LISTVIEW_COLUMNDETAIL"},
+		{Val: VS_MENU_MENUITEM_TMSCHEMA, Name: "VS_MENU_MENUITEM_TMSCHEMA"},
+		{Val: VS_MENU_MENUDROPDOWN_TMSCHEMA, Name: "VS_MENU_MENUDROPDOWN_TMSCHEMA"},
+		{Val: VS_MENU_MENUBARITEM_TMSCHEMA, Name: "VS_MENU_MENUBARITEM_TMSCHEMA"},
+		{Val: VS_MENU_MENUBARDROPDOWN_TMSCHEMA, Name: "VS_MENU_MENUBARDROPDOWN_TMSCHEMA"},
+		{Val: VS_MENU_CHEVRON_TMSCHEMA, Name: "VS_MENU_CHEVRON_TMSCHEMA"},
+		{Val: VS_MENU_SEPARATOR_TMSCHEMA, Name: "VS_MENU_SEPARATOR_TMSCHEMA"},
+		{Val: VS_MENU_BARBACKGROUND_ACTIVE, Name: "VS_MENU_BARBACKGROUND_ACTIVE"},
+		{Val: VS_MENU_BARBACKGROUND_INACTIVE, Name: "VS_MENU_BARBACKGROUND_INACTIVE"},
+		{Val: VS_MENU_BARITEM_NORMAL, Name: "VS_MENU_BARITEM_NORMAL"},
+		{Val: VS_MENU_BARITEM_HOT, Name: "VS_MENU_BARITEM_HOT"},
+		{Val: VS_MENU_BARITEM_PUSHED, Name: "VS_MENU_BARITEM_PUSHED"},
+		{Val: VS_MENU_BARITEM_DISABLED, Name: "VS_MENU_BARITEM_DISABLED"},
+		{Val: VS_MENU_BARITEM_DISABLEDHOT, Name: "VS_MENU_BARITEM_DISABLEDHOT"},
+		{Val: VS_MENU_BARITEM_DISABLEDPUSHED, Name: "VS_MENU_BARITEM_DISABLEDPUSHED"},
+		{Val: VS_MENU_POPUPBACKGROUND, Name: "VS_MENU_POPUPBACKGROUND"},
+		{Val: VS_MENU_POPUPBORDERS, Name: "VS_MENU_POPUPBORDERS"},
+		{Val: VS_MENU_POPUPCHECK_CHECKMARKNORMAL, Name: "VS_MENU_POPUPCHECK_CHECKMARKNORMAL"},
+		{Val: VS_MENU_POPUPCHECK_CHECKMARKDISABLED, Name: "VS_MENU_POPUPCHECK_CHECKMARKDISABLED"},
+		{Val: VS_MENU_POPUPCHECK_BULLETNORMAL, Name: "VS_MENU_POPUPCHECK_BULLETNORMAL"},
+		{Val: VS_MENU_POPUPCHECK_BULLETDISABLED, Name: "VS_MENU_POPUPCHECK_BULLETDISABLED"},
+		{Val: VS_MENU_POPUPCHECKBACKGROUND_DISABLED, Name: "VS_MENU_POPUPCHECKBACKGROUND_DISABLED"},
+		{Val: VS_MENU_POPUPCHECKBACKGROUND_NORMAL, Name: "VS_MENU_POPUPCHECKBACKGROUND_NORMAL"},
+		{Val: VS_MENU_POPUPCHECKBACKGROUND_BITMAP, Name: "VS_MENU_POPUPCHECKBACKGROUND_BITMAP"},
+		{Val: VS_MENU_POPUPGUTTER, Name: "VS_MENU_POPUPGUTTER"},
+		{Val: VS_MENU_POPUPITEM_NORMAL, Name: "VS_MENU_POPUPITEM_NORMAL"},
+		{Val: VS_MENU_POPUPITEM_HOT, Name: "VS_MENU_POPUPITEM_HOT"},
+		{Val: VS_MENU_POPUPITEM_DISABLED, Name: "VS_MENU_POPUPITEM_DISABLED"},
+		{Val: VS_MENU_POPUPITEM_DISABLEDHOT, Name: "VS_MENU_POPUPITEM_DISABLEDHOT"},
+		{Val: VS_MENU_POPUPSEPARATOR, Name: "VS_MENU_POPUPSEPARATOR"},
+		{Val: VS_MENU_POPUPSUBMENU_NORMAL, Name: "VS_MENU_POPUPSUBMENU_NORMAL"},
+		{Val: VS_MENU_POPUPSUBMENU_DISABLED, Name: "VS_MENU_POPUPSUBMENU_DISABLED"},
+		{Val: VS_MENU_SYSTEMCLOSE_NORMAL, Name: "VS_MENU_SYSTEMCLOSE_NORMAL"},
+		{Val: VS_MENU_SYSTEMCLOSE_DISABLED, Name: "VS_MENU_SYSTEMCLOSE_DISABLED"},
+		{Val: VS_MENU_SYSTEMMAXIMIZE_NORMAL, Name: "VS_MENU_SYSTEMMAXIMIZE_NORMAL"},
+		{Val: VS_MENU_SYSTEMMAXIMIZE_DISABLED, Name: "VS_MENU_SYSTEMMAXIMIZE_DISABLED"},
+		{Val: VS_MENU_SYSTEMMINIMIZE_NORMAL, Name: "VS_MENU_SYSTEMMINIMIZE_NORMAL"},
+		{Val: VS_MENU_SYSTEMMINIMIZE_DISABLED, Name: "VS_MENU_SYSTEMMINIMIZE_DISABLED"},
+		{Val: VS_MENU_SYSTEMRESTORE_NORMAL, Name: "VS_MENU_SYSTEMRESTORE_NORMAL"},
+		{Val: VS_MENU_SYSTEMRESTORE_DISABLED, Name: "VS_MENU_SYSTEMRESTORE_DISABLED"},
+		{Val: VS_NAVIGATION_BACKBUTTON_NORMAL, Name: "VS_NAVIGATION_BACKBUTTON_NORMAL"},
+		{Val: VS_NAVIGATION_BACKBUTTON_HOT, Name: "VS_NAVIGATION_BACKBUTTON_HOT"},
+		{Val: VS_NAVIGATION_BACKBUTTON_PRESSED, Name: "VS_NAVIGATION_BACKBUTTON_PRESSED"},
+		{Val: VS_NAVIGATION_BACKBUTTON_DISABLED, Name: "VS_NAVIGATION_BACKBUTTON_DISABLED"},
+		{Val: VS_NAVIGATION_FORWARDBUTTON_NORMAL, Name: "VS_NAVIGATION_FORWARDBUTTON_NORMAL"},
+		{Val: VS_NAVIGATION_FORWARDBUTTON_HOT, Name: "VS_NAVIGATION_FORWARDBUTTON_HOT"},
+		{Val: VS_NAVIGATION_FORWARDBUTTON_PRESSED, Name: "VS_NAVIGATION_FORWARDBUTTON_PRESSED"},
+		{Val: VS_NAVIGATION_FORWARDBUTTON_DISABLED, Name: "VS_NAVIGATION_FORWARDBUTTON_DISABLED"},
+		{Val: VS_NAVIGATION_MENUBUTTON_NORMAL, Name: "VS_NAVIGATION_MENUBUTTON_NORMAL"},
+		{Val: VS_NAVIGATION_MENUBUTTON_HOT, Name: "VS_NAVIGATION_MENUBUTTON_HOT"},
+		{Val: VS_NAVIGATION_MENUBUTTON_PRESSED, Name: "VS_NAVIGATION_MENUBUTTON_PRESSED"},
+		{Val: VS_NAVIGATION_MENUBUTTON_DISABLED, Name: "VS_NAVIGATION_MENUBUTTON_DISABLED"},
+		{Val: VS_PROGRESS_BAR, Name: "VS_PROGRESS_BAR"},
+		{Val: VS_PROGRESS_BARVERT, Name: "VS_PROGRESS_BARVERT"},
+		{Val: VS_PROGRESS_CHUNK, Name: "VS_PROGRESS_CHUNK"},
+		{Val: VS_PROGRESS_CHUNKVERT, Name: "VS_PROGRESS_CHUNKVERT"},
+		{Val: VS_PROGRESS_FILL_NORMAL, Name: "VS_PROGRESS_FILL_NORMAL"},
+		{Val: VS_PROGRESS_FILL_ERROR, Name: "VS_PROGRESS_FILL_ERROR"},
+		{Val: VS_PROGRESS_FILL_PAUSED, Name: "VS_PROGRESS_FILL_PAUSED"},
+		{Val: VS_PROGRESS_FILL_PARTIAL, Name: "VS_PROGRESS_FILL_PARTIAL"},
+		{Val: VS_PROGRESS_FILLVERT_NORMAL, Name: "VS_PROGRESS_FILLVERT_NORMAL"},
+		{Val: VS_PROGRESS_FILLVERT_ERROR, Name: "VS_PROGRESS_FILLVERT_ERROR"},
+		{Val: VS_PROGRESS_FILLVERT_PAUSED, Name: "VS_PROGRESS_FILLVERT_PAUSED"},
+		{Val: VS_PROGRESS_FILLVERT_PARTIAL, Name: "VS_PROGRESS_FILLVERT_PARTIAL"},
+		{Val: VS_PROGRESS_PULSEOVERLAY, Name: "VS_PROGRESS_PULSEOVERLAY"},
+		{Val: VS_PROGRESS_MOVEOVERLAY, Name: "VS_PROGRESS_MOVEOVERLAY"},
+		{Val: VS_PROGRESS_PULSEOVERLAYVERT, Name: "VS_PROGRESS_PULSEOVERLAYVERT"},
+		{Val: VS_PROGRESS_MOVEOVERLAYVERT, Name: "VS_PROGRESS_MOVEOVERLAYVERT"},
+		{Val: VS_PROGRESS_TRANSPARENTBAR_NORMAL, Name: "VS_PROGRESS_TRANSPARENTBAR_NORMAL"},
+		{Val: VS_PROGRESS_TRANSPARENTBAR_PARTIAL, Name: "VS_PROGRESS_TRANSPARENTBAR_PARTIAL"},
+		{Val: VS_PROGRESS_TRANSPARENTBARVERT_NORMAL, Name: "VS_PROGRESS_TRANSPARENTBARVERT_NORMAL"},
+		{Val: VS_PROGRESS_TRANSPARENTBARVERT_PARTIAL, Name: "VS_PROGRESS_TRANSPARENTBARVERT_PARTIAL"},
+		{Val: VS_REBAR_GRIPPER, Name: "VS_REBAR_GRIPPER"},
+		{Val: VS_REBAR_GRIPPERVERT, Name: "VS_REBAR_GRIPPERVERT"},
+		{Val: VS_REBAR_BAND, Name: "VS_REBAR_BAND"},
+		{Val: VS_REBAR_CHEVRON_NORMAL, Name: "VS_REBAR_CHEVRON_NORMAL"},
+		{Val: VS_REBAR_CHEVRON_HOT, Name: "VS_REBAR_CHEVRON_HOT"},
+		{Val: VS_REBAR_CHEVRON_PRESSED, Name: "VS_REBAR_CHEVRON_PRESSED"},
+		{Val: VS_REBAR_CHEVRONVERT_NORMAL, Name: "VS_REBAR_CHEVRONVERT_NORMAL"},
+		{Val: VS_REBAR_CHEVRONVERT_HOT, Name: "VS_REBAR_CHEVRONVERT_HOT"},
+		{Val: VS_REBAR_CHEVRONVERT_PRESSED, Name: "VS_REBAR_CHEVRONVERT_PRESSED"},
+		{Val: VS_REBAR_BACKGROUND, Name: "VS_REBAR_BACKGROUND"},
+		{Val: VS_REBAR_SPLITTER_NORMAL, Name: "VS_REBAR_SPLITTER_NORMAL"},
+		{Val: VS_REBAR_SPLITTER_HOT, Name: "VS_REBAR_SPLITTER_HOT"},
+		{Val: VS_REBAR_SPLITTER_PRESSED, Name: "VS_REBAR_SPLITTER_PRESSED"},
+		{Val: VS_REBAR_SPLITTERVERT_NORMAL, Name: "VS_REBAR_SPLITTERVERT_NORMAL"},
+		{Val: VS_REBAR_SPLITTERVERT_HOT, Name: "VS_REBAR_SPLITTERVERT_HOT"},
+		{Val: VS_REBAR_SPLITTERVERT_PRESSED, Name: "VS_REBAR_SPLITTERVERT_PRESSED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_UPNORMAL, Name: "VS_SCROLLBAR_ARROWBTN_UPNORMAL"},
+		{Val: VS_SCROLLBAR_ARROWBTN_UPHOT, Name: "VS_SCROLLBAR_ARROWBTN_UPHOT"},
+		{Val: VS_SCROLLBAR_ARROWBTN_UPPRESSED, Name: "VS_SCROLLBAR_ARROWBTN_UPPRESSED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_UPDISABLED, Name: "VS_SCROLLBAR_ARROWBTN_UPDISABLED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_DOWNNORMAL, Name: "VS_SCROLLBAR_ARROWBTN_DOWNNORMAL"},
+		{Val: VS_SCROLLBAR_ARROWBTN_DOWNHOT, Name: "VS_SCROLLBAR_ARROWBTN_DOWNHOT"},
+		{Val: VS_SCROLLBAR_ARROWBTN_DOWNPRESSED, Name: "VS_SCROLLBAR_ARROWBTN_DOWNPRESSED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_DOWNDISABLED, Name: "VS_SCROLLBAR_ARROWBTN_DOWNDISABLED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_LEFTNORMAL, Name: "VS_SCROLLBAR_ARROWBTN_LEFTNORMAL"},
+		{Val: VS_SCROLLBAR_ARROWBTN_LEFTHOT, Name: "VS_SCROLLBAR_ARROWBTN_LEFTHOT"},
+		{Val: VS_SCROLLBAR_ARROWBTN_LEFTPRESSED, Name: "VS_SCROLLBAR_ARROWBTN_LEFTPRESSED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_LEFTDISABLED, Name: "VS_SCROLLBAR_ARROWBTN_LEFTDISABLED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_RIGHTNORMAL, Name: "VS_SCROLLBAR_ARROWBTN_RIGHTNORMAL"},
+		{Val: VS_SCROLLBAR_ARROWBTN_RIGHTHOT, Name: "VS_SCROLLBAR_ARROWBTN_RIGHTHOT"},
+		{Val: VS_SCROLLBAR_ARROWBTN_RIGHTPRESSED, Name: "VS_SCROLLBAR_ARROWBTN_RIGHTPRESSED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_RIGHTDISABLED, Name: "VS_SCROLLBAR_ARROWBTN_RIGHTDISABLED"},
+		{Val: VS_SCROLLBAR_ARROWBTN_UPHOVER, Name: "VS_SCROLLBAR_ARROWBTN_UPHOVER"},
+		{Val: VS_SCROLLBAR_ARROWBTN_DOWNHOVER, Name: "VS_SCROLLBAR_ARROWBTN_DOWNHOVER"},
+		{Val: VS_SCROLLBAR_ARROWBTN_LEFTHOVER, Name: "VS_SCROLLBAR_ARROWBTN_LEFTHOVER"},
+		{Val: VS_SCROLLBAR_ARROWBTN_RIGHTHOVER, Name: "VS_SCROLLBAR_ARROWBTN_RIGHTHOVER"},
+		{Val: VS_SCROLLBAR_THUMBBTNHORZ_NORMAL, Name: "VS_SCROLLBAR_THUMBBTNHORZ_NORMAL"},
+		{Val: VS_SCROLLBAR_THUMBBTNHORZ_HOT, Name: "VS_SCROLLBAR_THUMBBTNHORZ_HOT"},
+		{Val: VS_SCROLLBAR_THUMBBTNHORZ_PRESSED, Name: "VS_SCROLLBAR_THUMBBTNHORZ_PRESSED"},
+		{Val: VS_SCROLLBAR_THUMBBTNHORZ_DISABLED, Name: "VS_SCROLLBAR_THUMBBTNHORZ_DISABLED"},
+		{Val: VS_SCROLLBAR_THUMBBTNHORZ_HOVER, Name: "VS_SCROLLBAR_THUMBBTNHORZ_HOVER"},
+		{Val: VS_SCROLLBAR_THUMBBTNVERT_NORMAL, Name: "VS_SCROLLBAR_THUMBBTNVERT_NORMAL"},
+		{Val: VS_SCROLLBAR_THUMBBTNVERT_HOT, Name: "VS_SCROLLBAR_THUMBBTNVERT_HOT"},
+		{Val: VS_SCROLLBAR_THUMBBTNVERT_PRESSED, Name: "VS_SCROLLBAR_THUMBBTNVERT_PRESSED"},
+		{Val: VS_SCROLLBAR_THUMBBTNVERT_DISABLED, Name: "VS_SCROLLBAR_THUMBBTNVERT_DISABLED"},
+		{Val: VS_SCROLLBAR_THUMBBTNVERT_HOVER, Name: "VS_SCROLLBAR_THUMBBTNVERT_HOVER"},
+		{Val: VS_SCROLLBAR_LOWERTRACKHORZ_NORMAL, Name: "VS_SCROLLBAR_LOWERTRACKHORZ_NORMAL"},
+		{Val: VS_SCROLLBAR_LOWERTRACKHORZ_HOT, Name: "VS_SCROLLBAR_LOWERTRACKHORZ_HOT"},
+		{Val: VS_SCROLLBAR_LOWERTRACKHORZ_PRESSED, Name: "VS_SCROLLBAR_LOWERTRACKHORZ_PRESSED"},
+		{Val: VS_SCROLLBAR_LOWERTRACKHORZ_DISABLED, Name: "VS_SCROLLBAR_LOWERTRACKHORZ_DISABLED"},
+		{Val: VS_SCROLLBAR_LOWERTRACKHORZ_HOVER, Name: "VS_SCROLLBAR_LOWERTRACKHORZ_HOVER"},
+		{Val: VS_SCROLLBAR_UPPERTRACKHORZ_NORMAL, Name: "VS_SCROLLBAR_UPPERTRACKHORZ_NORMAL"},
+		{Val: VS_SCROLLBAR_UPPERTRACKHORZ_HOT, Name: "VS_SCROLLBAR_UPPERTRACKHORZ_HOT"},
+		{Val: VS_SCROLLBAR_UPPERTRACKHORZ_PRESSED, Name: "VS_SCROLLBAR_UPPERTRACKHORZ_PRESSED"},
+		{Val: VS_SCROLLBAR_UPPERTRACKHORZ_DISABLED, Name: "VS_SCROLLBAR_UPPERTRACKHORZ_DISABLED"},
+		{Val: VS_SCROLLBAR_UPPERTRACKHORZ_HOVER, Name: "VS_SCROLLBAR_UPPERTRACKHORZ_HOVER"},
+		{Val: VS_SCROLLBAR_LOWERTRACKVERT_NORMAL, Name: "VS_SCROLLBAR_LOWERTRACKVERT_NORMAL"},
+		{Val: VS_SCROLLBAR_LOWERTRACKVERT_HOT, Name: "VS_SCROLLBAR_LOWERTRACKVERT_HOT"},
+		{Val: VS_SCROLLBAR_LOWERTRACKVERT_PRESSED, Name: "VS_SCROLLBAR_LOWERTRACKVERT_PRESSED"},
+		{Val: VS_SCROLLBAR_LOWERTRACKVERT_DISABLED, Name: "VS_SCROLLBAR_LOWERTRACKVERT_DISABLED"},
+		{Val: VS_SCROLLBAR_LOWERTRACKVERT_HOVER, Name: "VS_SCROLLBAR_LOWERTRACKVERT_HOVER"},
+		{Val: VS_SCROLLBAR_UPPERTRACKVERT_NORMAL, Name: "VS_SCROLLBAR_UPPERTRACKVERT_NORMAL"},
+		{Val: VS_SCROLLBAR_UPPERTRACKVERT_HOT, Name: "VS_SCROLLBAR_UPPERTRACKVERT_HOT"},
+		{Val: VS_SCROLLBAR_UPPERTRACKVERT_PRESSED, Name: "VS_SCROLLBAR_UPPERTRACKVERT_PRESSED"},
+		{Val: VS_SCROLLBAR_UPPERTRACKVERT_DISABLED, Name: "VS_SCROLLBAR_UPPERTRACKVERT_DISABLED"},
+		{Val: VS_SCROLLBAR_UPPERTRACKVERT_HOVER, Name: "VS_SCROLLBAR_UPPERTRACKVERT_HOVER"},
+		{Val: VS_SCROLLBAR_GRIPPERHORZ_NORMAL, Name: "VS_SCROLLBAR_GRIPPERHORZ_NORMAL"},
+		{Val: VS_SCROLLBAR_GRIPPERHORZ_HOT, Name: "VS_SCROLLBAR_GRIPPERHORZ_HOT"},
+		{Val: VS_SCROLLBAR_GRIPPERHORZ_PRESSED, Name: "VS_SCROLLBAR_GRIPPERHORZ_PRESSED"},
+		{Val: VS_SCROLLBAR_GRIPPERHORZ_DISABLED, Name: "VS_SCROLLBAR_GRIPPERHORZ_DISABLED"},
+		{Val: VS_SCROLLBAR_GRIPPERHORZ_HOVER, Name: "VS_SCROLLBAR_GRIPPERHORZ_HOVER"},
+		{Val: VS_SCROLLBAR_GRIPPERVERT_NORMAL, Name: "VS_SCROLLBAR_GRIPPERVERT_NORMAL"},
+		{Val: VS_SCROLLBAR_GRIPPERVERT_HOT, Name: "VS_SCROLLBAR_GRIPPERVERT_HOT"},
+		{Val: VS_SCROLLBAR_GRIPPERVERT_PRESSED, Name: "VS_SCROLLBAR_GRIPPERVERT_PRESSED"},
+		{Val: VS_SCROLLBAR_GRIPPERVERT_DISABLED, Name: "VS_SCROLLBAR_GRIPPERVERT_DISABLED"},
+		{Val: VS_SCROLLBAR_GRIPPERVERT_HOVER, Name: "VS_SCROLLBAR_GRIPPERVERT_HOVER"},
+		{Val: VS_SCROLLBAR_SIZEBOX_RIGHTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_RIGHTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOX_LEFTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_LEFTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOX_TOPRIGHTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_TOPRIGHTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOX_TOPLEFTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_TOPLEFTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOX_HALFBOTTOMRIGHTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_HALFBOTTOMRIGHTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOX_HALFBOTTOMLEFTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_HALFBOTTOMLEFTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOX_HALFTOPRIGHTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_HALFTOPRIGHTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOX_HALFTOPLEFTALIGN, Name: "VS_SCROLLBAR_SIZEBOX_HALFTOPLEFTALIGN"},
+		{Val: VS_SCROLLBAR_SIZEBOXBKGND_NORMAL, Name: "VS_SCROLLBAR_SIZEBOXBKGND_NORMAL"},
+		{Val: VS_SCROLLBAR_SIZEBOXBKGND_HOT, Name: "VS_SCROLLBAR_SIZEBOXBKGND_HOT"},
+		{Val: VS_SCROLLBAR_SIZEBOXBKGND_PRESSED, Name: "VS_SCROLLBAR_SIZEBOXBKGND_PRESSED"},
+		{Val: VS_SCROLLBAR_SIZEBOXBKGND_DISABLED, Name: "VS_SCROLLBAR_SIZEBOXBKGND_DISABLED"},
+		{Val: VS_SCROLLBAR_SIZEBOXBKGND_HOVER, Name: "VS_SCROLLBAR_SIZEBOXBKGND_HOVER"},
+		{Val: VS_SPIN_UP_NORMAL, Name: "VS_SPIN_UP_NORMAL"},
+		{Val: VS_SPIN_UP_HOT, Name: "VS_SPIN_UP_HOT"},
+		{Val: VS_SPIN_UP_PRESSED, Name: "VS_SPIN_UP_PRESSED"},
+		{Val: VS_SPIN_UP_DISABLED, Name: "VS_SPIN_UP_DISABLED"},
+		{Val: VS_SPIN_DOWN_NORMAL, Name: "VS_SPIN_DOWN_NORMAL"},
+		{Val: VS_SPIN_DOWN_HOT, Name: "VS_SPIN_DOWN_HOT"},
+		{Val: VS_SPIN_DOWN_PRESSED, Name: "VS_SPIN_DOWN_PRESSED"},
+		{Val: VS_SPIN_DOWN_DISABLED, Name: "VS_SPIN_DOWN_DISABLED"},
+		{Val: VS_SPIN_UPHORZ_NORMAL, Name: "VS_SPIN_UPHORZ_NORMAL"},
+		{Val: VS_SPIN_UPHORZ_HOT, Name: "VS_SPIN_UPHORZ_HOT"},
+		{Val: VS_SPIN_UPHORZ_PRESSED, Name: "VS_SPIN_UPHORZ_PRESSED"},
+		{Val: VS_SPIN_UPHORZ_DISABLED, Name: "VS_SPIN_UPHORZ_DISABLED"},
+		{Val: VS_SPIN_DOWNHORZ_NORMAL, Name: "VS_SPIN_DOWNHORZ_NORMAL"},
+		{Val: VS_SPIN_DOWNHORZ_HOT, Name: "VS_SPIN_DOWNHORZ_HOT"},
+		{Val: VS_SPIN_DOWNHORZ_PRESSED, Name: "VS_SPIN_DOWNHORZ_PRESSED"},
+		{Val: VS_SPIN_DOWNHORZ_DISABLED, Name: "VS_SPIN_DOWNHORZ_DISABLED"},
+		{Val: VS_STATUS_PANE, Name: "VS_STATUS_PANE"},
+		{Val: VS_STATUS_GRIPPERPANE, Name: "VS_STATUS_GRIPPERPANE"},
+		{Val: VS_STATUS_GRIPPER, Name: "VS_STATUS_GRIPPER"},
+		{Val: VS_TAB_TABITEM_NORMAL, Name: "VS_TAB_TABITEM_NORMAL"},
+		{Val: VS_TAB_TABITEM_HOT, Name: "VS_TAB_TABITEM_HOT"},
+		{Val: VS_TAB_TABITEM_SELECTED, Name: "VS_TAB_TABITEM_SELECTED"},
+		{Val: VS_TAB_TABITEM_DISABLED, Name: "VS_TAB_TABITEM_DISABLED"},
+		{Val: VS_TAB_TABITEM_FOCUSED, Name: "VS_TAB_TABITEM_FOCUSED"},
+		{Val: VS_TAB_TABITEMLEFTEDGE_NORMAL, Name: "VS_TAB_TABITEMLEFTEDGE_NORMAL"},
+		{Val: VS_TAB_TABITEMLEFTEDGE_HOT, Name: "VS_TAB_TABITEMLEFTEDGE_HOT"},
+		{Val: VS_TAB_TABITEMLEFTEDGE_SELECTED, Name: "VS_TAB_TABITEMLEFTEDGE_SELECTED"},
+		{Val: VS_TAB_TABITEMLEFTEDGE_DISABLED, Name: "VS_TAB_TABITEMLEFTEDGE_DISABLED"},
+		{Val: VS_TAB_TABITEMLEFTEDGE_FOCUSED, Name: "VS_TAB_TABITEMLEFTEDGE_FOCUSED"},
+		{Val: VS_TAB_TABITEMRIGHTEDGE_NORMAL, Name: "VS_TAB_TABITEMRIGHTEDGE_NORMAL"},
+		{Val: VS_TAB_TABITEMRIGHTEDGE_HOT, Name: "VS_TAB_TABITEMRIGHTEDGE_HOT"},
+		{Val: VS_TAB_TABITEMRIGHTEDGE_SELECTED, Name: "VS_TAB_TABITEMRIGHTEDGE_SELECTED"},
+		{Val: VS_TAB_TABITEMRIGHTEDGE_DISABLED, Name: "VS_TAB_TABITEMRIGHTEDGE_DISABLED"},
+		{Val: VS_TAB_TABITEMRIGHTEDGE_FOCUSED, Name: "VS_TAB_TABITEMRIGHTEDGE_FOCUSED"},
+		{Val: VS_TAB_TABITEMBOTHEDGE_NORMAL, Name: "VS_TAB_TABITEMBOTHEDGE_NORMAL"},
+		{Val: VS_TAB_TABITEMBOTHEDGE_HOT, Name: "VS_TAB_TABITEMBOTHEDGE_HOT"},
+		{Val: VS_TAB_TABITEMBOTHEDGE_SELECTED, Name: "VS_TAB_TABITEMBOTHEDGE_SELECTED"},
+		{Val: VS_TAB_TABITEMBOTHEDGE_DISABLED, Name: "VS_TAB_TABITEMBOTHEDGE_DISABLED"},
+		{Val: VS_TAB_TABITEMBOTHEDGE_FOCUSED, Name: "VS_TAB_TABITEMBOTHEDGE_FOCUSED"},
+		{Val: VS_TAB_TOPTABITEM_NORMAL, Name: "VS_TAB_TOPTABITEM_NORMAL"},
+		{Val: VS_TAB_TOPTABITEM_HOT, Name: "VS_TAB_TOPTABITEM_HOT"},
+		{Val: VS_TAB_TOPTABITEM_SELECTED, Name: "VS_TAB_TOPTABITEM_SELECTED"},
+		{Val: VS_TAB_TOPTABITEM_DISABLED, Name: "VS_TAB_TOPTABITEM_DISABLED"},
+		{Val: VS_TAB_TOPTABITEM_FOCUSED, Name: "VS_TAB_TOPTABITEM_FOCUSED"},
+		{Val: VS_TAB_TOPTABITEMLEFTEDGE_NORMAL, Name: "VS_TAB_TOPTABITEMLEFTEDGE_NORMAL"},
+		{Val: VS_TAB_TOPTABITEMLEFTEDGE_HOT, Name: "VS_TAB_TOPTABITEMLEFTEDGE_HOT"},
+		{Val: VS_TAB_TOPTABITEMLEFTEDGE_SELECTED, Name: "VS_TAB_TOPTABITEMLEFTEDGE_SELECTED"},
+		{Val: VS_TAB_TOPTABITEMLEFTEDGE_DISABLED, Name: "VS_TAB_TOPTABITEMLEFTEDGE_DISABLED"},
+		{Val: VS_TAB_TOPTABITEMLEFTEDGE_FOCUSED, Name: "VS_TAB_TOPTABITEMLEFTEDGE_FOCUSED"},
+		{Val: VS_TAB_TOPTABITEMRIGHTEDGE_NORMAL, Name: "VS_TAB_TOPTABITEMRIGHTEDGE_NORMAL"},
+		{Val: VS_TAB_TOPTABITEMRIGHTEDGE_HOT, Name: "VS_TAB_TOPTABITEMRIGHTEDGE_HOT"},
+		{Val: VS_TAB_TOPTABITEMRIGHTEDGE_SELECTED, Name: "VS_TAB_TOPTABITEMRIGHTEDGE_SELECTED"},
+		{Val: VS_TAB_TOPTABITEMRIGHTEDGE_DISABLED, Name: "VS_TAB_TOPTABITEMRIGHTEDGE_DISABLED"},
+		{Val: VS_TAB_TOPTABITEMRIGHTEDGE_FOCUSED, Name: "VS_TAB_TOPTABITEMRIGHTEDGE_FOCUSED"},
+		{Val: VS_TAB_TOPTABITEMBOTHEDGE_NORMAL, Name: "VS_TAB_TOPTABITEMBOTHEDGE_NORMAL"},
+		{Val: VS_TAB_TOPTABITEMBOTHEDGE_HOT, Name: "VS_TAB_TOPTABITEMBOTHEDGE_HOT"},
+		{Val: VS_TAB_TOPTABITEMBOTHEDGE_SELECTED, Name: "VS_TAB_TOPTABITEMBOTHEDGE_SELECTED"},
+		{Val: VS_TAB_TOPTABITEMBOTHEDGE_DISABLED, Name: "VS_TAB_TOPTABITEMBOTHEDGE_DISABLED"},
+		{Val: VS_TAB_TOPTABITEMBOTHEDGE_FOCUSED, Name: "VS_TAB_TOPTABITEMBOTHEDGE_FOCUSED"},
+		{Val: VS_TAB_PANE, Name: "VS_TAB_PANE"},
+		{Val: VS_TAB_BODY, Name: "VS_TAB_BODY"},
+		{Val: VS_TAB_AEROWIZARDBODY, Name: "VS_TAB_AEROWIZARDBODY"},
+		{Val: VS_TASKDIALOG_PRIMARYPANEL, Name: "VS_TASKDIALOG_PRIMARYPANEL"},
+		{Val: VS_TASKDIALOG_MAININSTRUCTIONPANE, Name: "VS_TASKDIALOG_MAININSTRUCTIONPANE"},
+		{Val: VS_TASKDIALOG_MAINICON, Name: "VS_TASKDIALOG_MAINICON"},
+		{Val: VS_TASKDIALOG_CONTENTPANE_STANDALONE, Name: "VS_TASKDIALOG_CONTENTPANE_STANDALONE"},
+		{Val: VS_TASKDIALOG_CONTENTICON, Name: "VS_TASKDIALOG_CONTENTICON"},
+		{Val: VS_TASKDIALOG_EXPANDEDCONTENT, Name: "VS_TASKDIALOG_EXPANDEDCONTENT"},
+		{Val: VS_TASKDIALOG_COMMANDLINKPANE, Name: "VS_TASKDIALOG_COMMANDLINKPANE"},
+		{Val: VS_TASKDIALOG_SECONDARYPANEL, Name: "VS_TASKDIALOG_SECONDARYPANEL"},
+		{Val: VS_TASKDIALOG_CONTROLPANE, Name: "VS_TASKDIALOG_CONTROLPANE"},
+		{Val: VS_TASKDIALOG_BUTTONSECTION, Name: "VS_TASKDIALOG_BUTTONSECTION"},
+		{Val: VS_TASKDIALOG_BUTTONWRAPPER, Name: "VS_TASKDIALOG_BUTTONWRAPPER"},
+		{Val: VS_TASKDIALOG_EXPANDOTEXT, Name: "VS_TASKDIALOG_EXPANDOTEXT"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_NORMAL, Name: "VS_TASKDIALOG_EXPANDOBUTTON_NORMAL"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_HOVER, Name: "VS_TASKDIALOG_EXPANDOBUTTON_HOVER"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_PRESSED, Name: "VS_TASKDIALOG_EXPANDOBUTTON_PRESSED"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDNORMAL, Name: "VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDNORMAL"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDHOVER, Name: "VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDHOVER"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDPRESSED, Name: "VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDPRESSED"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_NORMALDISABLED, Name: "VS_TASKDIALOG_EXPANDOBUTTON_NORMALDISABLED"},
+		{Val: VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDDISABLED, Name: "VS_TASKDIALOG_EXPANDOBUTTON_EXPANDEDDISABLED"},
+		{Val: VS_TASKDIALOG_VERIFICATIONTEXT, Name: "VS_TASKDIALOG_VERIFICATIONTEXT"},
+		{Val: VS_TASKDIALOG_FOOTNOTEPANE, Name: "VS_TASKDIALOG_FOOTNOTEPANE"},
+		{Val: VS_TASKDIALOG_FOOTNOTEAREA, Name: "VS_TASKDIALOG_FOOTNOTEAREA"},
+		{Val: VS_TASKDIALOG_FOOTNOTESEPARATOR, Name: "VS_TASKDIALOG_FOOTNOTESEPARATOR"},
+		{Val: VS_TASKDIALOG_EXPANDEDFOOTERAREA, Name: "VS_TASKDIALOG_EXPANDEDFOOTERAREA"},
+		{Val: VS_TASKDIALOG_PROGRESSBAR, Name: "VS_TASKDIALOG_PROGRESSBAR"},
+		{Val: VS_TASKDIALOG_IMAGEALIGNMENT, Name: "VS_TASKDIALOG_IMAGEALIGNMENT"},
+		{Val: VS_TASKDIALOG_RADIOBUTTONPANE, Name: "VS_TASKDIALOG_RADIOBUTTONPANE"},
+		{Val: VS_TEXTSTYLE_MAININSTRUCTION, Name: "VS_TEXTSTYLE_MAININSTRUCTION"},
+		{Val: VS_TEXTSTYLE_INSTRUCTION, Name: "VS_TEXTSTYLE_INSTRUCTION"},
+		{Val: VS_TEXTSTYLE_BODYTITLE, Name: "VS_TEXTSTYLE_BODYTITLE"},
+		{Val: VS_TEXTSTYLE_BODYTEXT, Name: "VS_TEXTSTYLE_BODYTEXT"},
+		{Val: VS_TEXTSTYLE_SECONDARYTEXT, Name: "VS_TEXTSTYLE_SECONDARYTEXT"},
+		{Val: VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_NORMAL, Name: "VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_NORMAL"},
+		{Val: VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_HOT, Name: "VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_HOT"},
+		{Val: VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_PRESSED, Name: "VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_PRESSED"},
+		{Val: VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_DISABLED, Name: "VS_TEXTSTYLE_HYPERLINKTEXT_HYPERLINK_DISABLED"},
+		{Val: VS_TEXTSTYLE_EXPANDED, Name: "VS_TEXTSTYLE_EXPANDED"},
+		{Val: VS_TEXTSTYLE_LABEL, Name: "VS_TEXTSTYLE_LABEL"},
+		{Val: VS_TEXTSTYLE_CONTROLLABEL_CONTROLLABEL_NORMAL, Name: "VS_TEXTSTYLE_CONTROLLABEL_CONTROLLABEL_NORMAL"},
+		{Val: VS_TEXTSTYLE_CONTROLLABEL_CONTROLLABEL_DISABLED, Name: "VS_TEXTSTYLE_CONTROLLABEL_CONTROLLABEL_DISABLED"},
+		{Val: VS_TOOLBAR_BUTTON_NORMAL, Name: "VS_TOOLBAR_BUTTON_NORMAL"},
+		{Val: VS_TOOLBAR_BUTTON_HOT, Name: "VS_TOOLBAR_BUTTON_HOT"},
+		{Val: VS_TOOLBAR_BUTTON_PRESSED, Name: "VS_TOOLBAR_BUTTON_PRESSED"},
+		{Val: VS_TOOLBAR_BUTTON_DISABLED, Name: "VS_TOOLBAR_BUTTON_DISABLED"},
+		{Val: VS_TOOLBAR_BUTTON_CHECKED, Name: "VS_TOOLBAR_BUTTON_CHECKED"},
+		{Val: VS_TOOLBAR_BUTTON_HOTCHECKED, Name: "VS_TOOLBAR_BUTTON_HOTCHECKED"},
+		{Val: VS_TOOLBAR_BUTTON_NEARHOT, Name: "VS_TOOLBAR_BUTTON_NEARHOT"},
+		{Val: VS_TOOLBAR_BUTTON_OTHERSIDEHOT, Name: "VS_TOOLBAR_BUTTON_OTHERSIDEHOT"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_NORMAL, Name: "VS_TOOLBAR_DROPDOWNBUTTON_NORMAL"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_HOT, Name: "VS_TOOLBAR_DROPDOWNBUTTON_HOT"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_PRESSED, Name: "VS_TOOLBAR_DROPDOWNBUTTON_PRESSED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_DISABLED, Name: "VS_TOOLBAR_DROPDOWNBUTTON_DISABLED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_CHECKED, Name: "VS_TOOLBAR_DROPDOWNBUTTON_CHECKED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_HOTCHECKED, Name: "VS_TOOLBAR_DROPDOWNBUTTON_HOTCHECKED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_NEARHOT, Name: "VS_TOOLBAR_DROPDOWNBUTTON_NEARHOT"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTON_OTHERSIDEHOT, Name: "VS_TOOLBAR_DROPDOWNBUTTON_OTHERSIDEHOT"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_NORMAL, Name: "VS_TOOLBAR_SPLITBUTTON_NORMAL"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_HOT, Name: "VS_TOOLBAR_SPLITBUTTON_HOT"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_PRESSED, Name: "VS_TOOLBAR_SPLITBUTTON_PRESSED"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_DISABLED, Name: "VS_TOOLBAR_SPLITBUTTON_DISABLED"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_CHECKED, Name: "VS_TOOLBAR_SPLITBUTTON_CHECKED"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_HOTCHECKED, Name: "VS_TOOLBAR_SPLITBUTTON_HOTCHECKED"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_NEARHOT, Name: "VS_TOOLBAR_SPLITBUTTON_NEARHOT"},
+		{Val: VS_TOOLBAR_SPLITBUTTON_OTHERSIDEHOT, Name: "VS_TOOLBAR_SPLITBUTTON_OTHERSIDEHOT"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_NORMAL, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_NORMAL"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_HOT, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_HOT"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_PRESSED, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_PRESSED"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_DISABLED, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_DISABLED"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_CHECKED, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_CHECKED"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_HOTCHECKED, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_HOTCHECKED"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_NEARHOT, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_NEARHOT"},
+		{Val: VS_TOOLBAR_SPLITBUTTONDROPDOWN_OTHERSIDEHOT, Name: "VS_TOOLBAR_SPLITBUTTONDROPDOWN_OTHERSIDEHOT"},
+		{Val: VS_TOOLBAR_SEPARATOR_NORMAL, Name: "VS_TOOLBAR_SEPARATOR_NORMAL"},
+		{Val: VS_TOOLBAR_SEPARATOR_HOT, Name: "VS_TOOLBAR_SEPARATOR_HOT"},
+		{Val: VS_TOOLBAR_SEPARATOR_PRESSED, Name: "VS_TOOLBAR_SEPARATOR_PRESSED"},
+		{Val: VS_TOOLBAR_SEPARATOR_DISABLED, Name: "VS_TOOLBAR_SEPARATOR_DISABLED"},
+		{Val: VS_TOOLBAR_SEPARATOR_CHECKED, Name: "VS_TOOLBAR_SEPARATOR_CHECKED"},
+		{Val: VS_TOOLBAR_SEPARATOR_HOTCHECKED, Name: "VS_TOOLBAR_SEPARATOR_HOTCHECKED"},
+		{Val: VS_TOOLBAR_SEPARATOR_NEARHOT, Name: "VS_TOOLBAR_SEPARATOR_NEARHOT"},
+		{Val: VS_TOOLBAR_SEPARATOR_OTHERSIDEHOT, Name: "VS_TOOLBAR_SEPARATOR_OTHERSIDEHOT"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_NORMAL, Name: "VS_TOOLBAR_SEPARATORVERT_NORMAL"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_HOT, Name: "VS_TOOLBAR_SEPARATORVERT_HOT"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_PRESSED, Name: "VS_TOOLBAR_SEPARATORVERT_PRESSED"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_DISABLED, Name: "VS_TOOLBAR_SEPARATORVERT_DISABLED"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_CHECKED, Name: "VS_TOOLBAR_SEPARATORVERT_CHECKED"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_HOTCHECKED, Name: "VS_TOOLBAR_SEPARATORVERT_HOTCHECKED"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_NEARHOT, Name: "VS_TOOLBAR_SEPARATORVERT_NEARHOT"},
+		{Val: VS_TOOLBAR_SEPARATORVERT_OTHERSIDEHOT, Name: "VS_TOOLBAR_SEPARATORVERT_OTHERSIDEHOT"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_NORMAL, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_NORMAL"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_HOT, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_HOT"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_PRESSED, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_PRESSED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_DISABLED, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_DISABLED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_CHECKED, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_CHECKED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_HOTCHECKED, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_HOTCHECKED"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_NEARHOT, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_NEARHOT"},
+		{Val: VS_TOOLBAR_DROPDOWNBUTTONGLYPH_OTHERSIDEHOT, Name: "VS_TOOLBAR_DROPDOWNBUTTONGLYPH_OTHERSIDEHOT"},
+		{Val: VS_TOOLTIP_STANDARD_NORMAL, Name: "VS_TOOLTIP_STANDARD_NORMAL"},
+		{Val: VS_TOOLTIP_STANDARD_LINK, Name: "VS_TOOLTIP_STANDARD_LINK"},
+		{Val: VS_TOOLTIP_STANDARDTITLE, Name: "VS_TOOLTIP_STANDARDTITLE"},
+		{Val: VS_TOOLTIP_BALLOON_NORMAL, Name: "VS_TOOLTIP_BALLOON_NORMAL"},
+		{Val: VS_TOOLTIP_BALLOON_LINK, Name: "VS_TOOLTIP_BALLOON_LINK"},
+		{Val: VS_TOOLTIP_BALLOONTITLE, Name: "VS_TOOLTIP_BALLOONTITLE"},
+		{Val: VS_TOOLTIP_CLOSE_NORMAL, Name: "VS_TOOLTIP_CLOSE_NORMAL"},
+		{Val: VS_TOOLTIP_CLOSE_HOT, Name: "VS_TOOLTIP_CLOSE_HOT"},
+		{Val: VS_TOOLTIP_CLOSE_PRESSED, Name: "VS_TOOLTIP_CLOSE_PRESSED"},
+		{Val: VS_TOOLTIP_BALLOONSTEM_POINTINGUPLEFTWALL, Name: "VS_TOOLTIP_BALLOONSTEM_POINTINGUPLEFTWALL"},
+		{Val: VS_TOOLTIP_BALLOONSTEM_POINTINGUPCENTERED, Name: "VS_TOOLTIP_BALLOONSTEM_POINTINGUPCENTERED"},
+		{Val: VS_TOOLTIP_BALLOONSTEM_POINTINGUPRIGHTWALL, Name: "VS_TOOLTIP_BALLOONSTEM_POINTINGUPRIGHTWALL"},
+		{Val: VS_TOOLTIP_BALLOONSTEM_POINTINGDOWNRIGHTWALL, Name: "VS_TOOLTIP_BALLOONSTEM_POINTINGDOWNRIGHTWALL"},
+		{Val: VS_TOOLTIP_BALLOONSTEM_POINTINGDOWNCENTERED, Name: "VS_TOOLTIP_BALLOONSTEM_POINTINGDOWNCENTERED"},
+		{Val: VS_TOOLTIP_BALLOONSTEM_POINTINGDOWNLEFTWALL, Name: "VS_TOOLTIP_BALLOONSTEM_POINTINGDOWNLEFTWALL"},
+		{Val: VS_TOOLTIP_WRENCH_NORMAL, Name: "VS_TOOLTIP_WRENCH_NORMAL"},
+		{Val: VS_TOOLTIP_WRENCH_HOT, Name: "VS_TOOLTIP_WRENCH_HOT"},
+		{Val: VS_TOOLTIP_WRENCH_PRESSED, Name: "VS_TOOLTIP_WRENCH_PRESSED"},
+		{Val: VS_TRACKBAR_TRACK_NORMAL, Name: "VS_TRACKBAR_TRACK_NORMAL"},
+		{Val: VS_TRACKBAR_TRACKVERT_NORMAL, Name: "VS_TRACKBAR_TRACKVERT_NORMAL"},
+		{Val: VS_TRACKBAR_THUMB_NORMAL, Name: "VS_TRACKBAR_THUMB_NORMAL"},
+		{Val: VS_TRACKBAR_THUMB_HOT, Name: "VS_TRACKBAR_THUMB_HOT"},
+		{Val: VS_TRACKBAR_THUMB_PRESSED, Name: "VS_TRACKBAR_THUMB_PRESSED"},
+		{Val: VS_TRACKBAR_THUMB_FOCUSED, Name: "VS_TRACKBAR_THUMB_FOCUSED"},
+		{Val: VS_TRACKBAR_THUMB_DISABLED, Name: "VS_TRACKBAR_THUMB_DISABLED"},
+		{Val: VS_TRACKBAR_THUMBBOTTOM_NORMAL, Name: "VS_TRACKBAR_THUMBBOTTOM_NORMAL"},
+		{Val: VS_TRACKBAR_THUMBBOTTOM_HOT, Name: "VS_TRACKBAR_THUMBBOTTOM_HOT"},
+		{Val: VS_TRACKBAR_THUMBBOTTOM_PRESSED, Name: "VS_TRACKBAR_THUMBBOTTOM_PRESSED"},
+		{Val: VS_TRACKBAR_THUMBBOTTOM_FOCUSED, Name: "VS_TRACKBAR_THUMBBOTTOM_FOCUSED"},
+		{Val: VS_TRACKBAR_THUMBBOTTOM_DISABLED, Name: "VS_TRACKBAR_THUMBBOTTOM_DISABLED"},
+		{Val: VS_TRACKBAR_THUMBTOP_NORMAL, Name: "VS_TRACKBAR_THUMBTOP_NORMAL"},
+		{Val: VS_TRACKBAR_THUMBTOP_HOT, Name: "VS_TRACKBAR_THUMBTOP_HOT"},
+		{Val: VS_TRACKBAR_THUMBTOP_PRESSED, Name: "VS_TRACKBAR_THUMBTOP_PRESSED"},
+		{Val: VS_TRACKBAR_THUMBTOP_FOCUSED, Name: "VS_TRACKBAR_THUMBTOP_FOCUSED"},
+		{Val: VS_TRACKBAR_THUMBTOP_DISABLED, Name: "VS_TRACKBAR_THUMBTOP_DISABLED"},
+		{Val: VS_TRACKBAR_THUMBVERT_NORMAL, Name: "VS_TRACKBAR_THUMBVERT_NORMAL"},
+		{Val: VS_TRACKBAR_THUMBVERT_HOT, Name: "VS_TRACKBAR_THUMBVERT_HOT"},
+		{Val: VS_TRACKBAR_THUMBVERT_PRESSED, Name: "VS_TRACKBAR_THUMBVERT_PRESSED"},
+		{Val: VS_TRACKBAR_THUMBVERT_FOCUSED, Name: "VS_TRACKBAR_THUMBVERT_FOCUSED"},
+		{Val: VS_TRACKBAR_THUMBVERT_DISABLED, Name: "VS_TRACKBAR_THUMBVERT_DISABLED"},
+		{Val: VS_TRACKBAR_THUMBLEFT_NORMAL, Name: "VS_TRACKBAR_THUMBLEFT_NORMAL"},
+		{Val: VS_TRACKBAR_THUMBLEFT_HOT, Name: "VS_TRACKBAR_THUMBLEFT_HOT"},
+		{Val: VS_TRACKBAR_THUMBLEFT_PRESSED, Name: "VS_TRACKBAR_THUMBLEFT_PRESSED"},
+		{Val: VS_TRACKBAR_THUMBLEFT_FOCUSED, Name: "VS_TRACKBAR_THUMBLEFT_FOCUSED"},
+		{Val: VS_TRACKBAR_THUMBLEFT_DISABLED, Name: "VS_TRACKBAR_THUMBLEFT_DISABLED"},
+		{Val: VS_TRACKBAR_THUMBRIGHT_NORMAL, Name: "VS_TRACKBAR_THUMBRIGHT_NORMAL"},
+		{Val: VS_TRACKBAR_THUMBRIGHT_HOT, Name: "VS_TRACKBAR_THUMBRIGHT_HOT"},
+		{Val: VS_TRACKBAR_THUMBRIGHT_PRESSED, Name: "VS_TRACKBAR_THUMBRIGHT_PRESSED"},
+		{Val: VS_TRACKBAR_THUMBRIGHT_FOCUSED, Name: "VS_TRACKBAR_THUMBRIGHT_FOCUSED"},
+		{Val: VS_TRACKBAR_THUMBRIGHT_DISABLED, Name: "VS_TRACKBAR_THUMBRIGHT_DISABLED"},
+		{Val: VS_TRACKBAR_TICS_NORMAL, Name: "VS_TRACKBAR_TICS_NORMAL"},
+		{Val: VS_TRACKBAR_TICSVERT_NORMAL, Name: "VS_TRACKBAR_TICSVERT_NORMAL"},
+		{Val: VS_TREEVIEW_TREEITEM_NORMAL, Name: "VS_TREEVIEW_TREEITEM_NORMAL"},
+		{Val: VS_TREEVIEW_TREEITEM_HOT, Name: "VS_TREEVIEW_TREEITEM_HOT"},
+		{Val: VS_TREEVIEW_TREEITEM_SELECTED, Name: "VS_TREEVIEW_TREEITEM_SELECTED"},
+		{Val: VS_TREEVIEW_TREEITEM_DISABLED, Name: "VS_TREEVIEW_TREEITEM_DISABLED"},
+		{Val: VS_TREEVIEW_TREEITEM_SELECTEDNOTFOCUS, Name: "VS_TREEVIEW_TREEITEM_SELECTEDNOTFOCUS"},
+		{Val: VS_TREEVIEW_TREEITEM_HOTSELECTED, Name: "VS_TREEVIEW_TREEITEM_HOTSELECTED"},
+		{Val: VS_TREEVIEW_GLYPH_CLOSED, Name: "VS_TREEVIEW_GLYPH_CLOSED"},
+		{Val: VS_TREEVIEW_GLYPH_OPENED, Name: "VS_TREEVIEW_GLYPH_OPENED"},
+		{Val: VS_TREEVIEW_BRANCH, Name: "VS_TREEVIEW_BRANCH"},
+		{Val: VS_TREEVIEW_HOTGLYPH_CLOSED, Name: "VS_TREEVIEW_HOTGLYPH_CLOSED"},
+		{Val: VS_TREEVIEW_HOTGLYPH_OPENED, Name: "VS_TREEVIEW_HOTGLYPH_OPENED"},
+		{Val: VS_USERTILE_STROKEBACKGROUND, Name: "VS_USERTILE_STROKEBACKGROUND"},
+		{Val: VS_USERTILE_HOVERBACKGROUND_NORMAL, Name: "VS_USERTILE_HOVERBACKGROUND_NORMAL"},
+		{Val: VS_USERTILE_HOVERBACKGROUND_HOT, Name: "VS_USERTILE_HOVERBACKGROUND_HOT"},
+		{Val: VS_USERTILE_HOVERBACKGROUND_PRESSED, Name: "VS_USERTILE_HOVERBACKGROUND_PRESSED"},
+		{Val: VS_TEXTSELECTIONGRIPPER_GRIPPER_NORMAL, Name: "VS_TEXTSELECTIONGRIPPER_GRIPPER_NORMAL"},
+		{Val: VS_TEXTSELECTIONGRIPPER_GRIPPER_CENTERED, Name: "VS_TEXTSELECTIONGRIPPER_GRIPPER_CENTERED"},
+		{Val: VS_WINDOW_CAPTION_ACTIVE, Name: "VS_WINDOW_CAPTION_ACTIVE"},
+		{Val: VS_WINDOW_CAPTION_INACTIVE, Name: "VS_WINDOW_CAPTION_INACTIVE"},
+		{Val: VS_WINDOW_CAPTION_DISABLED, Name: "VS_WINDOW_CAPTION_DISABLED"},
+		{Val: VS_WINDOW_SMALLCAPTION_ACTIVE, Name: "VS_WINDOW_SMALLCAPTION_ACTIVE"},
+		{Val: VS_WINDOW_SMALLCAPTION_INACTIVE, Name: "VS_WINDOW_SMALLCAPTION_INACTIVE"},
+		{Val: VS_WINDOW_SMALLCAPTION_DISABLED, Name: "VS_WINDOW_SMALLCAPTION_DISABLED"},
+		{Val: VS_WINDOW_MINCAPTION_ACTIVE, Name: "VS_WINDOW_MINCAPTION_ACTIVE"},
+		{Val: VS_WINDOW_MINCAPTION_INACTIVE, Name: "VS_WINDOW_MINCAPTION_INACTIVE"},
+		{Val: VS_WINDOW_MINCAPTION_DISABLED, Name: "VS_WINDOW_MINCAPTION_DISABLED"},
+		{Val: VS_WINDOW_SMALLMINCAPTION, Name: "VS_WINDOW_SMALLMINCAPTION"},
+		{Val: VS_WINDOW_MAXCAPTION_ACTIVE, Name: "VS_WINDOW_MAXCAPTION_ACTIVE"},
+		{Val: VS_WINDOW_MAXCAPTION_INACTIVE, Name: "VS_WINDOW_MAXCAPTION_INACTIVE"},
+		{Val: VS_WINDOW_MAXCAPTION_DISABLED, Name: "VS_WINDOW_MAXCAPTION_DISABLED"},
+		{Val: VS_WINDOW_SMALLMAXCAPTION, Name: "VS_WINDOW_SMALLMAXCAPTION"},
+		{Val: VS_WINDOW_FRAMELEFT_ACTIVE, Name: "VS_WINDOW_FRAMELEFT_ACTIVE"},
+		{Val: VS_WINDOW_FRAMELEFT_INACTIVE, Name: "VS_WINDOW_FRAMELEFT_INACTIVE"},
+		{Val: VS_WINDOW_FRAMERIGHT_ACTIVE, Name: "VS_WINDOW_FRAMERIGHT_ACTIVE"},
+		{Val: VS_WINDOW_FRAMERIGHT_INACTIVE, Name: "VS_WINDOW_FRAMERIGHT_INACTIVE"},
+		{Val: VS_WINDOW_FRAMEBOTTOM_ACTIVE, Name: "VS_WINDOW_FRAMEBOTTOM_ACTIVE"},
+		{Val: VS_WINDOW_FRAMEBOTTOM_INACTIVE, Name: "VS_WINDOW_FRAMEBOTTOM_INACTIVE"},
+		{Val: VS_WINDOW_SMALLFRAMELEFT_ACTIVE, Name: "VS_WINDOW_SMALLFRAMELEFT_ACTIVE"},
+		{Val: VS_WINDOW_SMALLFRAMELEFT_INACTIVE, Name: "VS_WINDOW_SMALLFRAMELEFT_INACTIVE"},
+		{Val: VS_WINDOW_SMALLFRAMERIGHT_ACTIVE, Name: "VS_WINDOW_SMALLFRAMERIGHT_ACTIVE"},
+		{Val: VS_WINDOW_SMALLFRAMERIGHT_INACTIVE, Name: "VS_WINDOW_SMALLFRAMERIGHT_INACTIVE"},
+		{Val: VS_WINDOW_SMALLFRAMEBOTTOM_ACTIVE, Name: "VS_WINDOW_SMALLFRAMEBOTTOM_ACTIVE"},
+		{Val: VS_WINDOW_SMALLFRAMEBOTTOM_INACTIVE, Name: "VS_WINDOW_SMALLFRAMEBOTTOM_INACTIVE"},
+		{Val: VS_WINDOW_SYSBUTTON_NORMAL, Name: "VS_WINDOW_SYSBUTTON_NORMAL"},
+		{Val: VS_WINDOW_SYSBUTTON_HOT, Name: "VS_WINDOW_SYSBUTTON_HOT"},
+		{Val: VS_WINDOW_SYSBUTTON_PUSHED, Name: "VS_WINDOW_SYSBUTTON_PUSHED"},
+		{Val: VS_WINDOW_SYSBUTTON_DISABLED, Name: "VS_WINDOW_SYSBUTTON_DISABLED"},
+		{Val: VS_WINDOW_MDISYSBUTTON, Name: "VS_WINDOW_MDISYSBUTTON"},
+		{Val: VS_WINDOW_MINBUTTON_NORMAL, Name: "VS_WINDOW_MINBUTTON_NORMAL"},
+		{Val: VS_WINDOW_MINBUTTON_HOT, Name: "VS_WINDOW_MINBUTTON_HOT"},
+		{Val: VS_WINDOW_MINBUTTON_PUSHED, Name: "VS_WINDOW_MINBUTTON_PUSHED"},
+		{Val: VS_WINDOW_MINBUTTON_DISABLED, Name: "VS_WINDOW_MINBUTTON_DISABLED"},
+		{Val: VS_WINDOW_MDIMINBUTTON_NORMAL, Name: "VS_WINDOW_MDIMINBUTTON_NORMAL"},
+		{Val: VS_WINDOW_MDIMINBUTTON_HOT, Name: "VS_WINDOW_MDIMINBUTTON_HOT"},
+		{Val: VS_WINDOW_MDIMINBUTTON_PUSHED, Name: "VS_WINDOW_MDIMINBUTTON_PUSHED"},
+		{Val: VS_WINDOW_MDIMINBUTTON_DISABLED, Name: "VS_WINDOW_MDIMINBUTTON_DISABLED"},
+		{Val: VS_WINDOW_MAXBUTTON_NORMAL, Name: "VS_WINDOW_MAXBUTTON_NORMAL"},
+		{Val: VS_WINDOW_MAXBUTTON_HOT, Name: "VS_WINDOW_MAXBUTTON_HOT"},
+		{Val: VS_WINDOW_MAXBUTTON_PUSHED, Name: "VS_WINDOW_MAXBUTTON_PUSHED"},
+		{Val: VS_WINDOW_MAXBUTTON_DISABLED, Name: "VS_WINDOW_MAXBUTTON_DISABLED"},
+		{Val: VS_WINDOW_CLOSEBUTTON_NORMAL, Name: "VS_WINDOW_CLOSEBUTTON_NORMAL"},
+		{Val: VS_WINDOW_CLOSEBUTTON_HOT, Name: "VS_WINDOW_CLOSEBUTTON_HOT"},
+		{Val: VS_WINDOW_CLOSEBUTTON_PUSHED, Name: "VS_WINDOW_CLOSEBUTTON_PUSHED"},
+		{Val: VS_WINDOW_CLOSEBUTTON_DISABLED, Name: "VS_WINDOW_CLOSEBUTTON_DISABLED"},
+		{Val: VS_WINDOW_SMALLCLOSEBUTTON_NORMAL, Name: "VS_WINDOW_SMALLCLOSEBUTTON_NORMAL"},
+		{Val: VS_WINDOW_SMALLCLOSEBUTTON_HOT, Name: "VS_WINDOW_SMALLCLOSEBUTTON_HOT"},
+		{Val: VS_WINDOW_SMALLCLOSEBUTTON_PUSHED, Name: "VS_WINDOW_SMALLCLOSEBUTTON_PUSHED"},
+		{Val: VS_WINDOW_SMALLCLOSEBUTTON_DISABLED, Name: "VS_WINDOW_SMALLCLOSEBUTTON_DISABLED"},
+		{Val: VS_WINDOW_MDICLOSEBUTTON_NORMAL, Name: "VS_WINDOW_MDICLOSEBUTTON_NORMAL"},
+		{Val: VS_WINDOW_MDICLOSEBUTTON_HOT, Name: "VS_WINDOW_MDICLOSEBUTTON_HOT"},
+		{Val: VS_WINDOW_MDICLOSEBUTTON_PUSHED, Name: "VS_WINDOW_MDICLOSEBUTTON_PUSHED"},
+		{Val: VS_WINDOW_MDICLOSEBUTTON_DISABLED, Name: "VS_WINDOW_MDICLOSEBUTTON_DISABLED"},
+		{Val: VS_WINDOW_RESTOREBUTTON_NORMAL, Name: "VS_WINDOW_RESTOREBUTTON_NORMAL"},
+		{Val: VS_WINDOW_RESTOREBUTTON_HOT, Name: "VS_WINDOW_RESTOREBUTTON_HOT"},
+		{Val: VS_WINDOW_RESTOREBUTTON_PUSHED, Name: "VS_WINDOW_RESTOREBUTTON_PUSHED"},
+		{Val: VS_WINDOW_RESTOREBUTTON_DISABLED, Name: "VS_WINDOW_RESTOREBUTTON_DISABLED"},
+		{Val: VS_WINDOW_MDIRESTOREBUTTON_NORMAL, Name: "VS_WINDOW_MDIRESTOREBUTTON_NORMAL"},
+		{Val: VS_WINDOW_MDIRESTOREBUTTON_HOT, Name: "VS_WINDOW_MDIRESTOREBUTTON_HOT"},
+		{Val: VS_WINDOW_MDIRESTOREBUTTON_PUSHED, Name: "VS_WINDOW_MDIRESTOREBUTTON_PUSHED"},
+		{Val: VS_WINDOW_MDIRESTOREBUTTON_DISABLED, Name: "VS_WINDOW_MDIRESTOREBUTTON_DISABLED"},
+		{Val: VS_WINDOW_HELPBUTTON_NORMAL, Name: "VS_WINDOW_HELPBUTTON_NORMAL"},
+		{Val: VS_WINDOW_HELPBUTTON_HOT, Name: "VS_WINDOW_HELPBUTTON_HOT"},
+		{Val: VS_WINDOW_HELPBUTTON_PUSHED, Name: "VS_WINDOW_HELPBUTTON_PUSHED"},
+		{Val: VS_WINDOW_HELPBUTTON_DISABLED, Name: "VS_WINDOW_HELPBUTTON_DISABLED"},
+		{Val: VS_WINDOW_MDIHELPBUTTON, Name: "VS_WINDOW_MDIHELPBUTTON"},
+		{Val: VS_WINDOW_HORZSCROLL_NORMAL, Name: "VS_WINDOW_HORZSCROLL_NORMAL"},
+		{Val: VS_WINDOW_HORZSCROLL_HOT, Name: "VS_WINDOW_HORZSCROLL_HOT"},
+		{Val: VS_WINDOW_HORZSCROLL_PUSHED, Name: "VS_WINDOW_HORZSCROLL_PUSHED"},
+		{Val: VS_WINDOW_HORZSCROLL_DISABLED, Name: "VS_WINDOW_HORZSCROLL_DISABLED"},
+		{Val: VS_WINDOW_HORZTHUMB_NORMAL, Name: "VS_WINDOW_HORZTHUMB_NORMAL"},
+		{Val: VS_WINDOW_HORZTHUMB_HOT, Name: "VS_WINDOW_HORZTHUMB_HOT"},
+		{Val: VS_WINDOW_HORZTHUMB_PUSHED, Name: "VS_WINDOW_HORZTHUMB_PUSHED"},
+		{Val: VS_WINDOW_HORZTHUMB_DISABLED, Name: "VS_WINDOW_HORZTHUMB_DISABLED"},
+		{Val: VS_WINDOW_VERTSCROLL_NORMAL, Name: "VS_WINDOW_VERTSCROLL_NORMAL"},
+		{Val: VS_WINDOW_VERTSCROLL_HOT, Name: "VS_WINDOW_VERTSCROLL_HOT"},
+		{Val: VS_WINDOW_VERTSCROLL_PUSHED, Name: "VS_WINDOW_VERTSCROLL_PUSHED"},
+		{Val: VS_WINDOW_VERTSCROLL_DISABLED, Name: "VS_WINDOW_VERTSCROLL_DISABLED"},
+		{Val: VS_WINDOW_VERTTHUMB_NORMAL, Name: "VS_WINDOW_VERTTHUMB_NORMAL"},
+		{Val: VS_WINDOW_VERTTHUMB_HOT, Name: "VS_WINDOW_VERTTHUMB_HOT"},
+		{Val: VS_WINDOW_VERTTHUMB_PUSHED, Name: "VS_WINDOW_VERTTHUMB_PUSHED"},
+		{Val: VS_WINDOW_VERTTHUMB_DISABLED, Name: "VS_WINDOW_VERTTHUMB_DISABLED"},
+		{Val: VS_WINDOW_DIALOG, Name: "VS_WINDOW_DIALOG"},
+		{Val: VS_WINDOW_CAPTIONSIZINGTEMPLATE, Name: "VS_WINDOW_CAPTIONSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_SMALLCAPTIONSIZINGTEMPLATE, Name: "VS_WINDOW_SMALLCAPTIONSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_FRAMELEFTSIZINGTEMPLATE, Name: "VS_WINDOW_FRAMELEFTSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_SMALLFRAMELEFTSIZINGTEMPLATE, Name: "VS_WINDOW_SMALLFRAMELEFTSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_FRAMERIGHTSIZINGTEMPLATE, Name: "VS_WINDOW_FRAMERIGHTSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_SMALLFRAMERIGHTSIZINGTEMPLATE, Name: "VS_WINDOW_SMALLFRAMERIGHTSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_FRAMEBOTTOMSIZINGTEMPLATE, Name: "VS_WINDOW_FRAMEBOTTOMSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_SMALLFRAMEBOTTOMSIZINGTEMPLATE, Name: "VS_WINDOW_SMALLFRAMEBOTTOMSIZINGTEMPLATE"},
+		{Val: VS_WINDOW_FRAME_ACTIVE, Name: "VS_WINDOW_FRAME_ACTIVE"},
+		{Val: VS_WINDOW_FRAME_INACTIVE, Name: "VS_WINDOW_FRAME_INACTIVE"},
+		{Val: VS_WINDOW_BORDER, Name: "VS_WINDOW_BORDER"},
+		{Val: VS_LINK_HYPERLINK_NORMALTEXT, Name: "VS_LINK_HYPERLINK_NORMALTEXT"},
+		{Val: VS_LINK_HYPERLINK_LINKTEXT, Name: "VS_LINK_HYPERLINK_LINKTEXT"},
+		{Val: VS_EMPTYMARKUP_MARKUPTEXT_NORMALTEXT, Name: "VS_EMPTYMARKUP_MARKUPTEXT_NORMALTEXT"},
+		{Val: VS_EMPTYMARKUP_MARKUPTEXT_LINKTEXT, Name: "VS_EMPTYMARKUP_MARKUPTEXT_LINKTEXT"},
+		{Val: VS_STATIC_TEXT, Name: "VS_STATIC_TEXT"},
+		{Val: VS_PAGE_UP, Name: "VS_PAGE_UP"},
+		{Val: VS_PAGE_DOWN, Name: "VS_PAGE_DOWN"},
+		{Val: VS_PAGE_UPHORZ, Name: "VS_PAGE_UPHORZ"},
+		{Val: VS_PAGE_DOWNHORZ, Name: "VS_PAGE_DOWNHORZ"},
+		{Val: VS_MONTHCAL_BACKGROUND, Name: "VS_MONTHCAL_BACKGROUND"},
+		{Val: VS_MONTHCAL_BORDERS, Name: "VS_MONTHCAL_BORDERS"},
+		{Val: VS_MONTHCAL_GRIDBACKGROUND, Name: "VS_MONTHCAL_GRIDBACKGROUND"},
+		{Val: VS_MONTHCAL_COLHEADERSPLITTER, Name: "VS_MONTHCAL_COLHEADERSPLITTER"},
+		{Val: VS_MONTHCAL_GRIDCELLBACKGROUND_SELECTED, Name: "VS_MONTHCAL_GRIDCELLBACKGROUND_SELECTED"},
+		{Val: VS_MONTHCAL_GRIDCELLBACKGROUND_HOT, Name: "VS_MONTHCAL_GRIDCELLBACKGROUND_HOT"},
+		{Val: VS_MONTHCAL_GRIDCELLBACKGROUND_SELECTEDHOT, Name: "VS_MONTHCAL_GRIDCELLBACKGROUND_SELECTEDHOT"},
+		{Val: VS_MONTHCAL_GRIDCELLBACKGROUND_SELECTEDNOTFOCUSED, Name: "VS_MONTHCAL_GRIDCELLBACKGROUND_SELECTEDNOTFOCUSED"},
+		{Val: VS_MONTHCAL_GRIDCELLBACKGROUND_TODAY, Name: "VS_MONTHCAL_GRIDCELLBACKGROUND_TODAY"},
+		{Val: VS_MONTHCAL_GRIDCELLBACKGROUND_TODAYSELECTED, Name: "VS_MONTHCAL_GRIDCELLBACKGROUND_TODAYSELECTED"},
+		{Val: VS_MONTHCAL_GRIDCELL_HOT, Name: "VS_MONTHCAL_GRIDCELL_HOT"},
+		{Val: VS_MONTHCAL_GRIDCELL_HASSTATE, Name: "VS_MONTHCAL_GRIDCELL_HASSTATE"},
+		{Val: VS_MONTHCAL_GRIDCELL_HASSTATEHOT, Name: "VS_MONTHCAL_GRIDCELL_HASSTATEHOT"},
+		{Val: VS_MONTHCAL_GRIDCELL_TODAY, Name: "VS_MONTHCAL_GRIDCELL_TODAY"},
+		{Val: VS_MONTHCAL_GRIDCELL_TODAYSELECTED, Name: "VS_MONTHCAL_GRIDCELL_TODAYSELECTED"},
+		{Val: VS_MONTHCAL_GRIDCELL_SELECTED, Name: "VS_MONTHCAL_GRIDCELL_SELECTED"},
+		{Val: VS_MONTHCAL_GRIDCELL_SELECTEDHOT, Name: "VS_MONTHCAL_GRIDCELL_SELECTEDHOT"},
+		{Val: VS_MONTHCAL_GRIDCELLUPPER_HOT, Name: "VS_MONTHCAL_GRIDCELLUPPER_HOT"},
+		{Val: VS_MONTHCAL_GRIDCELLUPPER_HASSTATE, Name: "VS_MONTHCAL_GRIDCELLUPPER_HASSTATE"},
+		{Val: VS_MONTHCAL_GRIDCELLUPPER_HASSTATEHOT, Name: "VS_MONTHCAL_GRIDCELLUPPER_HASSTATEHOT"},
+		{Val: VS_MONTHCAL_GRIDCELLUPPER_SELECTED, Name: "VS_MONTHCAL_GRIDCELLUPPER_SELECTED"},
+		{Val: VS_MONTHCAL_GRIDCELLUPPER_SELECTEDHOT, Name: "VS_MONTHCAL_GRIDCELLUPPER_SELECTEDHOT"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELL_HOT, Name: "VS_MONTHCAL_TRAILINGGRIDCELL_HOT"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELL_HASSTATE, Name: "VS_MONTHCAL_TRAILINGGRIDCELL_HASSTATE"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELL_HASSTATEHOT, Name: "VS_MONTHCAL_TRAILINGGRIDCELL_HASSTATEHOT"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELL_TODAY, Name: "VS_MONTHCAL_TRAILINGGRIDCELL_TODAY"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELL_TODAYSELECTED, Name: "VS_MONTHCAL_TRAILINGGRIDCELL_TODAYSELECTED"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELL_SELECTED, Name: "VS_MONTHCAL_TRAILINGGRIDCELL_SELECTED"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELL_SELECTEDHOT, Name: "VS_MONTHCAL_TRAILINGGRIDCELL_SELECTEDHOT"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELLUPPER_HOT, Name: "VS_MONTHCAL_TRAILINGGRIDCELLUPPER_HOT"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELLUPPER_HASSTATE, Name: "VS_MONTHCAL_TRAILINGGRIDCELLUPPER_HASSTATE"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELLUPPER_HASSTATEHOT, Name: "VS_MONTHCAL_TRAILINGGRIDCELLUPPER_HASSTATEHOT"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELLUPPER_SELECTED, Name: "VS_MONTHCAL_TRAILINGGRIDCELLUPPER_SELECTED"},
+		{Val: VS_MONTHCAL_TRAILINGGRIDCELLUPPER_SELECTEDHOT, Name: "VS_MONTHCAL_TRAILINGGRIDCELLUPPER_SELECTEDHOT"},
+		{Val: VS_MONTHCAL_NAVNEXT_NORMAL, Name: "VS_MONTHCAL_NAVNEXT_NORMAL"},
+		{Val: VS_MONTHCAL_NAVNEXT_HOT, Name: "VS_MONTHCAL_NAVNEXT_HOT"},
+		{Val: VS_MONTHCAL_NAVNEXT_PRESSED, Name: "VS_MONTHCAL_NAVNEXT_PRESSED"},
+		{Val: VS_MONTHCAL_NAVNEXT_DISABLED, Name: "VS_MONTHCAL_NAVNEXT_DISABLED"},
+		{Val: VS_MONTHCAL_NAVPREV_NORMAL, Name: "VS_MONTHCAL_NAVPREV_NORMAL"},
+		{Val: VS_MONTHCAL_NAVPREV_HOT, Name: "VS_MONTHCAL_NAVPREV_HOT"},
+		{Val: VS_MONTHCAL_NAVPREV_PRESSED, Name: "VS_MONTHCAL_NAVPREV_PRESSED"},
+		{Val: VS_MONTHCAL_NAVPREV_DISABLED, Name: "VS_MONTHCAL_NAVPREV_DISABLED"},
+		{Val: VS_CLOCK_TIME_NORMAL, Name: "VS_CLOCK_TIME_NORMAL"},
+		{Val: VS_CLOCK_TIME_HOT, Name: "VS_CLOCK_TIME_HOT"},
+		{Val: VS_CLOCK_TIME_PRESSED, Name: "VS_CLOCK_TIME_PRESSED"},
+		{Val: VS_TRAYNOTIFY_BACKGROUND, Name: "VS_TRAYNOTIFY_BACKGROUND"},
+		{Val: VS_TRAYNOTIFY_ANIMBACKGROUND, Name: "VS_TRAYNOTIFY_ANIMBACKGROUND"},
+		{Val: VS_TASKBAR_BACKGROUNDBOTTOM, Name: "VS_TASKBAR_BACKGROUNDBOTTOM"},
+		{Val: VS_TASKBAR_BACKGROUNDRIGHT, Name: "VS_TASKBAR_BACKGROUNDRIGHT"},
+		{Val: VS_TASKBAR_BACKGROUNDTOP, Name: "VS_TASKBAR_BACKGROUNDTOP"},
+		{Val: VS_TASKBAR_BACKGROUNDLEFT, Name: "VS_TASKBAR_BACKGROUNDLEFT"},
+		{Val: VS_TASKBAR_SIZINGBARBOTTOM, Name: "VS_TASKBAR_SIZINGBARBOTTOM"},
+		{Val: VS_TASKBAR_SIZINGBARRIGHT, Name: "VS_TASKBAR_SIZINGBARRIGHT"},
+		{Val: VS_TASKBAR_SIZINGBARTOP, Name: "VS_TASKBAR_SIZINGBARTOP"},
+		{Val: VS_TASKBAR_SIZINGBARLEFT, Name: "VS_TASKBAR_SIZINGBARLEFT"},
+		{Val: VS_TASKBAND_GROUPCOUNT, Name: "VS_TASKBAND_GROUPCOUNT"},
+		{Val: VS_TASKBAND_FLASHBUTTON, Name: "VS_TASKBAND_FLASHBUTTON"},
+		{Val: VS_TASKBAND_FLASHBUTTONGROUPMENU, Name: "VS_TASKBAND_FLASHBUTTONGROUPMENU"},
+		{Val: VS_STARTPANEL_USERPANE, Name: "VS_STARTPANEL_USERPANE"},
+		{Val: VS_STARTPANEL_MOREPROGRAMS, Name: "VS_STARTPANEL_MOREPROGRAMS"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSARROW_NORMAL, Name: "VS_STARTPANEL_MOREPROGRAMSARROW_NORMAL"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSARROW_HOT, Name: "VS_STARTPANEL_MOREPROGRAMSARROW_HOT"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSARROW_PRESSED, Name: "VS_STARTPANEL_MOREPROGRAMSARROW_PRESSED"},
+		{Val: VS_STARTPANEL_PROGLIST, Name: "VS_STARTPANEL_PROGLIST"},
+		{Val: VS_STARTPANEL_PROGLISTSEPARATOR, Name: "VS_STARTPANEL_PROGLISTSEPARATOR"},
+		{Val: VS_STARTPANEL_PLACESLIST, Name: "VS_STARTPANEL_PLACESLIST"},
+		{Val: VS_STARTPANEL_PLACESLISTSEPARATOR, Name: "VS_STARTPANEL_PLACESLISTSEPARATOR"},
+		{Val: VS_STARTPANEL_LOGOFF, Name: "VS_STARTPANEL_LOGOFF"},
+		{Val: VS_STARTPANEL_LOGOFFBUTTONS_NORMAL, Name: "VS_STARTPANEL_LOGOFFBUTTONS_NORMAL"},
+		{Val: VS_STARTPANEL_LOGOFFBUTTONS_HOT, Name: "VS_STARTPANEL_LOGOFFBUTTONS_HOT"},
+		{Val: VS_STARTPANEL_LOGOFFBUTTONS_PRESSED, Name: "VS_STARTPANEL_LOGOFFBUTTONS_PRESSED"},
+		{Val: VS_STARTPANEL_USERPICTURE, Name: "VS_STARTPANEL_USERPICTURE"},
+		{Val: VS_STARTPANEL_PREVIEW, Name: "VS_STARTPANEL_PREVIEW"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSTAB_NORMAL, Name: "VS_STARTPANEL_MOREPROGRAMSTAB_NORMAL"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSTAB_HOT, Name: "VS_STARTPANEL_MOREPROGRAMSTAB_HOT"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSTAB_SELECTED, Name: "VS_STARTPANEL_MOREPROGRAMSTAB_SELECTED"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSTAB_DISABLED, Name: "VS_STARTPANEL_MOREPROGRAMSTAB_DISABLED"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSTAB_FOCUSED, Name: "VS_STARTPANEL_MOREPROGRAMSTAB_FOCUSED"},
+		{Val: VS_STARTPANEL_NSCHOST, Name: "VS_STARTPANEL_NSCHOST"},
+		{Val: VS_STARTPANEL_SOFTWAREEXPLORER_NORMAL, Name: "VS_STARTPANEL_SOFTWAREEXPLORER_NORMAL"},
+		{Val: VS_STARTPANEL_SOFTWAREEXPLORER_HOT, Name: "VS_STARTPANEL_SOFTWAREEXPLORER_HOT"},
+		{Val: VS_STARTPANEL_SOFTWAREEXPLORER_SELECTED, Name: "VS_STARTPANEL_SOFTWAREEXPLORER_SELECTED"},
+		{Val: VS_STARTPANEL_SOFTWAREEXPLORER_DISABLED, Name: "VS_STARTPANEL_SOFTWAREEXPLORER_DISABLED"},
+		{Val: VS_STARTPANEL_SOFTWAREEXPLORER_FOCUSED, Name: "VS_STARTPANEL_SOFTWAREEXPLORER_FOCUSED"},
+		{Val: VS_STARTPANEL_OPENBOX_NORMAL, Name: "VS_STARTPANEL_OPENBOX_NORMAL"},
+		{Val: VS_STARTPANEL_OPENBOX_HOT, Name: "VS_STARTPANEL_OPENBOX_HOT"},
+		{Val: VS_STARTPANEL_OPENBOX_SELECTED, Name: "VS_STARTPANEL_OPENBOX_SELECTED"},
+		{Val: VS_STARTPANEL_OPENBOX_DISABLED, Name: "VS_STARTPANEL_OPENBOX_DISABLED"},
+		{Val: VS_STARTPANEL_OPENBOX_FOCUSED, Name: "VS_STARTPANEL_OPENBOX_FOCUSED"},
+		{Val: VS_STARTPANEL_SEARCHVIEW, Name: "VS_STARTPANEL_SEARCHVIEW"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSARROWBACK_NORMAL, Name: "VS_STARTPANEL_MOREPROGRAMSARROWBACK_NORMAL"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSARROWBACK_HOT, Name: "VS_STARTPANEL_MOREPROGRAMSARROWBACK_HOT"},
+		{Val: VS_STARTPANEL_MOREPROGRAMSARROWBACK_PRESSED, Name: "VS_STARTPANEL_MOREPROGRAMSARROWBACK_PRESSED"},
+		{Val: VS_STARTPANEL_TOPMATCH, Name: "VS_STARTPANEL_TOPMATCH"},
+		{Val: VS_STARTPANEL_LOGOFFSPLITBUTTONDROPDOWN, Name: "VS_STARTPANEL_LOGOFFSPLITBUTTONDROPDOWN"},
+		{Val: VS_MENUBAND_NEWAPPBUTTON_NORMAL, Name: "VS_MENUBAND_NEWAPPBUTTON_NORMAL"},
+		{Val: VS_MENUBAND_NEWAPPBUTTON_HOT, Name: "VS_MENUBAND_NEWAPPBUTTON_HOT"},
+		{Val: VS_MENUBAND_NEWAPPBUTTON_PRESSED, Name: "VS_MENUBAND_NEWAPPBUTTON_PRESSED"},
+		{Val: VS_MENUBAND_NEWAPPBUTTON_DISABLED, Name: "VS_MENUBAND_NEWAPPBUTTON_DISABLED"},
+		{Val: VS_MENUBAND_NEWAPPBUTTON_CHECKED, Name: "VS_MENUBAND_NEWAPPBUTTON_CHECKED"},
+		{Val: VS_MENUBAND_NEWAPPBUTTON_HOTCHECKED, Name: "VS_MENUBAND_NEWAPPBUTTON_HOTCHECKED"},
+		{Val: VS_MENUBAND_SEPARATOR, Name: "VS_MENUBAND_SEPARATOR"},
+	},
+}
+
+// Returns the name of the constant, like "VS_AEROWIZARD_TITLEBAR_S_TITLEBAR_ACTIVE".
+func (v VS) String() string {
+	return _VS_names.String(v)
+}
+
+// Parses the name of a [VS] constant, or a number.
+func ParseVS(s string) (VS, error) {
+	return _VS_names.Parse(s)
+}
+
+var _DWM_BB_names = enum.Flags[DWM_BB]{
+	Type: "DWM_BB",
+	Values: []enum.Name[DWM_BB]{
+		{Val: DWM_BB_ENABLE, Name: "DWM_BB_ENABLE"},
+		{Val: DWM_BB_BLURREGION, Name: "DWM_BB_BLURREGION"},
+		{Val: DWM_BB_TRANSITIONONMAXIMIZED, Name: "DWM_BB_TRANSITIONONMAXIMIZED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DWM_BB_ENABLE|DWM_BB_BLURREGION".
+func (v DWM_BB) String() string {
+	return _DWM_BB_names.String(v)
+}
+
+// Parses names of [DWM_BB] flags joined by "|", or numbers.
+func ParseDWM_BB(s string) (DWM_BB, error) {
+	return _DWM_BB_names.Parse(s)
+}
+
+var _DWM_CLOAKED_names = enum.Flags[DWM_CLOAKED]{
+	Type: "DWM_CLOAKED",
+	Values: []enum.Name[DWM_CLOAKED]{
+		{Val: DWM_CLOAKED_APP, Name: "DWM_CLOAKED_APP"},
+		{Val: DWM_CLOAKED_SHELL, Name: "DWM_CLOAKED_SHELL"},
+		{Val: DWM_CLOAKED_INHERITED, Name: "DWM_CLOAKED_INHERITED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DWM_CLOAKED_APP|DWM_CLOAKED_SHELL".
+func (v DWM_CLOAKED) String() string {
+	return _DWM_CLOAKED_names.String(v)
+}
+
+// Parses names of [DWM_CLOAKED] flags joined by "|", or numbers.
+func ParseDWM_CLOAKED(s string) (DWM_CLOAKED, error) {
+	return _DWM_CLOAKED_names.Parse(s)
+}
+
+var _DWM_SIT_names = enum.Enum[DWM_SIT]{
+	Type: "DWM_SIT",
+	Values: []enum.Name[DWM_SIT]{
+		{Val: DWM_SIT_NONE, Name: "DWM_SIT_NONE"},
+		{Val: DWM_SIT_DISPLAYFRAME, Name: "DWM_SIT_DISPLAYFRAME"},
+	},
+}
+
+// Returns the name of the constant, like "DWM_SIT_NONE".
+func (v DWM_SIT) String() string {
+	return _DWM_SIT_names.String(v)
+}
+
+// Parses the name of a [DWM_SIT] constant, or a number.
+func ParseDWM_SIT(s string) (DWM_SIT, error) {
+	return _DWM_SIT_names.Parse(s)
+}
+
+var _DWMNCRP_names = enum.Enum[DWMNCRP]{
+	Type: "DWMNCRP",
+	Values: []enum.Name[DWMNCRP]{
+		{Val: DWMNCRP_DISABLED, Name: "DWMNCRP_DISABLED"},
+		{Val: DWMNCRP_ENABLED, Name: "DWMNCRP_ENABLED"},
+		{Val: DWMNCRP_USEWINDOWSTYLE, Name: "DWMNCRP_USEWINDOWSTYLE"},
+	},
+}
+
+// Returns the name of the constant, like "DWMNCRP_DISABLED".
+func (v DWMNCRP) String() string {
+	return _DWMNCRP_names.String(v)
+}
+
+// Parses the name of a [DWMNCRP] constant, or a number.
+func ParseDWMNCRP(s string) (DWMNCRP, error) {
+	return _DWMNCRP_names.Parse(s)
+}
+
+var _DWMSBT_names = enum.Enum[DWMSBT]{
+	Type: "DWMSBT",
+	Values: []enum.Name[DWMSBT]{
+		{Val: DWMSBT_NONE, Name: "DWMSBT_NONE"},
+		{Val: DWMSBT_MAINWINDOW, Name: "DWMSBT_MAINWINDOW"},
+		{Val: DWMSBT_TRANSIENTWINDOW, Name: "DWMSBT_TRANSIENTWINDOW"},
+		{Val: DWMSBT_TABBEDWINDOW, Name: "DWMSBT_TABBEDWINDOW"},
+		{Val: DWMSBT_AUTO, Name: "DWMSBT_AUTO"},
+	},
+}
+
+// Returns the name of the constant, like "DWMSBT_NONE".
+func (v DWMSBT) String() string {
+	return _DWMSBT_names.String(v)
+}
+
+// Parses the name of a [DWMSBT] constant, or a number.
+func ParseDWMSBT(s string) (DWMSBT, error) {
+	return _DWMSBT_names.Parse(s)
+}
+
+var _DWMSC_names = enum.Flags[DWMSC]{
+	Type: "DWMSC",
+	Values: []enum.Name[DWMSC]{
+		{Val: DWMSC_ALL, Name: "DWMSC_ALL"},
+		{Val: DWMSC_DOWN, Name: "DWMSC_DOWN"},
+		{Val: DWMSC_UP, Name: "DWMSC_UP"},
+		{Val: DWMSC_DRAG, Name: "DWMSC_DRAG"},
+		{Val: DWMSC_HOLD, Name: "DWMSC_HOLD"},
+		{Val: DWMSC_PENBARREL, Name: "DWMSC_PENBARREL"},
+		{Val: DWMSC_NONE, Name: "DWMSC_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DWMSC_DOWN|DWMSC_UP".
+func (v DWMSC) String() string {
+	return _DWMSC_names.String(v)
+}
+
+// Parses names of [DWMSC] flags joined by "|", or numbers.
+func ParseDWMSC(s string) (DWMSC, error) {
+	return _DWMSC_names.Parse(s)
+}
+
+var _DWMWA_names = enum.Enum[DWMWA]{
+	Type: "DWMWA",
+	Values: []enum.Name[DWMWA]{
+		{Val: DWMWA_NCRENDERING_ENABLED, Name: "DWMWA_NCRENDERING_ENABLED"},
+		{Val: DWMWA_NCRENDERING_POLICY, Name: "DWMWA_NCRENDERING_POLICY"},
+		{Val: DWMWA_TRANSITIONS_FORCEDISABLED, Name: "DWMWA_TRANSITIONS_FORCEDISABLED"},
+		{Val: DWMWA_ALLOW_NCPAINT, Name: "DWMWA_ALLOW_NCPAINT"},
+		{Val: DWMWA_CAPTION_BUTTON_BOUNDS, Name: "DWMWA_CAPTION_BUTTON_BOUNDS"},
+		{Val: DWMWA_NONCLIENT_RTL_LAYOUT, Name: "DWMWA_NONCLIENT_RTL_LAYOUT"},
+		{Val: DWMWA_FORCE_ICONIC_REPRESENTATION, Name: "DWMWA_FORCE_ICONIC_REPRESENTATION"},
+		{Val: DWMWA_FLIP3D_POLICY, Name: "DWMWA_FLIP3D_POLICY"},
+		{Val: DWMWA_EXTENDED_FRAME_BOUNDS, Name: "DWMWA_EXTENDED_FRAME_BOUNDS"},
+		{Val: DWMWA_HAS_ICONIC_BITMAP, Name: "DWMWA_HAS_ICONIC_BITMAP"},
+		{Val: DWMWA_DISALLOW_PEEK, Name: "DWMWA_DISALLOW_PEEK"},
+		{Val: DWMWA_EXCLUDED_FROM_PEEK, Name: "DWMWA_EXCLUDED_FROM_PEEK"},
+		{Val: DWMWA_CLOAK, Name: "DWMWA_CLOAK"},
+		{Val: DWMWA_CLOAKED, Name: "DWMWA_CLOAKED"},
+		{Val: DWMWA_FREEZE_REPRESENTATION, Name: "DWMWA_FREEZE_REPRESENTATION"},
+		{Val: DWMWA_PASSIVE_UPDATE_MODE, Name: "DWMWA_PASSIVE_UPDATE_MODE"},
+		{Val: DWMWA_USE_HOSTBACKDROPBRUSH, Name: "DWMWA_USE_HOSTBACKDROPBRUSH"},
+		{Val: DWMWA_USE_IMMERSIVE_DARK_MODE, Name: "DWMWA_USE_IMMERSIVE_DARK_MODE"},
+		{Val: DWMWA_WINDOW_CORNER_PREFERENCE, Name: "DWMWA_WINDOW_CORNER_PREFERENCE"},
+		{Val: DWMWA_BORDER_COLOR, Name: "DWMWA_BORDER_COLOR"},
+		{Val: DWMWA_CAPTION_COLOR, Name: "DWMWA_CAPTION_COLOR"},
+		{Val: DWMWA_TEXT_COLOR, Name: "DWMWA_TEXT_COLOR"},
+		{Val: DWMWA_VISIBLE_FRAME_BORDER_THICKNESS, Name: "DWMWA_VISIBLE_FRAME_BORDER_THICKNESS"},
+		{Val: DWMWA_SYSTEMBACKDROP_TYPE, Name: "DWMWA_SYSTEMBACKDROP_TYPE"},
+	},
+}
+
+// Returns the name of the constant, like "DWMWA_NCRENDERING_ENABLED".
+func (v DWMWA) String() string {
+	return _DWMWA_names.String(v)
+}
+
+// Parses the name of a [DWMWA] constant, or a number.
+func ParseDWMWA(s string) (DWMWA, error) {
+	return _DWMWA_names.Parse(s)
+}
+
+var _DWMWCP_names = enum.Enum[DWMWCP]{
+	Type: "DWMWCP",
+	Values: []enum.Name[DWMWCP]{
+		{Val: DWMWCP_DONOTROUND, Name: "DWMWCP_DONOTROUND"},
+		{Val: DWMWCP_ROUND, Name: "DWMWCP_ROUND"},
+		{Val: DWMWCP_ROUNDSMALL, Name: "DWMWCP_ROUNDSMALL"},
+		{Val: DWMWCP_DEFAULT, Name: "DWMWCP_DEFAULT"},
+	},
+}
+
+// Returns the name of the constant, like "DWMWCP_DONOTROUND".
+func (v DWMWCP) String() string {
+	return _DWMWCP_names.String(v)
+}
+
+// Parses the name of a [DWMWCP] constant, or a number.
+func ParseDWMWCP(s string) (DWMWCP, error) {
+	return _DWMWCP_names.Parse(s)
+}
+
+var _AD_names = enum.Enum[AD]{
+	Type: "AD",
+	Values: []enum.Name[AD]{
+		{Val: AD_COUNTERCLOCKWISE, Name: "AD_COUNTERCLOCKWISE"},
+		{Val: AD_CLOCKWISE, Name: "AD_CLOCKWISE"},
+	},
+}
+
+// Returns the name of the constant, like "AD_COUNTERCLOCKWISE".
+func (v AD) String() string {
+	return _AD_names.String(v)
+}
+
+// Parses the name of a [AD] constant, or a number.
+func ParseAD(s string) (AD, error) {
+	return _AD_names.Parse(s)
+}
+
+var _BI_names = enum.Enum[BI]{
+	Type: "BI",
+	Values: []enum.Name[BI]{
+		{Val: BI_RGB, Name: "BI_RGB"},
+		{Val: BI_RLE8, Name: "BI_RLE8"},
+		{Val: BI_RLE4, Name: "BI_RLE4"},
+		{Val: BI_BITFIELDS, Name: "BI_BITFIELDS"},
+		{Val: BI_JPEG, Name: "BI_JPEG"},
+		{Val: BI_PNG, Name: "BI_PNG"},
+	},
+}
+
+// Returns the name of the constant, like "BI_RGB".
+func (v BI) String() string {
+	return _BI_names.String(v)
+}
+
+// Parses the name of a [BI] constant, or a number.
+func ParseBI(s string) (BI, error) {
+	return _BI_names.Parse(s)
+}
+
+var _BITCOUNT_names = enum.Flags[BITCOUNT]{
+	Type: "BITCOUNT",
+	Values: []enum.Name[BITCOUNT]{
+		{Val: BITCOUNT_24, Name: "BITCOUNT_24"},
+		{Val: BITCOUNT_1, Name: "BITCOUNT_1"},
+		{Val: BITCOUNT_4, Name: "BITCOUNT_4"},
+		{Val: BITCOUNT_8, Name: "BITCOUNT_8"},
+		{Val: BITCOUNT_16, Name: "BITCOUNT_16"},
+		{Val: BITCOUNT_32, Name: "BITCOUNT_32"},
+		{Val: BITCOUNT_0, Name: "BITCOUNT_0"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "BITCOUNT_1|BITCOUNT_4".
+func (v BITCOUNT) String() string {
+	return _BITCOUNT_names.String(v)
+}
+
+// Parses names of [BITCOUNT] flags joined by "|", or numbers.
+func ParseBITCOUNT(s string) (BITCOUNT, error) {
+	return _BITCOUNT_names.Parse(s)
+}
+
+var _BKMODE_names = enum.Enum[BKMODE]{
+	Type: "BKMODE",
+	Values: []enum.Name[BKMODE]{
+		{Val: BKMODE_TRANSPARENT, Name: "BKMODE_TRANSPARENT"},
+		{Val: BKMODE_OPAQUE, Name: "BKMODE_OPAQUE"},
+	},
+}
+
+// Returns the name of the constant, like "BKMODE_TRANSPARENT".
+func (v BKMODE) String() string {
+	return _BKMODE_names.String(v)
+}
+
+// Parses the name of a [BKMODE] constant, or a number.
+func ParseBKMODE(s string) (BKMODE, error) {
+	return _BKMODE_names.Parse(s)
+}
+
+var _BRS_names = enum.Enum[BRS]{
+	Type: "BRS",
+	Values: []enum.Name[BRS]{
+		{Val: BRS_SOLID, Name: "BRS_SOLID"},
+		{Val: BRS_NULL, Name: "BRS_NULL"},
+		{Val: BRS_HATCHED, Name: "BRS_HATCHED"},
+		{Val: BRS_PATTERN, Name: "BRS_PATTERN"},
+		{Val: BRS_INDEXED, Name: "BRS_INDEXED"},
+		{Val: BRS_DIBPATTERN, Name: "BRS_DIBPATTERN"},
+		{Val: BRS_DIBPATTERNPT, Name: "BRS_DIBPATTERNPT"},
+		{Val: BRS_PATTERN8X8, Name: "BRS_PATTERN8X8"},
+		{Val: BRS_DIBPATTERN8X8, Name: "BRS_DIBPATTERN8X8"},
+		{Val: BRS_MONOPATTERN, Name: "BRS_MONOPATTERN"},
+		{Val: BRS_HOLLOW, Name: "BRS_HOLLOW"},
+	},
+}
+
+// Returns the name of the constant, like "BRS_SOLID".
+func (v BRS) String() string {
+	return _BRS_names.String(v)
+}
+
+// Parses the name of a [BRS] constant, or a number.
+func ParseBRS(s string) (BRS, error) {
+	return _BRS_names.Parse(s)
+}
+
+var _CHARSET_names = enum.Enum[CHARSET]{
+	Type: "CHARSET",
+	Values: []enum.Name[CHARSET]{
+		{Val: CHARSET_ANSI, Name: "CHARSET_ANSI"},
+		{Val: CHARSET_DEFAULT, Name: "CHARSET_DEFAULT"},
+		{Val: CHARSET_SYMBOL, Name: "CHARSET_SYMBOL"},
+		{Val: CHARSET_SHIFTJIS, Name: "CHARSET_SHIFTJIS"},
+		{Val: CHARSET_HANGUL, Name: "CHARSET_HANGUL"},
+		{Val: CHARSET_GB2312, Name: "CHARSET_GB2312"},
+		{Val: CHARSET_CHINESEBIG5, Name: "CHARSET_CHINESEBIG5"},
+		{Val: CHARSET_OEM, Name: "CHARSET_OEM"},
+		{Val: CHARSET_JOHAB, Name: "CHARSET_JOHAB"},
+		{Val: CHARSET_HEBREW, Name: "CHARSET_HEBREW"},
+		{Val: CHARSET_ARABIC, Name: "CHARSET_ARABIC"},
+		{Val: CHARSET_GREEK, Name: "CHARSET_GREEK"},
+		{Val: CHARSET_TURKISH, Name: "CHARSET_TURKISH"},
+		{Val: CHARSET_VIETNAMESE, Name: "CHARSET_VIETNAMESE"},
+		{Val: CHARSET_THAI, Name: "CHARSET_THAI"},
+		{Val: CHARSET_EASTEUROPE, Name: "CHARSET_EASTEUROPE"},
+		{Val: CHARSET_RUSSIAN, Name: "CHARSET_RUSSIAN"},
+		{Val: CHARSET_MAC, Name: "CHARSET_MAC"},
+		{Val: CHARSET_BALTIC, Name: "CHARSET_BALTIC"},
+	},
+}
+
+// Returns the name of the constant, like "CHARSET_ANSI".
+func (v CHARSET) String() string {
+	return _CHARSET_names.String(v)
+}
+
+// Parses the name of a [CHARSET] constant, or a number.
+func ParseCHARSET(s string) (CHARSET, error) {
+	return _CHARSET_names.Parse(s)
+}
+
+var _DCX_names = enum.Flags[DCX]{
+	Type: "DCX",
+	Values: []enum.Name[DCX]{
+		{Val: DCX_WINDOW, Name: "DCX_WINDOW"},
+		{Val: DCX_CACHE, Name: "DCX_CACHE"},
+		{Val: DCX_NORESETATTRS, Name: "DCX_NORESETATTRS"},
+		{Val: DCX_CLIPCHILDREN, Name: "DCX_CLIPCHILDREN"},
+		{Val: DCX_CLIPSIBLINGS, Name: "DCX_CLIPSIBLINGS"},
+		{Val: DCX_PARENTCLIP, Name: "DCX_PARENTCLIP"},
+		{Val: DCX_EXCLUDERGN, Name: "DCX_EXCLUDERGN"},
+		{Val: DCX_INTERSECTRGN, Name: "DCX_INTERSECTRGN"},
+		{Val: DCX_EXCLUDEUPDATE, Name: "DCX_EXCLUDEUPDATE"},
+		{Val: DCX_LOCKWINDOWUPDATE, Name: "DCX_LOCKWINDOWUPDATE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DCX_WINDOW|DCX_CACHE".
+func (v DCX) String() string {
+	return _DCX_names.String(v)
+}
+
+// Parses names of [DCX] flags joined by "|", or numbers.
+func ParseDCX(s string) (DCX, error) {
+	return _DCX_names.Parse(s)
+}
+
+var _DIB_COLORS_names = enum.Enum[DIB_COLORS]{
+	Type: "DIB_COLORS",
+	Values: []enum.Name[DIB_COLORS]{
+		{Val: DIB_COLORS_RGB, Name: "DIB_COLORS_RGB"},
+		{Val: DIB_COLORS_PAL, Name: "DIB_COLORS_PAL"},
+	},
+}
+
+// Returns the name of the constant, like "DIB_COLORS_RGB".
+func (v DIB_COLORS) String() string {
+	return _DIB_COLORS_names.String(v)
+}
+
+// Parses the name of a [DIB_COLORS] constant, or a number.
+func ParseDIB_COLORS(s string) (DIB_COLORS, error) {
+	return _DIB_COLORS_names.Parse(s)
+}
+
+var _CLIP_PRECIS_names = enum.Flags[CLIP_PRECIS]{
+	Type: "CLIP_PRECIS",
+	Values: []enum.Name[CLIP_PRECIS]{
+		{Val: CLIP_PRECIS_CHARACTER, Name: "CLIP_PRECIS_CHARACTER"},
+		{Val: CLIP_PRECIS_STROKE, Name: "CLIP_PRECIS_STROKE"},
+		{Val: CLIP_PRECIS_LH_ANGLES, Name: "CLIP_PRECIS_LH_ANGLES"},
+		{Val: CLIP_PRECIS_TT_ALWAYS, Name: "CLIP_PRECIS_TT_ALWAYS"},
+		{Val: CLIP_PRECIS_DFA_DISABLE, Name: "CLIP_PRECIS_DFA_DISABLE"},
+		{Val: CLIP_PRECIS_EMBEDDED, Name: "CLIP_PRECIS_EMBEDDED"},
+		{Val: CLIP_PRECIS_DEFAULT, Name: "CLIP_PRECIS_DEFAULT"},
+	},
+	ParseOnly: []enum.Name[CLIP_PRECIS]{
+		{Val: CLIP_PRECIS_MASK, Name: "CLIP_PRECIS_MASK"},
+	},
+	Fields: []CLIP_PRECIS{0xf},
+}
+
+// Returns the names of the flags joined by "|", like "CLIP_PRECIS_LH_ANGLES|CLIP_PRECIS_TT_ALWAYS".
+func (v CLIP_PRECIS) String() string {
+	return _CLIP_PRECIS_names.String(v)
+}
+
+// Parses names of [CLIP_PRECIS] flags joined by "|", or numbers.
+func ParseCLIP_PRECIS(s string) (CLIP_PRECIS, error) {
+	return _CLIP_PRECIS_names.Parse(s)
+}
+
+var _DIPJ_names = enum.Flags[DIPJ]{
+	Type: "DIPJ",
+	Values: []enum.Name[DIPJ]{
+		{Val: DIPJ_APPBANDING, Name: "DIPJ_APPBANDING"},
+		{Val: DIPJ_ROPS_READ_DESTINATION, Name: "DIPJ_ROPS_READ_DESTINATION"},
+		{Val: DIPJ_NONE, Name: "DIPJ_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DIPJ_APPBANDING|DIPJ_ROPS_READ_DESTINATION".
+func (v DIPJ) String() string {
+	return _DIPJ_names.String(v)
+}
+
+// Parses names of [DIPJ] flags joined by "|", or numbers.
+func ParseDIPJ(s string) (DIPJ, error) {
+	return _DIPJ_names.Parse(s)
+}
+
+var _DM_names = enum.Flags[DM]{
+	Type: "DM",
+	Values: []enum.Name[DM]{
+		{Val: DM_ORIENTATION, Name: "DM_ORIENTATION"},
+		{Val: DM_PAPERSIZE, Name: "DM_PAPERSIZE"},
+		{Val: DM_PAPERLENGTH, Name: "DM_PAPERLENGTH"},
+		{Val: DM_PAPERWIDTH, Name: "DM_PAPERWIDTH"},
+		{Val: DM_SCALE, Name: "DM_SCALE"},
+		{Val: DM_POSITION, Name: "DM_POSITION"},
+		{Val: DM_NUP, Name: "DM_NUP"},
+		{Val: DM_DISPLAYORIENTATION, Name: "DM_DISPLAYORIENTATION"},
+		{Val: DM_COPIES, Name: "DM_COPIES"},
+		{Val: DM_DEFAULTSOURCE, Name: "DM_DEFAULTSOURCE"},
+		{Val: DM_PRINTQUALITY, Name: "DM_PRINTQUALITY"},
+		{Val: DM_COLOR, Name: "DM_COLOR"},
+		{Val: DM_DUPLEX, Name: "DM_DUPLEX"},
+		{Val: DM_YRESOLUTION, Name: "DM_YRESOLUTION"},
+		{Val: DM_TTOPTION, Name: "DM_TTOPTION"},
+		{Val: DM_COLLATE, Name: "DM_COLLATE"},
+		{Val: DM_FORMNAME, Name: "DM_FORMNAME"},
+		{Val: DM_LOGPIXELS, Name: "DM_LOGPIXELS"},
+		{Val: DM_BITSPERPEL, Name: "DM_BITSPERPEL"},
+		{Val: DM_PELSWIDTH, Name: "DM_PELSWIDTH"},
+		{Val: DM_PELSHEIGHT, Name: "DM_PELSHEIGHT"},
+		{Val: DM_DISPLAYFLAGS, Name: "DM_DISPLAYFLAGS"},
+		{Val: DM_DISPLAYFREQUENCY, Name: "DM_DISPLAYFREQUENCY"},
+		{Val: DM_ICMMETHOD, Name: "DM_ICMMETHOD"},
+		{Val: DM_ICMINTENT, Name: "DM_ICMINTENT"},
+		{Val: DM_MEDIATYPE, Name: "DM_MEDIATYPE"},
+		{Val: DM_DITHERTYPE, Name: "DM_DITHERTYPE"},
+		{Val: DM_PANNINGWIDTH, Name: "DM_PANNINGWIDTH"},
+		{Val: DM_PANNINGHEIGHT, Name: "DM_PANNINGHEIGHT"},
+		{Val: DM_DISPLAYFIXEDOUTPUT, Name: "DM_DISPLAYFIXEDOUTPUT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DM_ORIENTATION|DM_PAPERSIZE".
+func (v DM) String() string {
+	return _DM_names.String(v)
+}
+
+// Parses names of [DM] flags joined by "|", or numbers.
+func ParseDM(s string) (DM, error) {
+	return _DM_names.Parse(s)
+}
+
+var _DMBIN_names = enum.Enum[DMBIN]{
+	Type: "DMBIN",
+	Values: []enum.Name[DMBIN]{
+		{Val: DMBIN_UPPER, Name: "DMBIN_UPPER"},
+		{Val: DMBIN_ONLYONE, Name: "DMBIN_ONLYONE"},
+		{Val: DMBIN_LOWER, Name: "DMBIN_LOWER"},
+		{Val: DMBIN_MIDDLE, Name: "DMBIN_MIDDLE"},
+		{Val: DMBIN_MANUAL, Name: "DMBIN_MANUAL"},
+		{Val: DMBIN_ENVELOPE, Name: "DMBIN_ENVELOPE"},
+		{Val: DMBIN_ENVMANUAL, Name: "DMBIN_ENVMANUAL"},
+		{Val: DMBIN_AUTO, Name: "DMBIN_AUTO"},
+		{Val: DMBIN_TRACTOR, Name: "DMBIN_TRACTOR"},
+		{Val: DMBIN_SMALLFMT, Name: "DMBIN_SMALLFMT"},
+		{Val: DMBIN_LARGEFMT, Name: "DMBIN_LARGEFMT"},
+		{Val: DMBIN_LARGECAPACITY, Name: "DMBIN_LARGECAPACITY"},
+		{Val: DMBIN_CASSETTE, Name: "DMBIN_CASSETTE"},
+		{Val: DMBIN_FORMSOURCE, Name: "DMBIN_FORMSOURCE"},
+		{Val: DMBIN_USER, Name: "DMBIN_USER"},
+		{Val: DMBIN_LAST, Name: "DMBIN_LAST"},
+	},
+}
+
+// Returns the name of the constant, like "DMBIN_UPPER".
+func (v DMBIN) String() string {
+	return _DMBIN_names.String(v)
+}
+
+// Parses the name of a [DMBIN] constant, or a number.
+func ParseDMBIN(s string) (DMBIN, error) {
+	return _DMBIN_names.Parse(s)
+}
+
+var _DMCOLLATE_names = enum.Enum[DMCOLLATE]{
+	Type: "DMCOLLATE",
+	Values: []enum.Name[DMCOLLATE]{
+		{Val: DMCOLLATE_FALSE, Name: "DMCOLLATE_FALSE"},
+		{Val: DMCOLLATE_TRUE, Name: "DMCOLLATE_TRUE"},
+	},
+}
+
+// Returns the name of the constant, like "DMCOLLATE_FALSE".
+func (v DMCOLLATE) String() string {
+	return _DMCOLLATE_names.String(v)
+}
+
+// Parses the name of a [DMCOLLATE] constant, or a number.
+func ParseDMCOLLATE(s string) (DMCOLLATE, error) {
+	return _DMCOLLATE_names.Parse(s)
+}
+
+var _DMCOLOR_names = enum.Enum[DMCOLOR]{
+	Type: "DMCOLOR",
+	Values: []enum.Name[DMCOLOR]{
+		{Val: DMCOLOR_MONOCHROME, Name: "DMCOLOR_MONOCHROME"},
+		{Val: DMCOLOR_COLOR, Name: "DMCOLOR_COLOR"},
+	},
+}
+
+// Returns the name of the constant, like "DMCOLOR_MONOCHROME".
+func (v DMCOLOR) String() string {
+	return _DMCOLOR_names.String(v)
+}
+
+// Parses the name of a [DMCOLOR] constant, or a number.
+func ParseDMCOLOR(s string) (DMCOLOR, error) {
+	return _DMCOLOR_names.Parse(s)
+}
+
+var _DMDFO_names = enum.Enum[DMDFO]{
+	Type: "DMDFO",
+	Values: []enum.Name[DMDFO]{
+		{Val: DMDFO_DEFAULT, Name: "DMDFO_DEFAULT"},
+		{Val: DMDFO_STRETCH, Name: "DMDFO_STRETCH"},
+		{Val: DMDFO_CENTER, Name: "DMDFO_CENTER"},
+	},
+}
+
+// Returns the name of the constant, like "DMDFO_DEFAULT".
+func (v DMDFO) String() string {
+	return _DMDFO_names.String(v)
+}
+
+// Parses the name of a [DMDFO] constant, or a number.
+func ParseDMDFO(s string) (DMDFO, error) {
+	return _DMDFO_names.Parse(s)
+}
+
+var _DMDISPLAYFLAGS_names = enum.Flags[DMDISPLAYFLAGS]{
+	Type: "DMDISPLAYFLAGS",
+	Values: []enum.Name[DMDISPLAYFLAGS]{
+		{Val: DMDISPLAYFLAGS_INTERLACED, Name: "DMDISPLAYFLAGS_INTERLACED"},
+		{Val: DMDISPLAYFLAGS_TEXTMODE, Name: "DMDISPLAYFLAGS_TEXTMODE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DMDISPLAYFLAGS_INTERLACED|DMDISPLAYFLAGS_TEXTMODE".
+func (v DMDISPLAYFLAGS) String() string {
+	return _DMDISPLAYFLAGS_names.String(v)
+}
+
+// Parses names of [DMDISPLAYFLAGS] flags joined by "|", or numbers.
+func ParseDMDISPLAYFLAGS(s string) (DMDISPLAYFLAGS, error) {
+	return _DMDISPLAYFLAGS_names.Parse(s)
+}
+
+var _DMDITHER_names = enum.Enum[DMDITHER]{
+	Type: "DMDITHER",
+	Values: []enum.Name[DMDITHER]{
+		{Val: DMDITHER_NONE, Name: "DMDITHER_NONE"},
+		{Val: DMDITHER_COARSE, Name: "DMDITHER_COARSE"},
+		{Val: DMDITHER_FINE, Name: "DMDITHER_FINE"},
+		{Val: DMDITHER_LINEART, Name: "DMDITHER_LINEART"},
+		{Val: DMDITHER_ERRORDIFFUSION, Name: "DMDITHER_ERRORDIFFUSION"},
+		{Val: DMDITHER_RESERVED6, Name: "DMDITHER_RESERVED6"},
+		{Val: DMDITHER_RESERVED7, Name: "DMDITHER_RESERVED7"},
+		{Val: DMDITHER_RESERVED8, Name: "DMDITHER_RESERVED8"},
+		{Val: DMDITHER_RESERVED9, Name: "DMDITHER_RESERVED9"},
+		{Val: DMDITHER_GRAYSCALE, Name: "DMDITHER_GRAYSCALE"},
+		{Val: DMDITHER_USER, Name: "DMDITHER_USER"},
+	},
+}
+
+// Returns the name of the constant, like "DMDITHER_NONE".
+func (v DMDITHER) String() string {
+	return _DMDITHER_names.String(v)
+}
+
+// Parses the name of a [DMDITHER] constant, or a number.
+func ParseDMDITHER(s string) (DMDITHER, error) {
+	return _DMDITHER_names.Parse(s)
+}
+
+var _DMDO_names = enum.Enum[DMDO]{
+	Type: "DMDO",
+	Values: []enum.Name[DMDO]{
+		{Val: DMDO_D90, Name: "DMDO_D90"},
+		{Val: DMDO_D180, Name: "DMDO_D180"},
+		{Val: DMDO_D270, Name: "DMDO_D270"},
+	},
+}
+
+// Returns the name of the constant, like "DMDO_D90".
+func (v DMDO) String() string {
+	return _DMDO_names.String(v)
+}
+
+// Parses the name of a [DMDO] constant, or a number.
+func ParseDMDO(s string) (DMDO, error) {
+	return _DMDO_names.Parse(s)
+}
+
+var _DMDUP_names = enum.Enum[DMDUP]{
+	Type: "DMDUP",
+	Values: []enum.Name[DMDUP]{
+		{Val: DMDUP_SIMPLEX, Name: "DMDUP_SIMPLEX"},
+		{Val: DMDUP_VERTICAL, Name: "DMDUP_VERTICAL"},
+		{Val: DMDUP_HORIZONTAL, Name: "DMDUP_HORIZONTAL"},
+	},
+}
+
+// Returns the name of the constant, like "DMDUP_SIMPLEX".
+func (v DMDUP) String() string {
+	return _DMDUP_names.String(v)
+}
+
+// Parses the name of a [DMDUP] constant, or a number.
+func ParseDMDUP(s string) (DMDUP, error) {
+	return _DMDUP_names.Parse(s)
+}
+
+var _DMICM_names = enum.Flags[DMICM]{
+	Type: "DMICM",
+	Values: []enum.Name[DMICM]{
+		{Val: DMICM_COLORIMETRIC, Name: "DMICM_COLORIMETRIC"},
+		{Val: DMICM_SATURATE, Name: "DMICM_SATURATE"},
+		{Val: DMICM_CONTRAST, Name: "DMICM_CONTRAST"},
+		{Val: DMICM_ABS_COLORIMETRIC, Name: "DMICM_ABS_COLORIMETRIC"},
+		{Val: DMICM_USER, Name: "DMICM_USER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DMICM_SATURATE|DMICM_CONTRAST".
+func (v DMICM) String() string {
+	return _DMICM_names.String(v)
+}
+
+// Parses names of [DMICM] flags joined by "|", or numbers.
+func ParseDMICM(s string) (DMICM, error) {
+	return _DMICM_names.Parse(s)
+}
+
+var _DMICMMETHOD_names = enum.Flags[DMICMMETHOD]{
+	Type: "DMICMMETHOD",
+	Values: []enum.Name[DMICMMETHOD]{
+		{Val: DMICMMETHOD_DRIVER, Name: "DMICMMETHOD_DRIVER"},
+		{Val: DMICMMETHOD_NONE, Name: "DMICMMETHOD_NONE"},
+		{Val: DMICMMETHOD_SYSTEM, Name: "DMICMMETHOD_SYSTEM"},
+		{Val: DMICMMETHOD_DEVICE, Name: "DMICMMETHOD_DEVICE"},
+		{Val: DMICMMETHOD_USER, Name: "DMICMMETHOD_USER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DMICMMETHOD_NONE|DMICMMETHOD_SYSTEM".
+func (v DMICMMETHOD) String() string {
+	return _DMICMMETHOD_names.String(v)
+}
+
+// Parses names of [DMICMMETHOD] flags joined by "|", or numbers.
+func ParseDMICMMETHOD(s string) (DMICMMETHOD, error) {
+	return _DMICMMETHOD_names.Parse(s)
+}
+
+var _DMMEDIA_names = enum.Flags[DMMEDIA]{
+	Type: "DMMEDIA",
+	Values: []enum.Name[DMMEDIA]{
+		{Val: DMMEDIA_GLOSSY, Name: "DMMEDIA_GLOSSY"},
+		{Val: DMMEDIA_STANDARD, Name: "DMMEDIA_STANDARD"},
+		{Val: DMMEDIA_TRANSPARENCY, Name: "DMMEDIA_TRANSPARENCY"},
+		{Val: DMMEDIA_USER, Name: "DMMEDIA_USER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DMMEDIA_STANDARD|DMMEDIA_TRANSPARENCY".
+func (v DMMEDIA) String() string {
+	return _DMMEDIA_names.String(v)
+}
+
+// Parses names of [DMMEDIA] flags joined by "|", or numbers.
+func ParseDMMEDIA(s string) (DMMEDIA, error) {
+	return _DMMEDIA_names.Parse(s)
+}
+
+var _DMNUP_names = enum.Enum[DMNUP]{
+	Type: "DMNUP",
+	Values: []enum.Name[DMNUP]{
+		{Val: DMNUP_SYSTEM, Name: "DMNUP_SYSTEM"},
+		{Val: DMNUP_ONEUP, Name: "DMNUP_ONEUP"},
+	},
+}
+
+// Returns the name of the constant, like "DMNUP_SYSTEM".
+func (v DMNUP) String() string {
+	return _DMNUP_names.String(v)
+}
+
+// Parses the name of a [DMNUP] constant, or a number.
+func ParseDMNUP(s string) (DMNUP, error) {
+	return _DMNUP_names.Parse(s)
+}
+
+var _DMORIENT_names = enum.Enum[DMORIENT]{
+	Type: "DMORIENT",
+	Values: []enum.Name[DMORIENT]{
+		{Val: DMORIENT_PORTRAIT, Name: "DMORIENT_PORTRAIT"},
+		{Val: DMORIENT_LANDSCAPE, Name: "DMORIENT_LANDSCAPE"},
+	},
+}
+
+// Returns the name of the constant, like "DMORIENT_PORTRAIT".
+func (v DMORIENT) String() string {
+	return _DMORIENT_names.String(v)
+}
+
+// Parses the name of a [DMORIENT] constant, or a number.
+func ParseDMORIENT(s string) (DMORIENT, error) {
+	return _DMORIENT_names.Parse(s)
+}
+
+var _DMPAPER_names = enum.Enum[DMPAPER]{
+	Type: "DMPAPER",
+	Values: []enum.Name[DMPAPER]{
+		{Val: DMPAPER_LETTER, Name: "DMPAPER_LETTER"},
+		{Val: DMPAPER_LETTERSMALL, Name: "DMPAPER_LETTERSMALL"},
+		{Val: DMPAPER_TABLOID, Name: "DMPAPER_TABLOID"},
+		{Val: DMPAPER_LEDGER, Name: "DMPAPER_LEDGER"},
+		{Val: DMPAPER_LEGAL, Name: "DMPAPER_LEGAL"},
+		{Val: DMPAPER_STATEMENT, Name: "DMPAPER_STATEMENT"},
+		{Val: DMPAPER_EXECUTIVE, Name: "DMPAPER_EXECUTIVE"},
+		{Val: DMPAPER_A3, Name: "DMPAPER_A3"},
+		{Val: DMPAPER_A4, Name: "DMPAPER_A4"},
+		{Val: DMPAPER_A4SMALL, Name: "DMPAPER_A4SMALL"},
+		{Val: DMPAPER_A5, Name: "DMPAPER_A5"},
+		{Val: DMPAPER_B4, Name: "DMPAPER_B4"},
+		{Val: DMPAPER_B5, Name: "DMPAPER_B5"},
+		{Val: DMPAPER_FOLIO, Name: "DMPAPER_FOLIO"},
+		{Val: DMPAPER_QUARTO, Name: "DMPAPER_QUARTO"},
+		{Val: DMPAPER_P10X14, Name: "DMPAPER_P10X14"},
+		{Val: DMPAPER_P11X17, Name: "DMPAPER_P11X17"},
+		{Val: DMPAPER_NOTE, Name: "DMPAPER_NOTE"},
+		{Val: DMPAPER_ENV_9, Name: "DMPAPER_ENV_9"},
+		{Val: DMPAPER_ENV_10, Name: "DMPAPER_ENV_10"},
+		{Val: DMPAPER_ENV_11, Name: "DMPAPER_ENV_11"},
+		{Val: DMPAPER_ENV_12, Name: "DMPAPER_ENV_12"},
+		{Val: DMPAPER_ENV_14, Name: "DMPAPER_ENV_14"},
+		{Val: DMPAPER_CSHEET, Name: "DMPAPER_CSHEET"},
+		{Val: DMPAPER_DSHEET, Name: "DMPAPER_DSHEET"},
+		{Val: DMPAPER_ESHEET, Name: "DMPAPER_ESHEET"},
+		{Val: DMPAPER_ENV_DL, Name: "DMPAPER_ENV_DL"},
+		{Val: DMPAPER_ENV_C5, Name: "DMPAPER_ENV_C5"},
+		{Val: DMPAPER_ENV_C3, Name: "DMPAPER_ENV_C3"},
+		{Val: DMPAPER_ENV_C4, Name: "DMPAPER_ENV_C4"},
+		{Val: DMPAPER_ENV_C6, Name: "DMPAPER_ENV_C6"},
+		{Val: DMPAPER_ENV_C65, Name: "DMPAPER_ENV_C65"},
+		{Val: DMPAPER_ENV_B4, Name: "DMPAPER_ENV_B4"},
+		{Val: DMPAPER_ENV_B5, Name: "DMPAPER_ENV_B5"},
+		{Val: DMPAPER_ENV_B6, Name: "DMPAPER_ENV_B6"},
+		{Val: DMPAPER_ENV_ITALY, Name: "DMPAPER_ENV_ITALY"},
+		{Val: DMPAPER_ENV_MONARCH, Name: "DMPAPER_ENV_MONARCH"},
+		{Val: DMPAPER_ENV_PERSONAL, Name: "DMPAPER_ENV_PERSONAL"},
+		{Val: DMPAPER_FANFOLD_US, Name: "DMPAPER_FANFOLD_US"},
+		{Val: DMPAPER_FANFOLD_STD_GERMAN, Name: "DMPAPER_FANFOLD_STD_GERMAN"},
+		{Val: DMPAPER_FANFOLD_LGL_GERMAN, Name: "DMPAPER_FANFOLD_LGL_GERMAN"},
+		{Val: DMPAPER_ISO_B4, Name: "DMPAPER_ISO_B4"},
+		{Val: DMPAPER_JAPANESE_POSTCARD, Name: "DMPAPER_JAPANESE_POSTCARD"},
+		{Val: DMPAPER_P9X11, Name: "DMPAPER_P9X11"},
+		{Val: DMPAPER_P10X11, Name: "DMPAPER_P10X11"},
+		{Val: DMPAPER_P15X11, Name: "DMPAPER_P15X11"},
+		{Val: DMPAPER_ENV_INVITE, Name: "DMPAPER_ENV_INVITE"},
+		{Val: DMPAPER_LETTER_EXTRA, Name: "DMPAPER_LETTER_EXTRA"},
+		{Val: DMPAPER_LEGAL_EXTRA, Name: "DMPAPER_LEGAL_EXTRA"},
+		{Val: DMPAPER_TABLOID_EXTRA, Name: "DMPAPER_TABLOID_EXTRA"},
+		{Val: DMPAPER_A4_EXTRA, Name: "DMPAPER_A4_EXTRA"},
+		{Val: DMPAPER_LETTER_TRANSVERSE, Name: "DMPAPER_LETTER_TRANSVERSE"},
+		{Val: DMPAPER_A4_TRANSVERSE, Name: "DMPAPER_A4_TRANSVERSE"},
+		{Val: DMPAPER_LETTER_EXTRA_TRANSVERSE, Name: "DMPAPER_LETTER_EXTRA_TRANSVERSE"},
+		{Val: DMPAPER_A_PLUS, Name: "DMPAPER_A_PLUS"},
+		{Val: DMPAPER_B_PLUS, Name: "DMPAPER_B_PLUS"},
+		{Val: DMPAPER_LETTER_PLUS, Name: "DMPAPER_LETTER_PLUS"},
+		{Val: DMPAPER_A4_PLUS, Name: "DMPAPER_A4_PLUS"},
+		{Val: DMPAPER_A5_TRANSVERSE, Name: "DMPAPER_A5_TRANSVERSE"},
+		{Val: DMPAPER_B5_TRANSVERSE, Name: "DMPAPER_B5_TRANSVERSE"},
+		{Val: DMPAPER_A3_EXTRA, Name: "DMPAPER_A3_EXTRA"},
+		{Val: DMPAPER_A5_EXTRA, Name: "DMPAPER_A5_EXTRA"},
+		{Val: DMPAPER_B5_EXTRA, Name: "DMPAPER_B5_EXTRA"},
+		{Val: DMPAPER_A2, Name: "DMPAPER_A2"},
+		{Val: DMPAPER_A3_TRANSVERSE, Name: "DMPAPER_A3_TRANSVERSE"},
+		{Val: DMPAPER_A3_EXTRA_TRANSVERSE, Name: "DMPAPER_A3_EXTRA_TRANSVERSE"},
+		{Val: DMPAPER_DBL_JAPANESE_POSTCARD, Name: "DMPAPER_DBL_JAPANESE_POSTCARD"},
+		{Val: DMPAPER_A6, Name: "DMPAPER_A6"},
+		{Val: DMPAPER_JENV_KAKU2, Name: "DMPAPER_JENV_KAKU2"},
+		{Val: DMPAPER_JENV_KAKU3, Name: "DMPAPER_JENV_KAKU3"},
+		{Val: DMPAPER_JENV_CHOU3, Name: "DMPAPER_JENV_CHOU3"},
+		{Val: DMPAPER_JENV_CHOU4, Name: "DMPAPER_JENV_CHOU4"},
+		{Val: DMPAPER_LETTER_ROTATED, Name: "DMPAPER_LETTER_ROTATED"},
+		{Val: DMPAPER_A3_ROTATED, Name: "DMPAPER_A3_ROTATED"},
+		{Val: DMPAPER_A4_ROTATED, Name: "DMPAPER_A4_ROTATED"},
+		{Val: DMPAPER_A5_ROTATED, Name: "DMPAPER_A5_ROTATED"},
+		{Val: DMPAPER_B4_JIS_ROTATED, Name: "DMPAPER_B4_JIS_ROTATED"},
+		{Val: DMPAPER_B5_JIS_ROTATED, Name: "DMPAPER_B5_JIS_ROTATED"},
+		{Val: DMPAPER_JAPANESE_POSTCARD_ROTATED, Name: "DMPAPER_JAPANESE_POSTCARD_ROTATED"},
+		{Val: DMPAPER_DBL_JAPANESE_POSTCARD_ROTATED, Name: "DMPAPER_DBL_JAPANESE_POSTCARD_ROTATED"},
+		{Val: DMPAPER_A6_ROTATED, Name: "DMPAPER_A6_ROTATED"},
+		{Val: DMPAPER_JENV_KAKU2_ROTATED, Name: "DMPAPER_JENV_KAKU2_ROTATED"},
+		{Val: DMPAPER_JENV_KAKU3_ROTATED, Name: "DMPAPER_JENV_KAKU3_ROTATED"},
+		{Val: DMPAPER_JENV_CHOU3_ROTATED, Name: "DMPAPER_JENV_CHOU3_ROTATED"},
+		{Val: DMPAPER_JENV_CHOU4_ROTATED, Name: "DMPAPER_JENV_CHOU4_ROTATED"},
+		{Val: DMPAPER_B6_JIS, Name: "DMPAPER_B6_JIS"},
+		{Val: DMPAPER_B6_JIS_ROTATED, Name: "DMPAPER_B6_JIS_ROTATED"},
+		{Val: DMPAPER_P12X11, Name: "DMPAPER_P12X11"},
+		{Val: DMPAPER_JENV_YOU4, Name: "DMPAPER_JENV_YOU4"},
+		{Val: DMPAPER_JENV_YOU4_ROTATED, Name: "DMPAPER_JENV_YOU4_ROTATED"},
+		{Val: DMPAPER_P16K, Name: "DMPAPER_P16K"},
+		{Val: DMPAPER_P32K, Name: "DMPAPER_P32K"},
+		{Val: DMPAPER_P32KBIG, Name: "DMPAPER_P32KBIG"},
+		{Val: DMPAPER_PENV_1, Name: "DMPAPER_PENV_1"},
+		{Val: DMPAPER_PENV_2, Name: "DMPAPER_PENV_2"},
+		{Val: DMPAPER_PENV_3, Name: "DMPAPER_PENV_3"},
+		{Val: DMPAPER_PENV_4, Name: "DMPAPER_PENV_4"},
+		{Val: DMPAPER_PENV_5, Name: "DMPAPER_PENV_5"},
+		{Val: DMPAPER_PENV_6, Name: "DMPAPER_PENV_6"},
+		{Val: DMPAPER_PENV_7, Name: "DMPAPER_PENV_7"},
+		{Val: DMPAPER_PENV_8, Name: "DMPAPER_PENV_8"},
+		{Val: DMPAPER_PENV_9, Name: "DMPAPER_PENV_9"},
+		{Val: DMPAPER_PENV_10, Name: "DMPAPER_PENV_10"},
+		{Val: DMPAPER_P16K_ROTATED, Name: "DMPAPER_P16K_ROTATED"},
+		{Val: DMPAPER_P32K_ROTATED, Name: "DMPAPER_P32K_ROTATED"},
+		{Val: DMPAPER_P32KBIG_ROTATED, Name: "DMPAPER_P32KBIG_ROTATED"},
+		{Val: DMPAPER_PENV_1_ROTATED, Name: "DMPAPER_PENV_1_ROTATED"},
+		{Val: DMPAPER_PENV_2_ROTATED, Name: "DMPAPER_PENV_2_ROTATED"},
+		{Val: DMPAPER_PENV_3_ROTATED, Name: "DMPAPER_PENV_3_ROTATED"},
+		{Val: DMPAPER_PENV_4_ROTATED, Name: "DMPAPER_PENV_4_ROTATED"},
+		{Val: DMPAPER_PENV_5_ROTATED, Name: "DMPAPER_PENV_5_ROTATED"},
+		{Val: DMPAPER_PENV_6_ROTATED, Name: "DMPAPER_PENV_6_ROTATED"},
+		{Val: DMPAPER_PENV_7_ROTATED, Name: "DMPAPER_PENV_7_ROTATED"},
+		{Val: DMPAPER_PENV_8_ROTATED, Name: "DMPAPER_PENV_8_ROTATED"},
+		{Val: DMPAPER_PENV_9_ROTATED, Name: "DMPAPER_PENV_9_ROTATED"},
+		{Val: DMPAPER_PENV_10_ROTATED, Name: "DMPAPER_PENV_10_ROTATED"},
+		{Val: DMPAPER_USER, Name: "DMPAPER_USER"},
+	},
+}
+
+// Returns the name of the constant, like "DMPAPER_LETTER".
+func (v DMPAPER) String() string {
+	return _DMPAPER_names.String(v)
+}
+
+// Parses the name of a [DMPAPER] constant, or a number.
+func ParseDMPAPER(s string) (DMPAPER, error) {
+	return _DMPAPER_names.Parse(s)
+}
+
+var _DMRES_names = enum.Enum[DMRES]{
+	Type: "DMRES",
+	Values: []enum.Name[DMRES]{
+		{Val: DMRES_DRAFT, Name: "DMRES_DRAFT"},
+		{Val: DMRES_LOW, Name: "DMRES_LOW"},
+		{Val: DMRES_MEDIUM, Name: "DMRES_MEDIUM"},
+		{Val: DMRES_HIGH, Name: "DMRES_HIGH"},
+	},
+}
+
+// Returns the name of the constant, like "DMRES_DRAFT".
+func (v DMRES) String() string {
+	return _DMRES_names.String(v)
+}
+
+// Parses the name of a [DMRES] constant, or a number.
+func ParseDMRES(s string) (DMRES, error) {
+	return _DMRES_names.Parse(s)
+}
+
+var _DMTT_names = enum.Enum[DMTT]{
+	Type: "DMTT",
+	Values: []enum.Name[DMTT]{
+		{Val: DMTT_BITMAP, Name: "DMTT_BITMAP"},
+		{Val: DMTT_DOWNLOAD, Name: "DMTT_DOWNLOAD"},
+		{Val: DMTT_SUBDEV, Name: "DMTT_SUBDEV"},
+		{Val: DMTT_DOWNLOAD_OUTLINE, Name: "DMTT_DOWNLOAD_OUTLINE"},
+	},
+}
+
+// Returns the name of the constant, like "DMTT_BITMAP".
+func (v DMTT) String() string {
+	return _DMTT_names.String(v)
+}
+
+// Parses the name of a [DMTT] constant, or a number.
+func ParseDMTT(s string) (DMTT, error) {
+	return _DMTT_names.Parse(s)
+}
+
+var _FF_names = enum.Flags[FF]{
+	Type: "FF",
+	Values: []enum.Name[FF]{
+		{Val: FF_MODERN, Name: "FF_MODERN"},
+		{Val: FF_DECORATIVE, Name: "FF_DECORATIVE"},
+		{Val: FF_ROMAN, Name: "FF_ROMAN"},
+		{Val: FF_SWISS, Name: "FF_SWISS"},
+		{Val: FF_SCRIPT, Name: "FF_SCRIPT"},
+		{Val: FF_DONTCARE, Name: "FF_DONTCARE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FF_ROMAN|FF_SWISS".
+func (v FF) String() string {
+	return _FF_names.String(v)
+}
+
+// Parses names of [FF] flags joined by "|", or numbers.
+func ParseFF(s string) (FF, error) {
+	return _FF_names.Parse(s)
+}
+
+var _FR_names = enum.Flags[FR]{
+	Type: "FR",
+	Values: []enum.Name[FR]{
+		{Val: FR_PRIVATE, Name: "FR_PRIVATE"},
+		{Val: FR_NOT_ENUM, Name: "FR_NOT_ENUM"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FR_PRIVATE|FR_NOT_ENUM".
+func (v FR) String() string {
+	return _FR_names.String(v)
+}
+
+// Parses names of [FR] flags joined by "|", or numbers.
+func ParseFR(s string) (FR, error) {
+	return _FR_names.Parse(s)
+}
+
+var _FW_names = enum.Enum[FW]{
+	Type: "FW",
+	Values: []enum.Name[FW]{
+		{Val: FW_DONTCARE, Name: "FW_DONTCARE"},
+		{Val: FW_THIN, Name: "FW_THIN"},
+		{Val: FW_EXTRALIGHT, Name: "FW_EXTRALIGHT"},
+		{Val: FW_LIGHT, Name: "FW_LIGHT"},
+		{Val: FW_NORMAL, Name: "FW_NORMAL"},
+		{Val: FW_REGULAR, Name: "FW_REGULAR"},
+		{Val: FW_MEDIUM, Name: "FW_MEDIUM"},
+		{Val: FW_SEMIBOLD, Name: "FW_SEMIBOLD"},
+		{Val: FW_BOLD, Name: "FW_BOLD"},
+		{Val: FW_EXTRABOLD, Name: "FW_EXTRABOLD"},
+		{Val: FW_HEAVY, Name: "FW_HEAVY"},
+		{Val: FW_ULTRALIGHT, Name: "FW_ULTRALIGHT"},
+		{Val: FW_DEMIBOLD, Name: "FW_DEMIBOLD"},
+		{Val: FW_ULTRABOLD, Name: "FW_ULTRABOLD"},
+		{Val: FW_BLACK, Name: "FW_BLACK"},
+	},
+}
+
+// Returns the name of the constant, like "FW_DONTCARE".
+func (v FW) String() string {
+	return _FW_names.String(v)
+}
+
+// Parses the name of a [FW] constant, or a number.
+func ParseFW(s string) (FW, error) {
+	return _FW_names.Parse(s)
+}
+
+var _GDC_names = enum.Enum[GDC]{
+	Type: "GDC",
+	Values: []enum.Name[GDC]{
+		{Val: GDC_DRIVERVERSION, Name: "GDC_DRIVERVERSION"},
+		{Val: GDC_TECHNOLOGY, Name: "GDC_TECHNOLOGY"},
+		{Val: GDC_HORZSIZE, Name: "GDC_HORZSIZE"},
+		{Val: GDC_VERTSIZE, Name: "GDC_VERTSIZE"},
+		{Val: GDC_HORZRES, Name: "GDC_HORZRES"},
+		{Val: GDC_VERTRES, Name: "GDC_VERTRES"},
+		{Val: GDC_BITSPIXEL, Name: "GDC_BITSPIXEL"},
+		{Val: GDC_PLANES, Name: "GDC_PLANES"},
+		{Val: GDC_NUMBRUSHES, Name: "GDC_NUMBRUSHES"},
+		{Val: GDC_NUMPENS, Name: "GDC_NUMPENS"},
+		{Val: GDC_NUMMARKERS, Name: "GDC_NUMMARKERS"},
+		{Val: GDC_NUMFONTS, Name: "GDC_NUMFONTS"},
+		{Val: GDC_NUMCOLORS, Name: "GDC_NUMCOLORS"},
+		{Val: GDC_PDEVICESIZE, Name: "GDC_PDEVICESIZE"},
+		{Val: GDC_CURVECAPS, Name: "GDC_CURVECAPS"},
+		{Val: GDC_LINECAPS, Name: "GDC_LINECAPS"},
+		{Val: GDC_POLYGONALCAPS, Name: "GDC_POLYGONALCAPS"},
+		{Val: GDC_TEXTCAPS, Name: "GDC_TEXTCAPS"},
+		{Val: GDC_CLIPCAPS, Name: "GDC_CLIPCAPS"},
+		{Val: GDC_RASTERCAPS, Name: "GDC_RASTERCAPS"},
+		{Val: GDC_ASPECTX, Name: "GDC_ASPECTX"},
+		{Val: GDC_ASPECTY, Name: "GDC_ASPECTY"},
+		{Val: GDC_ASPECTXY, Name: "GDC_ASPECTXY"},
+		{Val: GDC_LOGPIXELSX, Name: "GDC_LOGPIXELSX"},
+		{Val: GDC_LOGPIXELSY, Name: "GDC_LOGPIXELSY"},
+		{Val: GDC_SIZEPALETTE, Name: "GDC_SIZEPALETTE"},
+		{Val: GDC_NUMRESERVED, Name: "GDC_NUMRESERVED"},
+		{Val: GDC_COLORRES, Name: "GDC_COLORRES"},
+		{Val: GDC_PHYSICALWIDTH, Name: "GDC_PHYSICALWIDTH"},
+		{Val: GDC_PHYSICALHEIGHT, Name: "GDC_PHYSICALHEIGHT"},
+		{Val: GDC_PHYSICALOFFSETX, Name: "GDC_PHYSICALOFFSETX"},
+		{Val: GDC_PHYSICALOFFSETY, Name: "GDC_PHYSICALOFFSETY"},
+		{Val: GDC_SCALINGFACTORX, Name: "GDC_SCALINGFACTORX"},
+		{Val: GDC_SCALINGFACTORY, Name: "GDC_SCALINGFACTORY"},
+		{Val: GDC_VREFRESH, Name: "GDC_VREFRESH"},
+		{Val: GDC_DESKTOPVERTRES, Name: "GDC_DESKTOPVERTRES"},
+		{Val: GDC_DESKTOPHORZRES, Name: "GDC_DESKTOPHORZRES"},
+		{Val: GDC_BLTALIGNMENT, Name: "GDC_BLTALIGNMENT"},
+		{Val: GDC_SHADEBLENDCAPS, Name: "GDC_SHADEBLENDCAPS"},
+		{Val: GDC_COLORMGMTCAPS, Name: "GDC_COLORMGMTCAPS"},
+	},
+}
+
+// Returns the name of the constant, like "GDC_DRIVERVERSION".
+func (v GDC) String() string {
+	return _GDC_names.String(v)
+}
+
+// Parses the name of a [GDC] constant, or a number.
+func ParseGDC(s string) (GDC, error) {
+	return _GDC_names.Parse(s)
+}
+
+var _GRADIENT_FILL_names = enum.Enum[GRADIENT_FILL]{
+	Type: "GRADIENT_FILL",
+	Values: []enum.Name[GRADIENT_FILL]{
+		{Val: GRADIENT_FILL_RECT_H, Name: "GRADIENT_FILL_RECT_H"},
+		{Val: GRADIENT_FILL_RECT_V, Name: "GRADIENT_FILL_RECT_V"},
+		{Val: GRADIENT_FILL_TRIANGLE, Name: "GRADIENT_FILL_TRIANGLE"},
+	},
+}
+
+// Returns the name of the constant, like "GRADIENT_FILL_RECT_H".
+func (v GRADIENT_FILL) String() string {
+	return _GRADIENT_FILL_names.String(v)
+}
+
+// Parses the name of a [GRADIENT_FILL] constant, or a number.
+func ParseGRADIENT_FILL(s string) (GRADIENT_FILL, error) {
+	return _GRADIENT_FILL_names.Parse(s)
+}
+
+var _LCS_names = enum.Enum[LCS]{
+	Type: "LCS",
+	Values: []enum.Name[LCS]{
+		{Val: LCS_CALIBRATED_RGB, Name: "LCS_CALIBRATED_RGB"},
+		{Val: LCS_SRGB, Name: "LCS_SRGB"},
+		{Val: LCS_WINDOWS_COLOR_SPACE, Name: "LCS_WINDOWS_COLOR_SPACE"},
+		{Val: LCS_PROFILE_LINKED, Name: "LCS_PROFILE_LINKED"},
+		{Val: LCS_PROFILE_EMBEDDED, Name: "LCS_PROFILE_EMBEDDED"},
+	},
+}
+
+// Returns the name of the constant, like "LCS_CALIBRATED_RGB".
+func (v LCS) String() string {
+	return _LCS_names.String(v)
+}
+
+// Parses the name of a [LCS] constant, or a number.
+func ParseLCS(s string) (LCS, error) {
+	return _LCS_names.Parse(s)
+}
+
+var _LCS_GM_names = enum.Flags[LCS_GM]{
+	Type: "LCS_GM",
+	Values: []enum.Name[LCS_GM]{
+		{Val: LCS_GM_BUSINESS, Name: "LCS_GM_BUSINESS"},
+		{Val: LCS_GM_GRAPHICS, Name: "LCS_GM_GRAPHICS"},
+		{Val: LCS_GM_IMAGES, Name: "LCS_GM_IMAGES"},
+		{Val: LCS_GM_ABS_COLORIMETRIC, Name: "LCS_GM_ABS_COLORIMETRIC"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LCS_GM_BUSINESS|LCS_GM_GRAPHICS".
+func (v LCS_GM) String() string {
+	return _LCS_GM_names.String(v)
+}
+
+// Parses names of [LCS_GM] flags joined by "|", or numbers.
+func ParseLCS_GM(s string) (LCS_GM, error) {
+	return _LCS_GM_names.Parse(s)
+}
+
+var _OUT_PRECIS_names = enum.Enum[OUT_PRECIS]{
+	Type: "OUT_PRECIS",
+	Values: []enum.Name[OUT_PRECIS]{
+		{Val: OUT_PRECIS_DEFAULT, Name: "OUT_PRECIS_DEFAULT"},
+		{Val: OUT_PRECIS_STRING, Name: "OUT_PRECIS_STRING"},
+		{Val: OUT_PRECIS_CHARACTER, Name: "OUT_PRECIS_CHARACTER"},
+		{Val: OUT_PRECIS_STROKE, Name: "OUT_PRECIS_STROKE"},
+		{Val: OUT_PRECIS_TT, Name: "OUT_PRECIS_TT"},
+		{Val: OUT_PRECIS_DEVICE, Name: "OUT_PRECIS_DEVICE"},
+		{Val: OUT_PRECIS_RASTER, Name: "OUT_PRECIS_RASTER"},
+		{Val: OUT_PRECIS_TT_ONLY, Name: "OUT_PRECIS_TT_ONLY"},
+		{Val: OUT_PRECIS_OUTLINE, Name: "OUT_PRECIS_OUTLINE"},
+		{Val: OUT_PRECIS_SCREEN_OUTLINE, Name: "OUT_PRECIS_SCREEN_OUTLINE"},
+		{Val: OUT_PRECIS_PS_ONLY, Name: "OUT_PRECIS_PS_ONLY"},
+	},
+}
+
+// Returns the name of the constant, like "OUT_PRECIS_DEFAULT".
+func (v OUT_PRECIS) String() string {
+	return _OUT_PRECIS_names.String(v)
+}
+
+// Parses the name of a [OUT_PRECIS] constant, or a number.
+func ParseOUT_PRECIS(s string) (OUT_PRECIS, error) {
+	return _OUT_PRECIS_names.Parse(s)
+}
+
+var _PC_names = enum.Flags[PC]{
+	Type: "PC",
+	Values: []enum.Name[PC]{
+		{Val: PC_RESERVED, Name: "PC_RESERVED"},
+		{Val: PC_EXPLICIT, Name: "PC_EXPLICIT"},
+		{Val: PC_NOCOLLAPSE, Name: "PC_NOCOLLAPSE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PC_RESERVED|PC_EXPLICIT".
+func (v PC) String() string {
+	return _PC_names.String(v)
+}
+
+// Parses names of [PC] flags joined by "|", or numbers.
+func ParsePC(s string) (PC, error) {
+	return _PC_names.Parse(s)
+}
+
+var _PFD_names = enum.Flags[PFD]{
+	Type: "PFD",
+	Values: []enum.Name[PFD]{
+		{Val: PFD_DRAW_TO_WINDOW, Name: "PFD_DRAW_TO_WINDOW"},
+		{Val: PFD_DRAW_TO_BITMAP, Name: "PFD_DRAW_TO_BITMAP"},
+		{Val: PFD_SUPPORT_GDI, Name: "PFD_SUPPORT_GDI"},
+		{Val: PFD_SUPPORT_OPENGL, Name: "PFD_SUPPORT_OPENGL"},
+		{Val: PFD_GENERIC_ACCELERATED, Name: "PFD_GENERIC_ACCELERATED"},
+		{Val: PFD_GENERIC_FORMAT, Name: "PFD_GENERIC_FORMAT"},
+		{Val: PFD_NEED_PALETTE, Name: "PFD_NEED_PALETTE"},
+		{Val: PFD_NEED_SYSTEM_PALETTE, Name: "PFD_NEED_SYSTEM_PALETTE"},
+		{Val: PFD_DOUBLEBUFFER, Name: "PFD_DOUBLEBUFFER"},
+		{Val: PFD_STEREO, Name: "PFD_STEREO"},
+		{Val: PFD_SWAP_LAYER_BUFFERS, Name: "PFD_SWAP_LAYER_BUFFERS"},
+		{Val: PFD_DEPTH_DONTCARE, Name: "PFD_DEPTH_DONTCARE"},
+		{Val: PFD_DOUBLEBUFFER_DONTCARE, Name: "PFD_DOUBLEBUFFER_DONTCARE"},
+		{Val: PFD_STEREO_DONTCARE, Name: "PFD_STEREO_DONTCARE"},
+		{Val: PFD_SWAP_COPY, Name: "PFD_SWAP_COPY"},
+		{Val: PFD_SWAP_EXCHANGE, Name: "PFD_SWAP_EXCHANGE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PFD_DRAW_TO_WINDOW|PFD_DRAW_TO_BITMAP".
+func (v PFD) String() string {
+	return _PFD_names.String(v)
+}
+
+// Parses names of [PFD] flags joined by "|", or numbers.
+func ParsePFD(s string) (PFD, error) {
+	return _PFD_names.Parse(s)
+}
+
+var _PFD_TYPE_names = enum.Enum[PFD_TYPE]{
+	Type: "PFD_TYPE",
+	Values: []enum.Name[PFD_TYPE]{
+		{Val: PFD_TYPE_RGBA, Name: "PFD_TYPE_RGBA"},
+		{Val: PFD_TYPE_COLORINDEX, Name: "PFD_TYPE_COLORINDEX"},
+	},
+}
+
+// Returns the name of the constant, like "PFD_TYPE_RGBA".
+func (v PFD_TYPE) String() string {
+	return _PFD_TYPE_names.String(v)
+}
+
+// Parses the name of a [PFD_TYPE] constant, or a number.
+func ParsePFD_TYPE(s string) (PFD_TYPE, error) {
+	return _PFD_TYPE_names.Parse(s)
+}
+
+var _PITCH_names = enum.Enum[PITCH]{
+	Type: "PITCH",
+	Values: []enum.Name[PITCH]{
+		{Val: PITCH_DEFAULT, Name: "PITCH_DEFAULT"},
+		{Val: PITCH_FIXED, Name: "PITCH_FIXED"},
+		{Val: PITCH_VARIABLE, Name: "PITCH_VARIABLE"},
+	},
+}
+
+// Returns the name of the constant, like "PITCH_DEFAULT".
+func (v PITCH) String() string {
+	return _PITCH_names.String(v)
+}
+
+// Parses the name of a [PITCH] constant, or a number.
+func ParsePITCH(s string) (PITCH, error) {
+	return _PITCH_names.Parse(s)
+}
+
+var _POLYF_names = enum.Enum[POLYF]{
+	Type: "POLYF",
+	Values: []enum.Name[POLYF]{
+		{Val: POLYF_ALTERNATE, Name: "POLYF_ALTERNATE"},
+		{Val: POLYF_WINDING, Name: "POLYF_WINDING"},
+	},
+}
+
+// Returns the name of the constant, like "POLYF_ALTERNATE".
+func (v POLYF) String() string {
+	return _POLYF_names.String(v)
+}
+
+// Parses the name of a [POLYF] constant, or a number.
+func ParsePOLYF(s string) (POLYF, error) {
+	return _POLYF_names.Parse(s)
+}
+
+var _PRF_names = enum.Flags[PRF]{
+	Type: "PRF",
+	Values: []enum.Name[PRF]{
+		{Val: PRF_CHECKVISIBLE, Name: "PRF_CHECKVISIBLE"},
+		{Val: PRF_NONCLIENT, Name: "PRF_NONCLIENT"},
+		{Val: PRF_CLIENT, Name: "PRF_CLIENT"},
+		{Val: PRF_ERASEBKGND, Name: "PRF_ERASEBKGND"},
+		{Val: PRF_CHILDREN, Name: "PRF_CHILDREN"},
+		{Val: PRF_OWNED, Name: "PRF_OWNED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PRF_CHECKVISIBLE|PRF_NONCLIENT".
+func (v PRF) String() string {
+	return _PRF_names.String(v)
+}
+
+// Parses names of [PRF] flags joined by "|", or numbers.
+func ParsePRF(s string) (PRF, error) {
+	return _PRF_names.Parse(s)
+}
+
+var _PS_names = enum.Enum[PS]{
+	Type: "PS",
+	Values: []enum.Name[PS]{
+		{Val: PS_SOLID, Name: "PS_SOLID"},
+		{Val: PS_DASH, Name: "PS_DASH"},
+		{Val: PS_DOT, Name: "PS_DOT"},
+		{Val: PS_DASHDOT, Name: "PS_DASHDOT"},
+		{Val: PS_DASHDOTDOT, Name: "PS_DASHDOTDOT"},
+		{Val: PS_NULL, Name: "PS_NULL"},
+		{Val: PS_INSIDEFRAME, Name: "PS_INSIDEFRAME"},
+	},
+}
+
+// Returns the name of the constant, like "PS_SOLID".
+func (v PS) String() string {
+	return _PS_names.String(v)
+}
+
+// Parses the name of a [PS] constant, or a number.
+func ParsePS(s string) (PS, error) {
+	return _PS_names.Parse(s)
+}
+
+var _PS_ENDCAP_names = enum.Enum[PS_ENDCAP]{
+	Type: "PS_ENDCAP",
+	Values: []enum.Name[PS_ENDCAP]{
+		{Val: PS_ENDCAP_ROUND, Name: "PS_ENDCAP_ROUND"},
+		{Val: PS_ENDCAP_SQUARE, Name: "PS_ENDCAP_SQUARE"},
+		{Val: PS_ENDCAP_FLAT, Name: "PS_ENDCAP_FLAT"},
+	},
+}
+
+// Returns the name of the constant, like "PS_ENDCAP_ROUND".
+func (v PS_ENDCAP) String() string {
+	return _PS_ENDCAP_names.String(v)
+}
+
+// Parses the name of a [PS_ENDCAP] constant, or a number.
+func ParsePS_ENDCAP(s string) (PS_ENDCAP, error) {
+	return _PS_ENDCAP_names.Parse(s)
+}
+
+var _PS_STYLE_names = enum.Enum[PS_STYLE]{
+	Type: "PS_STYLE",
+	Values: []enum.Name[PS_STYLE]{
+		{Val: PS_STYLE_ALTERNATE, Name: "PS_STYLE_ALTERNATE"},
+		{Val: PS_STYLE_SOLID, Name: "PS_STYLE_SOLID"},
+		{Val: PS_STYLE_DASH, Name: "PS_STYLE_DASH"},
+		{Val: PS_STYLE_DOT, Name: "PS_STYLE_DOT"},
+		{Val: PS_STYLE_DASHDOT, Name: "PS_STYLE_DASHDOT"},
+		{Val: PS_STYLE_DASHDOTDOT, Name: "PS_STYLE_DASHDOTDOT"},
+		{Val: PS_STYLE_NULL, Name: "PS_STYLE_NULL"},
+		{Val: PS_STYLE_USERSTYLE, Name: "PS_STYLE_USERSTYLE"},
+		{Val: PS_STYLE_INSIDEFRAME, Name: "PS_STYLE_INSIDEFRAME"},
+	},
+}
+
+// Returns the name of the constant, like "PS_STYLE_ALTERNATE".
+func (v PS_STYLE) String() string {
+	return _PS_STYLE_names.String(v)
+}
+
+// Parses the name of a [PS_STYLE] constant, or a number.
+func ParsePS_STYLE(s string) (PS_STYLE, error) {
+	return _PS_STYLE_names.Parse(s)
+}
+
+var _PS_TYPE_names = enum.Enum[PS_TYPE]{
+	Type: "PS_TYPE",
+	Values: []enum.Name[PS_TYPE]{
+		{Val: PS_TYPE_COSMETIC, Name: "PS_TYPE_COSMETIC"},
+		{Val: PS_TYPE_GEOMETRIC, Name: "PS_TYPE_GEOMETRIC"},
+	},
+}
+
+// Returns the name of the constant, like "PS_TYPE_COSMETIC".
+func (v PS_TYPE) String() string {
+	return _PS_TYPE_names.String(v)
+}
+
+// Parses the name of a [PS_TYPE] constant, or a number.
+func ParsePS_TYPE(s string) (PS_TYPE, error) {
+	return _PS_TYPE_names.Parse(s)
+}
+
+var _PT_names = enum.Flags[PT]{
+	Type: "PT",
+	Values: []enum.Name[PT]{
+		{Val: PT_MOVETO, Name: "PT_MOVETO"},
+		{Val: PT_CLOSEFIGURE, Name: "PT_CLOSEFIGURE"},
+		{Val: PT_LINETO, Name: "PT_LINETO"},
+		{Val: PT_BEZIERTO, Name: "PT_BEZIERTO"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PT_CLOSEFIGURE|PT_LINETO".
+func (v PT) String() string {
+	return _PT_names.String(v)
+}
+
+// Parses names of [PT] flags joined by "|", or numbers.
+func ParsePT(s string) (PT, error) {
+	return _PT_names.Parse(s)
+}
+
+var _QUALITY_names = enum.Enum[QUALITY]{
+	Type: "QUALITY",
+	Values: []enum.Name[QUALITY]{
+		{Val: QUALITY_DEFAULT, Name: "QUALITY_DEFAULT"},
+		{Val: QUALITY_DRAFT, Name: "QUALITY_DRAFT"},
+		{Val: QUALITY_PROOF, Name: "QUALITY_PROOF"},
+		{Val: QUALITY_NONANTIALIASED, Name: "QUALITY_NONANTIALIASED"},
+		{Val: QUALITY_ANTIALIASED, Name: "QUALITY_ANTIALIASED"},
+		{Val: QUALITY_CLEARTYPE, Name: "QUALITY_CLEARTYPE"},
+		{Val: QUALITY_CLEARTYPE_NATURAL, Name: "QUALITY_CLEARTYPE_NATURAL"},
+	},
+}
+
+// Returns the name of the constant, like "QUALITY_DEFAULT".
+func (v QUALITY) String() string {
+	return _QUALITY_names.String(v)
+}
+
+// Parses the name of a [QUALITY] constant, or a number.
+func ParseQUALITY(s string) (QUALITY, error) {
+	return _QUALITY_names.Parse(s)
+}
+
+var _REGION_names = enum.Enum[REGION]{
+	Type: "REGION",
+	Values: []enum.Name[REGION]{
+		{Val: REGION_NULL, Name: "REGION_NULL"},
+		{Val: REGION_SIMPLE, Name: "REGION_SIMPLE"},
+		{Val: REGION_COMPLEX, Name: "REGION_COMPLEX"},
+	},
+}
+
+// Returns the name of the constant, like "REGION_NULL".
+func (v REGION) String() string {
+	return _REGION_names.String(v)
+}
+
+// Parses the name of a [REGION] constant, or a number.
+func ParseREGION(s string) (REGION, error) {
+	return _REGION_names.Parse(s)
+}
+
+var _RGN_names = enum.Enum[RGN]{
+	Type: "RGN",
+	Values: []enum.Name[RGN]{
+		{Val: RGN_AND, Name: "RGN_AND"},
+		{Val: RGN_OR, Name: "RGN_OR"},
+		{Val: RGN_XOR, Name: "RGN_XOR"},
+		{Val: RGN_DIFF, Name: "RGN_DIFF"},
+		{Val: RGN_COPY, Name: "RGN_COPY"},
+	},
+}
+
+// Returns the name of the constant, like "RGN_AND".
+func (v RGN) String() string {
+	return _RGN_names.String(v)
+}
+
+// Parses the name of a [RGN] constant, or a number.
+func ParseRGN(s string) (RGN, error) {
+	return _RGN_names.Parse(s)
+}
+
+var _ROP_names = enum.Enum[ROP]{
+	Type: "ROP",
+	Values: []enum.Name[ROP]{
+		{Val: ROP_SRCCOPY, Name: "ROP_SRCCOPY"},
+		{Val: ROP_SRCPAINT, Name: "ROP_SRCPAINT"},
+		{Val: ROP_SRCAND, Name: "ROP_SRCAND"},
+		{Val: ROP_SRCINVERT, Name: "ROP_SRCINVERT"},
+		{Val: ROP_SRCERASE, Name: "ROP_SRCERASE"},
+		{Val: ROP_NOTSRCCOPY, Name: "ROP_NOTSRCCOPY"},
+		{Val: ROP_NOTSRCERASE, Name: "ROP_NOTSRCERASE"},
+		{Val: ROP_MERGECOPY, Name: "ROP_MERGECOPY"},
+		{Val: ROP_MERGEPAINT, Name: "ROP_MERGEPAINT"},
+		{Val: ROP_PATCOPY, Name: "ROP_PATCOPY"},
+		{Val: ROP_PATPAINT, Name: "ROP_PATPAINT"},
+		{Val: ROP_PATINVERT, Name: "ROP_PATINVERT"},
+		{Val: ROP_DSTINVERT, Name: "ROP_DSTINVERT"},
+		{Val: ROP_BLACKNESS, Name: "ROP_BLACKNESS"},
+		{Val: ROP_WHITENESS, Name: "ROP_WHITENESS"},
+		{Val: ROP_NOMIRRORBITMAP, Name: "ROP_NOMIRRORBITMAP"},
+		{Val: ROP_CAPTUREBLT, Name: "ROP_CAPTUREBLT"},
+	},
+}
+
+// Returns the name of the constant, like "ROP_SRCCOPY".
+func (v ROP) String() string {
+	return _ROP_names.String(v)
+}
+
+// Parses the name of a [ROP] constant, or a number.
+func ParseROP(s string) (ROP, error) {
+	return _ROP_names.Parse(s)
+}
+
+var _STOCK_names = enum.Enum[STOCK]{
+	Type: "STOCK",
+	Values: []enum.Name[STOCK]{
+		{Val: STOCK_WHITE_BRUSH, Name: "STOCK_WHITE_BRUSH"},
+		{Val: STOCK_LTGRAY_BRUSH, Name: "STOCK_LTGRAY_BRUSH"},
+		{Val: STOCK_GRAY_BRUSH, Name: "STOCK_GRAY_BRUSH"},
+		{Val: STOCK_DKGRAY_BRUSH, Name: "STOCK_DKGRAY_BRUSH"},
+		{Val: STOCK_BLACK_BRUSH, Name: "STOCK_BLACK_BRUSH"},
+		{Val: STOCK_NULL_BRUSH, Name: "STOCK_NULL_BRUSH"},
+		{Val: STOCK_WHITE_PEN, Name: "STOCK_WHITE_PEN"},
+		{Val: STOCK_BLACK_PEN, Name: "STOCK_BLACK_PEN"},
+		{Val: STOCK_NULL_PEN, Name: "STOCK_NULL_PEN"},
+		{Val: STOCK_OEM_FIXED_FONT, Name: "STOCK_OEM_FIXED_FONT"},
+		{Val: STOCK_ANSI_FIXED_FONT, Name: "STOCK_ANSI_FIXED_FONT"},
+		{Val: STOCK_ANSI_VAR_FONT, Name: "STOCK_ANSI_VAR_FONT"},
+		{Val: STOCK_SYSTEM_FONT, Name: "STOCK_SYSTEM_FONT"},
+		{Val: STOCK_DEVICE_DEFAULT_FONT, Name: "STOCK_DEVICE_DEFAULT_FONT"},
+		{Val: STOCK_DEFAULT_PALETTE, Name: "STOCK_DEFAULT_PALETTE"},
+		{Val: STOCK_SYSTEM_FIXED_FONT, Name: "STOCK_SYSTEM_FIXED_FONT"},
+		{Val: STOCK_DEFAULT_GUI_FONT, Name: "STOCK_DEFAULT_GUI_FONT"},
+		{Val: STOCK_DC_BRUSH, Name: "STOCK_DC_BRUSH"},
+		{Val: STOCK_DC_PEN, Name: "STOCK_DC_PEN"},
+		{Val: STOCK_HOLLOW_BRUSH, Name: "STOCK_HOLLOW_BRUSH"},
+	},
+}
+
+// Returns the name of the constant, like "STOCK_WHITE_BRUSH".
+func (v STOCK) String() string {
+	return _STOCK_names.String(v)
+}
+
+// Parses the name of a [STOCK] constant, or a number.
+func ParseSTOCK(s string) (STOCK, error) {
+	return _STOCK_names.Parse(s)
+}
+
+var _STRETCH_names = enum.Enum[STRETCH]{
+	Type: "STRETCH",
+	Values: []enum.Name[STRETCH]{
+		{Val: STRETCH_BLACKONWHITE, Name: "STRETCH_BLACKONWHITE"},
+		{Val: STRETCH_WHITEONBLACK, Name: "STRETCH_WHITEONBLACK"},
+		{Val: STRETCH_COLORONCOLOR, Name: "STRETCH_COLORONCOLOR"},
+		{Val: STRETCH_HALFTONE, Name: "STRETCH_HALFTONE"},
+		{Val: STRETCH_ANDSCANS, Name: "STRETCH_ANDSCANS"},
+		{Val: STRETCH_ORSCANS, Name: "STRETCH_ORSCANS"},
+		{Val: STRETCH_DELETESCANS, Name: "STRETCH_DELETESCANS"},
+	},
+}
+
+// Returns the name of the constant, like "STRETCH_BLACKONWHITE".
+func (v STRETCH) String() string {
+	return _STRETCH_names.String(v)
+}
+
+// Parses the name of a [STRETCH] constant, or a number.
+func ParseSTRETCH(s string) (STRETCH, error) {
+	return _STRETCH_names.Parse(s)
+}
+
+var _TA_names = enum.Flags[TA]{
+	Type: "TA",
+	Values: []enum.Name[TA]{
+		{Val: TA_CENTER, Name: "TA_CENTER"},
+		{Val: TA_BASELINE, Name: "TA_BASELINE"},
+		{Val: TA_UPDATECP, Name: "TA_UPDATECP"},
+		{Val: TA_RIGHT, Name: "TA_RIGHT"},
+		{Val: TA_BOTTOM, Name: "TA_BOTTOM"},
+		{Val: TA_RTLREADING, Name: "TA_RTLREADING"},
+		{Val: TA_NOUPDATECP, Name: "TA_NOUPDATECP"},
+		{Val: TA_LEFT, Name: "TA_LEFT"},
+		{Val: TA_TOP, Name: "TA_TOP"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TA_UPDATECP|TA_RIGHT".
+func (v TA) String() string {
+	return _TA_names.String(v)
+}
+
+// Parses names of [TA] flags joined by "|", or numbers.
+func ParseTA(s string) (TA, error) {
+	return _TA_names.Parse(s)
+}
+
+var _TMPF_names = enum.Flags[TMPF]{
+	Type: "TMPF",
+	Values: []enum.Name[TMPF]{
+		{Val: TMPF_FIXED_PITCH, Name: "TMPF_FIXED_PITCH"},
+		{Val: TMPF_VECTOR, Name: "TMPF_VECTOR"},
+		{Val: TMPF_DEVICE, Name: "TMPF_DEVICE"},
+		{Val: TMPF_TRUETYPE, Name: "TMPF_TRUETYPE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TMPF_FIXED_PITCH|TMPF_VECTOR".
+func (v TMPF) String() string {
+	return _TMPF_names.String(v)
+}
+
+// Parses names of [TMPF] flags joined by "|", or numbers.
+func ParseTMPF(s string) (TMPF, error) {
+	return _TMPF_names.Parse(s)
+}
+
+var _ACTCTX_names = enum.Flags[ACTCTX]{
+	Type: "ACTCTX",
+	Values: []enum.Name[ACTCTX]{
+		{Val: ACTCTX_PROCESSOR_ARCHITECTURE_VALID, Name: "ACTCTX_PROCESSOR_ARCHITECTURE_VALID"},
+		{Val: ACTCTX_LANGID_VALID, Name: "ACTCTX_LANGID_VALID"},
+		{Val: ACTCTX_ASSEMBLY_DIRECTORY_VALID, Name: "ACTCTX_ASSEMBLY_DIRECTORY_VALID"},
+		{Val: ACTCTX_RESOURCE_NAME_VALID, Name: "ACTCTX_RESOURCE_NAME_VALID"},
+		{Val: ACTCTX_SET_PROCESS_DEFAULT, Name: "ACTCTX_SET_PROCESS_DEFAULT"},
+		{Val: ACTCTX_APPLICATION_NAME_VALID, Name: "ACTCTX_APPLICATION_NAME_VALID"},
+		{Val: ACTCTX_SOURCE_IS_ASSEMBLYREF, Name: "ACTCTX_SOURCE_IS_ASSEMBLYREF"},
+		{Val: ACTCTX_HMODULE_VALID, Name: "ACTCTX_HMODULE_VALID"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ACTCTX_PROCESSOR_ARCHITECTURE_VALID|ACTCTX_LANGID_VALID".
+func (v ACTCTX) String() string {
+	return _ACTCTX_names.String(v)
+}
+
+// Parses names of [ACTCTX] flags joined by "|", or numbers.
+func ParseACTCTX(s string) (ACTCTX, error) {
+	return _ACTCTX_names.Parse(s)
+}
+
+var _AFFINITY_names = enum.Enum[AFFINITY]{
+	Type: "AFFINITY",
+	Values: []enum.Name[AFFINITY]{
+		{Val: AFFINITY_NONE, Name: "AFFINITY_NONE"},
+		{Val: AFFINITY_ENABLE_AUTO_UPDATE, Name: "AFFINITY_ENABLE_AUTO_UPDATE"},
+	},
+}
+
+// Returns the name of the constant, like "AFFINITY_NONE".
+func (v AFFINITY) String() string {
+	return _AFFINITY_names.String(v)
+}
+
+// Parses the name of a [AFFINITY] constant, or a number.
+func ParseAFFINITY(s string) (AFFINITY, error) {
+	return _AFFINITY_names.Parse(s)
+}
+
+var _BROADCAST_QUERY_names = enum.Enum[BROADCAST_QUERY]{
+	Type: "BROADCAST_QUERY",
+	Values: []enum.Name[BROADCAST_QUERY]{
+		{Val: BROADCAST_QUERY_OK, Name: "BROADCAST_QUERY_OK"},
+		{Val: BROADCAST_QUERY_DENY, Name: "BROADCAST_QUERY_DENY"},
+	},
+}
+
+// Returns the name of the constant, like "BROADCAST_QUERY_OK".
+func (v BROADCAST_QUERY) String() string {
+	return _BROADCAST_QUERY_names.String(v)
+}
+
+// Parses the name of a [BROADCAST_QUERY] constant, or a number.
+func ParseBROADCAST_QUERY(s string) (BROADCAST_QUERY, error) {
+	return _BROADCAST_QUERY_names.Parse(s)
+}
+
+var _CHAR_ATTR_names = enum.Flags[CHAR_ATTR]{
+	Type: "CHAR_ATTR",
+	Values: []enum.Name[CHAR_ATTR]{
+		{Val: CHAR_ATTR_FOREGROUND_BLUE, Name: "CHAR_ATTR_FOREGROUND_BLUE"},
+		{Val: CHAR_ATTR_FOREGROUND_GREEN, Name: "CHAR_ATTR_FOREGROUND_GREEN"},
+		{Val: CHAR_ATTR_FOREGROUND_RED, Name: "CHAR_ATTR_FOREGROUND_RED"},
+		{Val: CHAR_ATTR_FOREGROUND_INTENSITY, Name: "CHAR_ATTR_FOREGROUND_INTENSITY"},
+		{Val: CHAR_ATTR_BACKGROUND_BLUE, Name: "CHAR_ATTR_BACKGROUND_BLUE"},
+		{Val: CHAR_ATTR_BACKGROUND_GREEN, Name: "CHAR_ATTR_BACKGROUND_GREEN"},
+		{Val: CHAR_ATTR_BACKGROUND_RED, Name: "CHAR_ATTR_BACKGROUND_RED"},
+		{Val: CHAR_ATTR_BACKGROUND_INTENSITY, Name: "CHAR_ATTR_BACKGROUND_INTENSITY"},
+		{Val: CHAR_ATTR_COMMON_LVB_LEADING_BYTE, Name: "CHAR_ATTR_COMMON_LVB_LEADING_BYTE"},
+		{Val: CHAR_ATTR_COMMON_LVB_TRAILING_BYTE, Name: "CHAR_ATTR_COMMON_LVB_TRAILING_BYTE"},
+		{Val: CHAR_ATTR_COMMON_LVB_GRID_HORIZONTAL, Name: "CHAR_ATTR_COMMON_LVB_GRID_HORIZONTAL"},
+		{Val: CHAR_ATTR_COMMON_LVB_GRID_LVERTICAL, Name: "CHAR_ATTR_COMMON_LVB_GRID_LVERTICAL"},
+		{Val: CHAR_ATTR_COMMON_LVB_GRID_RVERTICAL, Name: "CHAR_ATTR_COMMON_LVB_GRID_RVERTICAL"},
+		{Val: CHAR_ATTR_COMMON_LVB_REVERSE_VIDEO, Name: "CHAR_ATTR_COMMON_LVB_REVERSE_VIDEO"},
+		{Val: CHAR_ATTR_COMMON_LVB_UNDERSCORE, Name: "CHAR_ATTR_COMMON_LVB_UNDERSCORE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CHAR_ATTR_FOREGROUND_BLUE|CHAR_ATTR_FOREGROUND_GREEN".
+func (v CHAR_ATTR) String() string {
+	return _CHAR_ATTR_names.String(v)
+}
+
+// Parses names of [CHAR_ATTR] flags joined by "|", or numbers.
+func ParseCHAR_ATTR(s string) (CHAR_ATTR, error) {
+	return _CHAR_ATTR_names.Parse(s)
+}
+
+var _CKS_names = enum.Flags[CKS]{
+	Type: "CKS",
+	Values: []enum.Name[CKS]{
+		{Val: CKS_CAPSLOCK_ON, Name: "CKS_CAPSLOCK_ON"},
+		{Val: CKS_ENHANCED_KEY, Name: "CKS_ENHANCED_KEY"},
+		{Val: CKS_LEFT_ALT_PRESSED, Name: "CKS_LEFT_ALT_PRESSED"},
+		{Val: CKS_LEFT_CTRL_PRESSED, Name: "CKS_LEFT_CTRL_PRESSED"},
+		{Val: CKS_NUMLOCK_ON, Name: "CKS_NUMLOCK_ON"},
+		{Val: CKS_RIGHT_ALT_PRESSED, Name: "CKS_RIGHT_ALT_PRESSED"},
+		{Val: CKS_RIGHT_CTRL_PRESSED, Name: "CKS_RIGHT_CTRL_PRESSED"},
+		{Val: CKS_SCROLLLOCK_ON, Name: "CKS_SCROLLLOCK_ON"},
+		{Val: CKS_SHIFT_PRESSED, Name: "CKS_SHIFT_PRESSED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CKS_CAPSLOCK_ON|CKS_ENHANCED_KEY".
+func (v CKS) String() string {
+	return _CKS_names.String(v)
+}
+
+// Parses names of [CKS] flags joined by "|", or numbers.
+func ParseCKS(s string) (CKS, error) {
+	return _CKS_names.Parse(s)
+}
+
+var _CONSOLE_MODE_names = enum.Enum[CONSOLE_MODE]{
+	Type: "CONSOLE_MODE",
+	Values: []enum.Name[CONSOLE_MODE]{
+		{Val: CONSOLE_MODE_FULLSCREEN, Name: "CONSOLE_MODE_FULLSCREEN"},
+		{Val: CONSOLE_MODE_WINDOWED, Name: "CONSOLE_MODE_WINDOWED"},
+	},
+}
+
+// Returns the name of the constant, like "CONSOLE_MODE_FULLSCREEN".
+func (v CONSOLE_MODE) String() string {
+	return _CONSOLE_MODE_names.String(v)
+}
+
+// Parses the name of a [CONSOLE_MODE] constant, or a number.
+func ParseCONSOLE_MODE(s string) (CONSOLE_MODE, error) {
+	return _CONSOLE_MODE_names.Parse(s)
+}
+
+var _CP_names = enum.Enum[CP]{
+	Type: "CP",
+	Values: []enum.Name[CP]{
+		{Val: CP_ACP, Name: "CP_ACP"},
+		{Val: CP_OEMCP, Name: "CP_OEMCP"},
+		{Val: CP_MACCP, Name: "CP_MACCP"},
+		{Val: CP_THREAD_ACP, Name: "CP_THREAD_ACP"},
+		{Val: CP_SYMBOL, Name: "CP_SYMBOL"},
+		{Val: CP_IBM1026, Name: "CP_IBM1026"},
+		{Val: CP_IBM01047, Name: "CP_IBM01047"},
+		{Val: CP_IBM01140, Name: "CP_IBM01140"},
+		{Val: CP_IBM01141, Name: "CP_IBM01141"},
+		{Val: CP_IBM01142, Name: "CP_IBM01142"},
+		{Val: CP_IBM01143, Name: "CP_IBM01143"},
+		{Val: CP_IBM01144, Name: "CP_IBM01144"},
+		{Val: CP_IBM01145, Name: "CP_IBM01145"},
+		{Val: CP_IBM01146, Name: "CP_IBM01146"},
+		{Val: CP_IBM01147, Name: "CP_IBM01147"},
+		{Val: CP_IBM01148, Name: "CP_IBM01148"},
+		{Val: CP_IBM01149, Name: "CP_IBM01149"},
+		{Val: CP_UTF16, Name: "CP_UTF16"},
+		{Val: CP_UNICODE_FFFE, Name: "CP_UNICODE_FFFE"},
+		{Val: CP_WINDOWS_1250, Name: "CP_WINDOWS_1250"},
+		{Val: CP_WINDOWS_1251, Name: "CP_WINDOWS_1251"},
+		{Val: CP_WINDOWS_1252, Name: "CP_WINDOWS_1252"},
+		{Val: CP_WINDOWS_1253, Name: "CP_WINDOWS_1253"},
+		{Val: CP_WINDOWS_1254, Name: "CP_WINDOWS_1254"},
+		{Val: CP_WINDOWS_1255, Name: "CP_WINDOWS_1255"},
+		{Val: CP_WINDOWS_1256, Name: "CP_WINDOWS_1256"},
+		{Val: CP_WINDOWS_1257, Name: "CP_WINDOWS_1257"},
+		{Val: CP_WINDOWS_1258, Name: "CP_WINDOWS_1258"},
+		{Val: CP_JOHAB, Name: "CP_JOHAB"},
+		{Val: CP_MACINTOSH, Name: "CP_MACINTOSH"},
+		{Val: CP_UTF7, Name: "CP_UTF7"},
+		{Val: CP_UTF8, Name: "CP_UTF8"},
+	},
+}
+
+// Returns the name of the constant, like "CP_ACP".
+func (v CP) String() string {
+	return _CP_names.String(v)
+}
+
+// Parses the name of a [CP] constant, or a number.
+func ParseCP(s string) (CP, error) {
+	return _CP_names.Parse(s)
+}
+
+var _CREATE_names = enum.Flags[CREATE]{
+	Type: "CREATE",
+	Values: []enum.Name[CREATE]{
+		{Val: CREATE_BREAKAWAY_FROM_JOB, Name: "CREATE_BREAKAWAY_FROM_JOB"},
+		{Val: CREATE_DEFAULT_ERROR_MODE, Name: "CREATE_DEFAULT_ERROR_MODE"},
+		{Val: CREATE_NEW_CONSOLE, Name: "CREATE_NEW_CONSOLE"},
+		{Val: CREATE_NEW_PROCESS_GROUP, Name: "CREATE_NEW_PROCESS_GROUP"},
+		{Val: CREATE_NO_WINDOW, Name: "CREATE_NO_WINDOW"},
+		{Val: CREATE_PROTECTED_PROCESS, Name: "CREATE_PROTECTED_PROCESS"},
+		{Val: CREATE_PRESERVE_CODE_AUTHZ_LEVEL, Name: "CREATE_PRESERVE_CODE_AUTHZ_LEVEL"},
+		{Val: CREATE_SECURE_PROCESS, Name: "CREATE_SECURE_PROCESS"},
+		{Val: CREATE_SEPARATE_WOW_VDM, Name: "CREATE_SEPARATE_WOW_VDM"},
+		{Val: CREATE_SHARED_WOW_VDM, Name: "CREATE_SHARED_WOW_VDM"},
+		{Val: CREATE_SUSPENDED, Name: "CREATE_SUSPENDED"},
+		{Val: CREATE_UNICODE_ENVIRONMENT, Name: "CREATE_UNICODE_ENVIRONMENT"},
+		{Val: CREATE_DEBUG_ONLY_THIS_PROCESS, Name: "CREATE_DEBUG_ONLY_THIS_PROCESS"},
+		{Val: CREATE_DEBUG_PROCESS, Name: "CREATE_DEBUG_PROCESS"},
+		{Val: CREATE_DETACHED_PROCESS, Name: "CREATE_DETACHED_PROCESS"},
+		{Val: CREATE_EXTENDED_STARTUPINFO_PRESENT, Name: "CREATE_EXTENDED_STARTUPINFO_PRESENT"},
+		{Val: CREATE_INHERIT_PARENT_AFFINITY, Name: "CREATE_INHERIT_PARENT_AFFINITY"},
+		{Val: CREATE_NONE, Name: "CREATE_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CREATE_BREAKAWAY_FROM_JOB|CREATE_DEFAULT_ERROR_MODE".
+func (v CREATE) String() string {
+	return _CREATE_names.String(v)
+}
+
+// Parses names of [CREATE] flags joined by "|", or numbers.
+func ParseCREATE(s string) (CREATE, error) {
+	return _CREATE_names.Parse(s)
+}
+
+var _DBT_names = enum.Enum[DBT]{
+	Type: "DBT",
+	Values: []enum.Name[DBT]{
+		{Val: DBT_DEVNODES_CHANGED, Name: "DBT_DEVNODES_CHANGED"},
+		{Val: DBT_QUERYCHANGECONFIG, Name: "DBT_QUERYCHANGECONFIG"},
+		{Val: DBT_CONFIGCHANGED, Name: "DBT_CONFIGCHANGED"},
+		{Val: DBT_CONFIGCHANGECANCELED, Name: "DBT_CONFIGCHANGECANCELED"},
+		{Val: DBT_DEVICEARRIVAL, Name: "DBT_DEVICEARRIVAL"},
+		{Val: DBT_DEVICEQUERYREMOVE, Name: "DBT_DEVICEQUERYREMOVE"},
+		{Val: DBT_DEVICEQUERYREMOVEFAILED, Name: "DBT_DEVICEQUERYREMOVEFAILED"},
+		{Val: DBT_DEVICEREMOVEPENDING, Name: "DBT_DEVICEREMOVEPENDING"},
+		{Val: DBT_DEVICEREMOVECOMPLETE, Name: "DBT_DEVICEREMOVECOMPLETE"},
+		{Val: DBT_DEVICETYPESPECIFIC, Name: "DBT_DEVICETYPESPECIFIC"},
+		{Val: DBT_CUSTOMEVENT, Name: "DBT_CUSTOMEVENT"},
+		{Val: DBT_USERDEFINED, Name: "DBT_USERDEFINED"},
+	},
+}
+
+// Returns the name of the constant, like "DBT_DEVNODES_CHANGED".
+func (v DBT) String() string {
+	return _DBT_names.String(v)
+}
+
+// Parses the name of a [DBT] constant, or a number.
+func ParseDBT(s string) (DBT, error) {
+	return _DBT_names.Parse(s)
+}
+
+var _DEACTIVATE_ACTCTX_names = enum.Enum[DEACTIVATE_ACTCTX]{
+	Type: "DEACTIVATE_ACTCTX",
+	Values: []enum.Name[DEACTIVATE_ACTCTX]{
+		{Val: DEACTIVATE_ACTCTX_NONE, Name: "DEACTIVATE_ACTCTX_NONE"},
+		{Val: DEACTIVATE_ACTCTX_FORCE_EARLY_DEACTIVATION, Name: "DEACTIVATE_ACTCTX_FORCE_EARLY_DEACTIVATION"},
+	},
+}
+
+// Returns the name of the constant, like "DEACTIVATE_ACTCTX_NONE".
+func (v DEACTIVATE_ACTCTX) String() string {
+	return _DEACTIVATE_ACTCTX_names.String(v)
+}
+
+// Parses the name of a [DEACTIVATE_ACTCTX] constant, or a number.
+func ParseDEACTIVATE_ACTCTX(s string) (DEACTIVATE_ACTCTX, error) {
+	return _DEACTIVATE_ACTCTX_names.Parse(s)
+}
+
+var _DISPOSITION_names = enum.Enum[DISPOSITION]{
+	Type: "DISPOSITION",
+	Values: []enum.Name[DISPOSITION]{
+		{Val: DISPOSITION_CREATE_ALWAYS, Name: "DISPOSITION_CREATE_ALWAYS"},
+		{Val: DISPOSITION_CREATE_NEW, Name: "DISPOSITION_CREATE_NEW"},
+		{Val: DISPOSITION_OPEN_ALWAYS, Name: "DISPOSITION_OPEN_ALWAYS"},
+		{Val: DISPOSITION_OPEN_EXISTING, Name: "DISPOSITION_OPEN_EXISTING"},
+		{Val: DISPOSITION_TRUNCATE_EXISTING, Name: "DISPOSITION_TRUNCATE_EXISTING"},
+	},
+}
+
+// Returns the name of the constant, like "DISPOSITION_CREATE_ALWAYS".
+func (v DISPOSITION) String() string {
+	return _DISPOSITION_names.String(v)
+}
+
+// Parses the name of a [DISPOSITION] constant, or a number.
+func ParseDISPOSITION(s string) (DISPOSITION, error) {
+	return _DISPOSITION_names.Parse(s)
+}
+
+var _DUPLICATE_names = enum.Flags[DUPLICATE]{
+	Type: "DUPLICATE",
+	Values: []enum.Name[DUPLICATE]{
+		{Val: DUPLICATE_CLOSE_SOURCE, Name: "DUPLICATE_CLOSE_SOURCE"},
+		{Val: DUPLICATE_SAME_ACCESS, Name: "DUPLICATE_SAME_ACCESS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DUPLICATE_CLOSE_SOURCE|DUPLICATE_SAME_ACCESS".
+func (v DUPLICATE) String() string {
+	return _DUPLICATE_names.String(v)
+}
+
+// Parses names of [DUPLICATE] flags joined by "|", or numbers.
+func ParseDUPLICATE(s string) (DUPLICATE, error) {
+	return _DUPLICATE_names.Parse(s)
+}
+
+var _ENABLE_names = enum.Flags[ENABLE]{
+	Type: "ENABLE",
+	Values: []enum.Name[ENABLE]{
+		{Val: ENABLE_ECHO_INPUT, Name: "ENABLE_ECHO_INPUT"},
+		{Val: ENABLE_INSERT_MODE, Name: "ENABLE_INSERT_MODE"},
+		{Val: ENABLE_LINE_INPUT, Name: "ENABLE_LINE_INPUT"},
+		{Val: ENABLE_MOUSE_INPUT, Name: "ENABLE_MOUSE_INPUT"},
+		{Val: ENABLE_PROCESSED_INPUT, Name: "ENABLE_PROCESSED_INPUT"},
+		{Val: ENABLE_QUICK_EDIT_MODE, Name: "ENABLE_QUICK_EDIT_MODE"},
+		{Val: ENABLE_WINDOW_INPUT, Name: "ENABLE_WINDOW_INPUT"},
+		{Val: ENABLE_VIRTUAL_TERMINAL_INPUT, Name: "ENABLE_VIRTUAL_TERMINAL_INPUT"},
+		{Val: ENABLE_PROCESSED_OUTPUT, Name: "ENABLE_PROCESSED_OUTPUT"},
+		{Val: ENABLE_WRAP_AT_EOL_OUTPUT, Name: "ENABLE_WRAP_AT_EOL_OUTPUT"},
+		{Val: ENABLE_VIRTUAL_TERMINAL_PROCESSING, Name: "ENABLE_VIRTUAL_TERMINAL_PROCESSING"},
+		{Val: ENABLE_DISABLE_NEWLINE_AUTO_RETURN, Name: "ENABLE_DISABLE_NEWLINE_AUTO_RETURN"},
+		{Val: ENABLE_LVB_GRID_WORLDWIDE, Name: "ENABLE_LVB_GRID_WORLDWIDE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ENABLE_ECHO_INPUT|ENABLE_INSERT_MODE".
+func (v ENABLE) String() string {
+	return _ENABLE_names.String(v)
+}
+
+// Parses names of [ENABLE] flags joined by "|", or numbers.
+func ParseENABLE(s string) (ENABLE, error) {
+	return _ENABLE_names.Parse(s)
+}
+
+var _ENDSESSION_names = enum.Flags[ENDSESSION]{
+	Type: "ENDSESSION",
+	Values: []enum.Name[ENDSESSION]{
+		{Val: ENDSESSION_CLOSEAPP, Name: "ENDSESSION_CLOSEAPP"},
+		{Val: ENDSESSION_CRITICAL, Name: "ENDSESSION_CRITICAL"},
+		{Val: ENDSESSION_LOGOFF, Name: "ENDSESSION_LOGOFF"},
+		{Val: ENDSESSION_RESTARTORSHUTDOWN, Name: "ENDSESSION_RESTARTORSHUTDOWN"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ENDSESSION_CLOSEAPP|ENDSESSION_CRITICAL".
+func (v ENDSESSION) String() string {
+	return _ENDSESSION_names.String(v)
+}
+
+// Parses names of [ENDSESSION] flags joined by "|", or numbers.
+func ParseENDSESSION(s string) (ENDSESSION, error) {
+	return _ENDSESSION_names.Parse(s)
+}
+
+var _EXS_names = enum.Flags[EXS]{
+	Type: "EXS",
+	Values: []enum.Name[EXS]{
+		{Val: EXS_AWAYMODE_REQUIRED, Name: "EXS_AWAYMODE_REQUIRED"},
+		{Val: EXS_CONTINUOUS, Name: "EXS_CONTINUOUS"},
+		{Val: EXS_DISPLAY_REQUIRED, Name: "EXS_DISPLAY_REQUIRED"},
+		{Val: EXS_SYSTEM_REQUIRED, Name: "EXS_SYSTEM_REQUIRED"},
+		{Val: EXS_USER_PRESENT, Name: "EXS_USER_PRESENT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "EXS_AWAYMODE_REQUIRED|EXS_CONTINUOUS".
+func (v EXS) String() string {
+	return _EXS_names.String(v)
+}
+
+// Parses names of [EXS] flags joined by "|", or numbers.
+func ParseEXS(s string) (EXS, error) {
+	return _EXS_names.Parse(s)
+}
+
+var _FILE_ATTRIBUTE_names = enum.Flags[FILE_ATTRIBUTE]{
+	Type: "FILE_ATTRIBUTE",
+	Values: []enum.Name[FILE_ATTRIBUTE]{
+		{Val: FILE_ATTRIBUTE_INVALID, Name: "FILE_ATTRIBUTE_INVALID"},
+		{Val: FILE_ATTRIBUTE_READONLY, Name: "FILE_ATTRIBUTE_READONLY"},
+		{Val: FILE_ATTRIBUTE_HIDDEN, Name: "FILE_ATTRIBUTE_HIDDEN"},
+		{Val: FILE_ATTRIBUTE_SYSTEM, Name: "FILE_ATTRIBUTE_SYSTEM"},
+		{Val: FILE_ATTRIBUTE_DIRECTORY, Name: "FILE_ATTRIBUTE_DIRECTORY"},
+		{Val: FILE_ATTRIBUTE_ARCHIVE, Name: "FILE_ATTRIBUTE_ARCHIVE"},
+		{Val: FILE_ATTRIBUTE_DEVICE, Name: "FILE_ATTRIBUTE_DEVICE"},
+		{Val: FILE_ATTRIBUTE_NORMAL, Name: "FILE_ATTRIBUTE_NORMAL"},
+		{Val: FILE_ATTRIBUTE_TEMPORARY, Name: "FILE_ATTRIBUTE_TEMPORARY"},
+		{Val: FILE_ATTRIBUTE_SPARSE_FILE, Name: "FILE_ATTRIBUTE_SPARSE_FILE"},
+		{Val: FILE_ATTRIBUTE_REPARSE_POINT, Name: "FILE_ATTRIBUTE_REPARSE_POINT"},
+		{Val: FILE_ATTRIBUTE_COMPRESSED, Name: "FILE_ATTRIBUTE_COMPRESSED"},
+		{Val: FILE_ATTRIBUTE_OFFLINE, Name: "FILE_ATTRIBUTE_OFFLINE"},
+		{Val: FILE_ATTRIBUTE_NOT_CONTENT_INDEXED, Name: "FILE_ATTRIBUTE_NOT_CONTENT_INDEXED"},
+		{Val: FILE_ATTRIBUTE_ENCRYPTED, Name: "FILE_ATTRIBUTE_ENCRYPTED"},
+		{Val: FILE_ATTRIBUTE_INTEGRITY_STREAM, Name: "FILE_ATTRIBUTE_INTEGRITY_STREAM"},
+		{Val: FILE_ATTRIBUTE_VIRTUAL, Name: "FILE_ATTRIBUTE_VIRTUAL"},
+		{Val: FILE_ATTRIBUTE_NO_SCRUB_DATA, Name: "FILE_ATTRIBUTE_NO_SCRUB_DATA"},
+		{Val: FILE_ATTRIBUTE_EA, Name: "FILE_ATTRIBUTE_EA"},
+		{Val: FILE_ATTRIBUTE_PINNED, Name: "FILE_ATTRIBUTE_PINNED"},
+		{Val: FILE_ATTRIBUTE_UNPINNED, Name: "FILE_ATTRIBUTE_UNPINNED"},
+		{Val: FILE_ATTRIBUTE_RECALL_ON_OPEN, Name: "FILE_ATTRIBUTE_RECALL_ON_OPEN"},
+		{Val: FILE_ATTRIBUTE_RECALL_ON_DATA_ACCESS, Name: "FILE_ATTRIBUTE_RECALL_ON_DATA_ACCESS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FILE_ATTRIBUTE_READONLY|FILE_ATTRIBUTE_HIDDEN".
+func (v FILE_ATTRIBUTE) String() string {
+	return _FILE_ATTRIBUTE_names.String(v)
+}
+
+// Parses names of [FILE_ATTRIBUTE] flags joined by "|", or numbers.
+func ParseFILE_ATTRIBUTE(s string) (FILE_ATTRIBUTE, error) {
+	return _FILE_ATTRIBUTE_names.Parse(s)
+}
+
+var _FILE_FLAG_names = enum.Flags[FILE_FLAG]{
+	Type: "FILE_FLAG",
+	Values: []enum.Name[FILE_FLAG]{
+		{Val: FILE_FLAG_WRITE_THROUGH, Name: "FILE_FLAG_WRITE_THROUGH"},
+		{Val: FILE_FLAG_OVERLAPPED, Name: "FILE_FLAG_OVERLAPPED"},
+		{Val: FILE_FLAG_NO_BUFFERING, Name: "FILE_FLAG_NO_BUFFERING"},
+		{Val: FILE_FLAG_RANDOM_ACCESS, Name: "FILE_FLAG_RANDOM_ACCESS"},
+		{Val: FILE_FLAG_SEQUENTIAL_SCAN, Name: "FILE_FLAG_SEQUENTIAL_SCAN"},
+		{Val: FILE_FLAG_DELETE_ON_CLOSE, Name: "FILE_FLAG_DELETE_ON_CLOSE"},
+		{Val: FILE_FLAG_BACKUP_SEMANTICS, Name: "FILE_FLAG_BACKUP_SEMANTICS"},
+		{Val: FILE_FLAG_POSIX_SEMANTICS, Name: "FILE_FLAG_POSIX_SEMANTICS"},
+		{Val: FILE_FLAG_SESSION_AWARE, Name: "FILE_FLAG_SESSION_AWARE"},
+		{Val: FILE_FLAG_OPEN_REPARSE_POINT, Name: "FILE_FLAG_OPEN_REPARSE_POINT"},
+		{Val: FILE_FLAG_OPEN_NO_RECALL, Name: "FILE_FLAG_OPEN_NO_RECALL"},
+		{Val: FILE_FLAG_FIRST_PIPE_INSTANCE, Name: "FILE_FLAG_FIRST_PIPE_INSTANCE"},
+		{Val: FILE_FLAG_OPEN_REQUIRING_OPLOCK, Name: "FILE_FLAG_OPEN_REQUIRING_OPLOCK"},
+		{Val: FILE_FLAG_NONE, Name: "FILE_FLAG_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FILE_FLAG_WRITE_THROUGH|FILE_FLAG_OVERLAPPED".
+func (v FILE_FLAG) String() string {
+	return _FILE_FLAG_names.String(v)
+}
+
+// Parses names of [FILE_FLAG] flags joined by "|", or numbers.
+func ParseFILE_FLAG(s string) (FILE_FLAG, error) {
+	return _FILE_FLAG_names.Parse(s)
+}
+
+var _FILE_FROM_names = enum.Enum[FILE_FROM]{
+	Type: "FILE_FROM",
+	Values: []enum.Name[FILE_FROM]{
+		{Val: FILE_FROM_BEGIN, Name: "FILE_FROM_BEGIN"},
+		{Val: FILE_FROM_CURRENT, Name: "FILE_FROM_CURRENT"},
+		{Val: FILE_FROM_END, Name: "FILE_FROM_END"},
+	},
+}
+
+// Returns the name of the constant, like "FILE_FROM_BEGIN".
+func (v FILE_FROM) String() string {
+	return _FILE_FROM_names.String(v)
+}
+
+// Parses the name of a [FILE_FROM] constant, or a number.
+func ParseFILE_FROM(s string) (FILE_FROM, error) {
+	return _FILE_FROM_names.Parse(s)
+}
+
+var _FILE_MAP_names = enum.Flags[FILE_MAP]{
+	Type: "FILE_MAP",
+	Values: []enum.Name[FILE_MAP]{
+		{Val: FILE_MAP_COPY, Name: "FILE_MAP_COPY"},
+		{Val: FILE_MAP_RESERVE, Name: "FILE_MAP_RESERVE"},
+		{Val: FILE_MAP_TARGETS_INVALID, Name: "FILE_MAP_TARGETS_INVALID"},
+		{Val: FILE_MAP_LARGE_PAGES, Name: "FILE_MAP_LARGE_PAGES"},
+		{Val: FILE_MAP_ALL_ACCESS, Name: "FILE_MAP_ALL_ACCESS"},
+		{Val: FILE_MAP_WRITE, Name: "FILE_MAP_WRITE"},
+		{Val: FILE_MAP_READ, Name: "FILE_MAP_READ"},
+		{Val: FILE_MAP_EXECUTE, Name: "FILE_MAP_EXECUTE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FILE_MAP_COPY|FILE_MAP_RESERVE".
+func (v FILE_MAP) String() string {
+	return _FILE_MAP_names.String(v)
+}
+
+// Parses names of [FILE_MAP] flags joined by "|", or numbers.
+func ParseFILE_MAP(s string) (FILE_MAP, error) {
+	return _FILE_MAP_names.Parse(s)
+}
+
+var _FILE_SHARE_names = enum.Flags[FILE_SHARE]{
+	Type: "FILE_SHARE",
+	Values: []enum.Name[FILE_SHARE]{
+		{Val: FILE_SHARE_READ, Name: "FILE_SHARE_READ"},
+		{Val: FILE_SHARE_WRITE, Name: "FILE_SHARE_WRITE"},
+		{Val: FILE_SHARE_DELETE, Name: "FILE_SHARE_DELETE"},
+		{Val: FILE_SHARE_NONE, Name: "FILE_SHARE_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FILE_SHARE_READ|FILE_SHARE_WRITE".
+func (v FILE_SHARE) String() string {
+	return _FILE_SHARE_names.String(v)
+}
+
+// Parses names of [FILE_SHARE] flags joined by "|", or numbers.
+func ParseFILE_SHARE(s string) (FILE_SHARE, error) {
+	return _FILE_SHARE_names.Parse(s)
+}
+
+var _FOPEN_names = enum.Enum[FOPEN]{
+	Type: "FOPEN",
+	Values: []enum.Name[FOPEN]{
+		{Val: FOPEN_RW_EXISTING, Name: "FOPEN_RW_EXISTING"},
+		{Val: FOPEN_RW_OPEN_OR_CREATE, Name: "FOPEN_RW_OPEN_OR_CREATE"},
+		{Val: FOPEN_RW_CREATE, Name: "FOPEN_RW_CREATE"},
+		{Val: FOPEN_READ_EXISTING, Name: "FOPEN_READ_EXISTING"},
+	},
+}
+
+// Returns the name of the constant, like "FOPEN_RW_EXISTING".
+func (v FOPEN) String() string {
+	return _FOPEN_names.String(v)
+}
+
+// Parses the name of a [FOPEN] constant, or a number.
+func ParseFOPEN(s string) (FOPEN, error) {
+	return _FOPEN_names.Parse(s)
+}
+
+var _GENERIC_names = enum.Flags[GENERIC]{
+	Type: "GENERIC",
+	Values: []enum.Name[GENERIC]{
+		{Val: GENERIC_READ, Name: "GENERIC_READ"},
+		{Val: GENERIC_WRITE, Name: "GENERIC_WRITE"},
+		{Val: GENERIC_EXECUTE, Name: "GENERIC_EXECUTE"},
+		{Val: GENERIC_ALL, Name: "GENERIC_ALL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "GENERIC_READ|GENERIC_WRITE".
+func (v GENERIC) String() string {
+	return _GENERIC_names.String(v)
+}
+
+// Parses names of [GENERIC] flags joined by "|", or numbers.
+func ParseGENERIC(s string) (GENERIC, error) {
+	return _GENERIC_names.Parse(s)
+}
+
+var _GMEM_names = enum.Flags[GMEM]{
+	Type: "GMEM",
+	Values: []enum.Name[GMEM]{
+		{Val: GMEM_VALID_FLAGS, Name: "GMEM_VALID_FLAGS"},
+		{Val: GMEM_LOCKCOUNT, Name: "GMEM_LOCKCOUNT"},
+		{Val: GMEM_GHND, Name: "GMEM_GHND"},
+		{Val: GMEM_MOVEABLE, Name: "GMEM_MOVEABLE"},
+		{Val: GMEM_NOCOMPACT, Name: "GMEM_NOCOMPACT"},
+		{Val: GMEM_NODISCARD, Name: "GMEM_NODISCARD"},
+		{Val: GMEM_ZEROINIT, Name: "GMEM_ZEROINIT"},
+		{Val: GMEM_MODIFY, Name: "GMEM_MODIFY"},
+		{Val: GMEM_DISCARDABLE, Name: "GMEM_DISCARDABLE"},
+		{Val: GMEM_NOT_BANKED, Name: "GMEM_NOT_BANKED"},
+		{Val: GMEM_SHARE, Name: "GMEM_SHARE"},
+		{Val: GMEM_DDESHARE, Name: "GMEM_DDESHARE"},
+		{Val: GMEM_NOTIFY, Name: "GMEM_NOTIFY"},
+		{Val: GMEM_INVALID_HANDLE, Name: "GMEM_INVALID_HANDLE"},
+		{Val: GMEM_GPTR, Name: "GMEM_GPTR"},
+		{Val: GMEM_DISCARDED, Name: "GMEM_DISCARDED"},
+		{Val: GMEM_FIXED, Name: "GMEM_FIXED"},
+		{Val: GMEM_LOWER, Name: "GMEM_LOWER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "GMEM_MOVEABLE|GMEM_NOCOMPACT".
+func (v GMEM) String() string {
+	return _GMEM_names.String(v)
+}
+
+// Parses names of [GMEM] flags joined by "|", or numbers.
+func ParseGMEM(s string) (GMEM, error) {
+	return _GMEM_names.Parse(s)
+}
+
+var _HANDLE_FLAG_names = enum.Flags[HANDLE_FLAG]{
+	Type: "HANDLE_FLAG",
+	Values: []enum.Name[HANDLE_FLAG]{
+		{Val: HANDLE_FLAG_INHERIT, Name: "HANDLE_FLAG_INHERIT"},
+		{Val: HANDLE_FLAG_PROTECT_FROM_CLOSE, Name: "HANDLE_FLAG_PROTECT_FROM_CLOSE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HANDLE_FLAG_INHERIT|HANDLE_FLAG_PROTECT_FROM_CLOSE".
+func (v HANDLE_FLAG) String() string {
+	return _HANDLE_FLAG_names.String(v)
+}
+
+// Parses names of [HANDLE_FLAG] flags joined by "|", or numbers.
+func ParseHANDLE_FLAG(s string) (HANDLE_FLAG, error) {
+	return _HANDLE_FLAG_names.Parse(s)
+}
+
+var _HEAP_ALLOC_names = enum.Flags[HEAP_ALLOC]{
+	Type: "HEAP_ALLOC",
+	Values: []enum.Name[HEAP_ALLOC]{
+		{Val: HEAP_ALLOC_GENERATE_EXCEPTIONS, Name: "HEAP_ALLOC_GENERATE_EXCEPTIONS"},
+		{Val: HEAP_ALLOC_NO_SERIALIZE, Name: "HEAP_ALLOC_NO_SERIALIZE"},
+		{Val: HEAP_ALLOC_ZERO_MEMORY, Name: "HEAP_ALLOC_ZERO_MEMORY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HEAP_ALLOC_GENERATE_EXCEPTIONS|HEAP_ALLOC_NO_SERIALIZE".
+func (v HEAP_ALLOC) String() string {
+	return _HEAP_ALLOC_names.String(v)
+}
+
+// Parses names of [HEAP_ALLOC] flags joined by "|", or numbers.
+func ParseHEAP_ALLOC(s string) (HEAP_ALLOC, error) {
+	return _HEAP_ALLOC_names.Parse(s)
+}
+
+var _HEAP_CREATE_names = enum.Flags[HEAP_CREATE]{
+	Type: "HEAP_CREATE",
+	Values: []enum.Name[HEAP_CREATE]{
+		{Val: HEAP_CREATE_ENABLE_EXECUTE, Name: "HEAP_CREATE_ENABLE_EXECUTE"},
+		{Val: HEAP_CREATE_GENERATE_EXCEPTIONS, Name: "HEAP_CREATE_GENERATE_EXCEPTIONS"},
+		{Val: HEAP_CREATE_NO_SERIALIZE, Name: "HEAP_CREATE_NO_SERIALIZE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HEAP_CREATE_ENABLE_EXECUTE|HEAP_CREATE_GENERATE_EXCEPTIONS".
+func (v HEAP_CREATE) String() string {
+	return _HEAP_CREATE_names.String(v)
+}
+
+// Parses names of [HEAP_CREATE] flags joined by "|", or numbers.
+func ParseHEAP_CREATE(s string) (HEAP_CREATE, error) {
+	return _HEAP_CREATE_names.Parse(s)
+}
+
+var _HEAP_INFO_names = enum.Enum[HEAP_INFO]{
+	Type: "HEAP_INFO",
+	Values: []enum.Name[HEAP_INFO]{
+		{Val: HEAP_INFO_CompatibilityInformation, Name: "HEAP_INFO_CompatibilityInformation"},
+		{Val: HEAP_INFO_EnableTerminationOnCorruption, Name: "HEAP_INFO_EnableTerminationOnCorruption"},
+		{Val: HEAP_INFO_HeapOptimizeResources, Name: "HEAP_INFO_HeapOptimizeResources"},
+	},
+}
+
+// Returns the name of the constant, like "HEAP_INFO_CompatibilityInformation".
+func (v HEAP_INFO) String() string {
+	return _HEAP_INFO_names.String(v)
+}
+
+// Parses the name of a [HEAP_INFO] constant, or a number.
+func ParseHEAP_INFO(s string) (HEAP_INFO, error) {
+	return _HEAP_INFO_names.Parse(s)
+}
+
+var _HEAP_NS_names = enum.Enum[HEAP_NS]{
+	Type: "HEAP_NS",
+	Values: []enum.Name[HEAP_NS]{
+		{Val: HEAP_NS_NONE, Name: "HEAP_NS_NONE"},
+		{Val: HEAP_NS_NO_SERIALIZE, Name: "HEAP_NS_NO_SERIALIZE"},
+	},
+}
+
+// Returns the name of the constant, like "HEAP_NS_NONE".
+func (v HEAP_NS) String() string {
+	return _HEAP_NS_names.String(v)
+}
+
+// Parses the name of a [HEAP_NS] constant, or a number.
+func ParseHEAP_NS(s string) (HEAP_NS, error) {
+	return _HEAP_NS_names.Parse(s)
+}
+
+var _HEAP_REALLOC_names = enum.Flags[HEAP_REALLOC]{
+	Type: "HEAP_REALLOC",
+	Values: []enum.Name[HEAP_REALLOC]{
+		{Val: HEAP_REALLOC_GENERATE_EXCEPTIONS, Name: "HEAP_REALLOC_GENERATE_EXCEPTIONS"},
+		{Val: HEAP_REALLOC_NO_SERIALIZE, Name: "HEAP_REALLOC_NO_SERIALIZE"},
+		{Val: HEAP_REALLOC_REALLOC_IN_PLACE_ONLY, Name: "HEAP_REALLOC_REALLOC_IN_PLACE_ONLY"},
+		{Val: HEAP_REALLOC_ZERO_MEMORY, Name: "HEAP_REALLOC_ZERO_MEMORY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HEAP_REALLOC_GENERATE_EXCEPTIONS|HEAP_REALLOC_NO_SERIALIZE".
+func (v HEAP_REALLOC) String() string {
+	return _HEAP_REALLOC_names.String(v)
+}
+
+// Parses names of [HEAP_REALLOC] flags joined by "|", or numbers.
+func ParseHEAP_REALLOC(s string) (HEAP_REALLOC, error) {
+	return _HEAP_REALLOC_names.Parse(s)
+}
+
+var _LANG_names = enum.Enum[LANG]{
+	Type: "LANG",
+	Values: []enum.Name[LANG]{
+		{Val: LANG_NEUTRAL, Name: "LANG_NEUTRAL"},
+		{Val: LANG_INVARIANT, Name: "LANG_INVARIANT"},
+		{Val: LANG_AFRIKAANS, Name: "LANG_AFRIKAANS"},
+		{Val: LANG_ALBANIAN, Name: "LANG_ALBANIAN"},
+		{Val: LANG_ALSATIAN, Name: "LANG_ALSATIAN"},
+		{Val: LANG_AMHARIC, Name: "LANG_AMHARIC"},
+		{Val: LANG_ARABIC, Name: "LANG_ARABIC"},
+		{Val: LANG_ARMENIAN, Name: "LANG_ARMENIAN"},
+		{Val: LANG_ASSAMESE, Name: "LANG_ASSAMESE"},
+		{Val: LANG_AZERI, Name: "LANG_AZERI"},
+		{Val: LANG_AZERBAIJANI, Name: "LANG_AZERBAIJANI"},
+		{Val: LANG_BANGLA, Name: "LANG_BANGLA"},
+		{Val: LANG_BASHKIR, Name: "LANG_BASHKIR"},
+		{Val: LANG_BASQUE, Name: "LANG_BASQUE"},
+		{Val: LANG_BELARUSIAN, Name: "LANG_BELARUSIAN"},
+		{Val: LANG_BENGALI, Name: "LANG_BENGALI"},
+		{Val: LANG_BRETON, Name: "LANG_BRETON"},
+		{Val: LANG_BOSNIAN, Name: "LANG_BOSNIAN"},
+		{Val: LANG_BOSNIAN_NEUTRAL, Name: "LANG_BOSNIAN_NEUTRAL"},
+		{Val: LANG_BULGARIAN, Name: "LANG_BULGARIAN"},
+		{Val: LANG_CATALAN, Name: "LANG_CATALAN"},
+		{Val: LANG_CENTRAL_KURDISH, Name: "LANG_CENTRAL_KURDISH"},
+		{Val: LANG_CHEROKEE, Name: "LANG_CHEROKEE"},
+		{Val: LANG_CHINESE, Name: "LANG_CHINESE"},
+		{Val: LANG_CHINESE_SIMPLIFIED, Name: "LANG_CHINESE_SIMPLIFIED"},
+		{Val: LANG_CHINESE_TRADITIONAL, Name: "LANG_CHINESE_TRADITIONAL"},
+		{Val: LANG_CORSICAN, Name: "LANG_CORSICAN"},
+		{Val: LANG_CROATIAN, Name: "LANG_CROATIAN"},
+		{Val: LANG_CZECH, Name: "LANG_CZECH"},
+		{Val: LANG_DANISH, Name: "LANG_DANISH"},
+		{Val: LANG_DARI, Name: "LANG_DARI"},
+		{Val: LANG_DIVEHI, Name: "LANG_DIVEHI"},
+		{Val: LANG_DUTCH, Name: "LANG_DUTCH"},
+		{Val: LANG_ENGLISH, Name: "LANG_ENGLISH"},
+		{Val: LANG_ESTONIAN, Name: "LANG_ESTONIAN"},
+		{Val: LANG_FAEROESE, Name: "LANG_FAEROESE"},
+		{Val: LANG_FARSI, Name: "LANG_FARSI"},
+		{Val: LANG_FILIPINO, Name: "LANG_FILIPINO"},
+		{Val: LANG_FINNISH, Name: "LANG_FINNISH"},
+		{Val: LANG_FRENCH, Name: "LANG_FRENCH"},
+		{Val: LANG_FRISIAN, Name: "LANG_FRISIAN"},
+		{Val: LANG_FULAH, Name: "LANG_FULAH"},
+		{Val: LANG_GALICIAN, Name: "LANG_GALICIAN"},
+		{Val: LANG_GEORGIAN, Name: "LANG_GEORGIAN"},
+		{Val: LANG_GERMAN, Name: "LANG_GERMAN"},
+		{Val: LANG_GREEK, Name: "LANG_GREEK"},
+		{Val: LANG_GREENLANDIC, Name: "LANG_GREENLANDIC"},
+		{Val: LANG_GUJARATI, Name: "LANG_GUJARATI"},
+		{Val: LANG_HAUSA, Name: "LANG_HAUSA"},
+		{Val: LANG_HAWAIIAN, Name: "LANG_HAWAIIAN"},
+		{Val: LANG_HEBREW, Name: "LANG_HEBREW"},
+		{Val: LANG_HINDI, Name: "LANG_HINDI"},
+		{Val: LANG_HUNGARIAN, Name: "LANG_HUNGARIAN"},
+		{Val: LANG_ICELANDIC, Name: "LANG_ICELANDIC"},
+		{Val: LANG_IGBO, Name: "LANG_IGBO"},
+		{Val: LANG_INDONESIAN, Name: "LANG_INDONESIAN"},
+		{Val: LANG_INUKTITUT, Name: "LANG_INUKTITUT"},
+		{Val: LANG_IRISH, Name: "LANG_IRISH"},
+		{Val: LANG_ITALIAN, Name: "LANG_ITALIAN"},
+		{Val: LANG_JAPANESE, Name: "LANG_JAPANESE"},
+		{Val: LANG_KANNADA, Name: "LANG_KANNADA"},
+		{Val: LANG_KASHMIRI, Name: "LANG_KASHMIRI"},
+		{Val: LANG_KAZAK, Name: "LANG_KAZAK"},
+		{Val: LANG_KHMER, Name: "LANG_KHMER"},
+		{Val: LANG_KICHE, Name: "LANG_KICHE"},
+		{Val: LANG_KINYARWANDA, Name: "LANG_KINYARWANDA"},
+		{Val: LANG_KONKANI, Name: "LANG_KONKANI"},
+		{Val: LANG_KOREAN, Name: "LANG_KOREAN"},
+		{Val: LANG_KYRGYZ, Name: "LANG_KYRGYZ"},
+		{Val: LANG_LAO, Name: "LANG_LAO"},
+		{Val: LANG_LATVIAN, Name: "LANG_LATVIAN"},
+		{Val: LANG_LITHUANIAN, Name: "LANG_LITHUANIAN"},
+		{Val: LANG_LOWER_SORBIAN, Name: "LANG_LOWER_SORBIAN"},
+		{Val: LANG_LUXEMBOURGISH, Name: "LANG_LUXEMBOURGISH"},
+		{Val: LANG_MACEDONIAN, Name: "LANG_MACEDONIAN"},
+		{Val: LANG_MALAY, Name: "LANG_MALAY"},
+		{Val: LANG_MALAYALAM, Name: "LANG_MALAYALAM"},
+		{Val: LANG_MALTESE, Name: "LANG_MALTESE"},
+		{Val: LANG_MANIPURI, Name: "LANG_MANIPURI"},
+		{Val: LANG_MAORI, Name: "LANG_MAORI"},
+		{Val: LANG_MAPUDUNGUN, Name: "LANG_MAPUDUNGUN"},
+		{Val: LANG_MARATHI, Name: "LANG_MARATHI"},
+		{Val: LANG_MOHAWK, Name: "LANG_MOHAWK"},
+		{Val: LANG_MONGOLIAN, Name: "LANG_MONGOLIAN"},
+		{Val: LANG_NEPALI, Name: "LANG_NEPALI"},
+		{Val: LANG_NORWEGIAN, Name: "LANG_NORWEGIAN"},
+		{Val: LANG_OCCITAN, Name: "LANG_OCCITAN"},
+		{Val: LANG_ODIA, Name: "LANG_ODIA"},
+		{Val: LANG_ORIYA, Name: "LANG_ORIYA"},
+		{Val: LANG_PASHTO, Name: "LANG_PASHTO"},
+		{Val: LANG_PERSIAN, Name: "LANG_PERSIAN"},
+		{Val: LANG_POLISH, Name: "LANG_POLISH"},
+		{Val: LANG_PORTUGUESE, Name: "LANG_PORTUGUESE"},
+		{Val: LANG_PULAR, Name: "LANG_PULAR"},
+		{Val: LANG_PUNJABI, Name: "LANG_PUNJABI"},
+		{Val: LANG_QUECHUA, Name: "LANG_QUECHUA"},
+		{Val: LANG_ROMANIAN, Name: "LANG_ROMANIAN"},
+		{Val: LANG_ROMANSH, Name: "LANG_ROMANSH"},
+		{Val: LANG_RUSSIAN, Name: "LANG_RUSSIAN"},
+		{Val: LANG_SAKHA, Name: "LANG_SAKHA"},
+		{Val: LANG_SAMI, Name: "LANG_SAMI"},
+		{Val: LANG_SANSKRIT, Name: "LANG_SANSKRIT"},
+		{Val: LANG_SCOTTISH_GAELIC, Name: "LANG_SCOTTISH_GAELIC"},
+		{Val: LANG_SERBIAN, Name: "LANG_SERBIAN"},
+		{Val: LANG_SERBIAN_NEUTRAL, Name: "LANG_SERBIAN_NEUTRAL"},
+		{Val: LANG_SINDHI, Name: "LANG_SINDHI"},
+		{Val: LANG_SINHALESE, Name: "LANG_SINHALESE"},
+		{Val: LANG_SLOVAK, Name: "LANG_SLOVAK"},
+		{Val: LANG_SLOVENIAN, Name: "LANG_SLOVENIAN"},
+		{Val: LANG_SOTHO, Name: "LANG_SOTHO"},
+		{Val: LANG_SPANISH, Name: "LANG_SPANISH"},
+		{Val: LANG_SWAHILI, Name: "LANG_SWAHILI"},
+		{Val: LANG_SWEDISH, Name: "LANG_SWEDISH"},
+		{Val: LANG_SYRIAC, Name: "LANG_SYRIAC"},
+		{Val: LANG_TAJIK, Name: "LANG_TAJIK"},
+		{Val: LANG_TAMAZIGHT, Name: "LANG_TAMAZIGHT"},
+		{Val: LANG_TAMIL, Name: "LANG_TAMIL"},
+		{Val: LANG_TATAR, Name: "LANG_TATAR"},
+		{Val: LANG_TELUGU, Name: "LANG_TELUGU"},
+		{Val: LANG_THAI, Name: "LANG_THAI"},
+		{Val: LANG_TIBETAN, Name: "LANG_TIBETAN"},
+		{Val: LANG_TIGRIGNA, Name: "LANG_TIGRIGNA"},
+		{Val: LANG_TIGRINYA, Name: "LANG_TIGRINYA"},
+		{Val: LANG_TSWANA, Name: "LANG_TSWANA"},
+		{Val: LANG_TURKISH, Name: "LANG_TURKISH"},
+		{Val: LANG_TURKMEN, Name: "LANG_TURKMEN"},
+		{Val: LANG_UIGHUR, Name: "LANG_UIGHUR"},
+		{Val: LANG_UKRAINIAN, Name: "LANG_UKRAINIAN"},
+		{Val: LANG_UPPER_SORBIAN, Name: "LANG_UPPER_SORBIAN"},
+		{Val: LANG_URDU, Name: "LANG_URDU"},
+		{Val: LANG_UZBEK, Name: "LANG_UZBEK"},
+		{Val: LANG_VALENCIAN, Name: "LANG_VALENCIAN"},
+		{Val: LANG_VIETNAMESE, Name: "LANG_VIETNAMESE"},
+		{Val: LANG_WELSH, Name: "LANG_WELSH"},
+		{Val: LANG_WOLOF, Name: "LANG_WOLOF"},
+		{Val: LANG_XHOSA, Name: "LANG_XHOSA"},
+		{Val: LANG_YAKUT, Name: "LANG_YAKUT"},
+		{Val: LANG_YI, Name: "LANG_YI"},
+		{Val: LANG_YORUBA, Name: "LANG_YORUBA"},
+		{Val: LANG_ZULU, Name: "LANG_ZULU"},
+	},
+}
+
+// Returns the name of the constant, like "LANG_NEUTRAL".
+func (v LANG) String() string {
+	return _LANG_names.String(v)
+}
+
+// Parses the name of a [LANG] constant, or a number.
+func ParseLANG(s string) (LANG, error) {
+	return _LANG_names.Parse(s)
+}
+
+var _LMEM_names = enum.Flags[LMEM]{
+	Type: "LMEM",
+	Values: []enum.Name[LMEM]{
+		{Val: LMEM_VALID_FLAGS, Name: "LMEM_VALID_FLAGS"},
+		{Val: LMEM_LOCKCOUNT, Name: "LMEM_LOCKCOUNT"},
+		{Val: LMEM_DISCARDABLE, Name: "LMEM_DISCARDABLE"},
+		{Val: LMEM_LHND, Name: "LMEM_LHND"},
+		{Val: LMEM_MOVEABLE, Name: "LMEM_MOVEABLE"},
+		{Val: LMEM_NOCOMPACT, Name: "LMEM_NOCOMPACT"},
+		{Val: LMEM_NODISCARD, Name: "LMEM_NODISCARD"},
+		{Val: LMEM_ZEROINIT, Name: "LMEM_ZEROINIT"},
+		{Val: LMEM_MODIFY, Name: "LMEM_MODIFY"},
+		{Val: LMEM_INVALID_HANDLE, Name: "LMEM_INVALID_HANDLE"},
+		{Val: LMEM_LPTR, Name: "LMEM_LPTR"},
+		{Val: LMEM_DISCARDED, Name: "LMEM_DISCARDED"},
+		{Val: LMEM_FIXED, Name: "LMEM_FIXED"},
+		{Val: LMEM_NONZEROLHND, Name: "LMEM_NONZEROLHND"},
+		{Val: LMEM_NONZEROLPTR, Name: "LMEM_NONZEROLPTR"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LMEM_MOVEABLE|LMEM_NOCOMPACT".
+func (v LMEM) String() string {
+	return _LMEM_names.String(v)
+}
+
+// Parses names of [LMEM] flags joined by "|", or numbers.
+func ParseLMEM(s string) (LMEM, error) {
+	return _LMEM_names.Parse(s)
+}
+
+var _LOCALE_names = enum.Enum[LOCALE]{
+	Type: "LOCALE",
+	Values: []enum.Name[LOCALE]{
+		{Val: LOCALE_NOUSEROVERRIDE, Name: "LOCALE_NOUSEROVERRIDE"},
+		{Val: LOCALE_USE_CP_ACP, Name: "LOCALE_USE_CP_ACP"},
+		{Val: LOCALE_RETURN_NUMBER, Name: "LOCALE_RETURN_NUMBER"},
+		{Val: LOCALE_RETURN_GENITIVE_NAMES, Name: "LOCALE_RETURN_GENITIVE_NAMES"},
+		{Val: LOCALE_ALLOW_NEUTRAL_NAMES, Name: "LOCALE_ALLOW_NEUTRAL_NAMES"},
+		{Val: LOCALE_ILANGUAGE, Name: "LOCALE_ILANGUAGE"},
+		{Val: LOCALE_SLIST, Name: "LOCALE_SLIST"},
+		{Val: LOCALE_SDECIMAL, Name: "LOCALE_SDECIMAL"},
+		{Val: LOCALE_STHOUSAND, Name: "LOCALE_STHOUSAND"},
+		{Val: LOCALE_SGROUPING, Name: "LOCALE_SGROUPING"},
+		{Val: LOCALE_SSHORTDATE, Name: "LOCALE_SSHORTDATE"},
+		{Val: LOCALE_SLONGDATE, Name: "LOCALE_SLONGDATE"},
+		{Val: LOCALE_SDAYNAME1, Name: "LOCALE_SDAYNAME1"},
+		{Val: LOCALE_SDAYNAME2, Name: "LOCALE_SDAYNAME2"},
+		{Val: LOCALE_SDAYNAME3, Name: "LOCALE_SDAYNAME3"},
+		{Val: LOCALE_SDAYNAME4, Name: "LOCALE_SDAYNAME4"},
+		{Val: LOCALE_SDAYNAME5, Name: "LOCALE_SDAYNAME5"},
+		{Val: LOCALE_SDAYNAME6, Name: "LOCALE_SDAYNAME6"},
+		{Val: LOCALE_SDAYNAME7, Name: "LOCALE_SDAYNAME7"},
+		{Val: LOCALE_SABBREVDAYNAME1, Name: "LOCALE_SABBREVDAYNAME1"},
+		{Val: LOCALE_SABBREVDAYNAME2, Name: "LOCALE_SABBREVDAYNAME2"},
+		{Val: LOCALE_SABBREVDAYNAME3, Name: "LOCALE_SABBREVDAYNAME3"},
+		{Val: LOCALE_SABBREVDAYNAME4, Name: "LOCALE_SABBREVDAYNAME4"},
+		{Val: LOCALE_SABBREVDAYNAME5, Name: "LOCALE_SABBREVDAYNAME5"},
+		{Val: LOCALE_SABBREVDAYNAME6, Name: "LOCALE_SABBREVDAYNAME6"},
+		{Val: LOCALE_SABBREVDAYNAME7, Name: "LOCALE_SABBREVDAYNAME7"},
+		{Val: LOCALE_SMONTHNAME1, Name: "LOCALE_SMONTHNAME1"},
+		{Val: LOCALE_SMONTHNAME2, Name: "LOCALE_SMONTHNAME2"},
+		{Val: LOCALE_SMONTHNAME3, Name: "LOCALE_SMONTHNAME3"},
+		{Val: LOCALE_SMONTHNAME4, Name: "LOCALE_SMONTHNAME4"},
+		{Val: LOCALE_SMONTHNAME5, Name: "LOCALE_SMONTHNAME5"},
+		{Val: LOCALE_SMONTHNAME6, Name: "LOCALE_SMONTHNAME6"},
+		{Val: LOCALE_SMONTHNAME7, Name: "LOCALE_SMONTHNAME7"},
+		{Val: LOCALE_SMONTHNAME8, Name: "LOCALE_SMONTHNAME8"},
+		{Val: LOCALE_SMONTHNAME9, Name: "LOCALE_SMONTHNAME9"},
+		{Val: LOCALE_SMONTHNAME10, Name: "LOCALE_SMONTHNAME10"},
+		{Val: LOCALE_SMONTHNAME11, Name: "LOCALE_SMONTHNAME11"},
+		{Val: LOCALE_SMONTHNAME12, Name: "LOCALE_SMONTHNAME12"},
+		{Val: LOCALE_SABBREVMONTHNAME1, Name: "LOCALE_SABBREVMONTHNAME1"},
+		{Val: LOCALE_SABBREVMONTHNAME2, Name: "LOCALE_SABBREVMONTHNAME2"},
+		{Val: LOCALE_SABBREVMONTHNAME3, Name: "LOCALE_SABBREVMONTHNAME3"},
+		{Val: LOCALE_SABBREVMONTHNAME4, Name: "LOCALE_SABBREVMONTHNAME4"},
+		{Val: LOCALE_SABBREVMONTHNAME5, Name: "LOCALE_SABBREVMONTHNAME5"},
+		{Val: LOCALE_SABBREVMONTHNAME6, Name: "LOCALE_SABBREVMONTHNAME6"},
+		{Val: LOCALE_SABBREVMONTHNAME7, Name: "LOCALE_SABBREVMONTHNAME7"},
+		{Val: LOCALE_SABBREVMONTHNAME8, Name: "LOCALE_SABBREVMONTHNAME8"},
+		{Val: LOCALE_SABBREVMONTHNAME9, Name: "LOCALE_SABBREVMONTHNAME9"},
+		{Val: LOCALE_SABBREVMONTHNAME10, Name: "LOCALE_SABBREVMONTHNAME10"},
+		{Val: LOCALE_SABBREVMONTHNAME11, Name: "LOCALE_SABBREVMONTHNAME11"},
+		{Val: LOCALE_SABBREVMONTHNAME12, Name: "LOCALE_SABBREVMONTHNAME12"},
+		{Val: LOCALE_SNEGATIVESIGN, Name: "LOCALE_SNEGATIVESIGN"},
+		{Val: LOCALE_SNAME, Name: "LOCALE_SNAME"},
+		{Val: LOCALE_SENGLISHDISPLAYNAME, Name: "LOCALE_SENGLISHDISPLAYNAME"},
+		{Val: LOCALE_SNATIVEDISPLAYNAME, Name: "LOCALE_SNATIVEDISPLAYNAME"},
+		{Val: LOCALE_SSHORTTIME, Name: "LOCALE_SSHORTTIME"},
+		{Val: LOCALE_SENGLISHLANGUAGENAME, Name: "LOCALE_SENGLISHLANGUAGENAME"},
+		{Val: LOCALE_SENGLISHCOUNTRYNAME, Name: "LOCALE_SENGLISHCOUNTRYNAME"},
+		{Val: LOCALE_STIMEFORMAT, Name: "LOCALE_STIMEFORMAT"},
+	},
+}
+
+// Returns the name of the constant, like "LOCALE_NOUSEROVERRIDE".
+func (v LOCALE) String() string {
+	return _LOCALE_names.String(v)
+}
+
+// Parses the name of a [LOCALE] constant, or a number.
+func ParseLOCALE(s string) (LOCALE, error) {
+	return _LOCALE_names.Parse(s)
+}
+
+var _LOCKFILE_names = enum.Flags[LOCKFILE]{
+	Type: "LOCKFILE",
+	Values: []enum.Name[LOCKFILE]{
+		{Val: LOCKFILE_FAIL_IMMEDIATELY, Name: "LOCKFILE_FAIL_IMMEDIATELY"},
+		{Val: LOCKFILE_EXCLUSIVE_LOCK, Name: "LOCKFILE_EXCLUSIVE_LOCK"},
+		{Val: LOCKFILE_NONE, Name: "LOCKFILE_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LOCKFILE_FAIL_IMMEDIATELY|LOCKFILE_EXCLUSIVE_LOCK".
+func (v LOCKFILE) String() string {
+	return _LOCKFILE_names.String(v)
+}
+
+// Parses names of [LOCKFILE] flags joined by "|", or numbers.
+func ParseLOCKFILE(s string) (LOCKFILE, error) {
+	return _LOCKFILE_names.Parse(s)
+}
+
+var _MEM_names = enum.Flags[MEM]{
+	Type: "MEM",
+	Values: []enum.Name[MEM]{
+		{Val: MEM_64K_PAGES, Name: "MEM_64K_PAGES"},
+		{Val: MEM_COMMIT, Name: "MEM_COMMIT"},
+		{Val: MEM_RESERVE, Name: "MEM_RESERVE"},
+		{Val: MEM_REPLACE_PLACEHOLDER, Name: "MEM_REPLACE_PLACEHOLDER"},
+		{Val: MEM_RESERVE_PLACEHOLDER, Name: "MEM_RESERVE_PLACEHOLDER"},
+		{Val: MEM_RESET, Name: "MEM_RESET"},
+		{Val: MEM_TOP_DOWN, Name: "MEM_TOP_DOWN"},
+		{Val: MEM_WRITE_WATCH, Name: "MEM_WRITE_WATCH"},
+		{Val: MEM_PHYSICAL, Name: "MEM_PHYSICAL"},
+		{Val: MEM_ROTATE, Name: "MEM_ROTATE"},
+		{Val: MEM_DIFFERENT_IMAGE_BASE_OK, Name: "MEM_DIFFERENT_IMAGE_BASE_OK"},
+		{Val: MEM_RESET_UNDO, Name: "MEM_RESET_UNDO"},
+		{Val: MEM_LARGE_PAGES, Name: "MEM_LARGE_PAGES"},
+		{Val: MEM_4MB_PAGES, Name: "MEM_4MB_PAGES"},
+		{Val: MEM_UNMAP_WITH_TRANSIENT_BOOST, Name: "MEM_UNMAP_WITH_TRANSIENT_BOOST"},
+		{Val: MEM_COALESCE_PLACEHOLDERS, Name: "MEM_COALESCE_PLACEHOLDERS"},
+		{Val: MEM_PRESERVE_PLACEHOLDER, Name: "MEM_PRESERVE_PLACEHOLDER"},
+		{Val: MEM_DECOMMIT, Name: "MEM_DECOMMIT"},
+		{Val: MEM_RELEASE, Name: "MEM_RELEASE"},
+		{Val: MEM_FREE, Name: "MEM_FREE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MEM_COMMIT|MEM_RESERVE".
+func (v MEM) String() string {
+	return _MEM_names.String(v)
+}
+
+// Parses names of [MEM] flags joined by "|", or numbers.
+func ParseMEM(s string) (MEM, error) {
+	return _MEM_names.Parse(s)
+}
+
+var _PACKAGE_PATH_TYPE_names = enum.Enum[PACKAGE_PATH_TYPE]{
+	Type: "PACKAGE_PATH_TYPE",
+	Values: []enum.Name[PACKAGE_PATH_TYPE]{
+		{Val: PACKAGE_PATH_TYPE_Install, Name: "PACKAGE_PATH_TYPE_Install"},
+		{Val: PACKAGE_PATH_TYPE_Mutable, Name: "PACKAGE_PATH_TYPE_Mutable"},
+		{Val: PACKAGE_PATH_TYPE_Effective, Name: "PACKAGE_PATH_TYPE_Effective"},
+		{Val: PACKAGE_PATH_TYPE_MachineExternal, Name: "PACKAGE_PATH_TYPE_MachineExternal"},
+		{Val: PACKAGE_PATH_TYPE_UserExternal, Name: "PACKAGE_PATH_TYPE_UserExternal"},
+		{Val: PACKAGE_PATH_TYPE_EffectiveExternal, Name: "PACKAGE_PATH_TYPE_EffectiveExternal"},
+	},
+}
+
+// Returns the name of the constant, like "PACKAGE_PATH_TYPE_Install".
+func (v PACKAGE_PATH_TYPE) String() string {
+	return _PACKAGE_PATH_TYPE_names.String(v)
+}
+
+// Parses the name of a [PACKAGE_PATH_TYPE] constant, or a number.
+func ParsePACKAGE_PATH_TYPE(s string) (PACKAGE_PATH_TYPE, error) {
+	return _PACKAGE_PATH_TYPE_names.Parse(s)
+}
+
+var _PAGE_names = enum.Flags[PAGE]{
+	Type: "PAGE",
+	Values: []enum.Name[PAGE]{
+		{Val: PAGE_ENCLAVE_SS_FIRST, Name: "PAGE_ENCLAVE_SS_FIRST"},
+		{Val: PAGE_ENCLAVE_SS_REST, Name: "PAGE_ENCLAVE_SS_REST"},
+		{Val: PAGE_NOACCESS, Name: "PAGE_NOACCESS"},
+		{Val: PAGE_READONLY, Name: "PAGE_READONLY"},
+		{Val: PAGE_READWRITE, Name: "PAGE_READWRITE"},
+		{Val: PAGE_WRITECOPY, Name: "PAGE_WRITECOPY"},
+		{Val: PAGE_EXECUTE, Name: "PAGE_EXECUTE"},
+		{Val: PAGE_EXECUTE_READ, Name: "PAGE_EXECUTE_READ"},
+		{Val: PAGE_EXECUTE_READWRITE, Name: "PAGE_EXECUTE_READWRITE"},
+		{Val: PAGE_EXECUTE_WRITECOPY, Name: "PAGE_EXECUTE_WRITECOPY"},
+		{Val: PAGE_GUARD, Name: "PAGE_GUARD"},
+		{Val: PAGE_NOCACHE, Name: "PAGE_NOCACHE"},
+		{Val: PAGE_WRITECOMBINE, Name: "PAGE_WRITECOMBINE"},
+		{Val: PAGE_GRAPHICS_NOACCESS, Name: "PAGE_GRAPHICS_NOACCESS"},
+		{Val: PAGE_GRAPHICS_READONLY, Name: "PAGE_GRAPHICS_READONLY"},
+		{Val: PAGE_GRAPHICS_READWRITE, Name: "PAGE_GRAPHICS_READWRITE"},
+		{Val: PAGE_GRAPHICS_EXECUTE, Name: "PAGE_GRAPHICS_EXECUTE"},
+		{Val: PAGE_GRAPHICS_EXECUTE_READ, Name: "PAGE_GRAPHICS_EXECUTE_READ"},
+		{Val: PAGE_GRAPHICS_EXECUTE_READWRITE, Name: "PAGE_GRAPHICS_EXECUTE_READWRITE"},
+		{Val: PAGE_GRAPHICS_COHERENT, Name: "PAGE_GRAPHICS_COHERENT"},
+		{Val: PAGE_GRAPHICS_NOCACHE, Name: "PAGE_GRAPHICS_NOCACHE"},
+		{Val: PAGE_ENCLAVE_THREAD_CONTROL, Name: "PAGE_ENCLAVE_THREAD_CONTROL"},
+		{Val: PAGE_REVERT_TO_FILE_MAP, Name: "PAGE_REVERT_TO_FILE_MAP"},
+		{Val: PAGE_TARGETS_NO_UPDATE, Name: "PAGE_TARGETS_NO_UPDATE"},
+		{Val: PAGE_TARGETS_INVALID, Name: "PAGE_TARGETS_INVALID"},
+		{Val: PAGE_ENCLAVE_UNVALIDATED, Name: "PAGE_ENCLAVE_UNVALIDATED"},
+		{Val: PAGE_NONE, Name: "PAGE_NONE"},
+		{Val: PAGE_ENCLAVE_DECOMMIT, Name: "PAGE_ENCLAVE_DECOMMIT"},
+	},
+	ParseOnly: []enum.Name[PAGE]{
+		{Val: PAGE_ENCLAVE_MASK, Name: "PAGE_ENCLAVE_MASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PAGE_NOACCESS|PAGE_READONLY".
+func (v PAGE) String() string {
+	return _PAGE_names.String(v)
+}
+
+// Parses names of [PAGE] flags joined by "|", or numbers.
+func ParsePAGE(s string) (PAGE, error) {
+	return _PAGE_names.Parse(s)
+}
+
+var _PBT_names = enum.Enum[PBT]{
+	Type: "PBT",
+	Values: []enum.Name[PBT]{
+		{Val: PBT_APMQUERYSUSPEND, Name: "PBT_APMQUERYSUSPEND"},
+		{Val: PBT_APMQUERYSTANDBY, Name: "PBT_APMQUERYSTANDBY"},
+		{Val: PBT_APMQUERYSUSPENDFAILED, Name: "PBT_APMQUERYSUSPENDFAILED"},
+		{Val: PBT_APMQUERYSTANDBYFAILED, Name: "PBT_APMQUERYSTANDBYFAILED"},
+		{Val: PBT_APMSUSPEND, Name: "PBT_APMSUSPEND"},
+		{Val: PBT_APMSTANDBY, Name: "PBT_APMSTANDBY"},
+		{Val: PBT_APMRESUMECRITICAL, Name: "PBT_APMRESUMECRITICAL"},
+		{Val: PBT_APMRESUMESUSPEND, Name: "PBT_APMRESUMESUSPEND"},
+		{Val: PBT_APMRESUMESTANDBY, Name: "PBT_APMRESUMESTANDBY"},
+		{Val: PBT_APMBATTERYLOW, Name: "PBT_APMBATTERYLOW"},
+		{Val: PBT_APMPOWERSTATUSCHANGE, Name: "PBT_APMPOWERSTATUSCHANGE"},
+		{Val: PBT_APMOEMEVENT, Name: "PBT_APMOEMEVENT"},
+		{Val: PBT_APMRESUMEAUTOMATIC, Name: "PBT_APMRESUMEAUTOMATIC"},
+		{Val: PBT_POWERSETTINGCHANGE, Name: "PBT_POWERSETTINGCHANGE"},
+	},
+}
+
+// Returns the name of the constant, like "PBT_APMQUERYSUSPEND".
+func (v PBT) String() string {
+	return _PBT_names.String(v)
+}
+
+// Parses the name of a [PBT] constant, or a number.
+func ParsePBT(s string) (PBT, error) {
+	return _PBT_names.Parse(s)
+}
+
+var _PIPE_names = enum.Flags[PIPE]{
+	Type: "PIPE",
+	Values: []enum.Name[PIPE]{
+		{Val: PIPE_NOWAIT, Name: "PIPE_NOWAIT"},
+		{Val: PIPE_READMODE_MESSAGE, Name: "PIPE_READMODE_MESSAGE"},
+		{Val: PIPE_TYPE_MESSAGE, Name: "PIPE_TYPE_MESSAGE"},
+		{Val: PIPE_REJECT_REMOTE_CLIENTS, Name: "PIPE_REJECT_REMOTE_CLIENTS"},
+		{Val: PIPE_WAIT, Name: "PIPE_WAIT"},
+		{Val: PIPE_READMODE_BYTE, Name: "PIPE_READMODE_BYTE"},
+		{Val: PIPE_TYPE_BYTE, Name: "PIPE_TYPE_BYTE"},
+		{Val: PIPE_ACCEPT_REMOTE_CLIENTS, Name: "PIPE_ACCEPT_REMOTE_CLIENTS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PIPE_NOWAIT|PIPE_READMODE_MESSAGE".
+func (v PIPE) String() string {
+	return _PIPE_names.String(v)
+}
+
+// Parses names of [PIPE] flags joined by "|", or numbers.
+func ParsePIPE(s string) (PIPE, error) {
+	return _PIPE_names.Parse(s)
+}
+
+var _PIPE_ACCESS_names = enum.Flags[PIPE_ACCESS]{
+	Type: "PIPE_ACCESS",
+	Values: []enum.Name[PIPE_ACCESS]{
+		{Val: PIPE_ACCESS_DUPLEX, Name: "PIPE_ACCESS_DUPLEX"},
+		{Val: PIPE_ACCESS_INBOUND, Name: "PIPE_ACCESS_INBOUND"},
+		{Val: PIPE_ACCESS_OUTBOUND, Name: "PIPE_ACCESS_OUTBOUND"},
+		{Val: PIPE_ACCESS_FIRST_PIPE_INSTANCE, Name: "PIPE_ACCESS_FIRST_PIPE_INSTANCE"},
+		{Val: PIPE_ACCESS_WRITE_THROUGH, Name: "PIPE_ACCESS_WRITE_THROUGH"},
+		{Val: PIPE_ACCESS_OVERLAPPED, Name: "PIPE_ACCESS_OVERLAPPED"},
+		{Val: PIPE_ACCESS_WRITE_DAC, Name: "PIPE_ACCESS_WRITE_DAC"},
+		{Val: PIPE_ACCESS_WRITE_OWNER, Name: "PIPE_ACCESS_WRITE_OWNER"},
+		{Val: PIPE_ACCESS_ACCESS_SYSTEM_SECURITY, Name: "PIPE_ACCESS_ACCESS_SYSTEM_SECURITY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PIPE_ACCESS_INBOUND|PIPE_ACCESS_OUTBOUND".
+func (v PIPE_ACCESS) String() string {
+	return _PIPE_ACCESS_names.String(v)
+}
+
+// Parses names of [PIPE_ACCESS] flags joined by "|", or numbers.
+func ParsePIPE_ACCESS(s string) (PIPE_ACCESS, error) {
+	return _PIPE_ACCESS_names.Parse(s)
+}
+
+var _PRIORITY_names = enum.Flags[PRIORITY]{
+	Type: "PRIORITY",
+	Values: []enum.Name[PRIORITY]{
+		{Val: PRIORITY_ABOVE_NORMAL, Name: "PRIORITY_ABOVE_NORMAL"},
+		{Val: PRIORITY_BELOW_NORMAL, Name: "PRIORITY_BELOW_NORMAL"},
+		{Val: PRIORITY_HIGH, Name: "PRIORITY_HIGH"},
+		{Val: PRIORITY_IDLE, Name: "PRIORITY_IDLE"},
+		{Val: PRIORITY_NORMAL, Name: "PRIORITY_NORMAL"},
+		{Val: PRIORITY_REALTIME, Name: "PRIORITY_REALTIME"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PRIORITY_ABOVE_NORMAL|PRIORITY_BELOW_NORMAL".
+func (v PRIORITY) String() string {
+	return _PRIORITY_names.String(v)
+}
+
+// Parses names of [PRIORITY] flags joined by "|", or numbers.
+func ParsePRIORITY(s string) (PRIORITY, error) {
+	return _PRIORITY_names.Parse(s)
+}
+
+var _PROCESS_names = enum.Flags[PROCESS]{
+	Type: "PROCESS",
+	Values: []enum.Name[PROCESS]{
+		{Val: PROCESS_ALL_ACCESS, Name: "PROCESS_ALL_ACCESS"},
+		{Val: PROCESS_DELETE, Name: "PROCESS_DELETE"},
+		{Val: PROCESS_READ_CONTROL, Name: "PROCESS_READ_CONTROL"},
+		{Val: PROCESS_SYNCHRONIZE, Name: "PROCESS_SYNCHRONIZE"},
+		{Val: PROCESS_WRITE_DAC, Name: "PROCESS_WRITE_DAC"},
+		{Val: PROCESS_WRITE_OWNER, Name: "PROCESS_WRITE_OWNER"},
+		{Val: PROCESS_CREATE_PROCESS, Name: "PROCESS_CREATE_PROCESS"},
+		{Val: PROCESS_CREATE_THREAD, Name: "PROCESS_CREATE_THREAD"},
+		{Val: PROCESS_DUP_HANDLE, Name: "PROCESS_DUP_HANDLE"},
+		{Val: PROCESS_QUERY_INFORMATION, Name: "PROCESS_QUERY_INFORMATION"},
+		{Val: PROCESS_QUERY_LIMITED_INFORMATION, Name: "PROCESS_QUERY_LIMITED_INFORMATION"},
+		{Val: PROCESS_SET_INFORMATION, Name: "PROCESS_SET_INFORMATION"},
+		{Val: PROCESS_SET_QUOTA, Name: "PROCESS_SET_QUOTA"},
+		{Val: PROCESS_SUSPEND_RESUME, Name: "PROCESS_SUSPEND_RESUME"},
+		{Val: PROCESS_TERMINATE, Name: "PROCESS_TERMINATE"},
+		{Val: PROCESS_WM_OPERATION, Name: "PROCESS_WM_OPERATION"},
+		{Val: PROCESS_VM_READ, Name: "PROCESS_VM_READ"},
+		{Val: PROCESS_VM_WRITE, Name: "PROCESS_VM_WRITE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PROCESS_DELETE|PROCESS_READ_CONTROL".
+func (v PROCESS) String() string {
+	return _PROCESS_names.String(v)
+}
+
+// Parses names of [PROCESS] flags joined by "|", or numbers.
+func ParsePROCESS(s string) (PROCESS, error) {
+	return _PROCESS_names.Parse(s)
+}
+
+var _PROCESS_NAME_names = enum.Enum[PROCESS_NAME]{
+	Type: "PROCESS_NAME",
+	Values: []enum.Name[PROCESS_NAME]{
+		{Val: PROCESS_NAME_WIN32, Name: "PROCESS_NAME_WIN32"},
+		{Val: PROCESS_NAME_NATIVE, Name: "PROCESS_NAME_NATIVE"},
+	},
+}
+
+// Returns the name of the constant, like "PROCESS_NAME_WIN32".
+func (v PROCESS_NAME) String() string {
+	return _PROCESS_NAME_names.String(v)
+}
+
+// Parses the name of a [PROCESS_NAME] constant, or a number.
+func ParsePROCESS_NAME(s string) (PROCESS_NAME, error) {
+	return _PROCESS_NAME_names.Parse(s)
+}
+
+var _PROCESSOR_names = enum.Enum[PROCESSOR]{
+	Type: "PROCESSOR",
+	Values: []enum.Name[PROCESSOR]{
+		{Val: PROCESSOR_INTEL_386, Name: "PROCESSOR_INTEL_386"},
+		{Val: PROCESSOR_INTEL_486, Name: "PROCESSOR_INTEL_486"},
+		{Val: PROCESSOR_INTEL_PENTIUM, Name: "PROCESSOR_INTEL_PENTIUM"},
+		{Val: PROCESSOR_INTEL_IA64, Name: "PROCESSOR_INTEL_IA64"},
+		{Val: PROCESSOR_AMD_X8664, Name: "PROCESSOR_AMD_X8664"},
+		{Val: PROCESSOR_MIPS_R4000, Name: "PROCESSOR_MIPS_R4000"},
+		{Val: PROCESSOR_ALPHA_21064, Name: "PROCESSOR_ALPHA_21064"},
+		{Val: PROCESSOR_PPC_601, Name: "PROCESSOR_PPC_601"},
+		{Val: PROCESSOR_PPC_603, Name: "PROCESSOR_PPC_603"},
+		{Val: PROCESSOR_PPC_604, Name: "PROCESSOR_PPC_604"},
+		{Val: PROCESSOR_PPC_620, Name: "PROCESSOR_PPC_620"},
+		{Val: PROCESSOR_HITACHI_SH3, Name: "PROCESSOR_HITACHI_SH3"},
+		{Val: PROCESSOR_HITACHI_SH3E, Name: "PROCESSOR_HITACHI_SH3E"},
+		{Val: PROCESSOR_HITACHI_SH4, Name: "PROCESSOR_HITACHI_SH4"},
+		{Val: PROCESSOR_MOTOROLA_821, Name: "PROCESSOR_MOTOROLA_821"},
+		{Val: PROCESSOR_SHx_SH3, Name: "PROCESSOR_SHx_SH3"},
+		{Val: PROCESSOR_SHx_SH4, Name: "PROCESSOR_SHx_SH4"},
+		{Val: PROCESSOR_STRONGARM, Name: "PROCESSOR_STRONGARM"},
+		{Val: PROCESSOR_ARM720, Name: "PROCESSOR_ARM720"},
+		{Val: PROCESSOR_ARM820, Name: "PROCESSOR_ARM820"},
+		{Val: PROCESSOR_ARM920, Name: "PROCESSOR_ARM920"},
+		{Val: PROCESSOR_ARM_7TDMI, Name: "PROCESSOR_ARM_7TDMI"},
+		{Val: PROCESSOR_OPTIL, Name: "PROCESSOR_OPTIL"},
+	},
+}
+
+// Returns the name of the constant, like "PROCESSOR_INTEL_386".
+func (v PROCESSOR) String() string {
+	return _PROCESSOR_names.String(v)
+}
+
+// Parses the name of a [PROCESSOR] constant, or a number.
+func ParsePROCESSOR(s string) (PROCESSOR, error) {
+	return _PROCESSOR_names.Parse(s)
+}
+
+var _PROCESSOR_ARCH_ENUM_names = enum.Enum[PROCESSOR_ARCH_ENUM]{
+	Type: "PROCESSOR_ARCH_ENUM",
+	Values: []enum.Name[PROCESSOR_ARCH_ENUM]{
+		{Val: PROCESSOR_ARCH_ENUM_X86, Name: "PROCESSOR_ARCH_ENUM_X86"},
+		{Val: PROCESSOR_ARCH_ENUM_ARM, Name: "PROCESSOR_ARCH_ENUM_ARM"},
+		{Val: PROCESSOR_ARCH_ENUM_X64, Name: "PROCESSOR_ARCH_ENUM_X64"},
+		{Val: PROCESSOR_ARCH_ENUM_NEUTRAL, Name: "PROCESSOR_ARCH_ENUM_NEUTRAL"},
+		{Val: PROCESSOR_ARCH_ENUM_ARM64, Name: "PROCESSOR_ARCH_ENUM_ARM64"},
+		{Val: PROCESSOR_ARCH_ENUM_X86ONARM64, Name: "PROCESSOR_ARCH_ENUM_X86ONARM64"},
+		{Val: PROCESSOR_ARCH_ENUM_UNKNOWN, Name: "PROCESSOR_ARCH_ENUM_UNKNOWN"},
+	},
+}
+
+// Returns the name of the constant, like "PROCESSOR_ARCH_ENUM_X86".
+func (v PROCESSOR_ARCH_ENUM) String() string {
+	return _PROCESSOR_ARCH_ENUM_names.String(v)
+}
+
+// Parses the name of a [PROCESSOR_ARCH_ENUM] constant, or a number.
+func ParsePROCESSOR_ARCH_ENUM(s string) (PROCESSOR_ARCH_ENUM, error) {
+	return _PROCESSOR_ARCH_ENUM_names.Parse(s)
+}
+
+var _PROCESSOR_ARCHITECTURE_names = enum.Enum[PROCESSOR_ARCHITECTURE]{
+	Type: "PROCESSOR_ARCHITECTURE",
+	Values: []enum.Name[PROCESSOR_ARCHITECTURE]{
+		{Val: PROCESSOR_ARCHITECTURE_INTEL, Name: "PROCESSOR_ARCHITECTURE_INTEL"},
+		{Val: PROCESSOR_ARCHITECTURE_MIPS, Name: "PROCESSOR_ARCHITECTURE_MIPS"},
+		{Val: PROCESSOR_ARCHITECTURE_ALPHA, Name: "PROCESSOR_ARCHITECTURE_ALPHA"},
+		{Val: PROCESSOR_ARCHITECTURE_PPC, Name: "PROCESSOR_ARCHITECTURE_PPC"},
+		{Val: PROCESSOR_ARCHITECTURE_SHX, Name: "PROCESSOR_ARCHITECTURE_SHX"},
+		{Val: PROCESSOR_ARCHITECTURE_ARM, Name: "PROCESSOR_ARCHITECTURE_ARM"},
+		{Val: PROCESSOR_ARCHITECTURE_IA64, Name: "PROCESSOR_ARCHITECTURE_IA64"},
+		{Val: PROCESSOR_ARCHITECTURE_ALPHA64, Name: "PROCESSOR_ARCHITECTURE_ALPHA64"},
+		{Val: PROCESSOR_ARCHITECTURE_MSIL, Name: "PROCESSOR_ARCHITECTURE_MSIL"},
+		{Val: PROCESSOR_ARCHITECTURE_AMD64, Name: "PROCESSOR_ARCHITECTURE_AMD64"},
+		{Val: PROCESSOR_ARCHITECTURE_IA32_ON_WIN64, Name: "PROCESSOR_ARCHITECTURE_IA32_ON_WIN64"},
+		{Val: PROCESSOR_ARCHITECTURE_NEUTRAL, Name: "PROCESSOR_ARCHITECTURE_NEUTRAL"},
+		{Val: PROCESSOR_ARCHITECTURE_ARM64, Name: "PROCESSOR_ARCHITECTURE_ARM64"},
+		{Val: PROCESSOR_ARCHITECTURE_ARM32_ON_WIN64, Name: "PROCESSOR_ARCHITECTURE_ARM32_ON_WIN64"},
+		{Val: PROCESSOR_ARCHITECTURE_IA32_ON_ARM64, Name: "PROCESSOR_ARCHITECTURE_IA32_ON_ARM64"},
+		{Val: PROCESSOR_ARCHITECTURE_UNKNOWN, Name: "PROCESSOR_ARCHITECTURE_UNKNOWN"},
+	},
+}
+
+// Returns the name of the constant, like "PROCESSOR_ARCHITECTURE_INTEL".
+func (v PROCESSOR_ARCHITECTURE) String() string {
+	return _PROCESSOR_ARCHITECTURE_names.String(v)
+}
+
+// Parses the name of a [PROCESSOR_ARCHITECTURE] constant, or a number.
+func ParsePROCESSOR_ARCHITECTURE(s string) (PROCESSOR_ARCHITECTURE, error) {
+	return _PROCESSOR_ARCHITECTURE_names.Parse(s)
+}
+
+var _SEC_names = enum.Flags[SEC]{
+	Type: "SEC",
+	Values: []enum.Name[SEC]{
+		{Val: SEC_IMAGE_NO_EXECUTE, Name: "SEC_IMAGE_NO_EXECUTE"},
+		{Val: SEC_PARTITION_OWNER_HANDLE, Name: "SEC_PARTITION_OWNER_HANDLE"},
+		{Val: SEC_64K_PAGES, Name: "SEC_64K_PAGES"},
+		{Val: SEC_FILE, Name: "SEC_FILE"},
+		{Val: SEC_IMAGE, Name: "SEC_IMAGE"},
+		{Val: SEC_PROTECTED_IMAGE, Name: "SEC_PROTECTED_IMAGE"},
+		{Val: SEC_RESERVE, Name: "SEC_RESERVE"},
+		{Val: SEC_COMMIT, Name: "SEC_COMMIT"},
+		{Val: SEC_NOCACHE, Name: "SEC_NOCACHE"},
+		{Val: SEC_WRITECOMBINE, Name: "SEC_WRITECOMBINE"},
+		{Val: SEC_LARGE_PAGES, Name: "SEC_LARGE_PAGES"},
+		{Val: SEC_NONE, Name: "SEC_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SEC_PARTITION_OWNER_HANDLE|SEC_64K_PAGES".
+func (v SEC) String() string {
+	return _SEC_names.String(v)
+}
+
+// Parses names of [SEC] flags joined by "|", or numbers.
+func ParseSEC(s string) (SEC, error) {
+	return _SEC_names.Parse(s)
+}
+
+var _SECURITY_names = enum.Flags[SECURITY]{
+	Type: "SECURITY",
+	Values: []enum.Name[SECURITY]{
+		{Val: SECURITY_DELEGATION, Name: "SECURITY_DELEGATION"},
+		{Val: SECURITY_IDENTIFICATION, Name: "SECURITY_IDENTIFICATION"},
+		{Val: SECURITY_IMPERSONATION, Name: "SECURITY_IMPERSONATION"},
+		{Val: SECURITY_CONTEXT_TRACKING, Name: "SECURITY_CONTEXT_TRACKING"},
+		{Val: SECURITY_EFFECTIVE_ONLY, Name: "SECURITY_EFFECTIVE_ONLY"},
+		{Val: SECURITY_NONE, Name: "SECURITY_NONE"},
+		{Val: SECURITY_ANONYMOUS, Name: "SECURITY_ANONYMOUS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SECURITY_IDENTIFICATION|SECURITY_IMPERSONATION".
+func (v SECURITY) String() string {
+	return _SECURITY_names.String(v)
+}
+
+// Parses names of [SECURITY] flags joined by "|", or numbers.
+func ParseSECURITY(s string) (SECURITY, error) {
+	return _SECURITY_names.Parse(s)
+}
+
+var _SHUTDOWN_names = enum.Enum[SHUTDOWN]{
+	Type: "SHUTDOWN",
+	Values: []enum.Name[SHUTDOWN]{
+		{Val: SHUTDOWN_NONE, Name: "SHUTDOWN_NONE"},
+		{Val: SHUTDOWN_NORETRY, Name: "SHUTDOWN_NORETRY"},
+	},
+}
+
+// Returns the name of the constant, like "SHUTDOWN_NONE".
+func (v SHUTDOWN) String() string {
+	return _SHUTDOWN_names.String(v)
+}
+
+// Parses the name of a [SHUTDOWN] constant, or a number.
+func ParseSHUTDOWN(s string) (SHUTDOWN, error) {
+	return _SHUTDOWN_names.Parse(s)
+}
+
+var _SORT_names = enum.Enum[SORT]{
+	Type: "SORT",
+	Values: []enum.Name[SORT]{
+		{Val: SORT_DEFAULT, Name: "SORT_DEFAULT"},
+		{Val: SORT_INVARIANT_MATH, Name: "SORT_INVARIANT_MATH"},
+		{Val: SORT_JAPANESE_XJIS, Name: "SORT_JAPANESE_XJIS"},
+		{Val: SORT_JAPANESE_UNICODE, Name: "SORT_JAPANESE_UNICODE"},
+		{Val: SORT_JAPANESE_RADICALSTROKE, Name: "SORT_JAPANESE_RADICALSTROKE"},
+		{Val: SORT_CHINESE_BIG5, Name: "SORT_CHINESE_BIG5"},
+		{Val: SORT_CHINESE_PRCP, Name: "SORT_CHINESE_PRCP"},
+		{Val: SORT_CHINESE_UNICODE, Name: "SORT_CHINESE_UNICODE"},
+		{Val: SORT_CHINESE_PRC, Name: "SORT_CHINESE_PRC"},
+		{Val: SORT_CHINESE_BOPOMOFO, Name: "SORT_CHINESE_BOPOMOFO"},
+		{Val: SORT_CHINESE_RADICALSTROKE, Name: "SORT_CHINESE_RADICALSTROKE"},
+		{Val: SORT_KOREAN_KSC, Name: "SORT_KOREAN_KSC"},
+		{Val: SORT_KOREAN_UNICODE, Name: "SORT_KOREAN_UNICODE"},
+		{Val: SORT_GERMAN_PHONE_BOOK, Name: "SORT_GERMAN_PHONE_BOOK"},
+		{Val: SORT_HUNGARIAN_DEFAULT, Name: "SORT_HUNGARIAN_DEFAULT"},
+		{Val: SORT_HUNGARIAN_TECHNICAL, Name: "SORT_HUNGARIAN_TECHNICAL"},
+		{Val: SORT_GEORGIAN_TRADITIONAL, Name: "SORT_GEORGIAN_TRADITIONAL"},
+		{Val: SORT_GEORGIAN_MODERN, Name: "SORT_GEORGIAN_MODERN"},
+	},
+}
+
+// Returns the name of the constant, like "SORT_DEFAULT".
+func (v SORT) String() string {
+	return _SORT_names.String(v)
+}
+
+// Parses the name of a [SORT] constant, or a number.
+func ParseSORT(s string) (SORT, error) {
+	return _SORT_names.Parse(s)
+}
+
+var _STANDARD_RIGHTS_names = enum.Flags[STANDARD_RIGHTS]{
+	Type: "STANDARD_RIGHTS",
+	Values: []enum.Name[STANDARD_RIGHTS]{
+		{Val: STANDARD_RIGHTS_ALL, Name: "STANDARD_RIGHTS_ALL"},
+		{Val: STANDARD_RIGHTS_REQUIRED, Name: "STANDARD_RIGHTS_REQUIRED"},
+		{Val: STANDARD_RIGHTS_DELETE, Name: "STANDARD_RIGHTS_DELETE"},
+		{Val: STANDARD_RIGHTS_READ_CONTROL, Name: "STANDARD_RIGHTS_READ_CONTROL"},
+		{Val: STANDARD_RIGHTS_SYNCHRONIZE, Name: "STANDARD_RIGHTS_SYNCHRONIZE"},
+		{Val: STANDARD_RIGHTS_WRITE_DAC, Name: "STANDARD_RIGHTS_WRITE_DAC"},
+		{Val: STANDARD_RIGHTS_WRITE_OWNER, Name: "STANDARD_RIGHTS_WRITE_OWNER"},
+		{Val: STANDARD_RIGHTS_NONE, Name: "STANDARD_RIGHTS_NONE"},
+		{Val: STANDARD_RIGHTS_EXECUTE, Name: "STANDARD_RIGHTS_EXECUTE"},
+		{Val: STANDARD_RIGHTS_READ, Name: "STANDARD_RIGHTS_READ"},
+		{Val: STANDARD_RIGHTS_WRITE, Name: "STANDARD_RIGHTS_WRITE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "STANDARD_RIGHTS_DELETE|STANDARD_RIGHTS_READ_CONTROL".
+func (v STANDARD_RIGHTS) String() string {
+	return _STANDARD_RIGHTS_names.String(v)
+}
+
+// Parses names of [STANDARD_RIGHTS] flags joined by "|", or numbers.
+func ParseSTANDARD_RIGHTS(s string) (STANDARD_RIGHTS, error) {
+	return _STANDARD_RIGHTS_names.Parse(s)
+}
+
+var _STARTF_names = enum.Flags[STARTF]{
+	Type: "STARTF",
+	Values: []enum.Name[STARTF]{
+		{Val: STARTF_FORCEONFEEDBACK, Name: "STARTF_FORCEONFEEDBACK"},
+		{Val: STARTF_FORCEOFFFEEDBACK, Name: "STARTF_FORCEOFFFEEDBACK"},
+		{Val: STARTF_PREVENTPINNING, Name: "STARTF_PREVENTPINNING"},
+		{Val: STARTF_RUNFULLSCREEN, Name: "STARTF_RUNFULLSCREEN"},
+		{Val: STARTF_TITLEISAPPID, Name: "STARTF_TITLEISAPPID"},
+		{Val: STARTF_TITLEISLINKNAME, Name: "STARTF_TITLEISLINKNAME"},
+		{Val: STARTF_UNTRUSTEDSOURCE, Name: "STARTF_UNTRUSTEDSOURCE"},
+		{Val: STARTF_USECOUNTCHARS, Name: "STARTF_USECOUNTCHARS"},
+		{Val: STARTF_USEFILLATTRIBUTE, Name: "STARTF_USEFILLATTRIBUTE"},
+		{Val: STARTF_USEHOTKEY, Name: "STARTF_USEHOTKEY"},
+		{Val: STARTF_USEPOSITION, Name: "STARTF_USEPOSITION"},
+		{Val: STARTF_USESHOWWINDOW, Name: "STARTF_USESHOWWINDOW"},
+		{Val: STARTF_USESIZE, Name: "STARTF_USESIZE"},
+		{Val: STARTF_USESTDHANDLES, Name: "STARTF_USESTDHANDLES"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "STARTF_FORCEONFEEDBACK|STARTF_FORCEOFFFEEDBACK".
+func (v STARTF) String() string {
+	return _STARTF_names.String(v)
+}
+
+// Parses names of [STARTF] flags joined by "|", or numbers.
+func ParseSTARTF(s string) (STARTF, error) {
+	return _STARTF_names.Parse(s)
+}
+
+var _STARTFILL_names = enum.Flags[STARTFILL]{
+	Type: "STARTFILL",
+	Values: []enum.Name[STARTFILL]{
+		{Val: STARTFILL_FOREGROUND_BLUE, Name: "STARTFILL_FOREGROUND_BLUE"},
+		{Val: STARTFILL_FOREGROUND_GREEN, Name: "STARTFILL_FOREGROUND_GREEN"},
+		{Val: STARTFILL_FOREGROUND_RED, Name: "STARTFILL_FOREGROUND_RED"},
+		{Val: STARTFILL_FOREGROUND_INTENSITY, Name: "STARTFILL_FOREGROUND_INTENSITY"},
+		{Val: STARTFILL_BACKGROUND_BLUE, Name: "STARTFILL_BACKGROUND_BLUE"},
+		{Val: STARTFILL_BACKGROUND_GREEN, Name: "STARTFILL_BACKGROUND_GREEN"},
+		{Val: STARTFILL_BACKGROUND_RED, Name: "STARTFILL_BACKGROUND_RED"},
+		{Val: STARTFILL_BACKGROUND_INTENSITY, Name: "STARTFILL_BACKGROUND_INTENSITY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "STARTFILL_FOREGROUND_BLUE|STARTFILL_FOREGROUND_GREEN".
+func (v STARTFILL) String() string {
+	return _STARTFILL_names.String(v)
+}
+
+// Parses names of [STARTFILL] flags joined by "|", or numbers.
+func ParseSTARTFILL(s string) (STARTFILL, error) {
+	return _STARTFILL_names.Parse(s)
+}
+
+var _STARTSW_names = enum.Enum[STARTSW]{
+	Type: "STARTSW",
+	Values: []enum.Name[STARTSW]{
+		{Val: STARTSW_HIDE, Name: "STARTSW_HIDE"},
+		{Val: STARTSW_SHOWNORMAL, Name: "STARTSW_SHOWNORMAL"},
+		{Val: STARTSW_SHOWMINIMIZED, Name: "STARTSW_SHOWMINIMIZED"},
+		{Val: STARTSW_SHOWMAXIMIZED, Name: "STARTSW_SHOWMAXIMIZED"},
+		{Val: STARTSW_MAXIMIZE, Name: "STARTSW_MAXIMIZE"},
+		{Val: STARTSW_SHOWNOACTIVATE, Name: "STARTSW_SHOWNOACTIVATE"},
+		{Val: STARTSW_SHOW, Name: "STARTSW_SHOW"},
+		{Val: STARTSW_MINIMIZE, Name: "STARTSW_MINIMIZE"},
+		{Val: STARTSW_SHOWMINNOACTIVE, Name: "STARTSW_SHOWMINNOACTIVE"},
+		{Val: STARTSW_SHOWNA, Name: "STARTSW_SHOWNA"},
+		{Val: STARTSW_RESTORE, Name: "STARTSW_RESTORE"},
+		{Val: STARTSW_FORCEMINIMIZE, Name: "STARTSW_FORCEMINIMIZE"},
+	},
+}
+
+// Returns the name of the constant, like "STARTSW_HIDE".
+func (v STARTSW) String() string {
+	return _STARTSW_names.String(v)
+}
+
+// Parses the name of a [STARTSW] constant, or a number.
+func ParseSTARTSW(s string) (STARTSW, error) {
+	return _STARTSW_names.Parse(s)
+}
+
+var _STD_names = enum.Enum[STD]{
+	Type: "STD",
+	Values: []enum.Name[STD]{
+		{Val: STD_INPUT_HANDLE, Name: "STD_INPUT_HANDLE"},
+		{Val: STD_OUTPUT_HANDLE, Name: "STD_OUTPUT_HANDLE"},
+		{Val: STD_ERROR_HANDLE, Name: "STD_ERROR_HANDLE"},
+	},
+}
+
+// Returns the name of the constant, like "STD_INPUT_HANDLE".
+func (v STD) String() string {
+	return _STD_names.String(v)
+}
+
+// Parses the name of a [STD] constant, or a number.
+func ParseSTD(s string) (STD, error) {
+	return _STD_names.Parse(s)
+}
+
+var _SUBLANG_names = enum.Enum[SUBLANG]{
+	Type: "SUBLANG",
+	Values: []enum.Name[SUBLANG]{
+		{Val: SUBLANG_NEUTRAL, Name: "SUBLANG_NEUTRAL"},
+		{Val: SUBLANG_DEFAULT, Name: "SUBLANG_DEFAULT"},
+		{Val: SUBLANG_SYS_DEFAULT, Name: "SUBLANG_SYS_DEFAULT"},
+		{Val: SUBLANG_CUSTOM_DEFAULT, Name: "SUBLANG_CUSTOM_DEFAULT"},
+		{Val: SUBLANG_CUSTOM_UNSPECIFIED, Name: "SUBLANG_CUSTOM_UNSPECIFIED"},
+		{Val: SUBLANG_UI_CUSTOM_DEFAULT, Name: "SUBLANG_UI_CUSTOM_DEFAULT"},
+		{Val: SUBLANG_AFRIKAANS_SOUTH_AFRICA, Name: "SUBLANG_AFRIKAANS_SOUTH_AFRICA"},
+		{Val: SUBLANG_ALBANIAN_ALBANIA, Name: "SUBLANG_ALBANIAN_ALBANIA"},
+		{Val: SUBLANG_ALSATIAN_FRANCE, Name: "SUBLANG_ALSATIAN_FRANCE"},
+		{Val: SUBLANG_AMHARIC_ETHIOPIA, Name: "SUBLANG_AMHARIC_ETHIOPIA"},
+		{Val: SUBLANG_ARABIC_SAUDI_ARABIA, Name: "SUBLANG_ARABIC_SAUDI_ARABIA"},
+		{Val: SUBLANG_ARABIC_IRAQ, Name: "SUBLANG_ARABIC_IRAQ"},
+		{Val: SUBLANG_ARABIC_EGYPT, Name: "SUBLANG_ARABIC_EGYPT"},
+		{Val: SUBLANG_ARABIC_LIBYA, Name: "SUBLANG_ARABIC_LIBYA"},
+		{Val: SUBLANG_ARABIC_ALGERIA, Name: "SUBLANG_ARABIC_ALGERIA"},
+		{Val: SUBLANG_ARABIC_MOROCCO, Name: "SUBLANG_ARABIC_MOROCCO"},
+		{Val: SUBLANG_ARABIC_TUNISIA, Name: "SUBLANG_ARABIC_TUNISIA"},
+		{Val: SUBLANG_ARABIC_OMAN, Name: "SUBLANG_ARABIC_OMAN"},
+		{Val: SUBLANG_ARABIC_YEMEN, Name: "SUBLANG_ARABIC_YEMEN"},
+		{Val: SUBLANG_ARABIC_SYRIA, Name: "SUBLANG_ARABIC_SYRIA"},
+		{Val: SUBLANG_ARABIC_JORDAN, Name: "SUBLANG_ARABIC_JORDAN"},
+		{Val: SUBLANG_ARABIC_LEBANON, Name: "SUBLANG_ARABIC_LEBANON"},
+		{Val: SUBLANG_ARABIC_KUWAIT, Name: "SUBLANG_ARABIC_KUWAIT"},
+		{Val: SUBLANG_ARABIC_UAE, Name: "SUBLANG_ARABIC_UAE"},
+		{Val: SUBLANG_ARABIC_BAHRAIN, Name: "SUBLANG_ARABIC_BAHRAIN"},
+		{Val: SUBLANG_ARABIC_QATAR, Name: "SUBLANG_ARABIC_QATAR"},
+		{Val: SUBLANG_ARMENIAN_ARMENIA, Name: "SUBLANG_ARMENIAN_ARMENIA"},
+		{Val: SUBLANG_ASSAMESE_INDIA, Name: "SUBLANG_ASSAMESE_INDIA"},
+		{Val: SUBLANG_AZERI_LATIN, Name: "SUBLANG_AZERI_LATIN"},
+		{Val: SUBLANG_AZERI_CYRILLIC, Name: "SUBLANG_AZERI_CYRILLIC"},
+		{Val: SUBLANG_AZERBAIJANI_AZERBAIJAN_LATIN, Name: "SUBLANG_AZERBAIJANI_AZERBAIJAN_LATIN"},
+		{Val: SUBLANG_AZERBAIJANI_AZERBAIJAN_CYRILLIC, Name: "SUBLANG_AZERBAIJANI_AZERBAIJAN_CYRILLIC"},
+		{Val: SUBLANG_BANGLA_INDIA, Name: "SUBLANG_BANGLA_INDIA"},
+		{Val: SUBLANG_BANGLA_BANGLADESH, Name: "SUBLANG_BANGLA_BANGLADESH"},
+		{Val: SUBLANG_BASHKIR_RUSSIA, Name: "SUBLANG_BASHKIR_RUSSIA"},
+		{Val: SUBLANG_BASQUE_BASQUE, Name: "SUBLANG_BASQUE_BASQUE"},
+		{Val: SUBLANG_BELARUSIAN_BELARUS, Name: "SUBLANG_BELARUSIAN_BELARUS"},
+		{Val: SUBLANG_BENGALI_INDIA, Name: "SUBLANG_BENGALI_INDIA"},
+		{Val: SUBLANG_BENGALI_BANGLADESH, Name: "SUBLANG_BENGALI_BANGLADESH"},
+		{Val: SUBLANG_BOSNIAN_BOSNIA_HERZEGOVINA_LATIN, Name: "SUBLANG_BOSNIAN_BOSNIA_HERZEGOVINA_LATIN"},
+		{Val: SUBLANG_BOSNIAN_BOSNIA_HERZEGOVINA_CYRILLIC, Name: "SUBLANG_BOSNIAN_BOSNIA_HERZEGOVINA_CYRILLIC"},
+		{Val: SUBLANG_BRETON_FRANCE, Name: "SUBLANG_BRETON_FRANCE"},
+		{Val: SUBLANG_BULGARIAN_BULGARIA, Name: "SUBLANG_BULGARIAN_BULGARIA"},
+		{Val: SUBLANG_CATALAN_CATALAN, Name: "SUBLANG_CATALAN_CATALAN"},
+		{Val: SUBLANG_CENTRAL_KURDISH_IRAQ, Name: "SUBLANG_CENTRAL_KURDISH_IRAQ"},
+		{Val: SUBLANG_CHEROKEE_CHEROKEE, Name: "SUBLANG_CHEROKEE_CHEROKEE"},
+		{Val: SUBLANG_CHINESE_TRADITIONAL, Name: "SUBLANG_CHINESE_TRADITIONAL"},
+		{Val: SUBLANG_CHINESE_SIMPLIFIED, Name: "SUBLANG_CHINESE_SIMPLIFIED"},
+		{Val: SUBLANG_CHINESE_HONGKONG, Name: "SUBLANG_CHINESE_HONGKONG"},
+		{Val: SUBLANG_CHINESE_SINGAPORE, Name: "SUBLANG_CHINESE_SINGAPORE"},
+		{Val: SUBLANG_CHINESE_MACAU, Name: "SUBLANG_CHINESE_MACAU"},
+		{Val: SUBLANG_CORSICAN_FRANCE, Name: "SUBLANG_CORSICAN_FRANCE"},
+		{Val: SUBLANG_CZECH_CZECH_REPUBLIC, Name: "SUBLANG_CZECH_CZECH_REPUBLIC"},
+		{Val: SUBLANG_CROATIAN_CROATIA, Name: "SUBLANG_CROATIAN_CROATIA"},
+		{Val: SUBLANG_CROATIAN_BOSNIA_HERZEGOVINA_LATIN, Name: "SUBLANG_CROATIAN_BOSNIA_HERZEGOVINA_LATIN"},
+		{Val: SUBLANG_DANISH_DENMARK, Name: "SUBLANG_DANISH_DENMARK"},
+		{Val: SUBLANG_DARI_AFGHANISTAN, Name: "SUBLANG_DARI_AFGHANISTAN"},
+		{Val: SUBLANG_DIVEHI_MALDIVES, Name: "SUBLANG_DIVEHI_MALDIVES"},
+		{Val: SUBLANG_DUTCH, Name: "SUBLANG_DUTCH"},
+		{Val: SUBLANG_DUTCH_BELGIAN, Name: "SUBLANG_DUTCH_BELGIAN"},
+		{Val: SUBLANG_ENGLISH_US, Name: "SUBLANG_ENGLISH_US"},
+		{Val: SUBLANG_ENGLISH_UK, Name: "SUBLANG_ENGLISH_UK"},
+		{Val: SUBLANG_ENGLISH_AUS, Name: "SUBLANG_ENGLISH_AUS"},
+		{Val: SUBLANG_ENGLISH_CAN, Name: "SUBLANG_ENGLISH_CAN"},
+		{Val: SUBLANG_ENGLISH_NZ, Name: "SUBLANG_ENGLISH_NZ"},
+		{Val: SUBLANG_ENGLISH_EIRE, Name: "SUBLANG_ENGLISH_EIRE"},
+		{Val: SUBLANG_ENGLISH_SOUTH_AFRICA, Name: "SUBLANG_ENGLISH_SOUTH_AFRICA"},
+		{Val: SUBLANG_ENGLISH_JAMAICA, Name: "SUBLANG_ENGLISH_JAMAICA"},
+		{Val: SUBLANG_ENGLISH_CARIBBEAN, Name: "SUBLANG_ENGLISH_CARIBBEAN"},
+		{Val: SUBLANG_ENGLISH_BELIZE, Name: "SUBLANG_ENGLISH_BELIZE"},
+		{Val: SUBLANG_ENGLISH_TRINIDAD, Name: "SUBLANG_ENGLISH_TRINIDAD"},
+		{Val: SUBLANG_ENGLISH_ZIMBABWE, Name: "SUBLANG_ENGLISH_ZIMBABWE"},
+		{Val: SUBLANG_ENGLISH_PHILIPPINES, Name: "SUBLANG_ENGLISH_PHILIPPINES"},
+		{Val: SUBLANG_ENGLISH_INDIA, Name: "SUBLANG_ENGLISH_INDIA"},
+		{Val: SUBLANG_ENGLISH_MALAYSIA, Name: "SUBLANG_ENGLISH_MALAYSIA"},
+		{Val: SUBLANG_ENGLISH_SINGAPORE, Name: "SUBLANG_ENGLISH_SINGAPORE"},
+		{Val: SUBLANG_ESTONIAN_ESTONIA, Name: "SUBLANG_ESTONIAN_ESTONIA"},
+		{Val: SUBLANG_FAEROESE_FAROE_ISLANDS, Name: "SUBLANG_FAEROESE_FAROE_ISLANDS"},
+		{Val: SUBLANG_FILIPINO_PHILIPPINES, Name: "SUBLANG_FILIPINO_PHILIPPINES"},
+		{Val: SUBLANG_FINNISH_FINLAND, Name: "SUBLANG_FINNISH_FINLAND"},
+		{Val: SUBLANG_FRENCH, Name: "SUBLANG_FRENCH"},
+		{Val: SUBLANG_FRENCH_BELGIAN, Name: "SUBLANG_FRENCH_BELGIAN"},
+		{Val: SUBLANG_FRENCH_CANADIAN, Name: "SUBLANG_FRENCH_CANADIAN"},
+		{Val: SUBLANG_FRENCH_SWISS, Name: "SUBLANG_FRENCH_SWISS"},
+		{Val: SUBLANG_FRENCH_LUXEMBOURG, Name: "SUBLANG_FRENCH_LUXEMBOURG"},
+		{Val: SUBLANG_FRENCH_MONACO, Name: "SUBLANG_FRENCH_MONACO"},
+		{Val: SUBLANG_FRISIAN_NETHERLANDS, Name: "SUBLANG_FRISIAN_NETHERLANDS"},
+		{Val: SUBLANG_FULAH_SENEGAL, Name: "SUBLANG_FULAH_SENEGAL"},
+		{Val: SUBLANG_GALICIAN_GALICIAN, Name: "SUBLANG_GALICIAN_GALICIAN"},
+		{Val: SUBLANG_GEORGIAN_GEORGIA, Name: "SUBLANG_GEORGIAN_GEORGIA"},
+		{Val: SUBLANG_GERMAN, Name: "SUBLANG_GERMAN"},
+		{Val: SUBLANG_GERMAN_SWISS, Name: "SUBLANG_GERMAN_SWISS"},
+		{Val: SUBLANG_GERMAN_AUSTRIAN, Name: "SUBLANG_GERMAN_AUSTRIAN"},
+		{Val: SUBLANG_GERMAN_LUXEMBOURG, Name: "SUBLANG_GERMAN_LUXEMBOURG"},
+		{Val: SUBLANG_GERMAN_LIECHTENSTEIN, Name: "SUBLANG_GERMAN_LIECHTENSTEIN"},
+		{Val: SUBLANG_GREEK_GREECE, Name: "SUBLANG_GREEK_GREECE"},
+		{Val: SUBLANG_GREENLANDIC_GREENLAND, Name: "SUBLANG_GREENLANDIC_GREENLAND"},
+		{Val: SUBLANG_GUJARATI_INDIA, Name: "SUBLANG_GUJARATI_INDIA"},
+		{Val: SUBLANG_HAUSA_NIGERIA_LATIN, Name: "SUBLANG_HAUSA_NIGERIA_LATIN"},
+		{Val: SUBLANG_HAWAIIAN_US, Name: "SUBLANG_HAWAIIAN_US"},
+		{Val: SUBLANG_HEBREW_ISRAEL, Name: "SUBLANG_HEBREW_ISRAEL"},
+		{Val: SUBLANG_HINDI_INDIA, Name: "SUBLANG_HINDI_INDIA"},
+		{Val: SUBLANG_HUNGARIAN_HUNGARY, Name: "SUBLANG_HUNGARIAN_HUNGARY"},
+		{Val: SUBLANG_ICELANDIC_ICELAND, Name: "SUBLANG_ICELANDIC_ICELAND"},
+		{Val: SUBLANG_IGBO_NIGERIA, Name: "SUBLANG_IGBO_NIGERIA"},
+		{Val: SUBLANG_INDONESIAN_INDONESIA, Name: "SUBLANG_INDONESIAN_INDONESIA"},
+		{Val: SUBLANG_INUKTITUT_CANADA, Name: "SUBLANG_INUKTITUT_CANADA"},
+		{Val: SUBLANG_INUKTITUT_CANADA_LATIN, Name: "SUBLANG_INUKTITUT_CANADA_LATIN"},
+		{Val: SUBLANG_IRISH_IRELAND, Name: "SUBLANG_IRISH_IRELAND"},
+		{Val: SUBLANG_ITALIAN, Name: "SUBLANG_ITALIAN"},
+		{Val: SUBLANG_ITALIAN_SWISS, Name: "SUBLANG_ITALIAN_SWISS"},
+		{Val: SUBLANG_JAPANESE_JAPAN, Name: "SUBLANG_JAPANESE_JAPAN"},
+		{Val: SUBLANG_KANNADA_INDIA, Name: "SUBLANG_KANNADA_INDIA"},
+		{Val: SUBLANG_KASHMIRI_SASIA, Name: "SUBLANG_KASHMIRI_SASIA"},
+		{Val: SUBLANG_KASHMIRI_INDIA, Name: "SUBLANG_KASHMIRI_INDIA"},
+		{Val: SUBLANG_KAZAK_KAZAKHSTAN, Name: "SUBLANG_KAZAK_KAZAKHSTAN"},
+		{Val: SUBLANG_KHMER_CAMBODIA, Name: "SUBLANG_KHMER_CAMBODIA"},
+		{Val: SUBLANG_KICHE_GUATEMALA, Name: "SUBLANG_KICHE_GUATEMALA"},
+		{Val: SUBLANG_KINYARWANDA_RWANDA, Name: "SUBLANG_KINYARWANDA_RWANDA"},
+		{Val: SUBLANG_KONKANI_INDIA, Name: "SUBLANG_KONKANI_INDIA"},
+		{Val: SUBLANG_KOREAN, Name: "SUBLANG_KOREAN"},
+		{Val: SUBLANG_KYRGYZ_KYRGYZSTAN, Name: "SUBLANG_KYRGYZ_KYRGYZSTAN"},
+		{Val: SUBLANG_LAO_LAO, Name: "SUBLANG_LAO_LAO"},
+		{Val: SUBLANG_LATVIAN_LATVIA, Name: "SUBLANG_LATVIAN_LATVIA"},
+		{Val: SUBLANG_LITHUANIAN, Name: "SUBLANG_LITHUANIAN"},
+		{Val: SUBLANG_LOWER_SORBIAN_GERMANY, Name: "SUBLANG_LOWER_SORBIAN_GERMANY"},
+		{Val: SUBLANG_LUXEMBOURGISH_LUXEMBOURG, Name: "SUBLANG_LUXEMBOURGISH_LUXEMBOURG"},
+		{Val: SUBLANG_MACEDONIAN_MACEDONIA, Name: "SUBLANG_MACEDONIAN_MACEDONIA"},
+		{Val: SUBLANG_MALAY_MALAYSIA, Name: "SUBLANG_MALAY_MALAYSIA"},
+		{Val: SUBLANG_MALAY_BRUNEI_DARUSSALAM, Name: "SUBLANG_MALAY_BRUNEI_DARUSSALAM"},
+		{Val: SUBLANG_MALAYALAM_INDIA, Name: "SUBLANG_MALAYALAM_INDIA"},
+		{Val: SUBLANG_MALTESE_MALTA, Name: "SUBLANG_MALTESE_MALTA"},
+		{Val: SUBLANG_MAORI_NEW_ZEALAND, Name: "SUBLANG_MAORI_NEW_ZEALAND"},
+		{Val: SUBLANG_MAPUDUNGUN_CHILE, Name: "SUBLANG_MAPUDUNGUN_CHILE"},
+		{Val: SUBLANG_MARATHI_INDIA, Name: "SUBLANG_MARATHI_INDIA"},
+		{Val: SUBLANG_MOHAWK_MOHAWK, Name: "SUBLANG_MOHAWK_MOHAWK"},
+		{Val: SUBLANG_MONGOLIAN_CYRILLIC_MONGOLIA, Name: "SUBLANG_MONGOLIAN_CYRILLIC_MONGOLIA"},
+		{Val: SUBLANG_MONGOLIAN_PRC, Name: "SUBLANG_MONGOLIAN_PRC"},
+		{Val: SUBLANG_NEPALI_INDIA, Name: "SUBLANG_NEPALI_INDIA"},
+		{Val: SUBLANG_NEPALI_NEPAL, Name: "SUBLANG_NEPALI_NEPAL"},
+		{Val: SUBLANG_NORWEGIAN_BOKMAL, Name: "SUBLANG_NORWEGIAN_BOKMAL"},
+		{Val: SUBLANG_NORWEGIAN_NYNORSK, Name: "SUBLANG_NORWEGIAN_NYNORSK"},
+		{Val: SUBLANG_OCCITAN_FRANCE, Name: "SUBLANG_OCCITAN_FRANCE"},
+		{Val: SUBLANG_ODIA_INDIA, Name: "SUBLANG_ODIA_INDIA"},
+		{Val: SUBLANG_ORIYA_INDIA, Name: "SUBLANG_ORIYA_INDIA"},
+		{Val: SUBLANG_PASHTO_AFGHANISTAN, Name: "SUBLANG_PASHTO_AFGHANISTAN"},
+		{Val: SUBLANG_PERSIAN_IRAN, Name: "SUBLANG_PERSIAN_IRAN"},
+		{Val: SUBLANG_POLISH_POLAND, Name: "SUBLANG_POLISH_POLAND"},
+		{Val: SUBLANG_PORTUGUESE, Name: "SUBLANG_PORTUGUESE"},
+		{Val: SUBLANG_PORTUGUESE_BRAZILIAN, Name: "SUBLANG_PORTUGUESE_BRAZILIAN"},
+		{Val: SUBLANG_PULAR_SENEGAL, Name: "SUBLANG_PULAR_SENEGAL"},
+		{Val: SUBLANG_PUNJABI_INDIA, Name: "SUBLANG_PUNJABI_INDIA"},
+		{Val: SUBLANG_PUNJABI_PAKISTAN, Name: "SUBLANG_PUNJABI_PAKISTAN"},
+		{Val: SUBLANG_QUECHUA_BOLIVIA, Name: "SUBLANG_QUECHUA_BOLIVIA"},
+		{Val: SUBLANG_QUECHUA_ECUADOR, Name: "SUBLANG_QUECHUA_ECUADOR"},
+		{Val: SUBLANG_QUECHUA_PERU, Name: "SUBLANG_QUECHUA_PERU"},
+		{Val: SUBLANG_ROMANIAN_ROMANIA, Name: "SUBLANG_ROMANIAN_ROMANIA"},
+		{Val: SUBLANG_ROMANSH_SWITZERLAND, Name: "SUBLANG_ROMANSH_SWITZERLAND"},
+		{Val: SUBLANG_RUSSIAN_RUSSIA, Name: "SUBLANG_RUSSIAN_RUSSIA"},
+		{Val: SUBLANG_SAKHA_RUSSIA, Name: "SUBLANG_SAKHA_RUSSIA"},
+		{Val: SUBLANG_SAMI_NORTHERN_NORWAY, Name: "SUBLANG_SAMI_NORTHERN_NORWAY"},
+		{Val: SUBLANG_SAMI_NORTHERN_SWEDEN, Name: "SUBLANG_SAMI_NORTHERN_SWEDEN"},
+		{Val: SUBLANG_SAMI_NORTHERN_FINLAND, Name: "SUBLANG_SAMI_NORTHERN_FINLAND"},
+		{Val: SUBLANG_SAMI_LULE_NORWAY, Name: "SUBLANG_SAMI_LULE_NORWAY"},
+		{Val: SUBLANG_SAMI_LULE_SWEDEN, Name: "SUBLANG_SAMI_LULE_SWEDEN"},
+		{Val: SUBLANG_SAMI_SOUTHERN_NORWAY, Name: "SUBLANG_SAMI_SOUTHERN_NORWAY"},
+		{Val: SUBLANG_SAMI_SOUTHERN_SWEDEN, Name: "SUBLANG_SAMI_SOUTHERN_SWEDEN"},
+		{Val: SUBLANG_SAMI_SKOLT_FINLAND, Name: "SUBLANG_SAMI_SKOLT_FINLAND"},
+		{Val: SUBLANG_SAMI_INARI_FINLAND, Name: "SUBLANG_SAMI_INARI_FINLAND"},
+		{Val: SUBLANG_SANSKRIT_INDIA, Name: "SUBLANG_SANSKRIT_INDIA"},
+		{Val: SUBLANG_SCOTTISH_GAELIC, Name: "SUBLANG_SCOTTISH_GAELIC"},
+		{Val: SUBLANG_SERBIAN_BOSNIA_HERZEGOVINA_LATIN, Name: "SUBLANG_SERBIAN_BOSNIA_HERZEGOVINA_LATIN"},
+		{Val: SUBLANG_SERBIAN_BOSNIA_HERZEGOVINA_CYRILLIC, Name: "SUBLANG_SERBIAN_BOSNIA_HERZEGOVINA_CYRILLIC"},
+		{Val: SUBLANG_SERBIAN_MONTENEGRO_LATIN, Name: "SUBLANG_SERBIAN_MONTENEGRO_LATIN"},
+		{Val: SUBLANG_SERBIAN_MONTENEGRO_CYRILLIC, Name: "SUBLANG_SERBIAN_MONTENEGRO_CYRILLIC"},
+		{Val: SUBLANG_SERBIAN_SERBIA_LATIN, Name: "SUBLANG_SERBIAN_SERBIA_LATIN"},
+		{Val: SUBLANG_SERBIAN_SERBIA_CYRILLIC, Name: "SUBLANG_SERBIAN_SERBIA_CYRILLIC"},
+		{Val: SUBLANG_SERBIAN_CROATIA, Name: "SUBLANG_SERBIAN_CROATIA"},
+		{Val: SUBLANG_SERBIAN_LATIN, Name: "SUBLANG_SERBIAN_LATIN"},
+		{Val: SUBLANG_SERBIAN_CYRILLIC, Name: "SUBLANG_SERBIAN_CYRILLIC"},
+		{Val: SUBLANG_SINDHI_INDIA, Name: "SUBLANG_SINDHI_INDIA"},
+		{Val: SUBLANG_SINDHI_PAKISTAN, Name: "SUBLANG_SINDHI_PAKISTAN"},
+		{Val: SUBLANG_SINDHI_AFGHANISTAN, Name: "SUBLANG_SINDHI_AFGHANISTAN"},
+		{Val: SUBLANG_SINHALESE_SRI_LANKA, Name: "SUBLANG_SINHALESE_SRI_LANKA"},
+		{Val: SUBLANG_SOTHO_NORTHERN_SOUTH_AFRICA, Name: "SUBLANG_SOTHO_NORTHERN_SOUTH_AFRICA"},
+		{Val: SUBLANG_SLOVAK_SLOVAKIA, Name: "SUBLANG_SLOVAK_SLOVAKIA"},
+		{Val: SUBLANG_SLOVENIAN_SLOVENIA, Name: "SUBLANG_SLOVENIAN_SLOVENIA"},
+		{Val: SUBLANG_SPANISH, Name: "SUBLANG_SPANISH"},
+		{Val: SUBLANG_SPANISH_MEXICAN, Name: "SUBLANG_SPANISH_MEXICAN"},
+		{Val: SUBLANG_SPANISH_MODERN, Name: "SUBLANG_SPANISH_MODERN"},
+		{Val: SUBLANG_SPANISH_GUATEMALA, Name: "SUBLANG_SPANISH_GUATEMALA"},
+		{Val: SUBLANG_SPANISH_COSTA_RICA, Name: "SUBLANG_SPANISH_COSTA_RICA"},
+		{Val: SUBLANG_SPANISH_PANAMA, Name: "SUBLANG_SPANISH_PANAMA"},
+		{Val: SUBLANG_SPANISH_DOMINICAN_REPUBLIC, Name: "SUBLANG_SPANISH_DOMINICAN_REPUBLIC"},
+		{Val: SUBLANG_SPANISH_VENEZUELA, Name: "SUBLANG_SPANISH_VENEZUELA"},
+		{Val: SUBLANG_SPANISH_COLOMBIA, Name: "SUBLANG_SPANISH_COLOMBIA"},
+		{Val: SUBLANG_SPANISH_PERU, Name: "SUBLANG_SPANISH_PERU"},
+		{Val: SUBLANG_SPANISH_ARGENTINA, Name: "SUBLANG_SPANISH_ARGENTINA"},
+		{Val: SUBLANG_SPANISH_ECUADOR, Name: "SUBLANG_SPANISH_ECUADOR"},
+		{Val: SUBLANG_SPANISH_CHILE, Name: "SUBLANG_SPANISH_CHILE"},
+		{Val: SUBLANG_SPANISH_URUGUAY, Name: "SUBLANG_SPANISH_URUGUAY"},
+		{Val: SUBLANG_SPANISH_PARAGUAY, Name: "SUBLANG_SPANISH_PARAGUAY"},
+		{Val: SUBLANG_SPANISH_BOLIVIA, Name: "SUBLANG_SPANISH_BOLIVIA"},
+		{Val: SUBLANG_SPANISH_EL_SALVADOR, Name: "SUBLANG_SPANISH_EL_SALVADOR"},
+		{Val: SUBLANG_SPANISH_HONDURAS, Name: "SUBLANG_SPANISH_HONDURAS"},
+		{Val: SUBLANG_SPANISH_NICARAGUA, Name: "SUBLANG_SPANISH_NICARAGUA"},
+		{Val: SUBLANG_SPANISH_PUERTO_RICO, Name: "SUBLANG_SPANISH_PUERTO_RICO"},
+		{Val: SUBLANG_SPANISH_US, Name: "SUBLANG_SPANISH_US"},
+		{Val: SUBLANG_SWAHILI_KENYA, Name: "SUBLANG_SWAHILI_KENYA"},
+		{Val: SUBLANG_SWEDISH, Name: "SUBLANG_SWEDISH"},
+		{Val: SUBLANG_SWEDISH_FINLAND, Name: "SUBLANG_SWEDISH_FINLAND"},
+		{Val: SUBLANG_SYRIAC_SYRIA, Name: "SUBLANG_SYRIAC_SYRIA"},
+		{Val: SUBLANG_TAJIK_TAJIKISTAN, Name: "SUBLANG_TAJIK_TAJIKISTAN"},
+		{Val: SUBLANG_TAMAZIGHT_ALGERIA_LATIN, Name: "SUBLANG_TAMAZIGHT_ALGERIA_LATIN"},
+		{Val: SUBLANG_TAMAZIGHT_MOROCCO_TIFINAGH, Name: "SUBLANG_TAMAZIGHT_MOROCCO_TIFINAGH"},
+		{Val: SUBLANG_TAMIL_INDIA, Name: "SUBLANG_TAMIL_INDIA"},
+		{Val: SUBLANG_TAMIL_SRI_LANKA, Name: "SUBLANG_TAMIL_SRI_LANKA"},
+		{Val: SUBLANG_TATAR_RUSSIA, Name: "SUBLANG_TATAR_RUSSIA"},
+		{Val: SUBLANG_TELUGU_INDIA, Name: "SUBLANG_TELUGU_INDIA"},
+		{Val: SUBLANG_THAI_THAILAND, Name: "SUBLANG_THAI_THAILAND"},
+		{Val: SUBLANG_TIBETAN_PRC, Name: "SUBLANG_TIBETAN_PRC"},
+		{Val: SUBLANG_TIGRIGNA_ERITREA, Name: "SUBLANG_TIGRIGNA_ERITREA"},
+		{Val: SUBLANG_TIGRINYA_ERITREA, Name: "SUBLANG_TIGRINYA_ERITREA"},
+		{Val: SUBLANG_TIGRINYA_ETHIOPIA, Name: "SUBLANG_TIGRINYA_ETHIOPIA"},
+		{Val: SUBLANG_TSWANA_BOTSWANA, Name: "SUBLANG_TSWANA_BOTSWANA"},
+		{Val: SUBLANG_TSWANA_SOUTH_AFRICA, Name: "SUBLANG_TSWANA_SOUTH_AFRICA"},
+		{Val: SUBLANG_TURKISH_TURKEY, Name: "SUBLANG_TURKISH_TURKEY"},
+		{Val: SUBLANG_TURKMEN_TURKMENISTAN, Name: "SUBLANG_TURKMEN_TURKMENISTAN"},
+		{Val: SUBLANG_UIGHUR_PRC, Name: "SUBLANG_UIGHUR_PRC"},
+		{Val: SUBLANG_UKRAINIAN_UKRAINE, Name: "SUBLANG_UKRAINIAN_UKRAINE"},
+		{Val: SUBLANG_UPPER_SORBIAN_GERMANY, Name: "SUBLANG_UPPER_SORBIAN_GERMANY"},
+		{Val: SUBLANG_URDU_PAKISTAN, Name: "SUBLANG_URDU_PAKISTAN"},
+		{Val: SUBLANG_URDU_INDIA, Name: "SUBLANG_URDU_INDIA"},
+		{Val: SUBLANG_UZBEK_LATIN, Name: "SUBLANG_UZBEK_LATIN"},
+		{Val: SUBLANG_UZBEK_CYRILLIC, Name: "SUBLANG_UZBEK_CYRILLIC"},
+		{Val: SUBLANG_VALENCIAN_VALENCIA, Name: "SUBLANG_VALENCIAN_VALENCIA"},
+		{Val: SUBLANG_VIETNAMESE_VIETNAM, Name: "SUBLANG_VIETNAMESE_VIETNAM"},
+		{Val: SUBLANG_WELSH_UNITED_KINGDOM, Name: "SUBLANG_WELSH_UNITED_KINGDOM"},
+		{Val: SUBLANG_WOLOF_SENEGAL, Name: "SUBLANG_WOLOF_SENEGAL"},
+		{Val: SUBLANG_XHOSA_SOUTH_AFRICA, Name: "SUBLANG_XHOSA_SOUTH_AFRICA"},
+		{Val: SUBLANG_YAKUT_RUSSIA, Name: "SUBLANG_YAKUT_RUSSIA"},
+		{Val: SUBLANG_YI_PRC, Name: "SUBLANG_YI_PRC"},
+		{Val: SUBLANG_YORUBA_NIGERIA, Name: "SUBLANG_YORUBA_NIGERIA"},
+		{Val: SUBLANG_ZULU_SOUTH_AFRICA, Name: "SUBLANG_ZULU_SOUTH_AFRICA"},
+	},
+}
+
+// Returns the name of the constant, like "SUBLANG_NEUTRAL".
+func (v SUBLANG) String() string {
+	return _SUBLANG_names.String(v)
+}
+
+// Parses the name of a [SUBLANG] constant, or a number.
+func ParseSUBLANG(s string) (SUBLANG, error) {
+	return _SUBLANG_names.Parse(s)
+}
+
+var _TH32CS_names = enum.Flags[TH32CS]{
+	Type: "TH32CS",
+	Values: []enum.Name[TH32CS]{
+		{Val: TH32CS_SNAPALL, Name: "TH32CS_SNAPALL"},
+		{Val: TH32CS_SNAPHEAPLIST, Name: "TH32CS_SNAPHEAPLIST"},
+		{Val: TH32CS_SNAPPROCESS, Name: "TH32CS_SNAPPROCESS"},
+		{Val: TH32CS_SNAPTHREAD, Name: "TH32CS_SNAPTHREAD"},
+		{Val: TH32CS_SNAPMODULE, Name: "TH32CS_SNAPMODULE"},
+		{Val: TH32CS_SNAPMODULE32, Name: "TH32CS_SNAPMODULE32"},
+		{Val: TH32CS_INHERIT, Name: "TH32CS_INHERIT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TH32CS_SNAPHEAPLIST|TH32CS_SNAPPROCESS".
+func (v TH32CS) String() string {
+	return _TH32CS_names.String(v)
+}
+
+// Parses names of [TH32CS] flags joined by "|", or numbers.
+func ParseTH32CS(s string) (TH32CS, error) {
+	return _TH32CS_names.Parse(s)
+}
+
+var _THREAD_PRIORITY_names = enum.Enum[THREAD_PRIORITY]{
+	Type: "THREAD_PRIORITY",
+	Values: []enum.Name[THREAD_PRIORITY]{
+		{Val: THREAD_PRIORITY_BELOW_NORMAL, Name: "THREAD_PRIORITY_BELOW_NORMAL"},
+		{Val: THREAD_PRIORITY_NORMAL, Name: "THREAD_PRIORITY_NORMAL"},
+		{Val: THREAD_PRIORITY_ABOVE_NORMAL, Name: "THREAD_PRIORITY_ABOVE_NORMAL"},
+		{Val: THREAD_PRIORITY_LOWEST, Name: "THREAD_PRIORITY_LOWEST"},
+		{Val: THREAD_PRIORITY_HIGHEST, Name: "THREAD_PRIORITY_HIGHEST"},
+		{Val: THREAD_PRIORITY_TIME_CRITICAL, Name: "THREAD_PRIORITY_TIME_CRITICAL"},
+		{Val: THREAD_PRIORITY_IDLE, Name: "THREAD_PRIORITY_IDLE"},
+	},
+}
+
+// Returns the name of the constant, like "THREAD_PRIORITY_BELOW_NORMAL".
+func (v THREAD_PRIORITY) String() string {
+	return _THREAD_PRIORITY_names.String(v)
+}
+
+// Parses the name of a [THREAD_PRIORITY] constant, or a number.
+func ParseTHREAD_PRIORITY(s string) (THREAD_PRIORITY, error) {
+	return _THREAD_PRIORITY_names.Parse(s)
+}
+
+var _TIME_ZONE_ID_names = enum.Enum[TIME_ZONE_ID]{
+	Type: "TIME_ZONE_ID",
+	Values: []enum.Name[TIME_ZONE_ID]{
+		{Val: TIME_ZONE_ID_UNKNOWN, Name: "TIME_ZONE_ID_UNKNOWN"},
+		{Val: TIME_ZONE_ID_STANDARD, Name: "TIME_ZONE_ID_STANDARD"},
+		{Val: TIME_ZONE_ID_DAYLIGHT, Name: "TIME_ZONE_ID_DAYLIGHT"},
+	},
+}
+
+// Returns the name of the constant, like "TIME_ZONE_ID_UNKNOWN".
+func (v TIME_ZONE_ID) String() string {
+	return _TIME_ZONE_ID_names.String(v)
+}
+
+// Parses the name of a [TIME_ZONE_ID] constant, or a number.
+func ParseTIME_ZONE_ID(s string) (TIME_ZONE_ID, error) {
+	return _TIME_ZONE_ID_names.Parse(s)
+}
+
+var _VER_names = enum.Flags[VER]{
+	Type: "VER",
+	Values: []enum.Name[VER]{
+		{Val: VER_BUILDNUMBER, Name: "VER_BUILDNUMBER"},
+		{Val: VER_MAJORVERSION, Name: "VER_MAJORVERSION"},
+		{Val: VER_MINORVERSION, Name: "VER_MINORVERSION"},
+		{Val: VER_PLATFORMID, Name: "VER_PLATFORMID"},
+		{Val: VER_PRODUCT_TYPE, Name: "VER_PRODUCT_TYPE"},
+		{Val: VER_SERVICEPACKMAJOR, Name: "VER_SERVICEPACKMAJOR"},
+		{Val: VER_SERVICEPACKMINOR, Name: "VER_SERVICEPACKMINOR"},
+		{Val: VER_SUITENAME, Name: "VER_SUITENAME"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "VER_BUILDNUMBER|VER_MAJORVERSION".
+func (v VER) String() string {
+	return _VER_names.String(v)
+}
+
+// Parses names of [VER] flags joined by "|", or numbers.
+func ParseVER(s string) (VER, error) {
+	return _VER_names.Parse(s)
+}
+
+var _VER_COND_names = enum.Enum[VER_COND]{
+	Type: "VER_COND",
+	Values: []enum.Name[VER_COND]{
+		{Val: VER_COND_EQUAL, Name: "VER_COND_EQUAL"},
+		{Val: VER_COND_GREATER, Name: "VER_COND_GREATER"},
+		{Val: VER_COND_GREATER_EQUAL, Name: "VER_COND_GREATER_EQUAL"},
+		{Val: VER_COND_LESS, Name: "VER_COND_LESS"},
+		{Val: VER_COND_LESS_EQUAL, Name: "VER_COND_LESS_EQUAL"},
+		{Val: VER_COND_AND, Name: "VER_COND_AND"},
+		{Val: VER_COND_OR, Name: "VER_COND_OR"},
+	},
+}
+
+// Returns the name of the constant, like "VER_COND_EQUAL".
+func (v VER_COND) String() string {
+	return _VER_COND_names.String(v)
+}
+
+// Parses the name of a [VER_COND] constant, or a number.
+func ParseVER_COND(s string) (VER_COND, error) {
+	return _VER_COND_names.Parse(s)
+}
+
+var _VER_SUITE_names = enum.Flags[VER_SUITE]{
+	Type: "VER_SUITE",
+	Values: []enum.Name[VER_SUITE]{
+		{Val: VER_SUITE_NT_SERVER, Name: "VER_SUITE_NT_SERVER"},
+		{Val: VER_SUITE_BACKOFFICE, Name: "VER_SUITE_BACKOFFICE"},
+		{Val: VER_SUITE_BLADE, Name: "VER_SUITE_BLADE"},
+		{Val: VER_SUITE_COMPUTE_SERVER, Name: "VER_SUITE_COMPUTE_SERVER"},
+		{Val: VER_SUITE_DATACENTER, Name: "VER_SUITE_DATACENTER"},
+		{Val: VER_SUITE_ENTERPRISE, Name: "VER_SUITE_ENTERPRISE"},
+		{Val: VER_SUITE_EMBEDDEDNT, Name: "VER_SUITE_EMBEDDEDNT"},
+		{Val: VER_SUITE_PERSONAL, Name: "VER_SUITE_PERSONAL"},
+		{Val: VER_SUITE_SINGLEUSERTS, Name: "VER_SUITE_SINGLEUSERTS"},
+		{Val: VER_SUITE_SMALLBUSINESS, Name: "VER_SUITE_SMALLBUSINESS"},
+		{Val: VER_SUITE_SMALLBUSINESS_RESTRICTED, Name: "VER_SUITE_SMALLBUSINESS_RESTRICTED"},
+		{Val: VER_SUITE_STORAGE_SERVER, Name: "VER_SUITE_STORAGE_SERVER"},
+		{Val: VER_SUITE_TERMINAL, Name: "VER_SUITE_TERMINAL"},
+		{Val: VER_SUITE_WH_SERVER, Name: "VER_SUITE_WH_SERVER"},
+		{Val: VER_SUITE_NT_DOMAIN_CONTROLLER, Name: "VER_SUITE_NT_DOMAIN_CONTROLLER"},
+		{Val: VER_SUITE_NT_WORKSTATION, Name: "VER_SUITE_NT_WORKSTATION"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "VER_SUITE_BACKOFFICE|VER_SUITE_BLADE".
+func (v VER_SUITE) String() string {
+	return _VER_SUITE_names.String(v)
+}
+
+// Parses names of [VER_SUITE] flags joined by "|", or numbers.
+func ParseVER_SUITE(s string) (VER_SUITE, error) {
+	return _VER_SUITE_names.Parse(s)
+}
+
+var _WAIT_names = enum.Enum[WAIT]{
+	Type: "WAIT",
+	Values: []enum.Name[WAIT]{
+		{Val: WAIT_ABANDONED, Name: "WAIT_ABANDONED"},
+		{Val: WAIT_OBJECT_0, Name: "WAIT_OBJECT_0"},
+		{Val: WAIT_TIMEOUT, Name: "WAIT_TIMEOUT"},
+		{Val: WAIT_FAILED, Name: "WAIT_FAILED"},
+	},
+}
+
+// Returns the name of the constant, like "WAIT_ABANDONED".
+func (v WAIT) String() string {
+	return _WAIT_names.String(v)
+}
+
+// Parses the name of a [WAIT] constant, or a number.
+func ParseWAIT(s string) (WAIT, error) {
+	return _WAIT_names.Parse(s)
+}
+
+var _WIN32_WINNT_names = enum.Enum[WIN32_WINNT]{
+	Type: "WIN32_WINNT",
+	Values: []enum.Name[WIN32_WINNT]{
+		{Val: WIN32_WINNT_NT4, Name: "WIN32_WINNT_NT4"},
+		{Val: WIN32_WINNT_WIN2K, Name: "WIN32_WINNT_WIN2K"},
+		{Val: WIN32_WINNT_WINXP, Name: "WIN32_WINNT_WINXP"},
+		{Val: WIN32_WINNT_WS03, Name: "WIN32_WINNT_WS03"},
+		{Val: WIN32_WINNT_WIN6, Name: "WIN32_WINNT_WIN6"},
+		{Val: WIN32_WINNT_VISTA, Name: "WIN32_WINNT_VISTA"},
+		{Val: WIN32_WINNT_WS08, Name: "WIN32_WINNT_WS08"},
+		{Val: WIN32_WINNT_LONGHORN, Name: "WIN32_WINNT_LONGHORN"},
+		{Val: WIN32_WINNT_WIN7, Name: "WIN32_WINNT_WIN7"},
+		{Val: WIN32_WINNT_WIN8, Name: "WIN32_WINNT_WIN8"},
+		{Val: WIN32_WINNT_WINBLUE, Name: "WIN32_WINNT_WINBLUE"},
+		{Val: WIN32_WINNT_WINTHRESHOLD, Name: "WIN32_WINNT_WINTHRESHOLD"},
+		{Val: WIN32_WINNT_WIN10, Name: "WIN32_WINNT_WIN10"},
+	},
+}
+
+// Returns the name of the constant, like "WIN32_WINNT_NT4".
+func (v WIN32_WINNT) String() string {
+	return _WIN32_WINNT_names.String(v)
+}
+
+// Parses the name of a [WIN32_WINNT] constant, or a number.
+func ParseWIN32_WINNT(s string) (WIN32_WINNT, error) {
+	return _WIN32_WINNT_names.Parse(s)
+}
+
+var _WTS_names = enum.Enum[WTS]{
+	Type: "WTS",
+	Values: []enum.Name[WTS]{
+		{Val: WTS_CONSOLE_CONNECT, Name: "WTS_CONSOLE_CONNECT"},
+		{Val: WTS_CONSOLE_DISCONNECT, Name: "WTS_CONSOLE_DISCONNECT"},
+		{Val: WTS_REMOTE_CONNECT, Name: "WTS_REMOTE_CONNECT"},
+		{Val: WTS_REMOTE_DISCONNECT, Name: "WTS_REMOTE_DISCONNECT"},
+		{Val: WTS_SESSION_LOGON, Name: "WTS_SESSION_LOGON"},
+		{Val: WTS_SESSION_LOGOFF, Name: "WTS_SESSION_LOGOFF"},
+		{Val: WTS_SESSION_LOCK, Name: "WTS_SESSION_LOCK"},
+		{Val: WTS_SESSION_UNLOCK, Name: "WTS_SESSION_UNLOCK"},
+		{Val: WTS_SESSION_REMOTE_CONTROL, Name: "WTS_SESSION_REMOTE_CONTROL"},
+		{Val: WTS_SESSION_CREATE, Name: "WTS_SESSION_CREATE"},
+		{Val: WTS_SESSION_TERMINATE, Name: "WTS_SESSION_TERMINATE"},
+	},
+}
+
+// Returns the name of the constant, like "WTS_CONSOLE_CONNECT".
+func (v WTS) String() string {
+	return _WTS_names.String(v)
+}
+
+// Parses the name of a [WTS] constant, or a number.
+func ParseWTS(s string) (WTS, error) {
+	return _WTS_names.Parse(s)
+}
+
+var _BIND_names = enum.Flags[BIND]{
+	Type: "BIND",
+	Values: []enum.Name[BIND]{
+		{Val: BIND_MAYBOTHERUSER, Name: "BIND_MAYBOTHERUSER"},
+		{Val: BIND_JUSTTESTEXISTENCE, Name: "BIND_JUSTTESTEXISTENCE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "BIND_MAYBOTHERUSER|BIND_JUSTTESTEXISTENCE".
+func (v BIND) String() string {
+	return _BIND_names.String(v)
+}
+
+// Parses names of [BIND] flags joined by "|", or numbers.
+func ParseBIND(s string) (BIND, error) {
+	return _BIND_names.Parse(s)
+}
+
+var _CLSCTX_names = enum.Flags[CLSCTX]{
+	Type: "CLSCTX",
+	Values: []enum.Name[CLSCTX]{
+		{Val: CLSCTX_ALL, Name: "CLSCTX_ALL"},
+		{Val: CLSCTX_SERVER, Name: "CLSCTX_SERVER"},
+		{Val: CLSCTX_INPROC_SERVER, Name: "CLSCTX_INPROC_SERVER"},
+		{Val: CLSCTX_INPROC_HANDLER, Name: "CLSCTX_INPROC_HANDLER"},
+		{Val: CLSCTX_LOCAL_SERVER, Name: "CLSCTX_LOCAL_SERVER"},
+		{Val: CLSCTX_INPROC_SERVER16, Name: "CLSCTX_INPROC_SERVER16"},
+		{Val: CLSCTX_REMOTE_SERVER, Name: "CLSCTX_REMOTE_SERVER"},
+		{Val: CLSCTX_INPROC_HANDLER16, Name: "CLSCTX_INPROC_HANDLER16"},
+		{Val: CLSCTX_NO_CODE_DOWNLOAD, Name: "CLSCTX_NO_CODE_DOWNLOAD"},
+		{Val: CLSCTX_NO_CUSTOM_MARSHAL, Name: "CLSCTX_NO_CUSTOM_MARSHAL"},
+		{Val: CLSCTX_ENABLE_CODE_DOWNLOAD, Name: "CLSCTX_ENABLE_CODE_DOWNLOAD"},
+		{Val: CLSCTX_NO_FAILURE_LOG, Name: "CLSCTX_NO_FAILURE_LOG"},
+		{Val: CLSCTX_DISABLE_AAA, Name: "CLSCTX_DISABLE_AAA"},
+		{Val: CLSCTX_ENABLE_AAA, Name: "CLSCTX_ENABLE_AAA"},
+		{Val: CLSCTX_FROM_DEFAULT_CONTEXT, Name: "CLSCTX_FROM_DEFAULT_CONTEXT"},
+		{Val: CLSCTX_ACTIVATE_X86_SERVER, Name: "CLSCTX_ACTIVATE_X86_SERVER"},
+		{Val: CLSCTX_ACTIVATE_64_BIT_SERVER, Name: "CLSCTX_ACTIVATE_64_BIT_SERVER"},
+		{Val: CLSCTX_ENABLE_CLOAKING, Name: "CLSCTX_ENABLE_CLOAKING"},
+		{Val: CLSCTX_APPCONTAINER, Name: "CLSCTX_APPCONTAINER"},
+		{Val: CLSCTX_ACTIVATE_AAA_AS_IU, Name: "CLSCTX_ACTIVATE_AAA_AS_IU"},
+		{Val: CLSCTX_ACTIVATE_ARM32_SERVER, Name: "CLSCTX_ACTIVATE_ARM32_SERVER"},
+		{Val: CLSCTX_PS_DLL, Name: "CLSCTX_PS_DLL"},
+		{Val: CLSCTX_ACTIVATE_32_BIT_SERVER, Name: "CLSCTX_ACTIVATE_32_BIT_SERVER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CLSCTX_INPROC_SERVER|CLSCTX_INPROC_HANDLER".
+func (v CLSCTX) String() string {
+	return _CLSCTX_names.String(v)
+}
+
+// Parses names of [CLSCTX] flags joined by "|", or numbers.
+func ParseCLSCTX(s string) (CLSCTX, error) {
+	return _CLSCTX_names.Parse(s)
+}
+
+var _COINIT_names = enum.Flags[COINIT]{
+	Type: "COINIT",
+	Values: []enum.Name[COINIT]{
+		{Val: COINIT_APARTMENTTHREADED, Name: "COINIT_APARTMENTTHREADED"},
+		{Val: COINIT_DISABLE_OLE1DDE, Name: "COINIT_DISABLE_OLE1DDE"},
+		{Val: COINIT_SPEED_OVER_MEMORY, Name: "COINIT_SPEED_OVER_MEMORY"},
+		{Val: COINIT_MULTITHREADED, Name: "COINIT_MULTITHREADED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "COINIT_APARTMENTTHREADED|COINIT_DISABLE_OLE1DDE".
+func (v COINIT) String() string {
+	return _COINIT_names.String(v)
+}
+
+// Parses names of [COINIT] flags joined by "|", or numbers.
+func ParseCOINIT(s string) (COINIT, error) {
+	return _COINIT_names.Parse(s)
+}
+
+var _DROPEFFECT_names = enum.Flags[DROPEFFECT]{
+	Type: "DROPEFFECT",
+	Values: []enum.Name[DROPEFFECT]{
+		{Val: DROPEFFECT_COPY, Name: "DROPEFFECT_COPY"},
+		{Val: DROPEFFECT_MOVE, Name: "DROPEFFECT_MOVE"},
+		{Val: DROPEFFECT_LINK, Name: "DROPEFFECT_LINK"},
+		{Val: DROPEFFECT_SCROLL, Name: "DROPEFFECT_SCROLL"},
+		{Val: DROPEFFECT_NONE, Name: "DROPEFFECT_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DROPEFFECT_COPY|DROPEFFECT_MOVE".
+func (v DROPEFFECT) String() string {
+	return _DROPEFFECT_names.String(v)
+}
+
+// Parses names of [DROPEFFECT] flags joined by "|", or numbers.
+func ParseDROPEFFECT(s string) (DROPEFFECT, error) {
+	return _DROPEFFECT_names.Parse(s)
+}
+
+var _DVASPECT_names = enum.Flags[DVASPECT]{
+	Type: "DVASPECT",
+	Values: []enum.Name[DVASPECT]{
+		{Val: DVASPECT_CONTENT, Name: "DVASPECT_CONTENT"},
+		{Val: DVASPECT_THUMBNAIL, Name: "DVASPECT_THUMBNAIL"},
+		{Val: DVASPECT_ICON, Name: "DVASPECT_ICON"},
+		{Val: DVASPECT_DOCPRINT, Name: "DVASPECT_DOCPRINT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DVASPECT_CONTENT|DVASPECT_THUMBNAIL".
+func (v DVASPECT) String() string {
+	return _DVASPECT_names.String(v)
+}
+
+// Parses names of [DVASPECT] flags joined by "|", or numbers.
+func ParseDVASPECT(s string) (DVASPECT, error) {
+	return _DVASPECT_names.Parse(s)
+}
+
+var _EOAC_names = enum.Flags[EOAC]{
+	Type: "EOAC",
+	Values: []enum.Name[EOAC]{
+		{Val: EOAC_MUTUAL_AUTH, Name: "EOAC_MUTUAL_AUTH"},
+		{Val: EOAC_STATIC_CLOAKING, Name: "EOAC_STATIC_CLOAKING"},
+		{Val: EOAC_DYNAMIC_CLOAKING, Name: "EOAC_DYNAMIC_CLOAKING"},
+		{Val: EOAC_ANY_AUTHORITY, Name: "EOAC_ANY_AUTHORITY"},
+		{Val: EOAC_MAKE_FULLSIC, Name: "EOAC_MAKE_FULLSIC"},
+		{Val: EOAC_DEFAULT, Name: "EOAC_DEFAULT"},
+		{Val: EOAC_SECURE_REFS, Name: "EOAC_SECURE_REFS"},
+		{Val: EOAC_ACCESS_CONTROL, Name: "EOAC_ACCESS_CONTROL"},
+		{Val: EOAC_APPID, Name: "EOAC_APPID"},
+		{Val: EOAC_DYNAMIC, Name: "EOAC_DYNAMIC"},
+		{Val: EOAC_REQUIRE_FULLSIC, Name: "EOAC_REQUIRE_FULLSIC"},
+		{Val: EOAC_AUTO_IMPERSONATE, Name: "EOAC_AUTO_IMPERSONATE"},
+		{Val: EOAC_DISABLE_AAA, Name: "EOAC_DISABLE_AAA"},
+		{Val: EOAC_NO_CUSTOM_MARSHAL, Name: "EOAC_NO_CUSTOM_MARSHAL"},
+		{Val: EOAC_RESERVED1, Name: "EOAC_RESERVED1"},
+		{Val: EOAC_NONE, Name: "EOAC_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "EOAC_MUTUAL_AUTH|EOAC_STATIC_CLOAKING".
+func (v EOAC) String() string {
+	return _EOAC_names.String(v)
+}
+
+// Parses names of [EOAC] flags joined by "|", or numbers.
+func ParseEOAC(s string) (EOAC, error) {
+	return _EOAC_names.Parse(s)
+}
+
+var _EOAC_QOS_names = enum.Enum[EOAC_QOS]{
+	Type: "EOAC_QOS",
+	Values: []enum.Name[EOAC_QOS]{
+		{Val: EOAC_QOS_NONE, Name: "EOAC_QOS_NONE"},
+		{Val: EOAC_QOS_MUTUAL_AUTH, Name: "EOAC_QOS_MUTUAL_AUTH"},
+	},
+}
+
+// Returns the name of the constant, like "EOAC_QOS_NONE".
+func (v EOAC_QOS) String() string {
+	return _EOAC_QOS_names.String(v)
+}
+
+// Parses the name of a [EOAC_QOS] constant, or a number.
+func ParseEOAC_QOS(s string) (EOAC_QOS, error) {
+	return _EOAC_QOS_names.Parse(s)
+}
+
+var _FACILITY_names = enum.Enum[FACILITY]{
+	Type: "FACILITY",
+	Values: []enum.Name[FACILITY]{
+		{Val: FACILITY_NULL, Name: "FACILITY_NULL"},
+		{Val: FACILITY_RPC, Name: "FACILITY_RPC"},
+		{Val: FACILITY_DISPATCH, Name: "FACILITY_DISPATCH"},
+		{Val: FACILITY_STORAGE, Name: "FACILITY_STORAGE"},
+		{Val: FACILITY_ITF, Name: "FACILITY_ITF"},
+		{Val: FACILITY_WIN32, Name: "FACILITY_WIN32"},
+		{Val: FACILITY_WINDOWS, Name: "FACILITY_WINDOWS"},
+		{Val: FACILITY_SSPI, Name: "FACILITY_SSPI"},
+		{Val: FACILITY_SECURITY, Name: "FACILITY_SECURITY"},
+		{Val: FACILITY_CONTROL, Name: "FACILITY_CONTROL"},
+		{Val: FACILITY_CERT, Name: "FACILITY_CERT"},
+		{Val: FACILITY_INTERNET, Name: "FACILITY_INTERNET"},
+		{Val: FACILITY_MEDIASERVER, Name: "FACILITY_MEDIASERVER"},
+		{Val: FACILITY_MSMQ, Name: "FACILITY_MSMQ"},
+		{Val: FACILITY_SETUPAPI, Name: "FACILITY_SETUPAPI"},
+		{Val: FACILITY_SCARD, Name: "FACILITY_SCARD"},
+		{Val: FACILITY_COMPLUS, Name: "FACILITY_COMPLUS"},
+		{Val: FACILITY_AAF, Name: "FACILITY_AAF"},
+		{Val: FACILITY_URT, Name: "FACILITY_URT"},
+		{Val: FACILITY_ACS, Name: "FACILITY_ACS"},
+		{Val: FACILITY_DPLAY, Name: "FACILITY_DPLAY"},
+		{Val: FACILITY_UMI, Name: "FACILITY_UMI"},
+		{Val: FACILITY_SXS, Name: "FACILITY_SXS"},
+		{Val: FACILITY_WINDOWS_CE, Name: "FACILITY_WINDOWS_CE"},
+		{Val: FACILITY_HTTP, Name: "FACILITY_HTTP"},
+		{Val: FACILITY_USERMODE_COMMONLOG, Name: "FACILITY_USERMODE_COMMONLOG"},
+		{Val: FACILITY_WER, Name: "FACILITY_WER"},
+		{Val: FACILITY_USERMODE_FILTER_MANAGER, Name: "FACILITY_USERMODE_FILTER_MANAGER"},
+		{Val: FACILITY_BACKGROUNDCOPY, Name: "FACILITY_BACKGROUNDCOPY"},
+		{Val: FACILITY_CONFIGURATION, Name: "FACILITY_CONFIGURATION"},
+		{Val: FACILITY_WIA, Name: "FACILITY_WIA"},
+		{Val: FACILITY_STATE_MANAGEMENT, Name: "FACILITY_STATE_MANAGEMENT"},
+		{Val: FACILITY_METADIRECTORY, Name: "FACILITY_METADIRECTORY"},
+		{Val: FACILITY_WINDOWSUPDATE, Name: "FACILITY_WINDOWSUPDATE"},
+		{Val: FACILITY_DIRECTORYSERVICE, Name: "FACILITY_DIRECTORYSERVICE"},
+		{Val: FACILITY_GRAPHICS, Name: "FACILITY_GRAPHICS"},
+		{Val: FACILITY_SHELL, Name: "FACILITY_SHELL"},
+		{Val: FACILITY_NAP, Name: "FACILITY_NAP"},
+		{Val: FACILITY_TPM_SERVICES, Name: "FACILITY_TPM_SERVICES"},
+		{Val: FACILITY_TPM_SOFTWARE, Name: "FACILITY_TPM_SOFTWARE"},
+		{Val: FACILITY_UI, Name: "FACILITY_UI"},
+		{Val: FACILITY_XAML, Name: "FACILITY_XAML"},
+		{Val: FACILITY_ACTION_QUEUE, Name: "FACILITY_ACTION_QUEUE"},
+		{Val: FACILITY_PLA, Name: "FACILITY_PLA"},
+		{Val: FACILITY_WINDOWS_SETUP, Name: "FACILITY_WINDOWS_SETUP"},
+		{Val: FACILITY_FVE, Name: "FACILITY_FVE"},
+		{Val: FACILITY_FWP, Name: "FACILITY_FWP"},
+		{Val: FACILITY_WINRM, Name: "FACILITY_WINRM"},
+		{Val: FACILITY_NDIS, Name: "FACILITY_NDIS"},
+		{Val: FACILITY_USERMODE_HYPERVISOR, Name: "FACILITY_USERMODE_HYPERVISOR"},
+		{Val: FACILITY_CMI, Name: "FACILITY_CMI"},
+		{Val: FACILITY_USERMODE_VIRTUALIZATION, Name: "FACILITY_USERMODE_VIRTUALIZATION"},
+		{Val: FACILITY_USERMODE_VOLMGR, Name: "FACILITY_USERMODE_VOLMGR"},
+		{Val: FACILITY_BCD, Name: "FACILITY_BCD"},
+		{Val: FACILITY_USERMODE_VHD, Name: "FACILITY_USERMODE_VHD"},
+		{Val: FACILITY_USERMODE_HNS, Name: "FACILITY_USERMODE_HNS"},
+		{Val: FACILITY_SDIAG, Name: "FACILITY_SDIAG"},
+		{Val: FACILITY_WEBSERVICES, Name: "FACILITY_WEBSERVICES"},
+		{Val: FACILITY_WINPE, Name: "FACILITY_WINPE"},
+		{Val: FACILITY_WPN, Name: "FACILITY_WPN"},
+		{Val: FACILITY_WINDOWS_STORE, Name: "FACILITY_WINDOWS_STORE"},
+		{Val: FACILITY_INPUT, Name: "FACILITY_INPUT"},
+		{Val: FACILITY_EAP, Name: "FACILITY_EAP"},
+		{Val: FACILITY_WINDOWS_DEFENDER, Name: "FACILITY_WINDOWS_DEFENDER"},
+		{Val: FACILITY_OPC, Name: "FACILITY_OPC"},
+		{Val: FACILITY_XPS, Name: "FACILITY_XPS"},
+		{Val: FACILITY_MBN, Name: "FACILITY_MBN"},
+		{Val: FACILITY_POWERSHELL, Name: "FACILITY_POWERSHELL"},
+		{Val: FACILITY_RAS, Name: "FACILITY_RAS"},
+		{Val: FACILITY_P2P_INT, Name: "FACILITY_P2P_INT"},
+		{Val: FACILITY_P2P, Name: "FACILITY_P2P"},
+		{Val: FACILITY_DAF, Name: "FACILITY_DAF"},
+		{Val: FACILITY_BLUETOOTH_ATT, Name: "FACILITY_BLUETOOTH_ATT"},
+		{Val: FACILITY_AUDIO, Name: "FACILITY_AUDIO"},
+		{Val: FACILITY_STATEREPOSITORY, Name: "FACILITY_STATEREPOSITORY"},
+		{Val: FACILITY_VISUALCPP, Name: "FACILITY_VISUALCPP"},
+		{Val: FACILITY_SCRIPT, Name: "FACILITY_SCRIPT"},
+		{Val: FACILITY_PARSE, Name: "FACILITY_PARSE"},
+		{Val: FACILITY_BLB, Name: "FACILITY_BLB"},
+		{Val: FACILITY_BLB_CLI, Name: "FACILITY_BLB_CLI"},
+		{Val: FACILITY_WSBAPP, Name: "FACILITY_WSBAPP"},
+		{Val: FACILITY_BLBUI, Name: "FACILITY_BLBUI"},
+		{Val: FACILITY_USN, Name: "FACILITY_USN"},
+		{Val: FACILITY_USERMODE_VOLSNAP, Name: "FACILITY_USERMODE_VOLSNAP"},
+		{Val: FACILITY_TIERING, Name: "FACILITY_TIERING"},
+		{Val: FACILITY_WSB_ONLINE, Name: "FACILITY_WSB_ONLINE"},
+		{Val: FACILITY_ONLINE_ID, Name: "FACILITY_ONLINE_ID"},
+		{Val: FACILITY_DEVICE_UPDATE_AGENT, Name: "FACILITY_DEVICE_UPDATE_AGENT"},
+		{Val: FACILITY_DRVSERVICING, Name: "FACILITY_DRVSERVICING"},
+		{Val: FACILITY_DLS, Name: "FACILITY_DLS"},
+		{Val: FACILITY_DELIVERY_OPTIMIZATION, Name: "FACILITY_DELIVERY_OPTIMIZATION"},
+		{Val: FACILITY_USERMODE_SPACES, Name: "FACILITY_USERMODE_SPACES"},
+		{Val: FACILITY_USER_MODE_SECURITY_CORE, Name: "FACILITY_USER_MODE_SECURITY_CORE"},
+		{Val: FACILITY_USERMODE_LICENSING, Name: "FACILITY_USERMODE_LICENSING"},
+		{Val: FACILITY_SOS, Name: "FACILITY_SOS"},
+		{Val: FACILITY_DEBUGGERS, Name: "FACILITY_DEBUGGERS"},
+		{Val: FACILITY_SPP, Name: "FACILITY_SPP"},
+		{Val: FACILITY_RESTORE, Name: "FACILITY_RESTORE"},
+		{Val: FACILITY_DMSERVER, Name: "FACILITY_DMSERVER"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_SERVER, Name: "FACILITY_DEPLOYMENT_SERVICES_SERVER"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_IMAGING, Name: "FACILITY_DEPLOYMENT_SERVICES_IMAGING"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_MANAGEMENT, Name: "FACILITY_DEPLOYMENT_SERVICES_MANAGEMENT"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_UTIL, Name: "FACILITY_DEPLOYMENT_SERVICES_UTIL"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_BINLSVC, Name: "FACILITY_DEPLOYMENT_SERVICES_BINLSVC"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_PXE, Name: "FACILITY_DEPLOYMENT_SERVICES_PXE"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_TFTP, Name: "FACILITY_DEPLOYMENT_SERVICES_TFTP"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_TRANSPORT_MANAGEMENT, Name: "FACILITY_DEPLOYMENT_SERVICES_TRANSPORT_MANAGEMENT"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_DRIVER_PROVISIONING, Name: "FACILITY_DEPLOYMENT_SERVICES_DRIVER_PROVISIONING"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_MULTICAST_SERVER, Name: "FACILITY_DEPLOYMENT_SERVICES_MULTICAST_SERVER"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_MULTICAST_CLIENT, Name: "FACILITY_DEPLOYMENT_SERVICES_MULTICAST_CLIENT"},
+		{Val: FACILITY_DEPLOYMENT_SERVICES_CONTENT_PROVIDER, Name: "FACILITY_DEPLOYMENT_SERVICES_CONTENT_PROVIDER"},
+		{Val: FACILITY_LINGUISTIC_SERVICES, Name: "FACILITY_LINGUISTIC_SERVICES"},
+		{Val: FACILITY_AUDIOSTREAMING, Name: "FACILITY_AUDIOSTREAMING"},
+		{Val: FACILITY_ACCELERATOR, Name: "FACILITY_ACCELERATOR"},
+		{Val: FACILITY_WMAAECMA, Name: "FACILITY_WMAAECMA"},
+		{Val: FACILITY_DIRECTMUSIC, Name: "FACILITY_DIRECTMUSIC"},
+		{Val: FACILITY_DIRECT3D10, Name: "FACILITY_DIRECT3D10"},
+		{Val: FACILITY_DXGI, Name: "FACILITY_DXGI"},
+		{Val: FACILITY_DXGI_DDI, Name: "FACILITY_DXGI_DDI"},
+		{Val: FACILITY_DIRECT3D11, Name: "FACILITY_DIRECT3D11"},
+		{Val: FACILITY_DIRECT3D11_DEBUG, Name: "FACILITY_DIRECT3D11_DEBUG"},
+		{Val: FACILITY_DIRECT3D12, Name: "FACILITY_DIRECT3D12"},
+		{Val: FACILITY_DIRECT3D12_DEBUG, Name: "FACILITY_DIRECT3D12_DEBUG"},
+		{Val: FACILITY_LEAP, Name: "FACILITY_LEAP"},
+		{Val: FACILITY_AUDCLNT, Name: "FACILITY_AUDCLNT"},
+		{Val: FACILITY_WINCODEC_DWRITE_DWM, Name: "FACILITY_WINCODEC_DWRITE_DWM"},
+		{Val: FACILITY_WINML, Name: "FACILITY_WINML"},
+		{Val: FACILITY_DIRECT2D, Name: "FACILITY_DIRECT2D"},
+		{Val: FACILITY_DEFRAG, Name: "FACILITY_DEFRAG"},
+		{Val: FACILITY_USERMODE_SDBUS, Name: "FACILITY_USERMODE_SDBUS"},
+		{Val: FACILITY_JSCRIPT, Name: "FACILITY_JSCRIPT"},
+		{Val: FACILITY_PIDGENX, Name: "FACILITY_PIDGENX"},
+		{Val: FACILITY_EAS, Name: "FACILITY_EAS"},
+		{Val: FACILITY_WEB, Name: "FACILITY_WEB"},
+		{Val: FACILITY_WEB_SOCKET, Name: "FACILITY_WEB_SOCKET"},
+		{Val: FACILITY_MOBILE, Name: "FACILITY_MOBILE"},
+		{Val: FACILITY_SQLITE, Name: "FACILITY_SQLITE"},
+		{Val: FACILITY_UTC, Name: "FACILITY_UTC"},
+		{Val: FACILITY_WEP, Name: "FACILITY_WEP"},
+		{Val: FACILITY_SYNCENGINE, Name: "FACILITY_SYNCENGINE"},
+		{Val: FACILITY_XBOX, Name: "FACILITY_XBOX"},
+		{Val: FACILITY_GAME, Name: "FACILITY_GAME"},
+		{Val: FACILITY_PIX, Name: "FACILITY_PIX"},
+	},
+}
+
+// Returns the name of the constant, like "FACILITY_NULL".
+func (v FACILITY) String() string {
+	return _FACILITY_names.String(v)
+}
+
+// Parses the name of a [FACILITY] constant, or a number.
+func ParseFACILITY(s string) (FACILITY, error) {
+	return _FACILITY_names.Parse(s)
+}
+
+var _LOCKTYPE_names = enum.Flags[LOCKTYPE]{
+	Type: "LOCKTYPE",
+	Values: []enum.Name[LOCKTYPE]{
+		{Val: LOCKTYPE_WRITE, Name: "LOCKTYPE_WRITE"},
+		{Val: LOCKTYPE_EXCLUSIVE, Name: "LOCKTYPE_EXCLUSIVE"},
+		{Val: LOCKTYPE_ONLYONCE, Name: "LOCKTYPE_ONLYONCE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LOCKTYPE_WRITE|LOCKTYPE_EXCLUSIVE".
+func (v LOCKTYPE) String() string {
+	return _LOCKTYPE_names.String(v)
+}
+
+// Parses names of [LOCKTYPE] flags joined by "|", or numbers.
+func ParseLOCKTYPE(s string) (LOCKTYPE, error) {
+	return _LOCKTYPE_names.Parse(s)
+}
+
+var _RPC_C_AUTHN_names = enum.Enum[RPC_C_AUTHN]{
+	Type: "RPC_C_AUTHN",
+	Values: []enum.Name[RPC_C_AUTHN]{
+		{Val: RPC_C_AUTHN_NONE, Name: "RPC_C_AUTHN_NONE"},
+		{Val: RPC_C_AUTHN_DCE_PRIVATE, Name: "RPC_C_AUTHN_DCE_PRIVATE"},
+		{Val: RPC_C_AUTHN_DCE_PUBLIC, Name: "RPC_C_AUTHN_DCE_PUBLIC"},
+		{Val: RPC_C_AUTHN_DEC_PUBLIC, Name: "RPC_C_AUTHN_DEC_PUBLIC"},
+		{Val: RPC_C_AUTHN_GSS_NEGOTIATE, Name: "RPC_C_AUTHN_GSS_NEGOTIATE"},
+		{Val: RPC_C_AUTHN_WINNT, Name: "RPC_C_AUTHN_WINNT"},
+		{Val: RPC_C_AUTHN_GSS_SCHANNEL, Name: "RPC_C_AUTHN_GSS_SCHANNEL"},
+		{Val: RPC_C_AUTHN_GSS_KERBEROS, Name: "RPC_C_AUTHN_GSS_KERBEROS"},
+		{Val: RPC_C_AUTHN_DPA, Name: "RPC_C_AUTHN_DPA"},
+		{Val: RPC_C_AUTHN_MSN, Name: "RPC_C_AUTHN_MSN"},
+		{Val: RPC_C_AUTHN_KERNEL, Name: "RPC_C_AUTHN_KERNEL"},
+		{Val: RPC_C_AUTHN_DIGEST, Name: "RPC_C_AUTHN_DIGEST"},
+		{Val: RPC_C_AUTHN_NEGO_EXTENDER, Name: "RPC_C_AUTHN_NEGO_EXTENDER"},
+		{Val: RPC_C_AUTHN_PKU2U, Name: "RPC_C_AUTHN_PKU2U"},
+		{Val: RPC_C_AUTHN_MQ, Name: "RPC_C_AUTHN_MQ"},
+		{Val: RPC_C_AUTHN_DEFAULT, Name: "RPC_C_AUTHN_DEFAULT"},
+	},
+}
+
+// Returns the name of the constant, like "RPC_C_AUTHN_NONE".
+func (v RPC_C_AUTHN) String() string {
+	return _RPC_C_AUTHN_names.String(v)
+}
+
+// Parses the name of a [RPC_C_AUTHN] constant, or a number.
+func ParseRPC_C_AUTHN(s string) (RPC_C_AUTHN, error) {
+	return _RPC_C_AUTHN_names.Parse(s)
+}
+
+var _RPC_C_AUTHN_LEVEL_names = enum.Enum[RPC_C_AUTHN_LEVEL]{
+	Type: "RPC_C_AUTHN_LEVEL",
+	Values: []enum.Name[RPC_C_AUTHN_LEVEL]{
+		{Val: RPC_C_AUTHN_LEVEL_DEFAULT, Name: "RPC_C_AUTHN_LEVEL_DEFAULT"},
+		{Val: RPC_C_AUTHN_LEVEL_NONE, Name: "RPC_C_AUTHN_LEVEL_NONE"},
+		{Val: RPC_C_AUTHN_LEVEL_CONNECT, Name: "RPC_C_AUTHN_LEVEL_CONNECT"},
+		{Val: RPC_C_AUTHN_LEVEL_CALL, Name: "RPC_C_AUTHN_LEVEL_CALL"},
+		{Val: RPC_C_AUTHN_LEVEL_PKT, Name: "RPC_C_AUTHN_LEVEL_PKT"},
+		{Val: RPC_C_AUTHN_LEVEL_PKT_INTEGRITY, Name: "RPC_C_AUTHN_LEVEL_PKT_INTEGRITY"},
+		{Val: RPC_C_AUTHN_LEVEL_PKT_PRIVACY, Name: "RPC_C_AUTHN_LEVEL_PKT_PRIVACY"},
+	},
+}
+
+// Returns the name of the constant, like "RPC_C_AUTHN_LEVEL_DEFAULT".
+func (v RPC_C_AUTHN_LEVEL) String() string {
+	return _RPC_C_AUTHN_LEVEL_names.String(v)
+}
+
+// Parses the name of a [RPC_C_AUTHN_LEVEL] constant, or a number.
+func ParseRPC_C_AUTHN_LEVEL(s string) (RPC_C_AUTHN_LEVEL, error) {
+	return _RPC_C_AUTHN_LEVEL_names.Parse(s)
+}
+
+var _RPC_C_AUTHZ_names = enum.Flags[RPC_C_AUTHZ]{
+	Type: "RPC_C_AUTHZ",
+	Values: []enum.Name[RPC_C_AUTHZ]{
+		{Val: RPC_C_AUTHZ_DEFAULT, Name: "RPC_C_AUTHZ_DEFAULT"},
+		{Val: RPC_C_AUTHZ_NAME, Name: "RPC_C_AUTHZ_NAME"},
+		{Val: RPC_C_AUTHZ_DCE, Name: "RPC_C_AUTHZ_DCE"},
+		{Val: RPC_C_AUTHZ_NONE, Name: "RPC_C_AUTHZ_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "RPC_C_AUTHZ_NAME|RPC_C_AUTHZ_DCE".
+func (v RPC_C_AUTHZ) String() string {
+	return _RPC_C_AUTHZ_names.String(v)
+}
+
+// Parses names of [RPC_C_AUTHZ] flags joined by "|", or numbers.
+func ParseRPC_C_AUTHZ(s string) (RPC_C_AUTHZ, error) {
+	return _RPC_C_AUTHZ_names.Parse(s)
+}
+
+var _RPC_C_IMP_LEVEL_names = enum.Enum[RPC_C_IMP_LEVEL]{
+	Type: "RPC_C_IMP_LEVEL",
+	Values: []enum.Name[RPC_C_IMP_LEVEL]{
+		{Val: RPC_C_IMP_LEVEL_DEFAULT, Name: "RPC_C_IMP_LEVEL_DEFAULT"},
+		{Val: RPC_C_IMP_LEVEL_ANONYMOUS, Name: "RPC_C_IMP_LEVEL_ANONYMOUS"},
+		{Val: RPC_C_IMP_LEVEL_IDENTIFY, Name: "RPC_C_IMP_LEVEL_IDENTIFY"},
+		{Val: RPC_C_IMP_LEVEL_IMPERSONATE, Name: "RPC_C_IMP_LEVEL_IMPERSONATE"},
+		{Val: RPC_C_IMP_LEVEL_DELEGATE, Name: "RPC_C_IMP_LEVEL_DELEGATE"},
+	},
+}
+
+// Returns the name of the constant, like "RPC_C_IMP_LEVEL_DEFAULT".
+func (v RPC_C_IMP_LEVEL) String() string {
+	return _RPC_C_IMP_LEVEL_names.String(v)
+}
+
+// Parses the name of a [RPC_C_IMP_LEVEL] constant, or a number.
+func ParseRPC_C_IMP_LEVEL(s string) (RPC_C_IMP_LEVEL, error) {
+	return _RPC_C_IMP_LEVEL_names.Parse(s)
+}
+
+var _RPC_C_QOS_CAPABILITIES_names = enum.Flags[RPC_C_QOS_CAPABILITIES]{
+	Type: "RPC_C_QOS_CAPABILITIES",
+	Values: []enum.Name[RPC_C_QOS_CAPABILITIES]{
+		{Val: RPC_C_QOS_CAPABILITIES_MUTUAL_AUTH, Name: "RPC_C_QOS_CAPABILITIES_MUTUAL_AUTH"},
+		{Val: RPC_C_QOS_CAPABILITIES_MAKE_FULLSIC, Name: "RPC_C_QOS_CAPABILITIES_MAKE_FULLSIC"},
+		{Val: RPC_C_QOS_CAPABILITIES_ANY_AUTHORITY, Name: "RPC_C_QOS_CAPABILITIES_ANY_AUTHORITY"},
+		{Val: RPC_C_QOS_CAPABILITIES_IGNORE_DELEGATE_FAILURE, Name: "RPC_C_QOS_CAPABILITIES_IGNORE_DELEGATE_FAILURE"},
+		{Val: RPC_C_QOS_CAPABILITIES_LOCAL_MA_HINT, Name: "RPC_C_QOS_CAPABILITIES_LOCAL_MA_HINT"},
+		{Val: RPC_C_QOS_CAPABILITIES_SCHANNEL_FULL_AUTH_IDENTITY, Name: "RPC_C_QOS_CAPABILITIES_SCHANNEL_FULL_AUTH_IDENTITY"},
+		{Val: RPC_C_QOS_CAPABILITIES_DEFAULT, Name: "RPC_C_QOS_CAPABILITIES_DEFAULT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "RPC_C_QOS_CAPABILITIES_MUTUAL_AUTH|RPC_C_QOS_CAPABILITIES_MAKE_FULLSIC".
+func (v RPC_C_QOS_CAPABILITIES) String() string {
+	return _RPC_C_QOS_CAPABILITIES_names.String(v)
+}
+
+// Parses names of [RPC_C_QOS_CAPABILITIES] flags joined by "|", or numbers.
+func ParseRPC_C_QOS_CAPABILITIES(s string) (RPC_C_QOS_CAPABILITIES, error) {
+	return _RPC_C_QOS_CAPABILITIES_names.Parse(s)
+}
+
+var _SEC_WINNT_AUTH_IDENTITY_names = enum.Enum[SEC_WINNT_AUTH_IDENTITY]{
+	Type: "SEC_WINNT_AUTH_IDENTITY",
+	Values: []enum.Name[SEC_WINNT_AUTH_IDENTITY]{
+		{Val: SEC_WINNT_AUTH_IDENTITY_ANSI, Name: "SEC_WINNT_AUTH_IDENTITY_ANSI"},
+		{Val: SEC_WINNT_AUTH_IDENTITY_UNICODE, Name: "SEC_WINNT_AUTH_IDENTITY_UNICODE"},
+	},
+}
+
+// Returns the name of the constant, like "SEC_WINNT_AUTH_IDENTITY_ANSI".
+func (v SEC_WINNT_AUTH_IDENTITY) String() string {
+	return _SEC_WINNT_AUTH_IDENTITY_names.String(v)
+}
+
+// Parses the name of a [SEC_WINNT_AUTH_IDENTITY] constant, or a number.
+func ParseSEC_WINNT_AUTH_IDENTITY(s string) (SEC_WINNT_AUTH_IDENTITY, error) {
+	return _SEC_WINNT_AUTH_IDENTITY_names.Parse(s)
+}
+
+var _SEVERITY_names = enum.Enum[SEVERITY]{
+	Type: "SEVERITY",
+	Values: []enum.Name[SEVERITY]{
+		{Val: SEVERITY_SUCCESS, Name: "SEVERITY_SUCCESS"},
+		{Val: SEVERITY_ERROR, Name: "SEVERITY_ERROR"},
+	},
+}
+
+// Returns the name of the constant, like "SEVERITY_SUCCESS".
+func (v SEVERITY) String() string {
+	return _SEVERITY_names.String(v)
+}
+
+// Parses the name of a [SEVERITY] constant, or a number.
+func ParseSEVERITY(s string) (SEVERITY, error) {
+	return _SEVERITY_names.Parse(s)
+}
+
+var _SLR_names = enum.Flags[SLR]{
+	Type: "SLR",
+	Values: []enum.Name[SLR]{
+		{Val: SLR_NO_UI_WITH_MSG_PUMP, Name: "SLR_NO_UI_WITH_MSG_PUMP"},
+		{Val: SLR_NO_UI, Name: "SLR_NO_UI"},
+		{Val: SLR_ANY_MATCH, Name: "SLR_ANY_MATCH"},
+		{Val: SLR_UPDATE, Name: "SLR_UPDATE"},
+		{Val: SLR_NOUPDATE, Name: "SLR_NOUPDATE"},
+		{Val: SLR_NOSEARCH, Name: "SLR_NOSEARCH"},
+		{Val: SLR_NOTRACK, Name: "SLR_NOTRACK"},
+		{Val: SLR_NOLINKINFO, Name: "SLR_NOLINKINFO"},
+		{Val: SLR_INVOKE_MSI, Name: "SLR_INVOKE_MSI"},
+		{Val: SLR_OFFER_DELETE_WITHOUT_FILE, Name: "SLR_OFFER_DELETE_WITHOUT_FILE"},
+		{Val: SLR_KNOWNFOLDER, Name: "SLR_KNOWNFOLDER"},
+		{Val: SLR_MACHINE_IN_LOCAL_TARGET, Name: "SLR_MACHINE_IN_LOCAL_TARGET"},
+		{Val: SLR_UPDATE_MACHINE_AND_SID, Name: "SLR_UPDATE_MACHINE_AND_SID"},
+		{Val: SLR_NO_OBJECT_ID, Name: "SLR_NO_OBJECT_ID"},
+		{Val: SLR_NONE, Name: "SLR_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SLR_NO_UI|SLR_ANY_MATCH".
+func (v SLR) String() string {
+	return _SLR_names.String(v)
+}
+
+// Parses names of [SLR] flags joined by "|", or numbers.
+func ParseSLR(s string) (SLR, error) {
+	return _SLR_names.Parse(s)
+}
+
+var _STATFLAG_names = enum.Enum[STATFLAG]{
+	Type: "STATFLAG",
+	Values: []enum.Name[STATFLAG]{
+		{Val: STATFLAG_DEFAULT, Name: "STATFLAG_DEFAULT"},
+		{Val: STATFLAG_NONAME, Name: "STATFLAG_NONAME"},
+		{Val: STATFLAG_NOOPEN, Name: "STATFLAG_NOOPEN"},
+	},
+}
+
+// Returns the name of the constant, like "STATFLAG_DEFAULT".
+func (v STATFLAG) String() string {
+	return _STATFLAG_names.String(v)
+}
+
+// Parses the name of a [STATFLAG] constant, or a number.
+func ParseSTATFLAG(s string) (STATFLAG, error) {
+	return _STATFLAG_names.Parse(s)
+}
+
+var _STGC_names = enum.Flags[STGC]{
+	Type: "STGC",
+	Values: []enum.Name[STGC]{
+		{Val: STGTY_LOCKBYTES, Name: "STGTY_LOCKBYTES"},
+		{Val: STGC_OVERWRITE, Name: "STGC_OVERWRITE"},
+		{Val: STGC_ONLYIFCURRENT, Name: "STGC_ONLYIFCURRENT"},
+		{Val: STGC_DANGEROUSLYCOMMITMERELYTODISKCACHE, Name: "STGC_DANGEROUSLYCOMMITMERELYTODISKCACHE"},
+		{Val: STGC_CONSOLIDATE, Name: "STGC_CONSOLIDATE"},
+		{Val: STGTY_STORAGE, Name: "STGTY_STORAGE"},
+		{Val: STGTY_STREAM, Name: "STGTY_STREAM"},
+		{Val: STGTY_PROPERTY, Name: "STGTY_PROPERTY"},
+		{Val: STGC_DEFAULT, Name: "STGC_DEFAULT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "STGC_OVERWRITE|STGC_ONLYIFCURRENT".
+func (v STGC) String() string {
+	return _STGC_names.String(v)
+}
+
+// Parses names of [STGC] flags joined by "|", or numbers.
+func ParseSTGC(s string) (STGC, error) {
+	return _STGC_names.Parse(s)
+}
+
+var _STGM_names = enum.Flags[STGM]{
+	Type: "STGM",
+	Values: []enum.Name[STGM]{
+		{Val: STGM_SHARE_DENY_READ, Name: "STGM_SHARE_DENY_READ"},
+		{Val: STGM_WRITE, Name: "STGM_WRITE"},
+		{Val: STGM_READWRITE, Name: "STGM_READWRITE"},
+		{Val: STGM_SHARE_DENY_NONE, Name: "STGM_SHARE_DENY_NONE"},
+		{Val: STGM_SHARE_DENY_WRITE, Name: "STGM_SHARE_DENY_WRITE"},
+		{Val: STGM_SHARE_EXCLUSIVE, Name: "STGM_SHARE_EXCLUSIVE"},
+		{Val: STGM_PRIORITY, Name: "STGM_PRIORITY"},
+		{Val: STGM_CREATE, Name: "STGM_CREATE"},
+		{Val: STGM_CONVERT, Name: "STGM_CONVERT"},
+		{Val: STGM_TRANSACTED, Name: "STGM_TRANSACTED"},
+		{Val: STGM_NOSCRATCH, Name: "STGM_NOSCRATCH"},
+		{Val: STGM_NOSNAPSHOT, Name: "STGM_NOSNAPSHOT"},
+		{Val: STGM_SIMPLE, Name: "STGM_SIMPLE"},
+		{Val: STGM_DIRECT_SWMR, Name: "STGM_DIRECT_SWMR"},
+		{Val: STGM_DELETEONRELEASE, Name: "STGM_DELETEONRELEASE"},
+		{Val: STGM_READ, Name: "STGM_READ"},
+		{Val: STGM_FAILIFTHERE, Name: "STGM_FAILIFTHERE"},
+		{Val: STGM_DIRECT, Name: "STGM_DIRECT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "STGM_WRITE|STGM_READWRITE".
+func (v STGM) String() string {
+	return _STGM_names.String(v)
+}
+
+// Parses names of [STGM] flags joined by "|", or numbers.
+func ParseSTGM(s string) (STGM, error) {
+	return _STGM_names.Parse(s)
+}
+
+var _STREAM_SEEK_names = enum.Enum[STREAM_SEEK]{
+	Type: "STREAM_SEEK",
+	Values: []enum.Name[STREAM_SEEK]{
+		{Val: STREAM_SEEK_SET, Name: "STREAM_SEEK_SET"},
+		{Val: STREAM_SEEK_CUR, Name: "STREAM_SEEK_CUR"},
+		{Val: STREAM_SEEK_END, Name: "STREAM_SEEK_END"},
+	},
+}
+
+// Returns the name of the constant, like "STREAM_SEEK_SET".
+func (v STREAM_SEEK) String() string {
+	return _STREAM_SEEK_names.String(v)
+}
+
+// Parses the name of a [STREAM_SEEK] constant, or a number.
+func ParseSTREAM_SEEK(s string) (STREAM_SEEK, error) {
+	return _STREAM_SEEK_names.Parse(s)
+}
+
+var _TYMED_names = enum.Flags[TYMED]{
+	Type: "TYMED",
+	Values: []enum.Name[TYMED]{
+		{Val: TYMED_HGLOBAL, Name: "TYMED_HGLOBAL"},
+		{Val: TYMED_FILE, Name: "TYMED_FILE"},
+		{Val: TYMED_ISTREAM, Name: "TYMED_ISTREAM"},
+		{Val: TYMED_ISTORAGE, Name: "TYMED_ISTORAGE"},
+		{Val: TYMED_GDI, Name: "TYMED_GDI"},
+		{Val: TYMED_MFPICT, Name: "TYMED_MFPICT"},
+		{Val: TYMED_ENHMF, Name: "TYMED_ENHMF"},
+		{Val: TYMED_NULL, Name: "TYMED_NULL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TYMED_HGLOBAL|TYMED_FILE".
+func (v TYMED) String() string {
+	return _TYMED_names.String(v)
+}
+
+// Parses names of [TYMED] flags joined by "|", or numbers.
+func ParseTYMED(s string) (TYMED, error) {
+	return _TYMED_names.Parse(s)
+}
+
+var _ACCELF_names = enum.Flags[ACCELF]{
+	Type: "ACCELF",
+	Values: []enum.Name[ACCELF]{
+		{Val: ACCELF_VIRTKEY, Name: "ACCELF_VIRTKEY"},
+		{Val: ACCELF_SHIFT, Name: "ACCELF_SHIFT"},
+		{Val: ACCELF_CONTROL, Name: "ACCELF_CONTROL"},
+		{Val: ACCELF_ALT, Name: "ACCELF_ALT"},
+		{Val: ACCELF_NONE, Name: "ACCELF_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ACCELF_VIRTKEY|ACCELF_SHIFT".
+func (v ACCELF) String() string {
+	return _ACCELF_names.String(v)
+}
+
+// Parses names of [ACCELF] flags joined by "|", or numbers.
+func ParseACCELF(s string) (ACCELF, error) {
+	return _ACCELF_names.Parse(s)
+}
+
+var _APPCOMMAND_names = enum.Enum[APPCOMMAND]{
+	Type: "APPCOMMAND",
+	Values: []enum.Name[APPCOMMAND]{
+		{Val: APPCOMMAND_BROWSER_BACKWARD, Name: "APPCOMMAND_BROWSER_BACKWARD"},
+		{Val: APPCOMMAND_BROWSER_FORWARD, Name: "APPCOMMAND_BROWSER_FORWARD"},
+		{Val: APPCOMMAND_BROWSER_REFRESH, Name: "APPCOMMAND_BROWSER_REFRESH"},
+		{Val: APPCOMMAND_BROWSER_STOP, Name: "APPCOMMAND_BROWSER_STOP"},
+		{Val: APPCOMMAND_BROWSER_SEARCH, Name: "APPCOMMAND_BROWSER_SEARCH"},
+		{Val: APPCOMMAND_BROWSER_FAVORITES, Name: "APPCOMMAND_BROWSER_FAVORITES"},
+		{Val: APPCOMMAND_BROWSER_HOME, Name: "APPCOMMAND_BROWSER_HOME"},
+		{Val: APPCOMMAND_VOLUME_MUTE, Name: "APPCOMMAND_VOLUME_MUTE"},
+		{Val: APPCOMMAND_VOLUME_DOWN, Name: "APPCOMMAND_VOLUME_DOWN"},
+		{Val: APPCOMMAND_VOLUME_UP, Name: "APPCOMMAND_VOLUME_UP"},
+		{Val: APPCOMMAND_MEDIA_NEXTTRACK, Name: "APPCOMMAND_MEDIA_NEXTTRACK"},
+		{Val: APPCOMMAND_MEDIA_PREVIOUSTRACK, Name: "APPCOMMAND_MEDIA_PREVIOUSTRACK"},
+		{Val: APPCOMMAND_MEDIA_STOP, Name: "APPCOMMAND_MEDIA_STOP"},
+		{Val: APPCOMMAND_MEDIA_PLAY_PAUSE, Name: "APPCOMMAND_MEDIA_PLAY_PAUSE"},
+		{Val: APPCOMMAND_LAUNCH_MAIL, Name: "APPCOMMAND_LAUNCH_MAIL"},
+		{Val: APPCOMMAND_LAUNCH_MEDIA_SELECT, Name: "APPCOMMAND_LAUNCH_MEDIA_SELECT"},
+		{Val: APPCOMMAND_LAUNCH_APP1, Name: "APPCOMMAND_LAUNCH_APP1"},
+		{Val: APPCOMMAND_LAUNCH_APP2, Name: "APPCOMMAND_LAUNCH_APP2"},
+		{Val: APPCOMMAND_BASS_DOWN, Name: "APPCOMMAND_BASS_DOWN"},
+		{Val: APPCOMMAND_BASS_BOOST, Name: "APPCOMMAND_BASS_BOOST"},
+		{Val: APPCOMMAND_BASS_UP, Name: "APPCOMMAND_BASS_UP"},
+		{Val: APPCOMMAND_TREBLE_DOWN, Name: "APPCOMMAND_TREBLE_DOWN"},
+		{Val: APPCOMMAND_TREBLE_UP, Name: "APPCOMMAND_TREBLE_UP"},
+		{Val: APPCOMMAND_MICROPHONE_VOLUME_MUTE, Name: "APPCOMMAND_MICROPHONE_VOLUME_MUTE"},
+		{Val: APPCOMMAND_MICROPHONE_VOLUME_DOWN, Name: "APPCOMMAND_MICROPHONE_VOLUME_DOWN"},
+		{Val: APPCOMMAND_MICROPHONE_VOLUME_UP, Name: "APPCOMMAND_MICROPHONE_VOLUME_UP"},
+		{Val: APPCOMMAND_HELP, Name: "APPCOMMAND_HELP"},
+		{Val: APPCOMMAND_FIND, Name: "APPCOMMAND_FIND"},
+		{Val: APPCOMMAND_NEW, Name: "APPCOMMAND_NEW"},
+		{Val: APPCOMMAND_OPEN, Name: "APPCOMMAND_OPEN"},
+		{Val: APPCOMMAND_CLOSE, Name: "APPCOMMAND_CLOSE"},
+		{Val: APPCOMMAND_SAVE, Name: "APPCOMMAND_SAVE"},
+		{Val: APPCOMMAND_PRINT, Name: "APPCOMMAND_PRINT"},
+		{Val: APPCOMMAND_UNDO, Name: "APPCOMMAND_UNDO"},
+		{Val: APPCOMMAND_REDO, Name: "APPCOMMAND_REDO"},
+		{Val: APPCOMMAND_COPY, Name: "APPCOMMAND_COPY"},
+		{Val: APPCOMMAND_CUT, Name: "APPCOMMAND_CUT"},
+		{Val: APPCOMMAND_PASTE, Name: "APPCOMMAND_PASTE"},
+		{Val: APPCOMMAND_REPLY_TO_MAIL, Name: "APPCOMMAND_REPLY_TO_MAIL"},
+		{Val: APPCOMMAND_FORWARD_MAIL, Name: "APPCOMMAND_FORWARD_MAIL"},
+		{Val: APPCOMMAND_SEND_MAIL, Name: "APPCOMMAND_SEND_MAIL"},
+		{Val: APPCOMMAND_SPELL_CHECK, Name: "APPCOMMAND_SPELL_CHECK"},
+		{Val: APPCOMMAND_DICTATE_OR_COMMAND_CONTROL_TOGGLE, Name: "APPCOMMAND_DICTATE_OR_COMMAND_CONTROL_TOGGLE"},
+		{Val: APPCOMMAND_MIC_ON_OFF_TOGGLE, Name: "APPCOMMAND_MIC_ON_OFF_TOGGLE"},
+		{Val: APPCOMMAND_CORRECTION_LIST, Name: "APPCOMMAND_CORRECTION_LIST"},
+		{Val: APPCOMMAND_MEDIA_PLAY, Name: "APPCOMMAND_MEDIA_PLAY"},
+		{Val: APPCOMMAND_MEDIA_PAUSE, Name: "APPCOMMAND_MEDIA_PAUSE"},
+		{Val: APPCOMMAND_MEDIA_RECORD, Name: "APPCOMMAND_MEDIA_RECORD"},
+		{Val: APPCOMMAND_MEDIA_FAST_FORWARD, Name: "APPCOMMAND_MEDIA_FAST_FORWARD"},
+		{Val: APPCOMMAND_MEDIA_REWIND, Name: "APPCOMMAND_MEDIA_REWIND"},
+		{Val: APPCOMMAND_MEDIA_CHANNEL_UP, Name: "APPCOMMAND_MEDIA_CHANNEL_UP"},
+		{Val: APPCOMMAND_MEDIA_CHANNEL_DOWN, Name: "APPCOMMAND_MEDIA_CHANNEL_DOWN"},
+		{Val: APPCOMMAND_DELETE, Name: "APPCOMMAND_DELETE"},
+		{Val: APPCOMMAND_DWM_FLIP3D, Name: "APPCOMMAND_DWM_FLIP3D"},
+	},
+}
+
+// Returns the name of the constant, like "APPCOMMAND_BROWSER_BACKWARD".
+func (v APPCOMMAND) String() string {
+	return _APPCOMMAND_names.String(v)
+}
+
+// Parses the name of a [APPCOMMAND] constant, or a number.
+func ParseAPPCOMMAND(s string) (APPCOMMAND, error) {
+	return _APPCOMMAND_names.Parse(s)
+}
+
+var _AW_names = enum.Flags[AW]{
+	Type: "AW",
+	Values: []enum.Name[AW]{
+		{Val: AW_ACTIVATE, Name: "AW_ACTIVATE"},
+		{Val: AW_BLEND, Name: "AW_BLEND"},
+		{Val: AW_CENTER, Name: "AW_CENTER"},
+		{Val: AW_HIDE, Name: "AW_HIDE"},
+		{Val: AW_HOR_POSITIVE, Name: "AW_HOR_POSITIVE"},
+		{Val: AW_HOR_NEGATIVE, Name: "AW_HOR_NEGATIVE"},
+		{Val: AW_SLIDE, Name: "AW_SLIDE"},
+		{Val: AW_VER_POSITIVE, Name: "AW_VER_POSITIVE"},
+		{Val: AW_VER_NEGATIVE, Name: "AW_VER_NEGATIVE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "AW_ACTIVATE|AW_BLEND".
+func (v AW) String() string {
+	return _AW_names.String(v)
+}
+
+// Parses names of [AW] flags joined by "|", or numbers.
+func ParseAW(s string) (AW, error) {
+	return _AW_names.Parse(s)
+}
+
+var _BS_names = enum.Flags[BS]{
+	Type: "BS",
+	Values: []enum.Name[BS]{
+		{Val: BS_GROUPBOX, Name: "BS_GROUPBOX"},
+		{Val: BS_OWNERDRAW, Name: "BS_OWNERDRAW"},
+		{Val: BS_AUTOCHECKBOX, Name: "BS_AUTOCHECKBOX"},
+		{Val: BS_3STATE, Name: "BS_3STATE"},
+		{Val: BS_AUTO3STATE, Name: "BS_AUTO3STATE"},
+		{Val: BS_AUTORADIOBUTTON, Name: "BS_AUTORADIOBUTTON"},
+		{Val: BS_PUSHBOX, Name: "BS_PUSHBOX"},
+		{Val: BS_CENTER, Name: "BS_CENTER"},
+		{Val: BS_VCENTER, Name: "BS_VCENTER"},
+		{Val: BS_DEFPUSHBUTTON, Name: "BS_DEFPUSHBUTTON"},
+		{Val: BS_CHECKBOX, Name: "BS_CHECKBOX"},
+		{Val: BS_RADIOBUTTON, Name: "BS_RADIOBUTTON"},
+		{Val: BS_USERBUTTON, Name: "BS_USERBUTTON"},
+		{Val: BS_LEFTTEXT, Name: "BS_LEFTTEXT"},
+		{Val: BS_ICON, Name: "BS_ICON"},
+		{Val: BS_BITMAP, Name: "BS_BITMAP"},
+		{Val: BS_LEFT, Name: "BS_LEFT"},
+		{Val: BS_RIGHT, Name: "BS_RIGHT"},
+		{Val: BS_TOP, Name: "BS_TOP"},
+		{Val: BS_BOTTOM, Name: "BS_BOTTOM"},
+		{Val: BS_PUSHLIKE, Name: "BS_PUSHLIKE"},
+		{Val: BS_MULTILINE, Name: "BS_MULTILINE"},
+		{Val: BS_NOTIFY, Name: "BS_NOTIFY"},
+		{Val: BS_FLAT, Name: "BS_FLAT"},
+		{Val: BS_PUSHBUTTON, Name: "BS_PUSHBUTTON"},
+		{Val: BS_TEXT, Name: "BS_TEXT"},
+		{Val: BS_RIGHTBUTTON, Name: "BS_RIGHTBUTTON"},
+	},
+	ParseOnly: []enum.Name[BS]{
+		{Val: BS_TYPEMASK, Name: "BS_TYPEMASK"},
+	},
+	Fields: []BS{0xf},
+}
+
+// Returns the names of the flags joined by "|", like "BS_LEFTTEXT|BS_ICON".
+func (v BS) String() string {
+	return _BS_names.String(v)
+}
+
+// Parses names of [BS] flags joined by "|", or numbers.
+func ParseBS(s string) (BS, error) {
+	return _BS_names.Parse(s)
+}
+
+var _BSF_names = enum.Flags[BSF]{
+	Type: "BSF",
+	Values: []enum.Name[BSF]{
+		{Val: BSF_ALLOWSFW, Name: "BSF_ALLOWSFW"},
+		{Val: BSF_FLUSHDISK, Name: "BSF_FLUSHDISK"},
+		{Val: BSF_FORCEIFHUNG, Name: "BSF_FORCEIFHUNG"},
+		{Val: BSF_IGNORECURRENTTASK, Name: "BSF_IGNORECURRENTTASK"},
+		{Val: BSF_NOHANG, Name: "BSF_NOHANG"},
+		{Val: BSF_NOTIMEOUTIFNOTHUNG, Name: "BSF_NOTIMEOUTIFNOTHUNG"},
+		{Val: BSF_POSTMESSAGE, Name: "BSF_POSTMESSAGE"},
+		{Val: BSF_QUERY, Name: "BSF_QUERY"},
+		{Val: BSF_SENDNOTIFYMESSAGE, Name: "BSF_SENDNOTIFYMESSAGE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "BSF_ALLOWSFW|BSF_FLUSHDISK".
+func (v BSF) String() string {
+	return _BSF_names.String(v)
+}
+
+// Parses names of [BSF] flags joined by "|", or numbers.
+func ParseBSF(s string) (BSF, error) {
+	return _BSF_names.Parse(s)
+}
+
+var _BSM_names = enum.Flags[BSM]{
+	Type: "BSM",
+	Values: []enum.Name[BSM]{
+		{Val: BSM_ALLDESKTOPS, Name: "BSM_ALLDESKTOPS"},
+		{Val: BSM_APPLICATIONS, Name: "BSM_APPLICATIONS"},
+		{Val: BSM_ALLCOMPONENTS, Name: "BSM_ALLCOMPONENTS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "BSM_ALLDESKTOPS|BSM_APPLICATIONS".
+func (v BSM) String() string {
+	return _BSM_names.String(v)
+}
+
+// Parses names of [BSM] flags joined by "|", or numbers.
+func ParseBSM(s string) (BSM, error) {
+	return _BSM_names.Parse(s)
+}
+
+var _BST_names = enum.Flags[BST]{
+	Type: "BST",
+	Values: []enum.Name[BST]{
+		{Val: BST_CHECKED, Name: "BST_CHECKED"},
+		{Val: BST_INDETERMINATE, Name: "BST_INDETERMINATE"},
+		{Val: BST_PUSHED, Name: "BST_PUSHED"},
+		{Val: BST_FOCUS, Name: "BST_FOCUS"},
+		{Val: BST_UNCHECKED, Name: "BST_UNCHECKED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "BST_CHECKED|BST_INDETERMINATE".
+func (v BST) String() string {
+	return _BST_names.String(v)
+}
+
+// Parses names of [BST] flags joined by "|", or numbers.
+func ParseBST(s string) (BST, error) {
+	return _BST_names.Parse(s)
+}
+
+var _CBS_names = enum.Flags[CBS]{
+	Type: "CBS",
+	Values: []enum.Name[CBS]{
+		{Val: CBS_DROPDOWNLIST, Name: "CBS_DROPDOWNLIST"},
+		{Val: CBS_SIMPLE, Name: "CBS_SIMPLE"},
+		{Val: CBS_DROPDOWN, Name: "CBS_DROPDOWN"},
+		{Val: CBS_OWNERDRAWFIXED, Name: "CBS_OWNERDRAWFIXED"},
+		{Val: CBS_OWNERDRAWVARIABLE, Name: "CBS_OWNERDRAWVARIABLE"},
+		{Val: CBS_AUTOHSCROLL, Name: "CBS_AUTOHSCROLL"},
+		{Val: CBS_OEMCONVERT, Name: "CBS_OEMCONVERT"},
+		{Val: CBS_SORT, Name: "CBS_SORT"},
+		{Val: CBS_HASSTRINGS, Name: "CBS_HASSTRINGS"},
+		{Val: CBS_NOINTEGRALHEIGHT, Name: "CBS_NOINTEGRALHEIGHT"},
+		{Val: CBS_DISABLENOSCROLL, Name: "CBS_DISABLENOSCROLL"},
+		{Val: CBS_UPPERCASE, Name: "CBS_UPPERCASE"},
+		{Val: CBS_LOWERCASE, Name: "CBS_LOWERCASE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CBS_SIMPLE|CBS_DROPDOWN".
+func (v CBS) String() string {
+	return _CBS_names.String(v)
+}
+
+// Parses names of [CBS] flags joined by "|", or numbers.
+func ParseCBS(s string) (CBS, error) {
+	return _CBS_names.Parse(s)
+}
+
+var _CDS_names = enum.Flags[CDS]{
+	Type: "CDS",
+	Values: []enum.Name[CDS]{
+		{Val: CDS_UPDATEREGISTRY, Name: "CDS_UPDATEREGISTRY"},
+		{Val: CDS_TEST, Name: "CDS_TEST"},
+		{Val: CDS_FULLSCREEN, Name: "CDS_FULLSCREEN"},
+		{Val: CDS_GLOBAL, Name: "CDS_GLOBAL"},
+		{Val: CDS_SET_PRIMARY, Name: "CDS_SET_PRIMARY"},
+		{Val: CDS_VIDEOPARAMETERS, Name: "CDS_VIDEOPARAMETERS"},
+		{Val: CDS_ENABLE_UNSAFE_MODES, Name: "CDS_ENABLE_UNSAFE_MODES"},
+		{Val: CDS_DISABLE_UNSAFE_MODES, Name: "CDS_DISABLE_UNSAFE_MODES"},
+		{Val: CDS_RESET, Name: "CDS_RESET"},
+		{Val: CDS_RESET_EX, Name: "CDS_RESET_EX"},
+		{Val: CDS_NORESET, Name: "CDS_NORESET"},
+		{Val: CDS_NONE, Name: "CDS_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CDS_UPDATEREGISTRY|CDS_TEST".
+func (v CDS) String() string {
+	return _CDS_names.String(v)
+}
+
+// Parses names of [CDS] flags joined by "|", or numbers.
+func ParseCDS(s string) (CDS, error) {
+	return _CDS_names.Parse(s)
+}
+
+var _CF_names = enum.Enum[CF]{
+	Type: "CF",
+	Values: []enum.Name[CF]{
+		{Val: CF_TEXT, Name: "CF_TEXT"},
+		{Val: CF_BITMAP, Name: "CF_BITMAP"},
+		{Val: CF_METAFILEPICT, Name: "CF_METAFILEPICT"},
+		{Val: CF_SYLK, Name: "CF_SYLK"},
+		{Val: CF_DIF, Name: "CF_DIF"},
+		{Val: CF_TIFF, Name: "CF_TIFF"},
+		{Val: CF_OEMTEXT, Name: "CF_OEMTEXT"},
+		{Val: CF_DIB, Name: "CF_DIB"},
+		{Val: CF_PALETTE, Name: "CF_PALETTE"},
+		{Val: CF_PENDATA, Name: "CF_PENDATA"},
+		{Val: CF_RIFF, Name: "CF_RIFF"},
+		{Val: CF_WAVE, Name: "CF_WAVE"},
+		{Val: CF_UNICODETEXT, Name: "CF_UNICODETEXT"},
+		{Val: CF_ENHMETAFILE, Name: "CF_ENHMETAFILE"},
+		{Val: CF_HDROP, Name: "CF_HDROP"},
+		{Val: CF_LOCALE, Name: "CF_LOCALE"},
+		{Val: CF_DIBV5, Name: "CF_DIBV5"},
+		{Val: CF_MAX, Name: "CF_MAX"},
+		{Val: CF_OWNERDISPLAY, Name: "CF_OWNERDISPLAY"},
+		{Val: CF_DSPTEXT, Name: "CF_DSPTEXT"},
+		{Val: CF_DSPBITMAP, Name: "CF_DSPBITMAP"},
+		{Val: CF_DSPMETAFILEPICT, Name: "CF_DSPMETAFILEPICT"},
+		{Val: CF_DSPENHMETAFILE, Name: "CF_DSPENHMETAFILE"},
+		{Val: CF_PRIVATEFIRST, Name: "CF_PRIVATEFIRST"},
+		{Val: CF_PRIVATELAST, Name: "CF_PRIVATELAST"},
+		{Val: CF_GDIOBJFIRST, Name: "CF_GDIOBJFIRST"},
+		{Val: CF_GDIOBJLAST, Name: "CF_GDIOBJLAST"},
+	},
+}
+
+// Returns the name of the constant, like "CF_TEXT".
+func (v CF) String() string {
+	return _CF_names.String(v)
+}
+
+// Parses the name of a [CF] constant, or a number.
+func ParseCF(s string) (CF, error) {
+	return _CF_names.Parse(s)
+}
+
+var _COLOR_names = enum.Enum[COLOR]{
+	Type: "COLOR",
+	Values: []enum.Name[COLOR]{
+		{Val: COLOR_SCROLLBAR, Name: "COLOR_SCROLLBAR"},
+		{Val: COLOR_BACKGROUND, Name: "COLOR_BACKGROUND"},
+		{Val: COLOR_ACTIVECAPTION, Name: "COLOR_ACTIVECAPTION"},
+		{Val: COLOR_INACTIVECAPTION, Name: "COLOR_INACTIVECAPTION"},
+		{Val: COLOR_MENU, Name: "COLOR_MENU"},
+		{Val: COLOR_WINDOW, Name: "COLOR_WINDOW"},
+		{Val: COLOR_WINDOWFRAME, Name: "COLOR_WINDOWFRAME"},
+		{Val: COLOR_MENUTEXT, Name: "COLOR_MENUTEXT"},
+		{Val: COLOR_WINDOWTEXT, Name: "COLOR_WINDOWTEXT"},
+		{Val: COLOR_CAPTIONTEXT, Name: "COLOR_CAPTIONTEXT"},
+		{Val: COLOR_ACTIVEBORDER, Name: "COLOR_ACTIVEBORDER"},
+		{Val: COLOR_INACTIVEBORDER, Name: "COLOR_INACTIVEBORDER"},
+		{Val: COLOR_APPWORKSPACE, Name: "COLOR_APPWORKSPACE"},
+		{Val: COLOR_HIGHLIGHT, Name: "COLOR_HIGHLIGHT"},
+		{Val: COLOR_HIGHLIGHTTEXT, Name: "COLOR_HIGHLIGHTTEXT"},
+		{Val: COLOR_BTNFACE, Name: "COLOR_BTNFACE"},
+		{Val: COLOR_BTNSHADOW, Name: "COLOR_BTNSHADOW"},
+		{Val: COLOR_GRAYTEXT, Name: "COLOR_GRAYTEXT"},
+		{Val: COLOR_BTNTEXT, Name: "COLOR_BTNTEXT"},
+		{Val: COLOR_INACTIVECAPTIONTEXT, Name: "COLOR_INACTIVECAPTIONTEXT"},
+		{Val: COLOR_BTNHIGHLIGHT, Name: "COLOR_BTNHIGHLIGHT"},
+		{Val: COLOR_3DDKSHADOW, Name: "COLOR_3DDKSHADOW"},
+		{Val: COLOR_3DLIGHT, Name: "COLOR_3DLIGHT"},
+		{Val: COLOR_INFOTEXT, Name: "COLOR_INFOTEXT"},
+		{Val: COLOR_INFOBK, Name: "COLOR_INFOBK"},
+		{Val: COLOR_HOTLIGHT, Name: "COLOR_HOTLIGHT"},
+		{Val: COLOR_GRADIENTACTIVECAPTION, Name: "COLOR_GRADIENTACTIVECAPTION"},
+		{Val: COLOR_GRADIENTINACTIVECAPTION, Name: "COLOR_GRADIENTINACTIVECAPTION"},
+		{Val: COLOR_MENUHILIGHT, Name: "COLOR_MENUHILIGHT"},
+		{Val: COLOR_MENUBAR, Name: "COLOR_MENUBAR"},
+		{Val: COLOR_DESKTOP, Name: "COLOR_DESKTOP"},
+		{Val: COLOR_3DFACE, Name: "COLOR_3DFACE"},
+		{Val: COLOR_3DSHADOW, Name: "COLOR_3DSHADOW"},
+		{Val: COLOR_3DHIGHLIGHT, Name: "COLOR_3DHIGHLIGHT"},
+		{Val: COLOR_3DHILIGHT, Name: "COLOR_3DHILIGHT"},
+		{Val: COLOR_BTNHILIGHT, Name: "COLOR_BTNHILIGHT"},
+	},
+}
+
+// Returns the name of the constant, like "COLOR_SCROLLBAR".
+func (v COLOR) String() string {
+	return _COLOR_names.String(v)
+}
+
+// Parses the name of a [COLOR] constant, or a number.
+func ParseCOLOR(s string) (COLOR, error) {
+	return _COLOR_names.Parse(s)
+}
+
+var _CS_names = enum.Flags[CS]{
+	Type: "CS",
+	Values: []enum.Name[CS]{
+		{Val: CS_VREDRAW, Name: "CS_VREDRAW"},
+		{Val: CS_HREDRAW, Name: "CS_HREDRAW"},
+		{Val: CS_DBLCLKS, Name: "CS_DBLCLKS"},
+		{Val: CS_OWNDC, Name: "CS_OWNDC"},
+		{Val: CS_CLASSDC, Name: "CS_CLASSDC"},
+		{Val: CS_PARENTDC, Name: "CS_PARENTDC"},
+		{Val: CS_NOCLOSE, Name: "CS_NOCLOSE"},
+		{Val: CS_SAVEBITS, Name: "CS_SAVEBITS"},
+		{Val: CS_BYTEALIGNCLIENT, Name: "CS_BYTEALIGNCLIENT"},
+		{Val: CS_BYTEALIGNWINDOW, Name: "CS_BYTEALIGNWINDOW"},
+		{Val: CS_GLOBALCLASS, Name: "CS_GLOBALCLASS"},
+		{Val: CS_DROPSHADOW, Name: "CS_DROPSHADOW"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CS_VREDRAW|CS_HREDRAW".
+func (v CS) String() string {
+	return _CS_names.String(v)
+}
+
+// Parses names of [CS] flags joined by "|", or numbers.
+func ParseCS(s string) (CS, error) {
+	return _CS_names.Parse(s)
+}
+
+var _CURSOR_names = enum.Enum[CURSOR]{
+	Type: "CURSOR",
+	Values: []enum.Name[CURSOR]{
+		{Val: CURSOR_HIDDEN, Name: "CURSOR_HIDDEN"},
+		{Val: CURSOR_SHOWING, Name: "CURSOR_SHOWING"},
+		{Val: CURSOR_SUPPRESSED, Name: "CURSOR_SUPPRESSED"},
+	},
+}
+
+// Returns the name of the constant, like "CURSOR_HIDDEN".
+func (v CURSOR) String() string {
+	return _CURSOR_names.String(v)
+}
+
+// Parses the name of a [CURSOR] constant, or a number.
+func ParseCURSOR(s string) (CURSOR, error) {
+	return _CURSOR_names.Parse(s)
+}
+
+var _CWP_names = enum.Flags[CWP]{
+	Type: "CWP",
+	Values: []enum.Name[CWP]{
+		{Val: CWP_SKIPDISABLED, Name: "CWP_SKIPDISABLED"},
+		{Val: CWP_SKIPINVISIBLE, Name: "CWP_SKIPINVISIBLE"},
+		{Val: CWP_SKIPTRANSPARENT, Name: "CWP_SKIPTRANSPARENT"},
+		{Val: CWP_ALL, Name: "CWP_ALL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "CWP_SKIPDISABLED|CWP_SKIPINVISIBLE".
+func (v CWP) String() string {
+	return _CWP_names.String(v)
+}
+
+// Parses names of [CWP] flags joined by "|", or numbers.
+func ParseCWP(s string) (CWP, error) {
+	return _CWP_names.Parse(s)
+}
+
+var _DI_names = enum.Flags[DI]{
+	Type: "DI",
+	Values: []enum.Name[DI]{
+		{Val: DI_NORMAL, Name: "DI_NORMAL"},
+		{Val: DI_COMPAT, Name: "DI_COMPAT"},
+		{Val: DI_DEFAULTSIZE, Name: "DI_DEFAULTSIZE"},
+		{Val: DI_IMAGE, Name: "DI_IMAGE"},
+		{Val: DI_NOMIRROR, Name: "DI_NOMIRROR"},
+	},
+	ParseOnly: []enum.Name[DI]{
+		{Val: DI_MASK, Name: "DI_MASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DI_COMPAT|DI_DEFAULTSIZE".
+func (v DI) String() string {
+	return _DI_names.String(v)
+}
+
+// Parses names of [DI] flags joined by "|", or numbers.
+func ParseDI(s string) (DI, error) {
+	return _DI_names.Parse(s)
+}
+
+var _DISP_CHANGE_names = enum.Enum[DISP_CHANGE]{
+	Type: "DISP_CHANGE",
+	Values: []enum.Name[DISP_CHANGE]{
+		{Val: DISP_CHANGE_SUCCESSFUL, Name: "DISP_CHANGE_SUCCESSFUL"},
+		{Val: DISP_CHANGE_RESTART, Name: "DISP_CHANGE_RESTART"},
+		{Val: DISP_CHANGE_FAILED, Name: "DISP_CHANGE_FAILED"},
+		{Val: DISP_CHANGE_BADMODE, Name: "DISP_CHANGE_BADMODE"},
+		{Val: DISP_CHANGE_NOTUPDATED, Name: "DISP_CHANGE_NOTUPDATED"},
+		{Val: DISP_CHANGE_BADFLAGS, Name: "DISP_CHANGE_BADFLAGS"},
+		{Val: DISP_CHANGE_BADPARAM, Name: "DISP_CHANGE_BADPARAM"},
+		{Val: DISP_CHANGE_BADDUALVIEW, Name: "DISP_CHANGE_BADDUALVIEW"},
+	},
+}
+
+// Returns the name of the constant, like "DISP_CHANGE_SUCCESSFUL".
+func (v DISP_CHANGE) String() string {
+	return _DISP_CHANGE_names.String(v)
+}
+
+// Parses the name of a [DISP_CHANGE] constant, or a number.
+func ParseDISP_CHANGE(s string) (DISP_CHANGE, error) {
+	return _DISP_CHANGE_names.Parse(s)
+}
+
+var _DISPLAY_DEVICE_names = enum.Flags[DISPLAY_DEVICE]{
+	Type: "DISPLAY_DEVICE",
+	Values: []enum.Name[DISPLAY_DEVICE]{
+		{Val: DISPLAY_DEVICE_ATTACHED_TO_DESKTOP, Name: "DISPLAY_DEVICE_ATTACHED_TO_DESKTOP"},
+		{Val: DISPLAY_DEVICE_MULTI_DRIVER, Name: "DISPLAY_DEVICE_MULTI_DRIVER"},
+		{Val: DISPLAY_DEVICE_PRIMARY_DEVICE, Name: "DISPLAY_DEVICE_PRIMARY_DEVICE"},
+		{Val: DISPLAY_DEVICE_MIRRORING_DRIVER, Name: "DISPLAY_DEVICE_MIRRORING_DRIVER"},
+		{Val: DISPLAY_DEVICE_VGA_COMPATIBLE, Name: "DISPLAY_DEVICE_VGA_COMPATIBLE"},
+		{Val: DISPLAY_DEVICE_REMOVABLE, Name: "DISPLAY_DEVICE_REMOVABLE"},
+		{Val: DISPLAY_DEVICE_ACC_DRIVER, Name: "DISPLAY_DEVICE_ACC_DRIVER"},
+		{Val: DISPLAY_DEVICE_MODESPRUNED, Name: "DISPLAY_DEVICE_MODESPRUNED"},
+		{Val: DISPLAY_DEVICE_RDPUDD, Name: "DISPLAY_DEVICE_RDPUDD"},
+		{Val: DISPLAY_DEVICE_REMOTE, Name: "DISPLAY_DEVICE_REMOTE"},
+		{Val: DISPLAY_DEVICE_DISCONNECT, Name: "DISPLAY_DEVICE_DISCONNECT"},
+		{Val: DISPLAY_DEVICE_TS_COMPATIBLE, Name: "DISPLAY_DEVICE_TS_COMPATIBLE"},
+		{Val: DISPLAY_DEVICE_UNSAFE_MODES_ON, Name: "DISPLAY_DEVICE_UNSAFE_MODES_ON"},
+		{Val: DISPLAY_DEVICE_ACTIVE, Name: "DISPLAY_DEVICE_ACTIVE"},
+		{Val: DISPLAY_DEVICE_ATTACHED, Name: "DISPLAY_DEVICE_ATTACHED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DISPLAY_DEVICE_ATTACHED_TO_DESKTOP|DISPLAY_DEVICE_MULTI_DRIVER".
+func (v DISPLAY_DEVICE) String() string {
+	return _DISPLAY_DEVICE_names.String(v)
+}
+
+// Parses names of [DISPLAY_DEVICE] flags joined by "|", or numbers.
+func ParseDISPLAY_DEVICE(s string) (DISPLAY_DEVICE, error) {
+	return _DISPLAY_DEVICE_names.Parse(s)
+}
+
+var _DLGC_names = enum.Flags[DLGC]{
+	Type: "DLGC",
+	Values: []enum.Name[DLGC]{
+		{Val: DLGC_WANTARROWS, Name: "DLGC_WANTARROWS"},
+		{Val: DLGC_WANTTAB, Name: "DLGC_WANTTAB"},
+		{Val: DLGC_WANTALLKEYS, Name: "DLGC_WANTALLKEYS"},
+		{Val: DLGC_WANTMESSAGE, Name: "DLGC_WANTMESSAGE"},
+		{Val: DLGC_HASSETSEL, Name: "DLGC_HASSETSEL"},
+		{Val: DLGC_DEFPUSHBUTTON, Name: "DLGC_DEFPUSHBUTTON"},
+		{Val: DLGC_UNDEFPUSHBUTTON, Name: "DLGC_UNDEFPUSHBUTTON"},
+		{Val: DLGC_RADIOBUTTON, Name: "DLGC_RADIOBUTTON"},
+		{Val: DLGC_WANTCHARS, Name: "DLGC_WANTCHARS"},
+		{Val: DLGC_STATIC, Name: "DLGC_STATIC"},
+		{Val: DLGC_BUTTON, Name: "DLGC_BUTTON"},
+		{Val: DLGC_NONE, Name: "DLGC_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DLGC_WANTARROWS|DLGC_WANTTAB".
+func (v DLGC) String() string {
+	return _DLGC_names.String(v)
+}
+
+// Parses names of [DLGC] flags joined by "|", or numbers.
+func ParseDLGC(s string) (DLGC, error) {
+	return _DLGC_names.Parse(s)
+}
+
+var _DPI_AWARENESS_CONTEXT_names = enum.Enum[DPI_AWARENESS_CONTEXT]{
+	Type: "DPI_AWARENESS_CONTEXT",
+	Values: []enum.Name[DPI_AWARENESS_CONTEXT]{
+		{Val: DPI_AWARENESS_CONTEXT_UNAWARE, Name: "DPI_AWARENESS_CONTEXT_UNAWARE"},
+		{Val: DPI_AWARENESS_CONTEXT_SYSTEM_AWARE, Name: "DPI_AWARENESS_CONTEXT_SYSTEM_AWARE"},
+		{Val: DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE, Name: "DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE"},
+		{Val: DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2, Name: "DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2"},
+		{Val: DPI_AWARENESS_CONTEXT_UNAWARE_GDISCALED, Name: "DPI_AWARENESS_CONTEXT_UNAWARE_GDISCALED"},
+	},
+}
+
+// Returns the name of the constant, like "DPI_AWARENESS_CONTEXT_UNAWARE".
+func (v DPI_AWARENESS_CONTEXT) String() string {
+	return _DPI_AWARENESS_CONTEXT_names.String(v)
+}
+
+// Parses the name of a [DPI_AWARENESS_CONTEXT] constant, or a number.
+func ParseDPI_AWARENESS_CONTEXT(s string) (DPI_AWARENESS_CONTEXT, error) {
+	return _DPI_AWARENESS_CONTEXT_names.Parse(s)
+}
+
+var _DPI_HOSTING_BEHAVIOR_names = enum.Enum[DPI_HOSTING_BEHAVIOR]{
+	Type: "DPI_HOSTING_BEHAVIOR",
+	Values: []enum.Name[DPI_HOSTING_BEHAVIOR]{
+		{Val: DPI_HOSTING_BEHAVIOR_INVALID, Name: "DPI_HOSTING_BEHAVIOR_INVALID"},
+		{Val: DPI_HOSTING_BEHAVIOR_DEFAULT, Name: "DPI_HOSTING_BEHAVIOR_DEFAULT"},
+		{Val: DPI_HOSTING_BEHAVIOR_MIXED, Name: "DPI_HOSTING_BEHAVIOR_MIXED"},
+	},
+}
+
+// Returns the name of the constant, like "DPI_HOSTING_BEHAVIOR_INVALID".
+func (v DPI_HOSTING_BEHAVIOR) String() string {
+	return _DPI_HOSTING_BEHAVIOR_names.String(v)
+}
+
+// Parses the name of a [DPI_HOSTING_BEHAVIOR] constant, or a number.
+func ParseDPI_HOSTING_BEHAVIOR(s string) (DPI_HOSTING_BEHAVIOR, error) {
+	return _DPI_HOSTING_BEHAVIOR_names.Parse(s)
+}
+
+var _DT_names = enum.Flags[DT]{
+	Type: "DT",
+	Values: []enum.Name[DT]{
+		{Val: DT_CENTER, Name: "DT_CENTER"},
+		{Val: DT_RIGHT, Name: "DT_RIGHT"},
+		{Val: DT_VCENTER, Name: "DT_VCENTER"},
+		{Val: DT_BOTTOM, Name: "DT_BOTTOM"},
+		{Val: DT_WORDBREAK, Name: "DT_WORDBREAK"},
+		{Val: DT_SINGLELINE, Name: "DT_SINGLELINE"},
+		{Val: DT_EXPANDTABS, Name: "DT_EXPANDTABS"},
+		{Val: DT_TABSTOP, Name: "DT_TABSTOP"},
+		{Val: DT_NOCLIP, Name: "DT_NOCLIP"},
+		{Val: DT_EXTERNALLEADING, Name: "DT_EXTERNALLEADING"},
+		{Val: DT_CALCRECT, Name: "DT_CALCRECT"},
+		{Val: DT_NOPREFIX, Name: "DT_NOPREFIX"},
+		{Val: DT_INTERNAL, Name: "DT_INTERNAL"},
+		{Val: DT_EDITCONTROL, Name: "DT_EDITCONTROL"},
+		{Val: DT_PATH_ELLIPSIS, Name: "DT_PATH_ELLIPSIS"},
+		{Val: DT_END_ELLIPSIS, Name: "DT_END_ELLIPSIS"},
+		{Val: DT_MODIFYSTRING, Name: "DT_MODIFYSTRING"},
+		{Val: DT_RTLREADING, Name: "DT_RTLREADING"},
+		{Val: DT_WORD_ELLIPSIS, Name: "DT_WORD_ELLIPSIS"},
+		{Val: DT_NOFULLWIDTHCHARBREAK, Name: "DT_NOFULLWIDTHCHARBREAK"},
+		{Val: DT_HIDEPREFIX, Name: "DT_HIDEPREFIX"},
+		{Val: DT_PREFIXONLY, Name: "DT_PREFIXONLY"},
+		{Val: DT_TOP, Name: "DT_TOP"},
+		{Val: DT_LEFT, Name: "DT_LEFT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DT_CENTER|DT_RIGHT".
+func (v DT) String() string {
+	return _DT_names.String(v)
+}
+
+// Parses names of [DT] flags joined by "|", or numbers.
+func ParseDT(s string) (DT, error) {
+	return _DT_names.Parse(s)
+}
+
+var _EDD_names = enum.Enum[EDD]{
+	Type: "EDD",
+	Values: []enum.Name[EDD]{
+		{Val: EDD_NONE, Name: "EDD_NONE"},
+		{Val: EDD_GET_DEVICE_INTERFACE_NAME, Name: "EDD_GET_DEVICE_INTERFACE_NAME"},
+	},
+}
+
+// Returns the name of the constant, like "EDD_NONE".
+func (v EDD) String() string {
+	return _EDD_names.String(v)
+}
+
+// Parses the name of a [EDD] constant, or a number.
+func ParseEDD(s string) (EDD, error) {
+	return _EDD_names.Parse(s)
+}
+
+var _EDS_names = enum.Flags[EDS]{
+	Type: "EDS",
+	Values: []enum.Name[EDS]{
+		{Val: EDS_RAWMODE, Name: "EDS_RAWMODE"},
+		{Val: EDS_ROTATEDMODE, Name: "EDS_ROTATEDMODE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "EDS_RAWMODE|EDS_ROTATEDMODE".
+func (v EDS) String() string {
+	return _EDS_names.String(v)
+}
+
+// Parses names of [EDS] flags joined by "|", or numbers.
+func ParseEDS(s string) (EDS, error) {
+	return _EDS_names.Parse(s)
+}
+
+var _ENUM_SETTINGS_names = enum.Enum[ENUM_SETTINGS]{
+	Type: "ENUM_SETTINGS",
+	Values: []enum.Name[ENUM_SETTINGS]{
+		{Val: ENUM_SETTINGS_CURRENT, Name: "ENUM_SETTINGS_CURRENT"},
+		{Val: ENUM_SETTINGS_REGISTRY, Name: "ENUM_SETTINGS_REGISTRY"},
+		{Val: ENUM_SETTINGS_ALL, Name: "ENUM_SETTINGS_ALL"},
+	},
+}
+
+// Returns the name of the constant, like "ENUM_SETTINGS_CURRENT".
+func (v ENUM_SETTINGS) String() string {
+	return _ENUM_SETTINGS_names.String(v)
+}
+
+// Parses the name of a [ENUM_SETTINGS] constant, or a number.
+func ParseENUM_SETTINGS(s string) (ENUM_SETTINGS, error) {
+	return _ENUM_SETTINGS_names.Parse(s)
+}
+
+var _ES_names = enum.Flags[ES]{
+	Type: "ES",
+	Values: []enum.Name[ES]{
+		{Val: ES_CENTER, Name: "ES_CENTER"},
+		{Val: ES_RIGHT, Name: "ES_RIGHT"},
+		{Val: ES_MULTILINE, Name: "ES_MULTILINE"},
+		{Val: ES_UPPERCASE, Name: "ES_UPPERCASE"},
+		{Val: ES_LOWERCASE, Name: "ES_LOWERCASE"},
+		{Val: ES_PASSWORD, Name: "ES_PASSWORD"},
+		{Val: ES_AUTOVSCROLL, Name: "ES_AUTOVSCROLL"},
+		{Val: ES_AUTOHSCROLL, Name: "ES_AUTOHSCROLL"},
+		{Val: ES_NOHIDESEL, Name: "ES_NOHIDESEL"},
+		{Val: ES_OEMCONVERT, Name: "ES_OEMCONVERT"},
+		{Val: ES_READONLY, Name: "ES_READONLY"},
+		{Val: ES_WANTRETURN, Name: "ES_WANTRETURN"},
+		{Val: ES_NUMBER, Name: "ES_NUMBER"},
+		{Val: ES_LEFT, Name: "ES_LEFT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ES_CENTER|ES_RIGHT".
+func (v ES) String() string {
+	return _ES_names.String(v)
+}
+
+// Parses names of [ES] flags joined by "|", or numbers.
+func ParseES(s string) (ES, error) {
+	return _ES_names.Parse(s)
+}
+
+var _EXW_names = enum.Flags[EXW]{
+	Type: "EXW",
+	Values: []enum.Name[EXW]{
+		{Val: EWX_SHUTDOWN, Name: "EWX_SHUTDOWN"},
+		{Val: EWX_REBOOT, Name: "EWX_REBOOT"},
+		{Val: EWX_FORCE, Name: "EWX_FORCE"},
+		{Val: EWX_POWEROFF, Name: "EWX_POWEROFF"},
+		{Val: EWX_FORCEIFHUNG, Name: "EWX_FORCEIFHUNG"},
+		{Val: EWX_QUICKRESOLVE, Name: "EWX_QUICKRESOLVE"},
+		{Val: EWX_RESTARTAPPS, Name: "EWX_RESTARTAPPS"},
+		{Val: EWX_HYBRID_SHUTDOWN, Name: "EWX_HYBRID_SHUTDOWN"},
+		{Val: EWX_BOOTOPTIONS, Name: "EWX_BOOTOPTIONS"},
+		{Val: EWX_ARSO, Name: "EWX_ARSO"},
+		{Val: EWX_CHECK_SAFE_FOR_SERVER, Name: "EWX_CHECK_SAFE_FOR_SERVER"},
+		{Val: EWX_LOGOFF, Name: "EWX_LOGOFF"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "EWX_SHUTDOWN|EWX_REBOOT".
+func (v EXW) String() string {
+	return _EXW_names.String(v)
+}
+
+// Parses names of [EXW] flags joined by "|", or numbers.
+func ParseEXW(s string) (EXW, error) {
+	return _EXW_names.Parse(s)
+}
+
+var _FAPPCOMMAND_names = enum.Enum[FAPPCOMMAND]{
+	Type: "FAPPCOMMAND",
+	Values: []enum.Name[FAPPCOMMAND]{
+		{Val: FAPPCOMMAND_MOUSE, Name: "FAPPCOMMAND_MOUSE"},
+		{Val: FAPPCOMMAND_KEY, Name: "FAPPCOMMAND_KEY"},
+		{Val: FAPPCOMMAND_OEM, Name: "FAPPCOMMAND_OEM"},
+	},
+}
+
+// Returns the name of the constant, like "FAPPCOMMAND_MOUSE".
+func (v FAPPCOMMAND) String() string {
+	return _FAPPCOMMAND_names.String(v)
+}
+
+// Parses the name of a [FAPPCOMMAND] constant, or a number.
+func ParseFAPPCOMMAND(s string) (FAPPCOMMAND, error) {
+	return _FAPPCOMMAND_names.Parse(s)
+}
+
+var _FEEDBACK_names = enum.Enum[FEEDBACK]{
+	Type: "FEEDBACK",
+	Values: []enum.Name[FEEDBACK]{
+		{Val: FEEDBACK_TOUCH_CONTACTVISUALIZATION, Name: "FEEDBACK_TOUCH_CONTACTVISUALIZATION"},
+		{Val: FEEDBACK_PEN_BARRELVISUALIZATION, Name: "FEEDBACK_PEN_BARRELVISUALIZATION"},
+		{Val: FEEDBACK_PEN_TAP, Name: "FEEDBACK_PEN_TAP"},
+		{Val: FEEDBACK_PEN_DOUBLETAP, Name: "FEEDBACK_PEN_DOUBLETAP"},
+		{Val: FEEDBACK_PEN_PRESSANDHOLD, Name: "FEEDBACK_PEN_PRESSANDHOLD"},
+		{Val: FEEDBACK_PEN_RIGHTTAP, Name: "FEEDBACK_PEN_RIGHTTAP"},
+		{Val: FEEDBACK_TOUCH_TAP, Name: "FEEDBACK_TOUCH_TAP"},
+		{Val: FEEDBACK_TOUCH_DOUBLETAP, Name: "FEEDBACK_TOUCH_DOUBLETAP"},
+		{Val: FEEDBACK_TOUCH_PRESSANDHOLD, Name: "FEEDBACK_TOUCH_PRESSANDHOLD"},
+		{Val: FEEDBACK_TOUCH_RIGHTTAP, Name: "FEEDBACK_TOUCH_RIGHTTAP"},
+		{Val: FEEDBACK_GESTURE_PRESSANDTAP, Name: "FEEDBACK_GESTURE_PRESSANDTAP"},
+	},
+}
+
+// Returns the name of the constant, like "FEEDBACK_TOUCH_CONTACTVISUALIZATION".
+func (v FEEDBACK) String() string {
+	return _FEEDBACK_names.String(v)
+}
+
+// Parses the name of a [FEEDBACK] constant, or a number.
+func ParseFEEDBACK(s string) (FEEDBACK, error) {
+	return _FEEDBACK_names.Parse(s)
+}
+
+var _FEEDBACK_OPT_names = enum.Enum[FEEDBACK_OPT]{
+	Type: "FEEDBACK_OPT",
+	Values: []enum.Name[FEEDBACK_OPT]{
+		{Val: FEEDBACK_OPT_TRUE, Name: "FEEDBACK_OPT_TRUE"},
+		{Val: FEEDBACK_OPT_RESET, Name: "FEEDBACK_OPT_RESET"},
+		{Val: FEEDBACK_OPT_FALSE, Name: "FEEDBACK_OPT_FALSE"},
+	},
+}
+
+// Returns the name of the constant, like "FEEDBACK_OPT_TRUE".
+func (v FEEDBACK_OPT) String() string {
+	return _FEEDBACK_OPT_names.String(v)
+}
+
+// Parses the name of a [FEEDBACK_OPT] constant, or a number.
+func ParseFEEDBACK_OPT(s string) (FEEDBACK_OPT, error) {
+	return _FEEDBACK_OPT_names.Parse(s)
+}
+
+var _FLASHW_names = enum.Flags[FLASHW]{
+	Type: "FLASHW",
+	Values: []enum.Name[FLASHW]{
+		{Val: FLASHW_ALL, Name: "FLASHW_ALL"},
+		{Val: FLASHW_TIMERNOFG, Name: "FLASHW_TIMERNOFG"},
+		{Val: FLASHW_CAPTION, Name: "FLASHW_CAPTION"},
+		{Val: FLASHW_TRAY, Name: "FLASHW_TRAY"},
+		{Val: FLASHW_TIMER, Name: "FLASHW_TIMER"},
+		{Val: FLASHW_STOP, Name: "FLASHW_STOP"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FLASHW_CAPTION|FLASHW_TRAY".
+func (v FLASHW) String() string {
+	return _FLASHW_names.String(v)
+}
+
+// Parses names of [FLASHW] flags joined by "|", or numbers.
+func ParseFLASHW(s string) (FLASHW, error) {
+	return _FLASHW_names.Parse(s)
+}
+
+var _GA_names = enum.Enum[GA]{
+	Type: "GA",
+	Values: []enum.Name[GA]{
+		{Val: GA_PARENT, Name: "GA_PARENT"},
+		{Val: GA_ROOT, Name: "GA_ROOT"},
+		{Val: GA_ROOTOWNER, Name: "GA_ROOTOWNER"},
+	},
+}
+
+// Returns the name of the constant, like "GA_PARENT".
+func (v GA) String() string {
+	return _GA_names.String(v)
+}
+
+// Parses the name of a [GA] constant, or a number.
+func ParseGA(s string) (GA, error) {
+	return _GA_names.Parse(s)
+}
+
+var _GCL_names = enum.Enum[GCL]{
+	Type: "GCL",
+	Values: []enum.Name[GCL]{
+		{Val: GCL_ATOM, Name: "GCL_ATOM"},
+		{Val: GCL_CBCLSEXTRA, Name: "GCL_CBCLSEXTRA"},
+		{Val: GCL_CBWNDEXTRA, Name: "GCL_CBWNDEXTRA"},
+		{Val: GCL_HBRBACKGROUND, Name: "GCL_HBRBACKGROUND"},
+		{Val: GCL_HCURSOR, Name: "GCL_HCURSOR"},
+		{Val: GCL_HICON, Name: "GCL_HICON"},
+		{Val: GCL_HICONSM, Name: "GCL_HICONSM"},
+		{Val: GCL_HMODULE, Name: "GCL_HMODULE"},
+		{Val: GCL_MENUNAME, Name: "GCL_MENUNAME"},
+		{Val: GCL_STYLE, Name: "GCL_STYLE"},
+		{Val: GCL_WNDPROC, Name: "GCL_WNDPROC"},
+	},
+}
+
+// Returns the name of the constant, like "GCL_ATOM".
+func (v GCL) String() string {
+	return _GCL_names.String(v)
+}
+
+// Parses the name of a [GCL] constant, or a number.
+func ParseGCL(s string) (GCL, error) {
+	return _GCL_names.Parse(s)
+}
+
+var _GUI_names = enum.Flags[GUI]{
+	Type: "GUI",
+	Values: []enum.Name[GUI]{
+		{Val: GUI_CARETBLINKING, Name: "GUI_CARETBLINKING"},
+		{Val: GUI_INMENUMODE, Name: "GUI_INMENUMODE"},
+		{Val: GUI_INMOVESIZE, Name: "GUI_INMOVESIZE"},
+		{Val: GUI_POPUPMENUMODE, Name: "GUI_POPUPMENUMODE"},
+		{Val: GUI_SYSTEMMENUMODE, Name: "GUI_SYSTEMMENUMODE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "GUI_CARETBLINKING|GUI_INMENUMODE".
+func (v GUI) String() string {
+	return _GUI_names.String(v)
+}
+
+// Parses names of [GUI] flags joined by "|", or numbers.
+func ParseGUI(s string) (GUI, error) {
+	return _GUI_names.Parse(s)
+}
+
+var _GMDI_names = enum.Flags[GMDI]{
+	Type: "GMDI",
+	Values: []enum.Name[GMDI]{
+		{Val: GMDI_GOINTOPOPUPS, Name: "GMDI_GOINTOPOPUPS"},
+		{Val: GMDI_USEDISABLED, Name: "GMDI_USEDISABLED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "GMDI_GOINTOPOPUPS|GMDI_USEDISABLED".
+func (v GMDI) String() string {
+	return _GMDI_names.String(v)
+}
+
+// Parses names of [GMDI] flags joined by "|", or numbers.
+func ParseGMDI(s string) (GMDI, error) {
+	return _GMDI_names.Parse(s)
+}
+
+var _GW_names = enum.Enum[GW]{
+	Type: "GW",
+	Values: []enum.Name[GW]{
+		{Val: GW_HWNDFIRST, Name: "GW_HWNDFIRST"},
+		{Val: GW_HWNDLAST, Name: "GW_HWNDLAST"},
+		{Val: GW_HWNDNEXT, Name: "GW_HWNDNEXT"},
+		{Val: GW_HWNDPREV, Name: "GW_HWNDPREV"},
+		{Val: GW_OWNER, Name: "GW_OWNER"},
+		{Val: GW_CHILD, Name: "GW_CHILD"},
+		{Val: GW_ENABLEDPOPUP, Name: "GW_ENABLEDPOPUP"},
+	},
+}
+
+// Returns the name of the constant, like "GW_HWNDFIRST".
+func (v GW) String() string {
+	return _GW_names.String(v)
+}
+
+// Parses the name of a [GW] constant, or a number.
+func ParseGW(s string) (GW, error) {
+	return _GW_names.Parse(s)
+}
+
+var _HELPINFO_names = enum.Enum[HELPINFO]{
+	Type: "HELPINFO",
+	Values: []enum.Name[HELPINFO]{
+		{Val: HELPINFO_WINDOW, Name: "HELPINFO_WINDOW"},
+		{Val: HELPINFO_MENUITEM, Name: "HELPINFO_MENUITEM"},
+	},
+}
+
+// Returns the name of the constant, like "HELPINFO_WINDOW".
+func (v HELPINFO) String() string {
+	return _HELPINFO_names.String(v)
+}
+
+// Parses the name of a [HELPINFO] constant, or a number.
+func ParseHELPINFO(s string) (HELPINFO, error) {
+	return _HELPINFO_names.Parse(s)
+}
+
+var _HT_names = enum.Enum[HT]{
+	Type: "HT",
+	Values: []enum.Name[HT]{
+		{Val: HT_ERROR, Name: "HT_ERROR"},
+		{Val: HT_TRANSPARENT, Name: "HT_TRANSPARENT"},
+		{Val: HT_NOWHERE, Name: "HT_NOWHERE"},
+		{Val: HT_CLIENT, Name: "HT_CLIENT"},
+		{Val: HT_CAPTION, Name: "HT_CAPTION"},
+		{Val: HT_SYSMENU, Name: "HT_SYSMENU"},
+		{Val: HT_GROWBOX, Name: "HT_GROWBOX"},
+		{Val: HT_MENU, Name: "HT_MENU"},
+		{Val: HT_HSCROLL, Name: "HT_HSCROLL"},
+		{Val: HT_VSCROLL, Name: "HT_VSCROLL"},
+		{Val: HT_MINBUTTON, Name: "HT_MINBUTTON"},
+		{Val: HT_MAXBUTTON, Name: "HT_MAXBUTTON"},
+		{Val: HT_LEFT, Name: "HT_LEFT"},
+		{Val: HT_RIGHT, Name: "HT_RIGHT"},
+		{Val: HT_TOP, Name: "HT_TOP"},
+		{Val: HT_TOPLEFT, Name: "HT_TOPLEFT"},
+		{Val: HT_TOPRIGHT, Name: "HT_TOPRIGHT"},
+		{Val: HT_BOTTOM, Name: "HT_BOTTOM"},
+		{Val: HT_BOTTOMLEFT, Name: "HT_BOTTOMLEFT"},
+		{Val: HT_BOTTOMRIGHT, Name: "HT_BOTTOMRIGHT"},
+		{Val: HT_BORDER, Name: "HT_BORDER"},
+		{Val: HT_OBJECT, Name: "HT_OBJECT"},
+		{Val: HT_CLOSE, Name: "HT_CLOSE"},
+		{Val: HT_HELP, Name: "HT_HELP"},
+		{Val: HT_SIZE, Name: "HT_SIZE"},
+		{Val: HT_REDUCE, Name: "HT_REDUCE"},
+		{Val: HT_ZOOM, Name: "HT_ZOOM"},
+		{Val: HT_SIZEFIRST, Name: "HT_SIZEFIRST"},
+		{Val: HT_SIZELAST, Name: "HT_SIZELAST"},
+	},
+}
+
+// Returns the name of the constant, like "HT_ERROR".
+func (v HT) String() string {
+	return _HT_names.String(v)
+}
+
+// Parses the name of a [HT] constant, or a number.
+func ParseHT(s string) (HT, error) {
+	return _HT_names.Parse(s)
+}
+
+var _ICON_SZ_names = enum.Enum[ICON_SZ]{
+	Type: "ICON_SZ",
+	Values: []enum.Name[ICON_SZ]{
+		{Val: ICON_SZ_SMALL, Name: "ICON_SZ_SMALL"},
+		{Val: ICON_SZ_BIG, Name: "ICON_SZ_BIG"},
+		{Val: ICON_SZ_SMALL2, Name: "ICON_SZ_SMALL2"},
+	},
+}
+
+// Returns the name of the constant, like "ICON_SZ_SMALL".
+func (v ICON_SZ) String() string {
+	return _ICON_SZ_names.String(v)
+}
+
+// Parses the name of a [ICON_SZ] constant, or a number.
+func ParseICON_SZ(s string) (ICON_SZ, error) {
+	return _ICON_SZ_names.Parse(s)
+}
+
+var _ID_names = enum.Enum[ID]{
+	Type: "ID",
+	Values: []enum.Name[ID]{
+		{Val: ID_ABORT, Name: "ID_ABORT"},
+		{Val: ID_CANCEL, Name: "ID_CANCEL"},
+		{Val: ID_CONTINUE, Name: "ID_CONTINUE"},
+		{Val: ID_IGNORE, Name: "ID_IGNORE"},
+		{Val: ID_NO, Name: "ID_NO"},
+		{Val: ID_OK, Name: "ID_OK"},
+		{Val: ID_RETRY, Name: "ID_RETRY"},
+		{Val: ID_TRYAGAIN, Name: "ID_TRYAGAIN"},
+		{Val: ID_YES, Name: "ID_YES"},
+	},
+}
+
+// Returns the name of the constant, like "ID_ABORT".
+func (v ID) String() string {
+	return _ID_names.String(v)
+}
+
+// Parses the name of a [ID] constant, or a number.
+func ParseID(s string) (ID, error) {
+	return _ID_names.Parse(s)
+}
+
+var _IDC_names = enum.Enum[IDC]{
+	Type: "IDC",
+	Values: []enum.Name[IDC]{
+		{Val: IDC_ARROW, Name: "IDC_ARROW"},
+		{Val: IDC_IBEAM, Name: "IDC_IBEAM"},
+		{Val: IDC_WAIT, Name: "IDC_WAIT"},
+		{Val: IDC_CROSS, Name: "IDC_CROSS"},
+		{Val: IDC_UPARROW, Name: "IDC_UPARROW"},
+		{Val: IDC_SIZENWSE, Name: "IDC_SIZENWSE"},
+		{Val: IDC_SIZENESW, Name: "IDC_SIZENESW"},
+		{Val: IDC_SIZEWE, Name: "IDC_SIZEWE"},
+		{Val: IDC_SIZENS, Name: "IDC_SIZENS"},
+		{Val: IDC_SIZEALL, Name: "IDC_SIZEALL"},
+		{Val: IDC_NO, Name: "IDC_NO"},
+		{Val: IDC_HAND, Name: "IDC_HAND"},
+		{Val: IDC_APPSTARTING, Name: "IDC_APPSTARTING"},
+		{Val: IDC_HELP, Name: "IDC_HELP"},
+		{Val: IDC_PIN, Name: "IDC_PIN"},
+		{Val: IDC_PERSON, Name: "IDC_PERSON"},
+		{Val: IDC_PEN, Name: "IDC_PEN"},
+		{Val: IDC_CD, Name: "IDC_CD"},
+	},
+}
+
+// Returns the name of the constant, like "IDC_ARROW".
+func (v IDC) String() string {
+	return _IDC_names.String(v)
+}
+
+// Parses the name of a [IDC] constant, or a number.
+func ParseIDC(s string) (IDC, error) {
+	return _IDC_names.Parse(s)
+}
+
+var _IDHOT_names = enum.Enum[IDHOT]{
+	Type: "IDHOT",
+	Values: []enum.Name[IDHOT]{
+		{Val: IDHOT_SNAPWINDOW, Name: "IDHOT_SNAPWINDOW"},
+		{Val: IDHOT_SNAPDESKTOP, Name: "IDHOT_SNAPDESKTOP"},
+	},
+}
+
+// Returns the name of the constant, like "IDHOT_SNAPWINDOW".
+func (v IDHOT) String() string {
+	return _IDHOT_names.String(v)
+}
+
+// Parses the name of a [IDHOT] constant, or a number.
+func ParseIDHOT(s string) (IDHOT, error) {
+	return _IDHOT_names.Parse(s)
+}
+
+var _IDI_names = enum.Enum[IDI]{
+	Type: "IDI",
+	Values: []enum.Name[IDI]{
+		{Val: IDI_APPLICATION, Name: "IDI_APPLICATION"},
+		{Val: IDI_HAND, Name: "IDI_HAND"},
+		{Val: IDI_QUESTION, Name: "IDI_QUESTION"},
+		{Val: IDI_EXCLAMATION, Name: "IDI_EXCLAMATION"},
+		{Val: IDI_ASTERISK, Name: "IDI_ASTERISK"},
+		{Val: IDI_WINLOGO, Name: "IDI_WINLOGO"},
+		{Val: IDI_SHIELD, Name: "IDI_SHIELD"},
+		{Val: IDI_WARNING, Name: "IDI_WARNING"},
+		{Val: IDI_ERROR, Name: "IDI_ERROR"},
+		{Val: IDI_INFORMATION, Name: "IDI_INFORMATION"},
+	},
+}
+
+// Returns the name of the constant, like "IDI_APPLICATION".
+func (v IDI) String() string {
+	return _IDI_names.String(v)
+}
+
+// Parses the name of a [IDI] constant, or a number.
+func ParseIDI(s string) (IDI, error) {
+	return _IDI_names.Parse(s)
+}
+
+var _IMAGE_names = enum.Enum[IMAGE]{
+	Type: "IMAGE",
+	Values: []enum.Name[IMAGE]{
+		{Val: IMAGE_BITMAP, Name: "IMAGE_BITMAP"},
+		{Val: IMAGE_ICON, Name: "IMAGE_ICON"},
+		{Val: IMAGE_CURSOR, Name: "IMAGE_CURSOR"},
+		{Val: IMAGE_ENHMETAFILE, Name: "IMAGE_ENHMETAFILE"},
+	},
+}
+
+// Returns the name of the constant, like "IMAGE_BITMAP".
+func (v IMAGE) String() string {
+	return _IMAGE_names.String(v)
+}
+
+// Parses the name of a [IMAGE] constant, or a number.
+func ParseIMAGE(s string) (IMAGE, error) {
+	return _IMAGE_names.Parse(s)
+}
+
+var _INPUT_names = enum.Enum[INPUT]{
+	Type: "INPUT",
+	Values: []enum.Name[INPUT]{
+		{Val: INPUT_MOUSE, Name: "INPUT_MOUSE"},
+		{Val: INPUT_KEYBOARD, Name: "INPUT_KEYBOARD"},
+		{Val: INPUT_HARDWARE, Name: "INPUT_HARDWARE"},
+	},
+}
+
+// Returns the name of the constant, like "INPUT_MOUSE".
+func (v INPUT) String() string {
+	return _INPUT_names.String(v)
+}
+
+// Parses the name of a [INPUT] constant, or a number.
+func ParseINPUT(s string) (INPUT, error) {
+	return _INPUT_names.Parse(s)
+}
+
+var _ISMEX_names = enum.Flags[ISMEX]{
+	Type: "ISMEX",
+	Values: []enum.Name[ISMEX]{
+		{Val: ISMEX_CALLBACK, Name: "ISMEX_CALLBACK"},
+		{Val: ISMEX_NOTIFY, Name: "ISMEX_NOTIFY"},
+		{Val: ISMEX_REPLIED, Name: "ISMEX_REPLIED"},
+		{Val: ISMEX_SEND, Name: "ISMEX_SEND"},
+		{Val: ISMEX_NOSEND, Name: "ISMEX_NOSEND"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ISMEX_CALLBACK|ISMEX_NOTIFY".
+func (v ISMEX) String() string {
+	return _ISMEX_names.String(v)
+}
+
+// Parses names of [ISMEX] flags joined by "|", or numbers.
+func ParseISMEX(s string) (ISMEX, error) {
+	return _ISMEX_names.Parse(s)
+}
+
+var _KEYEVENTF_names = enum.Flags[KEYEVENTF]{
+	Type: "KEYEVENTF",
+	Values: []enum.Name[KEYEVENTF]{
+		{Val: KEYEVENTF_EXTENDEDKEY, Name: "KEYEVENTF_EXTENDEDKEY"},
+		{Val: KEYEVENTF_KEYUP, Name: "KEYEVENTF_KEYUP"},
+		{Val: KEYEVENTF_UNICODE, Name: "KEYEVENTF_UNICODE"},
+		{Val: KEYEVENTF_SCANCODE, Name: "KEYEVENTF_SCANCODE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "KEYEVENTF_EXTENDEDKEY|KEYEVENTF_KEYUP".
+func (v KEYEVENTF) String() string {
+	return _KEYEVENTF_names.String(v)
+}
+
+// Parses names of [KEYEVENTF] flags joined by "|", or numbers.
+func ParseKEYEVENTF(s string) (KEYEVENTF, error) {
+	return _KEYEVENTF_names.Parse(s)
+}
+
+var _LAYOUT_names = enum.Flags[LAYOUT]{
+	Type: "LAYOUT",
+	Values: []enum.Name[LAYOUT]{
+		{Val: LAYOUT_RTL, Name: "LAYOUT_RTL"},
+		{Val: LAYOUT_BTT, Name: "LAYOUT_BTT"},
+		{Val: LAYOUT_VBH, Name: "LAYOUT_VBH"},
+		{Val: LAYOUT_BITMAPORIENTATIONPRESERVED, Name: "LAYOUT_BITMAPORIENTATIONPRESERVED"},
+		{Val: LAYOUT_LTR, Name: "LAYOUT_LTR"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LAYOUT_RTL|LAYOUT_BTT".
+func (v LAYOUT) String() string {
+	return _LAYOUT_names.String(v)
+}
+
+// Parses names of [LAYOUT] flags joined by "|", or numbers.
+func ParseLAYOUT(s string) (LAYOUT, error) {
+	return _LAYOUT_names.Parse(s)
+}
+
+var _LLKHF_names = enum.Flags[LLKHF]{
+	Type: "LLKHF",
+	Values: []enum.Name[LLKHF]{
+		{Val: LLKHF_EXTENDED, Name: "LLKHF_EXTENDED"},
+		{Val: LLKHF_LOWER_IL_INJECTED, Name: "LLKHF_LOWER_IL_INJECTED"},
+		{Val: LLKHF_INJECTED, Name: "LLKHF_INJECTED"},
+		{Val: LLKHF_ALTDOWN, Name: "LLKHF_ALTDOWN"},
+		{Val: LLKHF_UP, Name: "LLKHF_UP"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LLKHF_EXTENDED|LLKHF_LOWER_IL_INJECTED".
+func (v LLKHF) String() string {
+	return _LLKHF_names.String(v)
+}
+
+// Parses names of [LLKHF] flags joined by "|", or numbers.
+func ParseLLKHF(s string) (LLKHF, error) {
+	return _LLKHF_names.Parse(s)
+}
+
+var _LLMHF_names = enum.Flags[LLMHF]{
+	Type: "LLMHF",
+	Values: []enum.Name[LLMHF]{
+		{Val: LLMHF_INJECTED, Name: "LLMHF_INJECTED"},
+		{Val: LLMHF_LOWER_IL_INJECTED, Name: "LLMHF_LOWER_IL_INJECTED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LLMHF_INJECTED|LLMHF_LOWER_IL_INJECTED".
+func (v LLMHF) String() string {
+	return _LLMHF_names.String(v)
+}
+
+// Parses names of [LLMHF] flags joined by "|", or numbers.
+func ParseLLMHF(s string) (LLMHF, error) {
+	return _LLMHF_names.Parse(s)
+}
+
+var _LR_names = enum.Flags[LR]{
+	Type: "LR",
+	Values: []enum.Name[LR]{
+		{Val: LR_MONOCHROME, Name: "LR_MONOCHROME"},
+		{Val: LR_COLOR, Name: "LR_COLOR"},
+		{Val: LR_COPYRETURNORG, Name: "LR_COPYRETURNORG"},
+		{Val: LR_COPYDELETEORG, Name: "LR_COPYDELETEORG"},
+		{Val: LR_LOADFROMFILE, Name: "LR_LOADFROMFILE"},
+		{Val: LR_LOADTRANSPARENT, Name: "LR_LOADTRANSPARENT"},
+		{Val: LR_DEFAULTSIZE, Name: "LR_DEFAULTSIZE"},
+		{Val: LR_VGACOLOR, Name: "LR_VGACOLOR"},
+		{Val: LR_LOADMAP3DCOLORS, Name: "LR_LOADMAP3DCOLORS"},
+		{Val: LR_CREATEDIBSECTION, Name: "LR_CREATEDIBSECTION"},
+		{Val: LR_COPYFROMRESOURCE, Name: "LR_COPYFROMRESOURCE"},
+		{Val: LR_SHARED, Name: "LR_SHARED"},
+		{Val: LR_DEFAULTCOLOR, Name: "LR_DEFAULTCOLOR"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LR_MONOCHROME|LR_COLOR".
+func (v LR) String() string {
+	return _LR_names.String(v)
+}
+
+// Parses names of [LR] flags joined by "|", or numbers.
+func ParseLR(s string) (LR, error) {
+	return _LR_names.Parse(s)
+}
+
+var _LSFW_names = enum.Enum[LSFW]{
+	Type: "LSFW",
+	Values: []enum.Name[LSFW]{
+		{Val: LSFW_LOCK, Name: "LSFW_LOCK"},
+		{Val: LSFW_UNLOCK, Name: "LSFW_UNLOCK"},
+	},
+}
+
+// Returns the name of the constant, like "LSFW_LOCK".
+func (v LSFW) String() string {
+	return _LSFW_names.String(v)
+}
+
+// Parses the name of a [LSFW] constant, or a number.
+func ParseLSFW(s string) (LSFW, error) {
+	return _LSFW_names.Parse(s)
+}
+
+var _LWA_names = enum.Flags[LWA]{
+	Type: "LWA",
+	Values: []enum.Name[LWA]{
+		{Val: LWA_ALPHA, Name: "LWA_ALPHA"},
+		{Val: LWA_COLORKEY, Name: "LWA_COLORKEY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "LWA_ALPHA|LWA_COLORKEY".
+func (v LWA) String() string {
+	return _LWA_names.String(v)
+}
+
+// Parses names of [LWA] flags joined by "|", or numbers.
+func ParseLWA(s string) (LWA, error) {
+	return _LWA_names.Parse(s)
+}
+
+var _MAPVK_names = enum.Enum[MAPVK]{
+	Type: "MAPVK",
+	Values: []enum.Name[MAPVK]{
+		{Val: MAPVK_VK_TO_VSC, Name: "MAPVK_VK_TO_VSC"},
+		{Val: MAPVK_VSC_TO_VK, Name: "MAPVK_VSC_TO_VK"},
+		{Val: MAPVK_VK_TO_CHAR, Name: "MAPVK_VK_TO_CHAR"},
+		{Val: MAPVK_VSC_TO_VK_EX, Name: "MAPVK_VSC_TO_VK_EX"},
+		{Val: MAPVK_VK_TO_VSC_EX, Name: "MAPVK_VK_TO_VSC_EX"},
+	},
+}
+
+// Returns the name of the constant, like "MAPVK_VK_TO_VSC".
+func (v MAPVK) String() string {
+	return _MAPVK_names.String(v)
+}
+
+// Parses the name of a [MAPVK] constant, or a number.
+func ParseMAPVK(s string) (MAPVK, error) {
+	return _MAPVK_names.Parse(s)
+}
+
+var _MB_names = enum.Flags[MB]{
+	Type: "MB",
+	Values: []enum.Name[MB]{
+		{Val: MB_CANCELTRYCONTINUE, Name: "MB_CANCELTRYCONTINUE"},
+		{Val: MB_RETRYCANCEL, Name: "MB_RETRYCANCEL"},
+		{Val: MB_YESNOCANCEL, Name: "MB_YESNOCANCEL"},
+		{Val: MB_ICONEXCLAMATION, Name: "MB_ICONEXCLAMATION"},
+		{Val: MB_ICONWARNING, Name: "MB_ICONWARNING"},
+		{Val: MB_DEFBUTTON4, Name: "MB_DEFBUTTON4"},
+		{Val: MB_ABORTRETRYIGNORE, Name: "MB_ABORTRETRYIGNORE"},
+		{Val: MB_HELP, Name: "MB_HELP"},
+		{Val: MB_OKCANCEL, Name: "MB_OKCANCEL"},
+		{Val: MB_YESNO, Name: "MB_YESNO"},
+		{Val: MB_ICONINFORMATION, Name: "MB_ICONINFORMATION"},
+		{Val: MB_ICONASTERISK, Name: "MB_ICONASTERISK"},
+		{Val: MB_ICONQUESTION, Name: "MB_ICONQUESTION"},
+		{Val: MB_ICONSTOP, Name: "MB_ICONSTOP"},
+		{Val: MB_ICONERROR, Name: "MB_ICONERROR"},
+		{Val: MB_ICONHAND, Name: "MB_ICONHAND"},
+		{Val: MB_DEFBUTTON2, Name: "MB_DEFBUTTON2"},
+		{Val: MB_DEFBUTTON3, Name: "MB_DEFBUTTON3"},
+		{Val: MB_SYSTEMMODAL, Name: "MB_SYSTEMMODAL"},
+		{Val: MB_TASKMODAL, Name: "MB_TASKMODAL"},
+		{Val: MB_DEFAULT_DESKTOP_ONLY, Name: "MB_DEFAULT_DESKTOP_ONLY"},
+		{Val: MB_RIGHT, Name: "MB_RIGHT"},
+		{Val: MB_RTLREADING, Name: "MB_RTLREADING"},
+		{Val: MB_SETFOREGROUND, Name: "MB_SETFOREGROUND"},
+		{Val: MB_TOPMOST, Name: "MB_TOPMOST"},
+		{Val: MB_SERVICE_NOTIFICATION, Name: "MB_SERVICE_NOTIFICATION"},
+		{Val: MB_OK, Name: "MB_OK"},
+		{Val: MB_DEFBUTTON1, Name: "MB_DEFBUTTON1"},
+		{Val: MB_APPLMODAL, Name: "MB_APPLMODAL"},
+	},
+	Fields: []MB{0xf, 0xf0, 0xf00, 0x3000},
+}
+
+// Returns the names of the flags joined by "|", like "MB_HELP|MB_DEFAULT_DESKTOP_ONLY".
+func (v MB) String() string {
+	return _MB_names.String(v)
+}
+
+// Parses names of [MB] flags joined by "|", or numbers.
+func ParseMB(s string) (MB, error) {
+	return _MB_names.Parse(s)
+}
+
+var _MF_names = enum.Flags[MF]{
+	Type: "MF",
+	Values: []enum.Name[MF]{
+		{Val: MF_CHANGE, Name: "MF_CHANGE"},
+		{Val: MF_APPEND, Name: "MF_APPEND"},
+		{Val: MF_DELETE, Name: "MF_DELETE"},
+		{Val: MF_REMOVE, Name: "MF_REMOVE"},
+		{Val: MF_BYPOSITION, Name: "MF_BYPOSITION"},
+		{Val: MF_SEPARATOR, Name: "MF_SEPARATOR"},
+		{Val: MF_GRAYED, Name: "MF_GRAYED"},
+		{Val: MF_DISABLED, Name: "MF_DISABLED"},
+		{Val: MF_CHECKED, Name: "MF_CHECKED"},
+		{Val: MF_USECHECKBITMAPS, Name: "MF_USECHECKBITMAPS"},
+		{Val: MF_BITMAP, Name: "MF_BITMAP"},
+		{Val: MF_OWNERDRAW, Name: "MF_OWNERDRAW"},
+		{Val: MF_POPUP, Name: "MF_POPUP"},
+		{Val: MF_MENUBARBREAK, Name: "MF_MENUBARBREAK"},
+		{Val: MF_MENUBREAK, Name: "MF_MENUBREAK"},
+		{Val: MF_HILITE, Name: "MF_HILITE"},
+		{Val: MF_DEFAULT, Name: "MF_DEFAULT"},
+		{Val: MF_SYSMENU, Name: "MF_SYSMENU"},
+		{Val: MF_HELP, Name: "MF_HELP"},
+		{Val: MF_RIGHTJUSTIFY, Name: "MF_RIGHTJUSTIFY"},
+		{Val: MF_MOUSESELECT, Name: "MF_MOUSESELECT"},
+		{Val: MF_INSERT, Name: "MF_INSERT"},
+		{Val: MF_BYCOMMAND, Name: "MF_BYCOMMAND"},
+		{Val: MF_ENABLED, Name: "MF_ENABLED"},
+		{Val: MF_UNCHECKED, Name: "MF_UNCHECKED"},
+		{Val: MF_STRING, Name: "MF_STRING"},
+		{Val: MF_UNHILITE, Name: "MF_UNHILITE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MF_CHANGE|MF_APPEND".
+func (v MF) String() string {
+	return _MF_names.String(v)
+}
+
+// Parses names of [MF] flags joined by "|", or numbers.
+func ParseMF(s string) (MF, error) {
+	return _MF_names.Parse(s)
+}
+
+var _MFMC_names = enum.Flags[MFMC]{
+	Type: "MFMC",
+	Values: []enum.Name[MFMC]{
+		{Val: POPUP, Name: "POPUP"},
+		{Val: SYSMENU, Name: "SYSMENU"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "POPUP|SYSMENU".
+func (v MFMC) String() string {
+	return _MFMC_names.String(v)
+}
+
+// Parses names of [MFMC] flags joined by "|", or numbers.
+func ParseMFMC(s string) (MFMC, error) {
+	return _MFMC_names.Parse(s)
+}
+
+var _MFS_names = enum.Flags[MFS]{
+	Type: "MFS",
+	Values: []enum.Name[MFS]{
+		{Val: MFS_GRAYED, Name: "MFS_GRAYED"},
+		{Val: MFS_CHECKED, Name: "MFS_CHECKED"},
+		{Val: MFS_HILITE, Name: "MFS_HILITE"},
+		{Val: MFS_DEFAULT, Name: "MFS_DEFAULT"},
+		{Val: MFS_ENABLED, Name: "MFS_ENABLED"},
+		{Val: MFS_UNCHECKED, Name: "MFS_UNCHECKED"},
+		{Val: MFS_UNHILITE, Name: "MFS_UNHILITE"},
+		{Val: MFS_DISABLED, Name: "MFS_DISABLED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MFS_CHECKED|MFS_HILITE".
+func (v MFS) String() string {
+	return _MFS_names.String(v)
+}
+
+// Parses names of [MFS] flags joined by "|", or numbers.
+func ParseMFS(s string) (MFS, error) {
+	return _MFS_names.Parse(s)
+}
+
+var _MFT_names = enum.Flags[MFT]{
+	Type: "MFT",
+	Values: []enum.Name[MFT]{
+		{Val: MFT_BITMAP, Name: "MFT_BITMAP"},
+		{Val: MFT_MENUBARBREAK, Name: "MFT_MENUBARBREAK"},
+		{Val: MFT_MENUBREAK, Name: "MFT_MENUBREAK"},
+		{Val: MFT_OWNERDRAW, Name: "MFT_OWNERDRAW"},
+		{Val: MFT_RADIOCHECK, Name: "MFT_RADIOCHECK"},
+		{Val: MFT_SEPARATOR, Name: "MFT_SEPARATOR"},
+		{Val: MFT_RIGHTORDER, Name: "MFT_RIGHTORDER"},
+		{Val: MFT_RIGHTJUSTIFY, Name: "MFT_RIGHTJUSTIFY"},
+		{Val: MFT_STRING, Name: "MFT_STRING"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MFT_BITMAP|MFT_MENUBARBREAK".
+func (v MFT) String() string {
+	return _MFT_names.String(v)
+}
+
+// Parses names of [MFT] flags joined by "|", or numbers.
+func ParseMFT(s string) (MFT, error) {
+	return _MFT_names.Parse(s)
+}
+
+var _MIIM_names = enum.Flags[MIIM]{
+	Type: "MIIM",
+	Values: []enum.Name[MIIM]{
+		{Val: MIIM_STATE, Name: "MIIM_STATE"},
+		{Val: MIIM_ID, Name: "MIIM_ID"},
+		{Val: MIIM_SUBMENU, Name: "MIIM_SUBMENU"},
+		{Val: MIIM_CHECKMARKS, Name: "MIIM_CHECKMARKS"},
+		{Val: MIIM_TYPE, Name: "MIIM_TYPE"},
+		{Val: MIIM_DATA, Name: "MIIM_DATA"},
+		{Val: MIIM_STRING, Name: "MIIM_STRING"},
+		{Val: MIIM_BITMAP, Name: "MIIM_BITMAP"},
+		{Val: MIIM_FTYPE, Name: "MIIM_FTYPE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MIIM_STATE|MIIM_ID".
+func (v MIIM) String() string {
+	return _MIIM_names.String(v)
+}
+
+// Parses names of [MIIM] flags joined by "|", or numbers.
+func ParseMIIM(s string) (MIIM, error) {
+	return _MIIM_names.Parse(s)
+}
+
+var _MIM_names = enum.Flags[MIM]{
+	Type: "MIM",
+	Values: []enum.Name[MIM]{
+		{Val: MIM_MAXHEIGHT, Name: "MIM_MAXHEIGHT"},
+		{Val: MIM_BACKGROUND, Name: "MIM_BACKGROUND"},
+		{Val: MIM_HELPID, Name: "MIM_HELPID"},
+		{Val: MIM_MENUDATA, Name: "MIM_MENUDATA"},
+		{Val: MIM_STYLE, Name: "MIM_STYLE"},
+		{Val: MIM_APPLYTOSUBMENUS, Name: "MIM_APPLYTOSUBMENUS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MIM_MAXHEIGHT|MIM_BACKGROUND".
+func (v MIM) String() string {
+	return _MIM_names.String(v)
+}
+
+// Parses names of [MIM] flags joined by "|", or numbers.
+func ParseMIM(s string) (MIM, error) {
+	return _MIM_names.Parse(s)
+}
+
+var _MK_names = enum.Flags[MK]{
+	Type: "MK",
+	Values: []enum.Name[MK]{
+		{Val: MK_LBUTTON, Name: "MK_LBUTTON"},
+		{Val: MK_RBUTTON, Name: "MK_RBUTTON"},
+		{Val: MK_SHIFT, Name: "MK_SHIFT"},
+		{Val: MK_CONTROL, Name: "MK_CONTROL"},
+		{Val: MK_MBUTTON, Name: "MK_MBUTTON"},
+		{Val: MK_XBUTTON1, Name: "MK_XBUTTON1"},
+		{Val: MK_XBUTTON2, Name: "MK_XBUTTON2"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MK_LBUTTON|MK_RBUTTON".
+func (v MK) String() string {
+	return _MK_names.String(v)
+}
+
+// Parses names of [MK] flags joined by "|", or numbers.
+func ParseMK(s string) (MK, error) {
+	return _MK_names.Parse(s)
+}
+
+var _MNC_names = enum.Enum[MNC]{
+	Type: "MNC",
+	Values: []enum.Name[MNC]{
+		{Val: MNC_IGNORE, Name: "MNC_IGNORE"},
+		{Val: MNC_CLOSE, Name: "MNC_CLOSE"},
+		{Val: MNC_EXECUTE, Name: "MNC_EXECUTE"},
+		{Val: MNC_SELECT, Name: "MNC_SELECT"},
+	},
+}
+
+// Returns the name of the constant, like "MNC_IGNORE".
+func (v MNC) String() string {
+	return _MNC_names.String(v)
+}
+
+// Parses the name of a [MNC] constant, or a number.
+func ParseMNC(s string) (MNC, error) {
+	return _MNC_names.Parse(s)
+}
+
+var _MND_names = enum.Enum[MND]{
+	Type: "MND",
+	Values: []enum.Name[MND]{
+		{Val: MND_CONTINUE, Name: "MND_CONTINUE"},
+		{Val: MND_ENDMENU, Name: "MND_ENDMENU"},
+	},
+}
+
+// Returns the name of the constant, like "MND_CONTINUE".
+func (v MND) String() string {
+	return _MND_names.String(v)
+}
+
+// Parses the name of a [MND] constant, or a number.
+func ParseMND(s string) (MND, error) {
+	return _MND_names.Parse(s)
+}
+
+var _MNGO_names = enum.Enum[MNGO]{
+	Type: "MNGO",
+	Values: []enum.Name[MNGO]{
+		{Val: MNGO_NOINTERFACE, Name: "MNGO_NOINTERFACE"},
+		{Val: MNGO_NOERROR, Name: "MNGO_NOERROR"},
+	},
+}
+
+// Returns the name of the constant, like "MNGO_NOINTERFACE".
+func (v MNGO) String() string {
+	return _MNGO_names.String(v)
+}
+
+// Parses the name of a [MNGO] constant, or a number.
+func ParseMNGO(s string) (MNGO, error) {
+	return _MNGO_names.Parse(s)
+}
+
+var _MNGOF_names = enum.Flags[MNGOF]{
+	Type: "MNGOF",
+	Values: []enum.Name[MNGOF]{
+		{Val: MNGOF_TOPGAP, Name: "MNGOF_TOPGAP"},
+		{Val: MNGOF_BOTTOMGAP, Name: "MNGOF_BOTTOMGAP"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MNGOF_TOPGAP|MNGOF_BOTTOMGAP".
+func (v MNGOF) String() string {
+	return _MNGOF_names.String(v)
+}
+
+// Parses names of [MNGOF] flags joined by "|", or numbers.
+func ParseMNGOF(s string) (MNGOF, error) {
+	return _MNGOF_names.Parse(s)
+}
+
+var _MNS_names = enum.Flags[MNS]{
+	Type: "MNS",
+	Values: []enum.Name[MNS]{
+		{Val: MNS_NOCHECK, Name: "MNS_NOCHECK"},
+		{Val: MNS_MODELESS, Name: "MNS_MODELESS"},
+		{Val: MNS_DRAGDROP, Name: "MNS_DRAGDROP"},
+		{Val: MNS_AUTODISMISS, Name: "MNS_AUTODISMISS"},
+		{Val: MNS_NOTIFYBYPOS, Name: "MNS_NOTIFYBYPOS"},
+		{Val: MNS_CHECKORBMP, Name: "MNS_CHECKORBMP"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MNS_NOCHECK|MNS_MODELESS".
+func (v MNS) String() string {
+	return _MNS_names.String(v)
+}
+
+// Parses names of [MNS] flags joined by "|", or numbers.
+func ParseMNS(s string) (MNS, error) {
+	return _MNS_names.Parse(s)
+}
+
+var _MOD_names = enum.Flags[MOD]{
+	Type: "MOD",
+	Values: []enum.Name[MOD]{
+		{Val: MOD_ALT, Name: "MOD_ALT"},
+		{Val: MOD_CONTROL, Name: "MOD_CONTROL"},
+		{Val: MOD_SHIFT, Name: "MOD_SHIFT"},
+		{Val: MOD_WIN, Name: "MOD_WIN"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MOD_ALT|MOD_CONTROL".
+func (v MOD) String() string {
+	return _MOD_names.String(v)
+}
+
+// Parses names of [MOD] flags joined by "|", or numbers.
+func ParseMOD(s string) (MOD, error) {
+	return _MOD_names.Parse(s)
+}
+
+var _MONITOR_names = enum.Enum[MONITOR]{
+	Type: "MONITOR",
+	Values: []enum.Name[MONITOR]{
+		{Val: MONITOR_DEFAULTTONULL, Name: "MONITOR_DEFAULTTONULL"},
+		{Val: MONITOR_DEFAULTTOPRIMARY, Name: "MONITOR_DEFAULTTOPRIMARY"},
+		{Val: MONITOR_DEFAULTTONEAREST, Name: "MONITOR_DEFAULTTONEAREST"},
+	},
+}
+
+// Returns the name of the constant, like "MONITOR_DEFAULTTONULL".
+func (v MONITOR) String() string {
+	return _MONITOR_names.String(v)
+}
+
+// Parses the name of a [MONITOR] constant, or a number.
+func ParseMONITOR(s string) (MONITOR, error) {
+	return _MONITOR_names.Parse(s)
+}
+
+var _MONITORINFOF_names = enum.Enum[MONITORINFOF]{
+	Type: "MONITORINFOF",
+	Values: []enum.Name[MONITORINFOF]{
+		{Val: MONITORINFOF_NONE, Name: "MONITORINFOF_NONE"},
+		{Val: MONITORINFOF_PRIMARY, Name: "MONITORINFOF_PRIMARY"},
+	},
+}
+
+// Returns the name of the constant, like "MONITORINFOF_NONE".
+func (v MONITORINFOF) String() string {
+	return _MONITORINFOF_names.String(v)
+}
+
+// Parses the name of a [MONITORINFOF] constant, or a number.
+func ParseMONITORINFOF(s string) (MONITORINFOF, error) {
+	return _MONITORINFOF_names.Parse(s)
+}
+
+var _MOUSEEVENTF_names = enum.Flags[MOUSEEVENTF]{
+	Type: "MOUSEEVENTF",
+	Values: []enum.Name[MOUSEEVENTF]{
+		{Val: MOUSEEVENTF_MOVE, Name: "MOUSEEVENTF_MOVE"},
+		{Val: MOUSEEVENTF_LEFTDOWN, Name: "MOUSEEVENTF_LEFTDOWN"},
+		{Val: MOUSEEVENTF_LEFTUP, Name: "MOUSEEVENTF_LEFTUP"},
+		{Val: MOUSEEVENTF_RIGHTDOWN, Name: "MOUSEEVENTF_RIGHTDOWN"},
+		{Val: MOUSEEVENTF_RIGHTUP, Name: "MOUSEEVENTF_RIGHTUP"},
+		{Val: MOUSEEVENTF_MIDDLEDOWN, Name: "MOUSEEVENTF_MIDDLEDOWN"},
+		{Val: MOUSEEVENTF_MIDDLEUP, Name: "MOUSEEVENTF_MIDDLEUP"},
+		{Val: MOUSEEVENTF_XDOWN, Name: "MOUSEEVENTF_XDOWN"},
+		{Val: MOUSEEVENTF_XUP, Name: "MOUSEEVENTF_XUP"},
+		{Val: MOUSEEVENTF_WHEEL, Name: "MOUSEEVENTF_WHEEL"},
+		{Val: MOUSEEVENTF_HWHEEL, Name: "MOUSEEVENTF_HWHEEL"},
+		{Val: MOUSEEVENTF_MOVE_NOCOALESCE, Name: "MOUSEEVENTF_MOVE_NOCOALESCE"},
+		{Val: MOUSEEVENTF_VIRTUALDESK, Name: "MOUSEEVENTF_VIRTUALDESK"},
+		{Val: MOUSEEVENTF_ABSOLUTE, Name: "MOUSEEVENTF_ABSOLUTE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "MOUSEEVENTF_MOVE|MOUSEEVENTF_LEFTDOWN".
+func (v MOUSEEVENTF) String() string {
+	return _MOUSEEVENTF_names.String(v)
+}
+
+// Parses names of [MOUSEEVENTF] flags joined by "|", or numbers.
+func ParseMOUSEEVENTF(s string) (MOUSEEVENTF, error) {
+	return _MOUSEEVENTF_names.Parse(s)
+}
+
+var _MSGF_names = enum.Enum[MSGF]{
+	Type: "MSGF",
+	Values: []enum.Name[MSGF]{
+		{Val: MSGF_DIALOGBOX, Name: "MSGF_DIALOGBOX"},
+		{Val: MSGF_MENU, Name: "MSGF_MENU"},
+	},
+}
+
+// Returns the name of the constant, like "MSGF_DIALOGBOX".
+func (v MSGF) String() string {
+	return _MSGF_names.String(v)
+}
+
+// Parses the name of a [MSGF] constant, or a number.
+func ParseMSGF(s string) (MSGF, error) {
+	return _MSGF_names.Parse(s)
+}
+
+var _MSGFLT_names = enum.Enum[MSGFLT]{
+	Type: "MSGFLT",
+	Values: []enum.Name[MSGFLT]{
+		{Val: MSGFLT_ALLOW, Name: "MSGFLT_ALLOW"},
+		{Val: MSGFLT_DISALLOW, Name: "MSGFLT_DISALLOW"},
+		{Val: MSGFLT_RESET, Name: "MSGFLT_RESET"},
+	},
+}
+
+// Returns the name of the constant, like "MSGFLT_ALLOW".
+func (v MSGFLT) String() string {
+	return _MSGFLT_names.String(v)
+}
+
+// Parses the name of a [MSGFLT] constant, or a number.
+func ParseMSGFLT(s string) (MSGFLT, error) {
+	return _MSGFLT_names.Parse(s)
+}
+
+var _MSGFLTINFO_names = enum.Enum[MSGFLTINFO]{
+	Type: "MSGFLTINFO",
+	Values: []enum.Name[MSGFLTINFO]{
+		{Val: MSGFLTINFO_NONE, Name: "MSGFLTINFO_NONE"},
+		{Val: MSGFLTINFO_ALLOWED_HIGHER, Name: "MSGFLTINFO_ALLOWED_HIGHER"},
+		{Val: MSGFLTINFO_ALREADYALLOWED_FORWND, Name: "MSGFLTINFO_ALREADYALLOWED_FORWND"},
+		{Val: MSGFLTINFO_ALREADYDISALLOWED_FORWND, Name: "MSGFLTINFO_ALREADYDISALLOWED_FORWND"},
+	},
+}
+
+// Returns the name of the constant, like "MSGFLTINFO_NONE".
+func (v MSGFLTINFO) String() string {
+	return _MSGFLTINFO_names.String(v)
+}
+
+// Parses the name of a [MSGFLTINFO] constant, or a number.
+func ParseMSGFLTINFO(s string) (MSGFLTINFO, error) {
+	return _MSGFLTINFO_names.Parse(s)
+}
+
+var _ODA_names = enum.Flags[ODA]{
+	Type: "ODA",
+	Values: []enum.Name[ODA]{
+		{Val: ODA_DRAWENTIRE, Name: "ODA_DRAWENTIRE"},
+		{Val: ODA_SELECT, Name: "ODA_SELECT"},
+		{Val: ODA_FOCUS, Name: "ODA_FOCUS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ODA_DRAWENTIRE|ODA_SELECT".
+func (v ODA) String() string {
+	return _ODA_names.String(v)
+}
+
+// Parses names of [ODA] flags joined by "|", or numbers.
+func ParseODA(s string) (ODA, error) {
+	return _ODA_names.Parse(s)
+}
+
+var _ODS_names = enum.Flags[ODS]{
+	Type: "ODS",
+	Values: []enum.Name[ODS]{
+		{Val: ODS_SELECTED, Name: "ODS_SELECTED"},
+		{Val: ODS_GRAYED, Name: "ODS_GRAYED"},
+		{Val: ODS_DISABLED, Name: "ODS_DISABLED"},
+		{Val: ODS_CHECKED, Name: "ODS_CHECKED"},
+		{Val: ODS_FOCUS, Name: "ODS_FOCUS"},
+		{Val: ODS_DEFAULT, Name: "ODS_DEFAULT"},
+		{Val: ODS_COMBOBOXEDIT, Name: "ODS_COMBOBOXEDIT"},
+		{Val: ODS_HOTLIGHT, Name: "ODS_HOTLIGHT"},
+		{Val: ODS_INACTIVE, Name: "ODS_INACTIVE"},
+		{Val: ODS_NOACCEL, Name: "ODS_NOACCEL"},
+		{Val: ODS_NOFOCUSRECT, Name: "ODS_NOFOCUSRECT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "ODS_SELECTED|ODS_GRAYED".
+func (v ODS) String() string {
+	return _ODS_names.String(v)
+}
+
+// Parses names of [ODS] flags joined by "|", or numbers.
+func ParseODS(s string) (ODS, error) {
+	return _ODS_names.Parse(s)
+}
+
+var _ODT_names = enum.Enum[ODT]{
+	Type: "ODT",
+	Values: []enum.Name[ODT]{
+		{Val: ODT_MENU, Name: "ODT_MENU"},
+		{Val: ODT_LISTBOX, Name: "ODT_LISTBOX"},
+		{Val: ODT_COMBOBOX, Name: "ODT_COMBOBOX"},
+		{Val: ODT_BUTTON, Name: "ODT_BUTTON"},
+		{Val: ODT_STATIC, Name: "ODT_STATIC"},
+		{Val: ODT_TAB, Name: "ODT_TAB"},
+		{Val: ODT_LISTVIEW, Name: "ODT_LISTVIEW"},
+	},
+}
+
+// Returns the name of the constant, like "ODT_MENU".
+func (v ODT) String() string {
+	return _ODT_names.String(v)
+}
+
+// Parses the name of a [ODT] constant, or a number.
+func ParseODT(s string) (ODT, error) {
+	return _ODT_names.Parse(s)
+}
+
+var _ODT_C_names = enum.Enum[ODT_C]{
+	Type: "ODT_C",
+	Values: []enum.Name[ODT_C]{
+		{Val: ODT_C_LISTBOX, Name: "ODT_C_LISTBOX"},
+		{Val: ODT_C_COMBOBOX, Name: "ODT_C_COMBOBOX"},
+	},
+}
+
+// Returns the name of the constant, like "ODT_C_LISTBOX".
+func (v ODT_C) String() string {
+	return _ODT_C_names.String(v)
+}
+
+// Parses the name of a [ODT_C] constant, or a number.
+func ParseODT_C(s string) (ODT_C, error) {
+	return _ODT_C_names.Parse(s)
+}
+
+var _PM_names = enum.Flags[PM]{
+	Type: "PM",
+	Values: []enum.Name[PM]{
+		{Val: PM_QS_INPUT, Name: "PM_QS_INPUT"},
+		{Val: PM_QS_POSTMESSAGE, Name: "PM_QS_POSTMESSAGE"},
+		{Val: PM_REMOVE, Name: "PM_REMOVE"},
+		{Val: PM_NOYIELD, Name: "PM_NOYIELD"},
+		{Val: PM_QS_PAINT, Name: "PM_QS_PAINT"},
+		{Val: PM_QS_SENDMESSAGE, Name: "PM_QS_SENDMESSAGE"},
+		{Val: PM_NOREMOVE, Name: "PM_NOREMOVE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PM_REMOVE|PM_NOYIELD".
+func (v PM) String() string {
+	return _PM_names.String(v)
+}
+
+// Parses names of [PM] flags joined by "|", or numbers.
+func ParsePM(s string) (PM, error) {
+	return _PM_names.Parse(s)
+}
+
+var _RDW_names = enum.Flags[RDW]{
+	Type: "RDW",
+	Values: []enum.Name[RDW]{
+		{Val: RDW_ERASE, Name: "RDW_ERASE"},
+		{Val: RDW_FRAME, Name: "RDW_FRAME"},
+		{Val: RDW_INTERNALPAINT, Name: "RDW_INTERNALPAINT"},
+		{Val: RDW_INVALIDATE, Name: "RDW_INVALIDATE"},
+		{Val: RDW_NOERASE, Name: "RDW_NOERASE"},
+		{Val: RDW_NOFRAME, Name: "RDW_NOFRAME"},
+		{Val: RDW_NOINTERNALPAINT, Name: "RDW_NOINTERNALPAINT"},
+		{Val: RDW_VALIDATE, Name: "RDW_VALIDATE"},
+		{Val: RDW_ERASENOW, Name: "RDW_ERASENOW"},
+		{Val: RDW_UPDATENOW, Name: "RDW_UPDATENOW"},
+		{Val: RDW_ALLCHILDREN, Name: "RDW_ALLCHILDREN"},
+		{Val: RDW_NOCHILDREN, Name: "RDW_NOCHILDREN"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "RDW_ERASE|RDW_FRAME".
+func (v RDW) String() string {
+	return _RDW_names.String(v)
+}
+
+// Parses names of [RDW] flags joined by "|", or numbers.
+func ParseRDW(s string) (RDW, error) {
+	return _RDW_names.Parse(s)
+}
+
+var _QS_names = enum.Flags[QS]{
+	Type: "QS",
+	Values: []enum.Name[QS]{
+		{Val: QS_ALLINPUT, Name: "QS_ALLINPUT"},
+		{Val: QS_INPUT, Name: "QS_INPUT"},
+		{Val: QS_MOUSE, Name: "QS_MOUSE"},
+		{Val: QS_KEY, Name: "QS_KEY"},
+		{Val: QS_MOUSEMOVE, Name: "QS_MOUSEMOVE"},
+		{Val: QS_MOUSEBUTTON, Name: "QS_MOUSEBUTTON"},
+		{Val: QS_POSTMESSAGE, Name: "QS_POSTMESSAGE"},
+		{Val: QS_TIMER, Name: "QS_TIMER"},
+		{Val: QS_PAINT, Name: "QS_PAINT"},
+		{Val: QS_SENDMESSAGE, Name: "QS_SENDMESSAGE"},
+		{Val: QS_HOTKEY, Name: "QS_HOTKEY"},
+		{Val: QS_ALLPOSTMESSAGE, Name: "QS_ALLPOSTMESSAGE"},
+		{Val: QS_RAWINPUT, Name: "QS_RAWINPUT"},
+		{Val: QS_TOUCH, Name: "QS_TOUCH"},
+		{Val: QS_POINTER, Name: "QS_POINTER"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "QS_KEY|QS_MOUSEMOVE".
+func (v QS) String() string {
+	return _QS_names.String(v)
+}
+
+// Parses names of [QS] flags joined by "|", or numbers.
+func ParseQS(s string) (QS, error) {
+	return _QS_names.Parse(s)
+}
+
+var _RT_names = enum.Enum[RT]{
+	Type: "RT",
+	Values: []enum.Name[RT]{
+		{Val: RT_NONE, Name: "RT_NONE"},
+		{Val: RT_ACCELERATOR, Name: "RT_ACCELERATOR"},
+		{Val: RT_ANICURSOR, Name: "RT_ANICURSOR"},
+		{Val: RT_ANIICON, Name: "RT_ANIICON"},
+		{Val: RT_BITMAP, Name: "RT_BITMAP"},
+		{Val: RT_CURSOR, Name: "RT_CURSOR"},
+		{Val: RT_DIALOG, Name: "RT_DIALOG"},
+		{Val: RT_DLGINCLUDE, Name: "RT_DLGINCLUDE"},
+		{Val: RT_FONT, Name: "RT_FONT"},
+		{Val: RT_FONTDIR, Name: "RT_FONTDIR"},
+		{Val: RT_GROUP_CURSOR, Name: "RT_GROUP_CURSOR"},
+		{Val: RT_GROUP_ICON, Name: "RT_GROUP_ICON"},
+		{Val: RT_HTML, Name: "RT_HTML"},
+		{Val: RT_ICON, Name: "RT_ICON"},
+		{Val: RT_MANIFEST, Name: "RT_MANIFEST"},
+		{Val: RT_MENU, Name: "RT_MENU"},
+		{Val: RT_MESSAGETABLE, Name: "RT_MESSAGETABLE"},
+		{Val: RT_PLUGPLAY, Name: "RT_PLUGPLAY"},
+		{Val: RT_RCDATA, Name: "RT_RCDATA"},
+		{Val: RT_STRING, Name: "RT_STRING"},
+		{Val: RT_VERSION, Name: "RT_VERSION"},
+		{Val: RT_VXD, Name: "RT_VXD"},
+	},
+}
+
+// Returns the name of the constant, like "RT_NONE".
+func (v RT) String() string {
+	return _RT_names.String(v)
+}
+
+// Parses the name of a [RT] constant, or a number.
+func ParseRT(s string) (RT, error) {
+	return _RT_names.Parse(s)
+}
+
+var _SB_REQ_names = enum.Enum[SB_REQ]{
+	Type: "SB_REQ",
+	Values: []enum.Name[SB_REQ]{
+		{Val: SB_REQ_LINEUP, Name: "SB_REQ_LINEUP"},
+		{Val: SB_REQ_LINELEFT, Name: "SB_REQ_LINELEFT"},
+		{Val: SB_REQ_LINEDOWN, Name: "SB_REQ_LINEDOWN"},
+		{Val: SB_REQ_LINERIGHT, Name: "SB_REQ_LINERIGHT"},
+		{Val: SB_REQ_PAGEUP, Name: "SB_REQ_PAGEUP"},
+		{Val: SB_REQ_PAGELEFT, Name: "SB_REQ_PAGELEFT"},
+		{Val: SB_REQ_PAGEDOWN, Name: "SB_REQ_PAGEDOWN"},
+		{Val: SB_REQ_PAGERIGHT, Name: "SB_REQ_PAGERIGHT"},
+		{Val: SB_REQ_THUMBPOSITION, Name: "SB_REQ_THUMBPOSITION"},
+		{Val: SB_REQ_THUMBTRACK, Name: "SB_REQ_THUMBTRACK"},
+		{Val: SB_REQ_TOP, Name: "SB_REQ_TOP"},
+		{Val: SB_REQ_LEFT, Name: "SB_REQ_LEFT"},
+		{Val: SB_REQ_BOTTOM, Name: "SB_REQ_BOTTOM"},
+		{Val: SB_REQ_RIGHT, Name: "SB_REQ_RIGHT"},
+		{Val: SB_REQ_ENDSCROLL, Name: "SB_REQ_ENDSCROLL"},
+	},
+}
+
+// Returns the name of the constant, like "SB_REQ_LINEUP".
+func (v SB_REQ) String() string {
+	return _SB_REQ_names.String(v)
+}
+
+// Parses the name of a [SB_REQ] constant, or a number.
+func ParseSB_REQ(s string) (SB_REQ, error) {
+	return _SB_REQ_names.Parse(s)
+}
+
+var _SBB_names = enum.Enum[SBB]{
+	Type: "SBB",
+	Values: []enum.Name[SBB]{
+		{Val: SBB_HORZ, Name: "SBB_HORZ"},
+		{Val: SBB_VERT, Name: "SBB_VERT"},
+		{Val: SBB_CTL, Name: "SBB_CTL"},
+		{Val: SBB_BOTH, Name: "SBB_BOTH"},
+	},
+}
+
+// Returns the name of the constant, like "SBB_HORZ".
+func (v SBB) String() string {
+	return _SBB_names.String(v)
+}
+
+// Parses the name of a [SBB] constant, or a number.
+func ParseSBB(s string) (SBB, error) {
+	return _SBB_names.Parse(s)
+}
+
+var _SC_names = enum.Enum[SC]{
+	Type: "SC",
+	Values: []enum.Name[SC]{
+		{Val: SC_SIZE, Name: "SC_SIZE"},
+		{Val: SC_MOVE, Name: "SC_MOVE"},
+		{Val: SC_MINIMIZE, Name: "SC_MINIMIZE"},
+		{Val: SC_MAXIMIZE, Name: "SC_MAXIMIZE"},
+		{Val: SC_NEXTWINDOW, Name: "SC_NEXTWINDOW"},
+		{Val: SC_PREVWINDOW, Name: "SC_PREVWINDOW"},
+		{Val: SC_CLOSE, Name: "SC_CLOSE"},
+		{Val: SC_VSCROLL, Name: "SC_VSCROLL"},
+		{Val: SC_HSCROLL, Name: "SC_HSCROLL"},
+		{Val: SC_MOUSEMENU, Name: "SC_MOUSEMENU"},
+		{Val: SC_KEYMENU, Name: "SC_KEYMENU"},
+		{Val: SC_ARRANGE, Name: "SC_ARRANGE"},
+		{Val: SC_RESTORE, Name: "SC_RESTORE"},
+		{Val: SC_TASKLIST, Name: "SC_TASKLIST"},
+		{Val: SC_SCREENSAVE, Name: "SC_SCREENSAVE"},
+		{Val: SC_HOTKEY, Name: "SC_HOTKEY"},
+		{Val: SC_DEFAULT, Name: "SC_DEFAULT"},
+		{Val: SC_MONITORPOWER, Name: "SC_MONITORPOWER"},
+		{Val: SC_CONTEXTHELP, Name: "SC_CONTEXTHELP"},
+		{Val: SC_SEPARATOR, Name: "SC_SEPARATOR"},
+	},
+}
+
+// Returns the name of the constant, like "SC_SIZE".
+func (v SC) String() string {
+	return _SC_names.String(v)
+}
+
+// Parses the name of a [SC] constant, or a number.
+func ParseSC(s string) (SC, error) {
+	return _SC_names.Parse(s)
+}
+
+var _SHTDN_names = enum.Enum[SHTDN]{
+	Type: "SHTDN",
+	Values: []enum.Name[SHTDN]{
+		{Val: SHTDN_REASON_FLAG_COMMENT_REQUIRED, Name: "SHTDN_REASON_FLAG_COMMENT_REQUIRED"},
+		{Val: SHTDN_REASON_FLAG_DIRTY_PROBLEM_ID_REQUIRED, Name: "SHTDN_REASON_FLAG_DIRTY_PROBLEM_ID_REQUIRED"},
+		{Val: SHTDN_REASON_FLAG_CLEAN_UI, Name: "SHTDN_REASON_FLAG_CLEAN_UI"},
+		{Val: SHTDN_REASON_FLAG_DIRTY_UI, Name: "SHTDN_REASON_FLAG_DIRTY_UI"},
+		{Val: SHTDN_REASON_FLAG_MOBILE_UI_RESERVED, Name: "SHTDN_REASON_FLAG_MOBILE_UI_RESERVED"},
+		{Val: SHTDN_REASON_FLAG_USER_DEFINED, Name: "SHTDN_REASON_FLAG_USER_DEFINED"},
+		{Val: SHTDN_REASON_FLAG_PLANNED, Name: "SHTDN_REASON_FLAG_PLANNED"},
+		{Val: SHTDN_REASON_MAJOR_OTHER, Name: "SHTDN_REASON_MAJOR_OTHER"},
+		{Val: SHTDN_REASON_MAJOR_NONE, Name: "SHTDN_REASON_MAJOR_NONE"},
+		{Val: SHTDN_REASON_MAJOR_HARDWARE, Name: "SHTDN_REASON_MAJOR_HARDWARE"},
+		{Val: SHTDN_REASON_MAJOR_OPERATINGSYSTEM, Name: "SHTDN_REASON_MAJOR_OPERATINGSYSTEM"},
+		{Val: SHTDN_REASON_MAJOR_SOFTWARE, Name: "SHTDN_REASON_MAJOR_SOFTWARE"},
+		{Val: SHTDN_REASON_MAJOR_APPLICATION, Name: "SHTDN_REASON_MAJOR_APPLICATION"},
+		{Val: SHTDN_REASON_MAJOR_SYSTEM, Name: "SHTDN_REASON_MAJOR_SYSTEM"},
+		{Val: SHTDN_REASON_MAJOR_POWER, Name: "SHTDN_REASON_MAJOR_POWER"},
+		{Val: SHTDN_REASON_MAJOR_LEGACY_API, Name: "SHTDN_REASON_MAJOR_LEGACY_API"},
+		{Val: SHTDN_REASON_MINOR_OTHER, Name: "SHTDN_REASON_MINOR_OTHER"},
+		{Val: SHTDN_REASON_MINOR_NONE, Name: "SHTDN_REASON_MINOR_NONE"},
+		{Val: SHTDN_REASON_MINOR_MAINTENANCE, Name: "SHTDN_REASON_MINOR_MAINTENANCE"},
+		{Val: SHTDN_REASON_MINOR_INSTALLATION, Name: "SHTDN_REASON_MINOR_INSTALLATION"},
+		{Val: SHTDN_REASON_MINOR_UPGRADE, Name: "SHTDN_REASON_MINOR_UPGRADE"},
+		{Val: SHTDN_REASON_MINOR_RECONFIG, Name: "SHTDN_REASON_MINOR_RECONFIG"},
+		{Val: SHTDN_REASON_MINOR_HUNG, Name: "SHTDN_REASON_MINOR_HUNG"},
+		{Val: SHTDN_REASON_MINOR_UNSTABLE, Name: "SHTDN_REASON_MINOR_UNSTABLE"},
+		{Val: SHTDN_REASON_MINOR_DISK, Name: "SHTDN_REASON_MINOR_DISK"},
+		{Val: SHTDN_REASON_MINOR_PROCESSOR, Name: "SHTDN_REASON_MINOR_PROCESSOR"},
+		{Val: SHTDN_REASON_MINOR_NETWORKCARD, Name: "SHTDN_REASON_MINOR_NETWORKCARD"},
+		{Val: SHTDN_REASON_MINOR_POWER_SUPPLY, Name: "SHTDN_REASON_MINOR_POWER_SUPPLY"},
+		{Val: SHTDN_REASON_MINOR_CORDUNPLUGGED, Name: "SHTDN_REASON_MINOR_CORDUNPLUGGED"},
+		{Val: SHTDN_REASON_MINOR_ENVIRONMENT, Name: "SHTDN_REASON_MINOR_ENVIRONMENT"},
+		{Val: SHTDN_REASON_MINOR_HARDWARE_DRIVER, Name: "SHTDN_REASON_MINOR_HARDWARE_DRIVER"},
+		{Val: SHTDN_REASON_MINOR_OTHERDRIVER, Name: "SHTDN_REASON_MINOR_OTHERDRIVER"},
+		{Val: SHTDN_REASON_MINOR_BLUESCREEN, Name: "SHTDN_REASON_MINOR_BLUESCREEN"},
+		{Val: SHTDN_REASON_MINOR_SERVICEPACK, Name: "SHTDN_REASON_MINOR_SERVICEPACK"},
+		{Val: SHTDN_REASON_MINOR_HOTFIX, Name: "SHTDN_REASON_MINOR_HOTFIX"},
+		{Val: SHTDN_REASON_MINOR_SECURITYFIX, Name: "SHTDN_REASON_MINOR_SECURITYFIX"},
+		{Val: SHTDN_REASON_MINOR_SECURITY, Name: "SHTDN_REASON_MINOR_SECURITY"},
+		{Val: SHTDN_REASON_MINOR_NETWORK_CONNECTIVITY, Name: "SHTDN_REASON_MINOR_NETWORK_CONNECTIVITY"},
+		{Val: SHTDN_REASON_MINOR_WMI, Name: "SHTDN_REASON_MINOR_WMI"},
+		{Val: SHTDN_REASON_MINOR_SERVICEPACK_UNINSTALL, Name: "SHTDN_REASON_MINOR_SERVICEPACK_UNINSTALL"},
+		{Val: SHTDN_REASON_MINOR_HOTFIX_UNINSTALL, Name: "SHTDN_REASON_MINOR_HOTFIX_UNINSTALL"},
+		{Val: SHTDN_REASON_MINOR_SECURITYFIX_UNINSTALL, Name: "SHTDN_REASON_MINOR_SECURITYFIX_UNINSTALL"},
+		{Val: SHTDN_REASON_MINOR_MMC, Name: "SHTDN_REASON_MINOR_MMC"},
+		{Val: SHTDN_REASON_MINOR_SYSTEMRESTORE, Name: "SHTDN_REASON_MINOR_SYSTEMRESTORE"},
+		{Val: SHTDN_REASON_MINOR_TERMSRV, Name: "SHTDN_REASON_MINOR_TERMSRV"},
+		{Val: SHTDN_REASON_MINOR_DC_PROMOTION, Name: "SHTDN_REASON_MINOR_DC_PROMOTION"},
+		{Val: SHTDN_REASON_MINOR_DC_DEMOTION, Name: "SHTDN_REASON_MINOR_DC_DEMOTION"},
+		{Val: SHTDN_REASON_LEGACY_API, Name: "SHTDN_REASON_LEGACY_API"},
+		{Val: SHTDN_REASON_VALID_BIT_MASK, Name: "SHTDN_REASON_VALID_BIT_MASK"},
+		{Val: SHTDN_PCLEANUI, Name: "SHTDN_PCLEANUI"},
+		{Val: SHTDN_PDIRTYUI, Name: "SHTDN_PDIRTYUI"},
+		{Val: SHTDN_REASON_UNKNOWN, Name: "SHTDN_REASON_UNKNOWN"},
+		{Val: SHTDN_UCLEANUI, Name: "SHTDN_UCLEANUI"},
+		{Val: SHTDN_UDIRTYUI, Name: "SHTDN_UDIRTYUI"},
+	},
+}
+
+// Returns the name of the constant, like "SHTDN_REASON_FLAG_COMMENT_REQUIRED".
+func (v SHTDN) String() string {
+	return _SHTDN_names.String(v)
+}
+
+// Parses the name of a [SHTDN] constant, or a number.
+func ParseSHTDN(s string) (SHTDN, error) {
+	return _SHTDN_names.Parse(s)
+}
+
+var _SIF_names = enum.Flags[SIF]{
+	Type: "SIF",
+	Values: []enum.Name[SIF]{
+		{Val: SIF_ALL, Name: "SIF_ALL"},
+		{Val: SIF_RANGE, Name: "SIF_RANGE"},
+		{Val: SIF_PAGE, Name: "SIF_PAGE"},
+		{Val: SIF_POS, Name: "SIF_POS"},
+		{Val: SIF_DISABLENOSCROLL, Name: "SIF_DISABLENOSCROLL"},
+		{Val: SIF_TRACKPOS, Name: "SIF_TRACKPOS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SIF_RANGE|SIF_PAGE".
+func (v SIF) String() string {
+	return _SIF_names.String(v)
+}
+
+// Parses names of [SIF] flags joined by "|", or numbers.
+func ParseSIF(s string) (SIF, error) {
+	return _SIF_names.Parse(s)
+}
+
+var _SIZE_REQ_names = enum.Enum[SIZE_REQ]{
+	Type: "SIZE_REQ",
+	Values: []enum.Name[SIZE_REQ]{
+		{Val: SIZE_REQ_RESTORED, Name: "SIZE_REQ_RESTORED"},
+		{Val: SIZE_REQ_MINIMIZED, Name: "SIZE_REQ_MINIMIZED"},
+		{Val: SIZE_REQ_MAXIMIZED, Name: "SIZE_REQ_MAXIMIZED"},
+		{Val: SIZE_REQ_MAXSHOW, Name: "SIZE_REQ_MAXSHOW"},
+		{Val: SIZE_REQ_MAXHIDE, Name: "SIZE_REQ_MAXHIDE"},
+	},
+}
+
+// Returns the name of the constant, like "SIZE_REQ_RESTORED".
+func (v SIZE_REQ) String() string {
+	return _SIZE_REQ_names.String(v)
+}
+
+// Parses the name of a [SIZE_REQ] constant, or a number.
+func ParseSIZE_REQ(s string) (SIZE_REQ, error) {
+	return _SIZE_REQ_names.Parse(s)
+}
+
+var _SM_names = enum.Enum[SM]{
+	Type: "SM",
+	Values: []enum.Name[SM]{
+		{Val: SM_CXSCREEN, Name: "SM_CXSCREEN"},
+		{Val: SM_CYSCREEN, Name: "SM_CYSCREEN"},
+		{Val: SM_CXVSCROLL, Name: "SM_CXVSCROLL"},
+		{Val: SM_CYHSCROLL, Name: "SM_CYHSCROLL"},
+		{Val: SM_CYCAPTION, Name: "SM_CYCAPTION"},
+		{Val: SM_CXBORDER, Name: "SM_CXBORDER"},
+		{Val: SM_CYBORDER, Name: "SM_CYBORDER"},
+		{Val: SM_CXDLGFRAME, Name: "SM_CXDLGFRAME"},
+		{Val: SM_CYDLGFRAME, Name: "SM_CYDLGFRAME"},
+		{Val: SM_CYVTHUMB, Name: "SM_CYVTHUMB"},
+		{Val: SM_CXHTHUMB, Name: "SM_CXHTHUMB"},
+		{Val: SM_CXICON, Name: "SM_CXICON"},
+		{Val: SM_CYICON, Name: "SM_CYICON"},
+		{Val: SM_CXCURSOR, Name: "SM_CXCURSOR"},
+		{Val: SM_CYCURSOR, Name: "SM_CYCURSOR"},
+		{Val: SM_CYMENU, Name: "SM_CYMENU"},
+		{Val: SM_CXFULLSCREEN, Name: "SM_CXFULLSCREEN"},
+		{Val: SM_CYFULLSCREEN, Name: "SM_CYFULLSCREEN"},
+		{Val: SM_CYKANJIWINDOW, Name: "SM_CYKANJIWINDOW"},
+		{Val: SM_MOUSEPRESENT, Name: "SM_MOUSEPRESENT"},
+		{Val: SM_CYVSCROLL, Name: "SM_CYVSCROLL"},
+		{Val: SM_CXHSCROLL, Name: "SM_CXHSCROLL"},
+		{Val: SM_DEBUG, Name: "SM_DEBUG"},
+		{Val: SM_SWAPBUTTON, Name: "SM_SWAPBUTTON"},
+		{Val: SM_RESERVED1, Name: "SM_RESERVED1"},
+		{Val: SM_RESERVED2, Name: "SM_RESERVED2"},
+		{Val: SM_RESERVED3, Name: "SM_RESERVED3"},
+		{Val: SM_RESERVED4, Name: "SM_RESERVED4"},
+		{Val: SM_CXMIN, Name: "SM_CXMIN"},
+		{Val: SM_CYMIN, Name: "SM_CYMIN"},
+		{Val: SM_CXSIZE, Name: "SM_CXSIZE"},
+		{Val: SM_CYSIZE, Name: "SM_CYSIZE"},
+		{Val: SM_CXFRAME, Name: "SM_CXFRAME"},
+		{Val: SM_CYFRAME, Name: "SM_CYFRAME"},
+		{Val: SM_CXMINTRACK, Name: "SM_CXMINTRACK"},
+		{Val: SM_CYMINTRACK, Name: "SM_CYMINTRACK"},
+		{Val: SM_CXDOUBLECLK, Name: "SM_CXDOUBLECLK"},
+		{Val: SM_CYDOUBLECLK, Name: "SM_CYDOUBLECLK"},
+		{Val: SM_CXICONSPACING, Name: "SM_CXICONSPACING"},
+		{Val: SM_CYICONSPACING, Name: "SM_CYICONSPACING"},
+		{Val: SM_MENUDROPALIGNMENT, Name: "SM_MENUDROPALIGNMENT"},
+		{Val: SM_PENWINDOWS, Name: "SM_PENWINDOWS"},
+		{Val: SM_DBCSENABLED, Name: "SM_DBCSENABLED"},
+		{Val: SM_CMOUSEBUTTONS, Name: "SM_CMOUSEBUTTONS"},
+		{Val: SM_SECURE, Name: "SM_SECURE"},
+		{Val: SM_CXEDGE, Name: "SM_CXEDGE"},
+		{Val: SM_CYEDGE, Name: "SM_CYEDGE"},
+		{Val: SM_CXMINSPACING, Name: "SM_CXMINSPACING"},
+		{Val: SM_CYMINSPACING, Name: "SM_CYMINSPACING"},
+		{Val: SM_CXSMICON, Name: "SM_CXSMICON"},
+		{Val: SM_CYSMICON, Name: "SM_CYSMICON"},
+		{Val: SM_CYSMCAPTION, Name: "SM_CYSMCAPTION"},
+		{Val: SM_CXSMSIZE, Name: "SM_CXSMSIZE"},
+		{Val: SM_CYSMSIZE, Name: "SM_CYSMSIZE"},
+		{Val: SM_CXMENUSIZE, Name: "SM_CXMENUSIZE"},
+		{Val: SM_CYMENUSIZE, Name: "SM_CYMENUSIZE"},
+		{Val: SM_ARRANGE, Name: "SM_ARRANGE"},
+		{Val: SM_CXMINIMIZED, Name: "SM_CXMINIMIZED"},
+		{Val: SM_CYMINIMIZED, Name: "SM_CYMINIMIZED"},
+		{Val: SM_CXMAXTRACK, Name: "SM_CXMAXTRACK"},
+		{Val: SM_CYMAXTRACK, Name: "SM_CYMAXTRACK"},
+		{Val: SM_CXMAXIMIZED, Name: "SM_CXMAXIMIZED"},
+		{Val: SM_CYMAXIMIZED, Name: "SM_CYMAXIMIZED"},
+		{Val: SM_NETWORK, Name: "SM_NETWORK"},
+		{Val: SM_CLEANBOOT, Name: "SM_CLEANBOOT"},
+		{Val: SM_CXDRAG, Name: "SM_CXDRAG"},
+		{Val: SM_CYDRAG, Name: "SM_CYDRAG"},
+		{Val: SM_SHOWSOUNDS, Name: "SM_SHOWSOUNDS"},
+		{Val: SM_CXMENUCHECK, Name: "SM_CXMENUCHECK"},
+		{Val: SM_CYMENUCHECK, Name: "SM_CYMENUCHECK"},
+		{Val: SM_SLOWMACHINE, Name: "SM_SLOWMACHINE"},
+		{Val: SM_MIDEASTENABLED, Name: "SM_MIDEASTENABLED"},
+		{Val: SM_MOUSEWHEELPRESENT, Name: "SM_MOUSEWHEELPRESENT"},
+		{Val: SM_XVIRTUALSCREEN, Name: "SM_XVIRTUALSCREEN"},
+		{Val: SM_YVIRTUALSCREEN, Name: "SM_YVIRTUALSCREEN"},
+		{Val: SM_CXVIRTUALSCREEN, Name: "SM_CXVIRTUALSCREEN"},
+		{Val: SM_CYVIRTUALSCREEN, Name: "SM_CYVIRTUALSCREEN"},
+		{Val: SM_CMONITORS, Name: "SM_CMONITORS"},
+		{Val: SM_SAMEDISPLAYFORMAT, Name: "SM_SAMEDISPLAYFORMAT"},
+		{Val: SM_IMMENABLED, Name: "SM_IMMENABLED"},
+		{Val: SM_CXFOCUSBORDER, Name: "SM_CXFOCUSBORDER"},
+		{Val: SM_CYFOCUSBORDER, Name: "SM_CYFOCUSBORDER"},
+		{Val: SM_TABLETPC, Name: "SM_TABLETPC"},
+		{Val: SM_MEDIACENTER, Name: "SM_MEDIACENTER"},
+		{Val: SM_STARTER, Name: "SM_STARTER"},
+		{Val: SM_SERVERR2, Name: "SM_SERVERR2"},
+		{Val: SM_MOUSEHORIZONTALWHEELPRESENT, Name: "SM_MOUSEHORIZONTALWHEELPRESENT"},
+		{Val: SM_CXPADDEDBORDER, Name: "SM_CXPADDEDBORDER"},
+		{Val: SM_DIGITIZER, Name: "SM_DIGITIZER"},
+		{Val: SM_MAXIMUMTOUCHES, Name: "SM_MAXIMUMTOUCHES"},
+		{Val: SM_CMETRICS, Name: "SM_CMETRICS"},
+		{Val: SM_REMOTESESSION, Name: "SM_REMOTESESSION"},
+		{Val: SM_SHUTTINGDOWN, Name: "SM_SHUTTINGDOWN"},
+		{Val: SM_REMOTECONTROL, Name: "SM_REMOTECONTROL"},
+		{Val: SM_CARETBLINKINGENABLED, Name: "SM_CARETBLINKINGENABLED"},
+		{Val: SM_CONVERTIBLESLATEMODE, Name: "SM_CONVERTIBLESLATEMODE"},
+		{Val: SM_SYSTEMDOCKED, Name: "SM_SYSTEMDOCKED"},
+		{Val: SM_CXFIXEDFRAME, Name: "SM_CXFIXEDFRAME"},
+		{Val: SM_CYFIXEDFRAME, Name: "SM_CYFIXEDFRAME"},
+		{Val: SM_CXSIZEFRAME, Name: "SM_CXSIZEFRAME"},
+		{Val: SM_CYSIZEFRAME, Name: "SM_CYSIZEFRAME"},
+	},
+}
+
+// Returns the name of the constant, like "SM_CXSCREEN".
+func (v SM) String() string {
+	return _SM_names.String(v)
+}
+
+// Parses the name of a [SM] constant, or a number.
+func ParseSM(s string) (SM, error) {
+	return _SM_names.Parse(s)
+}
+
+var _SPI_names = enum.Enum[SPI]{
+	Type: "SPI",
+	Values: []enum.Name[SPI]{
+		{Val: SPI_GETBEEP, Name: "SPI_GETBEEP"},
+		{Val: SPI_SETBEEP, Name: "SPI_SETBEEP"},
+		{Val: SPI_GETMOUSE, Name: "SPI_GETMOUSE"},
+		{Val: SPI_SETMOUSE, Name: "SPI_SETMOUSE"},
+		{Val: SPI_GETBORDER, Name: "SPI_GETBORDER"},
+		{Val: SPI_SETBORDER, Name: "SPI_SETBORDER"},
+		{Val: SPI_GETKEYBOARDSPEED, Name: "SPI_GETKEYBOARDSPEED"},
+		{Val: SPI_SETKEYBOARDSPEED, Name: "SPI_SETKEYBOARDSPEED"},
+		{Val: SPI_LANGDRIVER, Name: "SPI_LANGDRIVER"},
+		{Val: SPI_ICONHORIZONTALSPACING, Name: "SPI_ICONHORIZONTALSPACING"},
+		{Val: SPI_GETSCREENSAVETIMEOUT, Name: "SPI_GETSCREENSAVETIMEOUT"},
+		{Val: SPI_SETSCREENSAVETIMEOUT, Name: "SPI_SETSCREENSAVETIMEOUT"},
+		{Val: SPI_GETSCREENSAVEACTIVE, Name: "SPI_GETSCREENSAVEACTIVE"},
+		{Val: SPI_SETSCREENSAVEACTIVE, Name: "SPI_SETSCREENSAVEACTIVE"},
+		{Val: SPI_GETGRIDGRANULARITY, Name: "SPI_GETGRIDGRANULARITY"},
+		{Val: SPI_SETGRIDGRANULARITY, Name: "SPI_SETGRIDGRANULARITY"},
+		{Val: SPI_SETDESKWALLPAPER, Name: "SPI_SETDESKWALLPAPER"},
+		{Val: SPI_SETDESKPATTERN, Name: "SPI_SETDESKPATTERN"},
+		{Val: SPI_GETKEYBOARDDELAY, Name: "SPI_GETKEYBOARDDELAY"},
+		{Val: SPI_SETKEYBOARDDELAY, Name: "SPI_SETKEYBOARDDELAY"},
+		{Val: SPI_ICONVERTICALSPACING, Name: "SPI_ICONVERTICALSPACING"},
+		{Val: SPI_GETICONTITLEWRAP, Name: "SPI_GETICONTITLEWRAP"},
+		{Val: SPI_SETICONTITLEWRAP, Name: "SPI_SETICONTITLEWRAP"},
+		{Val: SPI_GETMENUDROPALIGNMENT, Name: "SPI_GETMENUDROPALIGNMENT"},
+		{Val: SPI_SETMENUDROPALIGNMENT, Name: "SPI_SETMENUDROPALIGNMENT"},
+		{Val: SPI_SETDOUBLECLKWIDTH, Name: "SPI_SETDOUBLECLKWIDTH"},
+		{Val: SPI_SETDOUBLECLKHEIGHT, Name: "SPI_SETDOUBLECLKHEIGHT"},
+		{Val: SPI_GETICONTITLELOGFONT, Name: "SPI_GETICONTITLELOGFONT"},
+		{Val: SPI_SETDOUBLECLICKTIME, Name: "SPI_SETDOUBLECLICKTIME"},
+		{Val: SPI_SETMOUSEBUTTONSWAP, Name: "SPI_SETMOUSEBUTTONSWAP"},
+		{Val: SPI_SETICONTITLELOGFONT, Name: "SPI_SETICONTITLELOGFONT"},
+		{Val: SPI_GETFASTTASKSWITCH, Name: "SPI_GETFASTTASKSWITCH"},
+		{Val: SPI_SETFASTTASKSWITCH, Name: "SPI_SETFASTTASKSWITCH"},
+		{Val: SPI_SETDRAGFULLWINDOWS, Name: "SPI_SETDRAGFULLWINDOWS"},
+		{Val: SPI_GETDRAGFULLWINDOWS, Name: "SPI_GETDRAGFULLWINDOWS"},
+		{Val: SPI_GETNONCLIENTMETRICS, Name: "SPI_GETNONCLIENTMETRICS"},
+		{Val: SPI_SETNONCLIENTMETRICS, Name: "SPI_SETNONCLIENTMETRICS"},
+		{Val: SPI_GETMINIMIZEDMETRICS, Name: "SPI_GETMINIMIZEDMETRICS"},
+		{Val: SPI_SETMINIMIZEDMETRICS, Name: "SPI_SETMINIMIZEDMETRICS"},
+		{Val: SPI_GETICONMETRICS, Name: "SPI_GETICONMETRICS"},
+		{Val: SPI_SETICONMETRICS, Name: "SPI_SETICONMETRICS"},
+		{Val: SPI_SETWORKAREA, Name: "SPI_SETWORKAREA"},
+		{Val: SPI_GETWORKAREA, Name: "SPI_GETWORKAREA"},
+		{Val: SPI_SETPENWINDOWS, Name: "SPI_SETPENWINDOWS"},
+		{Val: SPI_GETHIGHCONTRAST, Name: "SPI_GETHIGHCONTRAST"},
+		{Val: SPI_SETHIGHCONTRAST, Name: "SPI_SETHIGHCONTRAST"},
+		{Val: SPI_GETKEYBOARDPREF, Name: "SPI_GETKEYBOARDPREF"},
+		{Val: SPI_SETKEYBOARDPREF, Name: "SPI_SETKEYBOARDPREF"},
+		{Val: SPI_GETSCREENREADER, Name: "SPI_GETSCREENREADER"},
+		{Val: SPI_SETSCREENREADER, Name: "SPI_SETSCREENREADER"},
+		{Val: SPI_GETANIMATION, Name: "SPI_GETANIMATION"},
+		{Val: SPI_SETANIMATION, Name: "SPI_SETANIMATION"},
+		{Val: SPI_GETFONTSMOOTHING, Name: "SPI_GETFONTSMOOTHING"},
+		{Val: SPI_SETFONTSMOOTHING, Name: "SPI_SETFONTSMOOTHING"},
+		{Val: SPI_SETDRAGWIDTH, Name: "SPI_SETDRAGWIDTH"},
+		{Val: SPI_SETDRAGHEIGHT, Name: "SPI_SETDRAGHEIGHT"},
+		{Val: SPI_SETHANDHELD, Name: "SPI_SETHANDHELD"},
+		{Val: SPI_GETLOWPOWERTIMEOUT, Name: "SPI_GETLOWPOWERTIMEOUT"},
+		{Val: SPI_GETPOWEROFFTIMEOUT, Name: "SPI_GETPOWEROFFTIMEOUT"},
+		{Val: SPI_SETLOWPOWERTIMEOUT, Name: "SPI_SETLOWPOWERTIMEOUT"},
+		{Val: SPI_SETPOWEROFFTIMEOUT, Name: "SPI_SETPOWEROFFTIMEOUT"},
+		{Val: SPI_GETLOWPOWERACTIVE, Name: "SPI_GETLOWPOWERACTIVE"},
+		{Val: SPI_GETPOWEROFFACTIVE, Name: "SPI_GETPOWEROFFACTIVE"},
+		{Val: SPI_SETLOWPOWERACTIVE, Name: "SPI_SETLOWPOWERACTIVE"},
+		{Val: SPI_SETPOWEROFFACTIVE, Name: "SPI_SETPOWEROFFACTIVE"},
+		{Val: SPI_SETCURSORS, Name: "SPI_SETCURSORS"},
+		{Val: SPI_SETICONS, Name: "SPI_SETICONS"},
+		{Val: SPI_GETDEFAULTINPUTLANG, Name: "SPI_GETDEFAULTINPUTLANG"},
+		{Val: SPI_SETDEFAULTINPUTLANG, Name: "SPI_SETDEFAULTINPUTLANG"},
+		{Val: SPI_SETLANGTOGGLE, Name: "SPI_SETLANGTOGGLE"},
+		{Val: SPI_GETWINDOWSEXTENSION, Name: "SPI_GETWINDOWSEXTENSION"},
+		{Val: SPI_SETMOUSETRAILS, Name: "SPI_SETMOUSETRAILS"},
+		{Val: SPI_GETMOUSETRAILS, Name: "SPI_GETMOUSETRAILS"},
+		{Val: SPI_SETSCREENSAVERRUNNING, Name: "SPI_SETSCREENSAVERRUNNING"},
+		{Val: SPI_GETFILTERKEYS, Name: "SPI_GETFILTERKEYS"},
+		{Val: SPI_SETFILTERKEYS, Name: "SPI_SETFILTERKEYS"},
+		{Val: SPI_GETTOGGLEKEYS, Name: "SPI_GETTOGGLEKEYS"},
+		{Val: SPI_SETTOGGLEKEYS, Name: "SPI_SETTOGGLEKEYS"},
+		{Val: SPI_GETMOUSEKEYS, Name: "SPI_GETMOUSEKEYS"},
+		{Val: SPI_SETMOUSEKEYS, Name: "SPI_SETMOUSEKEYS"},
+		{Val: SPI_GETSHOWSOUNDS, Name: "SPI_GETSHOWSOUNDS"},
+		{Val: SPI_SETSHOWSOUNDS, Name: "SPI_SETSHOWSOUNDS"},
+		{Val: SPI_GETSTICKYKEYS, Name: "SPI_GETSTICKYKEYS"},
+		{Val: SPI_SETSTICKYKEYS, Name: "SPI_SETSTICKYKEYS"},
+		{Val: SPI_GETACCESSTIMEOUT, Name: "SPI_GETACCESSTIMEOUT"},
+		{Val: SPI_SETACCESSTIMEOUT, Name: "SPI_SETACCESSTIMEOUT"},
+		{Val: SPI_GETSERIALKEYS, Name: "SPI_GETSERIALKEYS"},
+		{Val: SPI_SETSERIALKEYS, Name: "SPI_SETSERIALKEYS"},
+		{Val: SPI_GETSOUNDSENTRY, Name: "SPI_GETSOUNDSENTRY"},
+		{Val: SPI_SETSOUNDSENTRY, Name: "SPI_SETSOUNDSENTRY"},
+		{Val: SPI_GETSNAPTODEFBUTTON, Name: "SPI_GETSNAPTODEFBUTTON"},
+		{Val: SPI_SETSNAPTODEFBUTTON, Name: "SPI_SETSNAPTODEFBUTTON"},
+		{Val: SPI_GETMOUSEHOVERWIDTH, Name: "SPI_GETMOUSEHOVERWIDTH"},
+		{Val: SPI_SETMOUSEHOVERWIDTH, Name: "SPI_SETMOUSEHOVERWIDTH"},
+		{Val: SPI_GETMOUSEHOVERHEIGHT, Name: "SPI_GETMOUSEHOVERHEIGHT"},
+		{Val: SPI_SETMOUSEHOVERHEIGHT, Name: "SPI_SETMOUSEHOVERHEIGHT"},
+		{Val: SPI_GETMOUSEHOVERTIME, Name: "SPI_GETMOUSEHOVERTIME"},
+		{Val: SPI_SETMOUSEHOVERTIME, Name: "SPI_SETMOUSEHOVERTIME"},
+		{Val: SPI_GETWHEELSCROLLLINES, Name: "SPI_GETWHEELSCROLLLINES"},
+		{Val: SPI_SETWHEELSCROLLLINES, Name: "SPI_SETWHEELSCROLLLINES"},
+		{Val: SPI_GETMENUSHOWDELAY, Name: "SPI_GETMENUSHOWDELAY"},
+		{Val: SPI_SETMENUSHOWDELAY, Name: "SPI_SETMENUSHOWDELAY"},
+		{Val: SPI_GETWHEELSCROLLCHARS, Name: "SPI_GETWHEELSCROLLCHARS"},
+		{Val: SPI_SETWHEELSCROLLCHARS, Name: "SPI_SETWHEELSCROLLCHARS"},
+		{Val: SPI_GETSHOWIMEUI, Name: "SPI_GETSHOWIMEUI"},
+		{Val: SPI_SETSHOWIMEUI, Name: "SPI_SETSHOWIMEUI"},
+		{Val: SPI_GETMOUSESPEED, Name: "SPI_GETMOUSESPEED"},
+		{Val: SPI_SETMOUSESPEED, Name: "SPI_SETMOUSESPEED"},
+		{Val: SPI_GETSCREENSAVERRUNNING, Name: "SPI_GETSCREENSAVERRUNNING"},
+		{Val: SPI_GETDESKWALLPAPER, Name: "SPI_GETDESKWALLPAPER"},
+		{Val: SPI_GETAUDIODESCRIPTION, Name: "SPI_GETAUDIODESCRIPTION"},
+		{Val: SPI_SETAUDIODESCRIPTION, Name: "SPI_SETAUDIODESCRIPTION"},
+		{Val: SPI_GETSCREENSAVESECURE, Name: "SPI_GETSCREENSAVESECURE"},
+		{Val: SPI_SETSCREENSAVESECURE, Name: "SPI_SETSCREENSAVESECURE"},
+		{Val: SPI_GETHUNGAPPTIMEOUT, Name: "SPI_GETHUNGAPPTIMEOUT"},
+		{Val: SPI_SETHUNGAPPTIMEOUT, Name: "SPI_SETHUNGAPPTIMEOUT"},
+		{Val: SPI_GETWAITTOKILLTIMEOUT, Name: "SPI_GETWAITTOKILLTIMEOUT"},
+		{Val: SPI_SETWAITTOKILLTIMEOUT, Name: "SPI_SETWAITTOKILLTIMEOUT"},
+		{Val: SPI_GETWAITTOKILLSERVICETIMEOUT, Name: "SPI_GETWAITTOKILLSERVICETIMEOUT"},
+		{Val: SPI_SETWAITTOKILLSERVICETIMEOUT, Name: "SPI_SETWAITTOKILLSERVICETIMEOUT"},
+		{Val: SPI_GETMOUSEDOCKTHRESHOLD, Name: "SPI_GETMOUSEDOCKTHRESHOLD"},
+		{Val: SPI_SETMOUSEDOCKTHRESHOLD, Name: "SPI_SETMOUSEDOCKTHRESHOLD"},
+		{Val: SPI_GETPENDOCKTHRESHOLD, Name: "SPI_GETPENDOCKTHRESHOLD"},
+		{Val: SPI_SETPENDOCKTHRESHOLD, Name: "SPI_SETPENDOCKTHRESHOLD"},
+		{Val: SPI_GETWINARRANGING, Name: "SPI_GETWINARRANGING"},
+		{Val: SPI_SETWINARRANGING, Name: "SPI_SETWINARRANGING"},
+		{Val: SPI_GETMOUSEDRAGOUTTHRESHOLD, Name: "SPI_GETMOUSEDRAGOUTTHRESHOLD"},
+		{Val: SPI_SETMOUSEDRAGOUTTHRESHOLD, Name: "SPI_SETMOUSEDRAGOUTTHRESHOLD"},
+		{Val: SPI_GETPENDRAGOUTTHRESHOLD, Name: "SPI_GETPENDRAGOUTTHRESHOLD"},
+		{Val: SPI_SETPENDRAGOUTTHRESHOLD, Name: "SPI_SETPENDRAGOUTTHRESHOLD"},
+		{Val: SPI_GETMOUSESIDEMOVETHRESHOLD, Name: "SPI_GETMOUSESIDEMOVETHRESHOLD"},
+		{Val: SPI_SETMOUSESIDEMOVETHRESHOLD, Name: "SPI_SETMOUSESIDEMOVETHRESHOLD"},
+		{Val: SPI_GETPENSIDEMOVETHRESHOLD, Name: "SPI_GETPENSIDEMOVETHRESHOLD"},
+		{Val: SPI_SETPENSIDEMOVETHRESHOLD, Name: "SPI_SETPENSIDEMOVETHRESHOLD"},
+		{Val: SPI_GETDRAGFROMMAXIMIZE, Name: "SPI_GETDRAGFROMMAXIMIZE"},
+		{Val: SPI_SETDRAGFROMMAXIMIZE, Name: "SPI_SETDRAGFROMMAXIMIZE"},
+		{Val: SPI_GETSNAPSIZING, Name: "SPI_GETSNAPSIZING"},
+		{Val: SPI_SETSNAPSIZING, Name: "SPI_SETSNAPSIZING"},
+		{Val: SPI_GETDOCKMOVING, Name: "SPI_GETDOCKMOVING"},
+		{Val: SPI_SETDOCKMOVING, Name: "SPI_SETDOCKMOVING"},
+		{Val: SPI_SCREENSAVERRUNNING, Name: "SPI_SCREENSAVERRUNNING"},
+	},
+}
+
+// Returns the name of the constant, like "SPI_GETBEEP".
+func (v SPI) String() string {
+	return _SPI_names.String(v)
+}
+
+// Parses the name of a [SPI] constant, or a number.
+func ParseSPI(s string) (SPI, error) {
+	return _SPI_names.Parse(s)
+}
+
+var _SPIF_names = enum.Flags[SPIF]{
+	Type: "SPIF",
+	Values: []enum.Name[SPIF]{
+		{Val: SPIF_UPDATEINIFILE, Name: "SPIF_UPDATEINIFILE"},
+		{Val: SPIF_SENDWININICHANGE, Name: "SPIF_SENDWININICHANGE"},
+		{Val: SPIF_SENDCHANGE, Name: "SPIF_SENDCHANGE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SPIF_UPDATEINIFILE|SPIF_SENDWININICHANGE".
+func (v SPIF) String() string {
+	return _SPIF_names.String(v)
+}
+
+// Parses names of [SPIF] flags joined by "|", or numbers.
+func ParseSPIF(s string) (SPIF, error) {
+	return _SPIF_names.Parse(s)
+}
+
+var _SS_names = enum.Flags[SS]{
+	Type: "SS",
+	Values: []enum.Name[SS]{
+		{Val: SS_ENHMETAFILE, Name: "SS_ENHMETAFILE"},
+		{Val: SS_BLACKFRAME, Name: "SS_BLACKFRAME"},
+		{Val: SS_SIMPLE, Name: "SS_SIMPLE"},
+		{Val: SS_OWNERDRAW, Name: "SS_OWNERDRAW"},
+		{Val: SS_BITMAP, Name: "SS_BITMAP"},
+		{Val: SS_ICON, Name: "SS_ICON"},
+		{Val: SS_GRAYRECT, Name: "SS_GRAYRECT"},
+		{Val: SS_WHITERECT, Name: "SS_WHITERECT"},
+		{Val: SS_WHITEFRAME, Name: "SS_WHITEFRAME"},
+		{Val: SS_USERITEM, Name: "SS_USERITEM"},
+		{Val: SS_LEFTNOWORDWRAP, Name: "SS_LEFTNOWORDWRAP"},
+		{Val: SS_ETCHEDVERT, Name: "SS_ETCHEDVERT"},
+		{Val: SS_ETCHEDFRAME, Name: "SS_ETCHEDFRAME"},
+		{Val: SS_WORDELLIPSIS, Name: "SS_WORDELLIPSIS"},
+		{Val: SS_CENTER, Name: "SS_CENTER"},
+		{Val: SS_RIGHT, Name: "SS_RIGHT"},
+		{Val: SS_BLACKRECT, Name: "SS_BLACKRECT"},
+		{Val: SS_GRAYFRAME, Name: "SS_GRAYFRAME"},
+		{Val: SS_ETCHEDHORZ, Name: "SS_ETCHEDHORZ"},
+		{Val: SS_REALSIZECONTROL, Name: "SS_REALSIZECONTROL"},
+		{Val: SS_NOPREFIX, Name: "SS_NOPREFIX"},
+		{Val: SS_NOTIFY, Name: "SS_NOTIFY"},
+		{Val: SS_CENTERIMAGE, Name: "SS_CENTERIMAGE"},
+		{Val: SS_RIGHTJUST, Name: "SS_RIGHTJUST"},
+		{Val: SS_REALSIZEIMAGE, Name: "SS_REALSIZEIMAGE"},
+		{Val: SS_SUNKEN, Name: "SS_SUNKEN"},
+		{Val: SS_EDITCONTROL, Name: "SS_EDITCONTROL"},
+		{Val: SS_ENDELLIPSIS, Name: "SS_ENDELLIPSIS"},
+		{Val: SS_PATHELLIPSIS, Name: "SS_PATHELLIPSIS"},
+		{Val: SS_LEFT, Name: "SS_LEFT"},
+	},
+	ParseOnly: []enum.Name[SS]{
+		{Val: SS_TYPEMASK, Name: "SS_TYPEMASK"},
+		{Val: SS_ELLIPSISMASK, Name: "SS_ELLIPSISMASK"},
+	},
+	Fields: []SS{0x1f, 0xc000},
+}
+
+// Returns the names of the flags joined by "|", like "SS_REALSIZECONTROL|SS_NOPREFIX".
+func (v SS) String() string {
+	return _SS_names.String(v)
+}
+
+// Parses names of [SS] flags joined by "|", or numbers.
+func ParseSS(s string) (SS, error) {
+	return _SS_names.Parse(s)
+}
+
+var _SW_names = enum.Enum[SW]{
+	Type: "SW",
+	Values: []enum.Name[SW]{
+		{Val: SW_HIDE, Name: "SW_HIDE"},
+		{Val: SW_SHOWNORMAL, Name: "SW_SHOWNORMAL"},
+		{Val: SW_SHOWMINIMIZED, Name: "SW_SHOWMINIMIZED"},
+		{Val: SW_SHOWMAXIMIZED, Name: "SW_SHOWMAXIMIZED"},
+		{Val: SW_MAXIMIZE, Name: "SW_MAXIMIZE"},
+		{Val: SW_SHOWNOACTIVATE, Name: "SW_SHOWNOACTIVATE"},
+		{Val: SW_SHOW, Name: "SW_SHOW"},
+		{Val: SW_MINIMIZE, Name: "SW_MINIMIZE"},
+		{Val: SW_SHOWMINNOACTIVE, Name: "SW_SHOWMINNOACTIVE"},
+		{Val: SW_SHOWNA, Name: "SW_SHOWNA"},
+		{Val: SW_RESTORE, Name: "SW_RESTORE"},
+		{Val: SW_SHOWDEFAULT, Name: "SW_SHOWDEFAULT"},
+		{Val: SW_FORCEMINIMIZE, Name: "SW_FORCEMINIMIZE"},
+	},
+}
+
+// Returns the name of the constant, like "SW_HIDE".
+func (v SW) String() string {
+	return _SW_names.String(v)
+}
+
+// Parses the name of a [SW] constant, or a number.
+func ParseSW(s string) (SW, error) {
+	return _SW_names.Parse(s)
+}
+
+var _SWP_names = enum.Flags[SWP]{
+	Type: "SWP",
+	Values: []enum.Name[SWP]{
+		{Val: SWP_NOSIZE, Name: "SWP_NOSIZE"},
+		{Val: SWP_NOMOVE, Name: "SWP_NOMOVE"},
+		{Val: SWP_NOZORDER, Name: "SWP_NOZORDER"},
+		{Val: SWP_NOREDRAW, Name: "SWP_NOREDRAW"},
+		{Val: SWP_NOACTIVATE, Name: "SWP_NOACTIVATE"},
+		{Val: SWP_FRAMECHANGED, Name: "SWP_FRAMECHANGED"},
+		{Val: SWP_SHOWWINDOW, Name: "SWP_SHOWWINDOW"},
+		{Val: SWP_HIDEWINDOW, Name: "SWP_HIDEWINDOW"},
+		{Val: SWP_NOCOPYBITS, Name: "SWP_NOCOPYBITS"},
+		{Val: SWP_NOOWNERZORDER, Name: "SWP_NOOWNERZORDER"},
+		{Val: SWP_NOSENDCHANGING, Name: "SWP_NOSENDCHANGING"},
+		{Val: SWP_DEFERERASE, Name: "SWP_DEFERERASE"},
+		{Val: SWP_ASYNCWINDOWPOS, Name: "SWP_ASYNCWINDOWPOS"},
+		{Val: SWP_DRAWFRAME, Name: "SWP_DRAWFRAME"},
+		{Val: SWP_NOREPOSITION, Name: "SWP_NOREPOSITION"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SWP_NOSIZE|SWP_NOMOVE".
+func (v SWP) String() string {
+	return _SWP_names.String(v)
+}
+
+// Parses names of [SWP] flags joined by "|", or numbers.
+func ParseSWP(s string) (SWP, error) {
+	return _SWP_names.Parse(s)
+}
+
+var _SWS_names = enum.Enum[SWS]{
+	Type: "SWS",
+	Values: []enum.Name[SWS]{
+		{Val: SWS_OTHERUNZOOM, Name: "SWS_OTHERUNZOOM"},
+		{Val: SWS_OTHERZOOM, Name: "SWS_OTHERZOOM"},
+		{Val: SWS_PARENTCLOSING, Name: "SWS_PARENTCLOSING"},
+		{Val: SWS_PARENTOPENING, Name: "SWS_PARENTOPENING"},
+	},
+}
+
+// Returns the name of the constant, like "SWS_OTHERUNZOOM".
+func (v SWS) String() string {
+	return _SWS_names.String(v)
+}
+
+// Parses the name of a [SWS] constant, or a number.
+func ParseSWS(s string) (SWS, error) {
+	return _SWS_names.Parse(s)
+}
+
+var _TB_REQ_names = enum.Enum[TB_REQ]{
+	Type: "TB_REQ",
+	Values: []enum.Name[TB_REQ]{
+		{Val: TB_REQ_LINEUP, Name: "TB_REQ_LINEUP"},
+		{Val: TB_REQ_LINEDOWN, Name: "TB_REQ_LINEDOWN"},
+		{Val: TB_REQ_PAGEUP, Name: "TB_REQ_PAGEUP"},
+		{Val: TB_REQ_PAGEDOWN, Name: "TB_REQ_PAGEDOWN"},
+		{Val: TB_REQ_THUMBPOSITION, Name: "TB_REQ_THUMBPOSITION"},
+		{Val: TB_REQ_THUMBTRACK, Name: "TB_REQ_THUMBTRACK"},
+		{Val: TB_REQ_TOP, Name: "TB_REQ_TOP"},
+		{Val: TB_REQ_BOTTOM, Name: "TB_REQ_BOTTOM"},
+		{Val: TB_REQ_ENDTRACK, Name: "TB_REQ_ENDTRACK"},
+	},
+}
+
+// Returns the name of the constant, like "TB_REQ_LINEUP".
+func (v TB_REQ) String() string {
+	return _TB_REQ_names.String(v)
+}
+
+// Parses the name of a [TB_REQ] constant, or a number.
+func ParseTB_REQ(s string) (TB_REQ, error) {
+	return _TB_REQ_names.Parse(s)
+}
+
+var _TME_names = enum.Flags[TME]{
+	Type: "TME",
+	Values: []enum.Name[TME]{
+		{Val: TME_CANCEL, Name: "TME_CANCEL"},
+		{Val: TME_HOVER, Name: "TME_HOVER"},
+		{Val: TME_LEAVE, Name: "TME_LEAVE"},
+		{Val: TME_NONCLIENT, Name: "TME_NONCLIENT"},
+		{Val: TME_QUERYTME, Name: "TME_QUERYTME"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TME_CANCEL|TME_HOVER".
+func (v TME) String() string {
+	return _TME_names.String(v)
+}
+
+// Parses names of [TME] flags joined by "|", or numbers.
+func ParseTME(s string) (TME, error) {
+	return _TME_names.Parse(s)
+}
+
+var _TPM_names = enum.Flags[TPM]{
+	Type: "TPM",
+	Values: []enum.Name[TPM]{
+		{Val: TPM_RIGHTBUTTON, Name: "TPM_RIGHTBUTTON"},
+		{Val: TPM_CENTERALIGN, Name: "TPM_CENTERALIGN"},
+		{Val: TPM_RIGHTALIGN, Name: "TPM_RIGHTALIGN"},
+		{Val: TPM_VCENTERALIGN, Name: "TPM_VCENTERALIGN"},
+		{Val: TPM_BOTTOMALIGN, Name: "TPM_BOTTOMALIGN"},
+		{Val: TPM_VERTICAL, Name: "TPM_VERTICAL"},
+		{Val: TPM_NONOTIFY, Name: "TPM_NONOTIFY"},
+		{Val: TPM_RETURNCMD, Name: "TPM_RETURNCMD"},
+		{Val: TPM_RECURSE, Name: "TPM_RECURSE"},
+		{Val: TPM_HORPOSANIMATION, Name: "TPM_HORPOSANIMATION"},
+		{Val: TPM_HORNEGANIMATION, Name: "TPM_HORNEGANIMATION"},
+		{Val: TPM_VERPOSANIMATION, Name: "TPM_VERPOSANIMATION"},
+		{Val: TPM_VERNEGANIMATION, Name: "TPM_VERNEGANIMATION"},
+		{Val: TPM_NOANIMATION, Name: "TPM_NOANIMATION"},
+		{Val: TPM_LAYOUTRTL, Name: "TPM_LAYOUTRTL"},
+		{Val: TPM_WORKAREA, Name: "TPM_WORKAREA"},
+		{Val: TPM_LEFTBUTTON, Name: "TPM_LEFTBUTTON"},
+		{Val: TPM_LEFTALIGN, Name: "TPM_LEFTALIGN"},
+		{Val: TPM_TOPALIGN, Name: "TPM_TOPALIGN"},
+		{Val: TPM_HORIZONTAL, Name: "TPM_HORIZONTAL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TPM_RIGHTBUTTON|TPM_CENTERALIGN".
+func (v TPM) String() string {
+	return _TPM_names.String(v)
+}
+
+// Parses names of [TPM] flags joined by "|", or numbers.
+func ParseTPM(s string) (TPM, error) {
+	return _TPM_names.Parse(s)
+}
+
+var _UOI_names = enum.Enum[UOI]{
+	Type: "UOI",
+	Values: []enum.Name[UOI]{
+		{Val: UOI_FLAGS, Name: "UOI_FLAGS"},
+		{Val: UOI_TIMERPROC_EXCEPTION_SUPPRESSION, Name: "UOI_TIMERPROC_EXCEPTION_SUPPRESSION"},
+	},
+}
+
+// Returns the name of the constant, like "UOI_FLAGS".
+func (v UOI) String() string {
+	return _UOI_names.String(v)
+}
+
+// Parses the name of a [UOI] constant, or a number.
+func ParseUOI(s string) (UOI, error) {
+	return _UOI_names.Parse(s)
+}
+
+var _VK_names = enum.Enum[VK]{
+	Type: "VK",
+	Values: []enum.Name[VK]{
+		{Val: VK_LBUTTON, Name: "VK_LBUTTON"},
+		{Val: VK_RBUTTON, Name: "VK_RBUTTON"},
+		{Val: VK_CANCEL, Name: "VK_CANCEL"},
+		{Val: VK_MBUTTON, Name: "VK_MBUTTON"},
+		{Val: VK_XBUTTON1, Name: "VK_XBUTTON1"},
+		{Val: VK_XBUTTON2, Name: "VK_XBUTTON2"},
+		{Val: VK_BACK, Name: "VK_BACK"},
+		{Val: VK_TAB, Name: "VK_TAB"},
+		{Val: VK_CLEAR, Name: "VK_CLEAR"},
+		{Val: VK_RETURN, Name: "VK_RETURN"},
+		{Val: VK_SHIFT, Name: "VK_SHIFT"},
+		{Val: VK_CONTROL, Name: "VK_CONTROL"},
+		{Val: VK_MENU, Name: "VK_MENU"},
+		{Val: VK_PAUSE, Name: "VK_PAUSE"},
+		{Val: VK_CAPITAL, Name: "VK_CAPITAL"},
+		{Val: VK_KANA, Name: "VK_KANA"},
+		{Val: VK_HANGEUL, Name: "VK_HANGEUL"},
+		{Val: VK_HANGUL, Name: "VK_HANGUL"},
+		{Val: VK_JUNJA, Name: "VK_JUNJA"},
+		{Val: VK_FINAL, Name: "VK_FINAL"},
+		{Val: VK_HANJA, Name: "VK_HANJA"},
+		{Val: VK_KANJI, Name: "VK_KANJI"},
+		{Val: VK_ESCAPE, Name: "VK_ESCAPE"},
+		{Val: VK_CONVERT, Name: "VK_CONVERT"},
+		{Val: VK_NONCONVERT, Name: "VK_NONCONVERT"},
+		{Val: VK_ACCEPT, Name: "VK_ACCEPT"},
+		{Val: VK_MODECHANGE, Name: "VK_MODECHANGE"},
+		{Val: VK_SPACE, Name: "VK_SPACE"},
+		{Val: VK_PRIOR, Name: "VK_PRIOR"},
+		{Val: VK_NEXT, Name: "VK_NEXT"},
+		{Val: VK_END, Name: "VK_END"},
+		{Val: VK_HOME, Name: "VK_HOME"},
+		{Val: VK_LEFT, Name: "VK_LEFT"},
+		{Val: VK_UP, Name: "VK_UP"},
+		{Val: VK_RIGHT, Name: "VK_RIGHT"},
+		{Val: VK_DOWN, Name: "VK_DOWN"},
+		{Val: VK_SELECT, Name: "VK_SELECT"},
+		{Val: VK_PRINT, Name: "VK_PRINT"},
+		{Val: VK_EXECUTE, Name: "VK_EXECUTE"},
+		{Val: VK_SNAPSHOT, Name: "VK_SNAPSHOT"},
+		{Val: VK_INSERT, Name: "VK_INSERT"},
+		{Val: VK_DELETE, Name: "VK_DELETE"},
+		{Val: VK_HELP, Name: "VK_HELP"},
+		{Val: VK_0, Name: "VK_0"},
+		{Val: VK_1, Name: "VK_1"},
+		{Val: VK_2, Name: "VK_2"},
+		{Val: VK_3, Name: "VK_3"},
+		{Val: VK_4, Name: "VK_4"},
+		{Val: VK_5, Name: "VK_5"},
+		{Val: VK_6, Name: "VK_6"},
+		{Val: VK_7, Name: "VK_7"},
+		{Val: VK_8, Name: "VK_8"},
+		{Val: VK_9, Name: "VK_9"},
+		{Val: VK_A, Name: "VK_A"},
+		{Val: VK_B, Name: "VK_B"},
+		{Val: VK_C, Name: "VK_C"},
+		{Val: VK_D, Name: "VK_D"},
+		{Val: VK_E, Name: "VK_E"},
+		{Val: VK_F, Name: "VK_F"},
+		{Val: VK_G, Name: "VK_G"},
+		{Val: VK_H, Name: "VK_H"},
+		{Val: VK_I, Name: "VK_I"},
+		{Val: VK_J, Name: "VK_J"},
+		{Val: VK_K, Name: "VK_K"},
+		{Val: VK_L, Name: "VK_L"},
+		{Val: VK_M, Name: "VK_M"},
+		{Val: VK_N, Name: "VK_N"},
+		{Val: VK_O, Name: "VK_O"},
+		{Val: VK_P, Name: "VK_P"},
+		{Val: VK_Q, Name: "VK_Q"},
+		{Val: VK_R, Name: "VK_R"},
+		{Val: VK_S, Name: "VK_S"},
+		{Val: VK_T, Name: "VK_T"},
+		{Val: VK_U, Name: "VK_U"},
+		{Val: VK_V, Name: "VK_V"},
+		{Val: VK_W, Name: "VK_W"},
+		{Val: VK_X, Name: "VK_X"},
+		{Val: VK_Y, Name: "VK_Y"},
+		{Val: VK_Z, Name: "VK_Z"},
+		{Val: VK_LWIN, Name: "VK_LWIN"},
+		{Val: VK_RWIN, Name: "VK_RWIN"},
+		{Val: VK_APPS, Name: "VK_APPS"},
+		{Val: VK_SLEEP, Name: "VK_SLEEP"},
+		{Val: VK_NUMPAD0, Name: "VK_NUMPAD0"},
+		{Val: VK_NUMPAD1, Name: "VK_NUMPAD1"},
+		{Val: VK_NUMPAD2, Name: "VK_NUMPAD2"},
+		{Val: VK_NUMPAD3, Name: "VK_NUMPAD3"},
+		{Val: VK_NUMPAD4, Name: "VK_NUMPAD4"},
+		{Val: VK_NUMPAD5, Name: "VK_NUMPAD5"},
+		{Val: VK_NUMPAD6, Name: "VK_NUMPAD6"},
+		{Val: VK_NUMPAD7, Name: "VK_NUMPAD7"},
+		{Val: VK_NUMPAD8, Name: "VK_NUMPAD8"},
+		{Val: VK_NUMPAD9, Name: "VK_NUMPAD9"},
+		{Val: VK_MULTIPLY, Name: "VK_MULTIPLY"},
+		{Val: VK_ADD, Name: "VK_ADD"},
+		{Val: VK_SEPARATOR, Name: "VK_SEPARATOR"},
+		{Val: VK_SUBTRACT, Name: "VK_SUBTRACT"},
+		{Val: VK_DECIMAL, Name: "VK_DECIMAL"},
+		{Val: VK_DIVIDE, Name: "VK_DIVIDE"},
+		{Val: VK_F1, Name: "VK_F1"},
+		{Val: VK_F2, Name: "VK_F2"},
+		{Val: VK_F3, Name: "VK_F3"},
+		{Val: VK_F4, Name: "VK_F4"},
+		{Val: VK_F5, Name: "VK_F5"},
+		{Val: VK_F6, Name: "VK_F6"},
+		{Val: VK_F7, Name: "VK_F7"},
+		{Val: VK_F8, Name: "VK_F8"},
+		{Val: VK_F9, Name: "VK_F9"},
+		{Val: VK_F10, Name: "VK_F10"},
+		{Val: VK_F11, Name: "VK_F11"},
+		{Val: VK_F12, Name: "VK_F12"},
+		{Val: VK_F13, Name: "VK_F13"},
+		{Val: VK_F14, Name: "VK_F14"},
+		{Val: VK_F15, Name: "VK_F15"},
+		{Val: VK_F16, Name: "VK_F16"},
+		{Val: VK_F17, Name: "VK_F17"},
+		{Val: VK_F18, Name: "VK_F18"},
+		{Val: VK_F19, Name: "VK_F19"},
+		{Val: VK_F20, Name: "VK_F20"},
+		{Val: VK_F21, Name: "VK_F21"},
+		{Val: VK_F22, Name: "VK_F22"},
+		{Val: VK_F23, Name: "VK_F23"},
+		{Val: VK_F24, Name: "VK_F24"},
+		{Val: VK_NUMLOCK, Name: "VK_NUMLOCK"},
+		{Val: VK_SCROLL, Name: "VK_SCROLL"},
+		{Val: VK_OEM_NEC_EQUAL, Name: "VK_OEM_NEC_EQUAL"},
+		{Val: VK_OEM_FJ_JISHO, Name: "VK_OEM_FJ_JISHO"},
+		{Val: VK_OEM_FJ_MASSHOU, Name: "VK_OEM_FJ_MASSHOU"},
+		{Val: VK_OEM_FJ_TOUROKU, Name: "VK_OEM_FJ_TOUROKU"},
+		{Val: VK_OEM_FJ_LOYA, Name: "VK_OEM_FJ_LOYA"},
+		{Val: VK_OEM_FJ_ROYA, Name: "VK_OEM_FJ_ROYA"},
+		{Val: VK_LSHIFT, Name: "VK_LSHIFT"},
+		{Val: VK_RSHIFT, Name: "VK_RSHIFT"},
+		{Val: VK_LCONTROL, Name: "VK_LCONTROL"},
+		{Val: VK_RCONTROL, Name: "VK_RCONTROL"},
+		{Val: VK_LMENU, Name: "VK_LMENU"},
+		{Val: VK_RMENU, Name: "VK_RMENU"},
+		{Val: VK_BROWSER_BACK, Name: "VK_BROWSER_BACK"},
+		{Val: VK_BROWSER_FORWARD, Name: "VK_BROWSER_FORWARD"},
+		{Val: VK_BROWSER_REFRESH, Name: "VK_BROWSER_REFRESH"},
+		{Val: VK_BROWSER_STOP, Name: "VK_BROWSER_STOP"},
+		{Val: VK_BROWSER_SEARCH, Name: "VK_BROWSER_SEARCH"},
+		{Val: VK_BROWSER_FAVORITES, Name: "VK_BROWSER_FAVORITES"},
+		{Val: VK_BROWSER_HOME, Name: "VK_BROWSER_HOME"},
+		{Val: VK_VOLUME_MUTE, Name: "VK_VOLUME_MUTE"},
+		{Val: VK_VOLUME_DOWN, Name: "VK_VOLUME_DOWN"},
+		{Val: VK_VOLUME_UP, Name: "VK_VOLUME_UP"},
+		{Val: VK_MEDIA_NEXT_TRACK, Name: "VK_MEDIA_NEXT_TRACK"},
+		{Val: VK_MEDIA_PREV_TRACK, Name: "VK_MEDIA_PREV_TRACK"},
+		{Val: VK_MEDIA_STOP, Name: "VK_MEDIA_STOP"},
+		{Val: VK_MEDIA_PLAY_PAUSE, Name: "VK_MEDIA_PLAY_PAUSE"},
+		{Val: VK_LAUNCH_MAIL, Name: "VK_LAUNCH_MAIL"},
+		{Val: VK_LAUNCH_MEDIA_SELECT, Name: "VK_LAUNCH_MEDIA_SELECT"},
+		{Val: VK_LAUNCH_APP1, Name: "VK_LAUNCH_APP1"},
+		{Val: VK_LAUNCH_APP2, Name: "VK_LAUNCH_APP2"},
+		{Val: VK_OEM_1, Name: "VK_OEM_1"},
+		{Val: VK_OEM_PLUS, Name: "VK_OEM_PLUS"},
+		{Val: VK_OEM_COMMA, Name: "VK_OEM_COMMA"},
+		{Val: VK_OEM_MINUS, Name: "VK_OEM_MINUS"},
+		{Val: VK_OEM_PERIOD, Name: "VK_OEM_PERIOD"},
+		{Val: VK_OEM_2, Name: "VK_OEM_2"},
+		{Val: VK_OEM_3, Name: "VK_OEM_3"},
+		{Val: VK_OEM_4, Name: "VK_OEM_4"},
+		{Val: VK_OEM_5, Name: "VK_OEM_5"},
+		{Val: VK_OEM_6, Name: "VK_OEM_6"},
+		{Val: VK_OEM_7, Name: "VK_OEM_7"},
+		{Val: VK_OEM_8, Name: "VK_OEM_8"},
+		{Val: VK_OEM_AX, Name: "VK_OEM_AX"},
+		{Val: VK_OEM_102, Name: "VK_OEM_102"},
+		{Val: VK_ICO_HELP, Name: "VK_ICO_HELP"},
+		{Val: VK_ICO_00, Name: "VK_ICO_00"},
+		{Val: VK_PROCESSKEY, Name: "VK_PROCESSKEY"},
+		{Val: VK_ICO_CLEAR, Name: "VK_ICO_CLEAR"},
+		{Val: VK_PACKET, Name: "VK_PACKET"},
+		{Val: VK_OEM_RESET, Name: "VK_OEM_RESET"},
+		{Val: VK_OEM_JUMP, Name: "VK_OEM_JUMP"},
+		{Val: VK_OEM_PA1, Name: "VK_OEM_PA1"},
+		{Val: VK_OEM_PA2, Name: "VK_OEM_PA2"},
+		{Val: VK_OEM_PA3, Name: "VK_OEM_PA3"},
+		{Val: VK_OEM_WSCTRL, Name: "VK_OEM_WSCTRL"},
+		{Val: VK_OEM_CUSEL, Name: "VK_OEM_CUSEL"},
+		{Val: VK_OEM_ATTN, Name: "VK_OEM_ATTN"},
+		{Val: VK_OEM_FINISH, Name: "VK_OEM_FINISH"},
+		{Val: VK_OEM_COPY, Name: "VK_OEM_COPY"},
+		{Val: VK_OEM_AUTO, Name: "VK_OEM_AUTO"},
+		{Val: VK_OEM_ENLW, Name: "VK_OEM_ENLW"},
+		{Val: VK_OEM_BACKTAB, Name: "VK_OEM_BACKTAB"},
+		{Val: VK_ATTN, Name: "VK_ATTN"},
+		{Val: VK_CRSEL, Name: "VK_CRSEL"},
+		{Val: VK_EXSEL, Name: "VK_EXSEL"},
+		{Val: VK_EREOF, Name: "VK_EREOF"},
+		{Val: VK_PLAY, Name: "VK_PLAY"},
+		{Val: VK_ZOOM, Name: "VK_ZOOM"},
+		{Val: VK_NONAME, Name: "VK_NONAME"},
+		{Val: VK_PA1, Name: "VK_PA1"},
+		{Val: VK_OEM_CLEAR, Name: "VK_OEM_CLEAR"},
+	},
+}
+
+// Returns the name of the constant, like "VK_LBUTTON".
+func (v VK) String() string {
+	return _VK_names.String(v)
+}
+
+// Parses the name of a [VK] constant, or a number.
+func ParseVK(s string) (VK, error) {
+	return _VK_names.Parse(s)
+}
+
+var _VP_COMMAND_names = enum.Enum[VP_COMMAND]{
+	Type: "VP_COMMAND",
+	Values: []enum.Name[VP_COMMAND]{
+		{Val: VP_COMMAND_GET, Name: "VP_COMMAND_GET"},
+		{Val: VP_COMMAND_SET, Name: "VP_COMMAND_SET"},
+	},
+}
+
+// Returns the name of the constant, like "VP_COMMAND_GET".
+func (v VP_COMMAND) String() string {
+	return _VP_COMMAND_names.String(v)
+}
+
+// Parses the name of a [VP_COMMAND] constant, or a number.
+func ParseVP_COMMAND(s string) (VP_COMMAND, error) {
+	return _VP_COMMAND_names.Parse(s)
+}
+
+var _VP_CP_CMD_names = enum.Flags[VP_CP_CMD]{
+	Type: "VP_CP_CMD",
+	Values: []enum.Name[VP_CP_CMD]{
+		{Val: VP_CP_CMD_ACTIVATE, Name: "VP_CP_CMD_ACTIVATE"},
+		{Val: VP_CP_CMD_DEACTIVATE, Name: "VP_CP_CMD_DEACTIVATE"},
+		{Val: VP_CP_CMD_CHANGE, Name: "VP_CP_CMD_CHANGE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "VP_CP_CMD_ACTIVATE|VP_CP_CMD_DEACTIVATE".
+func (v VP_CP_CMD) String() string {
+	return _VP_CP_CMD_names.String(v)
+}
+
+// Parses names of [VP_CP_CMD] flags joined by "|", or numbers.
+func ParseVP_CP_CMD(s string) (VP_CP_CMD, error) {
+	return _VP_CP_CMD_names.Parse(s)
+}
+
+var _VP_CP_TYPE_names = enum.Enum[VP_CP_TYPE]{
+	Type: "VP_CP_TYPE",
+	Values: []enum.Name[VP_CP_TYPE]{
+		{Val: VP_CP_TYPE_APS_TRIGGER, Name: "VP_CP_TYPE_APS_TRIGGER"},
+		{Val: VP_CP_TYPE_MACROVISION, Name: "VP_CP_TYPE_MACROVISION"},
+	},
+}
+
+// Returns the name of the constant, like "VP_CP_TYPE_APS_TRIGGER".
+func (v VP_CP_TYPE) String() string {
+	return _VP_CP_TYPE_names.String(v)
+}
+
+// Parses the name of a [VP_CP_TYPE] constant, or a number.
+func ParseVP_CP_TYPE(s string) (VP_CP_TYPE, error) {
+	return _VP_CP_TYPE_names.Parse(s)
+}
+
+var _VP_FLAGS_names = enum.Flags[VP_FLAGS]{
+	Type: "VP_FLAGS",
+	Values: []enum.Name[VP_FLAGS]{
+		{Val: VP_FLAGS_TV_MODE, Name: "VP_FLAGS_TV_MODE"},
+		{Val: VP_FLAGS_TV_STANDARD, Name: "VP_FLAGS_TV_STANDARD"},
+		{Val: VP_FLAGS_FLICKER, Name: "VP_FLAGS_FLICKER"},
+		{Val: VP_FLAGS_OVERSCAN, Name: "VP_FLAGS_OVERSCAN"},
+		{Val: VP_FLAGS_MAX_UNSCALED, Name: "VP_FLAGS_MAX_UNSCALED"},
+		{Val: VP_FLAGS_POSITION, Name: "VP_FLAGS_POSITION"},
+		{Val: VP_FLAGS_BRIGHTNESS, Name: "VP_FLAGS_BRIGHTNESS"},
+		{Val: VP_FLAGS_CONTRAST, Name: "VP_FLAGS_CONTRAST"},
+		{Val: VP_FLAGS_COPYPROTECT, Name: "VP_FLAGS_COPYPROTECT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "VP_FLAGS_TV_MODE|VP_FLAGS_TV_STANDARD".
+func (v VP_FLAGS) String() string {
+	return _VP_FLAGS_names.String(v)
+}
+
+// Parses names of [VP_FLAGS] flags joined by "|", or numbers.
+func ParseVP_FLAGS(s string) (VP_FLAGS, error) {
+	return _VP_FLAGS_names.Parse(s)
+}
+
+var _VP_MODE_names = enum.Enum[VP_MODE]{
+	Type: "VP_MODE",
+	Values: []enum.Name[VP_MODE]{
+		{Val: VP_MODE_WIN_GRAPHICS, Name: "VP_MODE_WIN_GRAPHICS"},
+		{Val: VP_MODE_TV_PLAYBACK, Name: "VP_MODE_TV_PLAYBACK"},
+	},
+}
+
+// Returns the name of the constant, like "VP_MODE_WIN_GRAPHICS".
+func (v VP_MODE) String() string {
+	return _VP_MODE_names.String(v)
+}
+
+// Parses the name of a [VP_MODE] constant, or a number.
+func ParseVP_MODE(s string) (VP_MODE, error) {
+	return _VP_MODE_names.Parse(s)
+}
+
+var _VP_TV_names = enum.Flags[VP_TV]{
+	Type: "VP_TV",
+	Values: []enum.Name[VP_TV]{
+		{Val: VP_TV_STANDARD_NTSC_M, Name: "VP_TV_STANDARD_NTSC_M"},
+		{Val: VP_TV_STANDARD_NTSC_M_J, Name: "VP_TV_STANDARD_NTSC_M_J"},
+		{Val: VP_TV_STANDARD_PAL_B, Name: "VP_TV_STANDARD_PAL_B"},
+		{Val: VP_TV_STANDARD_PAL_D, Name: "VP_TV_STANDARD_PAL_D"},
+		{Val: VP_TV_STANDARD_PAL_H, Name: "VP_TV_STANDARD_PAL_H"},
+		{Val: VP_TV_STANDARD_PAL_I, Name: "VP_TV_STANDARD_PAL_I"},
+		{Val: VP_TV_STANDARD_PAL_M, Name: "VP_TV_STANDARD_PAL_M"},
+		{Val: VP_TV_STANDARD_PAL_N, Name: "VP_TV_STANDARD_PAL_N"},
+		{Val: VP_TV_STANDARD_SECAM_B, Name: "VP_TV_STANDARD_SECAM_B"},
+		{Val: VP_TV_STANDARD_SECAM_D, Name: "VP_TV_STANDARD_SECAM_D"},
+		{Val: VP_TV_STANDARD_SECAM_G, Name: "VP_TV_STANDARD_SECAM_G"},
+		{Val: VP_TV_STANDARD_SECAM_H, Name: "VP_TV_STANDARD_SECAM_H"},
+		{Val: VP_TV_STANDARD_SECAM_K, Name: "VP_TV_STANDARD_SECAM_K"},
+		{Val: VP_TV_STANDARD_SECAM_K1, Name: "VP_TV_STANDARD_SECAM_K1"},
+		{Val: VP_TV_STANDARD_SECAM_L, Name: "VP_TV_STANDARD_SECAM_L"},
+		{Val: VP_TV_STANDARD_WIN_VGA, Name: "VP_TV_STANDARD_WIN_VGA"},
+		{Val: VP_TV_STANDARD_NTSC_433, Name: "VP_TV_STANDARD_NTSC_433"},
+		{Val: VP_TV_STANDARD_PAL_G, Name: "VP_TV_STANDARD_PAL_G"},
+		{Val: VP_TV_STANDARD_PAL_60, Name: "VP_TV_STANDARD_PAL_60"},
+		{Val: VP_TV_STANDARD_SECAM_L1, Name: "VP_TV_STANDARD_SECAM_L1"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "VP_TV_STANDARD_NTSC_M|VP_TV_STANDARD_NTSC_M_J".
+func (v VP_TV) String() string {
+	return _VP_TV_names.String(v)
+}
+
+// Parses names of [VP_TV] flags joined by "|", or numbers.
+func ParseVP_TV(s string) (VP_TV, error) {
+	return _VP_TV_names.Parse(s)
+}
+
+var _WA_names = enum.Enum[WA]{
+	Type: "WA",
+	Values: []enum.Name[WA]{
+		{Val: WA_INACTIVE, Name: "WA_INACTIVE"},
+		{Val: WA_ACTIVE, Name: "WA_ACTIVE"},
+		{Val: WA_CLICKACTIVE, Name: "WA_CLICKACTIVE"},
+	},
+}
+
+// Returns the name of the constant, like "WA_INACTIVE".
+func (v WA) String() string {
+	return _WA_names.String(v)
+}
+
+// Parses the name of a [WA] constant, or a number.
+func ParseWA(s string) (WA, error) {
+	return _WA_names.Parse(s)
+}
+
+var _WDA_names = enum.Enum[WDA]{
+	Type: "WDA",
+	Values: []enum.Name[WDA]{
+		{Val: WDA_NONE, Name: "WDA_NONE"},
+		{Val: WDA_MONITOR, Name: "WDA_MONITOR"},
+		{Val: WDA_EXCLUDEFROMCAPTURE, Name: "WDA_EXCLUDEFROMCAPTURE"},
+	},
+}
+
+// Returns the name of the constant, like "WDA_NONE".
+func (v WDA) String() string {
+	return _WDA_names.String(v)
+}
+
+// Parses the name of a [WDA] constant, or a number.
+func ParseWDA(s string) (WDA, error) {
+	return _WDA_names.Parse(s)
+}
+
+var _WH_names = enum.Enum[WH]{
+	Type: "WH",
+	Values: []enum.Name[WH]{
+		{Val: WH_CALLWNDPROC, Name: "WH_CALLWNDPROC"},
+		{Val: WH_CALLWNDPROCRET, Name: "WH_CALLWNDPROCRET"},
+		{Val: WH_CBT, Name: "WH_CBT"},
+		{Val: WH_DEBUG, Name: "WH_DEBUG"},
+		{Val: WH_FOREGROUNDIDLE, Name: "WH_FOREGROUNDIDLE"},
+		{Val: WH_GETMESSAGE, Name: "WH_GETMESSAGE"},
+		{Val: WH_KEYBOARD, Name: "WH_KEYBOARD"},
+		{Val: WH_KEYBOARD_LL, Name: "WH_KEYBOARD_LL"},
+		{Val: WH_MOUSE, Name: "WH_MOUSE"},
+		{Val: WH_MOUSE_LL, Name: "WH_MOUSE_LL"},
+		{Val: WH_MSGFILTER, Name: "WH_MSGFILTER"},
+		{Val: WH_SHELL, Name: "WH_SHELL"},
+		{Val: WH_SYSMSGFILTER, Name: "WH_SYSMSGFILTER"},
+	},
+}
+
+// Returns the name of the constant, like "WH_CALLWNDPROC".
+func (v WH) String() string {
+	return _WH_names.String(v)
+}
+
+// Parses the name of a [WH] constant, or a number.
+func ParseWH(s string) (WH, error) {
+	return _WH_names.Parse(s)
+}
+
+var _WMPN_names = enum.Enum[WMPN]{
+	Type: "WMPN",
+	Values: []enum.Name[WMPN]{
+		{Val: WMPN_CREATE, Name: "WMPN_CREATE"},
+		{Val: WMPN_DESTROY, Name: "WMPN_DESTROY"},
+		{Val: WMPN_LBUTTONDOWN, Name: "WMPN_LBUTTONDOWN"},
+		{Val: WMPN_MBUTTONDOWN, Name: "WMPN_MBUTTONDOWN"},
+		{Val: WMPN_RBUTTONDOWN, Name: "WMPN_RBUTTONDOWN"},
+		{Val: WMPN_XBUTTONDOWN, Name: "WMPN_XBUTTONDOWN"},
+		{Val: WMPN_POINTERDOWN, Name: "WMPN_POINTERDOWN"},
+	},
+}
+
+// Returns the name of the constant, like "WMPN_CREATE".
+func (v WMPN) String() string {
+	return _WMPN_names.String(v)
+}
+
+// Parses the name of a [WMPN] constant, or a number.
+func ParseWMPN(s string) (WMPN, error) {
+	return _WMPN_names.Parse(s)
+}
+
+var _WMSZ_names = enum.Enum[WMSZ]{
+	Type: "WMSZ",
+	Values: []enum.Name[WMSZ]{
+		{Val: WMSZ_BOTTOM, Name: "WMSZ_BOTTOM"},
+		{Val: WMSZ_BOTTOMLEFT, Name: "WMSZ_BOTTOMLEFT"},
+		{Val: WMSZ_BOTTOMRIGHT, Name: "WMSZ_BOTTOMRIGHT"},
+		{Val: WMSZ_LEFT, Name: "WMSZ_LEFT"},
+		{Val: WMSZ_RIGHT, Name: "WMSZ_RIGHT"},
+		{Val: WMSZ_TOP, Name: "WMSZ_TOP"},
+		{Val: WMSZ_TOPLEFT, Name: "WMSZ_TOPLEFT"},
+		{Val: WMSZ_TOPRIGHT, Name: "WMSZ_TOPRIGHT"},
+	},
+}
+
+// Returns the name of the constant, like "WMSZ_BOTTOM".
+func (v WMSZ) String() string {
+	return _WMSZ_names.String(v)
+}
+
+// Parses the name of a [WMSZ] constant, or a number.
+func ParseWMSZ(s string) (WMSZ, error) {
+	return _WMSZ_names.Parse(s)
+}
+
+var _WPF_names = enum.Flags[WPF]{
+	Type: "WPF",
+	Values: []enum.Name[WPF]{
+		{Val: WPF_ASYNCWINDOWPLACEMENT, Name: "WPF_ASYNCWINDOWPLACEMENT"},
+		{Val: WPF_RESTORETOMAXIMIZED, Name: "WPF_RESTORETOMAXIMIZED"},
+		{Val: WPF_SETMINPOSITION, Name: "WPF_SETMINPOSITION"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WPF_ASYNCWINDOWPLACEMENT|WPF_RESTORETOMAXIMIZED".
+func (v WPF) String() string {
+	return _WPF_names.String(v)
+}
+
+// Parses names of [WPF] flags joined by "|", or numbers.
+func ParseWPF(s string) (WPF, error) {
+	return _WPF_names.Parse(s)
+}
+
+var _WS_names = enum.Flags[WS]{
+	Type: "WS",
+	Values: []enum.Name[WS]{
+		{Val: WS_OVERLAPPEDWINDOW, Name: "WS_OVERLAPPEDWINDOW"},
+		{Val: WS_POPUPWINDOW, Name: "WS_POPUPWINDOW"},
+		{Val: WS_CAPTION, Name: "WS_CAPTION"},
+		{Val: WS_POPUP, Name: "WS_POPUP"},
+		{Val: WS_CHILD, Name: "WS_CHILD"},
+		{Val: WS_MINIMIZE, Name: "WS_MINIMIZE"},
+		{Val: WS_VISIBLE, Name: "WS_VISIBLE"},
+		{Val: WS_DISABLED, Name: "WS_DISABLED"},
+		{Val: WS_CLIPSIBLINGS, Name: "WS_CLIPSIBLINGS"},
+		{Val: WS_CLIPCHILDREN, Name: "WS_CLIPCHILDREN"},
+		{Val: WS_MAXIMIZE, Name: "WS_MAXIMIZE"},
+		{Val: WS_BORDER, Name: "WS_BORDER"},
+		{Val: WS_DLGFRAME, Name: "WS_DLGFRAME"},
+		{Val: WS_VSCROLL, Name: "WS_VSCROLL"},
+		{Val: WS_HSCROLL, Name: "WS_HSCROLL"},
+		{Val: WS_SYSMENU, Name: "WS_SYSMENU"},
+		{Val: WS_THICKFRAME, Name: "WS_THICKFRAME"},
+		{Val: WS_GROUP, Name: "WS_GROUP"},
+		{Val: WS_TABSTOP, Name: "WS_TABSTOP"},
+		{Val: WS_MINIMIZEBOX, Name: "WS_MINIMIZEBOX"},
+		{Val: WS_MAXIMIZEBOX, Name: "WS_MAXIMIZEBOX"},
+		{Val: WS_NONE, Name: "WS_NONE"},
+		{Val: WS_OVERLAPPED, Name: "WS_OVERLAPPED"},
+		{Val: WS_TILEDWINDOW, Name: "WS_TILEDWINDOW"},
+		{Val: WS_ICONIC, Name: "WS_ICONIC"},
+		{Val: WS_SIZEBOX, Name: "WS_SIZEBOX"},
+		{Val: WS_CHILDWINDOW, Name: "WS_CHILDWINDOW"},
+		{Val: WS_TILED, Name: "WS_TILED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WS_POPUP|WS_CHILD".
+func (v WS) String() string {
+	return _WS_names.String(v)
+}
+
+// Parses names of [WS] flags joined by "|", or numbers.
+func ParseWS(s string) (WS, error) {
+	return _WS_names.Parse(s)
+}
+
+var _WS_EX_names = enum.Flags[WS_EX]{
+	Type: "WS_EX",
+	Values: []enum.Name[WS_EX]{
+		{Val: WS_EX_PALETTEWINDOW, Name: "WS_EX_PALETTEWINDOW"},
+		{Val: WS_EX_OVERLAPPEDWINDOW, Name: "WS_EX_OVERLAPPEDWINDOW"},
+		{Val: WS_EX_DLGMODALFRAME, Name: "WS_EX_DLGMODALFRAME"},
+		{Val: WS_EX_NOPARENTNOTIFY, Name: "WS_EX_NOPARENTNOTIFY"},
+		{Val: WS_EX_TOPMOST, Name: "WS_EX_TOPMOST"},
+		{Val: WS_EX_ACCEPTFILES, Name: "WS_EX_ACCEPTFILES"},
+		{Val: WS_EX_TRANSPARENT, Name: "WS_EX_TRANSPARENT"},
+		{Val: WS_EX_MDICHILD, Name: "WS_EX_MDICHILD"},
+		{Val: WS_EX_TOOLWINDOW, Name: "WS_EX_TOOLWINDOW"},
+		{Val: WS_EX_WINDOWEDGE, Name: "WS_EX_WINDOWEDGE"},
+		{Val: WS_EX_CLIENTEDGE, Name: "WS_EX_CLIENTEDGE"},
+		{Val: WS_EX_CONTEXTHELP, Name: "WS_EX_CONTEXTHELP"},
+		{Val: WS_EX_RIGHT, Name: "WS_EX_RIGHT"},
+		{Val: WS_EX_RTLREADING, Name: "WS_EX_RTLREADING"},
+		{Val: WS_EX_LEFTSCROLLBAR, Name: "WS_EX_LEFTSCROLLBAR"},
+		{Val: WS_EX_CONTROLPARENT, Name: "WS_EX_CONTROLPARENT"},
+		{Val: WS_EX_STATICEDGE, Name: "WS_EX_STATICEDGE"},
+		{Val: WS_EX_APPWINDOW, Name: "WS_EX_APPWINDOW"},
+		{Val: WS_EX_LAYERED, Name: "WS_EX_LAYERED"},
+		{Val: WS_EX_NOINHERITLAYOUT, Name: "WS_EX_NOINHERITLAYOUT"},
+		{Val: WS_EX_NOREDIRECTIONBITMAP, Name: "WS_EX_NOREDIRECTIONBITMAP"},
+		{Val: WS_EX_LAYOUTRTL, Name: "WS_EX_LAYOUTRTL"},
+		{Val: WS_EX_COMPOSITED, Name: "WS_EX_COMPOSITED"},
+		{Val: WS_EX_NOACTIVATE, Name: "WS_EX_NOACTIVATE"},
+		{Val: WS_EX_NONE, Name: "WS_EX_NONE"},
+		{Val: WS_EX_LEFT, Name: "WS_EX_LEFT"},
+		{Val: WS_EX_LTRREADING, Name: "WS_EX_LTRREADING"},
+		{Val: WS_EX_RIGHTSCROLLBAR, Name: "WS_EX_RIGHTSCROLLBAR"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WS_EX_DLGMODALFRAME|WS_EX_NOPARENTNOTIFY".
+func (v WS_EX) String() string {
+	return _WS_EX_names.String(v)
+}
+
+// Parses names of [WS_EX] flags joined by "|", or numbers.
+func ParseWS_EX(s string) (WS_EX, error) {
+	return _WS_EX_names.Parse(s)
+}
+
+var _WVR_names = enum.Flags[WVR]{
+	Type: "WVR",
+	Values: []enum.Name[WVR]{
+		{Val: WVR_REDRAW, Name: "WVR_REDRAW"},
+		{Val: WVR_ALIGNTOP, Name: "WVR_ALIGNTOP"},
+		{Val: WVR_ALIGNLEFT, Name: "WVR_ALIGNLEFT"},
+		{Val: WVR_ALIGNBOTTOM, Name: "WVR_ALIGNBOTTOM"},
+		{Val: WVR_ALIGNRIGHT, Name: "WVR_ALIGNRIGHT"},
+		{Val: WVR_HREDRAW, Name: "WVR_HREDRAW"},
+		{Val: WVR_VREDRAW, Name: "WVR_VREDRAW"},
+		{Val: WVR_VALIDRECTS, Name: "WVR_VALIDRECTS"},
+		{Val: WVR_ZERO, Name: "WVR_ZERO"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WVR_ALIGNTOP|WVR_ALIGNLEFT".
+func (v WVR) String() string {
+	return _WVR_names.String(v)
+}
+
+// Parses names of [WVR] flags joined by "|", or numbers.
+func ParseWVR(s string) (WVR, error) {
+	return _WVR_names.Parse(s)
+}
+
+var _GWLP_names = enum.Enum[GWLP]{
+	Type: "GWLP",
+	Values: []enum.Name[GWLP]{
+		{Val: GWLP_WNDPROC, Name: "GWLP_WNDPROC"},
+		{Val: GWLP_HINSTANCE, Name: "GWLP_HINSTANCE"},
+		{Val: GWLP_HWNDPARENT, Name: "GWLP_HWNDPARENT"},
+		{Val: GWLP_ID, Name: "GWLP_ID"},
+		{Val: GWLP_STYLE, Name: "GWLP_STYLE"},
+		{Val: GWLP_EXSTYLE, Name: "GWLP_EXSTYLE"},
+		{Val: GWLP_USERDATA, Name: "GWLP_USERDATA"},
+		{Val: GWLP_DWLP_MSGRESULT, Name: "GWLP_DWLP_MSGRESULT"},
+		{Val: GWLP_DWLP_DLGPROC, Name: "GWLP_DWLP_DLGPROC"},
+		{Val: GWLP_DWLP_USER, Name: "GWLP_DWLP_USER"},
+	},
+}
+
+// Returns the name of the constant, like "GWLP_WNDPROC".
+func (v GWLP) String() string {
+	return _GWLP_names.String(v)
+}
+
+// Parses the name of a [GWLP] constant, or a number.
+func ParseGWLP(s string) (GWLP, error) {
+	return _GWLP_names.Parse(s)
+}
+
+var _CMD_names = enum.Enum[CMD]{
+	Type: "CMD",
+	Values: []enum.Name[CMD]{
+		{Val: CMD_MENU, Name: "CMD_MENU"},
+		{Val: CMD_ACCELERATOR, Name: "CMD_ACCELERATOR"},
+		{Val: BN_CLICKED, Name: "BN_CLICKED"},
+		{Val: BN_PAINT, Name: "BN_PAINT"},
+		{Val: BN_HILITE, Name: "BN_HILITE"},
+		{Val: BN_UNHILITE, Name: "BN_UNHILITE"},
+		{Val: BN_DISABLE, Name: "BN_DISABLE"},
+		{Val: BN_DOUBLECLICKED, Name: "BN_DOUBLECLICKED"},
+		{Val: BN_SETFOCUS, Name: "BN_SETFOCUS"},
+		{Val: BN_KILLFOCUS, Name: "BN_KILLFOCUS"},
+		{Val: CBN_ERRSPACE, Name: "CBN_ERRSPACE"},
+		{Val: CBN_SELCHANGE, Name: "CBN_SELCHANGE"},
+		{Val: CBN_DBLCLK, Name: "CBN_DBLCLK"},
+		{Val: CBN_SETFOCUS, Name: "CBN_SETFOCUS"},
+		{Val: CBN_KILLFOCUS, Name: "CBN_KILLFOCUS"},
+		{Val: CBN_EDITCHANGE, Name: "CBN_EDITCHANGE"},
+		{Val: CBN_EDITUPDATE, Name: "CBN_EDITUPDATE"},
+		{Val: CBN_DROPDOWN, Name: "CBN_DROPDOWN"},
+		{Val: CBN_CLOSEUP, Name: "CBN_CLOSEUP"},
+		{Val: CBN_SELENDOK, Name: "CBN_SELENDOK"},
+		{Val: CBN_SELENDCANCEL, Name: "CBN_SELENDCANCEL"},
+		{Val: EN_SETFOCUS, Name: "EN_SETFOCUS"},
+		{Val: EN_KILLFOCUS, Name: "EN_KILLFOCUS"},
+		{Val: EN_CHANGE, Name: "EN_CHANGE"},
+		{Val: EN_UPDATE, Name: "EN_UPDATE"},
+		{Val: EN_ERRSPACE, Name: "EN_ERRSPACE"},
+		{Val: EN_MAXTEXT, Name: "EN_MAXTEXT"},
+		{Val: EN_HSCROLL, Name: "EN_HSCROLL"},
+		{Val: EN_VSCROLL, Name: "EN_VSCROLL"},
+		{Val: EN_ALIGN_LTR_EC, Name: "EN_ALIGN_LTR_EC"},
+		{Val: EN_ALIGN_RTL_EC, Name: "EN_ALIGN_RTL_EC"},
+		{Val: EN_BEFORE_PASTE, Name: "EN_BEFORE_PASTE"},
+		{Val: EN_AFTER_PASTE, Name: "EN_AFTER_PASTE"},
+		{Val: STN_CLICKED, Name: "STN_CLICKED"},
+		{Val: STN_DBLCLK, Name: "STN_DBLCLK"},
+		{Val: STN_ENABLE, Name: "STN_ENABLE"},
+		{Val: STN_DISABLE, Name: "STN_DISABLE"},
+		{Val: BN_PUSHED, Name: "BN_PUSHED"},
+		{Val: BN_UNPUSHED, Name: "BN_UNPUSHED"},
+		{Val: BN_DBLCLK, Name: "BN_DBLCLK"},
+	},
+}
+
+// Returns the name of the constant, like "CMD_MENU".
+func (v CMD) String() string {
+	return _CMD_names.String(v)
+}
+
+// Parses the name of a [CMD] constant, or a number.
+func ParseCMD(s string) (CMD, error) {
+	return _CMD_names.Parse(s)
+}
+
+var _NM_names = enum.Enum[NM]{
+	Type: "NM",
+	Values: []enum.Name[NM]{
+		{Val: NM_OUTOFMEMORY, Name: "NM_OUTOFMEMORY"},
+		{Val: NM_CLICK, Name: "NM_CLICK"},
+		{Val: NM_DBLCLK, Name: "NM_DBLCLK"},
+		{Val: NM_RETURN, Name: "NM_RETURN"},
+		{Val: NM_RCLICK, Name: "NM_RCLICK"},
+		{Val: NM_RDBLCLK, Name: "NM_RDBLCLK"},
+		{Val: NM_SETFOCUS, Name: "NM_SETFOCUS"},
+		{Val: NM_KILLFOCUS, Name: "NM_KILLFOCUS"},
+		{Val: NM_CUSTOMDRAW, Name: "NM_CUSTOMDRAW"},
+		{Val: NM_HOVER, Name: "NM_HOVER"},
+		{Val: NM_NCHITTEST, Name: "NM_NCHITTEST"},
+		{Val: NM_KEYDOWN, Name: "NM_KEYDOWN"},
+		{Val: NM_RELEASEDCAPTURE, Name: "NM_RELEASEDCAPTURE"},
+		{Val: NM_SETCURSOR, Name: "NM_SETCURSOR"},
+		{Val: NM_CHAR, Name: "NM_CHAR"},
+		{Val: NM_TOOLTIPSCREATED, Name: "NM_TOOLTIPSCREATED"},
+		{Val: NM_LDOWN, Name: "NM_LDOWN"},
+		{Val: NM_RDOWN, Name: "NM_RDOWN"},
+		{Val: NM_THEMECHANGED, Name: "NM_THEMECHANGED"},
+		{Val: NM_FONTCHANGED, Name: "NM_FONTCHANGED"},
+		{Val: NM_CUSTOMTEXT, Name: "NM_CUSTOMTEXT"},
+		{Val: NM_TVSTATEIMAGECHANGING, Name: "NM_TVSTATEIMAGECHANGING"},
+		{Val: BCN_HOTITEMCHANGE, Name: "BCN_HOTITEMCHANGE"},
+		{Val: BCN_DROPDOWN, Name: "BCN_DROPDOWN"},
+		{Val: CBEN_INSERTITEM, Name: "CBEN_INSERTITEM"},
+		{Val: CBEN_DELETEITEM, Name: "CBEN_DELETEITEM"},
+		{Val: CBEN_BEGINEDIT, Name: "CBEN_BEGINEDIT"},
+		{Val: CBEN_ENDEDIT, Name: "CBEN_ENDEDIT"},
+		{Val: CBEN_GETDISPINFO, Name: "CBEN_GETDISPINFO"},
+		{Val: CBEN_DRAGBEGIN, Name: "CBEN_DRAGBEGIN"},
+		{Val: DTN_CLOSEUP, Name: "DTN_CLOSEUP"},
+		{Val: DTN_DROPDOWN, Name: "DTN_DROPDOWN"},
+		{Val: DTN_DATETIMECHANGE, Name: "DTN_DATETIMECHANGE"},
+		{Val: DTN_FORMATQUERY, Name: "DTN_FORMATQUERY"},
+		{Val: DTN_FORMAT, Name: "DTN_FORMAT"},
+		{Val: DTN_WMKEYDOWN, Name: "DTN_WMKEYDOWN"},
+		{Val: DTN_USERSTRING, Name: "DTN_USERSTRING"},
+		{Val: HDN_ITEMCHANGING, Name: "HDN_ITEMCHANGING"},
+		{Val: HDN_ITEMCHANGED, Name: "HDN_ITEMCHANGED"},
+		{Val: HDN_ITEMCLICK, Name: "HDN_ITEMCLICK"},
+		{Val: HDN_ITEMDBLCLICK, Name: "HDN_ITEMDBLCLICK"},
+		{Val: HDN_DIVIDERDBLCLICK, Name: "HDN_DIVIDERDBLCLICK"},
+		{Val: HDN_BEGINTRACK, Name: "HDN_BEGINTRACK"},
+		{Val: HDN_ENDTRACK, Name: "HDN_ENDTRACK"},
+		{Val: HDN_TRACK, Name: "HDN_TRACK"},
+		{Val: HDN_GETDISPINFO, Name: "HDN_GETDISPINFO"},
+		{Val: HDN_BEGINDRAG, Name: "HDN_BEGINDRAG"},
+		{Val: HDN_ENDDRAG, Name: "HDN_ENDDRAG"},
+		{Val: HDN_FILTERCHANGE, Name: "HDN_FILTERCHANGE"},
+		{Val: HDN_FILTERBTNCLICK, Name: "HDN_FILTERBTNCLICK"},
+		{Val: HDN_BEGINFILTEREDIT, Name: "HDN_BEGINFILTEREDIT"},
+		{Val: HDN_ENDFILTEREDIT, Name: "HDN_ENDFILTEREDIT"},
+		{Val: HDN_ITEMSTATEICONCLICK, Name: "HDN_ITEMSTATEICONCLICK"},
+		{Val: HDN_ITEMKEYDOWN, Name: "HDN_ITEMKEYDOWN"},
+		{Val: HDN_DROPDOWN, Name: "HDN_DROPDOWN"},
+		{Val: HDN_OVERFLOWCLICK, Name: "HDN_OVERFLOWCLICK"},
+		{Val: IPN_FIELDCHANGED, Name: "IPN_FIELDCHANGED"},
+		{Val: LVN_ITEMCHANGING, Name: "LVN_ITEMCHANGING"},
+		{Val: LVN_ITEMCHANGED, Name: "LVN_ITEMCHANGED"},
+		{Val: LVN_INSERTITEM, Name: "LVN_INSERTITEM"},
+		{Val: LVN_DELETEITEM, Name: "LVN_DELETEITEM"},
+		{Val: LVN_DELETEALLITEMS, Name: "LVN_DELETEALLITEMS"},
+		{Val: LVN_BEGINLABELEDIT, Name: "LVN_BEGINLABELEDIT"},
+		{Val: LVN_ENDLABELEDIT, Name: "LVN_ENDLABELEDIT"},
+		{Val: LVN_COLUMNCLICK, Name: "LVN_COLUMNCLICK"},
+		{Val: LVN_BEGINDRAG, Name: "LVN_BEGINDRAG"},
+		{Val: LVN_BEGINRDRAG, Name: "LVN_BEGINRDRAG"},
+		{Val: LVN_ODCACHEHINT, Name: "LVN_ODCACHEHINT"},
+		{Val: LVN_ODFINDITEM, Name: "LVN_ODFINDITEM"},
+		{Val: LVN_ITEMACTIVATE, Name: "LVN_ITEMACTIVATE"},
+		{Val: LVN_ODSTATECHANGED, Name: "LVN_ODSTATECHANGED"},
+		{Val: LVN_HOTTRACK, Name: "LVN_HOTTRACK"},
+		{Val: LVN_GETDISPINFO, Name: "LVN_GETDISPINFO"},
+		{Val: LVN_SETDISPINFO, Name: "LVN_SETDISPINFO"},
+		{Val: LVN_KEYDOWN, Name: "LVN_KEYDOWN"},
+		{Val: LVN_MARQUEEBEGIN, Name: "LVN_MARQUEEBEGIN"},
+		{Val: LVN_GETINFOTIP, Name: "LVN_GETINFOTIP"},
+		{Val: LVN_INCREMENTALSEARCH, Name: "LVN_INCREMENTALSEARCH"},
+		{Val: LVN_COLUMNDROPDOWN, Name: "LVN_COLUMNDROPDOWN"},
+		{Val: LVN_COLUMNOVERFLOWCLICK, Name: "LVN_COLUMNOVERFLOWCLICK"},
+		{Val: LVN_BEGINSCROLL, Name: "LVN_BEGINSCROLL"},
+		{Val: LVN_ENDSCROLL, Name: "LVN_ENDSCROLL"},
+		{Val: LVN_LINKCLICK, Name: "LVN_LINKCLICK"},
+		{Val: LVN_GETEMPTYMARKUP, Name: "LVN_GETEMPTYMARKUP"},
+		{Val: MCN_SELCHANGE, Name: "MCN_SELCHANGE"},
+		{Val: MCN_GETDAYSTATE, Name: "MCN_GETDAYSTATE"},
+		{Val: MCN_VIEWCHANGE, Name: "MCN_VIEWCHANGE"},
+		{Val: RBN_HEIGHTCHANGE, Name: "RBN_HEIGHTCHANGE"},
+		{Val: RBN_GETOBJECT, Name: "RBN_GETOBJECT"},
+		{Val: RBN_LAYOUTCHANGED, Name: "RBN_LAYOUTCHANGED"},
+		{Val: RBN_AUTOSIZE, Name: "RBN_AUTOSIZE"},
+		{Val: RBN_BEGINDRAG, Name: "RBN_BEGINDRAG"},
+		{Val: RBN_ENDDRAG, Name: "RBN_ENDDRAG"},
+		{Val: RBN_DELETINGBAND, Name: "RBN_DELETINGBAND"},
+		{Val: RBN_DELETEDBAND, Name: "RBN_DELETEDBAND"},
+		{Val: RBN_CHILDSIZE, Name: "RBN_CHILDSIZE"},
+		{Val: RBN_CHEVRONPUSHED, Name: "RBN_CHEVRONPUSHED"},
+		{Val: RBN_SPLITTERDRAG, Name: "RBN_SPLITTERDRAG"},
+		{Val: RBN_MINMAX, Name: "RBN_MINMAX"},
+		{Val: RBN_AUTOBREAK, Name: "RBN_AUTOBREAK"},
+		{Val: SBN_SIMPLEMODECHANGE, Name: "SBN_SIMPLEMODECHANGE"},
+		{Val: TBN_BEGINDRAG, Name: "TBN_BEGINDRAG"},
+		{Val: TBN_ENDDRAG, Name: "TBN_ENDDRAG"},
+		{Val: TBN_BEGINADJUST, Name: "TBN_BEGINADJUST"},
+		{Val: TBN_ENDADJUST, Name: "TBN_ENDADJUST"},
+		{Val: TBN_RESET, Name: "TBN_RESET"},
+		{Val: TBN_QUERYINSERT, Name: "TBN_QUERYINSERT"},
+		{Val: TBN_QUERYDELETE, Name: "TBN_QUERYDELETE"},
+		{Val: TBN_TOOLBARCHANGE, Name: "TBN_TOOLBARCHANGE"},
+		{Val: TBN_CUSTHELP, Name: "TBN_CUSTHELP"},
+		{Val: TBN_DROPDOWN, Name: "TBN_DROPDOWN"},
+		{Val: TBN_GETOBJECT, Name: "TBN_GETOBJECT"},
+		{Val: TBN_HOTITEMCHANGE, Name: "TBN_HOTITEMCHANGE"},
+		{Val: TBN_DRAGOUT, Name: "TBN_DRAGOUT"},
+		{Val: TBN_DELETINGBUTTON, Name: "TBN_DELETINGBUTTON"},
+		{Val: TBN_GETDISPINFO, Name: "TBN_GETDISPINFO"},
+		{Val: TBN_GETINFOTIP, Name: "TBN_GETINFOTIP"},
+		{Val: TBN_GETBUTTONINFO, Name: "TBN_GETBUTTONINFO"},
+		{Val: TBN_RESTORE, Name: "TBN_RESTORE"},
+		{Val: TBN_SAVE, Name: "TBN_SAVE"},
+		{Val: TBN_INITCUSTOMIZE, Name: "TBN_INITCUSTOMIZE"},
+		{Val: TBN_WRAPHOTITEM, Name: "TBN_WRAPHOTITEM"},
+		{Val: TBN_DUPACCELERATOR, Name: "TBN_DUPACCELERATOR"},
+		{Val: TBN_WRAPACCELERATOR, Name: "TBN_WRAPACCELERATOR"},
+		{Val: TBN_DRAGOVER, Name: "TBN_DRAGOVER"},
+		{Val: TBN_MAPACCELERATOR, Name: "TBN_MAPACCELERATOR"},
+		{Val: TCN_KEYDOWN, Name: "TCN_KEYDOWN"},
+		{Val: TCN_SELCHANGE, Name: "TCN_SELCHANGE"},
+		{Val: TCN_SELCHANGING, Name: "TCN_SELCHANGING"},
+		{Val: TCN_GETOBJECT, Name: "TCN_GETOBJECT"},
+		{Val: TCN_FOCUSCHANGE, Name: "TCN_FOCUSCHANGE"},
+		{Val: TRBN_THUMBPOSCHANGING, Name: "TRBN_THUMBPOSCHANGING"},
+		{Val: TTN_GETDISPINFO, Name: "TTN_GETDISPINFO"},
+		{Val: TTN_SHOW, Name: "TTN_SHOW"},
+		{Val: TTN_POP, Name: "TTN_POP"},
+		{Val: TTN_LINKCLICK, Name: "TTN_LINKCLICK"},
+		{Val: TVN_SELCHANGING, Name: "TVN_SELCHANGING"},
+		{Val: TVN_SELCHANGED, Name: "TVN_SELCHANGED"},
+		{Val: TVN_GETDISPINFO, Name: "TVN_GETDISPINFO"},
+		{Val: TVN_SETDISPINFO, Name: "TVN_SETDISPINFO"},
+		{Val: TVN_ITEMEXPANDING, Name: "TVN_ITEMEXPANDING"},
+		{Val: TVN_ITEMEXPANDED, Name: "TVN_ITEMEXPANDED"},
+		{Val: TVN_BEGINDRAG, Name: "TVN_BEGINDRAG"},
+		{Val: TVN_BEGINRDRAG, Name: "TVN_BEGINRDRAG"},
+		{Val: TVN_DELETEITEM, Name: "TVN_DELETEITEM"},
+		{Val: TVN_BEGINLABELEDIT, Name: "TVN_BEGINLABELEDIT"},
+		{Val: TVN_ENDLABELEDIT, Name: "TVN_ENDLABELEDIT"},
+		{Val: TVN_KEYDOWN, Name: "TVN_KEYDOWN"},
+		{Val: TVN_GETINFOTIP, Name: "TVN_GETINFOTIP"},
+		{Val: TVN_SINGLEEXPAND, Name: "TVN_SINGLEEXPAND"},
+		{Val: TVN_ITEMCHANGING, Name: "TVN_ITEMCHANGING"},
+		{Val: TVN_ITEMCHANGED, Name: "TVN_ITEMCHANGED"},
+		{Val: TVN_ASYNCDRAW, Name: "TVN_ASYNCDRAW"},
+		{Val: UDN_DELTAPOS, Name: "UDN_DELTAPOS"},
+		{Val: MCN_SELECT, Name: "MCN_SELECT"},
+		{Val: TTN_NEEDTEXT, Name: "TTN_NEEDTEXT"},
+	},
+}
+
+// Returns the name of the constant, like "NM_OUTOFMEMORY".
+func (v NM) String() string {
+	return _NM_names.String(v)
+}
+
+// Parses the name of a [NM] constant, or a number.
+func ParseNM(s string) (NM, error) {
+	return _NM_names.Parse(s)
+}
+
+var _WM_names = enum.Enum[WM]{
+	Type: "WM",
+	Values: []enum.Name[WM]{
+		{Val: WM_NULL, Name: "WM_NULL"},
+		{Val: WM_CREATE, Name: "WM_CREATE"},
+		{Val: WM_DESTROY, Name: "WM_DESTROY"},
+		{Val: WM_MOVE, Name: "WM_MOVE"},
+		{Val: WM_SIZE, Name: "WM_SIZE"},
+		{Val: WM_ACTIVATE, Name: "WM_ACTIVATE"},
+		{Val: WM_SETFOCUS, Name: "WM_SETFOCUS"},
+		{Val: WM_KILLFOCUS, Name: "WM_KILLFOCUS"},
+		{Val: WM_ENABLE, Name: "WM_ENABLE"},
+		{Val: WM_SETREDRAW, Name: "WM_SETREDRAW"},
+		{Val: WM_SETTEXT, Name: "WM_SETTEXT"},
+		{Val: WM_GETTEXT, Name: "WM_GETTEXT"},
+		{Val: WM_GETTEXTLENGTH, Name: "WM_GETTEXTLENGTH"},
+		{Val: WM_PAINT, Name: "WM_PAINT"},
+		{Val: WM_CLOSE, Name: "WM_CLOSE"},
+		{Val: WM_QUERYENDSESSION, Name: "WM_QUERYENDSESSION"},
+		{Val: WM_QUERYOPEN, Name: "WM_QUERYOPEN"},
+		{Val: WM_ENDSESSION, Name: "WM_ENDSESSION"},
+		{Val: WM_QUIT, Name: "WM_QUIT"},
+		{Val: WM_ERASEBKGND, Name: "WM_ERASEBKGND"},
+		{Val: WM_SYSCOLORCHANGE, Name: "WM_SYSCOLORCHANGE"},
+		{Val: WM_SHOWWINDOW, Name: "WM_SHOWWINDOW"},
+		{Val: WM_WININICHANGE, Name: "WM_WININICHANGE"},
+		{Val: WM_DEVMODECHANGE, Name: "WM_DEVMODECHANGE"},
+		{Val: WM_ACTIVATEAPP, Name: "WM_ACTIVATEAPP"},
+		{Val: WM_FONTCHANGE, Name: "WM_FONTCHANGE"},
+		{Val: WM_TIMECHANGE, Name: "WM_TIMECHANGE"},
+		{Val: WM_CANCELMODE, Name: "WM_CANCELMODE"},
+		{Val: WM_SETCURSOR, Name: "WM_SETCURSOR"},
+		{Val: WM_MOUSEACTIVATE, Name: "WM_MOUSEACTIVATE"},
+		{Val: WM_CHILDACTIVATE, Name: "WM_CHILDACTIVATE"},
+		{Val: WM_QUEUESYNC, Name: "WM_QUEUESYNC"},
+		{Val: WM_GETMINMAXINFO, Name: "WM_GETMINMAXINFO"},
+		{Val: WM_PAINTICON, Name: "WM_PAINTICON"},
+		{Val: WM_ICONERASEBKGND, Name: "WM_ICONERASEBKGND"},
+		{Val: WM_NEXTDLGCTL, Name: "WM_NEXTDLGCTL"},
+		{Val: WM_SPOOLERSTATUS, Name: "WM_SPOOLERSTATUS"},
+		{Val: WM_DRAWITEM, Name: "WM_DRAWITEM"},
+		{Val: WM_MEASUREITEM, Name: "WM_MEASUREITEM"},
+		{Val: WM_DELETEITEM, Name: "WM_DELETEITEM"},
+		{Val: WM_VKEYTOITEM, Name: "WM_VKEYTOITEM"},
+		{Val: WM_CHARTOITEM, Name: "WM_CHARTOITEM"},
+		{Val: WM_SETFONT, Name: "WM_SETFONT"},
+		{Val: WM_GETFONT, Name: "WM_GETFONT"},
+		{Val: WM_SETHOTKEY, Name: "WM_SETHOTKEY"},
+		{Val: WM_GETHOTKEY, Name: "WM_GETHOTKEY"},
+		{Val: WM_QUERYDRAGICON, Name: "WM_QUERYDRAGICON"},
+		{Val: WM_COMPAREITEM, Name: "WM_COMPAREITEM"},
+		{Val: WM_GETOBJECT, Name: "WM_GETOBJECT"},
+		{Val: WM_COPYDATA, Name: "WM_COPYDATA"},
+		{Val: WM_COMPACTING, Name: "WM_COMPACTING"},
+		{Val: WM_COMMNOTIFY, Name: "WM_COMMNOTIFY"},
+		{Val: WM_WINDOWPOSCHANGING, Name: "WM_WINDOWPOSCHANGING"},
+		{Val: WM_WINDOWPOSCHANGED, Name: "WM_WINDOWPOSCHANGED"},
+		{Val: WM_POWER, Name: "WM_POWER"},
+		{Val: WM_NOTIFY, Name: "WM_NOTIFY"},
+		{Val: WM_INPUTLANGCHANGEREQUEST, Name: "WM_INPUTLANGCHANGEREQUEST"},
+		{Val: WM_INPUTLANGCHANGE, Name: "WM_INPUTLANGCHANGE"},
+		{Val: WM_TCARD, Name: "WM_TCARD"},
+		{Val: WM_HELP, Name: "WM_HELP"},
+		{Val: WM_USERCHANGED, Name: "WM_USERCHANGED"},
+		{Val: WM_NOTIFYFORMAT, Name: "WM_NOTIFYFORMAT"},
+		{Val: WM_CONTEXTMENU, Name: "WM_CONTEXTMENU"},
+		{Val: WM_STYLECHANGING, Name: "WM_STYLECHANGING"},
+		{Val: WM_STYLECHANGED, Name: "WM_STYLECHANGED"},
+		{Val: WM_DISPLAYCHANGE, Name: "WM_DISPLAYCHANGE"},
+		{Val: WM_GETICON, Name: "WM_GETICON"},
+		{Val: WM_SETICON, Name: "WM_SETICON"},
+		{Val: WM_NCCREATE, Name: "WM_NCCREATE"},
+		{Val: WM_NCDESTROY, Name: "WM_NCDESTROY"},
+		{Val: WM_NCCALCSIZE, Name: "WM_NCCALCSIZE"},
+		{Val: WM_NCHITTEST, Name: "WM_NCHITTEST"},
+		{Val: WM_NCPAINT, Name: "WM_NCPAINT"},
+		{Val: WM_NCACTIVATE, Name: "WM_NCACTIVATE"},
+		{Val: WM_GETDLGCODE, Name: "WM_GETDLGCODE"},
+		{Val: WM_SYNCPAINT, Name: "WM_SYNCPAINT"},
+		{Val: WM_NCMOUSEMOVE, Name: "WM_NCMOUSEMOVE"},
+		{Val: WM_NCLBUTTONDOWN, Name: "WM_NCLBUTTONDOWN"},
+		{Val: WM_NCLBUTTONUP, Name: "WM_NCLBUTTONUP"},
+		{Val: WM_NCLBUTTONDBLCLK, Name: "WM_NCLBUTTONDBLCLK"},
+		{Val: WM_NCRBUTTONDOWN, Name: "WM_NCRBUTTONDOWN"},
+		{Val: WM_NCRBUTTONUP, Name: "WM_NCRBUTTONUP"},
+		{Val: WM_NCRBUTTONDBLCLK, Name: "WM_NCRBUTTONDBLCLK"},
+		{Val: WM_NCMBUTTONDOWN, Name: "WM_NCMBUTTONDOWN"},
+		{Val: WM_NCMBUTTONUP, Name: "WM_NCMBUTTONUP"},
+		{Val: WM_NCMBUTTONDBLCLK, Name: "WM_NCMBUTTONDBLCLK"},
+		{Val: WM_NCXBUTTONDOWN, Name: "WM_NCXBUTTONDOWN"},
+		{Val: WM_NCXBUTTONUP, Name: "WM_NCXBUTTONUP"},
+		{Val: WM_NCXBUTTONDBLCLK, Name: "WM_NCXBUTTONDBLCLK"},
+		{Val: WM_INPUT_DEVICE_CHANGE, Name: "WM_INPUT_DEVICE_CHANGE"},
+		{Val: WM_INPUT, Name: "WM_INPUT"},
+		{Val: WM_KEYFIRST, Name: "WM_KEYFIRST"},
+		{Val: WM_KEYDOWN, Name: "WM_KEYDOWN"},
+		{Val: WM_KEYUP, Name: "WM_KEYUP"},
+		{Val: WM_CHAR, Name: "WM_CHAR"},
+		{Val: WM_DEADCHAR, Name: "WM_DEADCHAR"},
+		{Val: WM_SYSKEYDOWN, Name: "WM_SYSKEYDOWN"},
+		{Val: WM_SYSKEYUP, Name: "WM_SYSKEYUP"},
+		{Val: WM_SYSCHAR, Name: "WM_SYSCHAR"},
+		{Val: WM_SYSDEADCHAR, Name: "WM_SYSDEADCHAR"},
+		{Val: WM_UNICHAR, Name: "WM_UNICHAR"},
+		{Val: WM_KEYLAST, Name: "WM_KEYLAST"},
+		{Val: WM_IME_STARTCOMPOSITION, Name: "WM_IME_STARTCOMPOSITION"},
+		{Val: WM_IME_ENDCOMPOSITION, Name: "WM_IME_ENDCOMPOSITION"},
+		{Val: WM_IME_COMPOSITION, Name: "WM_IME_COMPOSITION"},
+		{Val: WM_IME_KEYLAST, Name: "WM_IME_KEYLAST"},
+		{Val: WM_INITDIALOG, Name: "WM_INITDIALOG"},
+		{Val: WM_COMMAND, Name: "WM_COMMAND"},
+		{Val: WM_SYSCOMMAND, Name: "WM_SYSCOMMAND"},
+		{Val: WM_TIMER, Name: "WM_TIMER"},
+		{Val: WM_HSCROLL, Name: "WM_HSCROLL"},
+		{Val: WM_VSCROLL, Name: "WM_VSCROLL"},
+		{Val: WM_INITMENU, Name: "WM_INITMENU"},
+		{Val: WM_INITMENUPOPUP, Name: "WM_INITMENUPOPUP"},
+		{Val: WM_GESTURE, Name: "WM_GESTURE"},
+		{Val: WM_GESTURENOTIFY, Name: "WM_GESTURENOTIFY"},
+		{Val: WM_MENUSELECT, Name: "WM_MENUSELECT"},
+		{Val: WM_MENUCHAR, Name: "WM_MENUCHAR"},
+		{Val: WM_ENTERIDLE, Name: "WM_ENTERIDLE"},
+		{Val: WM_MENURBUTTONUP, Name: "WM_MENURBUTTONUP"},
+		{Val: WM_MENUDRAG, Name: "WM_MENUDRAG"},
+		{Val: WM_MENUGETOBJECT, Name: "WM_MENUGETOBJECT"},
+		{Val: WM_UNINITMENUPOPUP, Name: "WM_UNINITMENUPOPUP"},
+		{Val: WM_MENUCOMMAND, Name: "WM_MENUCOMMAND"},
+		{Val: WM_CHANGEUISTATE, Name: "WM_CHANGEUISTATE"},
+		{Val: WM_UPDATEUISTATE, Name: "WM_UPDATEUISTATE"},
+		{Val: WM_QUERYUISTATE, Name: "WM_QUERYUISTATE"},
+		{Val: WM_CTLCOLORMSGBOX, Name: "WM_CTLCOLORMSGBOX"},
+		{Val: WM_CTLCOLOREDIT, Name: "WM_CTLCOLOREDIT"},
+		{Val: WM_CTLCOLORLISTBOX, Name: "WM_CTLCOLORLISTBOX"},
+		{Val: WM_CTLCOLORBTN, Name: "WM_CTLCOLORBTN"},
+		{Val: WM_CTLCOLORDLG, Name: "WM_CTLCOLORDLG"},
+		{Val: WM_CTLCOLORSCROLLBAR, Name: "WM_CTLCOLORSCROLLBAR"},
+		{Val: WM_CTLCOLORSTATIC, Name: "WM_CTLCOLORSTATIC"},
+		{Val: WM_MN_GETHMENU, Name: "WM_MN_GETHMENU"},
+		{Val: WM_MOUSEFIRST, Name: "WM_MOUSEFIRST"},
+		{Val: WM_MOUSEMOVE, Name: "WM_MOUSEMOVE"},
+		{Val: WM_LBUTTONDOWN, Name: "WM_LBUTTONDOWN"},
+		{Val: WM_LBUTTONUP, Name: "WM_LBUTTONUP"},
+		{Val: WM_LBUTTONDBLCLK, Name: "WM_LBUTTONDBLCLK"},
+		{Val: WM_RBUTTONDOWN, Name: "WM_RBUTTONDOWN"},
+		{Val: WM_RBUTTONUP, Name: "WM_RBUTTONUP"},
+		{Val: WM_RBUTTONDBLCLK, Name: "WM_RBUTTONDBLCLK"},
+		{Val: WM_MBUTTONDOWN, Name: "WM_MBUTTONDOWN"},
+		{Val: WM_MBUTTONUP, Name: "WM_MBUTTONUP"},
+		{Val: WM_MBUTTONDBLCLK, Name: "WM_MBUTTONDBLCLK"},
+		{Val: WM_MOUSEHWHEEL, Name: "WM_MOUSEHWHEEL"},
+		{Val: WM_XBUTTONDOWN, Name: "WM_XBUTTONDOWN"},
+		{Val: WM_XBUTTONUP, Name: "WM_XBUTTONUP"},
+		{Val: WM_XBUTTONDBLCLK, Name: "WM_XBUTTONDBLCLK"},
+		{Val: WM_MOUSELAST, Name: "WM_MOUSELAST"},
+		{Val: WM_PARENTNOTIFY, Name: "WM_PARENTNOTIFY"},
+		{Val: WM_ENTERMENULOOP, Name: "WM_ENTERMENULOOP"},
+		{Val: WM_EXITMENULOOP, Name: "WM_EXITMENULOOP"},
+		{Val: WM_NEXTMENU, Name: "WM_NEXTMENU"},
+		{Val: WM_SIZING, Name: "WM_SIZING"},
+		{Val: WM_CAPTURECHANGED, Name: "WM_CAPTURECHANGED"},
+		{Val: WM_MOVING, Name: "WM_MOVING"},
+		{Val: WM_POWERBROADCAST, Name: "WM_POWERBROADCAST"},
+		{Val: WM_DEVICECHANGE, Name: "WM_DEVICECHANGE"},
+		{Val: WM_MDICREATE, Name: "WM_MDICREATE"},
+		{Val: WM_MDIDESTROY, Name: "WM_MDIDESTROY"},
+		{Val: WM_MDIACTIVATE, Name: "WM_MDIACTIVATE"},
+		{Val: WM_MDIRESTORE, Name: "WM_MDIRESTORE"},
+		{Val: WM_MDINEXT, Name: "WM_MDINEXT"},
+		{Val: WM_MDIMAXIMIZE, Name: "WM_MDIMAXIMIZE"},
+		{Val: WM_MDITILE, Name: "WM_MDITILE"},
+		{Val: WM_MDICASCADE, Name: "WM_MDICASCADE"},
+		{Val: WM_MDIICONARRANGE, Name: "WM_MDIICONARRANGE"},
+		{Val: WM_MDIGETACTIVE, Name: "WM_MDIGETACTIVE"},
+		{Val: WM_MDISETMENU, Name: "WM_MDISETMENU"},
+		{Val: WM_ENTERSIZEMOVE, Name: "WM_ENTERSIZEMOVE"},
+		{Val: WM_EXITSIZEMOVE, Name: "WM_EXITSIZEMOVE"},
+		{Val: WM_DROPFILES, Name: "WM_DROPFILES"},
+		{Val: WM_MDIREFRESHMENU, Name: "WM_MDIREFRESHMENU"},
+		{Val: WM_POINTERDEVICECHANGE, Name: "WM_POINTERDEVICECHANGE"},
+		{Val: WM_POINTERDEVICEINRANGE, Name: "WM_POINTERDEVICEINRANGE"},
+		{Val: WM_POINTERDEVICEOUTOFRANGE, Name: "WM_POINTERDEVICEOUTOFRANGE"},
+		{Val: WM_TOUCH, Name: "WM_TOUCH"},
+		{Val: WM_NCPOINTERUPDATE, Name: "WM_NCPOINTERUPDATE"},
+		{Val: WM_NCPOINTERDOWN, Name: "WM_NCPOINTERDOWN"},
+		{Val: WM_NCPOINTERUP, Name: "WM_NCPOINTERUP"},
+		{Val: WM_POINTERUPDATE, Name: "WM_POINTERUPDATE"},
+		{Val: WM_POINTERDOWN, Name: "WM_POINTERDOWN"},
+		{Val: WM_POINTERUP, Name: "WM_POINTERUP"},
+		{Val: WM_POINTERENTER, Name: "WM_POINTERENTER"},
+		{Val: WM_POINTERLEAVE, Name: "WM_POINTERLEAVE"},
+		{Val: WM_POINTERACTIVATE, Name: "WM_POINTERACTIVATE"},
+		{Val: WM_POINTERCAPTURECHANGED, Name: "WM_POINTERCAPTURECHANGED"},
+		{Val: WM_TOUCHHITTESTING, Name: "WM_TOUCHHITTESTING"},
+		{Val: WM_POINTERWHEEL, Name: "WM_POINTERWHEEL"},
+		{Val: WM_POINTERHWHEEL, Name: "WM_POINTERHWHEEL"},
+		{Val: WM_POINTERHITTEST, Name: "WM_POINTERHITTEST"},
+		{Val: WM_POINTERROUTEDTO, Name: "WM_POINTERROUTEDTO"},
+		{Val: WM_POINTERROUTEDAWAY, Name: "WM_POINTERROUTEDAWAY"},
+		{Val: WM_POINTERROUTEDRELEASED, Name: "WM_POINTERROUTEDRELEASED"},
+		{Val: WM_IME_SETCONTEXT, Name: "WM_IME_SETCONTEXT"},
+		{Val: WM_IME_NOTIFY, Name: "WM_IME_NOTIFY"},
+		{Val: WM_IME_CONTROL, Name: "WM_IME_CONTROL"},
+		{Val: WM_IME_COMPOSITIONFULL, Name: "WM_IME_COMPOSITIONFULL"},
+		{Val: WM_IME_SELECT, Name: "WM_IME_SELECT"},
+		{Val: WM_IME_CHAR, Name: "WM_IME_CHAR"},
+		{Val: WM_IME_REQUEST, Name: "WM_IME_REQUEST"},
+		{Val: WM_IME_KEYDOWN, Name: "WM_IME_KEYDOWN"},
+		{Val: WM_IME_KEYUP, Name: "WM_IME_KEYUP"},
+		{Val: WM_MOUSEHOVER, Name: "WM_MOUSEHOVER"},
+		{Val: WM_MOUSELEAVE, Name: "WM_MOUSELEAVE"},
+		{Val: WM_NCMOUSEHOVER, Name: "WM_NCMOUSEHOVER"},
+		{Val: WM_NCMOUSELEAVE, Name: "WM_NCMOUSELEAVE"},
+		{Val: WM_WTSSESSION_CHANGE, Name: "WM_WTSSESSION_CHANGE"},
+		{Val: WM_TABLET_FIRST, Name: "WM_TABLET_FIRST"},
+		{Val: WM_TABLET_LAST, Name: "WM_TABLET_LAST"},
+		{Val: WM_DPICHANGED, Name: "WM_DPICHANGED"},
+		{Val: WM_DPICHANGED_BEFOREPARENT, Name: "WM_DPICHANGED_BEFOREPARENT"},
+		{Val: WM_DPICHANGED_AFTERPARENT, Name: "WM_DPICHANGED_AFTERPARENT"},
+		{Val: WM_GETDPISCALEDSIZE, Name: "WM_GETDPISCALEDSIZE"},
+		{Val: WM_CUT, Name: "WM_CUT"},
+		{Val: WM_COPY, Name: "WM_COPY"},
+		{Val: WM_PASTE, Name: "WM_PASTE"},
+		{Val: WM_CLEAR, Name: "WM_CLEAR"},
+		{Val: WM_UNDO, Name: "WM_UNDO"},
+		{Val: WM_RENDERFORMAT, Name: "WM_RENDERFORMAT"},
+		{Val: WM_RENDERALLFORMATS, Name: "WM_RENDERALLFORMATS"},
+		{Val: WM_DESTROYCLIPBOARD, Name: "WM_DESTROYCLIPBOARD"},
+		{Val: WM_DRAWCLIPBOARD, Name: "WM_DRAWCLIPBOARD"},
+		{Val: WM_PAINTCLIPBOARD, Name: "WM_PAINTCLIPBOARD"},
+		{Val: WM_VSCROLLCLIPBOARD, Name: "WM_VSCROLLCLIPBOARD"},
+		{Val: WM_SIZECLIPBOARD, Name: "WM_SIZECLIPBOARD"},
+		{Val: WM_ASKCBFORMATNAME, Name: "WM_ASKCBFORMATNAME"},
+		{Val: WM_CHANGECBCHAIN, Name: "WM_CHANGECBCHAIN"},
+		{Val: WM_HSCROLLCLIPBOARD, Name: "WM_HSCROLLCLIPBOARD"},
+		{Val: WM_QUERYNEWPALETTE, Name: "WM_QUERYNEWPALETTE"},
+		{Val: WM_PALETTEISCHANGING, Name: "WM_PALETTEISCHANGING"},
+		{Val: WM_PALETTECHANGED, Name: "WM_PALETTECHANGED"},
+		{Val: WM_HOTKEY, Name: "WM_HOTKEY"},
+		{Val: WM_PRINT, Name: "WM_PRINT"},
+		{Val: WM_PRINTCLIENT, Name: "WM_PRINTCLIENT"},
+		{Val: WM_APPCOMMAND, Name: "WM_APPCOMMAND"},
+		{Val: WM_THEMECHANGED, Name: "WM_THEMECHANGED"},
+		{Val: WM_CLIPBOARDUPDATE, Name: "WM_CLIPBOARDUPDATE"},
+		{Val: WM_DWMCOMPOSITIONCHANGED, Name: "WM_DWMCOMPOSITIONCHANGED"},
+		{Val: WM_DWMNCRENDERINGCHANGED, Name: "WM_DWMNCRENDERINGCHANGED"},
+		{Val: WM_DWMCOLORIZATIONCOLORCHANGED, Name: "WM_DWMCOLORIZATIONCOLORCHANGED"},
+		{Val: WM_DWMWINDOWMAXIMIZEDCHANGE, Name: "WM_DWMWINDOWMAXIMIZEDCHANGE"},
+		{Val: WM_DWMSENDICONICTHUMBNAIL, Name: "WM_DWMSENDICONICTHUMBNAIL"},
+		{Val: WM_DWMSENDICONICLIVEPREVIEWBITMAP, Name: "WM_DWMSENDICONICLIVEPREVIEWBITMAP"},
+		{Val: WM_GETTITLEBARINFOEX, Name: "WM_GETTITLEBARINFOEX"},
+		{Val: WM_HANDHELDFIRST, Name: "WM_HANDHELDFIRST"},
+		{Val: WM_HANDHELDLAST, Name: "WM_HANDHELDLAST"},
+		{Val: WM_AFXFIRST, Name: "WM_AFXFIRST"},
+		{Val: WM_AFXLAST, Name: "WM_AFXLAST"},
+		{Val: WM_PENWINFIRST, Name: "WM_PENWINFIRST"},
+		{Val: WM_PENWINLAST, Name: "WM_PENWINLAST"},
+		{Val: WM_APP, Name: "WM_APP"},
+		{Val: WM_USER, Name: "WM_USER"},
+		{Val: BCM_GETIDEALSIZE, Name: "BCM_GETIDEALSIZE"},
+		{Val: BCM_SETIMAGELIST, Name: "BCM_SETIMAGELIST"},
+		{Val: BCM_GETIMAGELIST, Name: "BCM_GETIMAGELIST"},
+		{Val: BCM_SETTEXTMARGIN, Name: "BCM_SETTEXTMARGIN"},
+		{Val: BCM_GETTEXTMARGIN, Name: "BCM_GETTEXTMARGIN"},
+		{Val: BCM_SETDROPDOWNSTATE, Name: "BCM_SETDROPDOWNSTATE"},
+		{Val: BCM_SETSPLITINFO, Name: "BCM_SETSPLITINFO"},
+		{Val: BCM_GETSPLITINFO, Name: "BCM_GETSPLITINFO"},
+		{Val: BCM_SETNOTE, Name: "BCM_SETNOTE"},
+		{Val: BCM_GETNOTE, Name: "BCM_GETNOTE"},
+		{Val: BCM_GETNOTELENGTH, Name: "BCM_GETNOTELENGTH"},
+		{Val: BCM_SETSHIELD, Name: "BCM_SETSHIELD"},
+		{Val: BM_GETCHECK, Name: "BM_GETCHECK"},
+		{Val: BM_SETCHECK, Name: "BM_SETCHECK"},
+		{Val: BM_GETSTATE, Name: "BM_GETSTATE"},
+		{Val: BM_SETSTATE, Name: "BM_SETSTATE"},
+		{Val: BM_SETSTYLE, Name: "BM_SETSTYLE"},
+		{Val: BM_CLICK, Name: "BM_CLICK"},
+		{Val: BM_GETIMAGE, Name: "BM_GETIMAGE"},
+		{Val: BM_SETIMAGE, Name: "BM_SETIMAGE"},
+		{Val: BM_SETDONTCLICK, Name: "BM_SETDONTCLICK"},
+		{Val: CCM_SETBKCOLOR, Name: "CCM_SETBKCOLOR"},
+		{Val: CCM_SETCOLORSCHEME, Name: "CCM_SETCOLORSCHEME"},
+		{Val: CCM_GETCOLORSCHEME, Name: "CCM_GETCOLORSCHEME"},
+		{Val: CCM_GETDROPTARGET, Name: "CCM_GETDROPTARGET"},
+		{Val: CCM_SETUNICODEFORMAT, Name: "CCM_SETUNICODEFORMAT"},
+		{Val: CCM_GETUNICODEFORMAT, Name: "CCM_GETUNICODEFORMAT"},
+		{Val: CCM_SETVERSION, Name: "CCM_SETVERSION"},
+		{Val: CCM_GETVERSION, Name: "CCM_GETVERSION"},
+		{Val: CCM_SETNOTIFYWINDOW, Name: "CCM_SETNOTIFYWINDOW"},
+		{Val: CCM_SETWINDOWTHEME, Name: "CCM_SETWINDOWTHEME"},
+		{Val: CCM_DPISCALE, Name: "CCM_DPISCALE"},
+		{Val: CB_GETEDITSEL, Name: "CB_GETEDITSEL"},
+		{Val: CB_LIMITTEXT, Name: "CB_LIMITTEXT"},
+		{Val: CB_SETEDITSEL, Name: "CB_SETEDITSEL"},
+		{Val: CB_ADDSTRING, Name: "CB_ADDSTRING"},
+		{Val: CB_DELETESTRING, Name: "CB_DELETESTRING"},
+		{Val: CB_DIR, Name: "CB_DIR"},
+		{Val: CB_GETCOUNT, Name: "CB_GETCOUNT"},
+		{Val: CB_GETCURSEL, Name: "CB_GETCURSEL"},
+		{Val: CB_GETLBTEXT, Name: "CB_GETLBTEXT"},
+		{Val: CB_GETLBTEXTLEN, Name: "CB_GETLBTEXTLEN"},
+		{Val: CB_INSERTSTRING, Name: "CB_INSERTSTRING"},
+		{Val: CB_RESETCONTENT, Name: "CB_RESETCONTENT"},
+		{Val: CB_FINDSTRING, Name: "CB_FINDSTRING"},
+		{Val: CB_SELECTSTRING, Name: "CB_SELECTSTRING"},
+		{Val: CB_SETCURSEL, Name: "CB_SETCURSEL"},
+		{Val: CB_SHOWDROPDOWN, Name: "CB_SHOWDROPDOWN"},
+		{Val: CB_GETITEMDATA, Name: "CB_GETITEMDATA"},
+		{Val: CB_SETITEMDATA, Name: "CB_SETITEMDATA"},
+		{Val: CB_GETDROPPEDCONTROLRECT, Name: "CB_GETDROPPEDCONTROLRECT"},
+		{Val: CB_SETITEMHEIGHT, Name: "CB_SETITEMHEIGHT"},
+		{Val: CB_GETITEMHEIGHT, Name: "CB_GETITEMHEIGHT"},
+		{Val: CB_SETEXTENDEDUI, Name: "CB_SETEXTENDEDUI"},
+		{Val: CB_GETEXTENDEDUI, Name: "CB_GETEXTENDEDUI"},
+		{Val: CB_GETDROPPEDSTATE, Name: "CB_GETDROPPEDSTATE"},
+		{Val: CB_FINDSTRINGEXACT, Name: "CB_FINDSTRINGEXACT"},
+		{Val: CB_SETLOCALE, Name: "CB_SETLOCALE"},
+		{Val: CB_GETLOCALE, Name: "CB_GETLOCALE"},
+		{Val: CB_GETTOPINDEX, Name: "CB_GETTOPINDEX"},
+		{Val: CB_SETTOPINDEX, Name: "CB_SETTOPINDEX"},
+		{Val: CB_GETHORIZONTALEXTENT, Name: "CB_GETHORIZONTALEXTENT"},
+		{Val: CB_SETHORIZONTALEXTENT, Name: "CB_SETHORIZONTALEXTENT"},
+		{Val: CB_GETDROPPEDWIDTH, Name: "CB_GETDROPPEDWIDTH"},
+		{Val: CB_SETDROPPEDWIDTH, Name: "CB_SETDROPPEDWIDTH"},
+		{Val: CB_INITSTORAGE, Name: "CB_INITSTORAGE"},
+		{Val: CB_GETCOMBOBOXINFO, Name: "CB_GETCOMBOBOXINFO"},
+		{Val: CB_MSGMAX, Name: "CB_MSGMAX"},
+		{Val: DTM_GETSYSTEMTIME, Name: "DTM_GETSYSTEMTIME"},
+		{Val: DTM_SETSYSTEMTIME, Name: "DTM_SETSYSTEMTIME"},
+		{Val: DTM_GETRANGE, Name: "DTM_GETRANGE"},
+		{Val: DTM_SETRANGE, Name: "DTM_SETRANGE"},
+		{Val: DTM_SETFORMAT, Name: "DTM_SETFORMAT"},
+		{Val: DTM_SETMCCOLOR, Name: "DTM_SETMCCOLOR"},
+		{Val: DTM_GETMCCOLOR, Name: "DTM_GETMCCOLOR"},
+		{Val: DTM_GETMONTHCAL, Name: "DTM_GETMONTHCAL"},
+		{Val: DTM_SETMCFONT, Name: "DTM_SETMCFONT"},
+		{Val: DTM_GETMCFONT, Name: "DTM_GETMCFONT"},
+		{Val: DTM_SETMCSTYLE, Name: "DTM_SETMCSTYLE"},
+		{Val: DTM_GETMCSTYLE, Name: "DTM_GETMCSTYLE"},
+		{Val: DTM_CLOSEMONTHCAL, Name: "DTM_CLOSEMONTHCAL"},
+		{Val: DTM_GETDATETIMEPICKERINFO, Name: "DTM_GETDATETIMEPICKERINFO"},
+		{Val: DTM_GETIDEALSIZE, Name: "DTM_GETIDEALSIZE"},
+		{Val: EM_GETSEL, Name: "EM_GETSEL"},
+		{Val: EM_SETSEL, Name: "EM_SETSEL"},
+		{Val: EM_GETRECT, Name: "EM_GETRECT"},
+		{Val: EM_SETRECT, Name: "EM_SETRECT"},
+		{Val: EM_SETRECTNP, Name: "EM_SETRECTNP"},
+		{Val: EM_SCROLL, Name: "EM_SCROLL"},
+		{Val: EM_LINESCROLL, Name: "EM_LINESCROLL"},
+		{Val: EM_SCROLLCARET, Name: "EM_SCROLLCARET"},
+		{Val: EM_GETMODIFY, Name: "EM_GETMODIFY"},
+		{Val: EM_SETMODIFY, Name: "EM_SETMODIFY"},
+		{Val: EM_GETLINECOUNT, Name: "EM_GETLINECOUNT"},
+		{Val: EM_LINEINDEX, Name: "EM_LINEINDEX"},
+		{Val: EM_SETHANDLE, Name: "EM_SETHANDLE"},
+		{Val: EM_GETHANDLE, Name: "EM_GETHANDLE"},
+		{Val: EM_GETTHUMB, Name: "EM_GETTHUMB"},
+		{Val: EM_LINELENGTH, Name: "EM_LINELENGTH"},
+		{Val: EM_REPLACESEL, Name: "EM_REPLACESEL"},
+		{Val: EM_GETLINE, Name: "EM_GETLINE"},
+		{Val: EM_LIMITTEXT, Name: "EM_LIMITTEXT"},
+		{Val: EM_CANUNDO, Name: "EM_CANUNDO"},
+		{Val: EM_UNDO, Name: "EM_UNDO"},
+		{Val: EM_FMTLINES, Name: "EM_FMTLINES"},
+		{Val: EM_LINEFROMCHAR, Name: "EM_LINEFROMCHAR"},
+		{Val: EM_SETTABSTOPS, Name: "EM_SETTABSTOPS"},
+		{Val: EM_SETPASSWORDCHAR, Name: "EM_SETPASSWORDCHAR"},
+		{Val: EM_EMPTYUNDOBUFFER, Name: "EM_EMPTYUNDOBUFFER"},
+		{Val: EM_GETFIRSTVISIBLELINE, Name: "EM_GETFIRSTVISIBLELINE"},
+		{Val: EM_SETREADONLY, Name: "EM_SETREADONLY"},
+		{Val: EM_SETWORDBREAKPROC, Name: "EM_SETWORDBREAKPROC"},
+		{Val: EM_GETWORDBREAKPROC, Name: "EM_GETWORDBREAKPROC"},
+		{Val: EM_GETPASSWORDCHAR, Name: "EM_GETPASSWORDCHAR"},
+		{Val: EM_SETMARGINS, Name: "EM_SETMARGINS"},
+		{Val: EM_GETMARGINS, Name: "EM_GETMARGINS"},
+		{Val: EM_GETLIMITTEXT, Name: "EM_GETLIMITTEXT"},
+		{Val: EM_POSFROMCHAR, Name: "EM_POSFROMCHAR"},
+		{Val: EM_CHARFROMPOS, Name: "EM_CHARFROMPOS"},
+		{Val: EM_SETIMESTATUS, Name: "EM_SETIMESTATUS"},
+		{Val: EM_GETIMESTATUS, Name: "EM_GETIMESTATUS"},
+		{Val: EM_SETCUEBANNER, Name: "EM_SETCUEBANNER"},
+		{Val: EM_GETCUEBANNER, Name: "EM_GETCUEBANNER"},
+		{Val: EM_SHOWBALLOONTIP, Name: "EM_SHOWBALLOONTIP"},
+		{Val: EM_HIDEBALLOONTIP, Name: "EM_HIDEBALLOONTIP"},
+		{Val: EM_SETHILITE, Name: "EM_SETHILITE"},
+		{Val: EM_GETHILITE, Name: "EM_GETHILITE"},
+		{Val: EM_NOSETFOCUS, Name: "EM_NOSETFOCUS"},
+		{Val: EM_TAKEFOCUS, Name: "EM_TAKEFOCUS"},
+		{Val: EM_SETEXTENDEDSTYLE, Name: "EM_SETEXTENDEDSTYLE"},
+		{Val: EM_GETEXTENDEDSTYLE, Name: "EM_GETEXTENDEDSTYLE"},
+		{Val: EM_SETENDOFLINE, Name: "EM_SETENDOFLINE"},
+		{Val: EM_GETENDOFLINE, Name: "EM_GETENDOFLINE"},
+		{Val: EM_ENABLESEARCHWEB, Name: "EM_ENABLESEARCHWEB"},
+		{Val: EM_SEARCHWEB, Name: "EM_SEARCHWEB"},
+		{Val: EM_SETCARETINDEX, Name: "EM_SETCARETINDEX"},
+		{Val: EM_GETCARETINDEX, Name: "EM_GETCARETINDEX"},
+		{Val: EM_GETZOOM, Name: "EM_GETZOOM"},
+		{Val: EM_SETZOOM, Name: "EM_SETZOOM"},
+		{Val: EM_FILELINEFROMCHAR, Name: "EM_FILELINEFROMCHAR"},
+		{Val: EM_FILELINEINDEX, Name: "EM_FILELINEINDEX"},
+		{Val: EM_FILELINELENGTH, Name: "EM_FILELINELENGTH"},
+		{Val: EM_GETFILELINE, Name: "EM_GETFILELINE"},
+		{Val: EM_GETFILELINECOUNT, Name: "EM_GETFILELINECOUNT"},
+		{Val: HDM_GETITEMCOUNT, Name: "HDM_GETITEMCOUNT"},
+		{Val: HDM_DELETEITEM, Name: "HDM_DELETEITEM"},
+		{Val: HDM_LAYOUT, Name: "HDM_LAYOUT"},
+		{Val: HDM_GETITEMRECT, Name: "HDM_GETITEMRECT"},
+		{Val: HDM_SETIMAGELIST, Name: "HDM_SETIMAGELIST"},
+		{Val: HDM_GETIMAGELIST, Name: "HDM_GETIMAGELIST"},
+		{Val: HDM_INSERTITEM, Name: "HDM_INSERTITEM"},
+		{Val: HDM_GETITEM, Name: "HDM_GETITEM"},
+		{Val: HDM_SETITEM, Name: "HDM_SETITEM"},
+		{Val: HDM_ORDERTOINDEX, Name: "HDM_ORDERTOINDEX"},
+		{Val: HDM_CREATEDRAGIMAGE, Name: "HDM_CREATEDRAGIMAGE"},
+		{Val: HDM_GETORDERARRAY, Name: "HDM_GETORDERARRAY"},
+		{Val: HDM_SETORDERARRAY, Name: "HDM_SETORDERARRAY"},
+		{Val: HDM_SETHOTDIVIDER, Name: "HDM_SETHOTDIVIDER"},
+		{Val: HDM_SETBITMAPMARGIN, Name: "HDM_SETBITMAPMARGIN"},
+		{Val: HDM_GETBITMAPMARGIN, Name: "HDM_GETBITMAPMARGIN"},
+		{Val: HDM_SETFILTERCHANGETIMEOUT, Name: "HDM_SETFILTERCHANGETIMEOUT"},
+		{Val: HDM_EDITFILTER, Name: "HDM_EDITFILTER"},
+		{Val: HDM_CLEARFILTER, Name: "HDM_CLEARFILTER"},
+		{Val: HDM_GETITEMDROPDOWNRECT, Name: "HDM_GETITEMDROPDOWNRECT"},
+		{Val: HDM_GETOVERFLOWRECT, Name: "HDM_GETOVERFLOWRECT"},
+		{Val: HDM_GETFOCUSEDITEM, Name: "HDM_GETFOCUSEDITEM"},
+		{Val: HDM_SETFOCUSEDITEM, Name: "HDM_SETFOCUSEDITEM"},
+		{Val: LVM_GETBKCOLOR, Name: "LVM_GETBKCOLOR"},
+		{Val: LVM_SETBKCOLOR, Name: "LVM_SETBKCOLOR"},
+		{Val: LVM_GETIMAGELIST, Name: "LVM_GETIMAGELIST"},
+		{Val: LVM_SETIMAGELIST, Name: "LVM_SETIMAGELIST"},
+		{Val: LVM_GETITEMCOUNT, Name: "LVM_GETITEMCOUNT"},
+		{Val: LVM_DELETEITEM, Name: "LVM_DELETEITEM"},
+		{Val: LVM_DELETEALLITEMS, Name: "LVM_DELETEALLITEMS"},
+		{Val: LVM_GETCALLBACKMASK, Name: "LVM_GETCALLBACKMASK"},
+		{Val: LVM_SETCALLBACKMASK, Name: "LVM_SETCALLBACKMASK"},
+		{Val: LVM_GETNEXTITEM, Name: "LVM_GETNEXTITEM"},
+		{Val: LVM_GETITEMRECT, Name: "LVM_GETITEMRECT"},
+		{Val: LVM_SETITEMPOSITION, Name: "LVM_SETITEMPOSITION"},
+		{Val: LVM_GETITEMPOSITION, Name: "LVM_GETITEMPOSITION"},
+		{Val: LVM_HITTEST, Name: "LVM_HITTEST"},
+		{Val: LVM_ENSUREVISIBLE, Name: "LVM_ENSUREVISIBLE"},
+		{Val: LVM_SCROLL, Name: "LVM_SCROLL"},
+		{Val: LVM_REDRAWITEMS, Name: "LVM_REDRAWITEMS"},
+		{Val: LVM_ARRANGE, Name: "LVM_ARRANGE"},
+		{Val: LVM_GETEDITCONTROL, Name: "LVM_GETEDITCONTROL"},
+		{Val: LVM_DELETECOLUMN, Name: "LVM_DELETECOLUMN"},
+		{Val: LVM_GETCOLUMNWIDTH, Name: "LVM_GETCOLUMNWIDTH"},
+		{Val: LVM_SETCOLUMNWIDTH, Name: "LVM_SETCOLUMNWIDTH"},
+		{Val: LVM_GETHEADER, Name: "LVM_GETHEADER"},
+		{Val: LVM_CREATEDRAGIMAGE, Name: "LVM_CREATEDRAGIMAGE"},
+		{Val: LVM_GETVIEWRECT, Name: "LVM_GETVIEWRECT"},
+		{Val: LVM_GETTEXTCOLOR, Name: "LVM_GETTEXTCOLOR"},
+		{Val: LVM_SETTEXTCOLOR, Name: "LVM_SETTEXTCOLOR"},
+		{Val: LVM_GETTEXTBKCOLOR, Name: "LVM_GETTEXTBKCOLOR"},
+		{Val: LVM_SETTEXTBKCOLOR, Name: "LVM_SETTEXTBKCOLOR"},
+		{Val: LVM_GETTOPINDEX, Name: "LVM_GETTOPINDEX"},
+		{Val: LVM_GETCOUNTPERPAGE, Name: "LVM_GETCOUNTPERPAGE"},
+		{Val: LVM_GETORIGIN, Name: "LVM_GETORIGIN"},
+		{Val: LVM_UPDATE, Name: "LVM_UPDATE"},
+		{Val: LVM_SETITEMSTATE, Name: "LVM_SETITEMSTATE"},
+		{Val: LVM_GETITEMSTATE, Name: "LVM_GETITEMSTATE"},
+		{Val: LVM_SETITEMCOUNT, Name: "LVM_SETITEMCOUNT"},
+		{Val: LVM_SORTITEMS, Name: "LVM_SORTITEMS"},
+		{Val: LVM_SETITEMPOSITION32, Name: "LVM_SETITEMPOSITION32"},
+		{Val: LVM_GETSELECTEDCOUNT, Name: "LVM_GETSELECTEDCOUNT"},
+		{Val: LVM_GETITEMSPACING, Name: "LVM_GETITEMSPACING"},
+		{Val: LVM_SETICONSPACING, Name: "LVM_SETICONSPACING"},
+		{Val: LVM_SETEXTENDEDLISTVIEWSTYLE, Name: "LVM_SETEXTENDEDLISTVIEWSTYLE"},
+		{Val: LVM_GETEXTENDEDLISTVIEWSTYLE, Name: "LVM_GETEXTENDEDLISTVIEWSTYLE"},
+		{Val: LVM_GETSUBITEMRECT, Name: "LVM_GETSUBITEMRECT"},
+		{Val: LVM_SUBITEMHITTEST, Name: "LVM_SUBITEMHITTEST"},
+		{Val: LVM_SETCOLUMNORDERARRAY, Name: "LVM_SETCOLUMNORDERARRAY"},
+		{Val: LVM_GETCOLUMNORDERARRAY, Name: "LVM_GETCOLUMNORDERARRAY"},
+		{Val: LVM_SETHOTITEM, Name: "LVM_SETHOTITEM"},
+		{Val: LVM_GETHOTITEM, Name: "LVM_GETHOTITEM"},
+		{Val: LVM_SETHOTCURSOR, Name: "LVM_SETHOTCURSOR"},
+		{Val: LVM_GETHOTCURSOR, Name: "LVM_GETHOTCURSOR"},
+		{Val: LVM_APPROXIMATEVIEWRECT, Name: "LVM_APPROXIMATEVIEWRECT"},
+		{Val: LVM_SETWORKAREAS, Name: "LVM_SETWORKAREAS"},
+		{Val: LVM_GETSELECTIONMARK, Name: "LVM_GETSELECTIONMARK"},
+		{Val: LVM_SETSELECTIONMARK, Name: "LVM_SETSELECTIONMARK"},
+		{Val: LVM_GETWORKAREAS, Name: "LVM_GETWORKAREAS"},
+		{Val: LVM_SETHOVERTIME, Name: "LVM_SETHOVERTIME"},
+		{Val: LVM_GETHOVERTIME, Name: "LVM_GETHOVERTIME"},
+		{Val: LVM_GETNUMBEROFWORKAREAS, Name: "LVM_GETNUMBEROFWORKAREAS"},
+		{Val: LVM_SETTOOLTIPS, Name: "LVM_SETTOOLTIPS"},
+		{Val: LVM_GETITEM, Name: "LVM_GETITEM"},
+		{Val: LVM_SETITEM, Name: "LVM_SETITEM"},
+		{Val: LVM_INSERTITEM, Name: "LVM_INSERTITEM"},
+		{Val: LVM_GETTOOLTIPS, Name: "LVM_GETTOOLTIPS"},
+		{Val: LVM_SORTITEMSEX, Name: "LVM_SORTITEMSEX"},
+		{Val: LVM_FINDITEM, Name: "LVM_FINDITEM"},
+		{Val: LVM_GETSTRINGWIDTH, Name: "LVM_GETSTRINGWIDTH"},
+		{Val: LVM_GETGROUPSTATE, Name: "LVM_GETGROUPSTATE"},
+		{Val: LVM_GETFOCUSEDGROUP, Name: "LVM_GETFOCUSEDGROUP"},
+		{Val: LVM_GETCOLUMN, Name: "LVM_GETCOLUMN"},
+		{Val: LVM_SETCOLUMN, Name: "LVM_SETCOLUMN"},
+		{Val: LVM_INSERTCOLUMN, Name: "LVM_INSERTCOLUMN"},
+		{Val: LVM_GETGROUPRECT, Name: "LVM_GETGROUPRECT"},
+		{Val: LVM_GETITEMTEXT, Name: "LVM_GETITEMTEXT"},
+		{Val: LVM_SETITEMTEXT, Name: "LVM_SETITEMTEXT"},
+		{Val: LVM_GETISEARCHSTRING, Name: "LVM_GETISEARCHSTRING"},
+		{Val: LVM_EDITLABEL, Name: "LVM_EDITLABEL"},
+		{Val: LVM_SETBKIMAGE, Name: "LVM_SETBKIMAGE"},
+		{Val: LVM_GETBKIMAGE, Name: "LVM_GETBKIMAGE"},
+		{Val: LVM_SETSELECTEDCOLUMN, Name: "LVM_SETSELECTEDCOLUMN"},
+		{Val: LVM_SETVIEW, Name: "LVM_SETVIEW"},
+		{Val: LVM_GETVIEW, Name: "LVM_GETVIEW"},
+		{Val: LVM_INSERTGROUP, Name: "LVM_INSERTGROUP"},
+		{Val: LVM_SETGROUPINFO, Name: "LVM_SETGROUPINFO"},
+		{Val: LVM_GETGROUPINFO, Name: "LVM_GETGROUPINFO"},
+		{Val: LVM_REMOVEGROUP, Name: "LVM_REMOVEGROUP"},
+		{Val: LVM_MOVEGROUP, Name: "LVM_MOVEGROUP"},
+		{Val: LVM_GETGROUPCOUNT, Name: "LVM_GETGROUPCOUNT"},
+		{Val: LVM_GETGROUPINFOBYINDEX, Name: "LVM_GETGROUPINFOBYINDEX"},
+		{Val: LVM_MOVEITEMTOGROUP, Name: "LVM_MOVEITEMTOGROUP"},
+		{Val: LVM_SETGROUPMETRICS, Name: "LVM_SETGROUPMETRICS"},
+		{Val: LVM_GETGROUPMETRICS, Name: "LVM_GETGROUPMETRICS"},
+		{Val: LVM_ENABLEGROUPVIEW, Name: "LVM_ENABLEGROUPVIEW"},
+		{Val: LVM_SORTGROUPS, Name: "LVM_SORTGROUPS"},
+		{Val: LVM_INSERTGROUPSORTED, Name: "LVM_INSERTGROUPSORTED"},
+		{Val: LVM_REMOVEALLGROUPS, Name: "LVM_REMOVEALLGROUPS"},
+		{Val: LVM_HASGROUP, Name: "LVM_HASGROUP"},
+		{Val: LVM_SETTILEVIEWINFO, Name: "LVM_SETTILEVIEWINFO"},
+		{Val: LVM_GETTILEVIEWINFO, Name: "LVM_GETTILEVIEWINFO"},
+		{Val: LVM_SETTILEINFO, Name: "LVM_SETTILEINFO"},
+		{Val: LVM_GETTILEINFO, Name: "LVM_GETTILEINFO"},
+		{Val: LVM_SETINSERTMARK, Name: "LVM_SETINSERTMARK"},
+		{Val: LVM_GETINSERTMARK, Name: "LVM_GETINSERTMARK"},
+		{Val: LVM_INSERTMARKHITTEST, Name: "LVM_INSERTMARKHITTEST"},
+		{Val: LVM_GETINSERTMARKRECT, Name: "LVM_GETINSERTMARKRECT"},
+		{Val: LVM_SETINSERTMARKCOLOR, Name: "LVM_SETINSERTMARKCOLOR"},
+		{Val: LVM_GETINSERTMARKCOLOR, Name: "LVM_GETINSERTMARKCOLOR"},
+		{Val: LVM_SETINFOTIP, Name: "LVM_SETINFOTIP"},
+		{Val: LVM_GETSELECTEDCOLUMN, Name: "LVM_GETSELECTEDCOLUMN"},
+		{Val: LVM_ISGROUPVIEWENABLED, Name: "LVM_ISGROUPVIEWENABLED"},
+		{Val: LVM_GETOUTLINECOLOR, Name: "LVM_GETOUTLINECOLOR"},
+		{Val: LVM_SETOUTLINECOLOR, Name: "LVM_SETOUTLINECOLOR"},
+		{Val: LVM_CANCELEDITLABEL, Name: "LVM_CANCELEDITLABEL"},
+		{Val: LVM_MAPINDEXTOID, Name: "LVM_MAPINDEXTOID"},
+		{Val: LVM_MAPIDTOINDEX, Name: "LVM_MAPIDTOINDEX"},
+		{Val: LVM_ISITEMVISIBLE, Name: "LVM_ISITEMVISIBLE"},
+		{Val: LVM_GETEMPTYTEXT, Name: "LVM_GETEMPTYTEXT"},
+		{Val: LVM_GETFOOTERRECT, Name: "LVM_GETFOOTERRECT"},
+		{Val: LVM_GETFOOTERINFO, Name: "LVM_GETFOOTERINFO"},
+		{Val: LVM_GETFOOTERITEMRECT, Name: "LVM_GETFOOTERITEMRECT"},
+		{Val: LVM_GETFOOTERITEM, Name: "LVM_GETFOOTERITEM"},
+		{Val: LVM_GETITEMINDEXRECT, Name: "LVM_GETITEMINDEXRECT"},
+		{Val: LVM_SETITEMINDEXSTATE, Name: "LVM_SETITEMINDEXSTATE"},
+		{Val: LVM_GETNEXTITEMINDEX, Name: "LVM_GETNEXTITEMINDEX"},
+		{Val: MCM_GETCURSEL, Name: "MCM_GETCURSEL"},
+		{Val: MCM_SETCURSEL, Name: "MCM_SETCURSEL"},
+		{Val: MCM_GETMAXSELCOUNT, Name: "MCM_GETMAXSELCOUNT"},
+		{Val: MCM_SETMAXSELCOUNT, Name: "MCM_SETMAXSELCOUNT"},
+		{Val: MCM_GETSELRANGE, Name: "MCM_GETSELRANGE"},
+		{Val: MCM_SETSELRANGE, Name: "MCM_SETSELRANGE"},
+		{Val: MCM_GETMONTHRANGE, Name: "MCM_GETMONTHRANGE"},
+		{Val: MCM_SETDAYSTATE, Name: "MCM_SETDAYSTATE"},
+		{Val: MCM_GETMINREQRECT, Name: "MCM_GETMINREQRECT"},
+		{Val: MCM_SETCOLOR, Name: "MCM_SETCOLOR"},
+		{Val: MCM_GETCOLOR, Name: "MCM_GETCOLOR"},
+		{Val: MCM_SETTODAY, Name: "MCM_SETTODAY"},
+		{Val: MCM_GETTODAY, Name: "MCM_GETTODAY"},
+		{Val: MCM_HITTEST, Name: "MCM_HITTEST"},
+		{Val: MCM_SETFIRSTDAYOFWEEK, Name: "MCM_SETFIRSTDAYOFWEEK"},
+		{Val: MCM_GETFIRSTDAYOFWEEK, Name: "MCM_GETFIRSTDAYOFWEEK"},
+		{Val: MCM_GETRANGE, Name: "MCM_GETRANGE"},
+		{Val: MCM_SETRANGE, Name: "MCM_SETRANGE"},
+		{Val: MCM_GETMONTHDELTA, Name: "MCM_GETMONTHDELTA"},
+		{Val: MCM_SETMONTHDELTA, Name: "MCM_SETMONTHDELTA"},
+		{Val: MCM_GETMAXTODAYWIDTH, Name: "MCM_GETMAXTODAYWIDTH"},
+		{Val: MCM_GETCURRENTVIEW, Name: "MCM_GETCURRENTVIEW"},
+		{Val: MCM_GETCALENDARCOUNT, Name: "MCM_GETCALENDARCOUNT"},
+		{Val: MCM_GETCALENDARGRIDINFO, Name: "MCM_GETCALENDARGRIDINFO"},
+		{Val: MCM_GETCALID, Name: "MCM_GETCALID"},
+		{Val: MCM_SETCALID, Name: "MCM_SETCALID"},
+		{Val: MCM_SIZERECTTOMIN, Name: "MCM_SIZERECTTOMIN"},
+		{Val: MCM_SETCALENDARBORDER, Name: "MCM_SETCALENDARBORDER"},
+		{Val: MCM_GETCALENDARBORDER, Name: "MCM_GETCALENDARBORDER"},
+		{Val: MCM_SETCURRENTVIEW, Name: "MCM_SETCURRENTVIEW"},
+		{Val: PBM_SETRANGE, Name: "PBM_SETRANGE"},
+		{Val: PBM_SETPOS, Name: "PBM_SETPOS"},
+		{Val: PBM_DELTAPOS, Name: "PBM_DELTAPOS"},
+		{Val: PBM_SETSTEP, Name: "PBM_SETSTEP"},
+		{Val: PBM_STEPIT, Name: "PBM_STEPIT"},
+		{Val: PBM_SETRANGE32, Name: "PBM_SETRANGE32"},
+		{Val: PBM_GETRANGE, Name: "PBM_GETRANGE"},
+		{Val: PBM_GETPOS, Name: "PBM_GETPOS"},
+		{Val: PBM_SETBARCOLOR, Name: "PBM_SETBARCOLOR"},
+		{Val: PBM_SETMARQUEE, Name: "PBM_SETMARQUEE"},
+		{Val: PBM_GETSTEP, Name: "PBM_GETSTEP"},
+		{Val: PBM_GETBKCOLOR, Name: "PBM_GETBKCOLOR"},
+		{Val: PBM_GETBARCOLOR, Name: "PBM_GETBARCOLOR"},
+		{Val: PBM_SETSTATE, Name: "PBM_SETSTATE"},
+		{Val: PBM_GETSTATE, Name: "PBM_GETSTATE"},
+		{Val: SB_SETTEXT, Name: "SB_SETTEXT"},
+		{Val: SB_GETTEXT, Name: "SB_GETTEXT"},
+		{Val: SB_GETTEXTLENGTH, Name: "SB_GETTEXTLENGTH"},
+		{Val: SB_SETPARTS, Name: "SB_SETPARTS"},
+		{Val: SB_GETPARTS, Name: "SB_GETPARTS"},
+		{Val: SB_GETBORDERS, Name: "SB_GETBORDERS"},
+		{Val: SB_SETMINHEIGHT, Name: "SB_SETMINHEIGHT"},
+		{Val: SB_SIMPLE, Name: "SB_SIMPLE"},
+		{Val: SB_GETRECT, Name: "SB_GETRECT"},
+		{Val: SB_ISSIMPLE, Name: "SB_ISSIMPLE"},
+		{Val: SB_SETICON, Name: "SB_SETICON"},
+		{Val: SB_SETTIPTEXT, Name: "SB_SETTIPTEXT"},
+		{Val: SB_GETTIPTEXT, Name: "SB_GETTIPTEXT"},
+		{Val: SB_GETICON, Name: "SB_GETICON"},
+		{Val: TB_ENABLEBUTTON, Name: "TB_ENABLEBUTTON"},
+		{Val: TB_CHECKBUTTON, Name: "TB_CHECKBUTTON"},
+		{Val: TB_PRESSBUTTON, Name: "TB_PRESSBUTTON"},
+		{Val: TB_HIDEBUTTON, Name: "TB_HIDEBUTTON"},
+		{Val: TB_INDETERMINATE, Name: "TB_INDETERMINATE"},
+		{Val: TB_MARKBUTTON, Name: "TB_MARKBUTTON"},
+		{Val: TB_ISBUTTONENABLED, Name: "TB_ISBUTTONENABLED"},
+		{Val: TB_ISBUTTONCHECKED, Name: "TB_ISBUTTONCHECKED"},
+		{Val: TB_ISBUTTONPRESSED, Name: "TB_ISBUTTONPRESSED"},
+		{Val: TB_ISBUTTONHIDDEN, Name: "TB_ISBUTTONHIDDEN"},
+		{Val: TB_ISBUTTONINDETERMINATE, Name: "TB_ISBUTTONINDETERMINATE"},
+		{Val: TB_ISBUTTONHIGHLIGHTED, Name: "TB_ISBUTTONHIGHLIGHTED"},
+		{Val: TB_SETSTATE, Name: "TB_SETSTATE"},
+		{Val: TB_GETSTATE, Name: "TB_GETSTATE"},
+		{Val: TB_ADDBITMAP, Name: "TB_ADDBITMAP"},
+		{Val: TB_DELETEBUTTON, Name: "TB_DELETEBUTTON"},
+		{Val: TB_GETBUTTON, Name: "TB_GETBUTTON"},
+		{Val: TB_BUTTONCOUNT, Name: "TB_BUTTONCOUNT"},
+		{Val: TB_COMMANDTOINDEX, Name: "TB_COMMANDTOINDEX"},
+		{Val: TB_SAVERESTORE, Name: "TB_SAVERESTORE"},
+		{Val: TB_CUSTOMIZE, Name: "TB_CUSTOMIZE"},
+		{Val: TB_ADDSTRING, Name: "TB_ADDSTRING"},
+		{Val: TB_GETITEMRECT, Name: "TB_GETITEMRECT"},
+		{Val: TB_BUTTONSTRUCTSIZE, Name: "TB_BUTTONSTRUCTSIZE"},
+		{Val: TB_SETBUTTONSIZE, Name: "TB_SETBUTTONSIZE"},
+		{Val: TB_SETBITMAPSIZE, Name: "TB_SETBITMAPSIZE"},
+		{Val: TB_AUTOSIZE, Name: "TB_AUTOSIZE"},
+		{Val: TB_GETTOOLTIPS, Name: "TB_GETTOOLTIPS"},
+		{Val: TB_SETTOOLTIPS, Name: "TB_SETTOOLTIPS"},
+		{Val: TB_SETPARENT, Name: "TB_SETPARENT"},
+		{Val: TB_SETROWS, Name: "TB_SETROWS"},
+		{Val: TB_GETROWS, Name: "TB_GETROWS"},
+		{Val: TB_SETCMDID, Name: "TB_SETCMDID"},
+		{Val: TB_CHANGEBITMAP, Name: "TB_CHANGEBITMAP"},
+		{Val: TB_GETBITMAP, Name: "TB_GETBITMAP"},
+		{Val: TB_GETBUTTONTEXT, Name: "TB_GETBUTTONTEXT"},
+		{Val: TB_REPLACEBITMAP, Name: "TB_REPLACEBITMAP"},
+		{Val: TB_SETINDENT, Name: "TB_SETINDENT"},
+		{Val: TB_SETIMAGELIST, Name: "TB_SETIMAGELIST"},
+		{Val: TB_GETIMAGELIST, Name: "TB_GETIMAGELIST"},
+		{Val: TB_LOADIMAGES, Name: "TB_LOADIMAGES"},
+		{Val: TB_GETRECT, Name: "TB_GETRECT"},
+		{Val: TB_SETHOTIMAGELIST, Name: "TB_SETHOTIMAGELIST"},
+		{Val: TB_GETHOTIMAGELIST, Name: "TB_GETHOTIMAGELIST"},
+		{Val: TB_SETDISABLEDIMAGELIST, Name: "TB_SETDISABLEDIMAGELIST"},
+		{Val: TB_GETDISABLEDIMAGELIST, Name: "TB_GETDISABLEDIMAGELIST"},
+		{Val: TB_SETSTYLE, Name: "TB_SETSTYLE"},
+		{Val: TB_GETSTYLE, Name: "TB_GETSTYLE"},
+		{Val: TB_GETBUTTONSIZE, Name: "TB_GETBUTTONSIZE"},
+		{Val: TB_SETBUTTONWIDTH, Name: "TB_SETBUTTONWIDTH"},
+		{Val: TB_SETMAXTEXTROWS, Name: "TB_SETMAXTEXTROWS"},
+		{Val: TB_GETTEXTROWS, Name: "TB_GETTEXTROWS"},
+		{Val: TB_GETOBJECT, Name: "TB_GETOBJECT"},
+		{Val: TB_GETHOTITEM, Name: "TB_GETHOTITEM"},
+		{Val: TB_SETHOTITEM, Name: "TB_SETHOTITEM"},
+		{Val: TB_SETANCHORHIGHLIGHT, Name: "TB_SETANCHORHIGHLIGHT"},
+		{Val: TB_GETANCHORHIGHLIGHT, Name: "TB_GETANCHORHIGHLIGHT"},
+		{Val: TB_GETINSERTMARK, Name: "TB_GETINSERTMARK"},
+		{Val: TB_SETINSERTMARK, Name: "TB_SETINSERTMARK"},
+		{Val: TB_INSERTMARKHITTEST, Name: "TB_INSERTMARKHITTEST"},
+		{Val: TB_MOVEBUTTON, Name: "TB_MOVEBUTTON"},
+		{Val: TB_GETMAXSIZE, Name: "TB_GETMAXSIZE"},
+		{Val: TB_SETEXTENDEDSTYLE, Name: "TB_SETEXTENDEDSTYLE"},
+		{Val: TB_GETEXTENDEDSTYLE, Name: "TB_GETEXTENDEDSTYLE"},
+		{Val: TB_GETPADDING, Name: "TB_GETPADDING"},
+		{Val: TB_SETPADDING, Name: "TB_SETPADDING"},
+		{Val: TB_SETINSERTMARKCOLOR, Name: "TB_SETINSERTMARKCOLOR"},
+		{Val: TB_GETINSERTMARKCOLOR, Name: "TB_GETINSERTMARKCOLOR"},
+		{Val: TB_MAPACCELERATOR, Name: "TB_MAPACCELERATOR"},
+		{Val: TB_GETBITMAPFLAGS, Name: "TB_GETBITMAPFLAGS"},
+		{Val: TB_GETBUTTONINFO, Name: "TB_GETBUTTONINFO"},
+		{Val: TB_SETBUTTONINFO, Name: "TB_SETBUTTONINFO"},
+		{Val: TB_INSERTBUTTON, Name: "TB_INSERTBUTTON"},
+		{Val: TB_ADDBUTTONS, Name: "TB_ADDBUTTONS"},
+		{Val: TB_HITTEST, Name: "TB_HITTEST"},
+		{Val: TB_SETDRAWTEXTFLAGS, Name: "TB_SETDRAWTEXTFLAGS"},
+		{Val: TB_GETSTRING, Name: "TB_GETSTRING"},
+		{Val: TB_SETBOUNDINGSIZE, Name: "TB_SETBOUNDINGSIZE"},
+		{Val: TB_SETHOTITEM2, Name: "TB_SETHOTITEM2"},
+		{Val: TB_HASACCELERATOR, Name: "TB_HASACCELERATOR"},
+		{Val: TB_SETLISTGAP, Name: "TB_SETLISTGAP"},
+		{Val: TB_GETIMAGELISTCOUNT, Name: "TB_GETIMAGELISTCOUNT"},
+		{Val: TB_GETIDEALSIZE, Name: "TB_GETIDEALSIZE"},
+		{Val: TB_GETMETRICS, Name: "TB_GETMETRICS"},
+		{Val: TB_SETMETRICS, Name: "TB_SETMETRICS"},
+		{Val: TB_GETITEMDROPDOWNRECT, Name: "TB_GETITEMDROPDOWNRECT"},
+		{Val: TB_SETPRESSEDIMAGELIST, Name: "TB_SETPRESSEDIMAGELIST"},
+		{Val: TB_GETPRESSEDIMAGELIST, Name: "TB_GETPRESSEDIMAGELIST"},
+		{Val: TBM_GETRANGEMIN, Name: "TBM_GETRANGEMIN"},
+		{Val: TBM_GETRANGEMAX, Name: "TBM_GETRANGEMAX"},
+		{Val: TBM_GETTIC, Name: "TBM_GETTIC"},
+		{Val: TBM_SETTIC, Name: "TBM_SETTIC"},
+		{Val: TBM_SETPOS, Name: "TBM_SETPOS"},
+		{Val: TBM_SETRANGE, Name: "TBM_SETRANGE"},
+		{Val: TBM_SETRANGEMIN, Name: "TBM_SETRANGEMIN"},
+		{Val: TBM_SETRANGEMAX, Name: "TBM_SETRANGEMAX"},
+		{Val: TBM_CLEARTICS, Name: "TBM_CLEARTICS"},
+		{Val: TBM_SETSEL, Name: "TBM_SETSEL"},
+		{Val: TBM_SETSELSTART, Name: "TBM_SETSELSTART"},
+		{Val: TBM_SETSELEND, Name: "TBM_SETSELEND"},
+		{Val: TBM_GETPTICS, Name: "TBM_GETPTICS"},
+		{Val: TBM_GETTICPOS, Name: "TBM_GETTICPOS"},
+		{Val: TBM_GETNUMTICS, Name: "TBM_GETNUMTICS"},
+		{Val: TBM_GETSELSTART, Name: "TBM_GETSELSTART"},
+		{Val: TBM_GETSELEND, Name: "TBM_GETSELEND"},
+		{Val: TBM_CLEARSEL, Name: "TBM_CLEARSEL"},
+		{Val: TBM_SETTICFREQ, Name: "TBM_SETTICFREQ"},
+		{Val: TBM_SETPAGESIZE, Name: "TBM_SETPAGESIZE"},
+		{Val: TBM_GETPAGESIZE, Name: "TBM_GETPAGESIZE"},
+		{Val: TBM_SETLINESIZE, Name: "TBM_SETLINESIZE"},
+		{Val: TBM_GETLINESIZE, Name: "TBM_GETLINESIZE"},
+		{Val: TBM_GETTHUMBRECT, Name: "TBM_GETTHUMBRECT"},
+		{Val: TBM_GETCHANNELRECT, Name: "TBM_GETCHANNELRECT"},
+		{Val: TBM_SETTHUMBLENGTH, Name: "TBM_SETTHUMBLENGTH"},
+		{Val: TBM_GETTHUMBLENGTH, Name: "TBM_GETTHUMBLENGTH"},
+		{Val: TBM_SETTOOLTIPS, Name: "TBM_SETTOOLTIPS"},
+		{Val: TBM_GETTOOLTIPS, Name: "TBM_GETTOOLTIPS"},
+		{Val: TBM_SETTIPSIDE, Name: "TBM_SETTIPSIDE"},
+		{Val: TBM_SETBUDDY, Name: "TBM_SETBUDDY"},
+		{Val: TBM_GETBUDDY, Name: "TBM_GETBUDDY"},
+		{Val: TCM_GETIMAGELIST, Name: "TCM_GETIMAGELIST"},
+		{Val: TCM_SETIMAGELIST, Name: "TCM_SETIMAGELIST"},
+		{Val: TCM_GETITEMCOUNT, Name: "TCM_GETITEMCOUNT"},
+		{Val: TCM_GETITEM, Name: "TCM_GETITEM"},
+		{Val: TCM_SETITEM, Name: "TCM_SETITEM"},
+		{Val: TCM_INSERTITEM, Name: "TCM_INSERTITEM"},
+		{Val: TCM_DELETEITEM, Name: "TCM_DELETEITEM"},
+		{Val: TCM_DELETEALLITEMS, Name: "TCM_DELETEALLITEMS"},
+		{Val: TCM_GETITEMRECT, Name: "TCM_GETITEMRECT"},
+		{Val: TCM_GETCURSEL, Name: "TCM_GETCURSEL"},
+		{Val: TCM_SETCURSEL, Name: "TCM_SETCURSEL"},
+		{Val: TCM_HITTEST, Name: "TCM_HITTEST"},
+		{Val: TCM_SETITEMEXTRA, Name: "TCM_SETITEMEXTRA"},
+		{Val: TCM_ADJUSTRECT, Name: "TCM_ADJUSTRECT"},
+		{Val: TCM_SETITEMSIZE, Name: "TCM_SETITEMSIZE"},
+		{Val: TCM_REMOVEIMAGE, Name: "TCM_REMOVEIMAGE"},
+		{Val: TCM_SETPADDING, Name: "TCM_SETPADDING"},
+		{Val: TCM_GETROWCOUNT, Name: "TCM_GETROWCOUNT"},
+		{Val: TCM_GETTOOLTIPS, Name: "TCM_GETTOOLTIPS"},
+		{Val: TCM_SETTOOLTIPS, Name: "TCM_SETTOOLTIPS"},
+		{Val: TCM_GETCURFOCUS, Name: "TCM_GETCURFOCUS"},
+		{Val: TCM_SETCURFOCUS, Name: "TCM_SETCURFOCUS"},
+		{Val: TCM_SETMINTABWIDTH, Name: "TCM_SETMINTABWIDTH"},
+		{Val: TCM_DESELECTALL, Name: "TCM_DESELECTALL"},
+		{Val: TCM_HIGHLIGHTITEM, Name: "TCM_HIGHLIGHTITEM"},
+		{Val: TCM_SETEXTENDEDSTYLE, Name: "TCM_SETEXTENDEDSTYLE"},
+		{Val: TCM_GETEXTENDEDSTYLE, Name: "TCM_GETEXTENDEDSTYLE"},
+		{Val: TVM_INSERTITEM, Name: "TVM_INSERTITEM"},
+		{Val: TVM_DELETEITEM, Name: "TVM_DELETEITEM"},
+		{Val: TVM_EXPAND, Name: "TVM_EXPAND"},
+		{Val: TVM_GETITEMRECT, Name: "TVM_GETITEMRECT"},
+		{Val: TVM_GETCOUNT, Name: "TVM_GETCOUNT"},
+		{Val: TVM_GETINDENT, Name: "TVM_GETINDENT"},
+		{Val: TVM_SETINDENT, Name: "TVM_SETINDENT"},
+		{Val: TVM_GETIMAGELIST, Name: "TVM_GETIMAGELIST"},
+		{Val: TVM_SETIMAGELIST, Name: "TVM_SETIMAGELIST"},
+		{Val: TVM_GETNEXTITEM, Name: "TVM_GETNEXTITEM"},
+		{Val: TVM_SELECTITEM, Name: "TVM_SELECTITEM"},
+		{Val: TVM_GETITEM, Name: "TVM_GETITEM"},
+		{Val: TVM_SETITEM, Name: "TVM_SETITEM"},
+		{Val: TVM_EDITLABEL, Name: "TVM_EDITLABEL"},
+		{Val: TVM_GETEDITCONTROL, Name: "TVM_GETEDITCONTROL"},
+		{Val: TVM_GETVISIBLECOUNT, Name: "TVM_GETVISIBLECOUNT"},
+		{Val: TVM_HITTEST, Name: "TVM_HITTEST"},
+		{Val: TVM_CREATEDRAGIMAGE, Name: "TVM_CREATEDRAGIMAGE"},
+		{Val: TVM_SORTCHILDREN, Name: "TVM_SORTCHILDREN"},
+		{Val: TVM_ENSUREVISIBLE, Name: "TVM_ENSUREVISIBLE"},
+		{Val: TVM_SORTCHILDRENCB, Name: "TVM_SORTCHILDRENCB"},
+		{Val: TVM_ENDEDITLABELNOW, Name: "TVM_ENDEDITLABELNOW"},
+		{Val: TVM_GETISEARCHSTRING, Name: "TVM_GETISEARCHSTRING"},
+		{Val: TVM_SETTOOLTIPS, Name: "TVM_SETTOOLTIPS"},
+		{Val: TVM_GETTOOLTIPS, Name: "TVM_GETTOOLTIPS"},
+		{Val: TVM_SETINSERTMARK, Name: "TVM_SETINSERTMARK"},
+		{Val: TVM_SETITEMHEIGHT, Name: "TVM_SETITEMHEIGHT"},
+		{Val: TVM_GETITEMHEIGHT, Name: "TVM_GETITEMHEIGHT"},
+		{Val: TVM_SETBKCOLOR, Name: "TVM_SETBKCOLOR"},
+		{Val: TVM_SETTEXTCOLOR, Name: "TVM_SETTEXTCOLOR"},
+		{Val: TVM_GETBKCOLOR, Name: "TVM_GETBKCOLOR"},
+		{Val: TVM_GETTEXTCOLOR, Name: "TVM_GETTEXTCOLOR"},
+		{Val: TVM_SETSCROLLTIME, Name: "TVM_SETSCROLLTIME"},
+		{Val: TVM_GETSCROLLTIME, Name: "TVM_GETSCROLLTIME"},
+		{Val: TVM_SETINSERTMARKCOLOR, Name: "TVM_SETINSERTMARKCOLOR"},
+		{Val: TVM_GETINSERTMARKCOLOR, Name: "TVM_GETINSERTMARKCOLOR"},
+		{Val: TVM_SETBORDER, Name: "TVM_SETBORDER"},
+		{Val: TVM_GETITEMSTATE, Name: "TVM_GETITEMSTATE"},
+		{Val: TVM_SETLINECOLOR, Name: "TVM_SETLINECOLOR"},
+		{Val: TVM_GETLINECOLOR, Name: "TVM_GETLINECOLOR"},
+		{Val: TVM_MAPACCIDTOHTREEITEM, Name: "TVM_MAPACCIDTOHTREEITEM"},
+		{Val: TVM_MAPHTREEITEMTOACCID, Name: "TVM_MAPHTREEITEMTOACCID"},
+		{Val: TVM_SETEXTENDEDSTYLE, Name: "TVM_SETEXTENDEDSTYLE"},
+		{Val: TVM_GETEXTENDEDSTYLE, Name: "TVM_GETEXTENDEDSTYLE"},
+		{Val: TVM_SETAUTOSCROLLINFO, Name: "TVM_SETAUTOSCROLLINFO"},
+		{Val: TVM_SETHOT, Name: "TVM_SETHOT"},
+		{Val: TVM_GETSELECTEDCOUNT, Name: "TVM_GETSELECTEDCOUNT"},
+		{Val: TVM_SHOWINFOTIP, Name: "TVM_SHOWINFOTIP"},
+		{Val: TVM_GETITEMPARTRECT, Name: "TVM_GETITEMPARTRECT"},
+		{Val: UDM_SETRANGE, Name: "UDM_SETRANGE"},
+		{Val: UDM_GETRANGE, Name: "UDM_GETRANGE"},
+		{Val: UDM_SETPOS, Name: "UDM_SETPOS"},
+		{Val: UDM_GETPOS, Name: "UDM_GETPOS"},
+		{Val: UDM_SETBUDDY, Name: "UDM_SETBUDDY"},
+		{Val: UDM_GETBUDDY, Name: "UDM_GETBUDDY"},
+		{Val: UDM_SETACCEL, Name: "UDM_SETACCEL"},
+		{Val: UDM_GETACCEL, Name: "UDM_GETACCEL"},
+		{Val: UDM_SETBASE, Name: "UDM_SETBASE"},
+		{Val: UDM_GETBASE, Name: "UDM_GETBASE"},
+		{Val: UDM_SETRANGE32, Name: "UDM_SETRANGE32"},
+		{Val: UDM_GETRANGE32, Name: "UDM_GETRANGE32"},
+		{Val: UDM_SETPOS32, Name: "UDM_SETPOS32"},
+		{Val: UDM_GETPOS32, Name: "UDM_GETPOS32"},
+		{Val: EM_SETLIMITTEXT, Name: "EM_SETLIMITTEXT"},
+		{Val: HDM_SETUNICODEFORMAT, Name: "HDM_SETUNICODEFORMAT"},
+		{Val: HDM_GETUNICODEFORMAT, Name: "HDM_GETUNICODEFORMAT"},
+		{Val: MCM_SETUNICODEFORMAT, Name: "MCM_SETUNICODEFORMAT"},
+		{Val: MCM_GETUNICODEFORMAT, Name: "MCM_GETUNICODEFORMAT"},
+		{Val: PBM_SETBKCOLOR, Name: "PBM_SETBKCOLOR"},
+		{Val: SB_SETUNICODEFORMAT, Name: "SB_SETUNICODEFORMAT"},
+		{Val: SB_GETUNICODEFORMAT, Name: "SB_GETUNICODEFORMAT"},
+		{Val: TB_SETCOLORSCHEME, Name: "TB_SETCOLORSCHEME"},
+		{Val: TB_GETCOLORSCHEME, Name: "TB_GETCOLORSCHEME"},
+		{Val: TB_SETUNICODEFORMAT, Name: "TB_SETUNICODEFORMAT"},
+		{Val: TB_GETUNICODEFORMAT, Name: "TB_GETUNICODEFORMAT"},
+		{Val: TB_SETWINDOWTHEME, Name: "TB_SETWINDOWTHEME"},
+		{Val: TBM_GETPOS, Name: "TBM_GETPOS"},
+		{Val: TBM_SETUNICODEFORMAT, Name: "TBM_SETUNICODEFORMAT"},
+		{Val: TBM_GETUNICODEFORMAT, Name: "TBM_GETUNICODEFORMAT"},
+		{Val: TCM_SETUNICODEFORMAT, Name: "TCM_SETUNICODEFORMAT"},
+		{Val: TCM_GETUNICODEFORMAT, Name: "TCM_GETUNICODEFORMAT"},
+		{Val: TVM_SETUNICODEFORMAT, Name: "TVM_SETUNICODEFORMAT"},
+		{Val: TVM_GETUNICODEFORMAT, Name: "TVM_GETUNICODEFORMAT"},
+		{Val: UDM_SETUNICODEFORMAT, Name: "UDM_SETUNICODEFORMAT"},
+		{Val: UDM_GETUNICODEFORMAT, Name: "UDM_GETUNICODEFORMAT"},
+	},
+}
+
+// Returns the name of the constant, like "WM_NULL".
+func (v WM) String() string {
+	return _WM_names.String(v)
+}
+
+// Parses the name of a [WM] constant, or a number.
+func ParseWM(s string) (WM, error) {
+	return _WM_names.Parse(s)
+}
+
+var _PROPERTYORIGIN_names = enum.Enum[PROPERTYORIGIN]{
+	Type: "PROPERTYORIGIN",
+	Values: []enum.Name[PROPERTYORIGIN]{
+		{Val: PROPERTYORIGIN_STATE, Name: "PROPERTYORIGIN_STATE"},
+		{Val: PROPERTYORIGIN_PART, Name: "PROPERTYORIGIN_PART"},
+		{Val: PROPERTYORIGIN_CLASS, Name: "PROPERTYORIGIN_CLASS"},
+		{Val: PROPERTYORIGIN_GLOBAL, Name: "PROPERTYORIGIN_GLOBAL"},
+		{Val: PROPERTYORIGIN_NOTFOUND, Name: "PROPERTYORIGIN_NOTFOUND"},
+	},
+}
+
+// Returns the name of the constant, like "PROPERTYORIGIN_STATE".
+func (v PROPERTYORIGIN) String() string {
+	return _PROPERTYORIGIN_names.String(v)
+}
+
+// Parses the name of a [PROPERTYORIGIN] constant, or a number.
+func ParsePROPERTYORIGIN(s string) (PROPERTYORIGIN, error) {
+	return _PROPERTYORIGIN_names.Parse(s)
+}
+
+var _TMT_names = enum.Enum[TMT]{
+	Type: "TMT",
+	Values: []enum.Name[TMT]{
+		{Val: TMT_DIBDATA, Name: "TMT_DIBDATA"},
+		{Val: TMT_GLYPHDIBDATA, Name: "TMT_GLYPHDIBDATA"},
+		{Val: TMT_ENUM, Name: "TMT_ENUM"},
+		{Val: TMT_STRING, Name: "TMT_STRING"},
+		{Val: TMT_INT, Name: "TMT_INT"},
+		{Val: TMT_BOOL, Name: "TMT_BOOL"},
+		{Val: TMT_COLOR, Name: "TMT_COLOR"},
+		{Val: TMT_MARGINS, Name: "TMT_MARGINS"},
+		{Val: TMT_FILENAME, Name: "TMT_FILENAME"},
+		{Val: TMT_SIZE, Name: "TMT_SIZE"},
+		{Val: TMT_POSITION, Name: "TMT_POSITION"},
+		{Val: TMT_RECT, Name: "TMT_RECT"},
+		{Val: TMT_FONT, Name: "TMT_FONT"},
+		{Val: TMT_INTLIST, Name: "TMT_INTLIST"},
+		{Val: TMT_HBITMAP, Name: "TMT_HBITMAP"},
+		{Val: TMT_DISKSTREAM, Name: "TMT_DISKSTREAM"},
+		{Val: TMT_STREAM, Name: "TMT_STREAM"},
+		{Val: TMT_BITMAPREF, Name: "TMT_BITMAPREF"},
+		{Val: TMT_FLOAT, Name: "TMT_FLOAT"},
+		{Val: TMT_FLOATLIST, Name: "TMT_FLOATLIST"},
+		{Val: TMT_COLORSCHEMES, Name: "TMT_COLORSCHEMES"},
+		{Val: TMT_SIZES, Name: "TMT_SIZES"},
+		{Val: TMT_CHARSET, Name: "TMT_CHARSET"},
+		{Val: TMT_NAME, Name: "TMT_NAME"},
+		{Val: TMT_DISPLAYNAME, Name: "TMT_DISPLAYNAME"},
+		{Val: TMT_TOOLTIP, Name: "TMT_TOOLTIP"},
+		{Val: TMT_COMPANY, Name: "TMT_COMPANY"},
+		{Val: TMT_AUTHOR, Name: "TMT_AUTHOR"},
+		{Val: TMT_COPYRIGHT, Name: "TMT_COPYRIGHT"},
+		{Val: TMT_URL, Name: "TMT_URL"},
+		{Val: TMT_VERSION, Name: "TMT_VERSION"},
+		{Val: TMT_DESCRIPTION, Name: "TMT_DESCRIPTION"},
+		{Val: TMT_CAPTIONFONT, Name: "TMT_CAPTIONFONT"},
+		{Val: TMT_SMALLCAPTIONFONT, Name: "TMT_SMALLCAPTIONFONT"},
+		{Val: TMT_MENUFONT, Name: "TMT_MENUFONT"},
+		{Val: TMT_STATUSFONT, Name: "TMT_STATUSFONT"},
+		{Val: TMT_MSGBOXFONT, Name: "TMT_MSGBOXFONT"},
+		{Val: TMT_ICONTITLEFONT, Name: "TMT_ICONTITLEFONT"},
+		{Val: TMT_HEADING1FONT, Name: "TMT_HEADING1FONT"},
+		{Val: TMT_HEADING2FONT, Name: "TMT_HEADING2FONT"},
+		{Val: TMT_BODYFONT, Name: "TMT_BODYFONT"},
+		{Val: TMT_FLATMENUS, Name: "TMT_FLATMENUS"},
+		{Val: TMT_SIZINGBORDERWIDTH, Name: "TMT_SIZINGBORDERWIDTH"},
+		{Val: TMT_SCROLLBARWIDTH, Name: "TMT_SCROLLBARWIDTH"},
+		{Val: TMT_SCROLLBARHEIGHT, Name: "TMT_SCROLLBARHEIGHT"},
+		{Val: TMT_CAPTIONBARWIDTH, Name: "TMT_CAPTIONBARWIDTH"},
+		{Val: TMT_CAPTIONBARHEIGHT, Name: "TMT_CAPTIONBARHEIGHT"},
+		{Val: TMT_SMCAPTIONBARWIDTH, Name: "TMT_SMCAPTIONBARWIDTH"},
+		{Val: TMT_SMCAPTIONBARHEIGHT, Name: "TMT_SMCAPTIONBARHEIGHT"},
+		{Val: TMT_MENUBARWIDTH, Name: "TMT_MENUBARWIDTH"},
+		{Val: TMT_MENUBARHEIGHT, Name: "TMT_MENUBARHEIGHT"},
+		{Val: TMT_PADDEDBORDERWIDTH, Name: "TMT_PADDEDBORDERWIDTH"},
+		{Val: TMT_MINCOLORDEPTH, Name: "TMT_MINCOLORDEPTH"},
+		{Val: TMT_CSSNAME, Name: "TMT_CSSNAME"},
+		{Val: TMT_XMLNAME, Name: "TMT_XMLNAME"},
+		{Val: TMT_LASTUPDATED, Name: "TMT_LASTUPDATED"},
+		{Val: TMT_ALIAS, Name: "TMT_ALIAS"},
+		{Val: TMT_SCROLLBAR, Name: "TMT_SCROLLBAR"},
+		{Val: TMT_BACKGROUND, Name: "TMT_BACKGROUND"},
+		{Val: TMT_ACTIVECAPTION, Name: "TMT_ACTIVECAPTION"},
+		{Val: TMT_INACTIVECAPTION, Name: "TMT_INACTIVECAPTION"},
+		{Val: TMT_MENU, Name: "TMT_MENU"},
+		{Val: TMT_WINDOW, Name: "TMT_WINDOW"},
+		{Val: TMT_WINDOWFRAME, Name: "TMT_WINDOWFRAME"},
+		{Val: TMT_MENUTEXT, Name: "TMT_MENUTEXT"},
+		{Val: TMT_WINDOWTEXT, Name: "TMT_WINDOWTEXT"},
+		{Val: TMT_CAPTIONTEXT, Name: "TMT_CAPTIONTEXT"},
+		{Val: TMT_ACTIVEBORDER, Name: "TMT_ACTIVEBORDER"},
+		{Val: TMT_INACTIVEBORDER, Name: "TMT_INACTIVEBORDER"},
+		{Val: TMT_APPWORKSPACE, Name: "TMT_APPWORKSPACE"},
+		{Val: TMT_HIGHLIGHT, Name: "TMT_HIGHLIGHT"},
+		{Val: TMT_HIGHLIGHTTEXT, Name: "TMT_HIGHLIGHTTEXT"},
+		{Val: TMT_BTNFACE, Name: "TMT_BTNFACE"},
+		{Val: TMT_BTNSHADOW, Name: "TMT_BTNSHADOW"},
+		{Val: TMT_GRAYTEXT, Name: "TMT_GRAYTEXT"},
+		{Val: TMT_BTNTEXT, Name: "TMT_BTNTEXT"},
+		{Val: TMT_INACTIVECAPTIONTEXT, Name: "TMT_INACTIVECAPTIONTEXT"},
+		{Val: TMT_BTNHIGHLIGHT, Name: "TMT_BTNHIGHLIGHT"},
+		{Val: TMT_DKSHADOW3D, Name: "TMT_DKSHADOW3D"},
+		{Val: TMT_LIGHT3D, Name: "TMT_LIGHT3D"},
+		{Val: TMT_INFOTEXT, Name: "TMT_INFOTEXT"},
+		{Val: TMT_INFOBK, Name: "TMT_INFOBK"},
+		{Val: TMT_BUTTONALTERNATEFACE, Name: "TMT_BUTTONALTERNATEFACE"},
+		{Val: TMT_HOTTRACKING, Name: "TMT_HOTTRACKING"},
+		{Val: TMT_GRADIENTACTIVECAPTION, Name: "TMT_GRADIENTACTIVECAPTION"},
+		{Val: TMT_GRADIENTINACTIVECAPTION, Name: "TMT_GRADIENTINACTIVECAPTION"},
+		{Val: TMT_MENUHILIGHT, Name: "TMT_MENUHILIGHT"},
+		{Val: TMT_MENUBAR, Name: "TMT_MENUBAR"},
+		{Val: TMT_FROMHUE1, Name: "TMT_FROMHUE1"},
+		{Val: TMT_FROMHUE2, Name: "TMT_FROMHUE2"},
+		{Val: TMT_FROMHUE3, Name: "TMT_FROMHUE3"},
+		{Val: TMT_FROMHUE4, Name: "TMT_FROMHUE4"},
+		{Val: TMT_FROMHUE5, Name: "TMT_FROMHUE5"},
+		{Val: TMT_TOHUE1, Name: "TMT_TOHUE1"},
+		{Val: TMT_TOHUE2, Name: "TMT_TOHUE2"},
+		{Val: TMT_TOHUE3, Name: "TMT_TOHUE3"},
+		{Val: TMT_TOHUE4, Name: "TMT_TOHUE4"},
+		{Val: TMT_TOHUE5, Name: "TMT_TOHUE5"},
+		{Val: TMT_FROMCOLOR1, Name: "TMT_FROMCOLOR1"},
+		{Val: TMT_FROMCOLOR2, Name: "TMT_FROMCOLOR2"},
+		{Val: TMT_FROMCOLOR3, Name: "TMT_FROMCOLOR3"},
+		{Val: TMT_FROMCOLOR4, Name: "TMT_FROMCOLOR4"},
+		{Val: TMT_FROMCOLOR5, Name: "TMT_FROMCOLOR5"},
+		{Val: TMT_TOCOLOR1, Name: "TMT_TOCOLOR1"},
+		{Val: TMT_TOCOLOR2, Name: "TMT_TOCOLOR2"},
+		{Val: TMT_TOCOLOR3, Name: "TMT_TOCOLOR3"},
+		{Val: TMT_TOCOLOR4, Name: "TMT_TOCOLOR4"},
+		{Val: TMT_TOCOLOR5, Name: "TMT_TOCOLOR5"},
+		{Val: TMT_TRANSPARENT, Name: "TMT_TRANSPARENT"},
+		{Val: TMT_AUTOSIZE, Name: "TMT_AUTOSIZE"},
+		{Val: TMT_BORDERONLY, Name: "TMT_BORDERONLY"},
+		{Val: TMT_COMPOSITED, Name: "TMT_COMPOSITED"},
+		{Val: TMT_BGFILL, Name: "TMT_BGFILL"},
+		{Val: TMT_GLYPHTRANSPARENT, Name: "TMT_GLYPHTRANSPARENT"},
+		{Val: TMT_GLYPHONLY, Name: "TMT_GLYPHONLY"},
+		{Val: TMT_ALWAYSSHOWSIZINGBAR, Name: "TMT_ALWAYSSHOWSIZINGBAR"},
+		{Val: TMT_MIRRORIMAGE, Name: "TMT_MIRRORIMAGE"},
+		{Val: TMT_UNIFORMSIZING, Name: "TMT_UNIFORMSIZING"},
+		{Val: TMT_INTEGRALSIZING, Name: "TMT_INTEGRALSIZING"},
+		{Val: TMT_SOURCEGROW, Name: "TMT_SOURCEGROW"},
+		{Val: TMT_SOURCESHRINK, Name: "TMT_SOURCESHRINK"},
+		{Val: TMT_DRAWBORDERS, Name: "TMT_DRAWBORDERS"},
+		{Val: TMT_NOETCHEDEFFECT, Name: "TMT_NOETCHEDEFFECT"},
+		{Val: TMT_TEXTAPPLYOVERLAY, Name: "TMT_TEXTAPPLYOVERLAY"},
+		{Val: TMT_TEXTGLOW, Name: "TMT_TEXTGLOW"},
+		{Val: TMT_TEXTITALIC, Name: "TMT_TEXTITALIC"},
+		{Val: TMT_COMPOSITEDOPAQUE, Name: "TMT_COMPOSITEDOPAQUE"},
+		{Val: TMT_LOCALIZEDMIRRORIMAGE, Name: "TMT_LOCALIZEDMIRRORIMAGE"},
+		{Val: TMT_IMAGECOUNT, Name: "TMT_IMAGECOUNT"},
+		{Val: TMT_ALPHALEVEL, Name: "TMT_ALPHALEVEL"},
+		{Val: TMT_BORDERSIZE, Name: "TMT_BORDERSIZE"},
+		{Val: TMT_ROUNDCORNERWIDTH, Name: "TMT_ROUNDCORNERWIDTH"},
+		{Val: TMT_ROUNDCORNERHEIGHT, Name: "TMT_ROUNDCORNERHEIGHT"},
+		{Val: TMT_GRADIENTRATIO1, Name: "TMT_GRADIENTRATIO1"},
+		{Val: TMT_GRADIENTRATIO2, Name: "TMT_GRADIENTRATIO2"},
+		{Val: TMT_GRADIENTRATIO3, Name: "TMT_GRADIENTRATIO3"},
+		{Val: TMT_GRADIENTRATIO4, Name: "TMT_GRADIENTRATIO4"},
+		{Val: TMT_GRADIENTRATIO5, Name: "TMT_GRADIENTRATIO5"},
+		{Val: TMT_PROGRESSCHUNKSIZE, Name: "TMT_PROGRESSCHUNKSIZE"},
+		{Val: TMT_PROGRESSSPACESIZE, Name: "TMT_PROGRESSSPACESIZE"},
+		{Val: TMT_SATURATION, Name: "TMT_SATURATION"},
+		{Val: TMT_TEXTBORDERSIZE, Name: "TMT_TEXTBORDERSIZE"},
+		{Val: TMT_ALPHATHRESHOLD, Name: "TMT_ALPHATHRESHOLD"},
+		{Val: TMT_WIDTH, Name: "TMT_WIDTH"},
+		{Val: TMT_HEIGHT, Name: "TMT_HEIGHT"},
+		{Val: TMT_GLYPHINDEX, Name: "TMT_GLYPHINDEX"},
+		{Val: TMT_TRUESIZESTRETCHMARK, Name: "TMT_TRUESIZESTRETCHMARK"},
+		{Val: TMT_MINDPI1, Name: "TMT_MINDPI1"},
+		{Val: TMT_MINDPI2, Name: "TMT_MINDPI2"},
+		{Val: TMT_MINDPI3, Name: "TMT_MINDPI3"},
+		{Val: TMT_MINDPI4, Name: "TMT_MINDPI4"},
+		{Val: TMT_MINDPI5, Name: "TMT_MINDPI5"},
+		{Val: TMT_TEXTGLOWSIZE, Name: "TMT_TEXTGLOWSIZE"},
+		{Val: TMT_FRAMESPERSECOND, Name: "TMT_FRAMESPERSECOND"},
+		{Val: TMT_PIXELSPERFRAME, Name: "TMT_PIXELSPERFRAME"},
+		{Val: TMT_ANIMATIONDELAY, Name: "TMT_ANIMATIONDELAY"},
+		{Val: TMT_GLOWINTENSITY, Name: "TMT_GLOWINTENSITY"},
+		{Val: TMT_OPACITY, Name: "TMT_OPACITY"},
+		{Val: TMT_COLORIZATIONCOLOR, Name: "TMT_COLORIZATIONCOLOR"},
+		{Val: TMT_COLORIZATIONOPACITY, Name: "TMT_COLORIZATIONOPACITY"},
+		{Val: TMT_MINDPI6, Name: "TMT_MINDPI6"},
+		{Val: TMT_MINDPI7, Name: "TMT_MINDPI7"},
+		{Val: TMT_GLYPHFONT, Name: "TMT_GLYPHFONT"},
+		{Val: TMT_IMAGEFILE, Name: "TMT_IMAGEFILE"},
+		{Val: TMT_IMAGEFILE1, Name: "TMT_IMAGEFILE1"},
+		{Val: TMT_IMAGEFILE2, Name: "TMT_IMAGEFILE2"},
+		{Val: TMT_IMAGEFILE3, Name: "TMT_IMAGEFILE3"},
+		{Val: TMT_IMAGEFILE4, Name: "TMT_IMAGEFILE4"},
+		{Val: TMT_IMAGEFILE5, Name: "TMT_IMAGEFILE5"},
+		{Val: TMT_GLYPHIMAGEFILE, Name: "TMT_GLYPHIMAGEFILE"},
+		{Val: TMT_IMAGEFILE6, Name: "TMT_IMAGEFILE6"},
+		{Val: TMT_IMAGEFILE7, Name: "TMT_IMAGEFILE7"},
+		{Val: TMT_TEXT, Name: "TMT_TEXT"},
+		{Val: TMT_CLASSICVALUE, Name: "TMT_CLASSICVALUE"},
+		{Val: TMT_OFFSET, Name: "TMT_OFFSET"},
+		{Val: TMT_TEXTSHADOWOFFSET, Name: "TMT_TEXTSHADOWOFFSET"},
+		{Val: TMT_MINSIZE, Name: "TMT_MINSIZE"},
+		{Val: TMT_MINSIZE1, Name: "TMT_MINSIZE1"},
+		{Val: TMT_MINSIZE2, Name: "TMT_MINSIZE2"},
+		{Val: TMT_MINSIZE3, Name: "TMT_MINSIZE3"},
+		{Val: TMT_MINSIZE4, Name: "TMT_MINSIZE4"},
+		{Val: TMT_MINSIZE5, Name: "TMT_MINSIZE5"},
+		{Val: TMT_NORMALSIZE, Name: "TMT_NORMALSIZE"},
+		{Val: TMT_MINSIZE6, Name: "TMT_MINSIZE6"},
+		{Val: TMT_MINSIZE7, Name: "TMT_MINSIZE7"},
+		{Val: TMT_SIZINGMARGINS, Name: "TMT_SIZINGMARGINS"},
+		{Val: TMT_CONTENTMARGINS, Name: "TMT_CONTENTMARGINS"},
+		{Val: TMT_CAPTIONMARGINS, Name: "TMT_CAPTIONMARGINS"},
+		{Val: TMT_BORDERCOLOR, Name: "TMT_BORDERCOLOR"},
+		{Val: TMT_FILLCOLOR, Name: "TMT_FILLCOLOR"},
+		{Val: TMT_TEXTCOLOR, Name: "TMT_TEXTCOLOR"},
+		{Val: TMT_EDGELIGHTCOLOR, Name: "TMT_EDGELIGHTCOLOR"},
+		{Val: TMT_EDGEHIGHLIGHTCOLOR, Name: "TMT_EDGEHIGHLIGHTCOLOR"},
+		{Val: TMT_EDGESHADOWCOLOR, Name: "TMT_EDGESHADOWCOLOR"},
+		{Val: TMT_EDGEDKSHADOWCOLOR, Name: "TMT_EDGEDKSHADOWCOLOR"},
+		{Val: TMT_EDGEFILLCOLOR, Name: "TMT_EDGEFILLCOLOR"},
+		{Val: TMT_TRANSPARENTCOLOR, Name: "TMT_TRANSPARENTCOLOR"},
+		{Val: TMT_GRADIENTCOLOR1, Name: "TMT_GRADIENTCOLOR1"},
+		{Val: TMT_GRADIENTCOLOR2, Name: "TMT_GRADIENTCOLOR2"},
+		{Val: TMT_GRADIENTCOLOR3, Name: "TMT_GRADIENTCOLOR3"},
+		{Val: TMT_GRADIENTCOLOR4, Name: "TMT_GRADIENTCOLOR4"},
+		{Val: TMT_GRADIENTCOLOR5, Name: "TMT_GRADIENTCOLOR5"},
+		{Val: TMT_SHADOWCOLOR, Name: "TMT_SHADOWCOLOR"},
+		{Val: TMT_GLOWCOLOR, Name: "TMT_GLOWCOLOR"},
+		{Val: TMT_TEXTBORDERCOLOR, Name: "TMT_TEXTBORDERCOLOR"},
+		{Val: TMT_TEXTSHADOWCOLOR, Name: "TMT_TEXTSHADOWCOLOR"},
+		{Val: TMT_GLYPHTEXTCOLOR, Name: "TMT_GLYPHTEXTCOLOR"},
+		{Val: TMT_GLYPHTRANSPARENTCOLOR, Name: "TMT_GLYPHTRANSPARENTCOLOR"},
+		{Val: TMT_FILLCOLORHINT, Name: "TMT_FILLCOLORHINT"},
+		{Val: TMT_BORDERCOLORHINT, Name: "TMT_BORDERCOLORHINT"},
+		{Val: TMT_ACCENTCOLORHINT, Name: "TMT_ACCENTCOLORHINT"},
+		{Val: TMT_TEXTCOLORHINT, Name: "TMT_TEXTCOLORHINT"},
+		{Val: TMT_HEADING1TEXTCOLOR, Name: "TMT_HEADING1TEXTCOLOR"},
+		{Val: TMT_HEADING2TEXTCOLOR, Name: "TMT_HEADING2TEXTCOLOR"},
+		{Val: TMT_BODYTEXTCOLOR, Name: "TMT_BODYTEXTCOLOR"},
+		{Val: TMT_BGTYPE, Name: "TMT_BGTYPE"},
+		{Val: TMT_BORDERTYPE, Name: "TMT_BORDERTYPE"},
+		{Val: TMT_FILLTYPE, Name: "TMT_FILLTYPE"},
+		{Val: TMT_SIZINGTYPE, Name: "TMT_SIZINGTYPE"},
+		{Val: TMT_HALIGN, Name: "TMT_HALIGN"},
+		{Val: TMT_CONTENTALIGNMENT, Name: "TMT_CONTENTALIGNMENT"},
+		{Val: TMT_VALIGN, Name: "TMT_VALIGN"},
+		{Val: TMT_OFFSETTYPE, Name: "TMT_OFFSETTYPE"},
+		{Val: TMT_ICONEFFECT, Name: "TMT_ICONEFFECT"},
+		{Val: TMT_TEXTSHADOWTYPE, Name: "TMT_TEXTSHADOWTYPE"},
+		{Val: TMT_IMAGELAYOUT, Name: "TMT_IMAGELAYOUT"},
+		{Val: TMT_GLYPHTYPE, Name: "TMT_GLYPHTYPE"},
+		{Val: TMT_IMAGESELECTTYPE, Name: "TMT_IMAGESELECTTYPE"},
+		{Val: TMT_GLYPHFONTSIZINGTYPE, Name: "TMT_GLYPHFONTSIZINGTYPE"},
+		{Val: TMT_TRUESIZESCALINGTYPE, Name: "TMT_TRUESIZESCALINGTYPE"},
+		{Val: TMT_USERPICTURE, Name: "TMT_USERPICTURE"},
+		{Val: TMT_DEFAULTPANESIZE, Name: "TMT_DEFAULTPANESIZE"},
+		{Val: TMT_BLENDCOLOR, Name: "TMT_BLENDCOLOR"},
+		{Val: TMT_CUSTOMSPLITRECT, Name: "TMT_CUSTOMSPLITRECT"},
+		{Val: TMT_ANIMATIONBUTTONRECT, Name: "TMT_ANIMATIONBUTTONRECT"},
+		{Val: TMT_ANIMATIONDURATION, Name: "TMT_ANIMATIONDURATION"},
+		{Val: TMT_TRANSITIONDURATIONS, Name: "TMT_TRANSITIONDURATIONS"},
+		{Val: TMT_SCALEDBACKGROUND, Name: "TMT_SCALEDBACKGROUND"},
+		{Val: TMT_ATLASIMAGE, Name: "TMT_ATLASIMAGE"},
+		{Val: TMT_ATLASINPUTIMAGE, Name: "TMT_ATLASINPUTIMAGE"},
+		{Val: TMT_ATLASRECT, Name: "TMT_ATLASRECT"},
+		{Val: TMT_FIRST_RCSTRING_NAME, Name: "TMT_FIRST_RCSTRING_NAME"},
+		{Val: TMT_LAST_RCSTRING_NAME, Name: "TMT_LAST_RCSTRING_NAME"},
+		{Val: TMT_FIRSTFONT, Name: "TMT_FIRSTFONT"},
+		{Val: TMT_LASTFONT, Name: "TMT_LASTFONT"},
+		{Val: TMT_FIRSTBOOL, Name: "TMT_FIRSTBOOL"},
+		{Val: TMT_LASTBOOL, Name: "TMT_LASTBOOL"},
+		{Val: TMT_FIRSTSIZE, Name: "TMT_FIRSTSIZE"},
+		{Val: TMT_LASTSIZE, Name: "TMT_LASTSIZE"},
+		{Val: TMT_FIRSTINT, Name: "TMT_FIRSTINT"},
+		{Val: TMT_LASTINT, Name: "TMT_LASTINT"},
+		{Val: TMT_FIRSTSTRING, Name: "TMT_FIRSTSTRING"},
+		{Val: TMT_LASTSTRING, Name: "TMT_LASTSTRING"},
+		{Val: TMT_FIRSTCOLOR, Name: "TMT_FIRSTCOLOR"},
+		{Val: TMT_LASTCOLOR, Name: "TMT_LASTCOLOR"},
+	},
+}
+
+// Returns the name of the constant, like "TMT_DIBDATA".
+func (v TMT) String() string {
+	return _TMT_names.String(v)
+}
+
+// Parses the name of a [TMT] constant, or a number.
+func ParseTMT(s string) (TMT, error) {
+	return _TMT_names.Parse(s)
+}
+
+var _VFT_names = enum.Flags[VFT]{
+	Type: "VFT",
+	Values: []enum.Name[VFT]{
+		{Val: VFT_STATIC_LIB, Name: "VFT_STATIC_LIB"},
+		{Val: VFT_DRV, Name: "VFT_DRV"},
+		{Val: VFT_VXD, Name: "VFT_VXD"},
+		{Val: VFT_APP, Name: "VFT_APP"},
+		{Val: VFT_DLL, Name: "VFT_DLL"},
+		{Val: VFT_FONT, Name: "VFT_FONT"},
+		{Val: VFT_UNKNOWN, Name: "VFT_UNKNOWN"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "VFT_APP|VFT_DLL".
+func (v VFT) String() string {
+	return _VFT_names.String(v)
+}
+
+// Parses names of [VFT] flags joined by "|", or numbers.
+func ParseVFT(s string) (VFT, error) {
+	return _VFT_names.Parse(s)
+}
+
+var _VFT2_names = enum.Enum[VFT2]{
+	Type: "VFT2",
+	Values: []enum.Name[VFT2]{
+		{Val: VFT2_UNKNOWN, Name: "VFT2_UNKNOWN"},
+		{Val: VFT2_DRV_PRINTER, Name: "VFT2_DRV_PRINTER"},
+		{Val: VFT2_DRV_KEYBOARD, Name: "VFT2_DRV_KEYBOARD"},
+		{Val: VFT2_DRV_LANGUAGE, Name: "VFT2_DRV_LANGUAGE"},
+		{Val: VFT2_DRV_DISPLAY, Name: "VFT2_DRV_DISPLAY"},
+		{Val: VFT2_DRV_MOUSE, Name: "VFT2_DRV_MOUSE"},
+		{Val: VFT2_DRV_NETWORK, Name: "VFT2_DRV_NETWORK"},
+		{Val: VFT2_DRV_SYSTEM, Name: "VFT2_DRV_SYSTEM"},
+		{Val: VFT2_DRV_INSTALLABLE, Name: "VFT2_DRV_INSTALLABLE"},
+		{Val: VFT2_DRV_SOUND, Name: "VFT2_DRV_SOUND"},
+		{Val: VFT2_DRV_COMM, Name: "VFT2_DRV_COMM"},
+		{Val: VFT2_DRV_INPUTMETHOD, Name: "VFT2_DRV_INPUTMETHOD"},
+		{Val: VFT2_DRV_VERSIONED_PRINTER, Name: "VFT2_DRV_VERSIONED_PRINTER"},
+		{Val: VFT2_FONT_RASTER, Name: "VFT2_FONT_RASTER"},
+		{Val: VFT2_FONT_VECTOR, Name: "VFT2_FONT_VECTOR"},
+		{Val: VFT2_FONT_TRUETYPE, Name: "VFT2_FONT_TRUETYPE"},
+	},
+}
+
+// Returns the name of the constant, like "VFT2_UNKNOWN".
+func (v VFT2) String() string {
+	return _VFT2_names.String(v)
+}
+
+// Parses the name of a [VFT2] constant, or a number.
+func ParseVFT2(s string) (VFT2, error) {
+	return _VFT2_names.Parse(s)
+}
+
+var _VOS_names = enum.Enum[VOS]{
+	Type: "VOS",
+	Values: []enum.Name[VOS]{
+		{Val: VOS_UNKNOWN, Name: "VOS_UNKNOWN"},
+		{Val: VOS_DOS, Name: "VOS_DOS"},
+		{Val: VOS_OS216, Name: "VOS_OS216"},
+		{Val: VOS_OS232, Name: "VOS_OS232"},
+		{Val: VOS_NT, Name: "VOS_NT"},
+		{Val: VOS_WINCE, Name: "VOS_WINCE"},
+		{Val: VOS_BASE, Name: "VOS_BASE"},
+		{Val: VOS_WINDOWS16, Name: "VOS_WINDOWS16"},
+		{Val: VOS_PM16, Name: "VOS_PM16"},
+		{Val: VOS_PM32, Name: "VOS_PM32"},
+		{Val: VOS_WINDOWS32, Name: "VOS_WINDOWS32"},
+		{Val: VOS_DOS_WINDOWS16, Name: "VOS_DOS_WINDOWS16"},
+		{Val: VOS_DOS_WINDOWS32, Name: "VOS_DOS_WINDOWS32"},
+		{Val: VOS_OS216_PM16, Name: "VOS_OS216_PM16"},
+		{Val: VOS_OS232_PM32, Name: "VOS_OS232_PM32"},
+		{Val: VOS_NT_WINDOWS32, Name: "VOS_NT_WINDOWS32"},
+	},
+}
+
+// Returns the name of the constant, like "VOS_UNKNOWN".
+func (v VOS) String() string {
+	return _VOS_names.String(v)
+}
+
+// Parses the name of a [VOS] constant, or a number.
+func ParseVOS(s string) (VOS, error) {
+	return _VOS_names.Parse(s)
+}
+
+var _VS_FF_names = enum.Flags[VS_FF]{
+	Type: "VS_FF",
+	Values: []enum.Name[VS_FF]{
+		{Val: VS_FF_DEBUG, Name: "VS_FF_DEBUG"},
+		{Val: VS_FF_PRERELEASE, Name: "VS_FF_PRERELEASE"},
+		{Val: VS_FF_PATCHED, Name: "VS_FF_PATCHED"},
+		{Val: VS_FF_PRIVATEBUILD, Name: "VS_FF_PRIVATEBUILD"},
+		{Val: VS_FF_INFOINFERRED, Name: "VS_FF_INFOINFERRED"},
+		{Val: VS_FF_SPECIALBUILD, Name: "VS_FF_SPECIALBUILD"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "VS_FF_DEBUG|VS_FF_PRERELEASE".
+func (v VS_FF) String() string {
+	return _VS_FF_names.String(v)
+}
+
+// Parses names of [VS_FF] flags joined by "|", or numbers.
+func ParseVS_FF(s string) (VS_FF, error) {
+	return _VS_FF_names.Parse(s)
+}
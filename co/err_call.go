@@ -0,0 +1,63 @@
+//go:build windows
+
+package co
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error which wraps another one with the name of the function which failed and
+// the arguments it received.
+//
+// Functions of the win package don't return it: they return the bare [ERROR],
+// so existing checks like err == co.ERROR_FILE_NOT_FOUND keep working. Wrap the
+// error yourself when the call context is useful. The wrapped error can still
+// be checked with errors.Is and errors.As.
+//
+// Example:
+//
+//	subKey := "Software\\Foo"
+//	hKey, err := win.HKEY_CURRENT_USER.RegOpenKeyEx(
+//		subKey, co.REG_OPTION_NONE, co.KEY_READ)
+//	if err != nil {
+//		err = co.NewCallError(err, "RegOpenKeyEx", win.HKEY_CURRENT_USER, subKey)
+//		println(err.Error())
+//		// RegOpenKeyEx(HKEY_CURRENT_USER, "Software\\Foo"): [2 0x02 ERROR_FILE_NOT_FOUND] The system cannot find the file specified.
+//		println(errors.Is(err, co.ERROR_FILE_NOT_FOUND)) // true
+//	}
+type CallError struct {
+	Func string // Name of the function, like "CreateFile".
+	Args []any  // Arguments of the call; strings are quoted.
+	Err  error  // The error returned by the function, usually an ERROR or an HRESULT.
+}
+
+// Constructs a [CallError].
+func NewCallError(err error, funcName string, args ...any) *CallError {
+	return &CallError{funcName, args, err}
+}
+
+// Implements error interface.
+func (me *CallError) Error() string {
+	var buf strings.Builder
+	buf.WriteString(me.Func)
+	buf.WriteByte('(')
+	for i, arg := range me.Args {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if s, ok := arg.(string); ok {
+			fmt.Fprintf(&buf, "%q", s)
+		} else {
+			fmt.Fprintf(&buf, "%v", arg)
+		}
+	}
+	buf.WriteString("): ")
+	buf.WriteString(me.Err.Error())
+	return buf.String()
+}
+
+// Returns the contained error.
+func (me *CallError) Unwrap() error {
+	return me.Err
+}
@@ -5,6 +5,8 @@ package co
 import (
 	"fmt"
 	"syscall"
+
+	"github.com/rodrigocfd/windigo/winerr"
 )
 
 // System [error codes] returned by [GetLastError].
@@ -27,12 +29,31 @@ func (err ERROR) Unwrap() error {
 	return syscall.Errno(err)
 }
 
-// Calls [FormatMessage] and returns a full error description.
+// Returns a full error description, with the symbolic name and the English
+// message from the winerr catalog, like:
 //
-// [FormatMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-formatmessagew
+//	[2 0x02 ERROR_FILE_NOT_FOUND] The system cannot find the file specified.
+//
+// If the code has no message in the catalog, [ERROR.OsMessage] is used.
 func (err ERROR) String() string {
-	return fmt.Sprintf("[%d 0x%02x] %s",
-		uint32(err), uint32(err), err.Unwrap().Error())
+	entry, _ := winerr.Win32(uint32(err))
+	msg := entry.Message
+	if msg == "" {
+		msg = err.OsMessage()
+	}
+
+	if entry.Name == "" {
+		return fmt.Sprintf("[%d 0x%02x] %s", uint32(err), uint32(err), msg)
+	}
+	return fmt.Sprintf("[%d 0x%02x %s] %s", uint32(err), uint32(err), entry.Name, msg)
+}
+
+// Calls [FormatMessage] and returns the error message of the OS, which is in
+// the language of the user.
+//
+// [FormatMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-formatmessagew
+func (err ERROR) OsMessage() string {
+	return err.Unwrap().Error()
 }
 
 // Converts the ordinary Windows error to an [HRESULT].
@@ -5,6 +5,8 @@ package co
 import (
 	"fmt"
 	"syscall"
+
+	"github.com/rodrigocfd/windigo/winerr"
 )
 
 // [HRESULT] error codes.
@@ -26,12 +28,31 @@ func (hr HRESULT) Unwrap() error {
 	return syscall.Errno(hr)
 }
 
-// Calls [FormatMessage] and returns a full error description.
+// Returns a full error description, with the symbolic name and the English
+// message from the winerr catalog, like:
 //
-// [FormatMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-formatmessagew
+//	[2147500034 0x80004002 E_NOINTERFACE] No such interface supported.
+//
+// If the code has no message in the catalog, [HRESULT.OsMessage] is used.
 func (hr HRESULT) String() string {
-	return fmt.Sprintf("[%d 0x%02x] %s",
-		uint32(hr), uint32(hr), hr.Unwrap().Error())
+	entry, _ := winerr.Hresult(uint32(hr))
+	msg := entry.Message
+	if msg == "" {
+		msg = hr.OsMessage()
+	}
+
+	if entry.Name == "" {
+		return fmt.Sprintf("[%d 0x%02x] %s", uint32(hr), uint32(hr), msg)
+	}
+	return fmt.Sprintf("[%d 0x%02x %s] %s", uint32(hr), uint32(hr), entry.Name, msg)
+}
+
+// Calls [FormatMessage] and returns the error message of the OS, which is in
+// the language of the user.
+//
+// [FormatMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-formatmessagew
+func (hr HRESULT) OsMessage() string {
+	return hr.Unwrap().Error()
 }
 
 // Returns the HRESULT code.
@@ -0,0 +1,73 @@
+//go:build windows
+
+package co
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+)
+
+// Standard library errors matched by [ERROR.Is] and [HRESULT.Is], besides the
+// ones already matched by syscall.Errno, which [ERROR.Unwrap] returns. These
+// are the codes Errno.Is doesn't know about, plus timeouts and cancellations,
+// which it doesn't map at all.
+var errorIsTargets = map[ERROR]error{
+	ERROR_INVALID_DRIVE:         fs.ErrNotExist,
+	ERROR_BAD_NET_NAME:          fs.ErrNotExist,
+	ERROR_NETWORK_ACCESS_DENIED: fs.ErrPermission,
+	ERROR_PRIVILEGE_NOT_HELD:    fs.ErrPermission,
+	ERROR_ELEVATION_REQUIRED:    fs.ErrPermission,
+	ERROR_TIMEOUT:               os.ErrDeadlineExceeded,
+	ERROR_SEM_TIMEOUT:           os.ErrDeadlineExceeded,
+	ERROR_CANCELLED:             context.Canceled,
+	ERROR_OPERATION_ABORTED:     context.Canceled,
+	ERROR_REQUEST_ABORTED:       context.Canceled,
+}
+
+// Implements errors.Is, so the error matches the equivalent errors of the
+// standard library:
+//   - fs.ErrNotExist, fs.ErrPermission and fs.ErrExist;
+//   - os.ErrDeadlineExceeded, for timeouts;
+//   - context.Canceled, for canceled and aborted operations.
+//
+// Example:
+//
+//	_, err := win.FileOpen("C:\\Temp\\foo.txt", co.FOPEN_READ_EXISTING)
+//	if errors.Is(err, fs.ErrNotExist) {
+//		println("File not found.")
+//	}
+func (err ERROR) Is(target error) bool {
+	if stdErr, ok := errorIsTargets[err]; ok && stdErr == target {
+		return true
+	}
+	if hr, ok := target.(HRESULT); ok {
+		return err.ToHresult() == hr
+	}
+	return false
+}
+
+// Implements errors.Is. An HRESULT with FACILITY_WIN32 matches the [ERROR] it
+// wraps, and thus the standard library errors matched by [ERROR.Is].
+//
+// Example:
+//
+//	hr := co.HRESULT(0x8007_0002)
+//	println(errors.Is(hr, co.ERROR_FILE_NOT_FOUND)) // true
+//	println(errors.Is(hr, fs.ErrNotExist))          // true
+func (hr HRESULT) Is(target error) bool {
+	if wErr, ok := hr.ToError(); ok {
+		return errors.Is(wErr, target) // the HRESULT itself unwraps to a meaningless Errno
+	}
+	return false
+}
+
+// If the HRESULT has FACILITY_WIN32, returns the [ERROR] it wraps; the
+// inverse of [ERROR.ToHresult].
+func (hr HRESULT) ToError() (ERROR, bool) {
+	if hr.Failed() && hr.Facility() == FACILITY_WIN32 {
+		return ERROR(hr.Code()), true
+	}
+	return ERROR(0), false
+}
@@ -1,5 +1,7 @@
 //go:build windows
 
+//go:generate go run ../internal/cogen
+
 // This package contains native Win32 constants. All constants have their own
 // type, in order to avoid improper mixing.
 //
@@ -8,5 +10,9 @@
 // entity has a link to its [official docs], so you can lookup the correct
 // usage.
 //
+// Each constant type has a String method, which returns the constant name, like
+// "WM_SIZE", or the flag names joined by "|", like "WS_CHILD|WS_VISIBLE". The
+// inverse is done by a Parse function, like [ParseWS].
+//
 // [official docs]: https://learn.microsoft.com/en-us/windows/win32/api/
 package co
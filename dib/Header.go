@@ -0,0 +1,223 @@
+package dib
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Returned when the data is not a valid DIB.
+var ErrFormat = errors.New("invalid DIB")
+
+// Returned when the DIB is valid, but its format is not supported.
+var ErrUnsupported = errors.New("unsupported DIB")
+
+// [Compression] of the pixels, the same values of co.BI.
+//
+// [Compression]: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-bitmapinfoheader
+type BI uint32
+
+const (
+	BI_RGB            BI = 0
+	BI_RLE8           BI = 1
+	BI_RLE4           BI = 2
+	BI_BITFIELDS      BI = 3
+	BI_JPEG           BI = 4
+	BI_PNG            BI = 5
+	BI_ALPHABITFIELDS BI = 6
+)
+
+// Sizes of the header versions.
+const (
+	SIZE_CORE = 12  // BITMAPCOREHEADER, from OS/2.
+	SIZE_INFO = 40  // BITMAPINFOHEADER.
+	SIZE_V2   = 52  // BITMAPV2INFOHEADER, with RGB masks.
+	SIZE_V3   = 56  // BITMAPV3INFOHEADER, with RGBA masks.
+	SIZE_V4   = 108 // BITMAPV4HEADER.
+	SIZE_V5   = 124 // BITMAPV5HEADER.
+)
+
+// Color space of V4 and V5 headers, LCS_sRGB.
+const lcsSrgb = 0x7352_4742
+
+// The fields of a [BITMAPINFOHEADER], [BITMAPV5HEADER] or any other version,
+// which is identified by its size.
+//
+// The masks are part of the header since [SIZE_V2]; with [SIZE_INFO] headers
+// they follow the header, and they're used only with [BI_BITFIELDS] and
+// [BI_ALPHABITFIELDS] compressions.
+//
+// [BITMAPINFOHEADER]: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-bitmapinfoheader
+// [BITMAPV5HEADER]: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-bitmapv5header
+type Header struct {
+	Size          uint32 // Size of the header, like SIZE_INFO or SIZE_V5.
+	Width         int32
+	Height        int32 // Negative for top-down DIBs.
+	Planes        uint16
+	BitCount      uint16
+	Compression   BI
+	SizeImage     uint32
+	XPelsPerMeter int32
+	YPelsPerMeter int32
+	ClrUsed       uint32
+	ClrImportant  uint32
+	RedMask       uint32
+	GreenMask     uint32
+	BlueMask      uint32
+	AlphaMask     uint32
+	CSType        uint32   // V4 and V5 only.
+	Endpoints     [9]int32 // V4 and V5 only.
+	GammaRed      uint32   // V4 and V5 only.
+	GammaGreen    uint32   // V4 and V5 only.
+	GammaBlue     uint32   // V4 and V5 only.
+	Intent        uint32   // V5 only.
+	ProfileData   uint32   // V5 only.
+	ProfileSize   uint32   // V5 only.
+}
+
+// Parses the header at the beginning of the data, including the masks which
+// follow a [SIZE_INFO] header. Returns the header and the number of bytes
+// consumed.
+func ParseHeader(data []byte) (Header, int, error) {
+	if len(data) < 4 {
+		return Header{}, 0, fmt.Errorf("ParseHeader: %w: no header", ErrFormat)
+	}
+	le := binary.LittleEndian
+	h := Header{Size: le.Uint32(data)}
+	if int64(h.Size) > int64(len(data)) {
+		return Header{}, 0, fmt.Errorf("ParseHeader: %w: header with %d bytes truncated", ErrFormat, h.Size)
+	}
+
+	if h.Size == SIZE_CORE {
+		h.Width = int32(le.Uint16(data[4:]))
+		h.Height = int32(int16(le.Uint16(data[6:])))
+		h.Planes = le.Uint16(data[8:])
+		h.BitCount = le.Uint16(data[10:])
+		return h, SIZE_CORE, nil
+	} else if h.Size < SIZE_INFO {
+		return Header{}, 0, fmt.Errorf("ParseHeader: %w: header size %d", ErrFormat, h.Size)
+	}
+
+	h.Width = int32(le.Uint32(data[4:]))
+	h.Height = int32(le.Uint32(data[8:]))
+	h.Planes = le.Uint16(data[12:])
+	h.BitCount = le.Uint16(data[14:])
+	h.Compression = BI(le.Uint32(data[16:]))
+	h.SizeImage = le.Uint32(data[20:])
+	h.XPelsPerMeter = int32(le.Uint32(data[24:]))
+	h.YPelsPerMeter = int32(le.Uint32(data[28:]))
+	h.ClrUsed = le.Uint32(data[32:])
+	h.ClrImportant = le.Uint32(data[36:])
+	n := int(h.Size)
+
+	masks := data[40:]
+	nMasks := 0
+	if h.Size >= SIZE_V2 {
+		nMasks = 3
+	}
+	if h.Size >= SIZE_V3 {
+		nMasks = 4
+	}
+	if h.Size == SIZE_INFO { // masks after the header
+		switch h.Compression {
+		case BI_BITFIELDS:
+			nMasks = 3
+		case BI_ALPHABITFIELDS:
+			nMasks = 4
+		}
+		if len(masks) < nMasks*4 {
+			return Header{}, 0, fmt.Errorf("ParseHeader: %w: masks truncated", ErrFormat)
+		}
+		n += nMasks * 4
+	}
+	for i, pMask := range []*uint32{&h.RedMask, &h.GreenMask, &h.BlueMask, &h.AlphaMask}[:nMasks] {
+		*pMask = le.Uint32(masks[i*4:])
+	}
+
+	if h.Size >= SIZE_V4 {
+		h.CSType = le.Uint32(data[56:])
+		for i := range h.Endpoints {
+			h.Endpoints[i] = int32(le.Uint32(data[60+i*4:]))
+		}
+		h.GammaRed = le.Uint32(data[96:])
+		h.GammaGreen = le.Uint32(data[100:])
+		h.GammaBlue = le.Uint32(data[104:])
+	}
+	if h.Size >= SIZE_V5 {
+		h.Intent = le.Uint32(data[108:])
+		h.ProfileData = le.Uint32(data[112:])
+		h.ProfileSize = le.Uint32(data[116:])
+	}
+	return h, n, nil
+}
+
+// Serializes the header with the fields of its version, plus the masks which
+// follow a [SIZE_INFO] header with [BI_BITFIELDS] or [BI_ALPHABITFIELDS]
+// compression.
+func (h *Header) Serialize() []byte {
+	le := binary.LittleEndian
+	if h.Size == SIZE_CORE {
+		buf := le.AppendUint32(nil, SIZE_CORE)
+		buf = le.AppendUint16(buf, uint16(h.Width))
+		buf = le.AppendUint16(buf, uint16(h.Height))
+		buf = le.AppendUint16(buf, h.Planes)
+		return le.AppendUint16(buf, h.BitCount)
+	}
+
+	buf := le.AppendUint32(make([]byte, 0, h.Size+16), h.Size)
+	for _, v := range []uint32{
+		uint32(h.Width), uint32(h.Height), uint32(h.Planes) | uint32(h.BitCount)<<16,
+		uint32(h.Compression), h.SizeImage, uint32(h.XPelsPerMeter), uint32(h.YPelsPerMeter),
+		h.ClrUsed, h.ClrImportant,
+		h.RedMask, h.GreenMask, h.BlueMask, h.AlphaMask,
+		h.CSType,
+	} {
+		buf = le.AppendUint32(buf, v)
+	}
+	for _, v := range h.Endpoints {
+		buf = le.AppendUint32(buf, uint32(v))
+	}
+	for _, v := range []uint32{h.GammaRed, h.GammaGreen, h.GammaBlue, h.Intent, h.ProfileData, h.ProfileSize, 0} {
+		buf = le.AppendUint32(buf, v)
+	}
+
+	size := int(h.Size)
+	if h.Size == SIZE_INFO {
+		switch h.Compression {
+		case BI_BITFIELDS:
+			size += 3 * 4
+		case BI_ALPHABITFIELDS:
+			size += 4 * 4
+		}
+	}
+	if size > len(buf) {
+		buf = append(buf, make([]byte, size-len(buf))...)
+	}
+	return buf[:size]
+}
+
+// Returns the number of entries of the color table which follows the header.
+func (h *Header) numColors() int {
+	if h.ClrUsed != 0 {
+		if h.ClrUsed > 256 && h.BitCount <= 8 {
+			return 1 << h.BitCount
+		}
+		return int(h.ClrUsed)
+	} else if h.BitCount <= 8 {
+		return 1 << h.BitCount
+	}
+	return 0
+}
+
+// Returns the number of bytes of each row of pixels, which is padded to 32 bits.
+func (h *Header) stride() int {
+	return int((int64(h.Width)*int64(h.BitCount) + 31) / 32 * 4)
+}
+
+// Returns the absolute height, and whether the rows are stored top-down.
+func (h *Header) absHeight() (int, bool) {
+	if h.Height < 0 {
+		return -int(h.Height), true
+	}
+	return int(h.Height), false
+}
@@ -0,0 +1,401 @@
+package dib
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math/bits"
+)
+
+func init() {
+	image.RegisterFormat("bmp", "BM", DecodeBmp, DecodeBmpConfig)
+}
+
+// Decodes a packed DIB: a header, followed by the optional masks and color
+// table, followed by the pixels. This is the format of CF_DIB and CF_DIBV5
+// clipboard data, and of the images inside icons.
+//
+// Images with up to 8 bits per pixel are returned as *image.Paletted; images
+// with an alpha mask as *image.NRGBA, and other images as *image.RGBA.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("clipboard.dib")
+//	img, _ := dib.Decode(data)
+//	println(img.Bounds().Dx(), img.Bounds().Dy())
+func Decode(data []byte) (image.Image, error) {
+	h, n, err := ParseHeader(data)
+	if err != nil {
+		return nil, fmt.Errorf("Decode: %w", err)
+	}
+	palette, n, err := parsePalette(&h, data, n)
+	if err != nil {
+		return nil, fmt.Errorf("Decode: %w", err)
+	}
+	img, err := decodePixels(&h, palette, data[n:])
+	if err != nil {
+		return nil, fmt.Errorf("Decode: %w", err)
+	}
+	return img, nil
+}
+
+// Decodes the pixels of a DIB which are stored apart from its header, like the
+// ones returned by GetDIBits. The info has the header, followed by the optional
+// masks and color table, like a BITMAPINFO struct.
+func DecodeBits(info, bits []byte) (image.Image, error) {
+	h, n, err := ParseHeader(info)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeBits: %w", err)
+	}
+	palette, _, err := parsePalette(&h, info, n)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeBits: %w", err)
+	}
+	img, err := decodePixels(&h, palette, bits)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeBits: %w", err)
+	}
+	return img, nil
+}
+
+// Returns the dimensions and the color model of a packed DIB, without decoding
+// the pixels.
+func DecodeConfig(data []byte) (image.Config, error) {
+	h, n, err := ParseHeader(data)
+	if err != nil {
+		return image.Config{}, fmt.Errorf("DecodeConfig: %w", err)
+	}
+	palette, _, err := parsePalette(&h, data, n)
+	if err != nil {
+		return image.Config{}, fmt.Errorf("DecodeConfig: %w", err)
+	}
+	if err := validate(&h); err != nil {
+		return image.Config{}, fmt.Errorf("DecodeConfig: %w", err)
+	}
+
+	height, _ := h.absHeight()
+	cfg := image.Config{ColorModel: color.RGBAModel, Width: int(h.Width), Height: height}
+	if h.BitCount <= 8 {
+		cfg.ColorModel = palette
+	} else if hasAlpha(&h) {
+		cfg.ColorModel = color.NRGBAModel
+	}
+	return cfg, nil
+}
+
+// Decodes a .bmp file, which is a BITMAPFILEHEADER followed by a packed DIB.
+//
+// This function is registered in the image package, so image.Decode reads .bmp
+// files once this package is imported.
+func DecodeBmp(r io.Reader) (image.Image, error) {
+	data, err := readBmp(r)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeBmp: %w", err)
+	}
+	img, err := Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeBmp: %w", err)
+	}
+	return img, nil
+}
+
+// Returns the dimensions and the color model of a .bmp file, without decoding
+// the pixels.
+func DecodeBmpConfig(r io.Reader) (image.Config, error) {
+	data, err := readBmp(r)
+	if err != nil {
+		return image.Config{}, fmt.Errorf("DecodeBmpConfig: %w", err)
+	}
+	cfg, err := DecodeConfig(data)
+	if err != nil {
+		return image.Config{}, fmt.Errorf("DecodeBmpConfig: %w", err)
+	}
+	return cfg, nil
+}
+
+// Reads a .bmp file, returning the packed DIB with the pixels right after the
+// color table, as the file header may have a gap between them.
+func readBmp(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 14 || data[0] != 'B' || data[1] != 'M' {
+		return nil, fmt.Errorf("%w: missing BM signature", ErrFormat)
+	}
+	offBits := int64(binary.LittleEndian.Uint32(data[10:]))
+	dib := data[14:]
+
+	h, n, err := ParseHeader(dib)
+	if err != nil {
+		return nil, err
+	}
+	_, n, err = parsePalette(&h, dib, n)
+	if err != nil {
+		return nil, err
+	}
+	if offBits < 14+int64(n) || offBits > int64(len(data)) {
+		return nil, fmt.Errorf("%w: pixels offset %d out of bounds", ErrFormat, offBits)
+	}
+	return append(dib[:n:n], data[offBits:]...), nil
+}
+
+// Parses the color table which starts at data[n:], returning the offset past
+// it. Images with more than 8 bits per pixel return a nil palette.
+func parsePalette(h *Header, data []byte, n int) (color.Palette, int, error) {
+	entrySize := 4
+	if h.Size == SIZE_CORE {
+		entrySize = 3 // RGBTRIPLE
+	}
+	numColors := h.numColors()
+	if int64(numColors)*int64(entrySize) > int64(len(data)-n) {
+		return nil, 0, fmt.Errorf("%w: color table truncated", ErrFormat)
+	}
+	table := data[n : n+numColors*entrySize]
+	n += len(table)
+
+	if h.BitCount > 8 {
+		return nil, n, nil // optional table, only a hint for palette devices
+	}
+	palette := make(color.Palette, numColors)
+	for i := range palette {
+		e := table[i*entrySize:]
+		palette[i] = color.RGBA{e[2], e[1], e[0], 0xff}
+	}
+	return palette, n, nil
+}
+
+// Checks the combination of bit count and compression.
+func validate(h *Header) error {
+	height, topDown := h.absHeight()
+	if h.Width <= 0 || height == 0 || int64(h.Width)*int64(height) > 1<<28 {
+		return fmt.Errorf("%w: dimensions %dx%d", ErrFormat, h.Width, h.Height)
+	}
+
+	switch h.Compression {
+	case BI_RGB:
+		switch h.BitCount {
+		case 1, 4, 8, 16, 24, 32:
+			return nil
+		}
+	case BI_RLE8, BI_RLE4:
+		if topDown {
+			return fmt.Errorf("%w: top-down RLE", ErrFormat)
+		} else if (h.Compression == BI_RLE8 && h.BitCount == 8) ||
+			(h.Compression == BI_RLE4 && h.BitCount == 4) {
+			return nil
+		}
+	case BI_BITFIELDS, BI_ALPHABITFIELDS:
+		if h.BitCount == 16 || h.BitCount == 32 {
+			return nil
+		}
+	default:
+		return fmt.Errorf("%w: compression %d", ErrUnsupported, h.Compression)
+	}
+	return fmt.Errorf("%w: %d bits per pixel with compression %d", ErrFormat, h.BitCount, h.Compression)
+}
+
+// Tells whether the pixels have an alpha channel: either given by the masks,
+// or a 32-bit BI_RGB V4 or V5 header with the alpha mask set.
+func hasAlpha(h *Header) bool {
+	switch h.Compression {
+	case BI_BITFIELDS, BI_ALPHABITFIELDS:
+		return h.AlphaMask != 0
+	case BI_RGB:
+		return h.BitCount == 32 && h.Size >= SIZE_V4 && h.AlphaMask == 0xff00_0000
+	}
+	return false
+}
+
+func decodePixels(h *Header, palette color.Palette, data []byte) (image.Image, error) {
+	if err := validate(h); err != nil {
+		return nil, err
+	}
+	width := int(h.Width)
+	height, topDown := h.absHeight()
+
+	if h.Compression == BI_RLE8 || h.Compression == BI_RLE4 {
+		img := image.NewPaletted(image.Rect(0, 0, width, height), palette)
+		if err := decodeRle(img, data, h.Compression == BI_RLE4); err != nil {
+			return nil, err
+		}
+		fixPalette(img)
+		return img, nil
+	}
+
+	stride := h.stride()
+	if int64(stride)*int64(height) > int64(len(data)) {
+		return nil, fmt.Errorf("%w: pixels truncated, %d bytes for %dx%d", ErrFormat, len(data), width, height)
+	}
+	row := func(y int) []byte { // DIB row of image line y
+		if !topDown {
+			y = height - 1 - y
+		}
+		return data[y*stride : (y+1)*stride]
+	}
+
+	if h.BitCount <= 8 {
+		img := image.NewPaletted(image.Rect(0, 0, width, height), palette)
+		bpp := int(h.BitCount)
+		mask := byte(1<<bpp - 1)
+		for y := 0; y < height; y++ {
+			src, dest := row(y), img.Pix[y*img.Stride:]
+			for x := 0; x < width; x++ {
+				bit := x * bpp
+				dest[x] = src[bit/8] >> (8 - bpp - bit%8) & mask
+			}
+		}
+		fixPalette(img)
+		return img, nil
+	}
+
+	r, g, b, a := h.RedMask, h.GreenMask, h.BlueMask, h.AlphaMask
+	if h.Compression == BI_RGB {
+		if h.BitCount == 16 {
+			r, g, b = 0x7c00, 0x03e0, 0x001f // X1R5G5B5
+		} else {
+			r, g, b = 0xff_0000, 0x00ff00, 0x0000ff
+		}
+		if !hasAlpha(h) {
+			a = 0
+		}
+	}
+	rf, gf, bf, af := newField(r), newField(g), newField(b), newField(a)
+
+	rect := image.Rect(0, 0, width, height)
+	var img image.Image
+	var setPixel func(x, y int, c color.NRGBA)
+	if a != 0 {
+		nrgba := image.NewNRGBA(rect)
+		img, setPixel = nrgba, nrgba.SetNRGBA
+	} else {
+		rgba := image.NewRGBA(rect)
+		img, setPixel = rgba, func(x, y int, c color.NRGBA) {
+			rgba.SetRGBA(x, y, color.RGBA{c.R, c.G, c.B, 0xff})
+		}
+	}
+
+	bytesPerPixel := int(h.BitCount / 8)
+	for y := 0; y < height; y++ {
+		src := row(y)
+		for x := 0; x < width; x++ {
+			p := src[x*bytesPerPixel:]
+			var v uint32
+			switch bytesPerPixel {
+			case 2:
+				v = uint32(binary.LittleEndian.Uint16(p))
+			case 3:
+				v = uint32(p[0]) | uint32(p[1])<<8 | uint32(p[2])<<16
+			case 4:
+				v = binary.LittleEndian.Uint32(p)
+			}
+			alpha := uint8(0xff)
+			if a != 0 {
+				alpha = af.get(v)
+			}
+			setPixel(x, y, color.NRGBA{rf.get(v), gf.get(v), bf.get(v), alpha})
+		}
+	}
+	return img, nil
+}
+
+// A color channel given by a bit mask.
+type _Field struct {
+	mask  uint32
+	shift int
+	max   uint32
+}
+
+func newField(mask uint32) _Field {
+	if mask == 0 {
+		return _Field{}
+	}
+	shift := bits.TrailingZeros32(mask)
+	return _Field{mask, shift, 1<<bits.OnesCount32(mask>>shift) - 1}
+}
+
+// Extracts the channel from the pixel, scaled to 8 bits.
+func (f _Field) get(v uint32) uint8 {
+	if f.mask == 0 {
+		return 0
+	}
+	return uint8(uint64((v&f.mask)>>f.shift) * 255 / uint64(f.max))
+}
+
+// Decodes RLE8 or RLE4 pixels, which are always stored bottom-up.
+func decodeRle(img *image.Paletted, data []byte, rle4 bool) error {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	x, y := 0, height-1
+	set := func(idx byte) {
+		if x < width && y >= 0 {
+			img.Pix[y*img.Stride+x] = idx
+		}
+		x++
+	}
+
+	for i := 0; i+1 < len(data); {
+		count, val := int(data[i]), data[i+1]
+		i += 2
+
+		if count > 0 { // encoded mode: a run of the same value, or two alternating nibbles
+			for j := 0; j < count; j++ {
+				if !rle4 {
+					set(val)
+				} else if j%2 == 0 {
+					set(val >> 4)
+				} else {
+					set(val & 0x0f)
+				}
+			}
+			continue
+		}
+
+		switch val {
+		case 0: // end of line
+			x, y = 0, y-1
+		case 1: // end of bitmap
+			return nil
+		case 2: // delta
+			if i+1 >= len(data) {
+				return fmt.Errorf("%w: RLE delta truncated", ErrFormat)
+			}
+			x, y = x+int(data[i]), y-int(data[i+1])
+			i += 2
+		default: // absolute mode: val literal pixels, padded to 16 bits
+			n := int(val)
+			nBytes := n
+			if rle4 {
+				nBytes = (n + 1) / 2
+			}
+			if i+nBytes > len(data) {
+				return fmt.Errorf("%w: RLE absolute run truncated", ErrFormat)
+			}
+			for j := 0; j < n; j++ {
+				if !rle4 {
+					set(data[i+j])
+				} else if j%2 == 0 {
+					set(data[i+j/2] >> 4)
+				} else {
+					set(data[i+j/2] & 0x0f)
+				}
+			}
+			i += (nBytes + 1) &^ 1
+		}
+	}
+	return nil // missing end of bitmap is tolerated, like Windows does
+}
+
+// Extends the palette with black, if any pixel refers to a missing entry.
+func fixPalette(img *image.Paletted) {
+	maxIdx := -1
+	for _, idx := range img.Pix {
+		if int(idx) > maxIdx {
+			maxIdx = int(idx)
+		}
+	}
+	for len(img.Palette) <= maxIdx {
+		img.Palette = append(img.Palette, color.RGBA{0, 0, 0, 0xff})
+	}
+}
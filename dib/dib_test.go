@@ -0,0 +1,157 @@
+package dib_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/dib"
+)
+
+// A 5x3 image with colors exactly representable in 5 bits per channel.
+func testImage(alpha bool) *image.NRGBA {
+	colors := []color.NRGBA{
+		{0xff, 0, 0, 0xff}, {0, 0xff, 0, 0xff}, {0, 0, 0xff, 0xff}, {0xff, 0xff, 0xff, 0xff},
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, 5, 3))
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 5; x++ {
+			c := colors[(x+y)%len(colors)]
+			if alpha {
+				c.A = uint8(x * 0x40)
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestRoundTrip(t *testing.T) {
+	cases := []dib.Options{
+		{BitCount: 4},
+		{BitCount: 8},
+		{BitCount: 8, TopDown: true},
+		{Compression: dib.BI_RLE8},
+		{Compression: dib.BI_RLE4},
+		{BitCount: 16},
+		{BitCount: 16, Compression: dib.BI_BITFIELDS},
+		{BitCount: 24},
+		{BitCount: 24, V5: true, TopDown: true},
+		{BitCount: 32},
+		{BitCount: 32, Compression: dib.BI_BITFIELDS},
+		{BitCount: 32, V5: true},
+	}
+	for _, opts := range cases {
+		alpha := opts.V5 && opts.BitCount == 32
+		src := testImage(alpha)
+
+		data, err := dib.Encode(src, &opts)
+		if err != nil {
+			t.Fatalf("%+v: %v", opts, err)
+		}
+		img, err := dib.Decode(data)
+		if err != nil {
+			t.Fatalf("%+v: %v", opts, err)
+		}
+
+		if _, isNrgba := img.(*image.NRGBA); isNrgba != alpha {
+			t.Errorf("%+v: decoded as %T", opts, img)
+		}
+		for y := 0; y < 3; y++ {
+			for x := 0; x < 5; x++ {
+				want := src.NRGBAAt(x, y)
+				if got := color.NRGBAModel.Convert(img.At(x, y)); got != want {
+					t.Errorf("%+v: pixel %d,%d: want %v, got %v", opts, x, y, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestRle8(t *testing.T) {
+	// From the BITMAPINFOHEADER documentation: encoded runs, absolute mode and
+	// delta, on a 32x5 image.
+	pixels := []byte{
+		0x03, 0x04, 0x05, 0x06, 0x00, 0x03, 0x45, 0x56, 0x67, 0x00, 0x02, 0x78,
+		0x00, 0x02, 0x05, 0x01, 0x02, 0x78, 0x00, 0x00, 0x09, 0x1e, 0x00, 0x01,
+	}
+	h := dib.Header{Size: dib.SIZE_INFO, Width: 32, Height: 5, Planes: 1,
+		BitCount: 8, Compression: dib.BI_RLE8, ClrUsed: 0x100}
+	info := h.Serialize()
+	for i := 0; i < 256; i++ {
+		info = append(info, byte(i), byte(i), byte(i), 0)
+	}
+
+	img, err := dib.DecodeBits(info, pixels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := img.(*image.Paletted)
+	row := func(y int) []byte { return p.Pix[y*p.Stride : (y+1)*p.Stride] }
+
+	want := make([]byte, 32*5) // top-down rows
+	copy(want[4*32:], []byte{4, 4, 4, 6, 6, 6, 6, 6, 0x45, 0x56, 0x67, 0x78, 0x78})
+	copy(want[3*32+18:], []byte{0x78, 0x78}) // after delta
+	copy(want[2*32:], bytes.Repeat([]byte{0x1e}, 9))
+	for y := 0; y < 5; y++ {
+		if !bytes.Equal(row(y), want[y*32:(y+1)*32]) {
+			t.Errorf("row %d: % x", y, row(y))
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	h := dib.Header{Size: dib.SIZE_INFO, Width: 4, Height: 4, Planes: 1, BitCount: 24}
+	inputs := [][]byte{
+		nil,
+		{40, 0, 0, 0},
+		h.Serialize(), // no pixels
+	}
+	h.BitCount, h.Compression = 24, dib.BI_RLE8
+	inputs = append(inputs, h.Serialize())
+
+	for _, data := range inputs {
+		if _, err := dib.Decode(data); err == nil {
+			t.Errorf("% x: expected error", data)
+		}
+	}
+}
+
+func ExampleDecode() {
+	// 2x2, 24 bits per pixel, bottom-up: the first row in the data is the last
+	// one of the image.
+	h := dib.Header{Size: dib.SIZE_INFO, Width: 2, Height: 2, Planes: 1, BitCount: 24}
+	data := h.Serialize()
+	data = append(data, 0xff, 0, 0, 0, 0xff, 0, 0, 0)       // blue, green, padding
+	data = append(data, 0, 0, 0xff, 0xff, 0xff, 0xff, 0, 0) // red, white, padding
+
+	img, _ := dib.Decode(data)
+	fmt.Printf("%T %v\n", img, img.Bounds())
+	for y := 0; y < 2; y++ {
+		fmt.Println(img.At(0, y), img.At(1, y))
+	}
+	// Output:
+	// *image.RGBA (0,0)-(2,2)
+	// {255 0 0 255} {255 255 255 255}
+	// {0 0 255 255} {0 255 0 255}
+}
+
+func ExampleEncodeBmp() {
+	img := image.NewPaletted(image.Rect(0, 0, 3, 1), color.Palette{color.Black, color.White})
+	img.SetColorIndex(1, 0, 1)
+
+	var buf bytes.Buffer
+	_ = dib.EncodeBmp(&buf, img, &dib.Options{BitCount: 1})
+	data := buf.Bytes()
+	fmt.Printf("%s size=%d offBits=%d\n",
+		data[:2], binary.LittleEndian.Uint32(data[2:]), binary.LittleEndian.Uint32(data[10:]))
+
+	decoded, format, _ := image.Decode(&buf) // registered format
+	fmt.Println(format, decoded.At(0, 0), decoded.At(1, 0))
+	// Output:
+	// BM size=66 offBits=62
+	// bmp {0 0 0 255} {255 255 255 255}
+}
@@ -0,0 +1,283 @@
+package dib
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+)
+
+// Options for [Encode] and [EncodeBmp].
+type Options struct {
+	// Bits per pixel: 1, 4, 8, 16, 24 or 32. If zero, *image.Paletted images
+	// use 8, opaque images use 24, and other images use 32 with a V5 header.
+	BitCount int
+
+	// BI_RGB, the default, or:
+	//  - BI_RLE8 for 8 bits per pixel;
+	//  - BI_RLE4 for 4 bits per pixel;
+	//  - BI_BITFIELDS for 16 bits per pixel, which writes R5G6B5 instead of
+	//    X1R5G5B5.
+	//
+	// If zero, BitCount is implied by BI_RLE8 and BI_RLE4.
+	Compression BI
+
+	// Writes a BITMAPV5HEADER instead of a BITMAPINFOHEADER. With 32 bits per
+	// pixel, this also writes the alpha channel, as CF_DIBV5 clipboard data.
+	V5 bool
+
+	// Stores the rows top-down, with a negative height. Not allowed with RLE.
+	TopDown bool
+}
+
+// Encodes the image as a packed DIB: a header, followed by the optional masks
+// and color table, followed by the pixels.
+//
+// Images with up to 8 bits per pixel need a palette: *image.Paletted images
+// use their own, and other images have one built from their colors, returning
+// an error wrapping [ErrUnsupported] if they have too many colors.
+//
+// Example:
+//
+//	img := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+//	data, _ := dib.Encode(img, &dib.Options{V5: true}) // CF_DIBV5 data
+func Encode(img image.Image, opts *Options) ([]byte, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	h, palette, err := newHeader(img, o)
+	if err != nil {
+		return nil, fmt.Errorf("Encode: %w", err)
+	}
+
+	buf := h.Serialize()
+	for _, c := range palette {
+		r, g, b, _ := c.RGBA()
+		buf = append(buf, byte(b>>8), byte(g>>8), byte(r>>8), 0)
+	}
+
+	var pixels []byte
+	if h.Compression == BI_RLE8 || h.Compression == BI_RLE4 {
+		pixels = encodeRle(img, palette, h.Compression == BI_RLE4)
+	} else {
+		pixels = encodePixels(img, &h, palette)
+	}
+	binary.LittleEndian.PutUint32(buf[20:], uint32(len(pixels))) // SizeImage
+	return append(buf, pixels...), nil
+}
+
+// Encodes the image as a .bmp file, which is a BITMAPFILEHEADER followed by a
+// packed DIB, as written by [Encode].
+//
+// Example:
+//
+//	fout, _ := os.Create("C:\\Temp\\image.bmp")
+//	defer fout.Close()
+//	_ = dib.EncodeBmp(fout, img, nil)
+func EncodeBmp(w io.Writer, img image.Image, opts *Options) error {
+	data, err := Encode(img, opts)
+	if err != nil {
+		return fmt.Errorf("EncodeBmp: %w", err)
+	}
+	h, n, _ := ParseHeader(data)
+	_, n, _ = parsePalette(&h, data, n)
+
+	fileHdr := []byte{'B', 'M'}
+	fileHdr = binary.LittleEndian.AppendUint32(fileHdr, uint32(14+len(data)))
+	fileHdr = binary.LittleEndian.AppendUint32(fileHdr, 0) // reserved
+	fileHdr = binary.LittleEndian.AppendUint32(fileHdr, uint32(14+n))
+
+	if _, err := w.Write(append(fileHdr, data...)); err != nil {
+		return fmt.Errorf("EncodeBmp: %w", err)
+	}
+	return nil
+}
+
+// Builds the header, and the palette for images up to 8 bits per pixel.
+func newHeader(img image.Image, o Options) (Header, color.Palette, error) {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return Header{}, nil, fmt.Errorf("%w: empty image", ErrUnsupported)
+	}
+
+	paletted, isPaletted := img.(*image.Paletted)
+	if o.BitCount == 0 {
+		switch {
+		case o.Compression == BI_RLE8:
+			o.BitCount = 8
+		case o.Compression == BI_RLE4:
+			o.BitCount = 4
+		case isPaletted:
+			o.BitCount = 8
+		case isOpaque(img):
+			o.BitCount = 24
+		default:
+			o.BitCount, o.V5 = 32, true
+		}
+	}
+
+	h := Header{
+		Size:        SIZE_INFO,
+		Width:       int32(bounds.Dx()),
+		Height:      int32(bounds.Dy()),
+		Planes:      1,
+		BitCount:    uint16(o.BitCount),
+		Compression: o.Compression,
+	}
+	if o.V5 {
+		h.Size, h.CSType, h.Intent = SIZE_V5, lcsSrgb, 4 // LCS_GM_IMAGES
+	}
+	if o.TopDown {
+		h.Height = -h.Height
+	}
+
+	switch {
+	case o.Compression == BI_BITFIELDS && o.BitCount == 16:
+		h.RedMask, h.GreenMask, h.BlueMask = 0xf800, 0x07e0, 0x001f
+	case o.BitCount == 32 && o.V5:
+		h.Compression = BI_BITFIELDS
+		h.RedMask, h.GreenMask, h.BlueMask, h.AlphaMask = 0xff_0000, 0x00ff00, 0x0000ff, 0xff00_0000
+	case o.BitCount == 32 && o.Compression == BI_BITFIELDS:
+		h.RedMask, h.GreenMask, h.BlueMask = 0xff_0000, 0x00ff00, 0x0000ff
+	case o.Compression == BI_RGB && o.V5 && o.BitCount == 16:
+		h.RedMask, h.GreenMask, h.BlueMask = 0x7c00, 0x03e0, 0x001f
+	case o.Compression == BI_RGB && o.V5 && o.BitCount == 24:
+		h.RedMask, h.GreenMask, h.BlueMask = 0xff_0000, 0x00ff00, 0x0000ff
+	}
+	if err := validate(&h); err != nil {
+		return Header{}, nil, err
+	}
+
+	var palette color.Palette
+	if o.BitCount <= 8 {
+		maxColors := 1 << o.BitCount
+		if isPaletted && len(paletted.Palette) > 0 && len(paletted.Palette) <= maxColors {
+			palette = paletted.Palette
+		} else {
+			palette = buildPalette(img, maxColors)
+			if palette == nil {
+				return Header{}, nil, fmt.Errorf("%w: more than %d colors for %d bits per pixel",
+					ErrUnsupported, maxColors, o.BitCount)
+			}
+		}
+		h.ClrUsed = uint32(len(palette))
+	}
+	return h, palette, nil
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+// Builds a palette with the distinct colors of the image, or returns nil if
+// there are more than maxColors.
+func buildPalette(img image.Image, maxColors int) color.Palette {
+	seen := make(map[color.RGBA]struct{})
+	palette := make(color.Palette, 0, maxColors)
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := opaqueRgba(img.At(x, y))
+			if _, ok := seen[c]; !ok {
+				if len(palette) == maxColors {
+					return nil
+				}
+				seen[c] = struct{}{}
+				palette = append(palette, c)
+			}
+		}
+	}
+	return palette
+}
+
+// Converts the color to straight alpha, then drops the alpha.
+func opaqueRgba(c color.Color) color.RGBA {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return color.RGBA{n.R, n.G, n.B, 0xff}
+}
+
+// Returns the palette index of the pixel.
+func paletteIndex(img image.Image, palette color.Palette, x, y int) byte {
+	if p, ok := img.(*image.Paletted); ok && &p.Palette[0] == &palette[0] {
+		return p.ColorIndexAt(x, y)
+	}
+	return byte(palette.Index(opaqueRgba(img.At(x, y))))
+}
+
+func encodePixels(img image.Image, h *Header, palette color.Palette) []byte {
+	bounds := img.Bounds()
+	height, topDown := h.absHeight()
+	stride := h.stride()
+	pixels := make([]byte, stride*height)
+	bpp := int(h.BitCount)
+
+	for y := 0; y < height; y++ {
+		dibY := y
+		if !topDown {
+			dibY = height - 1 - y
+		}
+		row := pixels[dibY*stride : (dibY+1)*stride]
+
+		for x := 0; x < int(h.Width); x++ {
+			imgX, imgY := bounds.Min.X+x, bounds.Min.Y+y
+			if bpp <= 8 {
+				bit := x * bpp
+				row[bit/8] |= paletteIndex(img, palette, imgX, imgY) << (8 - bpp - bit%8)
+				continue
+			}
+
+			c := color.NRGBAModel.Convert(img.At(imgX, imgY)).(color.NRGBA)
+			switch bpp {
+			case 16:
+				var v uint16
+				if h.Compression == BI_BITFIELDS { // R5G6B5
+					v = uint16(c.R>>3)<<11 | uint16(c.G>>2)<<5 | uint16(c.B>>3)
+				} else { // X1R5G5B5
+					v = uint16(c.R>>3)<<10 | uint16(c.G>>3)<<5 | uint16(c.B>>3)
+				}
+				binary.LittleEndian.PutUint16(row[x*2:], v)
+			case 24:
+				copy(row[x*3:], []byte{c.B, c.G, c.R})
+			case 32:
+				alpha := c.A
+				if h.AlphaMask == 0 {
+					alpha = 0 // reserved
+				}
+				copy(row[x*4:], []byte{c.B, c.G, c.R, alpha})
+			}
+		}
+	}
+	return pixels
+}
+
+// Encodes the pixels as RLE8 or RLE4, bottom-up, using only encoded runs.
+func encodeRle(img image.Image, palette color.Palette, rle4 bool) []byte {
+	bounds := img.Bounds()
+	var data []byte
+
+	for y := bounds.Max.Y - 1; y >= bounds.Min.Y; y-- {
+		for x := bounds.Min.X; x < bounds.Max.X; {
+			idx := paletteIndex(img, palette, x, y)
+			count := 1
+			for x+count < bounds.Max.X && count < 255 &&
+				paletteIndex(img, palette, x+count, y) == idx {
+				count++
+			}
+			if rle4 {
+				data = append(data, byte(count), idx<<4|idx)
+			} else {
+				data = append(data, byte(count), idx)
+			}
+			x += count
+		}
+		if y > bounds.Min.Y {
+			data = append(data, 0, 0) // end of line
+		}
+	}
+	return append(data, 0, 1) // end of bitmap
+}
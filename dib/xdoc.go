@@ -0,0 +1,24 @@
+// This package encodes and decodes device-independent bitmaps – DIBs – in pure
+// Go, converting them to and from image.Image. It can be used on any platform.
+//
+// A packed DIB is a header – BITMAPCOREHEADER, BITMAPINFOHEADER, BITMAPV5HEADER
+// or any other version – followed by the optional color masks, the color table
+// and the pixels. This is the format of CF_DIB and CF_DIBV5 clipboard data,
+// and of .bmp files after their BITMAPFILEHEADER.
+//
+// The decoder supports 1, 4, 8, 16, 24 and 32 bits per pixel, BI_RGB,
+// BI_BITFIELDS, BI_ALPHABITFIELDS, BI_RLE8 and BI_RLE4 compressions, top-down
+// and bottom-up rows, and the alpha channel of V4 and V5 headers. The "bmp"
+// format is registered with the image package.
+//
+// On Windows, the win package uses these functions to implement
+// BITMAPINFOHEADER.ToImage and BITMAPV5HEADER.ToImage.
+//
+// Example:
+//
+//	data, _ := hClip.GetClipboardData(co.CF_DIBV5)
+//	img, _ := dib.Decode(data)
+//
+//	pixels, _ := dib.Encode(img, &dib.Options{V5: true})
+//	_ = hClip.SetClipboardData(co.CF_DIBV5, pixels)
+package dib
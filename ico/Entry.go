@@ -0,0 +1,207 @@
+package ico
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	"github.com/rodrigocfd/windigo/dib"
+)
+
+// An image of an .ico or .cur [File].
+type Entry struct {
+	// The image, up to 256x256 pixels.
+	Image image.Image
+
+	// Bits per pixel of BMP entries: 1, 4, 8, 24 or 32. Zero means 32. Entries
+	// with up to 8 bits per pixel need at most 2^BitCount colors.
+	BitCount int
+
+	// Stores the image as PNG instead of BMP. Recommended for 256x256 icons.
+	Png bool
+
+	HotspotX uint16 // Cursors only.
+	HotspotY uint16 // Cursors only.
+}
+
+// The PNG signature, which starts PNG entries.
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// Decodes the image data of an entry, as stored in .ico and .cur files and in
+// RT_ICON resources: either a PNG, or a DIB followed by a 1-bit AND mask.
+//
+// BMP entries are returned as *image.NRGBA if they have transparency, either
+// from the alpha channel of 32-bit images or from the AND mask. Otherwise, the
+// image is returned as decoded by [dib.Decode]. Screen-inverted pixels of the
+// AND mask become transparent.
+//
+// The hotspot of cursors is not part of the data, so it's not set.
+func DecodeEntry(data []byte) (Entry, error) {
+	if bytes.HasPrefix(data, []byte(pngSignature)) {
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return Entry{}, fmt.Errorf("DecodeEntry: %w", err)
+		}
+		return Entry{Image: img, BitCount: 32, Png: true}, nil
+	}
+
+	img, bitCount, err := decodeBmpEntry(data)
+	if err != nil {
+		return Entry{}, fmt.Errorf("DecodeEntry: %w", err)
+	}
+	return Entry{Image: img, BitCount: bitCount}, nil
+}
+
+// Decodes a DIB whose height includes the AND mask.
+func decodeBmpEntry(data []byte) (image.Image, int, error) {
+	h, n, err := dib.ParseHeader(data)
+	if err != nil {
+		return nil, 0, err
+	} else if h.Size < dib.SIZE_INFO || h.Height <= 0 || h.Height%2 != 0 {
+		return nil, 0, fmt.Errorf("%w: header size %d, height %d", ErrFormat, h.Size, h.Height)
+	}
+	width, height := int(h.Width), int(h.Height/2)
+	h.Height /= 2
+	h.SizeImage = 0
+
+	numColors := int(h.ClrUsed)
+	if h.BitCount <= 8 && (numColors == 0 || numColors > 1<<h.BitCount) {
+		numColors = 1 << h.BitCount
+	}
+	if n+numColors*4 > len(data) {
+		return nil, 0, fmt.Errorf("%w: color table truncated", ErrFormat)
+	}
+	colorTable := data[n : n+numColors*4]
+	pixels := data[n+len(colorTable):]
+
+	alpha := h.BitCount == 32 && h.Compression == dib.BI_RGB
+	if alpha { // read the reserved byte as alpha
+		h.Size, h.Compression = dib.SIZE_INFO, dib.BI_ALPHABITFIELDS
+		h.RedMask, h.GreenMask, h.BlueMask, h.AlphaMask = 0xff_0000, 0x00_ff00, 0x00_00ff, 0xff00_0000
+	}
+	img, err := dib.DecodeBits(append(h.Serialize(), colorTable...), pixels)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if alpha {
+		nrgba := img.(*image.NRGBA)
+		for i := 3; i < len(nrgba.Pix); i += 4 {
+			if nrgba.Pix[i] != 0 {
+				return nrgba, 32, nil // alpha channel in use, mask is ignored
+			}
+		}
+		for i := 3; i < len(nrgba.Pix); i += 4 {
+			nrgba.Pix[i] = 0xff // old-style 32-bit icon, transparency from mask
+		}
+	}
+
+	xorSize := (width*int(h.BitCount) + 31) / 32 * 4 * height
+	maskStride := (width + 31) / 32 * 4
+	if len(pixels) < xorSize+maskStride*height {
+		return img, int(h.BitCount), nil // no mask, fully opaque
+	}
+	mask := pixels[xorSize : xorSize+maskStride*height]
+
+	var masked *image.NRGBA
+	for y := 0; y < height; y++ {
+		row := mask[(height-1-y)*maskStride:] // bottom-up
+		for x := 0; x < width; x++ {
+			if row[x/8]&(0x80>>(x%8)) == 0 {
+				continue
+			}
+			if masked == nil {
+				masked = toNrgba(img)
+			}
+			masked.SetNRGBA(x, y, color.NRGBA{})
+		}
+	}
+	if masked != nil {
+		return masked, int(h.BitCount), nil
+	}
+	return img, int(h.BitCount), nil
+}
+
+// Returns the image as *image.NRGBA, converting it if needed.
+func toNrgba(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok {
+		return nrgba
+	}
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			nrgba.Set(x, y, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return nrgba
+}
+
+// Encodes the image data of the entry, as stored in .ico and .cur files and in
+// RT_ICON resources: either a PNG, or a DIB followed by a 1-bit AND mask, where
+// pixels with less than 50% alpha are transparent.
+//
+// Entries with up to 8 bits per pixel return an error wrapping
+// dib.ErrUnsupported if the image has too many colors.
+//
+// Example:
+//
+//	entry := ico.Entry{Image: img}
+//	data, _ := entry.Encode()
+//	hIcon, _ := win.CreateIconFromResourceEx(data, 0x0003_0000,
+//		win.SIZE{Cx: 32, Cy: 32}, co.LR_DEFAULTCOLOR)
+func (me *Entry) Encode() ([]byte, error) {
+	if me.Png {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, me.Image); err != nil {
+			return nil, fmt.Errorf("Entry.Encode: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
+	bounds := me.Image.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	var data []byte
+
+	if me.bitCount() == 32 { // with alpha channel, which dib.Encode writes only to V5 headers
+		h := dib.Header{Size: dib.SIZE_INFO, Width: int32(width), Height: int32(height), Planes: 1, BitCount: 32}
+		data = h.Serialize()
+		for y := height - 1; y >= 0; y-- {
+			for x := 0; x < width; x++ {
+				c := color.NRGBAModel.Convert(me.Image.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+				data = append(data, c.B, c.G, c.R, c.A)
+			}
+		}
+	} else {
+		var err error
+		data, err = dib.Encode(me.Image, &dib.Options{BitCount: me.bitCount()})
+		if err != nil {
+			return nil, fmt.Errorf("Entry.Encode: %w", err)
+		}
+	}
+	binary.LittleEndian.PutUint32(data[8:], uint32(height*2)) // height includes the mask
+	binary.LittleEndian.PutUint32(data[20:], 0)               // SizeImage
+
+	maskStride := (width + 31) / 32 * 4
+	mask := make([]byte, maskStride*height)
+	for y := 0; y < height; y++ {
+		row := mask[(height-1-y)*maskStride:] // bottom-up
+		for x := 0; x < width; x++ {
+			if _, _, _, a := me.Image.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA(); a < 0x8000 {
+				row[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+	}
+	return append(data, mask...), nil
+}
+
+// Returns the bits per pixel, where zero means 32.
+func (me *Entry) bitCount() int {
+	if me.BitCount == 0 || me.Png {
+		return 32
+	}
+	return me.BitCount
+}
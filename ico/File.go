@@ -0,0 +1,158 @@
+package ico
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Returned when the data is not a valid .ico or .cur file, or image entry.
+var ErrFormat = errors.New("invalid ICO or CUR data")
+
+// An .ico or .cur file, which holds the same icon or cursor in several sizes
+// and color depths.
+type File struct {
+	IsCursor bool
+	Entries  []Entry
+}
+
+// Parses the contents of an .ico or .cur file.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("C:\\Temp\\app.ico")
+//	file, _ := ico.Parse(data)
+//	for _, entry := range file.Entries {
+//		println(entry.Image.Bounds().Dx(), entry.BitCount)
+//	}
+func Parse(data []byte) (*File, error) {
+	if len(data) < 6 || binary.LittleEndian.Uint16(data) != 0 {
+		return nil, fmt.Errorf("Parse: %w: missing header", ErrFormat)
+	}
+	file := &File{}
+	switch typ := binary.LittleEndian.Uint16(data[2:]); typ {
+	case 1:
+	case 2:
+		file.IsCursor = true
+	default:
+		return nil, fmt.Errorf("Parse: %w: unknown type %d", ErrFormat, typ)
+	}
+
+	count := int(binary.LittleEndian.Uint16(data[4:]))
+	if 6+count*16 > len(data) {
+		return nil, fmt.Errorf("Parse: %w: %d entries truncated", ErrFormat, count)
+	}
+	file.Entries = make([]Entry, 0, count)
+
+	for i := 0; i < count; i++ {
+		dirEntry := data[6+i*16:]
+		size := binary.LittleEndian.Uint32(dirEntry[8:])
+		offset := binary.LittleEndian.Uint32(dirEntry[12:])
+		if uint64(offset)+uint64(size) > uint64(len(data)) {
+			return nil, fmt.Errorf("Parse: %w: entry %d out of bounds", ErrFormat, i)
+		}
+
+		entry, err := DecodeEntry(data[offset : offset+size])
+		if err != nil {
+			return nil, fmt.Errorf("Parse: entry %d: %w", i, err)
+		}
+		if file.IsCursor { // planes and bit count fields hold the hotspot
+			entry.HotspotX = binary.LittleEndian.Uint16(dirEntry[4:])
+			entry.HotspotY = binary.LittleEndian.Uint16(dirEntry[6:])
+		}
+		file.Entries = append(file.Entries, entry)
+	}
+	return file, nil
+}
+
+// Returns the entry which best fits the given size in pixels: the smallest
+// entry not smaller than it, or the largest entry if all of them are smaller.
+// Among entries of the same size, the one with most colors is chosen.
+//
+// Returns nil if there are no entries.
+//
+// Example:
+//
+//	file, _ := ico.Parse(data)
+//	entry := file.Nearest(ui.DpiX(32))
+func (me *File) Nearest(size int) *Entry {
+	var best *Entry
+	bestWidth := 0
+	for i := range me.Entries {
+		entry := &me.Entries[i]
+		width := entry.Image.Bounds().Dx()
+
+		switch {
+		case best == nil,
+			bestWidth < size && width > bestWidth,
+			width >= size && width < bestWidth,
+			width == bestWidth && entry.bitCount() > best.bitCount():
+			best, bestWidth = entry, width
+		}
+	}
+	return best
+}
+
+// Writes the .ico or .cur file. The images must be up to 256x256 pixels.
+//
+// Example:
+//
+//	file := &ico.File{
+//		Entries: []ico.Entry{
+//			{Image: img16},
+//			{Image: img32},
+//			{Image: img256, Png: true},
+//		},
+//	}
+//
+//	fout, _ := os.Create("C:\\Temp\\app.ico")
+//	defer fout.Close()
+//	_, _ = file.WriteTo(fout)
+func (me *File) WriteTo(w io.Writer) (int64, error) {
+	typ := uint16(1)
+	if me.IsCursor {
+		typ = 2
+	}
+	buf := binary.LittleEndian.AppendUint16(nil, 0) // reserved
+	buf = binary.LittleEndian.AppendUint16(buf, typ)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(me.Entries)))
+
+	var images []byte
+	offset := 6 + len(me.Entries)*16
+	for i := range me.Entries {
+		entry := &me.Entries[i]
+		bounds := entry.Image.Bounds()
+		if bounds.Dx() < 1 || bounds.Dx() > 256 || bounds.Dy() < 1 || bounds.Dy() > 256 {
+			return 0, fmt.Errorf("File.WriteTo: entry %d: %w: size %dx%d",
+				i, ErrFormat, bounds.Dx(), bounds.Dy())
+		}
+		data, err := entry.Encode()
+		if err != nil {
+			return 0, fmt.Errorf("File.WriteTo: entry %d: %w", i, err)
+		}
+
+		buf = append(buf, uint8(bounds.Dx()), uint8(bounds.Dy())) // 256 is stored as zero
+		if entry.bitCount() < 8 {
+			buf = append(buf, uint8(1<<entry.bitCount()), 0)
+		} else {
+			buf = append(buf, 0, 0)
+		}
+		if me.IsCursor {
+			buf = binary.LittleEndian.AppendUint16(buf, entry.HotspotX)
+			buf = binary.LittleEndian.AppendUint16(buf, entry.HotspotY)
+		} else {
+			buf = binary.LittleEndian.AppendUint16(buf, 1) // planes
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(entry.bitCount()))
+		}
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(data)))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(offset+len(images)))
+		images = append(images, data...)
+	}
+
+	n, err := w.Write(append(buf, images...))
+	if err != nil {
+		return int64(n), fmt.Errorf("File.WriteTo: %w", err)
+	}
+	return int64(n), nil
+}
@@ -0,0 +1,142 @@
+package ico_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/ico"
+)
+
+// A square image with a transparent border, and opaque red and blue halves.
+func testImage(size int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for y := 1; y < size-1; y++ {
+		for x := 1; x < size-1; x++ {
+			if x < size/2 {
+				img.SetNRGBA(x, y, color.NRGBA{0xff, 0, 0, 0xff})
+			} else {
+				img.SetNRGBA(x, y, color.NRGBA{0, 0, 0xff, 0xff})
+			}
+		}
+	}
+	return img
+}
+
+func TestRoundTrip(t *testing.T) {
+	translucent := testImage(16)
+	translucent.SetNRGBA(5, 5, color.NRGBA{0, 0xff, 0, 0x80})
+
+	for _, isCursor := range []bool{false, true} {
+		src := &ico.File{
+			IsCursor: isCursor,
+			Entries: []ico.Entry{
+				{Image: translucent, HotspotX: 3, HotspotY: 4},
+				{Image: testImage(32), BitCount: 4},
+				{Image: testImage(20), BitCount: 8},
+				{Image: testImage(24), BitCount: 24},
+				{Image: testImage(256), Png: true},
+			},
+		}
+		var buf bytes.Buffer
+		if _, err := src.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+		file, err := ico.Parse(buf.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if file.IsCursor != isCursor || len(file.Entries) != len(src.Entries) {
+			t.Fatalf("cursor %v: got %v with %d entries", isCursor, file.IsCursor, len(file.Entries))
+		}
+		for i, entry := range file.Entries {
+			want := &src.Entries[i]
+			if entry.Png != want.Png || (want.BitCount != 0 && entry.BitCount != want.BitCount) {
+				t.Errorf("entry %d: png %v, %d bits", i, entry.Png, entry.BitCount)
+			}
+			if isCursor && (entry.HotspotX != want.HotspotX || entry.HotspotY != want.HotspotY) {
+				t.Errorf("entry %d: hotspot %d,%d", i, entry.HotspotX, entry.HotspotY)
+			}
+			bounds := want.Image.Bounds()
+			if entry.Image.Bounds() != bounds {
+				t.Fatalf("entry %d: bounds %v", i, entry.Image.Bounds())
+			}
+			for y := 0; y < bounds.Dy(); y++ {
+				for x := 0; x < bounds.Dx(); x++ {
+					w := color.NRGBAModel.Convert(want.Image.At(x, y)).(color.NRGBA)
+					g := color.NRGBAModel.Convert(entry.Image.At(x, y)).(color.NRGBA)
+					if w.A == 0 {
+						w = color.NRGBA{}
+					}
+					if g != w {
+						t.Errorf("entry %d: pixel %d,%d: want %v, got %v", i, x, y, w, g)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestOldStyleMask(t *testing.T) {
+	// A 32-bit entry with all alpha bytes zero takes its transparency from the
+	// AND mask, like icons made before Windows XP.
+	entry := ico.Entry{Image: testImage(8)}
+	data, _ := entry.Encode()
+	for i := 40 + 3; i < 40+8*8*4; i += 4 {
+		data[i] = 0
+	}
+
+	decoded, err := ico.DecodeEntry(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, a := decoded.Image.At(0, 0).RGBA(); a != 0 {
+		t.Errorf("border: alpha %d", a)
+	}
+	if _, _, _, a := decoded.Image.At(4, 4).RGBA(); a != 0xffff {
+		t.Errorf("center: alpha %d", a)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	inputs := [][]byte{
+		nil,
+		{0, 0, 3, 0, 0, 0}, // unknown type
+		{0, 0, 1, 0, 1, 0}, // truncated entries
+		{0, 0, 1, 0, 1, 0, 16, 16, 0, 0, 1, 0, 32, 0, 0xff, 0, 0, 0, 22, 0, 0, 0}, // out of bounds
+	}
+	for _, data := range inputs {
+		if _, err := ico.Parse(data); !errors.Is(err, ico.ErrFormat) {
+			t.Errorf("% x: got %v", data, err)
+		}
+	}
+
+	big := &ico.File{Entries: []ico.Entry{{Image: testImage(300)}}}
+	if _, err := big.WriteTo(&bytes.Buffer{}); !errors.Is(err, ico.ErrFormat) {
+		t.Errorf("300x300: got %v", err)
+	}
+}
+
+func ExampleFile_Nearest() {
+	file := &ico.File{
+		Entries: []ico.Entry{
+			{Image: testImage(16)},
+			{Image: testImage(32), BitCount: 8},
+			{Image: testImage(32)},
+			{Image: testImage(48)},
+		},
+	}
+	for _, size := range []int{16, 24, 32, 64} {
+		entry := file.Nearest(size)
+		fmt.Println(size, entry.Image.Bounds().Dx(), entry.BitCount)
+	}
+	// Output:
+	// 16 16 0
+	// 24 32 0
+	// 32 32 0
+	// 64 48 0
+}
@@ -0,0 +1,21 @@
+// This package reads and writes .ico and .cur files in pure Go, converting
+// their images to and from image.Image. It can be used on any platform.
+//
+// Each [Entry] of a [File] is either a PNG, or a DIB followed by a 1-bit AND
+// mask, which marks the transparent pixels. The image data of a single entry,
+// which is also the format of RT_ICON resources, is converted with
+// [DecodeEntry] and [Entry.Encode].
+//
+// On Windows, the win package uses these functions to implement
+// CreateIconFromImage, CreateIconFromIco and CreateCursorFromIco, so icons can
+// come from embedded files instead of compiled resources.
+//
+// Example:
+//
+//	//go:embed app.ico
+//	var appIco []byte
+//
+//	file, _ := ico.Parse(appIco)
+//	entry := file.Nearest(32)
+//	println(entry.Image.Bounds().Dx(), entry.BitCount)
+package ico
@@ -0,0 +1,309 @@
+package ini
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/wstr"
+)
+
+// Returned when a key or a section doesn't exist.
+var ErrNotFound = errors.New("not found")
+
+// Encoding of the file, detected by its BOM.
+type ENCODING uint8
+
+const (
+	ENCODING_UTF8     ENCODING = iota // UTF-8 without BOM, the default.
+	ENCODING_UTF8_BOM                 // UTF-8 with BOM.
+	ENCODING_UTF16LE                  // UTF-16 little-endian with BOM, as written by Windows.
+	ENCODING_UTF16BE                  // UTF-16 big-endian with BOM.
+)
+
+// Contents of an .ini file.
+//
+// The lines are kept as they were parsed, so comments, blank lines, ordering,
+// duplicate keys and line endings are preserved when the file is written back.
+// Only the lines whose values were changed are rewritten.
+//
+// Section and key names are case-insensitive, like in Windows.
+type File struct {
+	global   *Section   // lines before the first section header
+	sections []*Section // in file order
+	eol      string     // used for new lines
+	Encoding ENCODING   // Encoding used when writing; defaults to the encoding which was parsed.
+}
+
+// Creates an empty [File], which uses "\r\n" line endings.
+func New() *File {
+	me := &File{eol: "\r\n"}
+	me.global = &Section{file: me}
+	return me
+}
+
+// Parses the contents of an .ini file. UTF-8 is assumed, unless the data has a
+// UTF-16 BOM.
+//
+// Lines which are not blank, comments (starting with ";" or "#"), section
+// headers or "key=value" pairs are kept, but otherwise ignored.
+//
+// Example:
+//
+//	f, _ := ini.Parse(strings.NewReader("[Main]\r\nname=foo\r\n"))
+//	name, _ := f.Get("Main", "name")
+func Parse(r io.Reader) (*File, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("Parse: %w", err)
+	}
+
+	me := New()
+	me.eol = ""
+	text, err := me.decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("Parse: %w", err)
+	}
+
+	cur := me.global
+	for len(text) > 0 {
+		raw, eol := text, ""
+		if idx := strings.IndexByte(text, '\n'); idx != -1 {
+			raw, text = text[:idx], text[idx+1:]
+			eol = "\n"
+			if strings.HasSuffix(raw, "\r") {
+				raw, eol = raw[:len(raw)-1], "\r\n"
+			}
+		} else {
+			text = ""
+		}
+		if me.eol == "" {
+			me.eol = eol
+		}
+
+		line := parseLine(raw, eol)
+		if line.kind == _LINE_HEADER {
+			cur = &Section{name: line.key, header: line, file: me}
+			me.sections = append(me.sections, cur)
+		} else {
+			cur.lines = append(cur.lines, line)
+		}
+	}
+
+	if me.eol == "" {
+		me.eol = "\r\n"
+	}
+	return me, nil
+}
+
+// Reads and parses an .ini file.
+//
+// Example:
+//
+//	f, _ := ini.Load("C:\\Temp\\foo.ini")
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Load: %w", err)
+	}
+	me, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Load: %w", err)
+	}
+	return me, nil
+}
+
+// Decodes the raw data into UTF-8, according to the BOM.
+func (me *File) decode(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xef, 0xbb, 0xbf}):
+		me.Encoding = ENCODING_UTF8_BOM
+		return string(data[3:]), nil
+	case bytes.HasPrefix(data, []byte{0xff, 0xfe}), bytes.HasPrefix(data, []byte{0xfe, 0xff}):
+		if data[0] == 0xff {
+			me.Encoding = ENCODING_UTF16LE
+		} else {
+			me.Encoding = ENCODING_UTF16BE
+		}
+		text, err := io.ReadAll(wstr.NewUtf16Reader(bytes.NewReader(data), wstr.Utf16LE, wstr.LoneReplace))
+		return string(text), err
+	default:
+		me.Encoding = ENCODING_UTF8
+		return string(data), nil
+	}
+}
+
+// Implements [io.WriterTo], writing the contents in the file [File.Encoding].
+func (me *File) WriteTo(w io.Writer) (int64, error) {
+	cw := &_CountWriter{w: w}
+
+	var dest io.Writer = cw
+	var u16 *wstr.Utf16Writer
+	switch me.Encoding {
+	case ENCODING_UTF8_BOM:
+		if _, err := cw.Write([]byte{0xef, 0xbb, 0xbf}); err != nil {
+			return cw.n, fmt.Errorf("File.WriteTo: %w", err)
+		}
+	case ENCODING_UTF16LE, ENCODING_UTF16BE:
+		order := wstr.Utf16LE
+		if me.Encoding == ENCODING_UTF16BE {
+			order = wstr.Utf16BE
+		}
+		u16 = wstr.NewUtf16Writer(cw, order, true, wstr.LoneReplace)
+		dest = u16
+	}
+
+	if _, err := io.WriteString(dest, me.String()); err != nil {
+		return cw.n, fmt.Errorf("File.WriteTo: %w", err)
+	}
+	if u16 != nil {
+		if err := u16.Flush(); err != nil {
+			return cw.n, fmt.Errorf("File.WriteTo: %w", err)
+		}
+	}
+	return cw.n, nil
+}
+
+type _CountWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (me *_CountWriter) Write(p []byte) (int, error) {
+	n, err := me.w.Write(p)
+	me.n += int64(n)
+	return n, err
+}
+
+// Writes the contents to a file, in the file [File.Encoding].
+func (me *File) Save(path string) error {
+	var buf bytes.Buffer
+	if _, err := me.WriteTo(&buf); err != nil {
+		return fmt.Errorf("File.Save: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("File.Save: %w", err)
+	}
+	return nil
+}
+
+// Returns the contents of the file as text.
+func (me *File) String() string {
+	var buf strings.Builder
+	me.global.write(&buf)
+	for _, section := range me.sections {
+		buf.WriteString(section.header.raw)
+		buf.WriteString(section.header.eol)
+		section.write(&buf)
+	}
+	return buf.String()
+}
+
+// Returns the section with the lines before the first section header, which
+// may have keys without a section.
+func (me *File) Global() *Section {
+	return me.global
+}
+
+// Returns the sections, in file order. Sections with the same name are
+// returned separately.
+func (me *File) Sections() []*Section {
+	return append([]*Section{}, me.sections...)
+}
+
+// Returns the first section with the given name, or nil.
+func (me *File) Section(name string) *Section {
+	for _, section := range me.sections {
+		if strings.EqualFold(section.name, name) {
+			return section
+		}
+	}
+	return nil
+}
+
+// Returns the first section with the given name, creating it at the end of the
+// file if it doesn't exist.
+func (me *File) AddSection(name string) *Section {
+	if section := me.Section(name); section != nil {
+		return section
+	}
+
+	last := me.lastLine()
+	if last != nil {
+		if last.eol == "" {
+			last.eol = me.eol
+		}
+		if last.kind != _LINE_BLANK {
+			me.lastSection().lines = append(me.lastSection().lines, &_Line{kind: _LINE_BLANK, eol: me.eol})
+		}
+	}
+
+	section := &Section{
+		name:   name,
+		header: &_Line{kind: _LINE_HEADER, raw: "[" + name + "]", eol: me.eol, key: name},
+		file:   me,
+	}
+	me.sections = append(me.sections, section)
+	return section
+}
+
+// Removes all the sections with the given name, returning how many were
+// removed.
+func (me *File) RemoveSection(name string) int {
+	kept := me.sections[:0]
+	for _, section := range me.sections {
+		if !strings.EqualFold(section.name, name) {
+			kept = append(kept, section)
+		}
+	}
+	n := len(me.sections) - len(kept)
+	me.sections = kept
+	return n
+}
+
+// Returns the value of the first key with the given name, in the first section
+// with the given name. An empty section name refers to [File.Global].
+func (me *File) Get(section, key string) (string, bool) {
+	if s := me.sectionOrGlobal(section); s != nil {
+		return s.Get(key)
+	}
+	return "", false
+}
+
+// Sets the value of the first key with the given name, in the first section
+// with the given name, creating the section and the key if needed. An empty
+// section name refers to [File.Global].
+func (me *File) Set(section, key, value string) {
+	if section == "" {
+		me.global.Set(key, value)
+	} else {
+		me.AddSection(section).Set(key, value)
+	}
+}
+
+func (me *File) sectionOrGlobal(name string) *Section {
+	if name == "" {
+		return me.global
+	}
+	return me.Section(name)
+}
+
+func (me *File) lastSection() *Section {
+	if len(me.sections) > 0 {
+		return me.sections[len(me.sections)-1]
+	}
+	return me.global
+}
+
+func (me *File) lastLine() *_Line {
+	last := me.lastSection()
+	if len(last.lines) > 0 {
+		return last.lines[len(last.lines)-1]
+	} else if last != me.global {
+		return last.header
+	}
+	return nil
+}
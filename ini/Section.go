@@ -0,0 +1,263 @@
+package ini
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type _LINE uint8
+
+const (
+	_LINE_BLANK _LINE = iota
+	_LINE_COMMENT
+	_LINE_HEADER
+	_LINE_KEY
+	_LINE_OTHER // unrecognized, kept as it is
+)
+
+// A line of the file, as it was parsed.
+type _Line struct {
+	kind     _LINE
+	raw      string // without the line ending
+	eol      string // "\r\n", "\n", or empty for the last line
+	key      string // key name, or section name for headers
+	valStart int    // value position in raw, for keys
+	valEnd   int
+}
+
+func parseLine(raw, eol string) *_Line {
+	line := &_Line{kind: _LINE_OTHER, raw: raw, eol: eol}
+	trimmed := strings.TrimSpace(raw)
+
+	switch {
+	case trimmed == "":
+		line.kind = _LINE_BLANK
+	case trimmed[0] == ';' || trimmed[0] == '#':
+		line.kind = _LINE_COMMENT
+	case trimmed[0] == '[':
+		if idx := strings.IndexByte(trimmed, ']'); idx != -1 {
+			line.kind = _LINE_HEADER
+			line.key = strings.TrimSpace(trimmed[1:idx])
+		}
+	default:
+		if idx := strings.IndexByte(raw, '='); idx != -1 {
+			line.kind = _LINE_KEY
+			line.key = strings.TrimSpace(raw[:idx])
+			value := raw[idx+1:]
+			line.valStart = idx + 1 + (len(value) - len(strings.TrimLeft(value, " \t")))
+			line.valEnd = idx + 1 + len(strings.TrimRight(value, " \t"))
+			if line.valEnd < line.valStart {
+				line.valEnd = line.valStart
+			}
+		}
+	}
+	return line
+}
+
+// Returns the value of a key line, without the quotes.
+func (me *_Line) value() string {
+	value := me.raw[me.valStart:me.valEnd]
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// Replaces the value of a key line, keeping the text around it.
+func (me *_Line) setValue(value string) {
+	if value != strings.TrimSpace(value) ||
+		(len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"') {
+		value = `"` + value + `"` // quoted to be read back as it is
+	}
+	me.raw = me.raw[:me.valStart] + value + me.raw[me.valEnd:]
+	me.valEnd = me.valStart + len(value)
+}
+
+// A section of an .ini file, which holds its keys.
+type Section struct {
+	name   string
+	header *_Line // nil for the global section
+	lines  []*_Line
+	file   *File
+}
+
+func (me *Section) write(buf *strings.Builder) {
+	for _, line := range me.lines {
+		buf.WriteString(line.raw)
+		buf.WriteString(line.eol)
+	}
+}
+
+// Returns the section name; empty for [File.Global].
+func (me *Section) Name() string {
+	return me.name
+}
+
+// Returns the key names, in file order. Duplicate keys are returned repeatedly.
+func (me *Section) Keys() []string {
+	var keys []string
+	for _, line := range me.lines {
+		if line.kind == _LINE_KEY {
+			keys = append(keys, line.key)
+		}
+	}
+	return keys
+}
+
+// Returns the value of the first key with the given name.
+func (me *Section) Get(key string) (string, bool) {
+	for _, line := range me.lines {
+		if line.kind == _LINE_KEY && strings.EqualFold(line.key, key) {
+			return line.value(), true
+		}
+	}
+	return "", false
+}
+
+// Returns the values of all keys with the given name, in file order.
+func (me *Section) GetAll(key string) []string {
+	var values []string
+	for _, line := range me.lines {
+		if line.kind == _LINE_KEY && strings.EqualFold(line.key, key) {
+			values = append(values, line.value())
+		}
+	}
+	return values
+}
+
+// Sets the value of the first key with the given name. If the key doesn't
+// exist, it's added after the last key of the section.
+//
+// Values with leading or trailing spaces are written between quotes.
+func (me *Section) Set(key, value string) {
+	for _, line := range me.lines {
+		if line.kind == _LINE_KEY && strings.EqualFold(line.key, key) {
+			line.setValue(value)
+			return
+		}
+	}
+	me.Add(key, value)
+}
+
+// Adds a key after the last key of the section, even if a key with the same
+// name already exists.
+func (me *Section) Add(key, value string) {
+	line := &_Line{kind: _LINE_KEY, raw: key + "=", eol: me.file.eol, key: key}
+	line.valStart, line.valEnd = len(line.raw), len(line.raw)
+	line.setValue(value)
+
+	pos := -1
+	for i, l := range me.lines {
+		if l.kind == _LINE_KEY {
+			pos = i + 1
+		}
+	}
+	if pos == -1 { // no keys yet: before the trailing blank lines
+		pos = len(me.lines)
+		for pos > 0 && me.lines[pos-1].kind == _LINE_BLANK {
+			pos--
+		}
+	}
+
+	if pos > 0 && me.lines[pos-1].eol == "" { // last line of the file
+		me.lines[pos-1].eol = me.file.eol
+		line.eol = ""
+	} else if pos == 0 && me.header != nil && me.header.eol == "" {
+		me.header.eol = me.file.eol
+		line.eol = ""
+	}
+
+	me.lines = append(me.lines, nil)
+	copy(me.lines[pos+1:], me.lines[pos:])
+	me.lines[pos] = line
+}
+
+// Removes all the keys with the given name, returning how many were removed.
+func (me *Section) Delete(key string) int {
+	kept := me.lines[:0]
+	for _, line := range me.lines {
+		if line.kind != _LINE_KEY || !strings.EqualFold(line.key, key) {
+			kept = append(kept, line)
+		}
+	}
+	n := len(me.lines) - len(kept)
+	me.lines = kept
+	return n
+}
+
+// Returns the value of the key converted to int, or an error wrapping
+// [ErrNotFound] or strconv.ErrSyntax. Hex values with "0x" prefix are
+// accepted.
+func (me *Section) Int(key string) (int, error) {
+	value, err := me.mustGet("Section.Int", key)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(value, 0, strconv.IntSize)
+	if err != nil {
+		return 0, fmt.Errorf("Section.Int: key %q: %w", key, err)
+	}
+	return int(n), nil
+}
+
+// Returns the value of the key converted to bool, or an error wrapping
+// [ErrNotFound] or strconv.ErrSyntax. The accepted values, case-insensitive,
+// are 1, true, yes, on and 0, false, no, off.
+func (me *Section) Bool(key string) (bool, error) {
+	value, err := me.mustGet("Section.Bool", key)
+	if err != nil {
+		return false, err
+	}
+	b, err := parseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("Section.Bool: key %q: %w", key, err)
+	}
+	return b, nil
+}
+
+// Returns the value of the key converted to time.Duration, or an error
+// wrapping [ErrNotFound] or strconv.ErrSyntax. The value is parsed by
+// time.ParseDuration, like "1m30s"; a number without unit is taken as seconds.
+func (me *Section) Duration(key string) (time.Duration, error) {
+	value, err := me.mustGet("Section.Duration", key)
+	if err != nil {
+		return 0, err
+	}
+	d, err := parseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("Section.Duration: key %q: %w", key, err)
+	}
+	return d, nil
+}
+
+// Returns the value of the key split by commas, with the spaces around each
+// item removed, or an error wrapping [ErrNotFound]. An empty value returns an
+// empty slice.
+func (me *Section) List(key string) ([]string, error) {
+	value, err := me.mustGet("Section.List", key)
+	if err != nil {
+		return nil, err
+	}
+	return splitList(value), nil
+}
+
+func (me *Section) mustGet(funcName, key string) (string, error) {
+	value, ok := me.Get(key)
+	if !ok {
+		return "", fmt.Errorf("%s: %w: key %q", funcName, ErrNotFound, key)
+	}
+	return strings.TrimSpace(value), nil
+}
+
+func splitList(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return []string{}
+	}
+	items := strings.Split(value, ",")
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+	return items
+}
@@ -0,0 +1,160 @@
+package ini_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rodrigocfd/windigo/ini"
+)
+
+func TestRoundTrip(t *testing.T) {
+	inputs := []string{
+		"",
+		"key=value",
+		"; comment\r\n\r\n[Main]\r\nname = foo \r\nname=bar\r\n\r\n# other\r\n[Empty]\r\n",
+		"global=1\n[A]\n  indented =  spaced value\t\nnot a key\n[B] ; comment\nx=\"quoted \"\n",
+		"[NoEol]\nk=v",
+	}
+	for _, input := range inputs {
+		f, err := ini.Parse(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("%q: %v", input, err)
+		}
+		if got := f.String(); got != input {
+			t.Errorf("round trip:\nwant %q\ngot  %q", input, got)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	input := "; top\r\n[Main]\r\nname = foo ; not a comment\r\n\r\n; about Other\r\n[Other]\r\nx=1"
+	f, _ := ini.Parse(strings.NewReader(input))
+
+	f.Set("main", "NAME", "bar")
+	f.Set("Main", "new", " padded ")
+	f.Set("Other", "y", "2")
+	f.Set("Third", "z", "3")
+	f.Set("", "g", "0")
+
+	want := "; top\r\ng=0\r\n[Main]\r\nname = bar\r\nnew=\" padded \"\r\n\r\n; about Other\r\n" +
+		"[Other]\r\nx=1\r\ny=2\r\n\r\n[Third]\r\nz=3\r\n"
+	if got := f.String(); got != want {
+		t.Errorf("\nwant %q\ngot  %q", want, got)
+	}
+	if v, _ := f.Get("Main", "new"); v != " padded " {
+		t.Errorf("quoted value: %q", v)
+	}
+}
+
+func TestUtf16(t *testing.T) {
+	data := []byte{0xff, 0xfe, '[', 0, 'A', 0, ']', 0, '\r', 0, '\n', 0, 'k', 0, '=', 0, 0xe7, 0}
+	f, err := ini.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := f.Get("A", "k"); v != "ç" || f.Encoding != ini.ENCODING_UTF16LE {
+		t.Errorf("got %q, encoding %d", v, f.Encoding)
+	}
+
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil || !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("write back: % x, %v", buf.Bytes(), err)
+	}
+}
+
+func ExampleParse() {
+	f, _ := ini.Parse(strings.NewReader(
+		"; Server settings\n" +
+			"[Server]\n" +
+			"Host = example.com\n" +
+			"Port = 8080\n" +
+			"Mirror = a.com\n" +
+			"Mirror = b.com\n"))
+
+	server := f.Section("server")
+	fmt.Println(server.Keys())
+	fmt.Println(server.GetAll("Mirror"))
+
+	server.Set("Port", "8081")
+	server.Delete("Mirror")
+	fmt.Print(f)
+	// Output:
+	// [Host Port Mirror Mirror]
+	// [a.com b.com]
+	// ; Server settings
+	// [Server]
+	// Host = example.com
+	// Port = 8081
+}
+
+func ExampleSection_Int() {
+	f, _ := ini.Parse(strings.NewReader(
+		"[A]\nsize=0x10\nbad=12a\nenabled=Yes\ntimeout=1m30s\ndelay=2.5\nitems= a, b ,c\n"))
+	a := f.Section("A")
+
+	fmt.Println(a.Int("size"))
+	_, err := a.Int("bad")
+	fmt.Println(err)
+	_, err = a.Int("missing")
+	fmt.Println(errors.Is(err, ini.ErrNotFound), err)
+	fmt.Println(a.Bool("enabled"))
+	fmt.Println(a.Duration("timeout"))
+	fmt.Println(a.Duration("delay"))
+	items, _ := a.List("items")
+	fmt.Printf("%q\n", items)
+	// Output:
+	// 16 <nil>
+	// Section.Int: key "bad": strconv.ParseInt: parsing "12a": invalid syntax
+	// true Section.Int: not found: key "missing"
+	// true <nil>
+	// 1m30s <nil>
+	// 2.5s <nil>
+	// ["a" "b" "c"]
+}
+
+func ExampleFile_DecodeStruct() {
+	type Window struct {
+		Left, Top int
+		Maximized bool `ini:",omitempty"`
+	}
+	type Settings struct {
+		Version int
+		Timeout time.Duration
+		Recent  []string `ini:"Recent Files"`
+		Main    Window   `ini:"Main Window"`
+		cache   int
+	}
+
+	f, _ := ini.Parse(strings.NewReader(
+		"Version=2\n" +
+			"; Recently opened\n" +
+			"Recent Files=a.txt, b.txt\n" +
+			"\n" +
+			"[Main Window]\n" +
+			"Left=10\n"))
+
+	settings := Settings{Timeout: 30 * time.Second} // default
+	err := f.DecodeStruct(&settings)
+	fmt.Printf("%v %+v\n", err, settings)
+
+	settings.Version = 3
+	settings.Main.Top = 20
+	err = f.EncodeStruct(settings)
+	fmt.Println(err)
+	fmt.Print(f)
+	// Output:
+	// <nil> {Version:2 Timeout:30s Recent:[a.txt b.txt] Main:{Left:10 Top:0 Maximized:false} cache:0}
+	// <nil>
+	// Version=3
+	// ; Recently opened
+	// Recent Files=a.txt, b.txt
+	// Timeout=30s
+	//
+	// [Main Window]
+	// Left=10
+	// Top=20
+}
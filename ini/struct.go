@@ -0,0 +1,296 @@
+package ini
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Returned when a Go type cannot be converted to or from an .ini value.
+var ErrType = errors.New("incompatible type")
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Writes the exported fields of a struct, or pointer to struct, into the file.
+// Nested structs are written as sections, and the other fields are written as
+// keys of [File.Global]. The fields of a nested struct are written as keys of
+// its section. Existing keys are updated in place, so comments and ordering
+// are preserved.
+//
+// The accepted field types are string, bool, numbers, time.Duration and slices
+// of them, which are written as comma-separated lists.
+//
+// The field tag has the key or section name – which defaults to the field name
+// – and the omitempty option, which skips the field if it has the zero value.
+// A field with the tag "-" is ignored.
+//
+// Example:
+//
+//	type Window struct {
+//		Left, Top int
+//	}
+//	type Settings struct {
+//		Recent []string `ini:"Recent Files,omitempty"`
+//		Main   Window   `ini:"Main Window"`
+//	}
+//
+//	f := ini.New()
+//	_ = f.EncodeStruct(Settings{Main: Window{10, 20}})
+func (me *File) EncodeStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("File.EncodeStruct: %w: expected struct, got %T", ErrType, v)
+	}
+
+	var errs []error
+	for _, f := range structFields(rv.Type(), true) {
+		fv := rv.Field(f.index)
+		if f.err != nil {
+			errs = append(errs, f.err)
+		} else if f.omitEmpty && fv.IsZero() {
+			continue
+		} else if f.isSection {
+			if err := encodeSection(fv, me.AddSection(f.name)); err != nil {
+				errs = append(errs, fmt.Errorf("section %s: %w", f.name, err))
+			}
+		} else {
+			me.global.Set(f.name, formatValue(fv))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("File.EncodeStruct: %w", err)
+	}
+	return nil
+}
+
+func encodeSection(rv reflect.Value, section *Section) error {
+	var errs []error
+	for _, f := range structFields(rv.Type(), false) {
+		fv := rv.Field(f.index)
+		if f.err != nil {
+			errs = append(errs, f.err)
+		} else if !(f.omitEmpty && fv.IsZero()) {
+			section.Set(f.name, formatValue(fv))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Copies the values of the file into the exported fields of the struct pointed
+// to by pStruct, following the same rules of [File.EncodeStruct]. Fields
+// without a corresponding key or section are left untouched, so they can hold
+// default values.
+//
+// Example:
+//
+//	type Settings struct {
+//		Timeout time.Duration
+//	}
+//
+//	f, _ := ini.Parse(strings.NewReader("Timeout=30"))
+//
+//	settings := Settings{Timeout: time.Minute} // default
+//	_ = f.DecodeStruct(&settings)
+func (me *File) DecodeStruct(pStruct any) error {
+	rv := reflect.ValueOf(pStruct)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("File.DecodeStruct: %w: expected pointer to struct, got %T", ErrType, pStruct)
+	}
+	rv = rv.Elem()
+
+	var errs []error
+	for _, f := range structFields(rv.Type(), true) {
+		if f.err != nil {
+			errs = append(errs, f.err)
+		} else if f.isSection {
+			if section := me.Section(f.name); section != nil {
+				if err := decodeSection(section, rv.Field(f.index)); err != nil {
+					errs = append(errs, fmt.Errorf("section %s: %w", f.name, err))
+				}
+			}
+		} else if value, ok := me.global.Get(f.name); ok {
+			if err := parseValue(value, rv.Field(f.index)); err != nil {
+				errs = append(errs, fmt.Errorf("field %s: %w", f.goName, err))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("File.DecodeStruct: %w", err)
+	}
+	return nil
+}
+
+func decodeSection(section *Section, rv reflect.Value) error {
+	var errs []error
+	for _, f := range structFields(rv.Type(), false) {
+		if f.err != nil {
+			errs = append(errs, f.err)
+		} else if value, ok := section.Get(f.name); ok {
+			if err := parseValue(value, rv.Field(f.index)); err != nil {
+				errs = append(errs, fmt.Errorf("field %s: %w", f.goName, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// A struct field, with its tag parsed.
+type _Field struct {
+	index     int
+	goName    string
+	name      string // key or section name
+	omitEmpty bool
+	isSection bool
+	err       error // invalid tag or unsupported type
+}
+
+func structFields(t reflect.Type, allowSections bool) []_Field {
+	fields := make([]_Field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("ini")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		f := _Field{index: i, goName: sf.Name, name: name}
+
+		for _, opt := range strings.Split(opts, ",") {
+			if opt == "omitempty" {
+				f.omitEmpty = true
+			} else if opt != "" {
+				f.err = fmt.Errorf("field %s: unknown option %q", sf.Name, opt)
+			}
+		}
+
+		if allowSections && sf.Type.Kind() == reflect.Struct {
+			f.isSection = true
+		} else if !isScalar(sf.Type) &&
+			!(sf.Type.Kind() == reflect.Slice && isScalar(sf.Type.Elem())) && f.err == nil {
+			f.err = fmt.Errorf("field %s: %w: %s not supported", sf.Name, ErrType, sf.Type)
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// Formats a scalar, or a slice of scalars as a comma-separated list.
+func formatValue(rv reflect.Value) string {
+	if rv.Type() == durationType {
+		return time.Duration(rv.Int()).String()
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	case reflect.Slice:
+		items := make([]string, rv.Len())
+		for i := range items {
+			items[i] = formatValue(rv.Index(i))
+		}
+		return strings.Join(items, ", ")
+	}
+	return "" // validated by structFields
+}
+
+// Parses the value into a scalar, or a slice of scalars.
+func parseValue(value string, rv reflect.Value) error {
+	value = strings.TrimSpace(value)
+	if rv.Type() == durationType {
+		d, err := parseDuration(value)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(int64(d))
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(value)
+	case reflect.Bool:
+		b, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 0, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 0, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	case reflect.Slice:
+		items := splitList(value)
+		slice := reflect.MakeSlice(rv.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := parseValue(item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(slice)
+	}
+	return nil
+}
+
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "1", "true", "yes", "on":
+		return true, nil
+	case "0", "false", "no", "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("%w: %q", strconv.ErrSyntax, value)
+}
+
+func parseDuration(value string) (time.Duration, error) {
+	if secs, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Duration(secs * float64(time.Second)), nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", strconv.ErrSyntax, value)
+	}
+	return d, nil
+}
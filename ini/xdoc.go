@@ -0,0 +1,16 @@
+// This package reads and writes .ini files in pure Go, so it works on any
+// platform.
+//
+// Unlike the GetPrivateProfileString family of functions, the whole file is
+// kept in memory: comments, blank lines, key ordering, duplicate keys and line
+// endings are preserved, so a file written back has only the changed values
+// different from the original. Values can be read with typed getters, or
+// copied to and from structs with [File.DecodeStruct] and [File.EncodeStruct].
+//
+// Example:
+//
+//	f, _ := ini.Load("C:\\Temp\\app.ini")
+//	port, _ := f.Section("Server").Int("Port")
+//	f.Set("Server", "Port", strconv.Itoa(port+1))
+//	_ = f.Save("C:\\Temp\\app.ini")
+package ini
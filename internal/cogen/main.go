@@ -0,0 +1,368 @@
+// Generates the String methods and Parse functions of the constant types of co
+// and the x/co* packages. Run from anywhere in the module:
+//
+//	go run ./internal/cogen
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Packages whose constant types are processed, relative to the module root.
+var packages = []string{
+	"co",
+	"x/coaut",
+	"x/codxgi",
+	"x/cosh",
+	"x/cotasks",
+	"x/cowic",
+}
+
+// Types which have their own String method, or are not integers.
+var skipTypes = map[string]bool{
+	"ERROR":   true,
+	"HRESULT": true,
+}
+
+// Enumerations which the heuristic takes as flags, because their values are
+// powers of 2.
+var forceEnum = map[string]bool{
+	"AD":                      true,
+	"BKMODE":                  true,
+	"CONSOLE_MODE":            true,
+	"DMCOLOR":                 true,
+	"DMNUP":                   true,
+	"DMORIENT":                true,
+	"FAPPCOMMAND":             true,
+	"HELPINFO":                true,
+	"LSFW":                    true,
+	"POLYF":                   true,
+	"PS_ENDCAP":               true,
+	"SEC_WINNT_AUTH_IDENTITY": true,
+	"VP_COMMAND":              true,
+	"VP_CP_TYPE":              true,
+	"VP_MODE":                 true,
+	"LVGA_HEADER":             true,
+	"REG_DISPOSITION":         true,
+	"REG_SAVE":                true,
+}
+
+// Bit fields of flags types whose values are mutually exclusive, like the
+// button type in BS, which must not be decomposed into single bits.
+var flagFields = map[string][]uint64{
+	"BS":          {0x0000_000f},
+	"CDDS":        {0x0000_ffff},
+	"CLIP_PRECIS": {0x0000_000f},
+	"HDF":         {0x0000_0003},
+	"ILC":         {0x0000_00fe},
+	"LVCFMT_C":    {0x0000_0003},
+	"LVS":         {0x0000_0003},
+	"MB":          {0x0000_000f, 0x0000_00f0, 0x0000_0f00, 0x0000_3000},
+	"NIIF":        {0x0000_000f},
+	"SS":          {0x0000_001f, 0x0000_c000},
+}
+
+const outFile = "co_string.go"
+
+func main() {
+	root := moduleRoot()
+	build.Default.GOOS = "windows" // so the source importer sees the files
+	build.Default.GOARCH = "amd64"
+
+	listOnly := len(os.Args) > 1 && os.Args[1] == "-list"
+	for _, pkgDir := range packages {
+		types := loadTypes(filepath.Join(root, pkgDir))
+		if listOnly {
+			os.Stdout.WriteString(listKinds(pkgDir, types))
+			continue
+		}
+		src := generate(filepath.Base(pkgDir), types)
+		if err := os.WriteFile(filepath.Join(root, pkgDir, outFile), src, 0o644); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func moduleRoot() string {
+	dir, _ := os.Getwd()
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			panic("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+type _Const struct {
+	name  string
+	value uint64 // two's complement for negatives
+	alias bool   // declared as another constant, like WS_CHILDWINDOW = WS_CHILD
+}
+
+type _Type struct {
+	name      string
+	flags     bool
+	consts    []_Const // in String preference order
+	parseOnly []_Const // masks, not used to decompose
+	fields    []uint64 // masks of mutually exclusive values within flags
+}
+
+// Parses and type-checks the package, returning the constant types in
+// declaration order.
+func loadTypes(dir string) []*_Type {
+	fset := token.NewFileSet()
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	sort.Strings(matches)
+
+	var files []*ast.File
+	for _, m := range matches {
+		base := filepath.Base(m)
+		if strings.HasSuffix(base, "_386.go") || strings.HasSuffix(base, "_test.go") || base == outFile {
+			continue
+		}
+		f, err := parser.ParseFile(fset, m, nil, parser.ParseComments)
+		if err != nil {
+			panic(err)
+		}
+		files = append(files, f)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	pkg, err := conf.Check(files[0].Name.Name, fset, files, info)
+	if err != nil {
+		panic(err)
+	}
+
+	byName := make(map[string]*_Type)
+	var ordered []*_Type
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vspec := spec.(*ast.ValueSpec)
+				for i, ident := range vspec.Names {
+					c, ok := info.Defs[ident].(*types.Const)
+					if !ok || !c.Exported() {
+						continue
+					}
+					named, ok := c.Type().(*types.Named)
+					if !ok || named.Obj().Pkg() != pkg || skipTypes[named.Obj().Name()] {
+						continue
+					}
+					basic, ok := named.Underlying().(*types.Basic)
+					if !ok || basic.Info()&types.IsInteger == 0 {
+						continue
+					}
+
+					t := byName[named.Obj().Name()]
+					if t == nil {
+						t = &_Type{name: named.Obj().Name()}
+						byName[t.name] = t
+						ordered = append(ordered, t)
+					}
+					alias := false
+					if i < len(vspec.Values) {
+						_, alias = vspec.Values[i].(*ast.Ident)
+					}
+					t.consts = append(t.consts, _Const{ident.Name, constValue(c), alias})
+				}
+			}
+		}
+	}
+
+	for _, t := range ordered {
+		classify(t)
+	}
+	return ordered
+}
+
+func constValue(c *types.Const) uint64 {
+	s := c.Val().ExactString()
+	var n int64
+	var u uint64
+	if _, err := fmt.Sscan(s, &u); err == nil {
+		return u
+	}
+	if _, err := fmt.Sscan(s, &n); err == nil {
+		return uint64(n)
+	}
+	panic("bad constant " + c.Name() + " " + s)
+}
+
+// Decides whether the type is an enumeration or a bitmask, and sorts the
+// constants accordingly.
+func classify(t *_Type) {
+	distinct := make(map[uint64]bool)
+	for _, c := range t.consts {
+		if c.value != 0 {
+			distinct[c.value] = true
+		}
+	}
+	singleBits := 0
+	for v := range distinct {
+		if bits.OnesCount64(v) == 1 {
+			singleBits++
+		}
+	}
+
+	zeroName := ""
+	for _, c := range t.consts {
+		if c.value == 0 {
+			zeroName = c.name
+			break
+		}
+	}
+
+	// 1, 2, 3... is an enumeration, and so is 0, 1, 2, unless 0 means "none"
+	sequential := len(distinct) >= 3 ||
+		(len(distinct) == 2 && zeroName != "" && !strings.HasSuffix(zeroName, "_NONE"))
+	for i := 1; i <= len(distinct); i++ {
+		if !distinct[uint64(i)] {
+			sequential = false
+		}
+	}
+
+	t.flags = !sequential && singleBits >= 2 && singleBits*2 >= len(distinct)
+	if forceEnum[t.name] {
+		t.flags = false
+	}
+
+	if t.flags {
+		t.fields = flagFields[t.name]
+		var consts []_Const
+		for _, c := range t.consts {
+			if strings.Contains(c.name, "MASK") {
+				t.parseOnly = append(t.parseOnly, c)
+			} else {
+				consts = append(consts, c)
+			}
+		}
+		sort.SliceStable(consts, func(a, b int) bool { // bigger combinations first
+			return bits.OnesCount64(consts[a].value) > bits.OnesCount64(consts[b].value)
+		})
+		t.consts = consts
+	}
+
+	sort.SliceStable(t.consts, func(a, b int) bool { // names of aliases are not shown
+		return !t.consts[a].alias && t.consts[b].alias
+	})
+}
+
+func generate(pkgName string, ts []*_Type) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/cogen; DO NOT EDIT.\n\n")
+	buf.WriteString("//go:build windows\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	buf.WriteString("import \"github.com/rodrigocfd/windigo/internal/enum\"\n")
+
+	for _, t := range ts {
+		kind := "Enum"
+		if t.flags {
+			kind = "Flags"
+		}
+		fmt.Fprintf(&buf, "\nvar _%s_names = enum.%s[%s]{\n", t.name, kind, t.name)
+		fmt.Fprintf(&buf, "\tType: %q,\n", t.name)
+		writeNames(&buf, "Values", t.name, t.consts)
+		if len(t.parseOnly) > 0 {
+			writeNames(&buf, "ParseOnly", t.name, t.parseOnly)
+		}
+		if len(t.fields) > 0 {
+			fmt.Fprintf(&buf, "\tFields: []%s{", t.name)
+			for i, mask := range t.fields {
+				if i > 0 {
+					buf.WriteString(", ")
+				}
+				fmt.Fprintf(&buf, "0x%x", mask)
+			}
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("}\n")
+
+		if t.flags {
+			fmt.Fprintf(&buf, "\n// Returns the names of the flags joined by \"|\", like %q.\n",
+				joinFirst(t, 2))
+		} else {
+			fmt.Fprintf(&buf, "\n// Returns the name of the constant, like %q.\n", t.consts[0].name)
+		}
+		fmt.Fprintf(&buf, "func (v %s) String() string {\n\treturn _%s_names.String(v)\n}\n", t.name, t.name)
+
+		if t.flags {
+			fmt.Fprintf(&buf, "\n// Parses names of [%s] flags joined by \"|\", or numbers.\n", t.name)
+		} else {
+			fmt.Fprintf(&buf, "\n// Parses the name of a [%s] constant, or a number.\n", t.name)
+		}
+		fmt.Fprintf(&buf, "func Parse%s(s string) (%s, error) {\n\treturn _%s_names.Parse(s)\n}\n", t.name, t.name, t.name)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		os.Stdout.Write(buf.Bytes())
+		panic(err)
+	}
+	return src
+}
+
+// Writes a keyed field with a slice of names.
+func writeNames(buf *bytes.Buffer, field, typeName string, consts []_Const) {
+	fmt.Fprintf(buf, "\t%s: []enum.Name[%s]{\n", field, typeName)
+	for _, c := range consts {
+		fmt.Fprintf(buf, "\t\t{Val: %s, Name: %q},\n", c.name, c.name)
+	}
+	buf.WriteString("\t},\n")
+}
+
+// Returns one line for each type, with its kind and the masks of its fields,
+// which is pinned by testdata/kinds.txt.
+func listKinds(pkgDir string, ts []*_Type) string {
+	var buf strings.Builder
+	for _, t := range ts {
+		kind := "enum"
+		if t.flags {
+			kind = "flags"
+		}
+		fmt.Fprintf(&buf, "%s\t%s\t%s", pkgDir, t.name, kind)
+		for _, mask := range t.fields {
+			fmt.Fprintf(&buf, "\t0x%x", mask)
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// Returns an example of joined flag names, using single-bit constants.
+func joinFirst(t *_Type, n int) string {
+	var names []string
+	var used uint64
+	for _, mask := range t.fields {
+		used |= mask // values of fields are not flags
+	}
+	for _, c := range t.consts {
+		if len(names) < n && !c.alias && bits.OnesCount64(c.value) == 1 && used&c.value == 0 {
+			names = append(names, c.name)
+			used |= c.value
+		}
+	}
+	return strings.Join(names, "|")
+}
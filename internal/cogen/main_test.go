@@ -0,0 +1,71 @@
+package main
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The kind of each constant type is decided by a heuristic, so every type is
+// pinned in testdata/kinds.txt. If a type is added or changes its kind, check
+// whether it's right – fixing it with forceEnum or flagFields – then update the
+// file with:
+//
+//	go run ./internal/cogen -list > internal/cogen/testdata/kinds.txt
+func TestKinds(t *testing.T) {
+	contents, err := os.ReadFile("testdata/kinds.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		expected[fields[0]+"."+fields[1]] = fields[2]
+	}
+
+	build.Default.GOOS = "windows"
+	build.Default.GOARCH = "amd64"
+	root := moduleRoot()
+	got := make(map[string]bool)
+
+	for _, pkgDir := range packages {
+		listed := listKinds(pkgDir, loadTypes(filepath.Join(root, pkgDir)))
+		for _, line := range strings.Split(strings.TrimSpace(listed), "\n") {
+			fields := strings.SplitN(line, "\t", 3)
+			key := fields[0] + "." + fields[1]
+			got[key] = true
+			if exp, ok := expected[key]; !ok {
+				t.Errorf("%s: new type, kind %q not pinned", key, fields[2])
+			} else if fields[2] != exp {
+				t.Errorf("%s: kind %q, expected %q", key, fields[2], exp)
+			}
+		}
+	}
+
+	for key := range expected {
+		if !got[key] {
+			t.Errorf("%s: pinned type not found", key)
+		}
+	}
+	for name := range forceEnum {
+		if !found(got, name) {
+			t.Errorf("forceEnum: type %s not found", name)
+		}
+	}
+	for name := range flagFields {
+		if !found(got, name) {
+			t.Errorf("flagFields: type %s not found", name)
+		}
+	}
+}
+
+func found(got map[string]bool, name string) bool {
+	for key := range got {
+		if strings.HasSuffix(key, "."+name) {
+			return true
+		}
+	}
+	return false
+}
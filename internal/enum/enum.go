@@ -0,0 +1,190 @@
+// Package enum implements the String methods and Parse functions of the
+// constant types, which are generated by internal/cogen.
+package enum
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Underlying types of the constant types.
+type Integer interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~int |
+		~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uint | ~uintptr
+}
+
+// A constant value and its name.
+type Name[T Integer] struct {
+	Val  T
+	Name string
+}
+
+// Names of a type whose constants are mutually exclusive values.
+type Enum[T Integer] struct {
+	Type   string
+	Values []Name[T] // in declaration order; the first one wins for aliases
+}
+
+// Returns the name of the value, or the type followed by the number, like
+// "WM(0x401)".
+func (me *Enum[T]) String(v T) string {
+	for _, n := range me.Values {
+		if n.Val == v {
+			return n.Name
+		}
+	}
+	return me.Type + "(" + fmtNum(v) + ")"
+}
+
+// Parses a constant name, or a number.
+func (me *Enum[T]) Parse(s string) (T, error) {
+	if strings.Contains(s, "|") {
+		return 0, fmt.Errorf("Parse%s: flags not allowed: %q", me.Type, s)
+	}
+	v, err := parsePart(me.Type, me.Values, nil, s)
+	if err != nil {
+		return 0, fmt.Errorf("Parse%s: %w", me.Type, err)
+	}
+	return v, nil
+}
+
+// Names of a type whose constants are bit flags.
+type Flags[T Integer] struct {
+	Type      string
+	Values    []Name[T] // combinations with more bits come first
+	ParseOnly []Name[T] // masks, which are not used to decompose a value
+	Fields    []T       // masks of bit fields with mutually exclusive values
+}
+
+// Returns the name of the value, if there is one with the exact value.
+// Otherwise, decomposes the value into the flag names joined by "|", like
+// "WS_CHILD|WS_VISIBLE", with the bits without a name as a hex number.
+//
+// Each bit field is named as a whole, like "MB_YESNO|MB_ICONQUESTION", and
+// zero fields are omitted.
+func (me *Flags[T]) String(v T) string {
+	for _, n := range me.Values {
+		if n.Val == v {
+			return n.Name
+		}
+	}
+	if v == 0 {
+		return "0"
+	}
+
+	var parts []string
+	var unnamed T
+	rem := v
+	for _, mask := range me.Fields {
+		field := rem & mask
+		rem &^= mask
+		if field == 0 {
+			continue
+		}
+		unnamed |= field // unless a name is found
+		for _, n := range me.Values {
+			if n.Val == field {
+				parts = append(parts, n.Name)
+				unnamed &^= field
+				break
+			}
+		}
+	}
+	for _, n := range me.Values {
+		if n.Val != 0 && n.Val&rem == n.Val {
+			parts = append(parts, n.Name)
+			rem &^= n.Val
+		}
+	}
+	if rem |= unnamed; rem != 0 {
+		parts = append(parts, "0x"+strconv.FormatUint(toUint(rem), 16))
+	}
+	return strings.Join(parts, "|")
+}
+
+// Parses flag names or numbers joined by "|".
+func (me *Flags[T]) Parse(s string) (T, error) {
+	var v T
+	for _, part := range strings.Split(s, "|") {
+		n, err := parsePart(me.Type, me.Values, me.ParseOnly, part)
+		if err != nil {
+			return 0, fmt.Errorf("Parse%s: %w", me.Type, err)
+		}
+		v |= n
+	}
+	return v, nil
+}
+
+// Parses a single name, with an optional package prefix like "co.", or a
+// number, which can also be written as "TYPE(number)".
+func parsePart[T Integer](typeName string, names, more []Name[T], s string) (T, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("empty value")
+	}
+
+	name := s
+	if idx := strings.LastIndexByte(name, '.'); idx != -1 {
+		name = name[idx+1:]
+	}
+	for _, list := range [][]Name[T]{names, more} {
+		for _, n := range list {
+			if n.Name == name {
+				return n.Val, nil
+			}
+		}
+	}
+
+	num := s
+	if strings.HasPrefix(num, typeName+"(") && strings.HasSuffix(num, ")") {
+		num = num[len(typeName)+1 : len(num)-1]
+	}
+	return parseNum[T](num, s)
+}
+
+// Parses a decimal or 0x hex number, checking the range of the type.
+func parseNum[T Integer](num, orig string) (T, error) {
+	if isSigned[T]() {
+		n, err := strconv.ParseInt(num, 0, 64)
+		if err != nil || int64(T(n)) != n {
+			return 0, fmt.Errorf("invalid value: %q", orig)
+		}
+		return T(n), nil
+	}
+	n, err := strconv.ParseUint(num, 0, 64)
+	if err != nil || uint64(T(n)) != n {
+		return 0, fmt.Errorf("invalid value: %q", orig)
+	}
+	return T(n), nil
+}
+
+func isSigned[T Integer]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+// Formats signed types in decimal, and unsigned types in hex.
+func fmtNum[T Integer](v T) string {
+	if isSigned[T]() {
+		return strconv.FormatInt(int64(v), 10)
+	}
+	return "0x" + strconv.FormatUint(uint64(v), 16)
+}
+
+// Returns the bits of the value, without the sign extension.
+func toUint[T Integer](v T) uint64 {
+	var zero T
+	if isSigned[T]() && v < zero {
+		switch unsafe.Sizeof(v) {
+		case 1:
+			return uint64(uint8(v))
+		case 2:
+			return uint64(uint16(v))
+		case 4:
+			return uint64(uint32(v))
+		}
+	}
+	return uint64(v)
+}
@@ -0,0 +1,80 @@
+package enum_test
+
+import (
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/internal/enum"
+)
+
+type _BS uint32
+
+var _BS_names = enum.Flags[_BS]{
+	Type: "BS",
+	Values: []enum.Name[_BS]{
+		{Val: 0x0003, Name: "BS_AUTOCHECKBOX"},
+		{Val: 0x0300, Name: "BS_CENTER"},
+		{Val: 0x0000, Name: "BS_PUSHBUTTON"},
+		{Val: 0x0001, Name: "BS_DEFPUSHBUTTON"},
+		{Val: 0x0002, Name: "BS_CHECKBOX"},
+		{Val: 0x0100, Name: "BS_LEFT"},
+		{Val: 0x0200, Name: "BS_RIGHT"},
+		{Val: 0x2000, Name: "BS_MULTILINE"},
+	},
+	ParseOnly: []enum.Name[_BS]{
+		{Val: 0x000f, Name: "BS_TYPEMASK"},
+	},
+	Fields: []_BS{0x000f},
+}
+
+type _SW int32
+
+var _SW_names = enum.Enum[_SW]{
+	Type: "SW",
+	Values: []enum.Name[_SW]{
+		{Val: 0, Name: "SW_HIDE"},
+		{Val: 1, Name: "SW_SHOWNORMAL"},
+		{Val: 1, Name: "SW_NORMAL"},
+	},
+}
+
+func ExampleEnum_String() {
+	fmt.Println(_SW_names.String(1))
+	fmt.Println(_SW_names.String(-2))
+	// Output:
+	// SW_SHOWNORMAL
+	// SW(-2)
+}
+
+func ExampleEnum_Parse() {
+	v1, _ := _SW_names.Parse("co.SW_NORMAL")
+	v2, _ := _SW_names.Parse("SW(0x10)")
+	_, err := _SW_names.Parse("SW_HIDE|SW_NORMAL")
+	fmt.Println(v1, v2)
+	fmt.Println(err)
+	// Output:
+	// 1 16
+	// ParseSW: flags not allowed: "SW_HIDE|SW_NORMAL"
+}
+
+func ExampleFlags_String() {
+	fmt.Println(_BS_names.String(0x0003))
+	fmt.Println(_BS_names.String(0x2103))
+	fmt.Println(_BS_names.String(0x2300))
+	fmt.Println(_BS_names.String(0x800c))
+	// Output:
+	// BS_AUTOCHECKBOX
+	// BS_AUTOCHECKBOX|BS_LEFT|BS_MULTILINE
+	// BS_CENTER|BS_MULTILINE
+	// 0x800c
+}
+
+func ExampleFlags_Parse() {
+	v1, _ := _BS_names.Parse("BS_AUTOCHECKBOX | co.BS_CENTER")
+	v2, _ := _BS_names.Parse("BS_TYPEMASK|0x2000")
+	_, err := _BS_names.Parse("BS_FOO")
+	fmt.Printf("%#x %#x\n", v1, v2)
+	fmt.Println(err)
+	// Output:
+	// 0x303 0x200f
+	// ParseBS: invalid value: "BS_FOO"
+}
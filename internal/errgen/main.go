@@ -0,0 +1,209 @@
+// Generates winerr/data/errors.tsv from the error constants of co and the x/co*
+// packages. Run from anywhere in the module:
+//
+//	go run ./internal/errgen
+//
+// The names come from the constants. The English messages come from
+// FormatMessage, therefore the generator must run on Windows, with the en-US
+// language installed, to get all of them. On other systems, the messages
+// already in the file are kept, and codes without one get the comment of
+// their constant, if any.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type _Source struct {
+	glob     string // relative to the module root
+	typeName string // co.ERROR is "ERROR", co.HRESULT is "HRESULT"
+	kind     string // as written in the TSV
+	prefix   string // removed from the constant name
+}
+
+var sources = []_Source{
+	{"co/err_error.go", "ERROR", "E", ""},
+	{"co/err_hresult.go", "HRESULT", "H", "HRESULT_"},
+	{"x/co*/err_*.go", "HRESULT", "H", "HRESULT_"},
+	{"co/co_ole.go", "FACILITY", "F", ""},
+}
+
+const tsvPath = "winerr/data/errors.tsv"
+
+// Header line which tells where the messages came from; checked by the winerr
+// tests.
+const (
+	headerFromSystem = "# messages: FormatMessage, en-US"
+	headerIncomplete = "# messages: incomplete, run go generate ./winerr on Windows"
+)
+
+func main() {
+	root := moduleRoot()
+	path := filepath.Join(root, tsvPath)
+	prevHeader, prevMsgs := loadPrevious(path)
+
+	header := headerIncomplete
+	if canFormat {
+		header = headerFromSystem
+	} else if prevHeader == headerFromSystem {
+		header = prevHeader // messages kept from a previous run on Windows
+	}
+
+	type _Line struct{ kind, code, name, message string }
+	var lines []_Line
+	seen := make(map[string]bool) // kind+code, first name wins
+
+	for _, src := range sources {
+		files, _ := filepath.Glob(filepath.Join(root, src.glob))
+		for _, file := range files {
+			for _, c := range parseConsts(file, src.typeName) {
+				key := src.kind + c.code
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				message := ""
+				if src.kind != "F" {
+					if canFormat {
+						message = formatMessage(c.value)
+					} else {
+						message = prevMsgs[key]
+					}
+					if message == "" {
+						message = c.comment
+					}
+				}
+				lines = append(lines, _Line{src.kind, c.code,
+					strings.TrimPrefix(c.name, src.prefix), message})
+			}
+		}
+	}
+
+	fout, err := os.Create(path)
+	if err != nil {
+		panic(err)
+	}
+	defer fout.Close()
+	out := bufio.NewWriter(fout)
+	defer out.Flush()
+
+	fmt.Fprintln(out, "# Code generated by internal/errgen; DO NOT EDIT.")
+	fmt.Fprintln(out, header)
+	fmt.Fprintln(out, "# kind\tcode\tname\tmessage")
+	for _, line := range lines {
+		fmt.Fprintf(out, "%s\t%s\t%s\t%s\n", line.kind, line.code, line.name, line.message)
+	}
+}
+
+// Returns the root directory of the module, where go.mod is.
+func moduleRoot() string {
+	dir, _ := os.Getwd()
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			panic("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+// Returns the messages header and the messages of the existing file, keyed by
+// kind+code.
+func loadPrevious(path string) (string, map[string]string) {
+	header := ""
+	msgs := make(map[string]string)
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return header, msgs // no previous file
+	}
+	for _, line := range strings.Split(string(contents), "\n") {
+		if strings.HasPrefix(line, "# messages:") {
+			header = line
+		} else if line != "" && line[0] != '#' {
+			fields := strings.SplitN(line, "\t", 4)
+			if len(fields) == 4 && fields[3] != "" {
+				msgs[fields[0]+fields[1]] = fields[3]
+			}
+		}
+	}
+	return header, msgs
+}
+
+type _Const struct {
+	name    string
+	value   uint32
+	code    string // hex
+	comment string
+}
+
+// Returns the constants of the given type, in declaration order, whose values
+// are literals.
+func parseConsts(file, typeName string) []_Const {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+	if err != nil {
+		panic(err)
+	}
+
+	var consts []_Const
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vspec := spec.(*ast.ValueSpec)
+			if !isType(vspec.Type, typeName) || len(vspec.Values) != 1 {
+				continue
+			}
+			lit, ok := vspec.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				continue
+			}
+			n, err := strconv.ParseUint(lit.Value, 0, 32)
+			if err != nil {
+				panic(err)
+			}
+			comment := ""
+			if vspec.Comment != nil {
+				comment = strings.TrimSpace(vspec.Comment.Text())
+			}
+			consts = append(consts, _Const{
+				name:    vspec.Names[0].Name,
+				value:   uint32(n),
+				code:    fmt.Sprintf("0x%08x", n),
+				comment: cleanMessage(comment),
+			})
+		}
+	}
+	return consts
+}
+
+// Tells whether the type expression is T or co.T.
+func isType(expr ast.Expr, typeName string) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name == typeName
+	case *ast.SelectorExpr:
+		return e.Sel.Name == typeName
+	}
+	return false
+}
+
+// Puts the message in a single line, without tabs.
+func cleanMessage(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,10 @@
+//go:build !windows
+
+package main
+
+const canFormat = false
+
+// Never called, since canFormat is false.
+func formatMessage(code uint32) string {
+	panic("FormatMessage is available on Windows only.")
+}
@@ -0,0 +1,38 @@
+//go:build windows
+
+package main
+
+import (
+	"syscall"
+)
+
+const canFormat = true
+
+// Returns the English message of the error code, or an empty string if the
+// system has none.
+//
+// Panics if the en-US language is not installed.
+func formatMessage(code uint32) string {
+	const (
+		FORMAT_MESSAGE_IGNORE_INSERTS   = 0x0000_0200
+		FORMAT_MESSAGE_FROM_SYSTEM      = 0x0000_1000
+		ERROR_MR_MID_NOT_FOUND          = 317
+		ERROR_RESOURCE_LANG_NOT_FOUND   = 1815
+		LANG_ENGLISH_SUBLANG_ENGLISH_US = 0x0409
+	)
+
+	buf := make([]uint16, 4096)
+	n, err := syscall.FormatMessage(
+		FORMAT_MESSAGE_FROM_SYSTEM|FORMAT_MESSAGE_IGNORE_INSERTS,
+		0, code, LANG_ENGLISH_SUBLANG_ENGLISH_US, buf, nil)
+	if err != nil {
+		switch errno, _ := err.(syscall.Errno); errno {
+		case ERROR_MR_MID_NOT_FOUND:
+			return ""
+		case ERROR_RESOURCE_LANG_NOT_FOUND:
+			panic("The en-US language must be installed to generate the messages.")
+		}
+		panic(err)
+	}
+	return cleanMessage(syscall.UTF16ToString(buf[:n]))
+}
@@ -0,0 +1,186 @@
+package locale
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed data/locales.json
+var localesJson []byte
+
+// Returned by [ByTag] and [ByLangId] when the locale is not known.
+var ErrNotFound = errors.New("locale not found")
+
+// Formatting conventions of a locale.
+//
+// Obtain it with [ByTag], [ByLangId] or [Invariant]. The returned object is a
+// copy, so its fields can be freely changed.
+type Locale struct {
+	Tag      string // BCP-47 language tag, like "pt-BR"; empty for the invariant locale.
+	LangId   uint16 // Windows LANGID, like 0x0416.
+	Name     string // English name, like "Portuguese (Brazil)".
+	Decimal  string // Decimal separator.
+	Thousand string // Thousand separator.
+	// Sizes of the digit groups, from right to left, like {3, 2}. The last size
+	// is repeated, unless it's zero, which ends the grouping. Empty means no
+	// grouping.
+	Grouping []int
+	Negative string // Negative sign.
+	// Short date format, as a Windows [picture], like "dd/MM/yyyy".
+	//
+	// [picture]: https://learn.microsoft.com/en-us/windows/win32/intl/day--month--year--and-era-format-pictures
+	ShortDate string
+	// Long date format, as a Windows [picture], like "dddd, MMMM d, yyyy".
+	//
+	// [picture]: https://learn.microsoft.com/en-us/windows/win32/intl/day--month--year--and-era-format-pictures
+	LongDate       string
+	Months         [12]string // Month names, starting at January.
+	MonthsAbbr     [12]string // Abbreviated month names.
+	MonthsGenitive [12]string // Month names used along with the day; empty if the language has no genitive case.
+	Days           [7]string  // Day names, starting at Sunday.
+	DaysAbbr       [7]string  // Abbreviated day names.
+	Bytes          string     // Word for bytes, like "bytes".
+	ByteSymbol     string     // Symbol for byte used in the units, like "B".
+}
+
+var (
+	localesOnce sync.Once
+	locales     []*Locale // in the order of the data file
+)
+
+// Parses the embedded locale data, once.
+func loadLocales() {
+	localesOnce.Do(func() {
+		var raws []json.RawMessage
+		if err := json.Unmarshal(localesJson, &raws); err != nil {
+			panic(err) // embedded, should never happen
+		}
+
+		byTag := make(map[string]*Locale, len(raws))
+		for _, raw := range raws {
+			var header struct {
+				Base   *string `json:"base"`
+				LangId string  `json:"langId"`
+			}
+			if err := json.Unmarshal(raw, &header); err != nil {
+				panic(err)
+			}
+
+			loc := &Locale{}
+			if header.Base != nil {
+				*loc = *byTag[*header.Base]
+			}
+			if err := json.Unmarshal(raw, (*_LocaleJson)(loc)); err != nil {
+				panic(err)
+			}
+			langId, err := strconv.ParseUint(header.LangId, 16, 16)
+			if err != nil {
+				panic(err)
+			}
+			loc.LangId = uint16(langId)
+
+			byTag[loc.Tag] = loc
+			locales = append(locales, loc)
+		}
+	})
+}
+
+// Same fields of Locale, with the JSON names of the data file.
+type _LocaleJson struct {
+	Tag            string     `json:"tag"`
+	LangId         uint16     `json:"-"`
+	Name           string     `json:"name"`
+	Decimal        string     `json:"decimal"`
+	Thousand       string     `json:"thousand"`
+	Grouping       []int      `json:"grouping"`
+	Negative       string     `json:"negative"`
+	ShortDate      string     `json:"shortDate"`
+	LongDate       string     `json:"longDate"`
+	Months         [12]string `json:"months"`
+	MonthsAbbr     [12]string `json:"monthsAbbr"`
+	MonthsGenitive [12]string `json:"monthsGenitive"`
+	Days           [7]string  `json:"days"`
+	DaysAbbr       [7]string  `json:"daysAbbr"`
+	Bytes          string     `json:"bytes"`
+	ByteSymbol     string     `json:"byteSymbol"`
+}
+
+// Returns a copy of the locale.
+func (me *Locale) clone() *Locale {
+	c := *me
+	c.Grouping = append([]int{}, me.Grouping...)
+	return &c
+}
+
+// Returns the locale with the given BCP-47 language tag, case-insensitive,
+// like "pt-BR" or "pt_BR". If the region is not known, the main locale of the
+// language is returned, so "pt" and "pt-AO" return "pt-BR".
+//
+// Example:
+//
+//	deDe, _ := locale.ByTag("de-DE")
+//	println(deDe.FmtInt(-1234567)) // -1.234.567
+func ByTag(tag string) (*Locale, error) {
+	loadLocales()
+	tag = strings.ReplaceAll(tag, "_", "-")
+	for _, loc := range locales {
+		if loc.Tag != "" && strings.EqualFold(loc.Tag, tag) {
+			return loc.clone(), nil
+		}
+	}
+
+	lang, _, _ := strings.Cut(tag, "-")
+	for _, loc := range locales {
+		if locLang, _, _ := strings.Cut(loc.Tag, "-"); locLang != "" && strings.EqualFold(locLang, lang) {
+			return loc.clone(), nil
+		}
+	}
+	return nil, fmt.Errorf("ByTag: %w: %q", ErrNotFound, tag)
+}
+
+// Returns the locale with the given Windows language identifier, which is a
+// win.LANGID, or the lower 16 bits of a win.LCID. If the sublanguage is not
+// known, the main locale of the primary language is returned.
+//
+// Example:
+//
+//	frFr, _ := locale.ByLangId(0x040c)
+//	println(frFr.Tag) // fr-FR
+func ByLangId(langId uint16) (*Locale, error) {
+	loadLocales()
+	for _, loc := range locales {
+		if loc.LangId == langId {
+			return loc.clone(), nil
+		}
+	}
+
+	const primaryMask = 0x3ff
+	for _, loc := range locales {
+		if loc.Tag != "" && loc.LangId&primaryMask == langId&primaryMask {
+			return loc.clone(), nil
+		}
+	}
+	return nil, fmt.Errorf("ByLangId: %w: 0x%04x", ErrNotFound, langId)
+}
+
+// Returns the invariant locale, which has the English names and the US
+// separators, and a fixed date format.
+func Invariant() *Locale {
+	loadLocales()
+	return locales[0].clone()
+}
+
+// Returns the BCP-47 tags of all the embedded locales.
+func Tags() []string {
+	loadLocales()
+	tags := make([]string, 0, len(locales)-1)
+	for _, loc := range locales[1:] { // skip invariant
+		tags = append(tags, loc.Tag)
+	}
+	return tags
+}
@@ -0,0 +1,279 @@
+package locale
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats an integer with the locale negative sign and digit grouping.
+//
+// Example:
+//
+//	enIn, _ := locale.ByTag("en-IN")
+//	println(enIn.FmtInt(12345678)) // 1,23,45,678
+func (me *Locale) FmtInt(n int64) string {
+	if n < 0 {
+		return me.Negative + me.group(strconv.FormatUint(uint64(-n), 10))
+	}
+	return me.group(strconv.FormatInt(n, 10))
+}
+
+// Formats an unsigned integer with the locale digit grouping.
+func (me *Locale) FmtUint(n uint64) string {
+	return me.group(strconv.FormatUint(n, 10))
+}
+
+// Formats a number with the given number of decimal places, rounded, with the
+// locale negative sign, decimal separator and digit grouping.
+//
+// Example:
+//
+//	ptBr, _ := locale.ByTag("pt-BR")
+//	println(ptBr.FmtFloat(-1234.567, 2)) // -1.234,57
+func (me *Locale) FmtFloat(f float64, decimals int) string {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	s := strconv.FormatFloat(math.Abs(f), 'f', decimals, 64)
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+
+	var buf strings.Builder
+	if f < 0 && strings.Trim(s, "0.") != "" { // no "-0"
+		buf.WriteString(me.Negative)
+	}
+	buf.WriteString(me.group(intPart))
+	if hasFrac {
+		buf.WriteString(me.Decimal)
+		buf.WriteString(fracPart)
+	}
+	return buf.String()
+}
+
+// Inserts the thousand separators in a string of digits.
+func (me *Locale) group(digits string) string {
+	if len(me.Grouping) == 0 || me.Grouping[0] <= 0 {
+		return digits
+	}
+
+	groups := make([]string, 0, len(digits)/3+1)
+	idxGroup := 0
+	for len(digits) > 0 {
+		size := me.Grouping[idxGroup]
+		if idxGroup < len(me.Grouping)-1 {
+			idxGroup++
+		}
+		if size <= 0 || size >= len(digits) {
+			groups = append(groups, digits)
+			break
+		}
+		groups = append(groups, digits[len(digits)-size:])
+		digits = digits[:len(digits)-size]
+	}
+
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	return strings.Join(groups, me.Thousand)
+}
+
+// Units used by [Locale.FmtBytes].
+type UNITS uint8
+
+const (
+	// Multiples of 1024, with KB, MB, GB… like Windows Explorer.
+	UNITS_WINDOWS UNITS = iota
+	// Multiples of 1024, with KiB, MiB, GiB…
+	UNITS_IEC
+	// Multiples of 1000, with kB, MB, GB…
+	UNITS_SI
+)
+
+// Formats a number of bytes with 3 significant digits, like Windows Explorer,
+// using the locale decimal separator and symbol for byte.
+//
+// Example:
+//
+//	deDe, _ := locale.ByTag("de-DE")
+//	println(deDe.FmtBytes(1500, locale.UNITS_WINDOWS)) // 1,46 KB
+//	println(deDe.FmtBytes(1500, locale.UNITS_IEC))     // 1,46 KiB
+//	println(deDe.FmtBytes(1500, locale.UNITS_SI))      // 1,50 kB
+func (me *Locale) FmtBytes(numBytes uint64, units UNITS) string {
+	base := 1024.0
+	if units == UNITS_SI {
+		base = 1000
+	}
+	if float64(numBytes) < base {
+		return me.FmtUint(numBytes) + " " + me.Bytes
+	}
+
+	prefixes := "KMGTPE"
+	value := float64(numBytes)
+	idx := -1
+	for value >= 1000 && idx < len(prefixes)-1 { // 1000 KB is shown as 0,97 MB
+		value /= base
+		idx++
+	}
+
+	decimals := 0
+	switch {
+	case value < 9.995:
+		decimals = 2
+	case value < 99.95:
+		decimals = 1
+	}
+
+	prefix := prefixes[idx : idx+1]
+	if units == UNITS_SI && prefix == "K" {
+		prefix = "k"
+	} else if units == UNITS_IEC {
+		prefix += "i"
+	}
+	return me.FmtFloat(value, decimals) + " " + prefix + me.ByteSymbol
+}
+
+// Formats the date with [Locale.ShortDate].
+//
+// Example:
+//
+//	enUs, _ := locale.ByTag("en-US")
+//	println(enUs.FmtShortDate(time.Now())) // 3/14/2024
+func (me *Locale) FmtShortDate(t time.Time) string {
+	return me.FmtDate(t, me.ShortDate)
+}
+
+// Formats the date with [Locale.LongDate].
+//
+// Example:
+//
+//	enUs, _ := locale.ByTag("en-US")
+//	println(enUs.FmtLongDate(time.Now())) // Thursday, March 14, 2024
+func (me *Locale) FmtLongDate(t time.Time) string {
+	return me.FmtDate(t, me.LongDate)
+}
+
+// Formats the date with a Windows [picture], like GetDateFormatEx does:
+//   - d, dd – day of the month, without and with leading zero;
+//   - ddd, dddd – abbreviated and full day name;
+//   - M, MM – month, without and with leading zero;
+//   - MMM, MMMM – abbreviated and full month name, which uses
+//     [Locale.MonthsGenitive] if the picture also has a day of the month;
+//   - y, yy – year in two digits, without and with leading zero;
+//   - yyyy – full year;
+//   - text between single quotes is copied, and two single quotes produce one.
+//
+// Other characters are copied as they are.
+//
+// Example:
+//
+//	ptBr, _ := locale.ByTag("pt-BR")
+//	s := ptBr.FmtDate(time.Now(), "d 'de' MMMM") // 14 de março
+//
+// [picture]: https://learn.microsoft.com/en-us/windows/win32/intl/day--month--year--and-era-format-pictures
+func (me *Locale) FmtDate(t time.Time, picture string) string {
+	useGenitive := me.MonthsGenitive[0] != "" && pictureHasDay(picture)
+
+	var buf strings.Builder
+	for len(picture) > 0 {
+		ch := picture[0]
+		if ch == '\'' {
+			literal, rest := pictureLiteral(picture[1:])
+			buf.WriteString(literal)
+			picture = rest
+			continue
+		}
+
+		count := 1
+		for count < len(picture) && picture[count] == ch {
+			count++
+		}
+
+		switch ch {
+		case 'd':
+			switch count {
+			case 1:
+				buf.WriteString(strconv.Itoa(t.Day()))
+			case 2:
+				buf.WriteString(twoDigits(t.Day()))
+			case 3:
+				buf.WriteString(me.DaysAbbr[t.Weekday()])
+			default:
+				buf.WriteString(me.Days[t.Weekday()])
+			}
+		case 'M':
+			switch count {
+			case 1:
+				buf.WriteString(strconv.Itoa(int(t.Month())))
+			case 2:
+				buf.WriteString(twoDigits(int(t.Month())))
+			case 3:
+				buf.WriteString(me.MonthsAbbr[t.Month()-1])
+			default:
+				if useGenitive {
+					buf.WriteString(me.MonthsGenitive[t.Month()-1])
+				} else {
+					buf.WriteString(me.Months[t.Month()-1])
+				}
+			}
+		case 'y':
+			switch count {
+			case 1:
+				buf.WriteString(strconv.Itoa(t.Year() % 100))
+			case 2:
+				buf.WriteString(twoDigits(t.Year() % 100))
+			default:
+				buf.WriteString(strconv.Itoa(t.Year()))
+			}
+		case 'g': // era is not supported
+		default:
+			buf.WriteString(picture[:count])
+		}
+		picture = picture[count:]
+	}
+	return buf.String()
+}
+
+// Returns the text until the closing quote, and the rest of the picture.
+func pictureLiteral(picture string) (literal, rest string) {
+	var buf strings.Builder
+	for i := 0; i < len(picture); i++ {
+		if picture[i] == '\'' {
+			if i+1 < len(picture) && picture[i+1] == '\'' { // escaped quote
+				buf.WriteByte('\'')
+				i++
+				continue
+			}
+			return buf.String(), picture[i+1:]
+		}
+		buf.WriteByte(picture[i])
+	}
+	return buf.String(), "" // unclosed quote
+}
+
+// Tells whether the picture has a numeric day, outside quotes.
+func pictureHasDay(picture string) bool {
+	for len(picture) > 0 {
+		if picture[0] == '\'' {
+			_, picture = pictureLiteral(picture[1:])
+			continue
+		}
+		count := 1
+		for count < len(picture) && picture[count] == picture[0] {
+			count++
+		}
+		if picture[0] == 'd' && count <= 2 {
+			return true
+		}
+		picture = picture[count:]
+	}
+	return false
+}
+
+func twoDigits(n int) string {
+	if n < 10 {
+		return "0" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
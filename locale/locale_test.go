@@ -0,0 +1,82 @@
+package locale_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigocfd/windigo/locale"
+)
+
+func ExampleByTag() {
+	for _, tag := range []string{"de-CH", "pt_br", "pt-AO", "xx-YY"} {
+		if loc, err := locale.ByTag(tag); err != nil {
+			fmt.Println(errors.Is(err, locale.ErrNotFound), err)
+		} else {
+			fmt.Printf("%s %04x %s\n", loc.Tag, loc.LangId, loc.Name)
+		}
+	}
+	// Output:
+	// de-CH 0807 German (Switzerland)
+	// pt-BR 0416 Portuguese (Brazil)
+	// pt-BR 0416 Portuguese (Brazil)
+	// true ByTag: locale not found: "xx-YY"
+}
+
+func ExampleByLangId() {
+	esMx, _ := locale.ByLangId(0x080a)
+	fmt.Println(esMx.Tag)
+
+	es, _ := locale.ByLangId(0x2c0a) // Spanish (Argentina), not embedded
+	fmt.Println(es.Tag)
+	// Output:
+	// es-MX
+	// es-ES
+}
+
+func ExampleLocale_FmtFloat() {
+	for _, tag := range []string{"en-US", "de-DE", "pt-BR", "de-CH", "en-IN"} {
+		loc, _ := locale.ByTag(tag)
+		fmt.Printf("%s %s | %s\n", tag, loc.FmtFloat(-1234567.891, 2), loc.FmtInt(1000))
+	}
+	// Output:
+	// en-US -1,234,567.89 | 1,000
+	// de-DE -1.234.567,89 | 1.000
+	// pt-BR -1.234.567,89 | 1.000
+	// de-CH -1’234’567.89 | 1’000
+	// en-IN -12,34,567.89 | 1,000
+}
+
+func ExampleLocale_FmtBytes() {
+	deDe, _ := locale.ByTag("de-DE")
+	frFr, _ := locale.ByTag("fr-FR")
+	for _, n := range []uint64{900, 1500, 123_456_789} {
+		fmt.Printf("%s | %s | %s | %s\n",
+			deDe.FmtBytes(n, locale.UNITS_WINDOWS),
+			deDe.FmtBytes(n, locale.UNITS_IEC),
+			deDe.FmtBytes(n, locale.UNITS_SI),
+			frFr.FmtBytes(n, locale.UNITS_WINDOWS))
+	}
+	// Output:
+	// 900 Bytes | 900 Bytes | 900 Bytes | 900 octets
+	// 1,46 KB | 1,46 KiB | 1,50 kB | 1,46 Ko
+	// 118 MB | 118 MiB | 123 MB | 118 Mo
+}
+
+func ExampleLocale_FmtDate() {
+	t := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	for _, tag := range []string{"en-US", "en-GB", "pt-BR", "ru-RU", "ja-JP"} {
+		loc, _ := locale.ByTag(tag)
+		fmt.Printf("%s | %s\n", loc.FmtShortDate(t), loc.FmtLongDate(t))
+	}
+
+	inv := locale.Invariant()
+	fmt.Println(inv.FmtDate(t, "MMMM yyyy, 'week''s' ddd"))
+	// Output:
+	// 3/5/2024 | Tuesday, March 5, 2024
+	// 05/03/2024 | 05 March 2024
+	// 05/03/2024 | terça-feira, 5 de março de 2024
+	// 05.03.2024 | 5 марта 2024 г.
+	// 2024/03/05 | 2024年3月5日
+	// March 2024, week's Tue
+}
@@ -0,0 +1,13 @@
+// This package formats numbers, dates and byte sizes according to the
+// conventions of a locale, like the decimal and thousand separators, and the
+// names of months and days.
+//
+// The locale data is embedded, so the formatting is deterministic and works on
+// any OS. On Windows, win.UserLocale returns a [Locale] with the regional
+// settings customized by the user.
+//
+// Example:
+//
+//	ptBr, _ := locale.ByTag("pt-BR")
+//	println(ptBr.FmtFloat(1234.5, 2)) // 1.234,50
+package locale
@@ -0,0 +1,406 @@
+package reg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/rodrigocfd/windigo/wstr"
+)
+
+// Returned when a .reg file has invalid syntax.
+var ErrSyntax = errors.New("invalid .reg syntax")
+
+const (
+	fileHeader5 = "Windows Registry Editor Version 5.00"
+	fileHeader4 = "REGEDIT4"
+)
+
+// Contents of a .reg file, in the REGEDIT5 text format used by regedit to
+// export and import registry keys.
+//
+// Example:
+//
+//	f, _ := reg.ParseFile(strings.NewReader(
+//		"Windows Registry Editor Version 5.00\r\n" +
+//			"\r\n" +
+//			"[HKEY_CURRENT_USER\\Software\\MyApp]\r\n" +
+//			"\"Width\"=dword:00000320\r\n"))
+//
+//	for _, key := range f.Keys {
+//		println(key.Path)
+//	}
+type File struct {
+	Keys []FileKey // Keys, in file order.
+}
+
+// A key of a [File], written as a "[path]" header followed by its values.
+type FileKey struct {
+	Path   string      // Full path of the key, starting with the root key name, like "HKEY_CURRENT_USER\Software".
+	Delete bool        // The key and its subkeys are deleted; written as "[-path]".
+	Values []FileValue // Values, in file order.
+}
+
+// A value of a [FileKey].
+type FileValue struct {
+	Name   string // Value name; empty for the default value, written as "@".
+	Delete bool   // The value is deleted; written as "name"=-.
+	Value  Value  // Type and data of the value, unless deleted.
+}
+
+// Creates a [File] with the key and all its subkeys, recursively, as regedit
+// exports them. The path is the full path of the key, starting with the root
+// key name, like "HKEY_CURRENT_USER\Software\MyApp".
+//
+// Example:
+//
+//	key, _ := reg.EncodeStruct(settings)
+//	f := reg.FileFromKey("HKEY_CURRENT_USER\\Software\\MyApp", key)
+//	println(f.String())
+func FileFromKey(path string, key *Key) *File {
+	me := &File{}
+	me.addKey(path, key)
+	return me
+}
+
+func (me *File) addKey(path string, key *Key) {
+	fileKey := FileKey{Path: path}
+	for _, name := range key.ValueNames() {
+		fileKey.Values = append(fileKey.Values, FileValue{Name: name, Value: key.Values[name]})
+	}
+	me.Keys = append(me.Keys, fileKey)
+
+	for _, name := range key.SubkeyNames() {
+		me.addKey(path+"\\"+name, key.Subkeys[name])
+	}
+}
+
+// Parses the contents of a .reg file. The data is expected in UTF-16 with BOM,
+// as written by regedit, or in UTF-8.
+//
+// Both "Windows Registry Editor Version 5.00" and the older "REGEDIT4" headers
+// are accepted. Hex lines can be continued with a trailing backslash, and
+// comments start with ";".
+//
+// Errors wrap [ErrSyntax], with the line number.
+func ParseFile(r io.Reader) (*File, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("ParseFile: %w", err)
+	}
+	text, err := decodeFileText(data)
+	if err != nil {
+		return nil, fmt.Errorf("ParseFile: %w", err)
+	}
+
+	me := &File{}
+	var p _FileParser
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimRight(lines[i], "\r \t")
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" && trimmed[0] != '[' && trimmed[0] != ';' {
+			for strings.HasSuffix(line, "\\") && i+1 < len(lines) { // continuation
+				i++
+				line = line[:len(line)-1] + strings.TrimSpace(lines[i])
+			}
+		}
+
+		if err := p.parseLine(me, strings.TrimSpace(line)); err != nil {
+			return nil, fmt.Errorf("ParseFile: line %d: %w", lineNo, err)
+		}
+	}
+
+	if !p.gotHeader {
+		return nil, fmt.Errorf("ParseFile: %w: missing header", ErrSyntax)
+	}
+	return me, nil
+}
+
+// Decodes the raw data into UTF-8, according to the BOM.
+func decodeFileText(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xef, 0xbb, 0xbf}):
+		return string(data[3:]), nil
+	case bytes.HasPrefix(data, []byte{0xff, 0xfe}), bytes.HasPrefix(data, []byte{0xfe, 0xff}):
+		text, err := io.ReadAll(wstr.NewUtf16Reader(bytes.NewReader(data), wstr.Utf16LE, wstr.LoneReplace))
+		return string(text), err
+	default:
+		return string(data), nil
+	}
+}
+
+// Parsing state of a .reg file.
+type _FileParser struct {
+	gotHeader bool
+	regedit4  bool // strings in hex(2) and hex(7) are 8-bit
+}
+
+func (me *_FileParser) parseLine(f *File, line string) error {
+	if line == "" || line[0] == ';' {
+		return nil
+	}
+
+	if !me.gotHeader {
+		switch line {
+		case fileHeader5:
+		case fileHeader4:
+			me.regedit4 = true
+		default:
+			return fmt.Errorf("%w: invalid header %q", ErrSyntax, line)
+		}
+		me.gotHeader = true
+		return nil
+	}
+
+	if line[0] == '[' {
+		if line[len(line)-1] != ']' {
+			return fmt.Errorf("%w: unterminated key %q", ErrSyntax, line)
+		}
+		path := strings.TrimSpace(line[1 : len(line)-1])
+		fileKey := FileKey{Path: strings.TrimSpace(strings.TrimPrefix(path, "-"))}
+		fileKey.Delete = strings.HasPrefix(path, "-")
+		if fileKey.Path == "" {
+			return fmt.Errorf("%w: empty key path", ErrSyntax)
+		}
+		f.Keys = append(f.Keys, fileKey)
+		return nil
+	}
+
+	if len(f.Keys) == 0 {
+		return fmt.Errorf("%w: value outside of a key", ErrSyntax)
+	}
+	fileVal, err := me.parseValue(line)
+	if err != nil {
+		return err
+	}
+	fileKey := &f.Keys[len(f.Keys)-1]
+	fileKey.Values = append(fileKey.Values, fileVal)
+	return nil
+}
+
+// Parses a "name"=data line.
+func (me *_FileParser) parseValue(line string) (FileValue, error) {
+	var fileVal FileValue
+	var rest string
+
+	if strings.HasPrefix(line, "@") {
+		rest = line[1:]
+	} else if strings.HasPrefix(line, `"`) {
+		name, n, err := unquoteRegString(line)
+		if err != nil {
+			return FileValue{}, err
+		}
+		fileVal.Name, rest = name, line[n:]
+	} else {
+		return FileValue{}, fmt.Errorf("%w: invalid value %q", ErrSyntax, line)
+	}
+
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "=") {
+		return FileValue{}, fmt.Errorf("%w: missing \"=\" in %q", ErrSyntax, line)
+	}
+	rest = strings.TrimSpace(rest[1:])
+
+	switch {
+	case rest == "-":
+		fileVal.Delete = true
+
+	case strings.HasPrefix(rest, `"`):
+		str, n, err := unquoteRegString(rest)
+		if err != nil {
+			return FileValue{}, err
+		} else if strings.TrimSpace(rest[n:]) != "" {
+			return FileValue{}, fmt.Errorf("%w: unexpected text after string %q", ErrSyntax, rest)
+		}
+		fileVal.Value = Value{REG_SZ, encodeSz(str)}
+
+	case strings.HasPrefix(strings.ToLower(rest), "dword:"):
+		digits := rest[len("dword:"):]
+		n, err := strconv.ParseUint(digits, 16, 32)
+		if err != nil || len(digits) > 8 {
+			return FileValue{}, fmt.Errorf("%w: invalid dword %q", ErrSyntax, digits)
+		}
+		fileVal.Value = Value{REG_DWORD, binary.LittleEndian.AppendUint32(nil, uint32(n))}
+
+	case strings.HasPrefix(strings.ToLower(rest), "hex"):
+		val, err := me.parseHex(rest[len("hex"):])
+		if err != nil {
+			return FileValue{}, err
+		}
+		fileVal.Value = val
+
+	default:
+		return FileValue{}, fmt.Errorf("%w: invalid data %q", ErrSyntax, rest)
+	}
+	return fileVal, nil
+}
+
+// Parses the data after "hex", which is either ":bytes" or "(type):bytes".
+func (me *_FileParser) parseHex(s string) (Value, error) {
+	typ := REG_BINARY
+	if strings.HasPrefix(s, "(") {
+		end := strings.IndexByte(s, ')')
+		if end == -1 {
+			return Value{}, fmt.Errorf("%w: invalid hex type %q", ErrSyntax, s)
+		}
+		n, err := strconv.ParseUint(s[1:end], 16, 32)
+		if err != nil {
+			return Value{}, fmt.Errorf("%w: invalid hex type %q", ErrSyntax, s[1:end])
+		}
+		typ, s = REG(n), s[end+1:]
+	}
+	if !strings.HasPrefix(s, ":") {
+		return Value{}, fmt.Errorf("%w: missing \":\" after hex", ErrSyntax)
+	}
+
+	data := make([]byte, 0)
+	if s = strings.TrimSpace(s[1:]); s != "" {
+		for _, item := range strings.Split(s, ",") {
+			b, err := strconv.ParseUint(strings.TrimSpace(item), 16, 8)
+			if err != nil {
+				return Value{}, fmt.Errorf("%w: invalid hex byte %q", ErrSyntax, item)
+			}
+			data = append(data, byte(b))
+		}
+	}
+
+	if me.regedit4 && (typ == REG_EXPAND_SZ || typ == REG_MULTI_SZ) {
+		str16 := make([]uint16, len(data)) // 8-bit chars, taken as Latin-1
+		for i, b := range data {
+			str16[i] = uint16(b)
+		}
+		data = encodeUtf16(str16)
+	}
+	return Value{typ, data}, nil
+}
+
+// Parses a quoted string starting at s[0], returning it unescaped, and the
+// number of bytes consumed, including the quotes.
+func unquoteRegString(s string) (string, int, error) {
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '\\' || s[i+1] == '"') {
+				i++
+			}
+		}
+		sb.WriteByte(s[i])
+	}
+	return "", 0, fmt.Errorf("%w: unterminated string %q", ErrSyntax, s)
+}
+
+// Implements [io.WriterTo], writing the contents in UTF-16LE with BOM and
+// "\r\n" line endings, like regedit does.
+func (me *File) WriteTo(w io.Writer) (int64, error) {
+	cw := &_CountWriter{w: w}
+	u16 := wstr.NewUtf16Writer(cw, wstr.Utf16LE, true, wstr.LoneReplace)
+	if _, err := io.WriteString(u16, me.String()); err != nil {
+		return cw.n, fmt.Errorf("File.WriteTo: %w", err)
+	}
+	if err := u16.Flush(); err != nil {
+		return cw.n, fmt.Errorf("File.WriteTo: %w", err)
+	}
+	return cw.n, nil
+}
+
+type _CountWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (me *_CountWriter) Write(p []byte) (int, error) {
+	n, err := me.w.Write(p)
+	me.n += int64(n)
+	return n, err
+}
+
+// Returns the contents of the file as text, with "\r\n" line endings.
+//
+// REG_SZ values are written as strings, REG_DWORD values as "dword:", and
+// the other types as comma-separated hex bytes, wrapped at 80 columns.
+func (me *File) String() string {
+	var sb strings.Builder
+	sb.WriteString(fileHeader5 + "\r\n\r\n")
+
+	for _, fileKey := range me.Keys {
+		if fileKey.Delete {
+			sb.WriteString("[-" + fileKey.Path + "]\r\n")
+		} else {
+			sb.WriteString("[" + fileKey.Path + "]\r\n")
+		}
+		for _, fileVal := range fileKey.Values {
+			writeFileValue(&sb, fileVal)
+		}
+		sb.WriteString("\r\n")
+	}
+	return sb.String()
+}
+
+func writeFileValue(sb *strings.Builder, fileVal FileValue) {
+	name := "@="
+	if fileVal.Name != "" {
+		name = quoteRegString(fileVal.Name) + "="
+	}
+	sb.WriteString(name)
+
+	val := fileVal.Value
+	switch {
+	case fileVal.Delete:
+		sb.WriteString("-")
+	case val.Type == REG_SZ && isPlainSz(val.Data):
+		sb.WriteString(quoteRegString(decodeSz(val.Data)))
+	case val.Type == REG_DWORD && len(val.Data) == 4:
+		fmt.Fprintf(sb, "dword:%08x", binary.LittleEndian.Uint32(val.Data))
+	default:
+		writeFileHex(sb, val, len(utf16.Encode([]rune(name))))
+	}
+	sb.WriteString("\r\n")
+}
+
+// Tells whether the REG_SZ data can be written as a quoted string, and read
+// back unchanged.
+func isPlainSz(data []byte) bool {
+	str := decodeSz(data)
+	return !strings.ContainsAny(str, "\r\n") && bytes.Equal(encodeSz(str), data)
+}
+
+func quoteRegString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+// Writes the data as hex bytes, breaking the line after the comma which
+// reaches the 77th column, as regedit does.
+func writeFileHex(sb *strings.Builder, val Value, lineLen int) {
+	prefix := "hex:"
+	if val.Type != REG_BINARY {
+		prefix = fmt.Sprintf("hex(%x):", uint32(val.Type))
+	}
+	sb.WriteString(prefix)
+	lineLen += len(prefix)
+
+	for i, b := range val.Data {
+		fmt.Fprintf(sb, "%02x", b)
+		if i == len(val.Data)-1 {
+			break
+		}
+		sb.WriteByte(',')
+		lineLen += 3
+		if lineLen >= 77 {
+			sb.WriteString("\\\r\n  ")
+			lineLen = 2
+		}
+	}
+}
@@ -0,0 +1,529 @@
+package reg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// Returned when a hive file or a transaction log is malformed.
+var ErrHive = errors.New("invalid hive")
+
+// Returned when a key or a value doesn't exist in a hive.
+var ErrNotFound = errors.New("not found")
+
+const (
+	hiveBaseSize    = 4096  // base block at the beginning of the primary file
+	hiveBigDataSize = 16344 // values larger than this are split in segments
+	hiveNoOffset    = 0xffff_ffff
+	hiveMaxDepth    = 512 // protection against cycles in corrupted hives
+)
+
+// A registry hive file in the regf format, like NTUSER.DAT or SOFTWARE, read
+// in pure Go, without calling the OS. It can be used on any platform.
+//
+// Values are read as [Value], and whole subtrees as [Key], the same types
+// used by the win package when reading the live registry, so the same code can
+// analyze both.
+//
+// Example:
+//
+//	hive, _ := reg.LoadHive("/cases/42/NTUSER.DAT")
+//	key, _ := hive.Open("Software\\Microsoft\\Windows\\CurrentVersion\\Run")
+//
+//	names, _ := key.ValueNames()
+//	for _, name := range names {
+//		val, _ := key.Value(name)
+//		println(name, val.String())
+//	}
+type Hive struct {
+	data        []byte // hive bins data, which follows the base block
+	root        uint32 // offset of the root key cell
+	minor       uint32 // minor format version
+	lastWritten time.Time
+	dirty       bool // primary file was not completely written
+	recovered   bool // transaction log entries were applied
+}
+
+// Reads a hive file and, if they exist, its transaction logs, which have the
+// same name with .LOG1, .LOG2 or .LOG extensions, as written by Windows.
+//
+// Example:
+//
+//	hive, _ := reg.LoadHive("C:\\Temp\\NTUSER.DAT")
+func LoadHive(path string) (*Hive, error) {
+	primary, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("LoadHive: %w", err)
+	}
+
+	var logs [][]byte
+	for _, ext := range []string{".LOG1", ".LOG2", ".LOG"} {
+		log, err := os.ReadFile(path + ext)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("LoadHive: %w", err)
+		}
+		logs = append(logs, log)
+	}
+
+	me, err := ParseHive(primary, logs...)
+	if err != nil {
+		return nil, fmt.Errorf("LoadHive: %w", err)
+	}
+	return me, nil
+}
+
+// Parses the contents of a hive file, applying the entries of the transaction
+// logs which were not yet written to the primary file. Both the old log format
+// (dirty vector) and the new one, used since Windows 8.1, are supported.
+//
+// A primary file which was not completely written, and cannot be recovered
+// from the logs, is still parsed, and [Hive.Dirty] returns true.
+func ParseHive(primary []byte, logs ...[]byte) (*Hive, error) {
+	base, err := parseHiveBase(primary)
+	if err != nil {
+		return nil, fmt.Errorf("ParseHive: %w", err)
+	} else if len(primary) < hiveBaseSize {
+		return nil, fmt.Errorf("ParseHive: %w: truncated base block", ErrHive)
+	}
+	if base.fileType != 0 {
+		return nil, fmt.Errorf("ParseHive: %w: not a primary file, type %d", ErrHive, base.fileType)
+	}
+
+	bins := primary[hiveBaseSize:]
+	if int(base.binsSize) < len(bins) {
+		bins = bins[:base.binsSize]
+	}
+	bins = append([]byte{}, bins...) // logs will change it
+
+	me := &Hive{
+		root:        base.root,
+		minor:       base.minor,
+		lastWritten: filetimeToTime(base.timestamp),
+		dirty:       !base.valid || base.seq1 != base.seq2,
+	}
+
+	if rec, ok := replayHiveLogs(bins, base, me.dirty, logs); ok {
+		bins, me.recovered, me.dirty = rec, true, false
+	} else if !base.valid {
+		return nil, fmt.Errorf("ParseHive: %w: bad base block checksum", ErrHive)
+	}
+	me.data = bins
+
+	if _, err := me.newKey(me.root); err != nil {
+		return nil, fmt.Errorf("ParseHive: root key: %w", err)
+	}
+	return me, nil
+}
+
+// Tells whether the primary file was not completely written, and the
+// transaction logs couldn't recover it. The data may be inconsistent.
+func (me *Hive) Dirty() bool {
+	return me.dirty
+}
+
+// Tells whether entries of the transaction logs were applied.
+func (me *Hive) Recovered() bool {
+	return me.recovered
+}
+
+// Returns the last time the hive was written, from its base block.
+func (me *Hive) LastWritten() time.Time {
+	return me.lastWritten
+}
+
+// Returns the root key of the hive.
+func (me *Hive) Root() *HiveKey {
+	key, _ := me.newKey(me.root) // validated by ParseHive
+	return key
+}
+
+// Returns the key with the given path, relative to the root key, like
+// "Software\Microsoft". Names are case-insensitive.
+func (me *Hive) Open(path string) (*HiveKey, error) {
+	key, err := me.Root().Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Hive.Open: %w", err)
+	}
+	return key, nil
+}
+
+// Returns the data of the cell at the given offset, without the size field.
+func (me *Hive) cell(offset uint32) ([]byte, error) {
+	pos := int64(offset)
+	if offset == hiveNoOffset || pos+4 > int64(len(me.data)) {
+		return nil, fmt.Errorf("%w: cell offset 0x%x out of bounds", ErrHive, offset)
+	}
+	size := int64(int32(binary.LittleEndian.Uint32(me.data[pos:])))
+	if size < 0 {
+		size = -size // allocated cell
+	}
+	if size < 4 || pos+size > int64(len(me.data)) {
+		return nil, fmt.Errorf("%w: cell at 0x%x has invalid size %d", ErrHive, offset, size)
+	}
+	return me.data[pos+4 : pos+size], nil
+}
+
+// A key of a [Hive].
+type HiveKey struct {
+	hive  *Hive
+	nk    []byte // key node cell
+	name  string
+	class string
+}
+
+// Parses the key node cell at the given offset.
+func (me *Hive) newKey(offset uint32) (*HiveKey, error) {
+	nk, err := me.cell(offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(nk) < 76 || string(nk[:2]) != "nk" {
+		return nil, fmt.Errorf("%w: no key node at 0x%x", ErrHive, offset)
+	}
+
+	flags := binary.LittleEndian.Uint16(nk[2:])
+	nameLen := int(binary.LittleEndian.Uint16(nk[72:]))
+	if 76+nameLen > len(nk) {
+		return nil, fmt.Errorf("%w: key name at 0x%x out of bounds", ErrHive, offset)
+	}
+	key := &HiveKey{
+		hive: me,
+		nk:   nk,
+		name: decodeHiveName(nk[76:76+nameLen], flags&0x0020 != 0), // KEY_COMP_NAME
+	}
+
+	classOff := binary.LittleEndian.Uint32(nk[48:])
+	classLen := int(binary.LittleEndian.Uint16(nk[74:]))
+	if classOff != hiveNoOffset && classLen > 0 {
+		class, err := me.cell(classOff)
+		if err != nil || classLen > len(class) {
+			return nil, fmt.Errorf("%w: class name of key at 0x%x out of bounds", ErrHive, offset)
+		}
+		key.class = string(utf16.Decode(decodeUtf16(class[:classLen])))
+	}
+	return key, nil
+}
+
+// Decodes a key or value name, stored either as Latin-1 or as UTF-16LE.
+func decodeHiveName(data []byte, compressed bool) string {
+	if !compressed {
+		return string(utf16.Decode(decodeUtf16(data)))
+	}
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+// Returns the key name. The root key usually has a name generated by Windows,
+// like "CsiTool-CreateHive-{00000000-0000-0000-0000-000000000000}".
+func (me *HiveKey) Name() string {
+	return me.name
+}
+
+// Returns the class name of the key, usually empty.
+func (me *HiveKey) Class() string {
+	return me.class
+}
+
+// Returns the last time the key was written.
+func (me *HiveKey) LastWritten() time.Time {
+	return filetimeToTime(binary.LittleEndian.Uint64(me.nk[4:]))
+}
+
+// Returns the subkeys, in the order stored in the hive, which is sorted by
+// uppercase name.
+func (me *HiveKey) Subkeys() ([]*HiveKey, error) {
+	count := binary.LittleEndian.Uint32(me.nk[20:])
+	listOff := binary.LittleEndian.Uint32(me.nk[28:])
+	if count == 0 || listOff == hiveNoOffset {
+		return []*HiveKey{}, nil
+	}
+
+	offsets, err := me.hive.subkeyOffsets(listOff, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("HiveKey.Subkeys: key %s: %w", me.name, err)
+	}
+	keys := make([]*HiveKey, 0, len(offsets))
+	for _, offset := range offsets {
+		key, err := me.hive.newKey(offset)
+		if err != nil {
+			return nil, fmt.Errorf("HiveKey.Subkeys: key %s: %w", me.name, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Reads a subkey list, which may be an index of other lists.
+func (me *Hive) subkeyOffsets(listOff uint32, offsets []uint32, depth int) ([]uint32, error) {
+	list, err := me.cell(listOff)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) < 4 {
+		return nil, fmt.Errorf("%w: subkey list at 0x%x too small", ErrHive, listOff)
+	}
+	sig, count := string(list[:2]), int(binary.LittleEndian.Uint16(list[2:]))
+
+	stride := 4
+	switch sig {
+	case "lf", "lh": // offset followed by a name hint or hash
+		stride = 8
+	case "li":
+	case "ri": // index of lists
+		if depth > 0 {
+			return nil, fmt.Errorf("%w: nested subkey index at 0x%x", ErrHive, listOff)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown subkey list %q at 0x%x", ErrHive, sig, listOff)
+	}
+	if 4+count*stride > len(list) {
+		return nil, fmt.Errorf("%w: subkey list at 0x%x out of bounds", ErrHive, listOff)
+	}
+
+	for i := 0; i < count; i++ {
+		offset := binary.LittleEndian.Uint32(list[4+i*stride:])
+		if sig == "ri" {
+			if offsets, err = me.subkeyOffsets(offset, offsets, depth+1); err != nil {
+				return nil, err
+			}
+		} else {
+			offsets = append(offsets, offset)
+		}
+	}
+	return offsets, nil
+}
+
+// Returns the subkey with the given name, case-insensitive, or an error
+// wrapping [ErrNotFound].
+func (me *HiveKey) Subkey(name string) (*HiveKey, error) {
+	keys, err := me.Subkeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if strings.EqualFold(key.name, name) {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("HiveKey.Subkey: %w: %s\\%s", ErrNotFound, me.name, name)
+}
+
+// Returns the key with the given path, relative to this key, like
+// "Software\Microsoft". Names are case-insensitive. An empty path returns the
+// key itself.
+func (me *HiveKey) Open(path string) (*HiveKey, error) {
+	key := me
+	for _, name := range strings.Split(path, "\\") {
+		if name == "" {
+			continue
+		}
+		var err error
+		if key, err = key.Subkey(name); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Returns the names of the values, in the order stored in the hive. The
+// default value has an empty name.
+func (me *HiveKey) ValueNames() ([]string, error) {
+	vks, err := me.valueCells()
+	if err != nil {
+		return nil, fmt.Errorf("HiveKey.ValueNames: key %s: %w", me.name, err)
+	}
+	names := make([]string, 0, len(vks))
+	for _, vk := range vks {
+		names = append(names, vk.name)
+	}
+	return names, nil
+}
+
+// Returns the value with the given name, case-insensitive, or an error
+// wrapping [ErrNotFound]. An empty name returns the default value.
+func (me *HiveKey) Value(name string) (Value, error) {
+	vks, err := me.valueCells()
+	if err != nil {
+		return Value{}, fmt.Errorf("HiveKey.Value: key %s: %w", me.name, err)
+	}
+	for _, vk := range vks {
+		if strings.EqualFold(vk.name, name) {
+			val, err := me.hive.valueData(vk)
+			if err != nil {
+				return Value{}, fmt.Errorf("HiveKey.Value: key %s: value %q: %w", me.name, name, err)
+			}
+			return val, nil
+		}
+	}
+	return Value{}, fmt.Errorf("HiveKey.Value: %w: %s\\%s", ErrNotFound, me.name, name)
+}
+
+// Reads all values and subkeys, recursively, into a [Key], which can be
+// decoded with [DecodeStruct] or written with [FileFromKey].
+//
+// Example:
+//
+//	hive, _ := reg.LoadHive("/cases/42/NTUSER.DAT")
+//	hkey, _ := hive.Open("Software\\MyApp")
+//	key, _ := hkey.Key()
+//
+//	var settings Settings
+//	_ = reg.DecodeStruct(key, &settings)
+func (me *HiveKey) Key() (*Key, error) {
+	key, err := me.readKey(0)
+	if err != nil {
+		return nil, fmt.Errorf("HiveKey.Key: %w", err)
+	}
+	return key, nil
+}
+
+func (me *HiveKey) readKey(depth int) (*Key, error) {
+	if depth > hiveMaxDepth {
+		return nil, fmt.Errorf("%w: key %s nested too deep", ErrHive, me.name)
+	}
+	key := NewKey()
+
+	vks, err := me.valueCells()
+	if err != nil {
+		return nil, fmt.Errorf("key %s: %w", me.name, err)
+	}
+	for _, vk := range vks {
+		if key.Values[vk.name], err = me.hive.valueData(vk); err != nil {
+			return nil, fmt.Errorf("key %s: value %q: %w", me.name, vk.name, err)
+		}
+	}
+
+	subs, err := me.Subkeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		if key.Subkeys[sub.name], err = sub.readKey(depth + 1); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// A parsed value cell.
+type _HiveVk struct {
+	name     string
+	typ      REG
+	dataSize uint32
+	dataOff  uint32
+}
+
+func (me *HiveKey) valueCells() ([]_HiveVk, error) {
+	count := int(binary.LittleEndian.Uint32(me.nk[36:]))
+	listOff := binary.LittleEndian.Uint32(me.nk[40:])
+	if count == 0 || listOff == hiveNoOffset {
+		return []_HiveVk{}, nil
+	}
+
+	list, err := me.hive.cell(listOff)
+	if err != nil {
+		return nil, err
+	}
+	if count*4 > len(list) {
+		return nil, fmt.Errorf("%w: value list at 0x%x out of bounds", ErrHive, listOff)
+	}
+
+	vks := make([]_HiveVk, 0, count)
+	for i := 0; i < count; i++ {
+		offset := binary.LittleEndian.Uint32(list[i*4:])
+		vk, err := me.hive.cell(offset)
+		if err != nil {
+			return nil, err
+		}
+		if len(vk) < 20 || string(vk[:2]) != "vk" {
+			return nil, fmt.Errorf("%w: no value at 0x%x", ErrHive, offset)
+		}
+		nameLen := int(binary.LittleEndian.Uint16(vk[2:]))
+		if 20+nameLen > len(vk) {
+			return nil, fmt.Errorf("%w: value name at 0x%x out of bounds", ErrHive, offset)
+		}
+		vks = append(vks, _HiveVk{
+			name:     decodeHiveName(vk[20:20+nameLen], binary.LittleEndian.Uint16(vk[16:])&0x0001 != 0), // VALUE_COMP_NAME
+			typ:      REG(binary.LittleEndian.Uint32(vk[12:])),
+			dataSize: binary.LittleEndian.Uint32(vk[4:]),
+			dataOff:  binary.LittleEndian.Uint32(vk[8:]),
+		})
+	}
+	return vks, nil
+}
+
+// Reads the data of a value, which may be stored in the value cell itself, in
+// a data cell, or in big data segments.
+func (me *Hive) valueData(vk _HiveVk) (Value, error) {
+	size := int(vk.dataSize &^ 0x8000_0000)
+
+	if vk.dataSize&0x8000_0000 != 0 { // stored in the offset field
+		if size > 4 {
+			return Value{}, fmt.Errorf("%w: resident data with %d bytes", ErrHive, size)
+		}
+		data := binary.LittleEndian.AppendUint32(nil, vk.dataOff)
+		return Value{vk.typ, data[:size]}, nil
+	} else if size == 0 {
+		return Value{vk.typ, []byte{}}, nil
+	}
+
+	cell, err := me.cell(vk.dataOff)
+	if err != nil {
+		return Value{}, err
+	}
+
+	if size > hiveBigDataSize && me.minor >= 4 && len(cell) >= 8 && string(cell[:2]) == "db" {
+		count := int(binary.LittleEndian.Uint16(cell[2:]))
+		segs, err := me.cell(binary.LittleEndian.Uint32(cell[4:]))
+		if err != nil {
+			return Value{}, err
+		} else if count*4 > len(segs) {
+			return Value{}, fmt.Errorf("%w: big data segment list out of bounds", ErrHive)
+		}
+
+		data := make([]byte, 0, size)
+		for i := 0; i < count && len(data) < size; i++ {
+			seg, err := me.cell(binary.LittleEndian.Uint32(segs[i*4:]))
+			if err != nil {
+				return Value{}, err
+			}
+			n := size - len(data)
+			if n > hiveBigDataSize {
+				n = hiveBigDataSize
+			}
+			if n > len(seg) {
+				return Value{}, fmt.Errorf("%w: big data segment too small", ErrHive)
+			}
+			data = append(data, seg[:n]...)
+		}
+		if len(data) < size {
+			return Value{}, fmt.Errorf("%w: big data with %d of %d bytes", ErrHive, len(data), size)
+		}
+		return Value{vk.typ, data}, nil
+	}
+
+	if size > len(cell) {
+		return Value{}, fmt.Errorf("%w: data with %d bytes in a cell of %d", ErrHive, size, len(cell))
+	}
+	return Value{vk.typ, append([]byte{}, cell[:size]...)}, nil
+}
+
+// Converts a FILETIME, in 100-nanosecond intervals since 1601, to UTC time.
+func filetimeToTime(ft uint64) time.Time {
+	if ft == 0 {
+		return time.Time{}
+	}
+	since1970 := int64(ft) - 116_444_736_000_000_000
+	return time.Unix(since1970/10_000_000, since1970%10_000_000*100).UTC()
+}
@@ -0,0 +1,254 @@
+package reg
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// A registry key in memory, with its values and subkeys.
+//
+// As in the registry, names are case-insensitive when searched with
+// [Key.Value] and [Key.Subkey]. The default value of a key has an empty name.
+type Key struct {
+	Values  map[string]Value
+	Subkeys map[string]*Key
+}
+
+// Creates a new, empty [Key].
+func NewKey() *Key {
+	return &Key{
+		Values:  make(map[string]Value),
+		Subkeys: make(map[string]*Key),
+	}
+}
+
+// Returns the value with the given name, case-insensitive.
+func (me *Key) Value(name string) (Value, bool) {
+	if val, ok := me.Values[name]; ok {
+		return val, true
+	}
+	for valName, val := range me.Values {
+		if strings.EqualFold(valName, name) {
+			return val, true
+		}
+	}
+	return Value{}, false
+}
+
+// Returns the subkey with the given name, case-insensitive.
+func (me *Key) Subkey(name string) (*Key, bool) {
+	if sub, ok := me.Subkeys[name]; ok {
+		return sub, true
+	}
+	for subName, sub := range me.Subkeys {
+		if strings.EqualFold(subName, name) {
+			return sub, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the names of the values, sorted case-insensitive, as the registry
+// enumerates them.
+func (me *Key) ValueNames() []string {
+	return sortedNames(me.Values)
+}
+
+// Returns the names of the subkeys, sorted case-insensitive, as the registry
+// enumerates them.
+func (me *Key) SubkeyNames() []string {
+	return sortedNames(me.Subkeys)
+}
+
+func sortedNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(a, b int) bool {
+		return strings.ToUpper(names[a]) < strings.ToUpper(names[b])
+	})
+	return names
+}
+
+// Converts the exported fields of a struct, or pointer to struct, into a
+// [Key]. Each field is stored as a value, converted by [Encode], or by
+// [EncodeAs] if the tag has a type option. Nested structs are stored as
+// subkeys.
+//
+// The field tag has the value name – which defaults to the field name – and
+// options:
+//   - omitempty – the field is not stored if it has the zero value;
+//   - sz, expand_sz, link, multi_sz, binary, dword, dword_big_endian, qword –
+//     the registry type.
+//
+// A field with the tag "-" is ignored.
+//
+// Example:
+//
+//	type Window struct {
+//		Left, Top int32
+//	}
+//	type Settings struct {
+//		LastFile string   `reg:"Last File,omitempty"`
+//		Plugins  []string `reg:",multi_sz"`
+//		Main     Window   `reg:"Main Window"` // subkey
+//	}
+//
+//	key, _ := reg.EncodeStruct(Settings{LastFile: "a.txt"})
+func EncodeStruct(v any) (*Key, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("EncodeStruct: %w: expected struct, got %T", ErrType, v)
+	}
+
+	key := NewKey()
+	if err := encodeStruct(rv, key); err != nil {
+		return nil, fmt.Errorf("EncodeStruct: %w", err)
+	}
+	return key, nil
+}
+
+func encodeStruct(rv reflect.Value, key *Key) error {
+	var errs []error
+	for _, f := range structFields(rv.Type()) {
+		fv := rv.Field(f.index)
+		if f.err != nil {
+			errs = append(errs, f.err)
+		} else if f.omitEmpty && fv.IsZero() {
+			continue
+		} else if f.isSubkey {
+			sub := NewKey()
+			if err := encodeStruct(fv, sub); err != nil {
+				errs = append(errs, fmt.Errorf("subkey %s: %w", f.name, err))
+			}
+			key.Subkeys[f.name] = sub
+		} else {
+			typ := f.typ
+			if !f.hasTyp {
+				typ, _ = defaultType(fv.Type()) // validated by structFields
+			}
+			if val, err := encodeAs(fv, typ); err != nil {
+				errs = append(errs, fmt.Errorf("field %s: %w", f.goName, err))
+			} else {
+				key.Values[f.name] = val
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Copies the values of a [Key] into the exported fields of the struct pointed
+// to by pStruct, following the same rules of [EncodeStruct]. Fields without a
+// corresponding value or subkey are left untouched, so they can hold default
+// values.
+//
+// The type options of the tags are ignored, since [Decode] accepts all the
+// compatible registry types.
+//
+// Example:
+//
+//	type Settings struct {
+//		Retries uint32
+//	}
+//
+//	var key *reg.Key // initialized somewhere
+//
+//	settings := Settings{Retries: 3} // default
+//	_ = reg.DecodeStruct(key, &settings)
+func DecodeStruct(key *Key, pStruct any) error {
+	rv := reflect.ValueOf(pStruct)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("DecodeStruct: %w: expected pointer to struct, got %T", ErrType, pStruct)
+	}
+	if err := decodeStruct(key, rv.Elem()); err != nil {
+		return fmt.Errorf("DecodeStruct: %w", err)
+	}
+	return nil
+}
+
+func decodeStruct(key *Key, rv reflect.Value) error {
+	var errs []error
+	for _, f := range structFields(rv.Type()) {
+		if f.err != nil {
+			errs = append(errs, f.err)
+		} else if f.isSubkey {
+			if sub, ok := key.Subkey(f.name); ok {
+				if err := decodeStruct(sub, rv.Field(f.index)); err != nil {
+					errs = append(errs, fmt.Errorf("subkey %s: %w", f.name, err))
+				}
+			}
+		} else if val, ok := key.Value(f.name); ok {
+			if err := decodeInto(val, rv.Field(f.index)); err != nil {
+				errs = append(errs, fmt.Errorf("field %s: %w", f.goName, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// A struct field, with its tag parsed.
+type _Field struct {
+	index     int
+	goName    string
+	name      string // value or subkey name
+	typ       REG
+	hasTyp    bool
+	omitEmpty bool
+	isSubkey  bool
+	err       error // invalid tag or unsupported type
+}
+
+var tagTypes = map[string]REG{
+	"sz":               REG_SZ,
+	"expand_sz":        REG_EXPAND_SZ,
+	"link":             REG_LINK,
+	"multi_sz":         REG_MULTI_SZ,
+	"binary":           REG_BINARY,
+	"dword":            REG_DWORD,
+	"dword_big_endian": REG_DWORD_BIG_ENDIAN,
+	"qword":            REG_QWORD,
+}
+
+func structFields(t reflect.Type) []_Field {
+	fields := make([]_Field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("reg")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		f := _Field{index: i, goName: sf.Name, name: name}
+
+		for _, opt := range strings.Split(opts, ",") {
+			if opt == "" {
+				continue
+			} else if opt == "omitempty" {
+				f.omitEmpty = true
+			} else if typ, ok := tagTypes[opt]; ok {
+				f.typ, f.hasTyp = typ, true
+			} else {
+				f.err = fmt.Errorf("field %s: unknown option %q", sf.Name, opt)
+			}
+		}
+
+		if sf.Type.Kind() == reflect.Struct && sf.Type != valueType {
+			f.isSubkey = true
+		} else if _, err := defaultType(sf.Type); err != nil && f.err == nil {
+			f.err = fmt.Errorf("field %s: %w", sf.Name, err)
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
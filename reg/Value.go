@@ -0,0 +1,153 @@
+package reg
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// Registry value [types].
+//
+// [types]: https://learn.microsoft.com/en-us/windows/win32/sysinfo/registry-value-types
+type REG uint32
+
+const (
+	REG_NONE                       REG = 0
+	REG_SZ                         REG = 1
+	REG_EXPAND_SZ                  REG = 2
+	REG_BINARY                     REG = 3
+	REG_DWORD                      REG = 4
+	REG_DWORD_LITTLE_ENDIAN        REG = 4
+	REG_DWORD_BIG_ENDIAN           REG = 5
+	REG_LINK                       REG = 6
+	REG_MULTI_SZ                   REG = 7
+	REG_RESOURCE_LIST              REG = 8
+	REG_FULL_RESOURCE_DESCRIPTOR   REG = 9
+	REG_RESOURCE_REQUIREMENTS_LIST REG = 10
+	REG_QWORD                      REG = 11
+	REG_QWORD_LITTLE_ENDIAN        REG = 11
+)
+
+// Returns the name of the type, like "REG_SZ".
+func (t REG) String() string {
+	switch t {
+	case REG_NONE:
+		return "REG_NONE"
+	case REG_SZ:
+		return "REG_SZ"
+	case REG_EXPAND_SZ:
+		return "REG_EXPAND_SZ"
+	case REG_BINARY:
+		return "REG_BINARY"
+	case REG_DWORD:
+		return "REG_DWORD"
+	case REG_DWORD_BIG_ENDIAN:
+		return "REG_DWORD_BIG_ENDIAN"
+	case REG_LINK:
+		return "REG_LINK"
+	case REG_MULTI_SZ:
+		return "REG_MULTI_SZ"
+	case REG_RESOURCE_LIST:
+		return "REG_RESOURCE_LIST"
+	case REG_FULL_RESOURCE_DESCRIPTOR:
+		return "REG_FULL_RESOURCE_DESCRIPTOR"
+	case REG_RESOURCE_REQUIREMENTS_LIST:
+		return "REG_RESOURCE_REQUIREMENTS_LIST"
+	case REG_QWORD:
+		return "REG_QWORD"
+	default:
+		return fmt.Sprintf("REG(%d)", uint32(t))
+	}
+}
+
+// A registry value: its type and its raw data, as stored by the OS.
+//
+// Strings are stored as null-terminated UTF-16, and numbers in little-endian,
+// except for REG_DWORD_BIG_ENDIAN.
+type Value struct {
+	Type REG
+	Data []byte
+}
+
+// Returns the value formatted for debugging, like `REG_SZ "abc"`.
+func (v Value) String() string {
+	switch v.Type {
+	case REG_SZ, REG_EXPAND_SZ, REG_LINK:
+		return fmt.Sprintf("%s %q", v.Type, decodeSz(v.Data))
+	case REG_MULTI_SZ:
+		return fmt.Sprintf("%s %q", v.Type, decodeMultiSz(v.Data))
+	case REG_DWORD, REG_DWORD_BIG_ENDIAN, REG_QWORD:
+		if n, err := decodeUint(v); err == nil {
+			return fmt.Sprintf("%s %d", v.Type, n)
+		}
+	}
+	return fmt.Sprintf("%s % x", v.Type, v.Data)
+}
+
+// Encodes a string as null-terminated UTF-16LE.
+func encodeSz(s string) []byte {
+	return encodeUtf16(utf16.Encode([]rune(s + "\x00")))
+}
+
+// Encodes the strings as a sequence of null-terminated UTF-16LE strings,
+// followed by an additional null.
+func encodeMultiSz(strs []string) []byte {
+	var sb strings.Builder
+	for _, s := range strs {
+		sb.WriteString(s)
+		sb.WriteByte(0)
+	}
+	sb.WriteByte(0)
+	return encodeUtf16(utf16.Encode([]rune(sb.String())))
+}
+
+func encodeUtf16(str16 []uint16) []byte {
+	data := make([]byte, 0, len(str16)*2)
+	for _, ch := range str16 {
+		data = binary.LittleEndian.AppendUint16(data, ch)
+	}
+	return data
+}
+
+func decodeUtf16(data []byte) []uint16 {
+	str16 := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 { // an odd trailing byte is ignored
+		str16 = append(str16, binary.LittleEndian.Uint16(data[i:]))
+	}
+	return str16
+}
+
+// Decodes an UTF-16LE string, stopping at the first null, if any.
+func decodeSz(data []byte) string {
+	str16 := decodeUtf16(data)
+	for i, ch := range str16 {
+		if ch == 0 {
+			str16 = str16[:i]
+			break
+		}
+	}
+	return string(utf16.Decode(str16))
+}
+
+// Decodes a sequence of null-terminated UTF-16LE strings, stopping at an empty
+// string or at the end of the data.
+func decodeMultiSz(data []byte) []string {
+	strs := make([]string, 0)
+	str16 := decodeUtf16(data)
+	for len(str16) > 0 {
+		end := 0
+		for end < len(str16) && str16[end] != 0 {
+			end++
+		}
+		if end == 0 {
+			break // double null
+		}
+		strs = append(strs, string(utf16.Decode(str16[:end])))
+		if end == len(str16) {
+			break // missing terminating null
+		}
+		str16 = str16[end+1:]
+	}
+	return strs
+}
@@ -0,0 +1,312 @@
+package reg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Returned when a Go type cannot be converted to or from a registry type.
+var ErrType = errors.New("incompatible type")
+
+// Returned when a number doesn't fit the registry type, or the Go type.
+var ErrRange = errors.New("number out of range")
+
+// Returned when the data doesn't have the size required by its type.
+var ErrData = errors.New("invalid data size")
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	valueType    = reflect.TypeOf(Value{})
+)
+
+// Converts a Go value to a registry value, choosing the registry type from the
+// Go type:
+//   - string – REG_SZ;
+//   - []string – REG_MULTI_SZ;
+//   - []byte – REG_BINARY;
+//   - bool – REG_DWORD, 0 or 1;
+//   - int8, int16, int32, uint8, uint16, uint32 – REG_DWORD;
+//   - int, int64, uint, uint64 – REG_QWORD;
+//   - time.Duration – REG_QWORD, in milliseconds;
+//   - [Value] – returned as it is.
+//
+// Named types with these underlying types are also accepted. Signed numbers
+// are stored in two's complement.
+//
+// Example:
+//
+//	val, _ := reg.Encode([]string{"a", "b"})
+//	println(val.Type.String()) // REG_MULTI_SZ
+func Encode(v any) (Value, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return Value{}, fmt.Errorf("Encode: %w: nil", ErrType)
+	}
+	typ, err := defaultType(rv.Type())
+	if err != nil {
+		return Value{}, fmt.Errorf("Encode: %w", err)
+	}
+	val, err := encodeAs(rv, typ)
+	if err != nil {
+		return Value{}, fmt.Errorf("Encode: %w", err)
+	}
+	return val, nil
+}
+
+// Converts a Go value to a registry value of the given type. The accepted
+// conversions are:
+//   - string – REG_SZ, REG_EXPAND_SZ, REG_LINK or REG_MULTI_SZ;
+//   - []string – REG_MULTI_SZ;
+//   - []byte – any type, the bytes are the raw data;
+//   - numbers, bool and time.Duration – REG_DWORD, REG_DWORD_BIG_ENDIAN or
+//     REG_QWORD, as long as the number fits.
+//
+// Example:
+//
+//	val, _ := reg.EncodeAs("%TEMP%", reg.REG_EXPAND_SZ)
+func EncodeAs(v any, typ REG) (Value, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return Value{}, fmt.Errorf("EncodeAs: %w: nil", ErrType)
+	}
+	val, err := encodeAs(rv, typ)
+	if err != nil {
+		return Value{}, fmt.Errorf("EncodeAs: %w", err)
+	}
+	return val, nil
+}
+
+// Returns the registry type used by default for the Go type.
+func defaultType(t reflect.Type) (REG, error) {
+	switch {
+	case t == valueType:
+		return REG_NONE, nil // ignored
+	case t == durationType:
+		return REG_QWORD, nil
+	case isBytes(t):
+		return REG_BINARY, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return REG_SZ, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.String {
+			return REG_MULTI_SZ, nil
+		}
+	case reflect.Bool,
+		reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return REG_DWORD, nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return REG_QWORD, nil
+	}
+	return REG_NONE, fmt.Errorf("%w: %s not supported", ErrType, t)
+}
+
+func encodeAs(rv reflect.Value, typ REG) (Value, error) {
+	t := rv.Type()
+	mismatch := fmt.Errorf("%w: cannot encode %s as %s", ErrType, t, typ)
+
+	switch {
+	case t == valueType:
+		return rv.Interface().(Value), nil
+	case isBytes(t):
+		return Value{typ, append([]byte{}, rv.Bytes()...)}, nil
+	case t.Kind() == reflect.String:
+		switch typ {
+		case REG_SZ, REG_EXPAND_SZ:
+			return Value{typ, encodeSz(rv.String())}, nil
+		case REG_LINK: // not null-terminated
+			data := encodeSz(rv.String())
+			return Value{typ, data[:len(data)-2]}, nil
+		case REG_MULTI_SZ:
+			return Value{typ, encodeMultiSz([]string{rv.String()})}, nil
+		}
+		return Value{}, mismatch
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		if typ != REG_MULTI_SZ {
+			return Value{}, mismatch
+		}
+		strs := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			strs = append(strs, rv.Index(i).String())
+		}
+		return Value{typ, encodeMultiSz(strs)}, nil
+	}
+
+	var n uint64        // raw bits
+	var isNegative bool // sign of n, if signed
+	switch {
+	case t == durationType:
+		ms := rv.Int() / int64(time.Millisecond)
+		n, isNegative = uint64(ms), ms < 0
+	case t.Kind() == reflect.Bool:
+		if rv.Bool() {
+			n = 1
+		}
+	case isInt(t.Kind()):
+		n, isNegative = uint64(rv.Int()), rv.Int() < 0
+	case isUint(t.Kind()):
+		n = rv.Uint()
+	default:
+		return Value{}, mismatch
+	}
+
+	switch typ {
+	case REG_DWORD, REG_DWORD_BIG_ENDIAN:
+		if (!isNegative && n > math.MaxUint32) ||
+			(isNegative && int64(n) < math.MinInt32) {
+			return Value{}, fmt.Errorf("%w: %s", ErrRange, typ)
+		}
+		data := make([]byte, 4)
+		if typ == REG_DWORD {
+			binary.LittleEndian.PutUint32(data, uint32(n))
+		} else {
+			binary.BigEndian.PutUint32(data, uint32(n))
+		}
+		return Value{typ, data}, nil
+	case REG_QWORD:
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, n)
+		return Value{typ, data}, nil
+	}
+	return Value{}, mismatch
+}
+
+// Converts a registry value to the Go value pointed to by ptr. The accepted
+// conversions are:
+//   - *string – REG_SZ, REG_EXPAND_SZ (not expanded) and REG_LINK;
+//   - *[]string – REG_MULTI_SZ, REG_SZ and REG_EXPAND_SZ;
+//   - *[]byte – any type, the raw data is copied;
+//   - numbers and *bool – REG_DWORD, REG_DWORD_BIG_ENDIAN and REG_QWORD, as
+//     long as the number fits; signed numbers are read in two's complement;
+//   - *time.Duration – same as numbers, in milliseconds;
+//   - *[Value] – copied as it is.
+//
+// Example:
+//
+//	val, _ := reg.Encode(uint32(300))
+//
+//	var n uint8
+//	err := reg.Decode(val, &n) // reg.ErrRange
+func Decode(val Value, ptr any) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("Decode: %w: expected pointer, got %T", ErrType, ptr)
+	}
+	if err := decodeInto(val, rv.Elem()); err != nil {
+		return fmt.Errorf("Decode: %w", err)
+	}
+	return nil
+}
+
+func decodeInto(val Value, rv reflect.Value) error {
+	t := rv.Type()
+	mismatch := fmt.Errorf("%w: cannot decode %s into %s", ErrType, val.Type, t)
+
+	switch {
+	case t == valueType:
+		rv.Set(reflect.ValueOf(Value{val.Type, append([]byte{}, val.Data...)}))
+		return nil
+	case isBytes(t):
+		rv.SetBytes(append([]byte{}, val.Data...))
+		return nil
+	case t.Kind() == reflect.String:
+		switch val.Type {
+		case REG_SZ, REG_EXPAND_SZ, REG_LINK:
+			rv.SetString(decodeSz(val.Data))
+			return nil
+		}
+		return mismatch
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		var strs []string
+		switch val.Type {
+		case REG_MULTI_SZ:
+			strs = decodeMultiSz(val.Data)
+		case REG_SZ, REG_EXPAND_SZ:
+			strs = []string{decodeSz(val.Data)}
+		default:
+			return mismatch
+		}
+		slice := reflect.MakeSlice(t, len(strs), len(strs))
+		for i, s := range strs {
+			slice.Index(i).SetString(s)
+		}
+		rv.Set(slice)
+		return nil
+	}
+
+	if t != durationType && t.Kind() != reflect.Bool && !isInt(t.Kind()) && !isUint(t.Kind()) {
+		return mismatch
+	} else if val.Type != REG_DWORD && val.Type != REG_DWORD_BIG_ENDIAN && val.Type != REG_QWORD {
+		return mismatch
+	}
+
+	n, err := decodeUint(val)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case t.Kind() == reflect.Bool:
+		rv.SetBool(n != 0)
+	case isInt(t.Kind()):
+		signed := int64(n) // QWORD
+		if val.Type != REG_QWORD {
+			signed = int64(int32(uint32(n)))
+		}
+		if t == durationType {
+			if signed > math.MaxInt64/int64(time.Millisecond) ||
+				signed < math.MinInt64/int64(time.Millisecond) {
+				return fmt.Errorf("%w: %s", ErrRange, t)
+			}
+			signed *= int64(time.Millisecond)
+		} else if rv.OverflowInt(signed) {
+			return fmt.Errorf("%w: %d for %s", ErrRange, signed, t)
+		}
+		rv.SetInt(signed)
+	default:
+		if rv.OverflowUint(n) {
+			return fmt.Errorf("%w: %d for %s", ErrRange, n, t)
+		}
+		rv.SetUint(n)
+	}
+	return nil
+}
+
+// Reads the number of a REG_DWORD, REG_DWORD_BIG_ENDIAN or REG_QWORD value.
+func decodeUint(val Value) (uint64, error) {
+	switch val.Type {
+	case REG_DWORD, REG_DWORD_BIG_ENDIAN:
+		if len(val.Data) != 4 {
+			return 0, fmt.Errorf("%w: %s with %d bytes", ErrData, val.Type, len(val.Data))
+		}
+		if val.Type == REG_DWORD {
+			return uint64(binary.LittleEndian.Uint32(val.Data)), nil
+		}
+		return uint64(binary.BigEndian.Uint32(val.Data)), nil
+	case REG_QWORD:
+		if len(val.Data) != 8 {
+			return 0, fmt.Errorf("%w: %s with %d bytes", ErrData, val.Type, len(val.Data))
+		}
+		return binary.LittleEndian.Uint64(val.Data), nil
+	}
+	return 0, fmt.Errorf("%w: %s is not a number", ErrType, val.Type)
+}
+
+func isBytes(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+func isInt(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isUint(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uint64
+}
@@ -0,0 +1,224 @@
+package reg
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// Fields of the base block, which starts both primary files and transaction
+// logs.
+type _HiveBase struct {
+	seq1, seq2 uint32 // primary and secondary sequence numbers
+	timestamp  uint64
+	minor      uint32
+	fileType   uint32 // 0 for primary files
+	root       uint32
+	binsSize   uint32
+	valid      bool // checksum matches
+}
+
+func parseHiveBase(data []byte) (_HiveBase, error) {
+	if len(data) < 512 || string(data[:4]) != "regf" {
+		return _HiveBase{}, fmt.Errorf("%w: missing regf signature", ErrHive)
+	}
+	base := _HiveBase{
+		seq1:      binary.LittleEndian.Uint32(data[4:]),
+		seq2:      binary.LittleEndian.Uint32(data[8:]),
+		timestamp: binary.LittleEndian.Uint64(data[12:]),
+		minor:     binary.LittleEndian.Uint32(data[24:]),
+		fileType:  binary.LittleEndian.Uint32(data[28:]),
+		root:      binary.LittleEndian.Uint32(data[36:]),
+		binsSize:  binary.LittleEndian.Uint32(data[40:]),
+	}
+	if major := binary.LittleEndian.Uint32(data[20:]); major != 1 {
+		return _HiveBase{}, fmt.Errorf("%w: unsupported version %d.%d", ErrHive, major, base.minor)
+	}
+	base.valid = hiveChecksum(data) == binary.LittleEndian.Uint32(data[508:])
+	return base, nil
+}
+
+// XOR of the first 127 dwords of the base block.
+func hiveChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < 508; i += 4 {
+		sum ^= binary.LittleEndian.Uint32(data[i:])
+	}
+	switch sum {
+	case 0:
+		return 1
+	case 0xffff_ffff:
+		return 0xffff_fffe
+	}
+	return sum
+}
+
+// A dirty page of a transaction log, to be written at the offset of the hive
+// bins data.
+type _HivePage struct {
+	offset uint32
+	data   []byte
+}
+
+// An entry of a transaction log in the new format.
+type _HiveLogEntry struct {
+	seq      uint32
+	binsSize uint32
+	pages    []_HivePage
+}
+
+// Applies the transaction logs to the hive bins data, returning the new data
+// and whether anything was applied.
+//
+// Entries of logs in the new format are applied in sequence, starting from the
+// secondary sequence number of the primary file, since they may hold changes
+// not yet written even if the primary file is not dirty. Logs in the old format
+// are applied only to dirty primary files.
+func replayHiveLogs(bins []byte, base _HiveBase, dirty bool, logs [][]byte) ([]byte, bool) {
+	var entries []_HiveLogEntry
+	applied := false
+
+	for _, log := range logs {
+		logBase, err := parseHiveBase(log)
+		if err != nil || !logBase.valid || len(log) < 1024 {
+			continue
+		}
+		switch string(log[512:516]) {
+		case "DIRT": // old format
+			if dirty && logBase.seq1 == logBase.seq2 {
+				bins = applyHivePages(bins, logBase.binsSize, parseHiveDirtyVector(log, logBase.binsSize))
+				applied = true
+			}
+		case "HvLE":
+			entries = append(entries, parseHiveLogEntries(log)...)
+		}
+	}
+
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].seq < entries[b].seq
+	})
+	expected := base.seq2
+	for _, entry := range entries {
+		if entry.seq < expected {
+			continue // already in the primary file, or duplicated in the other log
+		} else if entry.seq != expected {
+			break // missing entry
+		}
+		bins = applyHivePages(bins, entry.binsSize, entry.pages)
+		applied = true
+		expected++
+	}
+
+	return bins, applied
+}
+
+// Writes the pages into the hive bins data, which is resized to binsSize.
+func applyHivePages(bins []byte, binsSize uint32, pages []_HivePage) []byte {
+	if int(binsSize) > len(bins) {
+		bins = append(bins, make([]byte, int(binsSize)-len(bins))...)
+	}
+	bins = bins[:binsSize]
+	for _, page := range pages {
+		if int64(page.offset)+int64(len(page.data)) <= int64(len(bins)) {
+			copy(bins[page.offset:], page.data)
+		}
+	}
+	return bins
+}
+
+// Parses the dirty vector of a log in the old format: a bitmap where each bit
+// marks a dirty 512-byte sector, followed by the dirty sectors themselves.
+func parseHiveDirtyVector(log []byte, binsSize uint32) []_HivePage {
+	const sector = 512
+	bitmap := log[516:]
+	if nBytes := int(binsSize / sector / 8); nBytes <= len(bitmap) {
+		bitmap = bitmap[:nBytes]
+	}
+	pos := (516 + len(bitmap) + sector - 1) / sector * sector
+
+	var pages []_HivePage
+	for i := 0; i < len(bitmap)*8; i++ {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if pos+sector > len(log) {
+			break
+		}
+		pages = append(pages, _HivePage{uint32(i * sector), log[pos : pos+sector]})
+		pos += sector
+	}
+	return pages
+}
+
+// Parses the entries of a log in the new format, stopping at the first one
+// which is invalid.
+func parseHiveLogEntries(log []byte) []_HiveLogEntry {
+	var entries []_HiveLogEntry
+	for pos := 512; pos+40 <= len(log); {
+		hdr := log[pos:]
+		size := int(binary.LittleEndian.Uint32(hdr[4:]))
+		if string(hdr[:4]) != "HvLE" || size < 40 || size%512 != 0 || size > len(hdr) {
+			break
+		}
+		entry := hdr[:size]
+		if marvin32(entry[40:]) != binary.LittleEndian.Uint64(entry[24:]) ||
+			marvin32(entry[:32]) != binary.LittleEndian.Uint64(entry[32:]) {
+			break
+		}
+
+		nPages := int(binary.LittleEndian.Uint32(entry[20:]))
+		if 40+nPages*8 > size {
+			break
+		}
+		pages := make([]_HivePage, 0, nPages)
+		dataPos := 40 + nPages*8
+		for i := 0; i < nPages; i++ {
+			offset := binary.LittleEndian.Uint32(entry[40+i*8:])
+			pageSize := int(binary.LittleEndian.Uint32(entry[44+i*8:]))
+			if dataPos+pageSize > size {
+				return entries
+			}
+			pages = append(pages, _HivePage{offset, entry[dataPos : dataPos+pageSize]})
+			dataPos += pageSize
+		}
+
+		entries = append(entries, _HiveLogEntry{
+			seq:      binary.LittleEndian.Uint32(entry[12:]),
+			binsSize: binary.LittleEndian.Uint32(entry[16:]),
+			pages:    pages,
+		})
+		pos += size
+	}
+	return entries
+}
+
+// Marvin32 hash, with the seed used by the registry.
+func marvin32(data []byte) uint64 {
+	lo, hi := uint32(0x7a4e_55c5), uint32(0x82ef_4d88) // seed 0x82ef4d887a4e55c5
+
+	block := func() {
+		hi ^= lo
+		lo = bits.RotateLeft32(lo, 20)
+		lo += hi
+		hi = bits.RotateLeft32(hi, 9)
+		hi ^= lo
+		lo = bits.RotateLeft32(lo, 27)
+		lo += hi
+		hi = bits.RotateLeft32(hi, 19)
+	}
+
+	for ; len(data) >= 4; data = data[4:] {
+		lo += binary.LittleEndian.Uint32(data)
+		block()
+	}
+	final := uint32(0x80)
+	for i := len(data) - 1; i >= 0; i-- {
+		final = final<<8 | uint32(data[i])
+	}
+	lo += final
+	block()
+	block()
+
+	return uint64(hi)<<32 | uint64(lo)
+}
@@ -0,0 +1,467 @@
+package reg_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rodrigocfd/windigo/reg"
+)
+
+func ExampleEncode() {
+	for _, v := range []any{"abc", []string{"a", "b"}, uint32(10), int64(-1), 90 * time.Second} {
+		val, _ := reg.Encode(v)
+		fmt.Printf("%s | % x\n", val, val.Data)
+	}
+	// Output:
+	// REG_SZ "abc" | 61 00 62 00 63 00 00 00
+	// REG_MULTI_SZ ["a" "b"] | 61 00 00 00 62 00 00 00 00 00
+	// REG_DWORD 10 | 0a 00 00 00
+	// REG_QWORD 18446744073709551615 | ff ff ff ff ff ff ff ff
+	// REG_QWORD 90000 | 90 5f 01 00 00 00 00 00
+}
+
+func ExampleDecode() {
+	val, _ := reg.EncodeAs(int32(-2), reg.REG_DWORD_BIG_ENDIAN)
+	fmt.Println(val)
+
+	var n int64
+	fmt.Println(reg.Decode(val, &n), n)
+
+	var u uint8
+	err := reg.Decode(val, &u)
+	fmt.Println(errors.Is(err, reg.ErrRange), err)
+
+	var s string
+	err = reg.Decode(val, &s)
+	fmt.Println(errors.Is(err, reg.ErrType), err)
+	// Output:
+	// REG_DWORD_BIG_ENDIAN 4294967294
+	// <nil> -2
+	// true Decode: number out of range: 4294967294 for uint8
+	// true Decode: incompatible type: cannot decode REG_DWORD_BIG_ENDIAN into string
+}
+
+func ExampleEncodeStruct() {
+	type Window struct {
+		Left, Top int32
+	}
+	type Settings struct {
+		Path    string        `reg:"Path,expand_sz"`
+		Recent  []string      `reg:"Recent Files"`
+		Timeout time.Duration `reg:",dword"`
+		Dark    bool          `reg:",omitempty"`
+		Main    Window        `reg:"Main Window"`
+		cache   int
+	}
+
+	key, err := reg.EncodeStruct(Settings{
+		Path:    "%TEMP%\\app",
+		Recent:  []string{"a.txt"},
+		Timeout: 5 * time.Second,
+		Main:    Window{Left: -8, Top: 20},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, name := range key.ValueNames() {
+		fmt.Printf("%s = %s\n", name, key.Values[name])
+	}
+	for _, name := range key.SubkeyNames() {
+		sub := key.Subkeys[name]
+		for _, subName := range sub.ValueNames() {
+			fmt.Printf("%s\\%s = %s\n", name, subName, sub.Values[subName])
+		}
+	}
+	// Output:
+	// Path = REG_EXPAND_SZ "%TEMP%\\app"
+	// Recent Files = REG_MULTI_SZ ["a.txt"]
+	// Timeout = REG_DWORD 5000
+	// Main Window\Left = REG_DWORD 4294967288
+	// Main Window\Top = REG_DWORD 20
+}
+
+func ExampleDecodeStruct() {
+	type Settings struct {
+		Name    string
+		Retries uint16
+		Enabled bool
+		Delay   time.Duration
+	}
+
+	key := reg.NewKey()
+	key.Values["name"], _ = reg.Encode("server") // names are case-insensitive
+	key.Values["Enabled"], _ = reg.Encode(uint32(1))
+	key.Values["Delay"], _ = reg.Encode(uint64(1500))
+
+	settings := Settings{Retries: 3} // default, not in the key
+	if err := reg.DecodeStruct(key, &settings); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", settings)
+
+	key.Values["Retries"], _ = reg.Encode("three")
+	fmt.Println(reg.DecodeStruct(key, &settings))
+	// Output:
+	// {Name:server Retries:3 Enabled:true Delay:1.5s}
+	// DecodeStruct: field Retries: incompatible type: cannot decode REG_SZ into uint16
+}
+
+func TestFileRoundTrip(t *testing.T) {
+	input := "Windows Registry Editor Version 5.00\r\n" +
+		"\r\n" +
+		"[HKEY_CURRENT_USER\\Software\\Test]\r\n" +
+		"@=\"default\"\r\n" +
+		"\"Path\"=\"C:\\\\Temp\\\\\\\"x\\\"\"\r\n" +
+		"\"Count\"=dword:0000002a\r\n" +
+		"\"Big\"=hex(b):00,01,02,03,04,05,06,07\r\n" +
+		"\"Expand\"=hex(2):25,00,54,00,45,00,4d,00,50,00,25,00,00,00\r\n" +
+		"\"Multi\"=hex(7):61,00,00,00,62,00,00,00,00,00\r\n" +
+		"\"Empty\"=hex:\r\n" +
+		"\"Long binary value\"=hex:00,01,02,03,04,05,06,07,08,09,0a,0b,0c,0d,0e,0f,10,11,\\\r\n" +
+		"  12,13,14,15,16,17,18,19,1a,1b,1c,1d,1e,1f,20,21,22,23,24,25,26,27,28,29,2a,\\\r\n" +
+		"  2b,2c\r\n" +
+		"\"Gone\"=-\r\n" +
+		"\r\n" +
+		"[-HKEY_CURRENT_USER\\Software\\Test\\Old]\r\n" +
+		"\r\n"
+
+	f, err := reg.ParseFile(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.String(); got != input {
+		t.Errorf("round trip:\nwant %q\ngot  %q", input, got)
+	}
+
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte{0xff, 0xfe, 'W', 0}) {
+		t.Errorf("missing UTF-16LE BOM: % x", buf.Bytes()[:4])
+	}
+	f2, err := reg.ParseFile(&buf)
+	if err != nil || f2.String() != input {
+		t.Errorf("UTF-16 round trip: %v", err)
+	}
+}
+
+func TestFileErrors(t *testing.T) {
+	inputs := []string{
+		"",
+		"[HKEY_CURRENT_USER]",
+		"REGEDIT4\n\"a\"=\"b\"",
+		"REGEDIT4\n[HKEY_CURRENT_USER\n",
+		"REGEDIT4\n[HKEY_CURRENT_USER]\n\"a\"=dword:123456789",
+		"REGEDIT4\n[HKEY_CURRENT_USER]\n\"a\"=hex:1,zz",
+		"REGEDIT4\n[HKEY_CURRENT_USER]\n\"a=\"b\"",
+	}
+	for _, input := range inputs {
+		if _, err := reg.ParseFile(strings.NewReader(input)); !errors.Is(err, reg.ErrSyntax) {
+			t.Errorf("%q: expected ErrSyntax, got %v", input, err)
+		}
+	}
+}
+
+func ExampleParseFile() {
+	f, err := reg.ParseFile(strings.NewReader(
+		"REGEDIT4\n" +
+			"\n" +
+			"; old format, with 8-bit strings\n" +
+			"[HKEY_LOCAL_MACHINE\\SOFTWARE\\App]\n" +
+			"\"Home\"=hex(2):25,48,4f,4d,45,25,00\n" +
+			"\"Flags\"=hex:01,\\\n" +
+			"  02\n"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, key := range f.Keys {
+		fmt.Println(key.Path)
+		for _, val := range key.Values {
+			fmt.Printf("%s = %s\n", val.Name, val.Value)
+		}
+	}
+	// Output:
+	// HKEY_LOCAL_MACHINE\SOFTWARE\App
+	// Home = REG_EXPAND_SZ "%HOME%"
+	// Flags = REG_BINARY 01 02
+}
+
+func ExampleFileFromKey() {
+	type Settings struct {
+		Name   string
+		Width  uint32
+		Recent []string
+	}
+
+	key, _ := reg.EncodeStruct(Settings{Name: "a \"b\"", Width: 800, Recent: []string{"x"}})
+	f := reg.FileFromKey("HKEY_CURRENT_USER\\Software\\App", key)
+	f.Keys = append(f.Keys, reg.FileKey{Path: "HKEY_CURRENT_USER\\Software\\Old", Delete: true})
+
+	fmt.Print(strings.ReplaceAll(f.String(), "\r\n", "\n"))
+	// Output:
+	// Windows Registry Editor Version 5.00
+	//
+	// [HKEY_CURRENT_USER\Software\App]
+	// "Name"="a \"b\""
+	// "Recent"=hex(7):78,00,00,00,00,00
+	// "Width"=dword:00000320
+	//
+	// [-HKEY_CURRENT_USER\Software\Old]
+	//
+}
+
+// Builds the hive bins data of a minimal hive, cell by cell.
+type _HiveBuilder struct {
+	bins []byte
+}
+
+func (me *_HiveBuilder) cell(data []byte) uint32 {
+	if me.bins == nil {
+		me.bins = append([]byte("hbin"), make([]byte, 28)...)
+	}
+	off := uint32(len(me.bins))
+	size := (4 + len(data) + 7) / 8 * 8
+	me.bins = binary.LittleEndian.AppendUint32(me.bins, uint32(-int32(size)))
+	me.bins = append(me.bins, data...)
+	me.bins = append(me.bins, make([]byte, size-4-len(data))...)
+	return off
+}
+
+func (me *_HiveBuilder) nk(name string, flags uint16, subkeys, subkeyList, values, valueList, class uint32, classLen uint16) uint32 {
+	nk := make([]byte, 76, 76+len(name))
+	copy(nk, "nk")
+	binary.LittleEndian.PutUint16(nk[2:], flags|0x0020)
+	binary.LittleEndian.PutUint64(nk[4:], 133_000_000_000_000_000) // 2022-06-15
+	binary.LittleEndian.PutUint32(nk[20:], subkeys)
+	binary.LittleEndian.PutUint32(nk[28:], subkeyList)
+	binary.LittleEndian.PutUint32(nk[32:], 0xffff_ffff)
+	binary.LittleEndian.PutUint32(nk[36:], values)
+	binary.LittleEndian.PutUint32(nk[40:], valueList)
+	binary.LittleEndian.PutUint32(nk[48:], class)
+	binary.LittleEndian.PutUint16(nk[72:], uint16(len(name)))
+	binary.LittleEndian.PutUint16(nk[74:], classLen)
+	return me.cell(append(nk, name...))
+}
+
+func (me *_HiveBuilder) vk(name string, val reg.Value, resident bool) uint32 {
+	vk := make([]byte, 20, 20+len(name))
+	copy(vk, "vk")
+	binary.LittleEndian.PutUint16(vk[2:], uint16(len(name)))
+	binary.LittleEndian.PutUint32(vk[4:], uint32(len(val.Data)))
+	binary.LittleEndian.PutUint32(vk[12:], uint32(val.Type))
+	binary.LittleEndian.PutUint16(vk[16:], 0x0001)
+	if resident {
+		binary.LittleEndian.PutUint32(vk[4:], uint32(len(val.Data))|0x8000_0000)
+		copy(vk[8:12], val.Data)
+	} else if len(val.Data) > 16344 {
+		var segs []byte
+		for data := val.Data; len(data) > 0; {
+			n := len(data)
+			if n > 16344 {
+				n = 16344
+			}
+			segs = binary.LittleEndian.AppendUint32(segs, me.cell(data[:n]))
+			data = data[n:]
+		}
+		db := []byte{'d', 'b', byte(len(segs) / 4), 0}
+		db = binary.LittleEndian.AppendUint32(db, me.cell(segs))
+		binary.LittleEndian.PutUint32(vk[8:], me.cell(db))
+	} else {
+		binary.LittleEndian.PutUint32(vk[8:], me.cell(val.Data))
+	}
+	return me.cell(append(vk, name...))
+}
+
+func (me *_HiveBuilder) file(root uint32, seq1, seq2 uint32) []byte {
+	bins := append([]byte{}, me.bins...)
+	bins = append(bins, make([]byte, (4096-len(bins)%4096)%4096)...)
+	binary.LittleEndian.PutUint32(bins[8:], uint32(len(bins)))
+
+	base := make([]byte, 4096)
+	copy(base, "regf")
+	binary.LittleEndian.PutUint32(base[4:], seq1)
+	binary.LittleEndian.PutUint32(base[8:], seq2)
+	binary.LittleEndian.PutUint32(base[20:], 1)
+	binary.LittleEndian.PutUint32(base[24:], 5)
+	binary.LittleEndian.PutUint32(base[32:], 1)
+	binary.LittleEndian.PutUint32(base[36:], root)
+	binary.LittleEndian.PutUint32(base[40:], uint32(len(bins)))
+	putHiveChecksum(base)
+	return append(base, bins...)
+}
+
+func putHiveChecksum(base []byte) {
+	var sum uint32
+	for i := 0; i < 508; i += 4 {
+		sum ^= binary.LittleEndian.Uint32(base[i:])
+	}
+	binary.LittleEndian.PutUint32(base[508:], sum)
+}
+
+func buildTestHive() (hive []byte, szData uint32) {
+	var b _HiveBuilder
+	sz, _ := reg.Encode("abc")
+	dword, _ := reg.Encode(uint32(42))
+	big := reg.Value{Type: reg.REG_BINARY, Data: bytes.Repeat([]byte{1, 2, 3}, 10000)}
+
+	szVk := b.vk("Name", sz, false)
+	szData = binary.LittleEndian.Uint32(b.bins[szVk+4+8:]) // data cell offset
+	values := binary.LittleEndian.AppendUint32(nil, szVk)
+	values = binary.LittleEndian.AppendUint32(values, b.vk("Count", dword, true))
+	values = binary.LittleEndian.AppendUint32(values, b.vk("Big", big, false))
+	values = binary.LittleEndian.AppendUint32(values, b.vk("", reg.Value{Type: reg.REG_NONE}, false))
+
+	class := b.cell([]byte{'C', 0, 'l', 0, 's', 0})
+	app := b.nk("MyApp", 0, 0, 0xffff_ffff, 4, b.cell(values), class, 6)
+	sub := b.nk("Other", 0, 0, 0xffff_ffff, 0, 0xffff_ffff, 0xffff_ffff, 0)
+
+	lh := []byte{'l', 'h', 2, 0}
+	lh = binary.LittleEndian.AppendUint64(lh, uint64(app))
+	lh = binary.LittleEndian.AppendUint64(lh, uint64(sub))
+	software := b.nk("Software", 0, 2, b.cell(lh), 0, 0xffff_ffff, 0xffff_ffff, 0)
+
+	li := binary.LittleEndian.AppendUint32([]byte{'l', 'i', 1, 0}, software)
+	ri := binary.LittleEndian.AppendUint32([]byte{'r', 'i', 1, 0}, b.cell(li))
+	root := b.nk("ROOT", 0x0004, 1, b.cell(ri), 0, 0xffff_ffff, 0xffff_ffff, 0)
+
+	return b.file(root, 7, 7), szData
+}
+
+func TestHive(t *testing.T) {
+	data, _ := buildTestHive()
+	hive, err := reg.ParseHive(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hive.Dirty() || hive.Recovered() {
+		t.Errorf("dirty %v, recovered %v", hive.Dirty(), hive.Recovered())
+	}
+
+	app, err := hive.Open("software\\MYAPP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app.Name() != "MyApp" || app.Class() != "Cls" || app.LastWritten().Year() != 2022 {
+		t.Errorf("key: %s %q %v", app.Name(), app.Class(), app.LastWritten())
+	}
+
+	names, _ := app.ValueNames()
+	if fmt.Sprintf("%q", names) != `["Name" "Count" "Big" ""]` {
+		t.Errorf("value names: %q", names)
+	}
+	if val, _ := app.Value("count"); val.String() != "REG_DWORD 42" {
+		t.Errorf("resident value: %s", val)
+	}
+	if val, err := app.Value("Big"); err != nil || len(val.Data) != 30000 || val.Data[29999] != 3 {
+		t.Errorf("big data value: %d bytes, %v", len(val.Data), err)
+	}
+	if _, err := app.Value("missing"); !errors.Is(err, reg.ErrNotFound) {
+		t.Errorf("missing value: %v", err)
+	}
+
+	software, _ := hive.Open("Software")
+	key, err := software.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var settings struct {
+		Name  string
+		Count uint32
+	}
+	if err := reg.DecodeStruct(key.Subkeys["MyApp"], &settings); err != nil || settings.Name != "abc" {
+		t.Errorf("decode: %+v, %v", settings, err)
+	}
+	if fmt.Sprint(key.SubkeyNames()) != "[MyApp Other]" {
+		t.Errorf("subkeys: %v", key.SubkeyNames())
+	}
+
+	root := binary.LittleEndian.Uint32(data[36:])
+	data[4096+root+4] = 'x' // corrupt the root key signature
+	if _, err := reg.ParseHive(data); !errors.Is(err, reg.ErrHive) {
+		t.Errorf("corrupted hive: %v", err)
+	}
+}
+
+func TestHiveLog(t *testing.T) {
+	data, szData := buildTestHive()
+
+	// The log holds the first page of the hive bins, with a new value.
+	page := append([]byte{}, data[4096:8192]...)
+	copy(page[szData+4:], []byte{'x', 0, 'y', 0, 'z', 0})
+
+	entry := make([]byte, 40, 512+4096)
+	copy(entry, "HvLE")
+	binary.LittleEndian.PutUint32(entry[12:], 7) // sequence number
+	binary.LittleEndian.PutUint32(entry[16:], uint32(len(data)-4096))
+	binary.LittleEndian.PutUint32(entry[20:], 1)
+	entry = binary.LittleEndian.AppendUint64(entry, 4096<<32) // offset 0, size 4096
+	entry = append(entry, page...)
+	entry = append(entry, make([]byte, 512-len(entry)%512)...)
+	binary.LittleEndian.PutUint32(entry[4:], uint32(len(entry)))
+	binary.LittleEndian.PutUint64(entry[24:], marvin32(entry[40:]))
+	binary.LittleEndian.PutUint64(entry[32:], marvin32(entry[:32]))
+
+	log := append([]byte{}, data[:512]...)
+	binary.LittleEndian.PutUint32(log[28:], 6) // file type of new logs
+	putHiveChecksum(log)
+	log = append(log, entry...)
+
+	for _, seq := range []uint32{7, 8} {
+		primary := append([]byte{}, data...)
+		binary.LittleEndian.PutUint32(primary[8:], seq) // secondary sequence number
+		putHiveChecksum(primary)
+
+		hive, err := reg.ParseHive(primary, log)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, _ := hive.Open("Software\\MyApp")
+		val, _ := key.Value("Name")
+
+		want := "REG_SZ \"xyz\""
+		if seq == 8 { // log entry already written to the primary file
+			want = "REG_SZ \"abc\""
+		}
+		if val.String() != want || hive.Recovered() != (seq == 7) {
+			t.Errorf("seq %d: got %s, recovered %v", seq, val, hive.Recovered())
+		}
+	}
+}
+
+// Marvin32 hash with the registry seed, as in the hive transaction logs.
+func marvin32(data []byte) uint64 {
+	lo, hi := uint32(0x7a4e_55c5), uint32(0x82ef_4d88)
+	block := func() {
+		hi ^= lo
+		lo = lo<<20 | lo>>12
+		lo += hi
+		hi = hi<<9 | hi>>23
+		hi ^= lo
+		lo = lo<<27 | lo>>5
+		lo += hi
+		hi = hi<<19 | hi>>13
+	}
+	for ; len(data) >= 4; data = data[4:] {
+		lo += binary.LittleEndian.Uint32(data)
+		block()
+	}
+	final := uint32(0x80)
+	for i := len(data) - 1; i >= 0; i-- {
+		final = final<<8 | uint32(data[i])
+	}
+	lo += final
+	block()
+	block()
+	return uint64(hi)<<32 | uint64(lo)
+}
@@ -0,0 +1,34 @@
+// This package encodes and decodes Windows [registry values] in pure Go,
+// without calling the OS. It can be used on any platform.
+//
+// A [Value] holds the type and the raw bytes of a registry value, exactly as
+// stored by the OS. Go values are converted with [Encode], [EncodeAs] and
+// [Decode], and whole structs – whose fields are values, and nested structs are
+// subkeys – are converted to and from a [Key] with [EncodeStruct] and
+// [DecodeStruct].
+//
+// The package also parses and writes .reg files, in the text format used by
+// regedit, with [ParseFile] and [File.WriteTo], and reads offline hive files,
+// like NTUSER.DAT, with [LoadHive], including their transaction logs.
+//
+// On Windows, the win package uses these functions to implement HKEY.Load,
+// HKEY.Store, HKEY.RegExport and HKEY.RegImport.
+//
+// Example:
+//
+//	type Settings struct {
+//		Path    string   `reg:"Path,expand_sz"`
+//		Recent  []string `reg:"Recent"`
+//		Retries uint32   `reg:"Retries"`
+//	}
+//
+//	key, _ := reg.EncodeStruct(Settings{
+//		Path:    "%USERPROFILE%\\Docs",
+//		Retries: 3,
+//	})
+//
+//	var settings Settings
+//	_ = reg.DecodeStruct(key, &settings)
+//
+// [registry values]: https://learn.microsoft.com/en-us/windows/win32/sysinfo/registry-value-types
+package reg
@@ -0,0 +1,294 @@
+package res
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Window and dialog styles used by dialog templates.
+const (
+	_WS_POPUP      uint32 = 0x8000_0000
+	_WS_CHILD      uint32 = 0x4000_0000
+	_WS_VISIBLE    uint32 = 0x1000_0000
+	_WS_CAPTION    uint32 = 0x00c0_0000
+	_WS_BORDER     uint32 = 0x0080_0000
+	_WS_SYSMENU    uint32 = 0x0008_0000
+	_WS_GROUP      uint32 = 0x0002_0000
+	_WS_TABSTOP    uint32 = 0x0001_0000
+	_DS_SETFONT    uint32 = 0x0000_0040
+	_DS_MODALFRAME uint32 = 0x0000_0080
+)
+
+// Predefined window classes, which are stored as atoms in dialog templates.
+var dlgClassAtoms = map[string]uint16{
+	"BUTTON":    0x0080,
+	"EDIT":      0x0081,
+	"STATIC":    0x0082,
+	"LISTBOX":   0x0083,
+	"SCROLLBAR": 0x0084,
+	"COMBOBOX":  0x0085,
+}
+
+// A dialog box template, stored in an RT_DIALOG resource, in either the
+// [DLGTEMPLATE] or the [DLGTEMPLATEEX] format.
+//
+// [DLGTEMPLATE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-dlgtemplate
+// [DLGTEMPLATEEX]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dlgtemplateex
+type Dialog struct {
+	Ex          bool   // DLGTEMPLATEEX format; if false, DLGTEMPLATE.
+	HelpId      uint32 // DLGTEMPLATEEX only.
+	ExStyle     uint32
+	Style       uint32
+	X, Y        int16 // In dialog units.
+	Cx, Cy      int16 // In dialog units.
+	Menu        Id    // Empty string if none.
+	Class       Id    // Empty string for the default dialog class.
+	Title       string
+	FontSize    uint16 // Font fields are used only if Style has DS_SETFONT.
+	FontWeight  uint16 // DLGTEMPLATEEX only.
+	FontItalic  uint8  // DLGTEMPLATEEX only.
+	FontCharset uint8  // DLGTEMPLATEEX only.
+	FontFace    string
+	Items       []DialogItem
+}
+
+// A control of a [Dialog], stored in either the [DLGITEMTEMPLATE] or the
+// [DLGITEMTEMPLATEEX] format.
+//
+// [DLGITEMTEMPLATE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-dlgitemtemplate
+// [DLGITEMTEMPLATEEX]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dlgitemtemplateex
+type DialogItem struct {
+	HelpId  uint32 // DLGITEMTEMPLATEEX only.
+	ExStyle uint32
+	Style   uint32
+	X, Y    int16 // In dialog units.
+	Cx, Cy  int16 // In dialog units.
+	Id      uint32
+	Class   Id     // Predefined classes, like Button, are stored as atoms.
+	Title   Id     // Text, or a resource ID, like an icon.
+	Extra   []byte // Creation data, DLGITEMTEMPLATEEX only.
+}
+
+// Creates a new [Dialog] in the DLGTEMPLATEEX format, with the given caption and
+// size in dialog units. The dialog has a caption bar with a system menu, and
+// uses the "MS Shell Dlg" font with size 8.
+//
+// Controls can be added with [Dialog.AddControl], and any field can be changed
+// directly before serializing.
+//
+// Example:
+//
+//	dlg := res.NewDialog("Hello", 200, 100).
+//		AddControl("Static", "Name:", 1001, 7, 9, 40, 8, 0).
+//		AddControl("Edit", "", 1002, 50, 7, 143, 12,
+//			uint32(co.ES_AUTOHSCROLL|co.WS_BORDER|co.WS_TABSTOP)).
+//		AddControl("Button", "OK", uint32(co.ID_OK), 143, 79, 50, 14,
+//			uint32(co.BS_DEFPUSHBUTTON|co.WS_TABSTOP))
+//
+//	data := dlg.Serialize()
+func NewDialog(title string, cx, cy int16) *Dialog {
+	return &Dialog{
+		Ex:          true,
+		Style:       _WS_POPUP | _WS_CAPTION | _WS_SYSMENU | _DS_MODALFRAME | _DS_SETFONT,
+		Cx:          cx,
+		Cy:          cy,
+		Menu:        IdStr(""),
+		Class:       IdStr(""),
+		Title:       title,
+		FontSize:    8,
+		FontWeight:  400, // FW_NORMAL
+		FontCharset: 1,   // DEFAULT_CHARSET
+		FontFace:    "MS Shell Dlg",
+	}
+}
+
+// Sets the font of the dialog, adding DS_SETFONT to its style.
+func (me *Dialog) SetFont(size uint16, face string) *Dialog {
+	me.Style |= _DS_SETFONT
+	me.FontSize = size
+	me.FontFace = face
+	return me
+}
+
+// Appends a control, like the CONTROL statement of a resource script. The
+// predefined classes – Button, Edit, Static, ListBox, ScrollBar and ComboBox –
+// are stored as atoms; WS_CHILD and WS_VISIBLE are always added to the style.
+// Position and size are in dialog units.
+func (me *Dialog) AddControl(
+	className, title string,
+	id uint32,
+	x, y, cx, cy int16,
+	style uint32,
+) *Dialog {
+	me.Items = append(me.Items, DialogItem{
+		Style: _WS_CHILD | _WS_VISIBLE | style,
+		X:     x,
+		Y:     y,
+		Cx:    cx,
+		Cy:    cy,
+		Id:    id,
+		Class: dlgClassId(className),
+		Title: IdStr(title),
+	})
+	return me
+}
+
+// Serializes the dialog template into the binary format stored in RT_DIALOG
+// resources.
+func (me *Dialog) Serialize() []byte {
+	var bw _BinWriter
+
+	if me.Ex {
+		bw.U16(1)      // dlgVer
+		bw.U16(0xffff) // signature
+		bw.U32(me.HelpId)
+		bw.U32(me.ExStyle)
+		bw.U32(me.Style)
+	} else {
+		bw.U32(me.Style)
+		bw.U32(me.ExStyle)
+	}
+	bw.U16(uint16(len(me.Items)))
+	bw.U16(uint16(me.X))
+	bw.U16(uint16(me.Y))
+	bw.U16(uint16(me.Cx))
+	bw.U16(uint16(me.Cy))
+	bw.SzOrOrd(me.Menu)
+	bw.SzOrOrd(me.Class)
+	bw.Str16Z(me.Title)
+
+	if me.Style&_DS_SETFONT != 0 {
+		bw.U16(me.FontSize)
+		if me.Ex {
+			bw.U16(me.FontWeight)
+			bw.U8(me.FontItalic)
+			bw.U8(me.FontCharset)
+		}
+		bw.Str16Z(me.FontFace)
+	}
+
+	for i := range me.Items {
+		item := &me.Items[i]
+		bw.Align(4)
+		if me.Ex {
+			bw.U32(item.HelpId)
+			bw.U32(item.ExStyle)
+			bw.U32(item.Style)
+		} else {
+			bw.U32(item.Style)
+			bw.U32(item.ExStyle)
+		}
+		bw.U16(uint16(item.X))
+		bw.U16(uint16(item.Y))
+		bw.U16(uint16(item.Cx))
+		bw.U16(uint16(item.Cy))
+		if me.Ex {
+			bw.U32(item.Id)
+		} else {
+			bw.U16(uint16(item.Id))
+		}
+		bw.SzOrOrd(item.Class)
+		bw.SzOrOrd(item.Title)
+		if me.Ex {
+			bw.U16(uint16(len(item.Extra)))
+		} else if len(item.Extra) > 0 {
+			bw.U16(uint16(len(item.Extra) + 2)) // DLGITEMTEMPLATE counts the size field itself
+		} else {
+			bw.U16(0)
+		}
+		bw.Raw(item.Extra)
+	}
+
+	return bw.Bytes()
+}
+
+// Parses the data of an RT_DIALOG resource, in either the DLGTEMPLATE or the
+// DLGTEMPLATEEX format.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	if rsrc, ok := res.FindResource(rsrcs, res.IdRt(res.RT_DIALOG), res.IdInt(101)); ok {
+//		dlg, _ := res.ParseDialog(rsrc.Data)
+//		println(dlg.Title, len(dlg.Items))
+//	}
+func ParseDialog(data []byte) (Dialog, error) {
+	r := _BinReader{data: data}
+	var dlg Dialog
+
+	if len(data) >= 4 && data[0] == 1 && data[1] == 0 && data[2] == 0xff && data[3] == 0xff {
+		dlg.Ex = true
+		r.U32() // dlgVer and signature
+		dlg.HelpId = r.U32()
+		dlg.ExStyle = r.U32()
+		dlg.Style = r.U32()
+	} else {
+		dlg.Style = r.U32()
+		dlg.ExStyle = r.U32()
+	}
+	numItems := int(r.U16())
+	dlg.X, dlg.Y = int16(r.U16()), int16(r.U16())
+	dlg.Cx, dlg.Cy = int16(r.U16()), int16(r.U16())
+	dlg.Menu = r.SzOrOrd()
+	dlg.Class = r.SzOrOrd()
+	dlg.Title = r.Str16Z()
+
+	if dlg.Style&_DS_SETFONT != 0 {
+		dlg.FontSize = r.U16()
+		if dlg.Ex {
+			dlg.FontWeight = r.U16()
+			dlg.FontItalic = r.U8()
+			dlg.FontCharset = r.U8()
+		}
+		dlg.FontFace = r.Str16Z()
+	}
+	if r.Err() != nil {
+		return Dialog{}, fmt.Errorf("ParseDialog: header: %w", ErrMalformed)
+	}
+
+	dlg.Items = make([]DialogItem, 0, numItems)
+	for i := 0; i < numItems; i++ {
+		var item DialogItem
+		r.Align(4)
+		if dlg.Ex {
+			item.HelpId = r.U32()
+			item.ExStyle = r.U32()
+			item.Style = r.U32()
+		} else {
+			item.Style = r.U32()
+			item.ExStyle = r.U32()
+		}
+		item.X, item.Y = int16(r.U16()), int16(r.U16())
+		item.Cx, item.Cy = int16(r.U16()), int16(r.U16())
+		if dlg.Ex {
+			item.Id = r.U32()
+		} else {
+			item.Id = uint32(r.U16())
+		}
+		item.Class = r.SzOrOrd()
+		item.Title = r.SzOrOrd()
+		extraLen := int(r.U16())
+		if !dlg.Ex && extraLen >= 2 {
+			extraLen -= 2 // DLGITEMTEMPLATE counts the size field itself
+		}
+		if extraLen > 0 {
+			item.Extra = append([]byte{}, r.Raw(extraLen)...)
+		}
+		if r.Err() != nil {
+			return Dialog{}, fmt.Errorf("ParseDialog: item %d: %w", i, ErrMalformed)
+		}
+		dlg.Items = append(dlg.Items, item)
+	}
+
+	return dlg, nil
+}
+
+// Returns the class ID, which is an atom for predefined classes.
+func dlgClassId(className string) Id {
+	if atom, ok := dlgClassAtoms[strings.ToUpper(className)]; ok {
+		return IdInt(atom)
+	}
+	return IdStr(className)
+}
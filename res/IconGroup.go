@@ -0,0 +1,116 @@
+package res
+
+import (
+	"fmt"
+)
+
+// An icon or cursor group, stored in an RT_GROUP_ICON or RT_GROUP_CURSOR
+// resource. Each entry refers to an individual image, stored in an RT_ICON or
+// RT_CURSOR resource.
+type IconGroup struct {
+	IsCursor bool
+	Entries  []IconGroupEntry
+}
+
+// An entry of an [IconGroup], which is a [GRPICONDIRENTRY] or a
+// [CURSORDIR]-based entry.
+//
+// [GRPICONDIRENTRY]: https://learn.microsoft.com/en-us/windows/win32/menurc/resdir
+// [CURSORDIR]: https://learn.microsoft.com/en-us/windows/win32/menurc/cursordir
+type IconGroupEntry struct {
+	Width      uint16 // In pixels.
+	Height     uint16 // In pixels, not including the AND mask of cursors.
+	ColorCount uint8  // Icons only.
+	Planes     uint16
+	BitCount   uint16
+	BytesInRes uint32 // Size of the image resource.
+	Id         uint16 // Name of the RT_ICON or RT_CURSOR resource.
+}
+
+// Serializes the group into the binary format stored in RT_GROUP_ICON and
+// RT_GROUP_CURSOR resources.
+func (me *IconGroup) Serialize() []byte {
+	var bw _BinWriter
+	bw.U16(0) // reserved
+	if me.IsCursor {
+		bw.U16(2)
+	} else {
+		bw.U16(1)
+	}
+	bw.U16(uint16(len(me.Entries)))
+
+	for _, e := range me.Entries {
+		if me.IsCursor {
+			bw.U16(e.Width)
+			bw.U16(e.Height * 2) // includes the AND mask
+		} else {
+			bw.U8(uint8(e.Width)) // 256 is stored as zero
+			bw.U8(uint8(e.Height))
+			bw.U8(e.ColorCount)
+			bw.U8(0) // reserved
+		}
+		bw.U16(e.Planes)
+		bw.U16(e.BitCount)
+		bw.U32(e.BytesInRes)
+		bw.U16(e.Id)
+	}
+	return bw.Bytes()
+}
+
+// Parses the data of an RT_GROUP_ICON or RT_GROUP_CURSOR resource.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	if rsrc, ok := res.FindResource(rsrcs, res.IdRt(res.RT_GROUP_ICON), res.IdInt(101)); ok {
+//		grp, _ := res.ParseIconGroup(rsrc.Data)
+//		for _, entry := range grp.Entries {
+//			println(entry.Width, entry.Height, entry.BitCount)
+//		}
+//	}
+func ParseIconGroup(data []byte) (IconGroup, error) {
+	r := _BinReader{data: data}
+	r.U16() // reserved
+	var grp IconGroup
+
+	switch typ := r.U16(); typ {
+	case 1:
+	case 2:
+		grp.IsCursor = true
+	default:
+		return IconGroup{}, fmt.Errorf("ParseIconGroup: unknown type %d: %w", typ, ErrMalformed)
+	}
+
+	count := int(r.U16())
+	grp.Entries = make([]IconGroupEntry, 0, count)
+	for i := 0; i < count; i++ {
+		var e IconGroupEntry
+		if grp.IsCursor {
+			e.Width = r.U16()
+			e.Height = r.U16() / 2
+		} else {
+			e.Width = uint16(r.U8())
+			e.Height = uint16(r.U8())
+			e.ColorCount = r.U8()
+			r.U8() // reserved
+			if e.Width == 0 {
+				e.Width = 256
+			}
+			if e.Height == 0 {
+				e.Height = 256
+			}
+		}
+		e.Planes = r.U16()
+		e.BitCount = r.U16()
+		e.BytesInRes = r.U32()
+		e.Id = r.U16()
+		grp.Entries = append(grp.Entries, e)
+	}
+
+	if r.Err() != nil {
+		return IconGroup{}, fmt.Errorf("ParseIconGroup: %w", ErrMalformed)
+	}
+	return grp, nil
+}
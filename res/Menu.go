@@ -0,0 +1,201 @@
+package res
+
+import (
+	"fmt"
+)
+
+// A menu template, stored in an RT_MENU resource, in either the [MENU] or the
+// [MENUEX] format.
+//
+// [MENU]: https://learn.microsoft.com/en-us/windows/win32/menurc/menuitemtemplate
+// [MENUEX]: https://learn.microsoft.com/en-us/windows/win32/menurc/menuex-template-item
+type Menu struct {
+	Ex     bool   // MENUEX format; if false, MENU.
+	HelpId uint32 // MENUEX only.
+	Items  []MenuItem
+}
+
+// An item of a [Menu].
+type MenuItem struct {
+	Text     string
+	Id       uint32     // Not used in MENU popups.
+	Flags    uint16     // MENU only, option flags like MF_CHECKED and MF_GRAYED.
+	Type     uint32     // MENUEX only, like MFT_SEPARATOR.
+	State    uint32     // MENUEX only, like MFS_CHECKED.
+	HelpId   uint32     // MENUEX popups only.
+	Popup    bool       // The item opens a submenu.
+	Children []MenuItem // Items of the submenu.
+}
+
+const (
+	_MF_POPUP  uint16 = 0x0010
+	_MF_END    uint16 = 0x0080
+	_MFR_POPUP uint16 = 0x0001
+	_MFR_END   uint16 = 0x0080
+)
+
+// Serializes the menu template into the binary format stored in RT_MENU
+// resources.
+func (me *Menu) Serialize() []byte {
+	var bw _BinWriter
+	if me.Ex {
+		bw.U16(1) // wVersion
+		bw.U16(4) // wOffset, relative to the end of this field
+		bw.U32(me.HelpId)
+		serializeMenuExItems(&bw, me.Items)
+	} else {
+		bw.U16(0) // wVersion
+		bw.U16(0) // cbHeaderSize
+		serializeMenuItems(&bw, me.Items)
+	}
+	return bw.Bytes()
+}
+
+// Writes MENUITEMTEMPLATE structs.
+func serializeMenuItems(bw *_BinWriter, items []MenuItem) {
+	for i := range items {
+		item := &items[i]
+		flags := item.Flags &^ (_MF_POPUP | _MF_END)
+		if item.Popup {
+			flags |= _MF_POPUP
+		}
+		if i == len(items)-1 {
+			flags |= _MF_END
+		}
+
+		bw.U16(flags)
+		if !item.Popup {
+			bw.U16(uint16(item.Id))
+		}
+		bw.Str16Z(item.Text)
+		if item.Popup {
+			serializeMenuItems(bw, item.Children)
+		}
+	}
+}
+
+// Writes MENUEX_TEMPLATE_ITEM structs.
+func serializeMenuExItems(bw *_BinWriter, items []MenuItem) {
+	for i := range items {
+		item := &items[i]
+		var resInfo uint16
+		if item.Popup {
+			resInfo |= _MFR_POPUP
+		}
+		if i == len(items)-1 {
+			resInfo |= _MFR_END
+		}
+
+		bw.U32(item.Type)
+		bw.U32(item.State)
+		bw.U32(item.Id)
+		bw.U16(resInfo)
+		bw.Str16Z(item.Text)
+		bw.Align(4)
+		if item.Popup {
+			bw.U32(item.HelpId)
+			serializeMenuExItems(bw, item.Children)
+		}
+	}
+}
+
+// Parses the data of an RT_MENU resource, in either the MENU or the MENUEX
+// format.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	if rsrc, ok := res.FindResource(rsrcs, res.IdRt(res.RT_MENU), res.IdInt(200)); ok {
+//		menu, _ := res.ParseMenu(rsrc.Data)
+//		for _, item := range menu.Items {
+//			println(item.Text)
+//		}
+//	}
+func ParseMenu(data []byte) (Menu, error) {
+	r := _BinReader{data: data}
+	var menu Menu
+
+	version := r.U16()
+	offset := int(r.U16())
+	switch version {
+	case 0:
+		r.Seek(4 + offset)
+		menu.Items = parseMenuItems(&r, 0)
+	case 1:
+		menu.Ex = true
+		menu.HelpId = r.U32()
+		r.Seek(4 + offset)
+		menu.Items = parseMenuExItems(&r, 0)
+	default:
+		return Menu{}, fmt.Errorf("ParseMenu: unknown version %d: %w", version, ErrMalformed)
+	}
+
+	if r.Err() != nil {
+		return Menu{}, fmt.Errorf("ParseMenu: %w", ErrMalformed)
+	}
+	return menu, nil
+}
+
+// Maximum nesting of submenus, to guard against malformed data.
+const _MENU_MAX_DEPTH = 64
+
+// Reads MENUITEMTEMPLATE structs, until the one with MF_END.
+func parseMenuItems(r *_BinReader, depth int) []MenuItem {
+	items := make([]MenuItem, 0, 8) // arbitrary
+	if depth > _MENU_MAX_DEPTH {
+		r.fail()
+		return items
+	}
+
+	for r.Err() == nil && r.Remaining() > 0 {
+		var item MenuItem
+		flags := r.U16()
+		item.Popup = flags&_MF_POPUP != 0
+		item.Flags = flags &^ (_MF_POPUP | _MF_END)
+		if !item.Popup {
+			item.Id = uint32(r.U16())
+		}
+		item.Text = r.Str16Z()
+		if item.Popup {
+			item.Children = parseMenuItems(r, depth+1)
+		}
+		items = append(items, item)
+
+		if flags&_MF_END != 0 {
+			break
+		}
+	}
+	return items
+}
+
+// Reads MENUEX_TEMPLATE_ITEM structs, until the one with the end flag.
+func parseMenuExItems(r *_BinReader, depth int) []MenuItem {
+	items := make([]MenuItem, 0, 8) // arbitrary
+	if depth > _MENU_MAX_DEPTH {
+		r.fail()
+		return items
+	}
+
+	for r.Err() == nil && r.Remaining() > 0 {
+		var item MenuItem
+		item.Type = r.U32()
+		item.State = r.U32()
+		item.Id = r.U32()
+		resInfo := r.U16()
+		item.Popup = resInfo&_MFR_POPUP != 0
+		item.Text = r.Str16Z()
+		r.Align(4)
+		if item.Popup {
+			item.HelpId = r.U32()
+			item.Children = parseMenuExItems(r, depth+1)
+		}
+		items = append(items, item)
+
+		if resInfo&_MFR_END != 0 {
+			break
+		}
+	}
+	return items
+}
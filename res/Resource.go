@@ -0,0 +1,226 @@
+package res
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// Resource [types].
+//
+// [types]: https://learn.microsoft.com/en-us/windows/win32/menurc/resource-types
+type RT uint16
+
+const (
+	RT_CURSOR       RT = 1
+	RT_BITMAP       RT = 2
+	RT_ICON         RT = 3
+	RT_MENU         RT = 4
+	RT_DIALOG       RT = 5
+	RT_STRING       RT = 6
+	RT_FONTDIR      RT = 7
+	RT_FONT         RT = 8
+	RT_ACCELERATOR  RT = 9
+	RT_RCDATA       RT = 10
+	RT_MESSAGETABLE RT = 11
+	RT_GROUP_CURSOR    = RT_CURSOR + 11
+	RT_GROUP_ICON      = RT_ICON + 11
+	RT_VERSION      RT = 16
+	RT_DLGINCLUDE   RT = 17
+	RT_PLUGPLAY     RT = 19
+	RT_VXD          RT = 20
+	RT_ANICURSOR    RT = 21
+	RT_ANIICON      RT = 22
+	RT_HTML         RT = 23
+	RT_MANIFEST     RT = 24
+)
+
+// Resource [memory flags], stored in .res files. They are ignored by 32 and
+// 64-bit Windows, being kept only for compatibility.
+//
+// [memory flags]: https://learn.microsoft.com/en-us/windows/win32/menurc/resourceheader
+type MEMFLAG uint16
+
+const (
+	MEMFLAG_MOVEABLE    MEMFLAG = 0x0010
+	MEMFLAG_PURE        MEMFLAG = 0x0020
+	MEMFLAG_PRELOAD     MEMFLAG = 0x0040
+	MEMFLAG_DISCARDABLE MEMFLAG = 0x1000
+)
+
+// Language identifier used when none is specified: English (United States).
+const LANGID_DEFAULT uint16 = 0x0409
+
+// Tagged union for a resource type or name, which can be:
+//   - uint16
+//   - string
+//
+// Example:
+//
+//	resId := res.IdInt(101)
+//
+//	if id, ok := resId.Int(); ok {
+//		println(id)
+//	}
+type Id struct {
+	tag _TagId
+	id  uint16
+	str string
+}
+
+type _TagId uint8
+
+const (
+	_TagId_id _TagId = 1 + iota
+	_TagId_str
+)
+
+// Constructs a new [Id] with an integer value.
+func IdInt(id uint16) Id {
+	return Id{
+		tag: _TagId_id,
+		id:  id,
+	}
+}
+
+// Constructs a new [Id] with a resource type.
+func IdRt(rt RT) Id {
+	return IdInt(uint16(rt))
+}
+
+// If the value is an integer, returns it and true.
+func (me *Id) Int() (uint16, bool) {
+	return me.id, me.tag == _TagId_id
+}
+
+// Constructs a new [Id] with a string value.
+func IdStr(s string) Id {
+	return Id{
+		tag: _TagId_str,
+		str: s,
+	}
+}
+
+// If the value is a string, returns it and true.
+func (me *Id) Str() (string, bool) {
+	return me.str, me.tag == _TagId_str
+}
+
+// Returns true if both identifiers have the same value. String values are
+// compared case-insensitive, like Windows does.
+func (me *Id) Equals(other Id) bool {
+	if me.tag != other.tag {
+		return false
+	} else if me.tag == _TagId_str {
+		return strings.EqualFold(me.str, other.str)
+	}
+	return me.id == other.id
+}
+
+// Returns the integer value in decimal, or the string value between quotes.
+func (me *Id) String() string {
+	if me.tag == _TagId_str {
+		return fmt.Sprintf("%q", me.str)
+	}
+	return fmt.Sprintf("%d", me.id)
+}
+
+// Compares two identifiers following the ordering of a resource directory:
+// strings come first, in ordinal UTF-16 order, then integers.
+func (me *Id) cmp(other Id) int {
+	if me.tag == _TagId_str && other.tag == _TagId_str {
+		a, b := utf16.Encode([]rune(me.str)), utf16.Encode([]rune(other.str))
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if a[i] != b[i] {
+				return int(a[i]) - int(b[i])
+			}
+		}
+		return len(a) - len(b)
+	} else if me.tag == _TagId_str {
+		return -1
+	} else if other.tag == _TagId_str {
+		return 1
+	}
+	return int(me.id) - int(other.id)
+}
+
+// A single compiled resource, identified by its type, name and language.
+type Resource struct {
+	Type            Id      // Resource type, usually one of the RT constants.
+	Name            Id      // Resource name.
+	LangId          uint16  // Language identifier.
+	MemFlags        MEMFLAG // Ignored by Windows, kept for compatibility.
+	Version         uint32  // User-defined version, stored only in .res files.
+	Characteristics uint32  // User-defined value, stored only in .res files.
+	Data            []byte  // Raw resource data.
+}
+
+// Returns the resources sorted in resource directory order: by type, then by
+// name, then by language.
+func sortResources(rsrcs []Resource) []Resource {
+	sorted := make([]Resource, len(rsrcs))
+	copy(sorted, rsrcs)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		ra, rb := &sorted[a], &sorted[b]
+		if c := ra.Type.cmp(rb.Type); c != 0 {
+			return c < 0
+		} else if c := ra.Name.cmp(rb.Name); c != 0 {
+			return c < 0
+		}
+		return ra.LangId < rb.LangId
+	})
+	return sorted
+}
+
+// Returns the first resource with the given type and name, regardless of its
+// language.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	if rsrc, ok := res.FindResource(rsrcs, res.IdRt(res.RT_MANIFEST), res.IdInt(1)); ok {
+//		println(res.ParseManifest(rsrc.Data))
+//	}
+func FindResource(rsrcs []Resource, rsrcType, name Id) (Resource, bool) {
+	for i := range rsrcs {
+		if rsrcs[i].Type.Equals(rsrcType) && rsrcs[i].Name.Equals(name) {
+			return rsrcs[i], true
+		}
+	}
+	return Resource{}, false
+}
+
+// Returns a copy of the slice with the given resource, replacing an existing
+// one with the same type, name and language, if any; otherwise the resource is
+// appended.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.syso")
+//	rsrcs, _ := res.ReadSyso(data)
+//
+//	rsrcs = res.SetResource(rsrcs, res.Resource{
+//		Type:   res.IdRt(res.RT_VERSION),
+//		Name:   res.IdInt(1),
+//		LangId: res.LANGID_DEFAULT,
+//		Data:   info.Serialize(),
+//	})
+func SetResource(rsrcs []Resource, rsrc Resource) []Resource {
+	newRsrcs := make([]Resource, 0, len(rsrcs)+1)
+	replaced := false
+	for _, r := range rsrcs {
+		if !replaced && r.Type.Equals(rsrc.Type) && r.Name.Equals(rsrc.Name) && r.LangId == rsrc.LangId {
+			newRsrcs = append(newRsrcs, rsrc)
+			replaced = true
+		} else {
+			newRsrcs = append(newRsrcs, r)
+		}
+	}
+	if !replaced {
+		newRsrcs = append(newRsrcs, rsrc)
+	}
+	return newRsrcs
+}
@@ -0,0 +1,229 @@
+package res
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Reads the resources of a PE file (EXE or DLL), by walking its resource
+// directory. No OS functions are called, so any PE file – 32 or 64-bit – can be
+// read on any platform.
+//
+// Returns an empty slice if the file has no resources.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	if rsrc, ok := res.FindResource(rsrcs, res.IdRt(res.RT_VERSION), res.IdInt(1)); ok {
+//		info, _ := res.ParseVersion(rsrc.Data)
+//		println(info.Fixed.FileVersion())
+//	}
+func ReadPe(data []byte) ([]Resource, error) {
+	r := _BinReader{data: data}
+	if !bytes.HasPrefix(data, []byte("MZ")) {
+		return nil, fmt.Errorf("ReadPe: not a PE file: %w", ErrMalformed)
+	}
+	r.Seek(0x3c)
+	peOff := int(r.U32()) // e_lfanew
+	r.Seek(peOff)
+	if !bytes.Equal(r.Raw(4), []byte("PE\x00\x00")) {
+		return nil, fmt.Errorf("ReadPe: PE signature not found: %w", ErrMalformed)
+	}
+
+	r.U16() // Machine
+	numSections := int(r.U16())
+	r.Raw(12) // TimeDateStamp, PointerToSymbolTable, NumberOfSymbols
+	optHdrSize := int(r.U16())
+	r.U16() // Characteristics
+	optHdrOff := r.Pos()
+
+	var dirsOff int // offset of the data directories within the optional header
+	switch magic := r.U16(); magic {
+	case 0x010b: // IMAGE_NT_OPTIONAL_HDR32_MAGIC
+		dirsOff = 96
+	case 0x020b: // IMAGE_NT_OPTIONAL_HDR64_MAGIC
+		dirsOff = 112
+	default:
+		return nil, fmt.Errorf("ReadPe: unknown optional header magic 0x%04x: %w", magic, ErrMalformed)
+	}
+	r.Seek(optHdrOff + dirsOff - 4)
+	numDirs := r.U32() // NumberOfRvaAndSizes
+	if r.Err() != nil {
+		return nil, fmt.Errorf("ReadPe: headers: %w", ErrMalformed)
+	}
+	if numDirs <= 2 {
+		return []Resource{}, nil
+	}
+	r.Seek(optHdrOff + dirsOff + 2*8) // IMAGE_DIRECTORY_ENTRY_RESOURCE
+	rsrcRva, rsrcSize := r.U32(), r.U32()
+	if rsrcRva == 0 || rsrcSize == 0 {
+		return []Resource{}, nil
+	}
+
+	type Section struct{ va, vSize, rawSize, rawPtr uint32 }
+	sections := make([]Section, 0, numSections)
+	r.Seek(optHdrOff + optHdrSize)
+	for i := 0; i < numSections; i++ {
+		r.Raw(8) // Name
+		var sec Section
+		sec.vSize, sec.va, sec.rawSize, sec.rawPtr = r.U32(), r.U32(), r.U32(), r.U32()
+		r.Raw(16) // relocations, line numbers and characteristics
+		sections = append(sections, sec)
+	}
+	if r.Err() != nil {
+		return nil, fmt.Errorf("ReadPe: section table: %w", ErrMalformed)
+	}
+
+	rvaToOff := func(rva uint32) (int, bool) {
+		for _, sec := range sections {
+			size := sec.vSize
+			if size == 0 || size > sec.rawSize {
+				size = sec.rawSize
+			}
+			if rva >= sec.va && rva-sec.va < size {
+				return int(sec.rawPtr + (rva - sec.va)), true
+			}
+		}
+		return 0, false
+	}
+
+	dirOff, ok := rvaToOff(rsrcRva)
+	if !ok {
+		return nil, fmt.Errorf("ReadPe: resource directory outside sections: %w", ErrMalformed)
+	}
+	rsrcs, err := readRsrcDir(data, dirOff, rvaToOff)
+	if err != nil {
+		return nil, fmt.Errorf("ReadPe: %w", err)
+	}
+	return rsrcs, nil
+}
+
+// Reads the resources of a COFF object file with a .rsrc section, like the
+// ones written by [WriteSyso].
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.syso")
+//	rsrcs, _ := res.ReadSyso(data)
+func ReadSyso(data []byte) ([]Resource, error) {
+	r := _BinReader{data: data}
+	r.U16() // Machine
+	numSections := int(r.U16())
+	r.Raw(12) // TimeDateStamp, PointerToSymbolTable, NumberOfSymbols
+	optHdrSize := int(r.U16())
+	r.U16() // Characteristics
+	r.Seek(r.Pos() + optHdrSize)
+
+	for i := 0; i < numSections && r.Err() == nil; i++ {
+		name := r.Raw(8)
+		r.U32() // VirtualSize
+		r.U32() // VirtualAddress
+		rawSize, rawPtr := r.U32(), r.U32()
+		r.Raw(16) // relocations, line numbers and characteristics
+
+		if r.Err() == nil && bytes.Equal(bytes.TrimRight(name, "\x00"), []byte(".rsrc")) {
+			if uint64(rawPtr)+uint64(rawSize) > uint64(len(data)) {
+				break
+			}
+			// Data entries hold offsets relative to the section, which are
+			// relocated only by the linker.
+			rvaToOff := func(rva uint32) (int, bool) {
+				return int(rawPtr + rva), rva < rawSize
+			}
+			rsrcs, err := readRsrcDir(data, int(rawPtr), rvaToOff)
+			if err != nil {
+				return nil, fmt.Errorf("ReadSyso: %w", err)
+			}
+			return rsrcs, nil
+		}
+	}
+
+	if r.Err() != nil {
+		return nil, fmt.Errorf("ReadSyso: headers: %w", ErrMalformed)
+	}
+	return nil, fmt.Errorf("ReadSyso: no .rsrc section: %w", ErrMalformed)
+}
+
+// Walks the 3 levels – type, name and language – of a resource directory which
+// starts at dirOff, returning all the resources. The rvaToOff function converts
+// the address of each data entry into a file offset.
+func readRsrcDir(data []byte, dirOff int, rvaToOff func(rva uint32) (int, bool)) ([]Resource, error) {
+	r := _BinReader{data: data}
+
+	type Entry struct {
+		id     Id
+		offset uint32 // relative to dirOff
+		isDir  bool
+	}
+
+	readDir := func(offset uint32) []Entry {
+		r.Seek(dirOff + int(offset))
+		r.Raw(12) // Characteristics, TimeDateStamp, MajorVersion, MinorVersion
+		numEntries := int(r.U16()) + int(r.U16())
+		entries := make([]Entry, 0, numEntries)
+
+		for i := 0; i < numEntries && r.Err() == nil; i++ {
+			nameField, offField := r.U32(), r.U32()
+			entries = append(entries, Entry{
+				id:     IdInt(uint16(nameField)),
+				offset: offField &^ 0x8000_0000,
+				isDir:  offField&0x8000_0000 != 0,
+			})
+			if nameField&0x8000_0000 != 0 {
+				pos := r.Pos()
+				r.Seek(dirOff + int(nameField&^0x8000_0000))
+				entries[i].id = IdStr(r.Str16(int(r.U16())))
+				r.Seek(pos)
+			}
+		}
+		return entries
+	}
+
+	rsrcs := make([]Resource, 0, 16) // arbitrary
+
+	for _, typeEntry := range readDir(0) {
+		if !typeEntry.isDir {
+			return nil, fmt.Errorf("type %s is not a directory: %w", typeEntry.id.String(), ErrMalformed)
+		}
+		for _, nameEntry := range readDir(typeEntry.offset) {
+			if !nameEntry.isDir {
+				return nil, fmt.Errorf("name %s is not a directory: %w", nameEntry.id.String(), ErrMalformed)
+			}
+			for _, langEntry := range readDir(nameEntry.offset) {
+				langId, _ := langEntry.id.Int()
+				if langEntry.isDir {
+					return nil, fmt.Errorf("language %d is a directory: %w", langId, ErrMalformed)
+				}
+
+				r.Seek(dirOff + int(langEntry.offset)) // IMAGE_RESOURCE_DATA_ENTRY
+				rva, size := r.U32(), r.U32()
+				off, ok := rvaToOff(rva)
+				if !ok || r.Err() != nil {
+					return nil, fmt.Errorf("data of %s/%s: %w",
+						typeEntry.id.String(), nameEntry.id.String(), ErrMalformed)
+				}
+				r.Seek(off)
+				rsrcData := r.Raw(int(size))
+				if r.Err() != nil {
+					return nil, fmt.Errorf("data of %s/%s: %w",
+						typeEntry.id.String(), nameEntry.id.String(), ErrMalformed)
+				}
+
+				rsrcs = append(rsrcs, Resource{
+					Type:     typeEntry.id,
+					Name:     nameEntry.id,
+					LangId:   langId,
+					MemFlags: _RC_DEFAULT_MEMFLAGS,
+					Data:     rsrcData,
+				})
+			}
+		}
+	}
+
+	if r.Err() != nil {
+		return nil, fmt.Errorf("resource directory: %w", ErrMalformed)
+	}
+	return rsrcs, nil
+}
@@ -0,0 +1,692 @@
+package res
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// Memory flags given to resources when none are specified.
+const _RC_DEFAULT_MEMFLAGS = MEMFLAG_MOVEABLE | MEMFLAG_PURE | MEMFLAG_DISCARDABLE
+
+// Compiles a resource script (.rc file), returning the resources it defines.
+// The script, along with any file it references – headers, icons, manifests,
+// and so on – is read from fsys. File names are resolved relatively to the
+// file which references them, then relatively to the root of fsys.
+//
+// The script is first processed by a C preprocessor, which handles #include,
+// #define, #undef, #if, #ifdef, #ifndef, #elif, #else and #endif. Included
+// header files (.h) only have their directives processed. The constants of the
+// Windows SDK headers, like WS_CHILD or VS_FF_DEBUG, are predefined, so
+// including <windows.h> is not necessary.
+//
+// The following resource statements are supported:
+//   - ACCELERATORS
+//   - BITMAP
+//   - CURSOR
+//   - DIALOG and DIALOGEX
+//   - FONT, HTML and MESSAGETABLE
+//   - ICON
+//   - LANGUAGE
+//   - MENU and MENUEX
+//   - RCDATA and user-defined types, like RT_MANIFEST
+//   - STRINGTABLE
+//   - VERSIONINFO
+//
+// Example:
+//
+//	fsys := fstest.MapFS{
+//		"app.rc": {Data: []byte(`
+//			#define IDI_APP 101
+//			IDI_APP ICON "app.ico"
+//			CREATEPROCESS_MANIFEST_RESOURCE_ID RT_MANIFEST "app.manifest"
+//		`)},
+//		"app.ico":      {Data: icoBytes},
+//		"app.manifest": {Data: manifestBytes},
+//	}
+//
+//	rsrcs, _ := res.CompileRc(fsys, "app.rc")
+func CompileRc(fsys fs.FS, name string) ([]Resource, error) {
+	pp := newRcPreproc(fsys)
+	if err := pp.processFile(rcCleanPath(name)); err != nil {
+		return nil, fmt.Errorf("CompileRc: %w", err)
+	}
+
+	c := _RcCompiler{
+		fsys:      fsys,
+		s:         _RcStream{toks: pp.out},
+		lang:      LANGID_DEFAULT,
+		nextImgId: make(map[RT]uint16, 2),
+		strTables: make(map[_RcStrBlockKey]*_RcStrBlock),
+	}
+	if err := c.compile(); err != nil {
+		return nil, fmt.Errorf("CompileRc: %w", err)
+	}
+	return c.rsrcs, nil
+}
+
+// Returns the value of a numeric constant predefined by [CompileRc], which
+// comes from the Windows SDK headers, like WS_VISIBLE or BS_DEFPUSHBUTTON.
+//
+// Example:
+//
+//	if val, ok := res.LookupSymbol("WS_VISIBLE"); ok {
+//		println(val)
+//	}
+func LookupSymbol(name string) (int64, bool) {
+	val, ok := rcBuiltinNumbers[name]
+	return val, ok
+}
+
+// Common attributes of a resource, which can be set by optional statements.
+type _RcAttrs struct {
+	lang            uint16
+	memFlags        MEMFLAG
+	version         uint32
+	characteristics uint32
+}
+
+// Key of a block of 16 strings of a string table.
+type _RcStrBlockKey struct {
+	lang    uint16
+	blockId uint16
+}
+
+// A block of 16 strings of a string table.
+type _RcStrBlock struct {
+	attrs   _RcAttrs
+	strs    [16]string
+	defined [16]bool
+}
+
+// Resource script compiler, which parses the preprocessed tokens.
+type _RcCompiler struct {
+	fsys      fs.FS
+	s         _RcStream
+	lang      uint16
+	rsrcs     []Resource
+	nextImgId map[RT]uint16 // next ID of icon and cursor images
+	strTables map[_RcStrBlockKey]*_RcStrBlock
+}
+
+func (me *_RcCompiler) compile() error {
+	for !me.s.atEof() {
+		tok := me.s.next()
+
+		switch {
+		case tok.isKw("LANGUAGE"):
+			lang, err := me.parseLanguage()
+			if err != nil {
+				return err
+			}
+			me.lang = lang
+
+		case tok.isKw("STRINGTABLE"):
+			if err := me.parseStringTable(); err != nil {
+				return err
+			}
+
+		default:
+			me.s.back()
+			name, err := me.parseNameOrId()
+			if err != nil {
+				return err
+			}
+			if err := me.parseResource(name); err != nil {
+				return err
+			}
+		}
+	}
+
+	me.addStringTables()
+	return nil
+}
+
+// Parses a resource name or type: a number, or an identifier or string, which
+// is converted to uppercase.
+func (me *_RcCompiler) parseNameOrId() (Id, error) {
+	tok := me.s.peek()
+	switch tok.kind {
+	case _RcTokIdent, _RcTokStr:
+		me.s.next()
+		return IdStr(strings.ToUpper(tok.text)), nil
+	default:
+		n, err := me.s.exprU16()
+		return IdInt(n), err
+	}
+}
+
+// Parses the arguments of a LANGUAGE statement.
+func (me *_RcCompiler) parseLanguage() (uint16, error) {
+	primary, err := me.s.exprU16()
+	if err != nil {
+		return 0, err
+	}
+	if err := me.s.expect(","); err != nil {
+		return 0, err
+	}
+	sub, err := me.s.exprU16()
+	if err != nil {
+		return 0, err
+	}
+	return (sub << 10) | primary, nil // MAKELANGID
+}
+
+// Parses the optional statements which can follow the resource type, before
+// the resource data.
+func (me *_RcCompiler) parseAttrs() (_RcAttrs, error) {
+	attrs := _RcAttrs{
+		lang:     me.lang,
+		memFlags: _RC_DEFAULT_MEMFLAGS,
+	}
+
+	for {
+		tok := me.s.peek()
+		var err error
+
+		switch {
+		case tok.isKw("LANGUAGE"):
+			me.s.next()
+			attrs.lang, err = me.parseLanguage()
+		case tok.isKw("VERSION"):
+			me.s.next()
+			attrs.version, err = me.s.exprU32()
+		case tok.isKw("CHARACTERISTICS"):
+			me.s.next()
+			attrs.characteristics, err = me.s.exprU32()
+		case tok.kind == _RcTokIdent && rcApplyMemFlag(&attrs.memFlags, tok.text):
+			me.s.next()
+		default:
+			return attrs, nil
+		}
+
+		if err != nil {
+			return attrs, err
+		}
+	}
+}
+
+// Applies a memory flag keyword. Returns false if the keyword is not a memory
+// flag.
+func rcApplyMemFlag(flags *MEMFLAG, kw string) bool {
+	switch strings.ToUpper(kw) {
+	case "MOVEABLE":
+		*flags |= MEMFLAG_MOVEABLE
+	case "FIXED":
+		*flags &^= MEMFLAG_MOVEABLE | MEMFLAG_DISCARDABLE
+	case "PURE", "SHARED":
+		*flags |= MEMFLAG_PURE
+	case "IMPURE", "NONSHARED":
+		*flags &^= MEMFLAG_PURE | MEMFLAG_DISCARDABLE
+	case "PRELOAD":
+		*flags |= MEMFLAG_PRELOAD
+	case "LOADONCALL":
+		*flags &^= MEMFLAG_PRELOAD
+	case "DISCARDABLE":
+		*flags |= MEMFLAG_DISCARDABLE | MEMFLAG_MOVEABLE | MEMFLAG_PURE
+	default:
+		return false
+	}
+	return true
+}
+
+// Adds a new resource, failing if it's a duplicate.
+func (me *_RcCompiler) add(tok *_RcTok, rsrcType, name Id, attrs _RcAttrs, data []byte) error {
+	for i := range me.rsrcs {
+		r := &me.rsrcs[i]
+		if r.Type.Equals(rsrcType) && r.Name.Equals(name) && r.LangId == attrs.lang {
+			return tok.errf("duplicate resource: type %s, name %s, language 0x%04x",
+				rsrcType.String(), name.String(), attrs.lang)
+		}
+	}
+
+	me.rsrcs = append(me.rsrcs, Resource{
+		Type:            rsrcType,
+		Name:            name,
+		LangId:          attrs.lang,
+		MemFlags:        attrs.memFlags,
+		Version:         attrs.version,
+		Characteristics: attrs.characteristics,
+		Data:            data,
+	})
+	return nil
+}
+
+// Parses a resource definition, whose name was already parsed.
+func (me *_RcCompiler) parseResource(name Id) error {
+	tok := me.s.peek()
+
+	if tok.kind == _RcTokIdent {
+		switch strings.ToUpper(tok.text) {
+		case "ACCELERATORS":
+			me.s.next()
+			return me.parseAccelerators(tok, name)
+		case "BITMAP":
+			me.s.next()
+			return me.parseBitmap(tok, name)
+		case "CURSOR":
+			me.s.next()
+			return me.parseIconOrCursor(tok, name, true)
+		case "DIALOG":
+			me.s.next()
+			return me.parseDialog(tok, name, false)
+		case "DIALOGEX":
+			me.s.next()
+			return me.parseDialog(tok, name, true)
+		case "FONT":
+			me.s.next()
+			return me.parseUserData(tok, IdRt(RT_FONT), name)
+		case "HTML":
+			me.s.next()
+			return me.parseUserData(tok, IdRt(RT_HTML), name)
+		case "ICON":
+			me.s.next()
+			return me.parseIconOrCursor(tok, name, false)
+		case "MENU":
+			me.s.next()
+			return me.parseMenu(tok, name, false)
+		case "MENUEX":
+			me.s.next()
+			return me.parseMenu(tok, name, true)
+		case "MESSAGETABLE":
+			me.s.next()
+			return me.parseUserData(tok, IdRt(RT_MESSAGETABLE), name)
+		case "RCDATA":
+			me.s.next()
+			return me.parseUserData(tok, IdRt(RT_RCDATA), name)
+		case "VERSIONINFO":
+			me.s.next()
+			return me.parseVersionInfo(tok, name)
+		}
+	} else if tok.kind == _RcTokEof {
+		return tok.errf("expected resource type after %s", name.String())
+	}
+
+	rsrcType, err := me.parseNameOrId()
+	if err != nil {
+		return err
+	}
+	return me.parseUserData(tok, rsrcType, name)
+}
+
+// Parses a resource whose data is either loaded from a file, or specified in
+// a raw data block.
+func (me *_RcCompiler) parseUserData(tok *_RcTok, rsrcType, name Id) error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if me.s.peek().isBegin() {
+		me.s.next()
+		data, err = me.parseRawData()
+	} else {
+		data, err = me.readFile()
+	}
+	if err != nil {
+		return err
+	}
+	return me.add(tok, rsrcType, name, attrs, data)
+}
+
+// Parses the contents of a raw data block, after BEGIN, until END. Numbers are
+// written as WORD, or DWORD if they have the L suffix; strings are written
+// without a terminating null.
+func (me *_RcCompiler) parseRawData() ([]byte, error) {
+	var bw _BinWriter
+
+	for {
+		tok := me.s.peek()
+		switch {
+		case tok.isEnd():
+			me.s.next()
+			return bw.Bytes(), nil
+		case tok.kind == _RcTokEof:
+			return nil, tok.errf("expected END")
+		case tok.kind == _RcTokStr:
+			me.s.next()
+			if tok.long {
+				bw.Str16(tok.text)
+			} else {
+				bw.Raw(tok.bytes)
+			}
+		default:
+			val, long, err := me.s.expr()
+			if err != nil {
+				return nil, err
+			}
+			if long {
+				bw.U32(uint32(val))
+			} else {
+				bw.U16(uint16(val))
+			}
+		}
+		me.s.skip(",")
+	}
+}
+
+// Parses one or more adjacent strings, returning their concatenation.
+func (me *_RcCompiler) parseString() (string, error) {
+	tok := me.s.next()
+	if tok.kind != _RcTokStr {
+		return "", tok.errf("expected string, found %s", tok)
+	}
+	text := tok.text
+	for me.s.peek().kind == _RcTokStr {
+		text += me.s.next().text
+	}
+	return text, nil
+}
+
+// Parses a file name and returns the file contents.
+func (me *_RcCompiler) readFile() ([]byte, error) {
+	tok := me.s.next()
+	var name string
+	switch tok.kind {
+	case _RcTokStr:
+		name = tok.raw
+	case _RcTokIdent:
+		name = tok.text
+	default:
+		return nil, tok.errf("expected file name, found %s", tok)
+	}
+
+	candidates := []string{
+		rcCleanPath(path.Join(path.Dir(tok.file), rcCleanPath(name))),
+		rcCleanPath(name),
+	}
+	for _, candidate := range candidates {
+		if data, err := fs.ReadFile(me.fsys, candidate); err == nil {
+			return data, nil
+		}
+	}
+	return nil, tok.errf("file not found: %s", name)
+}
+
+// Parses a BITMAP resource, which is a .bmp file without its file header.
+func (me *_RcCompiler) parseBitmap(tok *_RcTok, name Id) error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+	data, err := me.readFile()
+	if err != nil {
+		return err
+	}
+	if len(data) < 14 || data[0] != 'B' || data[1] != 'M' {
+		return tok.errf("not a bitmap file")
+	}
+	return me.add(tok, IdRt(RT_BITMAP), name, attrs, data[14:])
+}
+
+// Parses an ICON or CURSOR resource. Each image of the .ico/.cur file becomes
+// an individual RT_ICON/RT_CURSOR resource, with an automatic ID, and the
+// group becomes an RT_GROUP_ICON/RT_GROUP_CURSOR resource with the given name.
+func (me *_RcCompiler) parseIconOrCursor(tok *_RcTok, name Id, isCursor bool) error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+	data, err := me.readFile()
+	if err != nil {
+		return err
+	}
+
+	imgType, grpType, fileType := RT_ICON, RT_GROUP_ICON, uint16(1)
+	if isCursor {
+		imgType, grpType, fileType = RT_CURSOR, RT_GROUP_CURSOR, 2
+	}
+
+	r := _BinReader{data: data}
+	r.U16() // reserved
+	if r.U16() != fileType || r.Err() != nil {
+		return tok.errf("not a valid %s file", strings.ToLower(tok.text))
+	}
+	count := int(r.U16())
+	grp := IconGroup{IsCursor: isCursor}
+
+	for i := 0; i < count; i++ {
+		width, height, colorCount := r.U8(), r.U8(), r.U8()
+		r.U8() // reserved
+		planes, bitCount := r.U16(), r.U16()
+		size, offset := r.U32(), r.U32()
+		if r.Err() != nil || uint64(offset)+uint64(size) > uint64(len(data)) {
+			return tok.errf("malformed %s file", strings.ToLower(tok.text))
+		}
+		img := data[offset : offset+size]
+
+		me.nextImgId[imgType]++
+		imgId := me.nextImgId[imgType]
+
+		if isCursor {
+			hotX, hotY := planes, bitCount // in .cur files, these fields hold the hotspot
+			planes, bitCount = rcDibPlanesBits(img)
+			imgData := make([]byte, 0, 4+len(img))
+			imgData = binary.LittleEndian.AppendUint16(imgData, hotX)
+			imgData = binary.LittleEndian.AppendUint16(imgData, hotY)
+			imgData = append(imgData, img...)
+			if err := me.add(tok, IdRt(imgType), IdInt(imgId), attrs, imgData); err != nil {
+				return err
+			}
+
+			grp.Entries = append(grp.Entries, IconGroupEntry{
+				Width:      rcIconDim(width),
+				Height:     rcIconDim(height),
+				Planes:     planes,
+				BitCount:   bitCount,
+				BytesInRes: uint32(len(imgData)),
+				Id:         imgId,
+			})
+		} else {
+			if planes == 0 || bitCount == 0 {
+				planes, bitCount = rcDibPlanesBits(img)
+			}
+			if err := me.add(tok, IdRt(imgType), IdInt(imgId), attrs, img); err != nil {
+				return err
+			}
+
+			grp.Entries = append(grp.Entries, IconGroupEntry{
+				Width:      rcIconDim(width),
+				Height:     rcIconDim(height),
+				ColorCount: colorCount,
+				Planes:     planes,
+				BitCount:   bitCount,
+				BytesInRes: size,
+				Id:         imgId,
+			})
+		}
+	}
+
+	return me.add(tok, IdRt(grpType), name, attrs, grp.Serialize())
+}
+
+// Returns the width or height of an icon or cursor image, stored in a byte
+// where zero means 256.
+func rcIconDim(dim uint8) uint16 {
+	if dim == 0 {
+		return 256
+	}
+	return uint16(dim)
+}
+
+// Returns the planes and bit count of an icon or cursor image, which is either
+// a DIB or a PNG.
+func rcDibPlanesBits(img []byte) (uint16, uint16) {
+	if bytes.HasPrefix(img, []byte("\x89PNG")) {
+		return 1, 32
+	} else if len(img) >= 16 {
+		return binary.LittleEndian.Uint16(img[12:]), binary.LittleEndian.Uint16(img[14:])
+	}
+	return 1, 0
+}
+
+// Parses a STRINGTABLE statement. Strings are grouped in blocks of 16, each
+// block becoming one RT_STRING resource, which are added after all statements
+// are parsed.
+func (me *_RcCompiler) parseStringTable() error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+	if err := me.s.expectBegin(); err != nil {
+		return err
+	}
+
+	for {
+		tok := me.s.peek()
+		if tok.isEnd() {
+			me.s.next()
+			return nil
+		}
+
+		id, err := me.s.exprU16()
+		if err != nil {
+			return err
+		}
+		me.s.skip(",")
+		text, err := me.parseString()
+		if err != nil {
+			return err
+		}
+
+		key := _RcStrBlockKey{attrs.lang, id/16 + 1}
+		block, ok := me.strTables[key]
+		if !ok {
+			block = &_RcStrBlock{attrs: attrs}
+			me.strTables[key] = block
+		}
+		if block.defined[id%16] {
+			return tok.errf("duplicate string ID: %d", id)
+		}
+		block.strs[id%16] = text
+		block.defined[id%16] = true
+	}
+}
+
+// Adds the RT_STRING resources of all parsed string tables.
+func (me *_RcCompiler) addStringTables() {
+	keys := make([]_RcStrBlockKey, 0, len(me.strTables))
+	for key := range me.strTables {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		if keys[a].lang != keys[b].lang {
+			return keys[a].lang < keys[b].lang
+		}
+		return keys[a].blockId < keys[b].blockId
+	})
+
+	for _, key := range keys {
+		block := me.strTables[key]
+		var bw _BinWriter
+		for _, s := range block.strs {
+			words := utf16.Encode([]rune(s))
+			bw.U16(uint16(len(words)))
+			for _, w := range words {
+				bw.U16(w)
+			}
+		}
+		me.rsrcs = append(me.rsrcs, Resource{
+			Type:            IdRt(RT_STRING),
+			Name:            IdInt(key.blockId),
+			LangId:          key.lang,
+			MemFlags:        block.attrs.memFlags,
+			Version:         block.attrs.version,
+			Characteristics: block.attrs.characteristics,
+			Data:            bw.Bytes(),
+		})
+	}
+}
+
+// Parses an ACCELERATORS resource.
+func (me *_RcCompiler) parseAccelerators(tok *_RcTok, name Id) error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+	if err := me.s.expectBegin(); err != nil {
+		return err
+	}
+
+	var bw _BinWriter
+	lastFlagsPos := -1
+
+	for {
+		evTok := me.s.peek()
+		if evTok.isEnd() {
+			me.s.next()
+			break
+		}
+
+		var key uint16
+		isChar := evTok.kind == _RcTokStr
+		if isChar {
+			me.s.next()
+			chars := []rune(evTok.text)
+			if len(chars) == 2 && chars[0] == '^' {
+				key = uint16(chars[1]&^0x20) - 0x40 // control character
+			} else if len(chars) == 1 {
+				key = uint16(chars[0])
+			} else {
+				return evTok.errf("invalid accelerator key: %s", evTok)
+			}
+		} else if key, err = me.s.exprU16(); err != nil {
+			return err
+		}
+
+		if err := me.s.expect(","); err != nil {
+			return err
+		}
+		cmdId, err := me.s.exprU16()
+		if err != nil {
+			return err
+		}
+
+		var flags uint16
+		for {
+			hadComma := me.s.skip(",")
+			optTok := me.s.peek()
+			opt, isOpt := rcAccelOpts[strings.ToUpper(optTok.text)]
+			if optTok.kind != _RcTokIdent || !isOpt {
+				if hadComma {
+					return optTok.errf("invalid accelerator option: %s", optTok)
+				}
+				break
+			}
+			me.s.next()
+			flags |= opt
+		}
+
+		if isChar && flags&rcAccelOpts["VIRTKEY"] != 0 && key >= 'a' && key <= 'z' {
+			key -= 'a' - 'A' // virtual keys of letters are uppercase
+		}
+
+		lastFlagsPos = bw.Len()
+		bw.U16(flags)
+		bw.U16(key)
+		bw.U16(cmdId)
+		bw.U16(0) // padding
+	}
+
+	if lastFlagsPos != -1 {
+		data := bw.Bytes()
+		data[lastFlagsPos] |= 0x80 // FLAST
+	}
+	return me.add(tok, IdRt(RT_ACCELERATOR), name, attrs, bw.Bytes())
+}
+
+// Options of an accelerator, and their ACCELTABLEENTRY flags.
+var rcAccelOpts = map[string]uint16{
+	"ASCII":    0x00,
+	"VIRTKEY":  0x01,
+	"NOINVERT": 0x02,
+	"SHIFT":    0x04,
+	"CONTROL":  0x08,
+	"ALT":      0x10,
+}
@@ -0,0 +1,363 @@
+package res
+
+import (
+	"strings"
+)
+
+// Definition of a control statement, other than CONTROL.
+type _RcCtlDef struct {
+	class   string
+	style   uint32 // default style, besides WS_CHILD and WS_VISIBLE
+	hasText bool
+}
+
+// Control statements, and their classes and default styles.
+var rcCtlDefs = map[string]_RcCtlDef{
+	"AUTO3STATE":      {"BUTTON", 0x0006 | _WS_TABSTOP, true},
+	"AUTOCHECKBOX":    {"BUTTON", 0x0003 | _WS_TABSTOP, true},
+	"AUTORADIOBUTTON": {"BUTTON", 0x0009 | _WS_TABSTOP, true},
+	"CHECKBOX":        {"BUTTON", 0x0002 | _WS_TABSTOP, true},
+	"COMBOBOX":        {"COMBOBOX", 0x0001 | _WS_TABSTOP, false},
+	"CTEXT":           {"STATIC", 0x0001 | _WS_GROUP, true},
+	"DEFPUSHBUTTON":   {"BUTTON", 0x0001 | _WS_TABSTOP, true},
+	"EDITTEXT":        {"EDIT", _WS_BORDER | _WS_TABSTOP, false},
+	"GROUPBOX":        {"BUTTON", 0x0007, true},
+	"ICON":            {"STATIC", 0x0003, true},
+	"LISTBOX":         {"LISTBOX", 0x0001 | _WS_BORDER, false},
+	"LTEXT":           {"STATIC", 0x0000 | _WS_GROUP, true},
+	"PUSHBOX":         {"BUTTON", 0x000a | _WS_TABSTOP, true},
+	"PUSHBUTTON":      {"BUTTON", 0x0000 | _WS_TABSTOP, true},
+	"RADIOBUTTON":     {"BUTTON", 0x0004 | _WS_TABSTOP, true},
+	"RTEXT":           {"STATIC", 0x0002 | _WS_GROUP, true},
+	"SCROLLBAR":       {"SCROLLBAR", 0x0000, false},
+	"STATE3":          {"BUTTON", 0x0005 | _WS_TABSTOP, true},
+}
+
+// Parses a DIALOG or DIALOGEX resource.
+func (me *_RcCompiler) parseDialog(tok *_RcTok, name Id, ex bool) error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+
+	dlg := Dialog{
+		Ex:    ex,
+		Menu:  IdStr(""),
+		Class: IdStr(""),
+	}
+	coords, err := me.parseCommaList(4)
+	if err != nil {
+		return err
+	}
+	dlg.X, dlg.Y, dlg.Cx, dlg.Cy = int16(coords[0]), int16(coords[1]), int16(coords[2]), int16(coords[3])
+	if ex && me.s.skip(",") {
+		if dlg.HelpId, err = me.s.exprU32(); err != nil {
+			return err
+		}
+	}
+
+	style := _WS_POPUP | _WS_BORDER | _WS_SYSMENU
+	hasCaption, hasFont := false, false
+
+	for !me.s.peek().isBegin() {
+		stmt := me.s.next()
+		switch strings.ToUpper(stmt.text) {
+		case "STYLE":
+			style, err = me.parseStyle(0)
+		case "EXSTYLE":
+			dlg.ExStyle, err = me.parseStyle(0)
+		case "CAPTION":
+			hasCaption = true
+			dlg.Title, err = me.parseString()
+		case "CLASS":
+			if me.s.peek().kind == _RcTokStr {
+				dlg.Class = IdStr(me.s.next().text)
+			} else {
+				var atom uint16
+				atom, err = me.s.exprU16()
+				dlg.Class = IdInt(atom)
+			}
+		case "MENU":
+			dlg.Menu, err = me.parseNameOrId()
+		case "FONT":
+			hasFont = true
+			err = me.parseDialogFont(&dlg)
+		case "LANGUAGE":
+			attrs.lang, err = me.parseLanguage()
+		case "VERSION":
+			attrs.version, err = me.s.exprU32()
+		case "CHARACTERISTICS":
+			attrs.characteristics, err = me.s.exprU32()
+		default:
+			return stmt.errf("unexpected %s in dialog", stmt)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	me.s.next() // BEGIN
+
+	if hasCaption {
+		style |= _WS_CAPTION
+	}
+	if hasFont {
+		style |= _DS_SETFONT
+	}
+	dlg.Style = style
+
+	for {
+		ctlTok := me.s.next()
+		if ctlTok.isEnd() {
+			break
+		} else if ctlTok.kind != _RcTokIdent {
+			return ctlTok.errf("expected control, found %s", ctlTok)
+		}
+
+		var item DialogItem
+		kw := strings.ToUpper(ctlTok.text)
+		if kw == "CONTROL" {
+			err = me.parseControl(&item)
+		} else if def, ok := rcCtlDefs[kw]; ok {
+			err = me.parseCtlStatement(&item, kw, def)
+		} else {
+			return ctlTok.errf("unknown control: %s", ctlTok)
+		}
+		if err != nil {
+			return err
+		}
+
+		if ex && me.s.peek().isBegin() { // creation data
+			me.s.next()
+			if item.Extra, err = me.parseRawData(); err != nil {
+				return err
+			}
+		}
+		dlg.Items = append(dlg.Items, item)
+	}
+
+	return me.add(tok, IdRt(RT_DIALOG), name, attrs, dlg.Serialize())
+}
+
+// Parses the arguments of the FONT statement of a dialog.
+func (me *_RcCompiler) parseDialogFont(dlg *Dialog) error {
+	var err error
+	if dlg.FontSize, err = me.s.exprU16(); err != nil {
+		return err
+	}
+	if err := me.s.expect(","); err != nil {
+		return err
+	}
+	if dlg.FontFace, err = me.parseString(); err != nil {
+		return err
+	}
+	if !dlg.Ex {
+		return nil
+	}
+
+	if me.s.skip(",") {
+		if dlg.FontWeight, err = me.s.exprU16(); err != nil {
+			return err
+		}
+	}
+	if me.s.skip(",") {
+		italic, err := me.s.exprU16()
+		if err != nil {
+			return err
+		}
+		dlg.FontItalic = uint8(italic)
+	}
+	if me.s.skip(",") {
+		charset, err := me.s.exprU16()
+		if err != nil {
+			return err
+		}
+		dlg.FontCharset = uint8(charset)
+	} else {
+		dlg.FontCharset = 1 // DEFAULT_CHARSET
+	}
+	return nil
+}
+
+// Parses a generic CONTROL statement:
+//
+//	CONTROL text, id, class, style, x, y, cx, cy [, exStyle [, helpId]]
+func (me *_RcCompiler) parseControl(item *DialogItem) error {
+	var err error
+	if item.Title, err = me.parseCtlText(); err != nil {
+		return err
+	}
+	if err := me.s.expect(","); err != nil {
+		return err
+	}
+	if item.Id, err = me.s.exprU32(); err != nil {
+		return err
+	}
+	if err := me.s.expect(","); err != nil {
+		return err
+	}
+
+	classTok := me.s.next()
+	switch classTok.kind {
+	case _RcTokStr, _RcTokIdent:
+		item.Class = dlgClassId(classTok.text)
+	default:
+		me.s.back()
+		atom, err := me.s.exprU16()
+		if err != nil {
+			return err
+		}
+		item.Class = IdInt(atom)
+	}
+	if err := me.s.expect(","); err != nil {
+		return err
+	}
+
+	if item.Style, err = me.parseStyle(_WS_CHILD | _WS_VISIBLE); err != nil {
+		return err
+	}
+	if err := me.s.expect(","); err != nil {
+		return err
+	}
+	coords, err := me.parseCommaList(4)
+	if err != nil {
+		return err
+	}
+	item.X, item.Y, item.Cx, item.Cy = int16(coords[0]), int16(coords[1]), int16(coords[2]), int16(coords[3])
+	return me.parseCtlTail(item, false)
+}
+
+// Parses a control statement other than CONTROL:
+//
+//	KEYWORD [text,] id, x, y, cx, cy [, style [, exStyle [, helpId]]]
+//
+// For ICON, cx and cy are optional.
+func (me *_RcCompiler) parseCtlStatement(item *DialogItem, kw string, def _RcCtlDef) error {
+	var err error
+	item.Class = dlgClassId(def.class)
+	item.Style = _WS_CHILD | _WS_VISIBLE | def.style
+	item.Title = IdStr("")
+
+	if def.hasText {
+		if item.Title, err = me.parseCtlText(); err != nil {
+			return err
+		}
+		if err := me.s.expect(","); err != nil {
+			return err
+		}
+	}
+	if item.Id, err = me.s.exprU32(); err != nil {
+		return err
+	}
+	if err := me.s.expect(","); err != nil {
+		return err
+	}
+
+	numCoords := 4
+	if kw == "ICON" {
+		numCoords = 2
+	}
+	coords, err := me.parseCommaList(numCoords)
+	if err != nil {
+		return err
+	}
+	item.X, item.Y = int16(coords[0]), int16(coords[1])
+	if kw == "ICON" {
+		if me.s.skip(",") {
+			if coords, err = me.parseCommaList(2); err != nil {
+				return err
+			}
+			item.Cx, item.Cy = int16(coords[0]), int16(coords[1])
+		}
+	} else {
+		item.Cx, item.Cy = int16(coords[2]), int16(coords[3])
+	}
+
+	return me.parseCtlTail(item, true)
+}
+
+// Parses the optional trailing arguments of a control statement:
+//
+//	[, style] [, exStyle [, helpId]]
+func (me *_RcCompiler) parseCtlTail(item *DialogItem, hasStyle bool) error {
+	var err error
+	if hasStyle && me.s.skip(",") {
+		if item.Style, err = me.parseStyle(item.Style); err != nil {
+			return err
+		}
+	}
+	if me.s.skip(",") {
+		if item.ExStyle, err = me.parseStyle(0); err != nil {
+			return err
+		}
+	}
+	if me.s.skip(",") {
+		if item.HelpId, err = me.s.exprU32(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parses the text of a control, which can be a string or a resource ID, as
+// for ICON controls.
+func (me *_RcCompiler) parseCtlText() (Id, error) {
+	tok := me.s.peek()
+	switch tok.kind {
+	case _RcTokStr:
+		text, err := me.parseString()
+		return IdStr(text), err
+	case _RcTokIdent:
+		me.s.next()
+		return IdStr(strings.ToUpper(tok.text)), nil
+	default:
+		n, err := me.s.exprU16()
+		return IdInt(n), err
+	}
+}
+
+// Parses a style expression, starting from the given base style. Besides the
+// usual operators, the NOT keyword removes a style:
+//
+//	WS_CHILD | WS_VISIBLE | NOT WS_TABSTOP
+func (me *_RcCompiler) parseStyle(base uint32) (uint32, error) {
+	style := base
+	for {
+		isNot := false
+		if me.s.peek().isKw("NOT") {
+			me.s.next()
+			isNot = true
+		}
+
+		val, _, err := me.s.binExpr(rcBinOps["|"] + 1)
+		if err != nil {
+			return 0, err
+		}
+		if isNot {
+			style &^= uint32(val)
+		} else {
+			style |= uint32(val)
+		}
+
+		if !me.s.skip("|") {
+			return style, nil
+		}
+	}
+}
+
+// Parses a list of comma-separated expressions with the given length.
+func (me *_RcCompiler) parseCommaList(count int) ([]int64, error) {
+	vals := make([]int64, 0, count)
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			if err := me.s.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		val, _, err := me.s.expr()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
@@ -0,0 +1,487 @@
+package res
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Kind of a resource script token.
+type _RcTokKind uint8
+
+const (
+	_RcTokEof _RcTokKind = iota
+	_RcTokIdent
+	_RcTokNum
+	_RcTokStr
+	_RcTokPunct
+)
+
+// A single token of a resource script.
+type _RcTok struct {
+	kind  _RcTokKind
+	text  string // identifier, punctuator, or decoded string contents
+	raw   string // string contents without escape processing
+	bytes []byte // contents of narrow strings, as written in the file
+	num   int64  // value of numbers
+	long  bool   // number with L suffix, or L"" string
+	file  string
+	line  int
+}
+
+// Returns true if the token is the given punctuator.
+func (me *_RcTok) is(punct string) bool {
+	return me.kind == _RcTokPunct && me.text == punct
+}
+
+// Returns true if the token is the given keyword, which is case-insensitive.
+func (me *_RcTok) isKw(kw string) bool {
+	return me.kind == _RcTokIdent && strings.EqualFold(me.text, kw)
+}
+
+// Returns true if the token is BEGIN or an opening brace.
+func (me *_RcTok) isBegin() bool {
+	return me.is("{") || me.isKw("BEGIN")
+}
+
+// Returns true if the token is END or a closing brace.
+func (me *_RcTok) isEnd() bool {
+	return me.is("}") || me.isKw("END")
+}
+
+// Returns a printable representation of the token, used in error messages.
+func (me *_RcTok) String() string {
+	switch me.kind {
+	case _RcTokEof:
+		return "end of file"
+	case _RcTokStr:
+		return fmt.Sprintf("%q", me.text)
+	case _RcTokNum:
+		return strconv.FormatInt(me.num, 10)
+	default:
+		return me.text
+	}
+}
+
+// Returns an error prefixed with the token position.
+func (me *_RcTok) errf(format string, a ...any) error {
+	return fmt.Errorf("%s:%d: %s", me.file, me.line, fmt.Sprintf(format, a...))
+}
+
+// Splits a single line of source into tokens.
+func rcTokenize(line, file string, lineNo int) ([]_RcTok, error) {
+	toks := make([]_RcTok, 0, 16) // arbitrary
+	i := 0
+
+	for i < len(line) {
+		ch := line[i]
+		tok := _RcTok{file: file, line: lineNo}
+
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\r' || ch == '\f' || ch == '\v':
+			i++
+			continue
+
+		case ch == '"' || ((ch == 'L' || ch == 'l') && i+1 < len(line) && line[i+1] == '"'):
+			if ch != '"' {
+				tok.long = true
+				i++
+			}
+			n, err := rcScanString(line[i:], &tok)
+			if err != nil {
+				return nil, tok.errf("%s", err)
+			}
+			tok.kind = _RcTokStr
+			i += n
+
+		case ch >= '0' && ch <= '9':
+			start := i
+			for i < len(line) && (isRcIdentChar(line[i])) {
+				i++
+			}
+			lit := line[start:i]
+			digits := strings.TrimRight(lit, "uUlL")
+			tok.long = strings.ContainsAny(lit[len(digits):], "lL")
+			base := 10
+			if len(digits) > 2 && (digits[1] == 'x' || digits[1] == 'X') {
+				base, digits = 16, digits[2:]
+			} else if len(digits) > 2 && (digits[1] == 'o' || digits[1] == 'O') {
+				base, digits = 8, digits[2:]
+			}
+			val, err := strconv.ParseUint(digits, base, 32)
+			if err != nil {
+				return nil, tok.errf("invalid number: %s", lit)
+			}
+			tok.kind = _RcTokNum
+			tok.text = lit
+			tok.num = int64(val)
+
+		case isRcIdentStart(ch):
+			start := i
+			for i < len(line) && (isRcIdentChar(line[i]) || line[i] == '.' || line[i] == '\\') {
+				i++
+			}
+			tok.kind = _RcTokIdent
+			tok.text = line[start:i]
+
+		default:
+			tok.kind = _RcTokPunct
+			if i+1 < len(line) {
+				switch pair := line[i : i+2]; pair {
+				case "<<", ">>", "<=", ">=", "==", "!=", "&&", "||", "##":
+					tok.text = pair
+				}
+			}
+			if tok.text == "" {
+				if !strings.ContainsRune("{}(),|&^+-*/%~!<>=#", rune(ch)) {
+					return nil, tok.errf("unexpected character: %q", ch)
+				}
+				tok.text = line[i : i+1]
+			}
+			i += len(tok.text)
+		}
+
+		toks = append(toks, tok)
+	}
+	return toks, nil
+}
+
+func isRcIdentStart(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_' || ch == '$'
+}
+
+func isRcIdentChar(ch byte) bool {
+	return isRcIdentStart(ch) || (ch >= '0' && ch <= '9')
+}
+
+// Scans a quoted string at the beginning of s, filling the text, raw and bytes
+// fields of the token. Returns the number of bytes consumed.
+//
+// Besides C escape sequences, two consecutive quotes inside the string produce
+// a single quote, as in the resource compiler.
+func rcScanString(s string, tok *_RcTok) (int, error) {
+	var raw strings.Builder
+	var runes []rune // wide strings
+	var bytes []byte // narrow strings
+
+	put := func(val uint32) {
+		if tok.long {
+			runes = append(runes, rune(val))
+		} else {
+			bytes = append(bytes, byte(val))
+		}
+	}
+
+	i := 1 // skip opening quote
+	for {
+		if i >= len(s) {
+			return 0, fmt.Errorf("unterminated string")
+		}
+		ch := s[i]
+
+		if ch == '"' {
+			if i+1 < len(s) && s[i+1] == '"' {
+				raw.WriteString(`""`)
+				put('"')
+				i += 2
+				continue
+			}
+			i++
+			break
+
+		} else if ch == '\\' && i+1 < len(s) {
+			start := i
+			i++
+			switch esc := s[i]; esc {
+			case 'a':
+				put(0x07)
+			case 'b':
+				put(0x08)
+			case 'f':
+				put(0x0c)
+			case 'n':
+				put('\n')
+			case 'r':
+				put('\r')
+			case 't':
+				put('\t')
+			case 'v':
+				put(0x0b)
+			case 'x', 'X':
+				maxDigits := 2
+				if tok.long {
+					maxDigits = 4
+				}
+				val, n := rcScanDigits(s[i+1:], 16, maxDigits)
+				put(val)
+				i += n
+			default:
+				if esc >= '0' && esc <= '7' {
+					val, n := rcScanDigits(s[i:], 8, 3)
+					put(val)
+					i += n - 1
+				} else {
+					put(uint32(esc)) // \\, \", \' and unknown escapes
+				}
+			}
+			i++
+			raw.WriteString(s[start:i])
+
+		} else if tok.long {
+			r, n := utf8.DecodeRuneInString(s[i:])
+			runes = append(runes, r)
+			raw.WriteString(s[i : i+n])
+			i += n
+
+		} else {
+			bytes = append(bytes, ch)
+			raw.WriteByte(ch)
+			i++
+		}
+	}
+
+	tok.raw = raw.String()
+	if tok.long {
+		tok.text = string(runes)
+	} else {
+		tok.bytes = bytes
+		tok.text = rcNarrowToText(bytes)
+	}
+	return i, nil
+}
+
+// Parses up to maxDigits digits in the given base, returning the value and
+// the number of digits consumed.
+func rcScanDigits(s string, base, maxDigits int) (uint32, int) {
+	n := 0
+	for n < len(s) && n < maxDigits {
+		ch := s[n]
+		isDigit := (ch >= '0' && ch <= '9' && int(ch-'0') < base) ||
+			(base == 16 && ((ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')))
+		if !isDigit {
+			break
+		}
+		n++
+	}
+	val, _ := strconv.ParseUint(s[:n], base, 32)
+	return uint32(val), n
+}
+
+// Converts the bytes of a narrow string into text. Valid UTF-8 is kept as it
+// is; otherwise, each byte is taken as a Latin-1 character.
+func rcNarrowToText(bytes []byte) string {
+	if utf8.Valid(bytes) {
+		return string(bytes)
+	}
+	runes := make([]rune, 0, len(bytes))
+	for _, b := range bytes {
+		runes = append(runes, rune(b))
+	}
+	return string(runes)
+}
+
+// Sequential reader of tokens.
+type _RcStream struct {
+	toks          []_RcTok
+	pos           int
+	identsAreZero bool // undefined identifiers evaluate to zero, as in #if
+}
+
+// Returns the current token without consuming it.
+func (me *_RcStream) peek() *_RcTok {
+	if me.pos < len(me.toks) {
+		return &me.toks[me.pos]
+	}
+	eof := _RcTok{kind: _RcTokEof}
+	if len(me.toks) > 0 {
+		last := me.toks[len(me.toks)-1]
+		eof.file, eof.line = last.file, last.line
+	}
+	return &eof
+}
+
+// Returns the current token and advances.
+func (me *_RcStream) next() *_RcTok {
+	tok := me.peek()
+	if me.pos < len(me.toks) {
+		me.pos++
+	}
+	return tok
+}
+
+// Goes back one token.
+func (me *_RcStream) back() {
+	me.pos--
+}
+
+// Returns true if all tokens were consumed.
+func (me *_RcStream) atEof() bool {
+	return me.pos >= len(me.toks)
+}
+
+// Consumes the current token if it's the given punctuator.
+func (me *_RcStream) skip(punct string) bool {
+	if me.peek().is(punct) {
+		me.pos++
+		return true
+	}
+	return false
+}
+
+// Consumes the given punctuator, or fails.
+func (me *_RcStream) expect(punct string) error {
+	if tok := me.next(); !tok.is(punct) {
+		return tok.errf("expected %q, found %s", punct, tok)
+	}
+	return nil
+}
+
+// Consumes BEGIN or an opening brace, or fails.
+func (me *_RcStream) expectBegin() error {
+	if tok := me.next(); !tok.isBegin() {
+		return tok.errf("expected BEGIN, found %s", tok)
+	}
+	return nil
+}
+
+// Returns true if the current token can start an expression.
+func (me *_RcStream) atExpr() bool {
+	tok := me.peek()
+	return tok.kind == _RcTokNum ||
+		(tok.kind == _RcTokIdent && me.identsAreZero) ||
+		tok.is("(") || tok.is("-") || tok.is("+") || tok.is("~") || tok.is("!")
+}
+
+// Binary operators, and their precedence.
+var rcBinOps = map[string]int{
+	"||": 1, "&&": 2, "|": 3, "^": 4, "&": 5,
+	"==": 6, "!=": 6, "<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8, "+": 9, "-": 9, "*": 10, "/": 10, "%": 10,
+}
+
+// Evaluates an integer expression. Also returns whether any of the operands
+// has the L suffix.
+func (me *_RcStream) expr() (int64, bool, error) {
+	return me.binExpr(1)
+}
+
+// Evaluates an expression as an unsigned 32-bit integer.
+func (me *_RcStream) exprU32() (uint32, error) {
+	val, _, err := me.expr()
+	return uint32(val), err
+}
+
+// Evaluates an expression as an unsigned 16-bit integer.
+func (me *_RcStream) exprU16() (uint16, error) {
+	val, _, err := me.expr()
+	return uint16(val), err
+}
+
+func (me *_RcStream) binExpr(minPrec int) (int64, bool, error) {
+	lhs, long, err := me.unaryExpr()
+	if err != nil {
+		return 0, false, err
+	}
+
+	for {
+		opTok := me.peek()
+		prec, isOp := rcBinOps[opTok.text]
+		if opTok.kind != _RcTokPunct || !isOp || prec < minPrec {
+			return lhs, long, nil
+		}
+		me.next()
+
+		rhs, rhsLong, err := me.binExpr(prec + 1)
+		if err != nil {
+			return 0, false, err
+		}
+		long = long || rhsLong
+
+		switch opTok.text {
+		case "||":
+			lhs = rcBool(lhs != 0 || rhs != 0)
+		case "&&":
+			lhs = rcBool(lhs != 0 && rhs != 0)
+		case "|":
+			lhs |= rhs
+		case "^":
+			lhs ^= rhs
+		case "&":
+			lhs &= rhs
+		case "==":
+			lhs = rcBool(lhs == rhs)
+		case "!=":
+			lhs = rcBool(lhs != rhs)
+		case "<":
+			lhs = rcBool(lhs < rhs)
+		case ">":
+			lhs = rcBool(lhs > rhs)
+		case "<=":
+			lhs = rcBool(lhs <= rhs)
+		case ">=":
+			lhs = rcBool(lhs >= rhs)
+		case "<<":
+			lhs <<= uint64(rhs) & 63
+		case ">>":
+			lhs >>= uint64(rhs) & 63
+		case "+":
+			lhs += rhs
+		case "-":
+			lhs -= rhs
+		case "*":
+			lhs *= rhs
+		case "/", "%":
+			if rhs == 0 {
+				return 0, false, opTok.errf("division by zero")
+			} else if opTok.text == "/" {
+				lhs /= rhs
+			} else {
+				lhs %= rhs
+			}
+		}
+	}
+}
+
+func (me *_RcStream) unaryExpr() (int64, bool, error) {
+	tok := me.next()
+	switch {
+	case tok.is("-"), tok.is("+"), tok.is("~"), tok.is("!"):
+		val, long, err := me.unaryExpr()
+		switch tok.text {
+		case "-":
+			val = -val
+		case "~":
+			val = ^val
+		case "!":
+			val = rcBool(val == 0)
+		}
+		return val, long, err
+
+	case tok.is("("):
+		val, long, err := me.expr()
+		if err != nil {
+			return 0, false, err
+		}
+		return val, long, me.expect(")")
+
+	case tok.kind == _RcTokNum:
+		return tok.num, tok.long, nil
+
+	case tok.kind == _RcTokIdent && me.identsAreZero:
+		return 0, false, nil
+
+	case tok.kind == _RcTokIdent:
+		return 0, false, tok.errf("undefined symbol: %s", tok.text)
+
+	default:
+		return 0, false, tok.errf("expected expression, found %s", tok)
+	}
+}
+
+func rcBool(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+package res
+
+import (
+	"strings"
+)
+
+// Options of MENU items, and their flags.
+var rcMenuOpts = map[string]uint16{
+	"GRAYED":       0x0001,
+	"INACTIVE":     0x0002,
+	"CHECKED":      0x0008,
+	"MENUBARBREAK": 0x0020,
+	"MENUBREAK":    0x0040,
+	"OWNERDRAW":    0x0100,
+	"HELP":         0x4000,
+}
+
+const _MFT_SEPARATOR uint32 = 0x0800
+
+// Parses a MENU or MENUEX resource.
+func (me *_RcCompiler) parseMenu(tok *_RcTok, name Id, ex bool) error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+	if err := me.s.expectBegin(); err != nil {
+		return err
+	}
+	items, err := me.parseMenuItems(ex)
+	if err != nil {
+		return err
+	}
+
+	menu := Menu{Ex: ex, Items: items}
+	return me.add(tok, IdRt(RT_MENU), name, attrs, menu.Serialize())
+}
+
+// Parses menu items, after BEGIN, until END.
+func (me *_RcCompiler) parseMenuItems(ex bool) ([]MenuItem, error) {
+	items := make([]MenuItem, 0, 8) // arbitrary
+
+	for {
+		tok := me.s.next()
+		if tok.isEnd() {
+			return items, nil
+		}
+
+		var item MenuItem
+		switch strings.ToUpper(tok.text) {
+		case "MENUITEM":
+			if me.s.peek().isKw("SEPARATOR") {
+				me.s.next()
+				if ex {
+					item.Type = _MFT_SEPARATOR
+				}
+				items = append(items, item)
+				continue
+			}
+		case "POPUP":
+			item.Popup = true
+		default:
+			return nil, tok.errf("expected MENUITEM or POPUP, found %s", tok)
+		}
+
+		var err error
+		if item.Text, err = me.parseString(); err != nil {
+			return nil, err
+		}
+		if ex {
+			err = me.parseMenuExArgs(&item)
+		} else {
+			err = me.parseMenuArgs(&item)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if item.Popup {
+			if err := me.s.expectBegin(); err != nil {
+				return nil, err
+			}
+			if item.Children, err = me.parseMenuItems(ex); err != nil {
+				return nil, err
+			}
+		}
+		items = append(items, item)
+	}
+}
+
+// Parses the arguments of a MENU item, after its text:
+//
+//	MENUITEM text, id [, options...]
+//	POPUP text [, options...]
+func (me *_RcCompiler) parseMenuArgs(item *MenuItem) error {
+	if !item.Popup {
+		if err := me.s.expect(","); err != nil {
+			return err
+		}
+		id, err := me.s.exprU16()
+		if err != nil {
+			return err
+		}
+		item.Id = uint32(id)
+	}
+
+	for {
+		hadComma := me.s.skip(",")
+		optTok := me.s.peek()
+		opt, isOpt := rcMenuOpts[strings.ToUpper(optTok.text)]
+		if optTok.kind != _RcTokIdent || !isOpt {
+			if hadComma {
+				return optTok.errf("invalid menu option: %s", optTok)
+			}
+			return nil
+		}
+		me.s.next()
+		item.Flags |= opt
+	}
+}
+
+// Parses the arguments of a MENUEX item, after its text. All of them are
+// optional, and can be left empty:
+//
+//	MENUITEM text [, id [, type [, state]]]
+//	POPUP text [, id [, type [, state [, helpId]]]]
+func (me *_RcCompiler) parseMenuExArgs(item *MenuItem) error {
+	fields := []*uint32{&item.Id, &item.Type, &item.State}
+	if item.Popup {
+		fields = append(fields, &item.HelpId)
+	}
+
+	for _, field := range fields {
+		if !me.s.skip(",") {
+			return nil
+		}
+		if me.s.atExpr() {
+			val, err := me.s.exprU32()
+			if err != nil {
+				return err
+			}
+			*field = val
+		}
+	}
+	return nil
+}
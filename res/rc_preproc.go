@@ -0,0 +1,491 @@
+package res
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"unicode/utf16"
+)
+
+// A #define'd macro.
+type _RcMacro struct {
+	funcLike bool
+	params   []string
+	body     []_RcTok
+}
+
+// State of an #if block.
+type _RcCond struct {
+	parentActive bool // whether the enclosing block is being processed
+	active       bool // whether the current branch is being processed
+	taken        bool // whether any branch was already processed
+}
+
+// C preprocessor, which outputs the tokens of a resource script with all
+// directives processed and all macros expanded.
+type _RcPreproc struct {
+	fsys   fs.FS
+	macros map[string]*_RcMacro // a nil value marks an #undef'd builtin
+	out    []_RcTok
+	depth  int
+}
+
+// Headers of the Windows SDK, which are ignored by #include when not found,
+// since their symbols are predefined.
+var rcSdkHeaders = map[string]struct{}{
+	"afxres.h": {}, "commctrl.h": {}, "dlgs.h": {}, "richedit.h": {},
+	"verrsrc.h": {}, "windows.h": {}, "winnt.h": {}, "winres.h": {},
+	"winresrc.h": {}, "winuser.h": {}, "winuser.rh": {}, "winver.h": {},
+}
+
+func newRcPreproc(fsys fs.FS) *_RcPreproc {
+	return &_RcPreproc{
+		fsys: fsys,
+		macros: map[string]*_RcMacro{
+			"RC_INVOKED": {body: []_RcTok{{kind: _RcTokNum, text: "1", num: 1}}},
+			"_WIN32":     {body: []_RcTok{{kind: _RcTokNum, text: "1", num: 1}}},
+		},
+	}
+}
+
+// Returns the macro with the given name, or nil.
+func (me *_RcPreproc) macro(name string) *_RcMacro {
+	if m, ok := me.macros[name]; ok {
+		return m
+	} else if n, ok := rcBuiltinNumbers[name]; ok {
+		return &_RcMacro{body: []_RcTok{{kind: _RcTokNum, text: name, num: n}}}
+	} else if s, ok := rcBuiltinStrings[name]; ok {
+		return &_RcMacro{body: []_RcTok{{kind: _RcTokStr, text: s, raw: s, bytes: []byte(s)}}}
+	}
+	return nil
+}
+
+// Processes a source file, appending its tokens to the output. Header files
+// only have their directives processed, like the resource compiler does.
+func (me *_RcPreproc) processFile(name string) error {
+	if me.depth > 32 { // arbitrary
+		return fmt.Errorf("%s: #include nested too deeply", name)
+	}
+	me.depth++
+	defer func() { me.depth-- }()
+
+	src, err := fs.ReadFile(me.fsys, name)
+	if err != nil {
+		return err
+	}
+
+	onlyDirectives := false
+	switch strings.ToLower(path.Ext(name)) {
+	case ".h", ".hh", ".hpp", ".hxx", ".c", ".cpp", ".cxx":
+		onlyDirectives = true
+	}
+
+	lines := rcSourceLines(rcDecodeSource(src))
+	conds := make([]_RcCond, 0, 8) // arbitrary
+	isActive := func() bool {
+		return len(conds) == 0 || conds[len(conds)-1].active
+	}
+
+	for idx, line := range lines {
+		lineNo := idx + 1
+		trimmed := strings.TrimLeft(line, " \t")
+
+		if !strings.HasPrefix(trimmed, "#") {
+			if isActive() && !onlyDirectives {
+				toks, err := rcTokenize(line, name, lineNo)
+				if err != nil {
+					return err
+				}
+				me.out = append(me.out, me.expand(toks, nil)...)
+			}
+			continue
+		}
+
+		directive := strings.TrimLeft(trimmed[1:], " \t")
+		word := directive
+		if n := strings.IndexAny(directive, " \t(\"<"); n != -1 {
+			word = directive[:n]
+		}
+		rest := strings.TrimSpace(directive[len(word):])
+		errTok := _RcTok{file: name, line: lineNo}
+
+		switch word {
+		case "if", "ifdef", "ifndef":
+			cond := _RcCond{parentActive: isActive()}
+			if cond.parentActive {
+				val, err := me.evalCond(word, rest, name, lineNo)
+				if err != nil {
+					return err
+				}
+				cond.active, cond.taken = val, val
+			} else {
+				cond.taken = true
+			}
+			conds = append(conds, cond)
+
+		case "elif":
+			if len(conds) == 0 {
+				return errTok.errf("#elif without #if")
+			}
+			cond := &conds[len(conds)-1]
+			if cond.taken {
+				cond.active = false
+			} else {
+				val, err := me.evalCond(word, rest, name, lineNo)
+				if err != nil {
+					return err
+				}
+				cond.active, cond.taken = val, val
+			}
+
+		case "else":
+			if len(conds) == 0 {
+				return errTok.errf("#else without #if")
+			}
+			cond := &conds[len(conds)-1]
+			cond.active = cond.parentActive && !cond.taken
+			cond.taken = true
+
+		case "endif":
+			if len(conds) == 0 {
+				return errTok.errf("#endif without #if")
+			}
+			conds = conds[:len(conds)-1]
+
+		default:
+			if !isActive() {
+				continue
+			}
+			switch word {
+			case "define":
+				if err := me.define(rest, name, lineNo); err != nil {
+					return err
+				}
+			case "undef":
+				me.macros[rest] = nil
+			case "include":
+				if err := me.include(rest, name, lineNo); err != nil {
+					return err
+				}
+			case "error":
+				return errTok.errf("#error %s", rest)
+			case "pragma", "line", "":
+				// ignored
+			default:
+				return errTok.errf("unknown directive: #%s", word)
+			}
+		}
+	}
+
+	if len(conds) > 0 {
+		return fmt.Errorf("%s: unterminated #if", name)
+	}
+	return nil
+}
+
+// Evaluates the condition of #if, #ifdef, #ifndef or #elif.
+func (me *_RcPreproc) evalCond(word, rest, file string, lineNo int) (bool, error) {
+	toks, err := rcTokenize(rest, file, lineNo)
+	if err != nil {
+		return false, err
+	}
+
+	if word == "ifdef" || word == "ifndef" {
+		if len(toks) == 0 || toks[0].kind != _RcTokIdent {
+			return false, (&_RcTok{file: file, line: lineNo}).errf("#%s without macro name", word)
+		}
+		defined := me.macro(toks[0].text) != nil
+		return defined == (word == "ifdef"), nil
+	}
+
+	// Replace "defined X" and "defined(X)" before expanding the macros.
+	replaced := make([]_RcTok, 0, len(toks))
+	for i := 0; i < len(toks); i++ {
+		if toks[i].kind != _RcTokIdent || toks[i].text != "defined" {
+			replaced = append(replaced, toks[i])
+			continue
+		}
+		j := i + 1
+		paren := j < len(toks) && toks[j].is("(")
+		if paren {
+			j++
+		}
+		if j >= len(toks) || toks[j].kind != _RcTokIdent {
+			return false, toks[i].errf("defined without macro name")
+		}
+		val := rcBool(me.macro(toks[j].text) != nil)
+		if paren {
+			j++
+			if j >= len(toks) || !toks[j].is(")") {
+				return false, toks[i].errf("expected \")\" after defined")
+			}
+		}
+		replaced = append(replaced, _RcTok{kind: _RcTokNum, num: val, file: file, line: lineNo})
+		i = j
+	}
+
+	s := _RcStream{toks: me.expand(replaced, nil), identsAreZero: true}
+	val, _, err := s.expr()
+	if err != nil {
+		return false, err
+	} else if !s.atEof() {
+		return false, s.peek().errf("unexpected %s in #%s", s.peek(), word)
+	}
+	return val != 0, nil
+}
+
+// Processes a #define directive.
+func (me *_RcPreproc) define(rest, file string, lineNo int) error {
+	errTok := _RcTok{file: file, line: lineNo}
+	n := 0
+	for n < len(rest) && isRcIdentChar(rest[n]) {
+		n++
+	}
+	if n == 0 || !isRcIdentStart(rest[0]) {
+		return errTok.errf("#define without macro name")
+	}
+	macroName := rest[:n]
+	macro := &_RcMacro{}
+	rest = rest[n:]
+
+	if strings.HasPrefix(rest, "(") { // function-like: no space before paren
+		end := strings.IndexByte(rest, ')')
+		if end == -1 {
+			return errTok.errf("missing \")\" in macro parameter list")
+		}
+		macro.funcLike = true
+		for _, param := range strings.Split(rest[1:end], ",") {
+			if param = strings.TrimSpace(param); param != "" {
+				macro.params = append(macro.params, param)
+			}
+		}
+		rest = rest[end+1:]
+	}
+
+	body, err := rcTokenize(rest, file, lineNo)
+	if err != nil {
+		return err
+	}
+	macro.body = body
+	me.macros[macroName] = macro
+	return nil
+}
+
+// Processes an #include directive.
+func (me *_RcPreproc) include(rest, file string, lineNo int) error {
+	errTok := _RcTok{file: file, line: lineNo}
+	if len(rest) < 2 {
+		return errTok.errf("#include without file name")
+	}
+
+	var incName string
+	isSystem := rest[0] == '<'
+	if isSystem {
+		end := strings.IndexByte(rest, '>')
+		if end == -1 {
+			return errTok.errf("missing \">\" in #include")
+		}
+		incName = rest[1:end]
+	} else if rest[0] == '"' {
+		end := strings.IndexByte(rest[1:], '"')
+		if end == -1 {
+			return errTok.errf("missing '\"' in #include")
+		}
+		incName = rest[1 : end+1]
+	} else {
+		return errTok.errf("invalid #include: %s", rest)
+	}
+
+	candidates := []string{rcCleanPath(incName)}
+	if !isSystem {
+		candidates = append([]string{rcCleanPath(path.Join(path.Dir(file), incName))}, candidates...)
+	}
+	for _, candidate := range candidates {
+		if _, err := fs.Stat(me.fsys, candidate); err == nil {
+			return me.processFile(candidate)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return errTok.errf("%s", err)
+		}
+	}
+
+	if _, isSdk := rcSdkHeaders[strings.ToLower(path.Base(rcCleanPath(incName)))]; isSdk || isSystem {
+		return nil // symbols of system headers are predefined
+	}
+	return errTok.errf("#include file not found: %s", incName)
+}
+
+// Expands the macros in the tokens. Macros in the hide set are not expanded, to
+// prevent infinite recursion.
+func (me *_RcPreproc) expand(toks []_RcTok, hide map[string]struct{}) []_RcTok {
+	out := make([]_RcTok, 0, len(toks))
+
+	for i := 0; i < len(toks); i++ {
+		tok := toks[i]
+		if tok.kind != _RcTokIdent {
+			out = append(out, tok)
+			continue
+		}
+		macro := me.macro(tok.text)
+		if _, hidden := hide[tok.text]; hidden || macro == nil {
+			out = append(out, tok)
+			continue
+		}
+
+		var body []_RcTok
+		if macro.funcLike {
+			if i+1 >= len(toks) || !toks[i+1].is("(") {
+				out = append(out, tok) // name of a function-like macro without arguments
+				continue
+			}
+			args, next := rcMacroArgs(toks, i+2)
+			for _, bodyTok := range macro.body {
+				if idx := rcIndexOf(macro.params, bodyTok.text); bodyTok.kind == _RcTokIdent && idx != -1 {
+					if idx < len(args) {
+						body = append(body, me.expand(args[idx], hide)...)
+					}
+				} else {
+					body = append(body, bodyTok)
+				}
+			}
+			i = next - 1
+		} else {
+			body = append(body, macro.body...)
+		}
+
+		for j := range body { // errors must point to where the macro was used
+			body[j].file, body[j].line = tok.file, tok.line
+		}
+		newHide := make(map[string]struct{}, len(hide)+1)
+		for name := range hide {
+			newHide[name] = struct{}{}
+		}
+		newHide[tok.text] = struct{}{}
+		out = append(out, me.expand(body, newHide)...)
+	}
+
+	return out
+}
+
+// Collects the arguments of a function-like macro call, starting right after
+// the opening parenthesis. Returns the arguments and the index after the
+// closing parenthesis.
+func rcMacroArgs(toks []_RcTok, start int) ([][]_RcTok, int) {
+	args := make([][]_RcTok, 0, 4) // arbitrary
+	cur := make([]_RcTok, 0, 4)
+	depth := 0
+
+	i := start
+	for ; i < len(toks); i++ {
+		tok := toks[i]
+		if tok.is("(") {
+			depth++
+		} else if tok.is(")") {
+			if depth == 0 {
+				i++
+				break
+			}
+			depth--
+		} else if tok.is(",") && depth == 0 {
+			args = append(args, cur)
+			cur = make([]_RcTok, 0, 4)
+			continue
+		}
+		cur = append(cur, tok)
+	}
+	if len(cur) > 0 || len(args) > 0 {
+		args = append(args, cur)
+	}
+	return args, i
+}
+
+func rcIndexOf(names []string, name string) int {
+	for i := range names {
+		if names[i] == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Converts the raw bytes of a source file into text, handling UTF-16 and UTF-8
+// byte order marks.
+func rcDecodeSource(src []byte) string {
+	if len(src) >= 2 && src[0] == 0xff && src[1] == 0xfe {
+		words := make([]uint16, 0, len(src)/2)
+		for i := 2; i+1 < len(src); i += 2 {
+			words = append(words, uint16(src[i])|uint16(src[i+1])<<8)
+		}
+		return string(utf16.Decode(words))
+	} else if len(src) >= 3 && src[0] == 0xef && src[1] == 0xbb && src[2] == 0xbf {
+		return string(src[3:])
+	}
+	return rcNarrowToText(src)
+}
+
+// Splits the source into lines, with comments replaced by spaces and
+// backslash-continued lines joined. The number of lines is preserved, so line
+// numbers still match the file.
+func rcSourceLines(text string) []string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+	inString, inBlock := false, false
+
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		switch {
+		case inBlock:
+			if ch == '*' && i+1 < len(text) && text[i+1] == '/' {
+				inBlock = false
+				sb.WriteString("  ")
+				i++
+			} else if ch == '\n' {
+				sb.WriteByte('\n')
+			} else {
+				sb.WriteByte(' ')
+			}
+		case inString:
+			sb.WriteByte(ch)
+			if ch == '\\' && i+1 < len(text) && text[i+1] != '\n' {
+				sb.WriteByte(text[i+1])
+				i++
+			} else if ch == '"' || ch == '\n' {
+				inString = false
+			}
+		case ch == '"':
+			inString = true
+			sb.WriteByte(ch)
+		case ch == '/' && i+1 < len(text) && text[i+1] == '/':
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+			i--
+		case ch == '/' && i+1 < len(text) && text[i+1] == '*':
+			inBlock = true
+			sb.WriteString("  ")
+			i++
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+
+	lines := strings.Split(strings.ReplaceAll(sb.String(), "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		j := i + 1
+		for strings.HasSuffix(lines[i], "\\") && j < len(lines) {
+			lines[i] = lines[i][:len(lines[i])-1] + lines[j]
+			lines[j] = ""
+			j++
+		}
+		i = j - 1
+	}
+	return lines
+}
+
+// Converts a path found in a resource script into a valid [fs.FS] path.
+func rcCleanPath(p string) string {
+	p = strings.ReplaceAll(p, "\\\\", "/")
+	p = strings.ReplaceAll(p, "\\", "/")
+	return strings.TrimPrefix(path.Clean(p), "/")
+}
@@ -0,0 +1,158 @@
+package res
+
+import (
+	"strings"
+)
+
+// Parses a VERSIONINFO resource.
+func (me *_RcCompiler) parseVersionInfo(tok *_RcTok, name Id) error {
+	attrs, err := me.parseAttrs()
+	if err != nil {
+		return err
+	}
+
+	var fixed VS_FIXEDFILEINFO
+	fixed.setDefaults()
+
+	for !me.s.peek().isBegin() {
+		stmt := me.s.next()
+		var field *uint32
+
+		switch strings.ToUpper(stmt.text) {
+		case "FILEVERSION", "PRODUCTVERSION":
+			parts := [4]uint16{}
+			for i := 0; i < 4; i++ {
+				if i > 0 && !me.s.skip(",") {
+					break
+				}
+				if parts[i], err = me.s.exprU16(); err != nil {
+					return err
+				}
+			}
+			if stmt.isKw("FILEVERSION") {
+				fixed.SetFileVersion(parts[0], parts[1], parts[2], parts[3])
+			} else {
+				fixed.SetProductVersion(parts[0], parts[1], parts[2], parts[3])
+			}
+			continue
+		case "FILEFLAGSMASK":
+			field = &fixed.DwFileFlagsMask
+		case "FILEFLAGS":
+			field = &fixed.DwFileFlags
+		case "FILEOS":
+			field = &fixed.DwFileOS
+		case "FILETYPE":
+			field = &fixed.DwFileType
+		case "FILESUBTYPE":
+			field = &fixed.DwFileSubtype
+		default:
+			return stmt.errf("unexpected %s in VERSIONINFO", stmt)
+		}
+
+		if *field, err = me.s.exprU32(); err != nil {
+			return err
+		}
+	}
+	me.s.next() // BEGIN
+
+	info := VersionInfo{Fixed: fixed}
+	root := info.toNode() // fixed info only; blocks are written as declared
+	if root.children, err = me.parseVerBlocks(); err != nil {
+		return err
+	}
+
+	var bw _BinWriter
+	root.serialize(&bw)
+	return me.add(tok, IdRt(RT_VERSION), name, attrs, bw.Bytes())
+}
+
+// Parses BLOCK and VALUE statements, after BEGIN, until END.
+func (me *_RcCompiler) parseVerBlocks() ([]_VerNode, error) {
+	nodes := make([]_VerNode, 0, 4) // arbitrary
+
+	for {
+		tok := me.s.next()
+		if tok.isEnd() {
+			return nodes, nil
+		}
+
+		var node _VerNode
+		var err error
+		switch strings.ToUpper(tok.text) {
+		case "BLOCK":
+			if node.key, err = me.parseString(); err != nil {
+				return nil, err
+			}
+			node.isText = true
+			if err := me.s.expectBegin(); err != nil {
+				return nil, err
+			}
+			if node.children, err = me.parseVerBlocks(); err != nil {
+				return nil, err
+			}
+		case "VALUE":
+			if node.key, err = me.parseString(); err != nil {
+				return nil, err
+			}
+			if err := me.parseVerValue(&node); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, tok.errf("expected BLOCK or VALUE, found %s", tok)
+		}
+		nodes = append(nodes, node)
+	}
+}
+
+// Parses the comma-separated data of a VALUE statement. If it starts with a
+// string, the value is text, and strings are concatenated; otherwise, the
+// value is binary, with numbers written as WORD, or DWORD if they have the L
+// suffix.
+func (me *_RcCompiler) parseVerValue(node *_VerNode) error {
+	var bw _BinWriter
+
+	if !me.s.skip(",") {
+		return nil // value without data
+	}
+
+	if me.s.peek().kind == _RcTokStr {
+		node.isText = true
+		var text strings.Builder
+		for {
+			str, err := me.parseString()
+			if err != nil {
+				return err
+			}
+			text.WriteString(str)
+			if !me.s.skip(",") {
+				break
+			}
+		}
+		bw.Str16Z(text.String())
+		node.value = bw.Bytes()
+		node.valueLen = uint16(bw.Len() / 2)
+		return nil
+	}
+
+	for {
+		if me.s.peek().kind == _RcTokStr {
+			bw.Str16Z(me.s.next().text)
+		} else {
+			val, long, err := me.s.expr()
+			if err != nil {
+				return err
+			}
+			if long {
+				bw.U32(uint32(val))
+			} else {
+				bw.U16(uint16(val))
+			}
+		}
+		if !me.s.skip(",") {
+			break
+		}
+	}
+	node.value = bw.Bytes()
+	node.valueLen = uint16(bw.Len())
+	return nil
+}
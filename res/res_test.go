@@ -0,0 +1,196 @@
+package res_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing/fstest"
+
+	"github.com/rodrigocfd/windigo/res"
+)
+
+func ExampleCompileRc() {
+	fsys := fstest.MapFS{
+		"app.rc": {Data: []byte(`
+			#include "resource.h"
+
+			IDR_MANIFEST RT_MANIFEST "app.manifest"
+
+			STRINGTABLE
+			BEGIN
+				IDS_HELLO "Hello"
+			END
+		`)},
+		"resource.h": {Data: []byte(`
+			#define IDR_MANIFEST 1
+			#define IDS_HELLO    100
+		`)},
+		"app.manifest": {Data: []byte("<assembly/>")},
+	}
+
+	rsrcs, err := res.CompileRc(fsys, "app.rc")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, rsrc := range rsrcs {
+		fmt.Println(rsrc.Type.String(), rsrc.Name.String(), len(rsrc.Data))
+	}
+	// Output:
+	// 24 1 11
+	// 6 7 42
+}
+
+func ExampleWriteResFile() {
+	rsrcs := []res.Resource{
+		{
+			Type:   res.IdRt(res.RT_RCDATA),
+			Name:   res.IdStr("DATA"),
+			LangId: res.LANGID_DEFAULT,
+			Data:   []byte{1, 2, 3},
+		},
+	}
+
+	var buf bytes.Buffer
+	_ = res.WriteResFile(&buf, rsrcs)
+
+	readBack, _ := res.ReadResFile(buf.Bytes())
+	fmt.Println(readBack[0].Name.String(), readBack[0].Data)
+	// Output: "DATA" [1 2 3]
+}
+
+func ExampleWriteSyso() {
+	rsrcs := []res.Resource{
+		{
+			Type:   res.IdRt(res.RT_RCDATA),
+			Name:   res.IdInt(1),
+			LangId: res.LANGID_DEFAULT,
+			Data:   []byte("data"),
+		},
+	}
+
+	var buf bytes.Buffer
+	_ = res.WriteSyso(&buf, rsrcs, "amd64")
+
+	machine := binary.LittleEndian.Uint16(buf.Bytes())
+	fmt.Printf("0x%04x\n", machine)
+	// Output: 0x8664
+}
+
+func ExampleId() {
+	resId := res.IdInt(101)
+
+	if id, ok := resId.Int(); ok {
+		fmt.Println(id)
+	}
+	// Output: 101
+}
+
+func ExampleReadSyso() {
+	rsrcs := []res.Resource{
+		{
+			Type:   res.IdRt(res.RT_MANIFEST),
+			Name:   res.IdInt(1),
+			LangId: res.LANGID_DEFAULT,
+			Data:   []byte("<assembly/>"),
+		},
+	}
+
+	var buf bytes.Buffer
+	_ = res.WriteSyso(&buf, rsrcs, "amd64")
+
+	readBack, _ := res.ReadSyso(buf.Bytes())
+	if rsrc, ok := res.FindResource(readBack, res.IdRt(res.RT_MANIFEST), res.IdInt(1)); ok {
+		fmt.Println(res.ParseManifest(rsrc.Data))
+	}
+	// Output: <assembly/>
+}
+
+func ExampleParseVersion() {
+	fsys := fstest.MapFS{
+		"app.rc": {Data: []byte(`
+			1 VERSIONINFO
+			FILEVERSION 1,2,3,4
+			BEGIN
+				BLOCK "StringFileInfo"
+				BEGIN
+					BLOCK "040904b0"
+					BEGIN
+						VALUE "ProductName", "My app"
+					END
+				END
+				BLOCK "VarFileInfo"
+				BEGIN
+					VALUE "Translation", 0x409, 1200
+				END
+			END
+		`)},
+	}
+
+	rsrcs, _ := res.CompileRc(fsys, "app.rc")
+	info, _ := res.ParseVersion(rsrcs[0].Data)
+
+	fmt.Println(info.Fixed.FileVersion())
+	fmt.Println(info.StringTables[0].Strings[0].Value)
+	fmt.Printf("%04x\n", info.Translations[0].LangId)
+	// Output:
+	// 1 2 3 4
+	// My app
+	// 0409
+}
+
+func ExampleVersionInfo_Serialize() {
+	var info res.VersionInfo
+	info.Fixed.SetFileVersion(1, 2, 3, 4)
+	info.StringTables = []res.VersionStringTable{{
+		LangId:   res.LANGID_DEFAULT,
+		CodePage: 1200,
+		Strings: []res.VersionString{
+			{Key: "CompanyName", Value: "Acme"},
+			{Key: "ProductName", Value: "My app"},
+		},
+	}}
+	info.Translations = []res.VersionTranslation{{LangId: res.LANGID_DEFAULT, CodePage: 1200}}
+
+	data := info.Serialize()
+	parsed, _ := res.ParseVersion(data)
+
+	name, _ := parsed.Str("ProductName")
+	_, nChars, _ := parsed.Query("\\StringFileInfo\\040904b0\\CompanyName")
+	fixed, _, _ := parsed.Query("\\")
+
+	fmt.Println(name, nChars, len(fixed))
+	fmt.Printf("%x\n", fixed[:4])
+	// Output:
+	// My app 5 52
+	// bd04effe
+}
+
+func ExampleNewDialog() {
+	dlg := res.NewDialog("Hello", 200, 100).
+		AddControl("Static", "Name:", 1001, 7, 9, 40, 8, 0).
+		AddControl("Button", "OK", 1, 143, 79, 50, 14, 0x0001_0001) // BS_DEFPUSHBUTTON | WS_TABSTOP
+
+	fsys := fstest.MapFS{
+		"dlg.rc": {Data: []byte(`
+			1 DIALOGEX 0, 0, 200, 100
+			STYLE DS_MODALFRAME | WS_POPUP | WS_SYSMENU
+			CAPTION "Hello"
+			FONT 8, "MS Shell Dlg", 400, 0, 1
+			BEGIN
+				CONTROL "Name:", 1001, "Static", 0, 7, 9, 40, 8
+				CONTROL "OK", 1, "Button", BS_DEFPUSHBUTTON | WS_TABSTOP, 143, 79, 50, 14
+			END
+		`)},
+	}
+	rsrcs, _ := res.CompileRc(fsys, "dlg.rc")
+
+	data := dlg.Serialize()
+	parsed, _ := res.ParseDialog(data)
+
+	fmt.Println(bytes.Equal(data, rsrcs[0].Data))
+	fmt.Println(parsed.Title, len(parsed.Items), parsed.Items[1].Class.String())
+	// Output:
+	// true
+	// Hello 2 128
+}
@@ -0,0 +1,93 @@
+package res
+
+import (
+	"fmt"
+	"io"
+)
+
+// Parses the contents of a .res file, returning the resources it contains.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.res")
+//	rsrcs, _ := res.ReadResFile(data)
+func ReadResFile(data []byte) ([]Resource, error) {
+	rsrcs := make([]Resource, 0, 8) // arbitrary
+	r := _BinReader{data: data}
+
+	for r.Remaining() > 0 {
+		start := r.Pos()
+		dataSize := r.U32()
+		headerSize := r.U32()
+		rsrc := Resource{
+			Type: r.SzOrOrd(),
+		}
+		rsrc.Name = r.SzOrOrd()
+		r.Align(4)
+		r.U32() // DataVersion
+		rsrc.MemFlags = MEMFLAG(r.U16())
+		rsrc.LangId = r.U16()
+		rsrc.Version = r.U32()
+		rsrc.Characteristics = r.U32()
+		if r.Err() != nil || r.Pos()-start > int(headerSize) {
+			return nil, fmt.Errorf("ReadResFile: header at %d: %w", start, ErrMalformed)
+		}
+
+		r.Seek(start + int(headerSize))
+		rsrc.Data = r.Raw(int(dataSize))
+		r.Align(4)
+		if r.Err() != nil {
+			return nil, fmt.Errorf("ReadResFile: data at %d: %w", start, ErrMalformed)
+		}
+
+		if dataSize == 0 && headerSize == 0x20 && rsrc.Type.Equals(IdInt(0)) {
+			continue // the empty entry which starts every 32-bit .res file
+		}
+		rsrcs = append(rsrcs, rsrc)
+	}
+
+	return rsrcs, nil
+}
+
+// Writes the resources in the .res file format, which can be read by any
+// resource compiler or linker.
+//
+// Example:
+//
+//	rsrcs, _ := res.CompileRc(os.DirFS("."), "app.rc")
+//
+//	fout, _ := os.Create("app.res")
+//	defer fout.Close()
+//
+//	_ = res.WriteResFile(fout, rsrcs)
+func WriteResFile(w io.Writer, rsrcs []Resource) error {
+	var bw _BinWriter
+	bw.Raw(make([]byte, 0x20)) // empty entry, identifies a 32-bit .res file
+	bw.PutU32(4, 0x20)
+	bw.PutU32(8, 0xffff)
+	bw.PutU32(12, 0xffff)
+
+	for i := range rsrcs {
+		rsrc := &rsrcs[i]
+		start := bw.Len()
+		bw.U32(uint32(len(rsrc.Data)))
+		bw.U32(0) // header size, will be written later
+		bw.SzOrOrd(rsrc.Type)
+		bw.SzOrOrd(rsrc.Name)
+		bw.Align(4)
+		bw.U32(0) // DataVersion
+		bw.U16(uint16(rsrc.MemFlags))
+		bw.U16(rsrc.LangId)
+		bw.U32(rsrc.Version)
+		bw.U32(rsrc.Characteristics)
+		bw.PutU32(start+4, uint32(bw.Len()-start))
+
+		bw.Raw(rsrc.Data)
+		bw.Align(4)
+	}
+
+	if _, err := w.Write(bw.Bytes()); err != nil {
+		return fmt.Errorf("WriteResFile: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package res
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf16"
+)
+
+// Parses the data of an RT_STRING resource, which is a block of 16 strings.
+// The block ID is the resource name; string IDs are computed from it, and
+// strings which are empty are not returned.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	for _, rsrc := range rsrcs {
+//		if rsrc.Type.Equals(res.IdRt(res.RT_STRING)) {
+//			blockId, _ := rsrc.Name.Int()
+//			strs, _ := res.ParseStringBlock(blockId, rsrc.Data)
+//			for id, s := range strs {
+//				println(id, s)
+//			}
+//		}
+//	}
+func ParseStringBlock(blockId uint16, data []byte) (map[uint16]string, error) {
+	if blockId == 0 {
+		return nil, fmt.Errorf("ParseStringBlock: block ID cannot be zero")
+	}
+
+	r := _BinReader{data: data}
+	strs := make(map[uint16]string, 16)
+	for i := uint16(0); i < 16 && r.Remaining() > 0; i++ {
+		if s := r.Str16(int(r.U16())); s != "" {
+			strs[(blockId-1)*16+i] = s
+		}
+	}
+
+	if r.Err() != nil {
+		return nil, fmt.Errorf("ParseStringBlock: %w", ErrMalformed)
+	}
+	return strs, nil
+}
+
+// Returns the text of an RT_MANIFEST resource, which is usually UTF-8, but may
+// also have an UTF-8 or UTF-16 byte order mark.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	if rsrc, ok := res.FindResource(rsrcs, res.IdRt(res.RT_MANIFEST), res.IdInt(1)); ok {
+//		println(res.ParseManifest(rsrc.Data))
+//	}
+func ParseManifest(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xef, 0xbb, 0xbf}):
+		return string(data[3:])
+	case bytes.HasPrefix(data, []byte{0xff, 0xfe}), bytes.HasPrefix(data, []byte{0xfe, 0xff}):
+		bigEndian := data[0] == 0xfe
+		words := make([]uint16, 0, len(data)/2)
+		for i := 2; i+1 < len(data); i += 2 {
+			if bigEndian {
+				words = append(words, uint16(data[i])<<8|uint16(data[i+1]))
+			} else {
+				words = append(words, uint16(data[i])|uint16(data[i+1])<<8)
+			}
+		}
+		return string(utf16.Decode(words))
+	default:
+		return rcNarrowToText(data)
+	}
+}
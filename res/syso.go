@@ -0,0 +1,272 @@
+package res
+
+import (
+	"fmt"
+	"io"
+)
+
+// Writes the resources as a COFF object file with a single .rsrc section,
+// which is automatically linked by the Go toolchain when placed, with the .syso
+// extension, at the root folder of a Windows project.
+//
+// The arch argument is the target architecture, as in GOARCH: "386", "amd64",
+// "arm" or "arm64".
+//
+// Example:
+//
+//	rsrcs, _ := res.CompileRc(os.DirFS("."), "app.rc")
+//
+//	fout, _ := os.Create("app_windows_amd64.syso")
+//	defer fout.Close()
+//
+//	_ = res.WriteSyso(fout, rsrcs, "amd64")
+func WriteSyso(w io.Writer, rsrcs []Resource, arch string) error {
+	var machine, relocType uint16
+	switch arch {
+	case "386":
+		machine, relocType = 0x014c, 0x0007 // IMAGE_REL_I386_DIR32NB
+	case "amd64":
+		machine, relocType = 0x8664, 0x0003 // IMAGE_REL_AMD64_ADDR32NB
+	case "arm":
+		machine, relocType = 0x01c4, 0x000a // IMAGE_REL_ARM_ADDR32NB
+	case "arm64":
+		machine, relocType = 0xaa64, 0x0002 // IMAGE_REL_ARM64_ADDR32NB
+	default:
+		return fmt.Errorf("WriteSyso: unsupported architecture: %s", arch)
+	}
+
+	section, relocs := buildRsrcSection(rsrcs, 0)
+
+	const _HEADERS_SIZE = 20 + 40 // IMAGE_FILE_HEADER + IMAGE_SECTION_HEADER
+	ptrRelocs := _HEADERS_SIZE + len(section)
+	ptrSymbols := ptrRelocs + len(relocs)*10
+
+	var bw _BinWriter
+
+	bw.U16(machine) // IMAGE_FILE_HEADER
+	bw.U16(1)       // NumberOfSections
+	bw.U32(0)       // TimeDateStamp
+	bw.U32(uint32(ptrSymbols))
+	bw.U32(1)      // NumberOfSymbols
+	bw.U16(0)      // SizeOfOptionalHeader
+	bw.U16(0x0004) // IMAGE_FILE_LINE_NUMS_STRIPPED
+
+	bw.Raw([]byte(".rsrc\x00\x00\x00")) // IMAGE_SECTION_HEADER
+	bw.U32(0)                           // VirtualSize
+	bw.U32(0)                           // VirtualAddress
+	bw.U32(uint32(len(section)))
+	bw.U32(_HEADERS_SIZE) // PointerToRawData
+	bw.U32(uint32(ptrRelocs))
+	bw.U32(0) // PointerToLinenumbers
+	bw.U16(uint16(len(relocs)))
+	bw.U16(0)          // NumberOfLinenumbers
+	bw.U32(0x40300040) // IMAGE_SCN_CNT_INITIALIZED_DATA | IMAGE_SCN_ALIGN_4BYTES | IMAGE_SCN_MEM_READ
+
+	bw.Raw(section)
+
+	for _, reloc := range relocs { // IMAGE_RELOCATION
+		bw.U32(uint32(reloc))
+		bw.U32(0) // SymbolTableIndex, our single .rsrc symbol
+		bw.U16(relocType)
+	}
+
+	bw.Raw([]byte(".rsrc\x00\x00\x00")) // IMAGE_SYMBOL
+	bw.U32(0)                           // Value
+	bw.U16(1)                           // SectionNumber
+	bw.U16(0)                           // Type
+	bw.U8(3)                            // IMAGE_SYM_CLASS_STATIC
+	bw.U8(0)                            // NumberOfAuxSymbols
+
+	bw.U32(4) // string table size, which is empty
+
+	if _, err := w.Write(bw.Bytes()); err != nil {
+		return fmt.Errorf("WriteSyso: %w", err)
+	}
+	return nil
+}
+
+// Builds the contents of a .rsrc section, laid out as the PE format specifies:
+// directory tables, directory strings, data entries and then the data itself.
+// The OffsetToData field of each data entry is rva plus the data position
+// within the section.
+//
+// Returns the section bytes, and the positions of each OffsetToData field, to
+// be relocated.
+func buildRsrcSection(rsrcs []Resource, rva uint32) ([]byte, []int) {
+	type Lang struct {
+		langId uint16
+		rsrc   *Resource
+	}
+	type Name struct {
+		id    Id
+		langs []Lang
+	}
+	type Type struct {
+		id    Id
+		names []Name
+	}
+
+	var types []Type // resources are sorted, so the tree will also be
+	sorted := sortResources(rsrcs)
+	for i := range sorted {
+		rsrc := &sorted[i]
+		if len(types) == 0 || types[len(types)-1].id.cmp(rsrc.Type) != 0 {
+			types = append(types, Type{id: rsrc.Type})
+		}
+		ty := &types[len(types)-1]
+		if len(ty.names) == 0 || ty.names[len(ty.names)-1].id.cmp(rsrc.Name) != 0 {
+			ty.names = append(ty.names, Name{id: rsrc.Name})
+		}
+		nm := &ty.names[len(ty.names)-1]
+		nm.langs = append(nm.langs, Lang{rsrc.LangId, rsrc})
+	}
+
+	dirSize := func(numEntries int) int { return 16 + numEntries*8 }
+
+	// First pass: compute the position of each block.
+
+	pos := dirSize(len(types)) // root directory
+	typeDirPos := make([]int, len(types))
+	nameDirPos := make([][]int, len(types))
+	for t := range types {
+		typeDirPos[t] = pos
+		pos += dirSize(len(types[t].names))
+		nameDirPos[t] = make([]int, len(types[t].names))
+		for n := range types[t].names {
+			nameDirPos[t][n] = pos
+			pos += dirSize(len(types[t].names[n].langs))
+		}
+	}
+
+	strPos := make(map[string]int)
+	addStr := func(id Id) {
+		if s, ok := id.Str(); ok {
+			if _, has := strPos[s]; !has {
+				strPos[s] = pos
+				pos += 2 + len(encodeUtf16(s))*2
+			}
+		}
+	}
+	for t := range types {
+		addStr(types[t].id)
+		for n := range types[t].names {
+			addStr(types[t].names[n].id)
+		}
+	}
+	pos = alignUp(pos, 4)
+
+	entryPos := pos
+	pos += len(sorted) * 16 // IMAGE_RESOURCE_DATA_ENTRY
+
+	dataPos := make([]int, len(sorted))
+	for i := range sorted {
+		pos = alignUp(pos, 8)
+		dataPos[i] = pos
+		pos += len(sorted[i].Data)
+	}
+
+	// Second pass: write the blocks.
+
+	var bw _BinWriter
+	writeDir := func(numNamed, numIds int) {
+		bw.U32(0) // Characteristics
+		bw.U32(0) // TimeDateStamp
+		bw.U16(0) // MajorVersion
+		bw.U16(0) // MinorVersion
+		bw.U16(uint16(numNamed))
+		bw.U16(uint16(numIds))
+	}
+	writeEntryName := func(id Id) {
+		if s, ok := id.Str(); ok {
+			bw.U32(0x8000_0000 | uint32(strPos[s]))
+		} else {
+			n, _ := id.Int()
+			bw.U32(uint32(n))
+		}
+	}
+	countNamed := func(ids ...Id) int {
+		num := 0
+		for i := range ids {
+			if _, ok := ids[i].Str(); ok {
+				num++
+			}
+		}
+		return num
+	}
+
+	typeIds := make([]Id, 0, len(types))
+	for t := range types {
+		typeIds = append(typeIds, types[t].id)
+	}
+	writeDir(countNamed(typeIds...), len(types)-countNamed(typeIds...))
+	for t := range types {
+		writeEntryName(types[t].id)
+		bw.U32(0x8000_0000 | uint32(typeDirPos[t]))
+	}
+
+	idxRsrc := 0
+	for t := range types {
+		names := types[t].names
+		nameIds := make([]Id, 0, len(names))
+		for n := range names {
+			nameIds = append(nameIds, names[n].id)
+		}
+		writeDir(countNamed(nameIds...), len(names)-countNamed(nameIds...))
+		for n := range names {
+			writeEntryName(names[n].id)
+			bw.U32(0x8000_0000 | uint32(nameDirPos[t][n]))
+		}
+
+		for n := range names {
+			writeDir(0, len(names[n].langs))
+			for _, lang := range names[n].langs {
+				bw.U32(uint32(lang.langId))
+				bw.U32(uint32(entryPos + idxRsrc*16))
+				idxRsrc++
+			}
+		}
+	}
+
+	strWritten := make(map[string]struct{})
+	writeStr := func(id Id) {
+		if s, ok := id.Str(); ok {
+			if _, done := strWritten[s]; !done {
+				strWritten[s] = struct{}{}
+				words := encodeUtf16(s)
+				bw.U16(uint16(len(words)))
+				for _, w := range words {
+					bw.U16(w)
+				}
+			}
+		}
+	}
+	for t := range types {
+		writeStr(types[t].id)
+		for n := range types[t].names {
+			writeStr(types[t].names[n].id)
+		}
+	}
+	bw.Align(4)
+
+	relocs := make([]int, 0, len(sorted))
+	for i := range sorted {
+		relocs = append(relocs, bw.Len())
+		bw.U32(rva + uint32(dataPos[i])) // IMAGE_RESOURCE_DATA_ENTRY
+		bw.U32(uint32(len(sorted[i].Data)))
+		bw.U32(0) // CodePage
+		bw.U32(0) // Reserved
+	}
+
+	for i := range sorted {
+		bw.Align(8)
+		bw.Raw(sorted[i].Data)
+	}
+	bw.Align(8)
+
+	return bw.Bytes(), relocs
+}
+
+// Rounds n up to the next multiple of a.
+func alignUp(n, a int) int {
+	return (n + a - 1) / a * a
+}
@@ -0,0 +1,197 @@
+package res
+
+import (
+	"encoding/binary"
+	"errors"
+	"unicode/utf16"
+)
+
+// Returned when a binary block is truncated or has inconsistent offsets.
+var ErrMalformed = errors.New("malformed resource data")
+
+// Little-endian binary writer, used to serialize resource data.
+type _BinWriter struct {
+	buf []byte
+}
+
+// Returns the bytes written so far.
+func (me *_BinWriter) Bytes() []byte {
+	return me.buf
+}
+
+// Returns the number of bytes written so far.
+func (me *_BinWriter) Len() int {
+	return len(me.buf)
+}
+
+// Writes zeros until the length is a multiple of n.
+func (me *_BinWriter) Align(n int) {
+	for len(me.buf)%n != 0 {
+		me.buf = append(me.buf, 0)
+	}
+}
+
+func (me *_BinWriter) Raw(b []byte) {
+	me.buf = append(me.buf, b...)
+}
+
+func (me *_BinWriter) U8(v uint8) {
+	me.buf = append(me.buf, v)
+}
+
+func (me *_BinWriter) U16(v uint16) {
+	me.buf = binary.LittleEndian.AppendUint16(me.buf, v)
+}
+
+func (me *_BinWriter) U32(v uint32) {
+	me.buf = binary.LittleEndian.AppendUint32(me.buf, v)
+}
+
+// Overwrites an uint16 at the given position.
+func (me *_BinWriter) PutU16(pos int, v uint16) {
+	binary.LittleEndian.PutUint16(me.buf[pos:], v)
+}
+
+// Overwrites an uint32 at the given position.
+func (me *_BinWriter) PutU32(pos int, v uint32) {
+	binary.LittleEndian.PutUint32(me.buf[pos:], v)
+}
+
+// Writes the string as UTF-16, without a terminating null.
+func (me *_BinWriter) Str16(s string) {
+	for _, ch := range encodeUtf16(s) {
+		me.U16(ch)
+	}
+}
+
+// Writes the string as UTF-16, with a terminating null.
+func (me *_BinWriter) Str16Z(s string) {
+	me.Str16(s)
+	me.U16(0x0000)
+}
+
+// Writes a resource identifier: an integer as 0xffff followed by the number,
+// or a null-terminated UTF-16 string.
+func (me *_BinWriter) SzOrOrd(id Id) {
+	if n, ok := id.Int(); ok {
+		me.U16(0xffff)
+		me.U16(n)
+	} else {
+		s, _ := id.Str()
+		me.Str16Z(s)
+	}
+}
+
+// Little-endian binary reader, used to parse resource data. The first read
+// past the end of the data sets the error, and all subsequent reads return
+// zero.
+type _BinReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+// Returns the error of the first failed read, if any.
+func (me *_BinReader) Err() error {
+	return me.err
+}
+
+// Returns the current reading position.
+func (me *_BinReader) Pos() int {
+	return me.pos
+}
+
+// Returns the number of unread bytes.
+func (me *_BinReader) Remaining() int {
+	return len(me.data) - me.pos
+}
+
+// Sets the reading position.
+func (me *_BinReader) Seek(pos int) {
+	if pos < 0 || pos > len(me.data) {
+		me.fail()
+	} else {
+		me.pos = pos
+	}
+}
+
+// Skips bytes until the position is a multiple of n.
+func (me *_BinReader) Align(n int) {
+	for me.pos%n != 0 && me.pos < len(me.data) {
+		me.pos++
+	}
+}
+
+func (me *_BinReader) fail() {
+	if me.err == nil {
+		me.err = ErrMalformed
+	}
+	me.pos = len(me.data)
+}
+
+func (me *_BinReader) Raw(n int) []byte {
+	if n < 0 || me.pos+n > len(me.data) {
+		me.fail()
+		return nil
+	}
+	b := me.data[me.pos : me.pos+n]
+	me.pos += n
+	return b
+}
+
+func (me *_BinReader) U8() uint8 {
+	if b := me.Raw(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (me *_BinReader) U16() uint16 {
+	if b := me.Raw(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (me *_BinReader) U32() uint32 {
+	if b := me.Raw(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+// Reads a null-terminated UTF-16 string.
+func (me *_BinReader) Str16Z() string {
+	words := make([]uint16, 0, 16) // arbitrary
+	for me.err == nil {
+		ch := me.U16()
+		if ch == 0x0000 {
+			break
+		}
+		words = append(words, ch)
+	}
+	return string(utf16.Decode(words))
+}
+
+// Reads an UTF-16 string with the given number of words.
+func (me *_BinReader) Str16(numWords int) string {
+	words := make([]uint16, 0, numWords)
+	for i := 0; i < numWords && me.err == nil; i++ {
+		words = append(words, me.U16())
+	}
+	return string(utf16.Decode(words))
+}
+
+// Reads a resource identifier written by [_BinWriter.SzOrOrd].
+func (me *_BinReader) SzOrOrd() Id {
+	if me.Remaining() >= 2 && me.data[me.pos] == 0xff && me.data[me.pos+1] == 0xff {
+		me.U16()
+		return IdInt(me.U16())
+	}
+	return IdStr(me.Str16Z())
+}
+
+// Converts the string to UTF-16, without a terminating null.
+func encodeUtf16(s string) []uint16 {
+	return utf16.Encode([]rune(s))
+}
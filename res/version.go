@@ -0,0 +1,432 @@
+package res
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// [VS_FIXEDFILEINFO] struct, with the same layout of win.VS_FIXEDFILEINFO.
+//
+// [VS_FIXEDFILEINFO]: https://learn.microsoft.com/en-us/windows/win32/api/verrsrc/ns-verrsrc-vs_fixedfileinfo
+type VS_FIXEDFILEINFO struct {
+	DwSignature        uint32
+	DwStrucVersion     uint32
+	dwFileVersionMS    uint32
+	dwFileVersionLS    uint32
+	dwProductVersionMS uint32
+	dwProductVersionLS uint32
+	DwFileFlagsMask    uint32
+	DwFileFlags        uint32
+	DwFileOS           uint32
+	DwFileType         uint32
+	DwFileSubtype      uint32
+	dwFileDateMS       uint32
+	dwFileDateLS       uint32
+}
+
+func (ffi *VS_FIXEDFILEINFO) FileVersion() (major, minor, patch, build uint16) {
+	return uint16(ffi.dwFileVersionMS >> 16), uint16(ffi.dwFileVersionMS),
+		uint16(ffi.dwFileVersionLS >> 16), uint16(ffi.dwFileVersionLS)
+}
+func (ffi *VS_FIXEDFILEINFO) SetFileVersion(major, minor, patch, build uint16) {
+	ffi.dwFileVersionMS = uint32(major)<<16 | uint32(minor)
+	ffi.dwFileVersionLS = uint32(patch)<<16 | uint32(build)
+}
+
+func (ffi *VS_FIXEDFILEINFO) ProductVersion() (major, minor, patch, build uint16) {
+	return uint16(ffi.dwProductVersionMS >> 16), uint16(ffi.dwProductVersionMS),
+		uint16(ffi.dwProductVersionLS >> 16), uint16(ffi.dwProductVersionLS)
+}
+func (ffi *VS_FIXEDFILEINFO) SetProductVersion(major, minor, patch, build uint16) {
+	ffi.dwProductVersionMS = uint32(major)<<16 | uint32(minor)
+	ffi.dwProductVersionLS = uint32(patch)<<16 | uint32(build)
+}
+
+func (ffi *VS_FIXEDFILEINFO) FileDate() uint64 {
+	return uint64(ffi.dwFileDateMS)<<32 | uint64(ffi.dwFileDateLS)
+}
+func (ffi *VS_FIXEDFILEINFO) SetFileDate(val uint64) {
+	ffi.dwFileDateMS, ffi.dwFileDateLS = uint32(val>>32), uint32(val)
+}
+
+// Sets DwSignature and DwStrucVersion to the values expected by Windows, if
+// they are zero.
+func (ffi *VS_FIXEDFILEINFO) setDefaults() {
+	if ffi.DwSignature == 0 {
+		ffi.DwSignature = 0xfeef_04bd
+	}
+	if ffi.DwStrucVersion == 0 {
+		ffi.DwStrucVersion = 0x0001_0000
+	}
+}
+
+// Returns the struct fields in memory order.
+func (ffi *VS_FIXEDFILEINFO) fields() []*uint32 {
+	return []*uint32{
+		&ffi.DwSignature, &ffi.DwStrucVersion,
+		&ffi.dwFileVersionMS, &ffi.dwFileVersionLS,
+		&ffi.dwProductVersionMS, &ffi.dwProductVersionLS,
+		&ffi.DwFileFlagsMask, &ffi.DwFileFlags, &ffi.DwFileOS,
+		&ffi.DwFileType, &ffi.DwFileSubtype,
+		&ffi.dwFileDateMS, &ffi.dwFileDateLS,
+	}
+}
+
+// Version information, stored in an RT_VERSION resource as a [VS_VERSIONINFO]
+// tree.
+//
+// [VS_VERSIONINFO]: https://learn.microsoft.com/en-us/windows/win32/menurc/vs-versioninfo
+type VersionInfo struct {
+	Fixed        VS_FIXEDFILEINFO
+	StringTables []VersionStringTable // Blocks of StringFileInfo.
+	Translations []VersionTranslation // Translation value of VarFileInfo.
+}
+
+// A block of [StringFileInfo], with the strings of one language and code
+// page.
+//
+// [StringFileInfo]: https://learn.microsoft.com/en-us/windows/win32/menurc/stringfileinfo
+type VersionStringTable struct {
+	LangId   uint16
+	CodePage uint16
+	Strings  []VersionString
+}
+
+// A key/value pair of a [VersionStringTable], like CompanyName or FileVersion.
+type VersionString struct {
+	Key   string
+	Value string
+}
+
+// A language and code page pair, from the Translation value of [VarFileInfo].
+//
+// [VarFileInfo]: https://learn.microsoft.com/en-us/windows/win32/menurc/varfileinfo
+type VersionTranslation struct {
+	LangId   uint16
+	CodePage uint16
+}
+
+// Serializes the version information into a [VS_VERSIONINFO] tree, the binary
+// format stored in RT_VERSION resources. If DwSignature and DwStrucVersion of
+// the fixed info are zero, the values expected by Windows are written.
+//
+// Example:
+//
+//	var info res.VersionInfo
+//	info.Fixed.SetFileVersion(1, 2, 0, 0)
+//	info.Fixed.SetProductVersion(1, 2, 0, 0)
+//	info.StringTables = []res.VersionStringTable{{
+//		LangId:   res.LANGID_DEFAULT,
+//		CodePage: 1200,
+//		Strings: []res.VersionString{
+//			{Key: "FileVersion", Value: "1.2"},
+//			{Key: "ProductName", Value: "My app"},
+//		},
+//	}}
+//	info.Translations = []res.VersionTranslation{{res.LANGID_DEFAULT, 1200}}
+//
+//	rsrcs = res.SetResource(rsrcs, res.Resource{
+//		Type:   res.IdRt(res.RT_VERSION),
+//		Name:   res.IdInt(1),
+//		LangId: res.LANGID_DEFAULT,
+//		Data:   info.Serialize(),
+//	})
+//
+// [VS_VERSIONINFO]: https://learn.microsoft.com/en-us/windows/win32/menurc/vs-versioninfo
+func (me *VersionInfo) Serialize() []byte {
+	root := me.toNode()
+	var bw _BinWriter
+	root.serialize(&bw)
+	return bw.Bytes()
+}
+
+// Retrieves a value, following the same rules of [VerQueryValue]. The subBlock
+// path can be:
+//   - "\\" – the VS_FIXEDFILEINFO, 52 bytes;
+//   - "\\VarFileInfo\\Translation" – pairs of language and code page, as 2
+//     uint16 each;
+//   - "\\StringFileInfo\\llllcccc\\Key" – a null-terminated UTF-16 string of
+//     a string table, where llll and cccc are the hex language and code page.
+//
+// Keys are case-insensitive. Returns the raw value, and its length: in
+// characters, including the terminating null, for strings; in bytes for
+// binary values.
+//
+// Example:
+//
+//	info, _ := res.ParseVersion(data)
+//	if raw, nChars, ok := info.Query("\\StringFileInfo\\040904b0\\ProductName"); ok {
+//		println(nChars, len(raw))
+//	}
+//
+// [VerQueryValue]: https://learn.microsoft.com/en-us/windows/win32/api/winver/nf-winver-verqueryvaluew
+func (me *VersionInfo) Query(subBlock string) ([]byte, int, bool) {
+	root := me.toNode()
+	node := &root
+
+	for _, key := range strings.Split(subBlock, "\\") {
+		if key == "" {
+			continue
+		}
+		found := false
+		for i := range node.children {
+			if strings.EqualFold(node.children[i].key, key) {
+				node, found = &node.children[i], true
+				break
+			}
+		}
+		if !found {
+			return nil, 0, false
+		}
+	}
+	return node.value, int(node.valueLen), true
+}
+
+// Returns the value of the given key from the string table of the first
+// translation, or from the first string table, if there are no translations.
+// This is the lookup usually made by applications, like Windows Explorer.
+//
+// Example:
+//
+//	info, _ := res.ParseVersion(data)
+//	name, _ := info.Str("ProductName")
+func (me *VersionInfo) Str(key string) (string, bool) {
+	var table *VersionStringTable
+	for i := range me.StringTables {
+		t := &me.StringTables[i]
+		if len(me.Translations) == 0 ||
+			(t.LangId == me.Translations[0].LangId && t.CodePage == me.Translations[0].CodePage) {
+			table = t
+			break
+		}
+	}
+	if table != nil {
+		for _, str := range table.Strings {
+			if strings.EqualFold(str.Key, key) {
+				return str.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Builds the VS_VERSIONINFO tree. StringFileInfo and VarFileInfo blocks are
+// written only if they have content.
+func (me *VersionInfo) toNode() _VerNode {
+	fixed := me.Fixed
+	fixed.setDefaults()
+	var bwFixed _BinWriter
+	for _, field := range fixed.fields() {
+		bwFixed.U32(*field)
+	}
+
+	root := _VerNode{
+		key:      "VS_VERSION_INFO",
+		value:    bwFixed.Bytes(),
+		valueLen: uint16(bwFixed.Len()),
+	}
+
+	if len(me.StringTables) > 0 {
+		sfi := _VerNode{key: "StringFileInfo", isText: true}
+		for _, table := range me.StringTables {
+			tableNode := _VerNode{
+				key:    fmt.Sprintf("%04x%04x", table.LangId, table.CodePage),
+				isText: true,
+			}
+			for _, str := range table.Strings {
+				tableNode.children = append(tableNode.children, newVerTextNode(str.Key, str.Value))
+			}
+			sfi.children = append(sfi.children, tableNode)
+		}
+		root.children = append(root.children, sfi)
+	}
+
+	if len(me.Translations) > 0 {
+		var bw _BinWriter
+		for _, tr := range me.Translations {
+			bw.U16(tr.LangId)
+			bw.U16(tr.CodePage)
+		}
+		root.children = append(root.children, _VerNode{
+			key:    "VarFileInfo",
+			isText: true,
+			children: []_VerNode{{
+				key:      "Translation",
+				value:    bw.Bytes(),
+				valueLen: uint16(bw.Len()),
+			}},
+		})
+	}
+
+	return root
+}
+
+// Parses the data of an RT_VERSION resource, as returned by
+// GetFileVersionInfo.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("app.exe")
+//	rsrcs, _ := res.ReadPe(data)
+//
+//	if rsrc, ok := res.FindResource(rsrcs, res.IdRt(res.RT_VERSION), res.IdInt(1)); ok {
+//		info, _ := res.ParseVersion(rsrc.Data)
+//		println(info.Fixed.FileVersion())
+//		for _, str := range info.StringTables[0].Strings {
+//			println(str.Key, str.Value)
+//		}
+//	}
+func ParseVersion(data []byte) (VersionInfo, error) {
+	r := _BinReader{data: data}
+	root := parseVerNode(&r, 0)
+	if r.Err() != nil {
+		return VersionInfo{}, fmt.Errorf("ParseVersion: %w", ErrMalformed)
+	} else if root.key != "VS_VERSION_INFO" {
+		return VersionInfo{}, fmt.Errorf("ParseVersion: unexpected root key %q: %w", root.key, ErrMalformed)
+	}
+
+	var info VersionInfo
+	if len(root.value) >= 13*4 {
+		rv := _BinReader{data: root.value}
+		for _, field := range info.Fixed.fields() {
+			*field = rv.U32()
+		}
+	}
+
+	for _, child := range root.children {
+		switch child.key {
+		case "StringFileInfo":
+			for _, tableNode := range child.children {
+				langCp, err := strconv.ParseUint(tableNode.key, 16, 32)
+				if err != nil || len(tableNode.key) != 8 {
+					return VersionInfo{}, fmt.Errorf("ParseVersion: invalid string table key %q: %w",
+						tableNode.key, ErrMalformed)
+				}
+				table := VersionStringTable{
+					LangId:   uint16(langCp >> 16),
+					CodePage: uint16(langCp),
+					Strings:  make([]VersionString, 0, len(tableNode.children)),
+				}
+				for _, strNode := range tableNode.children {
+					table.Strings = append(table.Strings, VersionString{
+						Key:   strNode.key,
+						Value: strNode.text(),
+					})
+				}
+				info.StringTables = append(info.StringTables, table)
+			}
+
+		case "VarFileInfo":
+			for _, varNode := range child.children {
+				if varNode.key == "Translation" {
+					rv := _BinReader{data: varNode.value}
+					for rv.Remaining() >= 4 {
+						info.Translations = append(info.Translations, VersionTranslation{
+							LangId:   rv.U16(),
+							CodePage: rv.U16(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// A node of a version resource, which is a tree of blocks: each block has a
+// key, an optional value and children blocks.
+//
+// The root block is VS_VERSIONINFO, whose value is a VS_FIXEDFILEINFO; its
+// children are the StringFileInfo and VarFileInfo blocks.
+type _VerNode struct {
+	key      string
+	isText   bool   // wType is 1, value is a null-terminated UTF-16 string
+	value    []byte // raw value bytes
+	valueLen uint16 // wValueLength: words for text values, bytes for binary
+	children []_VerNode
+}
+
+// Writes the node and its children. The node must start at a DWORD boundary.
+func (me *_VerNode) serialize(bw *_BinWriter) {
+	start := bw.Len()
+	bw.U16(0) // wLength, will be written later
+	bw.U16(me.valueLen)
+	if me.isText {
+		bw.U16(1)
+	} else {
+		bw.U16(0)
+	}
+	bw.Str16Z(me.key)
+	bw.Align(4)
+	bw.Raw(me.value)
+
+	for i := range me.children {
+		bw.Align(4)
+		me.children[i].serialize(bw)
+	}
+	bw.PutU16(start, uint16(bw.Len()-start))
+}
+
+// Creates a node with a null-terminated UTF-16 text value.
+func newVerTextNode(key, text string) _VerNode {
+	var bw _BinWriter
+	bw.Str16Z(text)
+	return _VerNode{
+		key:      key,
+		isText:   true,
+		value:    bw.Bytes(),
+		valueLen: uint16(bw.Len() / 2),
+	}
+}
+
+// Returns the value as text, without the terminating null.
+func (me *_VerNode) text() string {
+	r := _BinReader{data: me.value}
+	return strings.TrimRight(r.Str16(len(me.value)/2), "\x00")
+}
+
+// Maximum nesting of version blocks, to guard against malformed data.
+const _VER_MAX_DEPTH = 16
+
+// Reads a node and its children, starting at the current position, which must
+// be at a DWORD boundary.
+func parseVerNode(r *_BinReader, depth int) _VerNode {
+	var node _VerNode
+	if depth > _VER_MAX_DEPTH {
+		r.fail()
+		return node
+	}
+
+	start := r.Pos()
+	length := int(r.U16())
+	node.valueLen = r.U16()
+	node.isText = r.U16() == 1
+	node.key = r.Str16Z()
+	r.Align(4)
+	end := start + length
+	if length < 6 || end > len(r.data) || r.Pos() > end {
+		r.fail()
+		return node
+	}
+
+	valueBytes := int(node.valueLen)
+	if node.isText {
+		valueBytes *= 2
+	}
+	if r.Pos()+valueBytes > end {
+		valueBytes = end - r.Pos() // some compilers store the text length in bytes
+	}
+	node.value = r.Raw(valueBytes)
+
+	for r.Err() == nil {
+		r.Align(4)
+		if r.Pos() >= end {
+			break
+		}
+		node.children = append(node.children, parseVerNode(r, depth+1))
+	}
+	r.Seek(end)
+	return node
+}
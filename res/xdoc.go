@@ -0,0 +1,26 @@
+// This package reads and writes native Win32 [resources] in pure Go, without
+// calling the OS. It can be used on any platform, so resources can be built on
+// a non-Windows machine, like a Linux CI server.
+//
+// Resource scripts (.rc files) are compiled with [CompileRc], and the
+// resulting resources can be written as a .res file with [WriteResFile], or as
+// a COFF object with [WriteSyso]. The latter, when placed at the root folder of
+// your project, is automatically linked by the Go toolchain.
+//
+// Resources can also be read from compiled files: .res files with
+// [ReadResFile], COFF objects with [ReadSyso], and EXE and DLL files with
+// [ReadPe]. The data of the most common resource types can be decoded with
+// [ParseDialog], [ParseIconGroup], [ParseManifest], [ParseMenu],
+// [ParseStringBlock] and [ParseVersion].
+//
+// Example:
+//
+//	rsrcs, _ := res.CompileRc(os.DirFS("."), "app.rc")
+//
+//	fout, _ := os.Create("app.syso")
+//	defer fout.Close()
+//
+//	_ = res.WriteSyso(fout, rsrcs, "amd64")
+//
+// [resources]: https://learn.microsoft.com/en-us/windows/win32/menurc/about-resource-files
+package res
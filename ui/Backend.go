@@ -0,0 +1,126 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/win"
+)
+
+// Low-level operations performed by the native controls upon their windows:
+// creation, messages and window texts. By default, they call the Win32 API;
+// tests can replace them with a [FakeBackend], so the controls work without
+// any real window.
+//
+// Only the native controls go through the backend. Parent windows – [Main],
+// [Modal] and [Control] – are always real windows, created by RunAsMain,
+// ShowModal and so on; a [FakeBackend] emulates their creation and
+// destruction with [FakeBackend.Create] and [FakeBackend.Destroy], running
+// the same handlers of their [WindowEvents]. Subclass events of the controls
+// are not routed.
+//
+// The methods mirror the Win32 functions of the same names.
+type Backend interface {
+	// Creates a child control window.
+	CreateWindowEx(exStyle co.WS_EX, className, title string, style co.WS,
+		pos win.POINT, size win.SIZE, hParent win.HWND, ctrlId uint16) (win.HWND, error)
+
+	// Retrieves a control from a dialog.
+	GetDlgItem(hDlg win.HWND, ctrlId uint16) (win.HWND, error)
+
+	// Retrieves the parent of a control.
+	GetParent(hWnd win.HWND) (win.HWND, error)
+
+	// Retrieves information about a window, like its styles.
+	GetWindowLongPtr(hWnd win.HWND, index co.GWLP) (uintptr, error)
+
+	// Retrieves the text of a window.
+	GetWindowText(hWnd win.HWND) (string, error)
+
+	// Sends a message to a window, returning its result.
+	SendMessage(hWnd win.HWND, msg co.WM, wParam win.WPARAM, lParam win.LPARAM) (uintptr, error)
+
+	// Sends a message to a window whose LPARAM is a pointer to a struct or a
+	// buffer, returning its result.
+	SendMessagePtr(hWnd win.HWND, msg co.WM, wParam win.WPARAM, lParam unsafe.Pointer) (uintptr, error)
+
+	// Installs a subclass procedure on a control.
+	SetWindowSubclass(hWnd win.HWND, subclassProc uintptr, idSubclass uint32, refData unsafe.Pointer) error
+
+	// Sets the text of a window.
+	SetWindowText(hWnd win.HWND, text string) error
+}
+
+// Current backend of the native controls.
+var _backend Backend = _Win32Backend{}
+
+// Replaces the [Backend] used by the native controls, returning the previous
+// one. Passing nil restores the default Win32 backend.
+//
+// The backend must be set before the controls are created, and kept until they
+// are destroyed.
+//
+// Example:
+//
+//	fake := ui.NewFakeBackend()
+//	defer ui.SetBackend(ui.SetBackend(fake))
+func SetBackend(backend Backend) Backend {
+	prev := _backend
+	if backend == nil {
+		backend = _Win32Backend{}
+	}
+	_backend = backend
+	return prev
+}
+
+// Default [Backend], which calls the Win32 API.
+type _Win32Backend struct{}
+
+func (_Win32Backend) CreateWindowEx(
+	exStyle co.WS_EX, className, title string, style co.WS,
+	pos win.POINT, size win.SIZE, hParent win.HWND, ctrlId uint16,
+) (win.HWND, error) {
+	hInst, _ := hParent.HInstance()
+	return win.CreateWindowEx(exStyle, win.ClassNameStr(className),
+		title, style, pos, size, hParent, win.HMENU(ctrlId), hInst, win.LPARAM(0))
+}
+
+func (_Win32Backend) GetDlgItem(hDlg win.HWND, ctrlId uint16) (win.HWND, error) {
+	return hDlg.GetDlgItem(ctrlId)
+}
+
+func (_Win32Backend) GetParent(hWnd win.HWND) (win.HWND, error) {
+	return hWnd.GetParent()
+}
+
+func (_Win32Backend) GetWindowLongPtr(hWnd win.HWND, index co.GWLP) (uintptr, error) {
+	return hWnd.GetWindowLongPtr(index)
+}
+
+func (_Win32Backend) GetWindowText(hWnd win.HWND) (string, error) {
+	return hWnd.GetWindowText()
+}
+
+func (_Win32Backend) SendMessage(
+	hWnd win.HWND, msg co.WM, wParam win.WPARAM, lParam win.LPARAM,
+) (uintptr, error) {
+	return hWnd.SendMessage(msg, wParam, lParam)
+}
+
+func (_Win32Backend) SendMessagePtr(
+	hWnd win.HWND, msg co.WM, wParam win.WPARAM, lParam unsafe.Pointer,
+) (uintptr, error) {
+	return hWnd.SendMessage(msg, wParam, win.LPARAM(lParam))
+}
+
+func (_Win32Backend) SetWindowSubclass(
+	hWnd win.HWND, subclassProc uintptr, idSubclass uint32, refData unsafe.Pointer,
+) error {
+	return hWnd.SetWindowSubclass(subclassProc, idSubclass, refData)
+}
+
+func (_Win32Backend) SetWindowText(hWnd win.HWND, text string) error {
+	return hWnd.SetWindowText(text)
+}
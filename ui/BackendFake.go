@@ -0,0 +1,869 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/wstr"
+)
+
+// In-memory [Backend] for automated tests, which doesn't create any real
+// window. It records the state of the native controls (text, check state,
+// items and selection), and lets tests fire WM_COMMAND and WM_NOTIFY events,
+// which run through the same handlers added with On().
+//
+// The following controls are emulated: [Button], [CheckBox], [RadioButton],
+// [ComboBox], [DateTimePicker], [Edit], [Static] and [ListView], including
+// virtual ones with a [ListViewData]. Other messages return zero. Subclass
+// events and custom [Control] windows are not supported.
+//
+// Example:
+//
+//	fake := ui.NewFakeBackend()
+//	defer ui.SetBackend(ui.SetBackend(fake))
+//
+//	wnd := ui.NewMain(ui.OptsMain())
+//	txtName := ui.NewEdit(wnd, ui.OptsEdit())
+//	btnOk := ui.NewButton(wnd, ui.OptsButton().Text("&Ok"))
+//
+//	btnOk.On().BnClicked(func() {
+//		txtName.SetText("clicked")
+//	})
+//
+//	fake.Create(wnd)
+//	fake.Click(btnOk)
+//	println(fake.Text(txtName)) // clicked
+type FakeBackend struct {
+	nextHwnd win.HWND
+	wnds     map[win.HWND]*_FakeWnd
+}
+
+// State of a window emulated by [FakeBackend].
+type _FakeWnd struct {
+	base      *_BaseContainer // set for parent windows only
+	hParent   win.HWND
+	children  []win.HWND // in creation order
+	ctrlId    uint16
+	className string
+	style     co.WS
+	text      string
+
+	check   co.BST         // BUTTON
+	items   []_FakeItem    // COMBOBOX and SysListView32
+	curSel  int            // COMBOBOX
+	cols    []string       // SysListView32
+	nextUid int            // SysListView32
+	exStyle co.LVS_EX      // SysListView32
+	view    co.LV_VIEW     // SysListView32
+	st      win.SYSTEMTIME // SysDateTimePick32
+	hasTime bool           // SysDateTimePick32
+
+	balloonShown              bool // EDIT
+	balloonTitle, balloonText string
+}
+
+// An item of a ComboBox or a ListView.
+type _FakeItem struct {
+	texts []string // one for each column
+	state co.LVIS
+	uid   int
+}
+
+// Creates a new [FakeBackend], which must be installed with [SetBackend].
+func NewFakeBackend() *FakeBackend {
+	return &FakeBackend{
+		nextHwnd: win.HWND(0x7fff_0000), // arbitrary, unlikely to clash with real handles
+		wnds:     make(map[win.HWND]*_FakeWnd),
+	}
+}
+
+// Emulates the creation of a parent window, created with any of the ui.New*
+// functions, instead of calling RunAsMain, ShowModal or similar. Handlers of
+// WM_CREATE or WM_INITDIALOG are run, therefore all child controls are
+// created.
+//
+// Panics if the window was already created.
+func (me *FakeBackend) Create(wnd Parent) {
+	base := wnd.base()
+	if base.hWnd != 0 {
+		panic("Cannot create window twice.")
+	}
+
+	base.hWnd = me.newHwnd(&_FakeWnd{base: base})
+
+	if base.userEvents.defProcVal != 0 { // dialog
+		me.dispatch(base, Wm{co.WM_INITDIALOG, 0, 0})
+	} else {
+		var cs win.CREATESTRUCT
+		me.dispatch(base, Wm{co.WM_CREATE, 0, win.LPARAM(unsafe.Pointer(&cs))})
+	}
+	base.removeWmCreateInitdialog()
+}
+
+// Emulates the destruction of a parent window, running the handlers of
+// WM_DESTROY and WM_NCDESTROY. Its child controls are discarded.
+func (me *FakeBackend) Destroy(wnd Parent) {
+	fw := me.wnd(wnd.Hwnd())
+	me.dispatch(fw.base, Wm{co.WM_DESTROY, 0, 0})
+	me.dispatch(fw.base, Wm{co.WM_NCDESTROY, 0, 0})
+
+	for _, hChild := range fw.children {
+		delete(me.wnds, hChild)
+	}
+	delete(me.wnds, wnd.Hwnd())
+	fw.base.hWnd = win.HWND(0)
+	fw.base.clearMessages()
+}
+
+// Sends a message to a window, either a parent or a control, returning its
+// result.
+func (me *FakeBackend) Send(wnd Window, msg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+	ret, _ := me.SendMessage(wnd.Hwnd(), msg, wParam, lParam)
+	return ret
+}
+
+// Fires a WM_COMMAND notification from the control to its parent, as if done
+// by the user, like a BN_CLICKED or an EN_CHANGE.
+//
+// Note that the state of the control is not changed; for that, use
+// [FakeBackend.Click], [FakeBackend.Type] or [FakeBackend.Select].
+func (me *FakeBackend) Command(ctrl ChildControl, notifCode co.CMD) {
+	me.command(me.wnd(ctrl.Hwnd()), ctrl.Hwnd(), notifCode)
+}
+
+// Fires a WM_NOTIFY notification from the control to its parent, returning
+// the result of the handler.
+//
+// If pHdr is nil, a plain NMHDR is sent. Otherwise it must point to the
+// notification struct, like NMITEMACTIVATE, whose NMHDR header will be filled
+// by this method.
+//
+// Example:
+//
+//	var fake *ui.FakeBackend // initialized somewhere
+//	var lstNames *ui.ListView
+//
+//	nmi := win.NMITEMACTIVATE{IItem: 0}
+//	fake.Notify(lstNames, co.NM_DBLCLK, unsafe.Pointer(&nmi))
+func (me *FakeBackend) Notify(ctrl ChildControl, code co.NM, pHdr unsafe.Pointer) uintptr {
+	return me.notify(me.wnd(ctrl.Hwnd()), ctrl.Hwnd(), code, pHdr)
+}
+
+// Emulates a mouse click on a button, which fires BN_CLICKED. Auto check
+// boxes have their state toggled, and auto radio buttons are checked, with the
+// other radio buttons of the same group being unchecked.
+func (me *FakeBackend) Click(ctrl ChildControl) {
+	me.click(me.wnd(ctrl.Hwnd()), ctrl.Hwnd())
+}
+
+// Emulates the user replacing the text of a control, like an [Edit], which
+// fires EN_CHANGE.
+func (me *FakeBackend) Type(ctrl ChildControl, text string) {
+	fw := me.wnd(ctrl.Hwnd())
+	fw.text = text
+	me.command(fw, ctrl.Hwnd(), co.EN_CHANGE)
+}
+
+// Emulates the user selecting items:
+//   - in a [ComboBox], selects the first index, which fires CBN_SELCHANGE;
+//   - in a [ListView], selects exactly the given indexes, which fires
+//     LVN_ITEMCHANGED for each changed item.
+//
+// Panics if an index is out of range.
+func (me *FakeBackend) Select(ctrl ChildControl, indexes ...int) {
+	hCtrl := ctrl.Hwnd()
+	fw := me.wnd(hCtrl)
+	for _, idx := range indexes {
+		if idx < 0 || idx >= len(fw.items) {
+			panic(fmt.Sprintf("Item index out of range: %d.", idx))
+		}
+	}
+
+	if fw.className == "SysListView32" {
+		selected := make(map[int]struct{}, len(indexes))
+		for _, idx := range indexes {
+			selected[idx] = struct{}{}
+		}
+		for i := range fw.items {
+			state := co.LVIS_NONE
+			if _, ok := selected[i]; ok {
+				state = co.LVIS_SELECTED
+			}
+			me.lvSetState(fw, hCtrl, i, state, co.LVIS_SELECTED)
+		}
+	} else {
+		sel := -1
+		if len(indexes) > 0 {
+			sel = indexes[0]
+		}
+		me.cbSetCurSel(fw, sel)
+		me.command(fw, hCtrl, co.CBN_SELCHANGE)
+	}
+}
+
+// Returns the text of a window.
+func (me *FakeBackend) Text(wnd Window) string {
+	return me.wnd(wnd.Hwnd()).text
+}
+
+// Returns the check state of a [CheckBox] or [RadioButton].
+func (me *FakeBackend) Check(ctrl ChildControl) co.BST {
+	return me.wnd(ctrl.Hwnd()).check
+}
+
+// Returns the texts of the items of a [ComboBox], or the texts of the first
+// column of a [ListView].
+func (me *FakeBackend) Items(ctrl ChildControl) []string {
+	fw := me.wnd(ctrl.Hwnd())
+	texts := make([]string, 0, len(fw.items))
+	for i := range fw.items {
+		texts = append(texts, me.itemText(fw, ctrl.Hwnd(), i, 0))
+	}
+	return texts
+}
+
+// Returns the texts of all columns of a [ListView] item.
+//
+// Panics if the index is out of range.
+func (me *FakeBackend) SubItems(ctrl ChildControl, index int) []string {
+	fw := me.wnd(ctrl.Hwnd())
+	if index < 0 || index >= len(fw.items) {
+		panic(fmt.Sprintf("Item index out of range: %d.", index))
+	} else if fw.isOwnerData() {
+		texts := make([]string, 0, len(fw.cols))
+		for col := range fw.cols {
+			texts = append(texts, me.itemText(fw, ctrl.Hwnd(), index, col))
+		}
+		return texts
+	}
+	return append([]string{}, fw.items[index].texts...)
+}
+
+// Returns the column titles of a [ListView].
+func (me *FakeBackend) Cols(ctrl ChildControl) []string {
+	return append([]string{}, me.wnd(ctrl.Hwnd()).cols...)
+}
+
+// Returns the selected indexes of a [ComboBox] or a [ListView].
+func (me *FakeBackend) Selection(ctrl ChildControl) []int {
+	fw := me.wnd(ctrl.Hwnd())
+	if fw.className == "SysListView32" {
+		indexes := make([]int, 0, len(fw.items))
+		for i, item := range fw.items {
+			if (item.state & co.LVIS_SELECTED) != 0 {
+				indexes = append(indexes, i)
+			}
+		}
+		return indexes
+	} else if fw.curSel >= 0 && fw.curSel < len(fw.items) {
+		return []int{fw.curSel}
+	}
+	return []int{}
+}
+
+// Returns the balloon tip of an [Edit], if currently shown.
+func (me *FakeBackend) Balloon(ctrl ChildControl) (title, text string, shown bool) {
+	fw := me.wnd(ctrl.Hwnd())
+	return fw.balloonTitle, fw.balloonText, fw.balloonShown
+}
+
+// Implements [Backend].
+func (me *FakeBackend) CreateWindowEx(
+	exStyle co.WS_EX, className, title string, style co.WS,
+	pos win.POINT, size win.SIZE, hParent win.HWND, ctrlId uint16,
+) (win.HWND, error) {
+	return me.newCtrl(hParent, ctrlId, className, style, title), nil
+}
+
+// Implements [Backend].
+//
+// Controls of dialogs are created on demand, therefore their class and styles
+// are unknown.
+func (me *FakeBackend) GetDlgItem(hDlg win.HWND, ctrlId uint16) (win.HWND, error) {
+	for _, hChild := range me.wnd(hDlg).children {
+		if me.wnds[hChild].ctrlId == ctrlId {
+			return hChild, nil
+		}
+	}
+	return me.newCtrl(hDlg, ctrlId, "", co.WS(0), ""), nil
+}
+
+// Implements [Backend].
+func (me *FakeBackend) GetParent(hWnd win.HWND) (win.HWND, error) {
+	if fw, ok := me.wnds[hWnd]; ok {
+		return fw.hParent, nil
+	}
+	return win.HWND(0), co.ERROR_INVALID_WINDOW_HANDLE
+}
+
+// Implements [Backend].
+//
+// Only GWLP_ID and GWLP_STYLE are emulated, other indexes return zero.
+func (me *FakeBackend) GetWindowLongPtr(hWnd win.HWND, index co.GWLP) (uintptr, error) {
+	fw, ok := me.wnds[hWnd]
+	if !ok {
+		return 0, co.ERROR_INVALID_WINDOW_HANDLE
+	}
+	switch index {
+	case co.GWLP_ID:
+		return uintptr(fw.ctrlId), nil
+	case co.GWLP_STYLE:
+		return uintptr(fw.style), nil
+	}
+	return 0, nil
+}
+
+// Implements [Backend].
+func (me *FakeBackend) GetWindowText(hWnd win.HWND) (string, error) {
+	if fw, ok := me.wnds[hWnd]; ok {
+		return fw.text, nil
+	}
+	return "", co.ERROR_INVALID_WINDOW_HANDLE
+}
+
+// Implements [Backend].
+func (me *FakeBackend) SetWindowSubclass(
+	hWnd win.HWND, subclassProc uintptr, idSubclass uint32, refData unsafe.Pointer,
+) error {
+	return nil // subclass events are not fired
+}
+
+// Implements [Backend].
+func (me *FakeBackend) SetWindowText(hWnd win.HWND, text string) error {
+	if fw, ok := me.wnds[hWnd]; ok {
+		fw.text = text
+		return nil
+	}
+	return co.ERROR_INVALID_WINDOW_HANDLE
+}
+
+// Implements [Backend].
+func (me *FakeBackend) SendMessage(
+	hWnd win.HWND, msg co.WM, wParam win.WPARAM, lParam win.LPARAM,
+) (uintptr, error) {
+	fw, ok := me.wnds[hWnd]
+	if !ok {
+		return 0, co.ERROR_INVALID_WINDOW_HANDLE
+	}
+	if fw.base != nil {
+		return me.dispatch(fw.base, Wm{msg, wParam, lParam}), nil
+	}
+
+	switch msg {
+	case co.WM_GETTEXTLENGTH:
+		return uintptr(wstr.CountUtf16Len(fw.text)), nil
+
+	case co.BM_CLICK:
+		me.click(fw, hWnd)
+	case co.BM_GETCHECK:
+		return uintptr(fw.check), nil
+	case co.BM_SETCHECK:
+		fw.check = co.BST(wParam)
+
+	case co.EM_HIDEBALLOONTIP:
+		fw.balloonShown = false
+		return 1, nil
+
+	case co.CB_DELETESTRING:
+		idx := int(int32(wParam))
+		if idx < 0 || idx >= len(fw.items) {
+			return fakeRet(-1), nil // CB_ERR
+		}
+		fw.items = append(fw.items[:idx], fw.items[idx+1:]...)
+		if fw.curSel == idx {
+			fw.curSel = -1
+		} else if fw.curSel > idx {
+			fw.curSel--
+		}
+		return uintptr(len(fw.items)), nil
+	case co.CB_GETCOUNT:
+		return uintptr(len(fw.items)), nil
+	case co.CB_GETCURSEL:
+		return fakeRet(fw.curSel), nil
+	case co.CB_GETLBTEXTLEN:
+		idx := int(int32(wParam))
+		if idx < 0 || idx >= len(fw.items) {
+			return fakeRet(-1), nil // CB_ERR
+		}
+		return uintptr(wstr.CountUtf16Len(fw.items[idx].text(0))), nil
+	case co.CB_RESETCONTENT:
+		fw.items = nil
+		me.cbSetCurSel(fw, -1)
+	case co.CB_SETCURSEL:
+		idx := int(int32(wParam))
+		me.cbSetCurSel(fw, idx)
+		if fw.curSel != idx {
+			return fakeRet(-1), nil // CB_ERR
+		}
+		return uintptr(idx), nil
+
+	case co.LVM_DELETEALLITEMS:
+		nm := win.NMLISTVIEW{IItem: -1}
+		if me.notify(fw, hWnd, co.LVN_DELETEALLITEMS, unsafe.Pointer(&nm)) == 0 {
+			for i := range fw.items {
+				nm = win.NMLISTVIEW{IItem: int32(i)}
+				me.notify(fw, hWnd, co.LVN_DELETEITEM, unsafe.Pointer(&nm))
+			}
+		}
+		fw.items = nil
+		return 1, nil
+	case co.LVM_DELETEITEM:
+		idx := int(int32(wParam))
+		if idx < 0 || idx >= len(fw.items) {
+			return 0, nil
+		}
+		nm := win.NMLISTVIEW{IItem: int32(idx)}
+		me.notify(fw, hWnd, co.LVN_DELETEITEM, unsafe.Pointer(&nm))
+		fw.items = append(fw.items[:idx], fw.items[idx+1:]...)
+		return 1, nil
+	case co.LVM_GETEXTENDEDLISTVIEWSTYLE:
+		return uintptr(fw.exStyle), nil
+	case co.LVM_GETITEMCOUNT:
+		return uintptr(len(fw.items)), nil
+	case co.LVM_GETITEMSTATE:
+		idx := int(int32(wParam))
+		if idx < 0 || idx >= len(fw.items) {
+			return 0, nil
+		}
+		return uintptr(fw.items[idx].state & co.LVIS(lParam)), nil
+	case co.LVM_GETNEXTITEM:
+		var mask co.LVIS
+		if (co.LVNI(lParam) & co.LVNI_SELECTED) != 0 {
+			mask |= co.LVIS_SELECTED
+		}
+		if (co.LVNI(lParam) & co.LVNI_FOCUSED) != 0 {
+			mask |= co.LVIS_FOCUSED
+		}
+		for i := int(int32(wParam)) + 1; i < len(fw.items); i++ {
+			if (fw.items[i].state & mask) == mask {
+				return uintptr(i), nil
+			}
+		}
+		return fakeRet(-1), nil
+	case co.LVM_GETSELECTEDCOUNT:
+		count := 0
+		for _, item := range fw.items {
+			if (item.state & co.LVIS_SELECTED) != 0 {
+				count++
+			}
+		}
+		return uintptr(count), nil
+	case co.LVM_GETVIEW:
+		return uintptr(fw.view), nil
+	case co.LVM_MAPIDTOINDEX:
+		for i, item := range fw.items {
+			if item.uid == int(int32(wParam)) {
+				return uintptr(i), nil
+			}
+		}
+		return fakeRet(-1), nil
+	case co.LVM_MAPINDEXTOID:
+		idx := int(int32(wParam))
+		if idx < 0 || idx >= len(fw.items) {
+			return fakeRet(-1), nil
+		}
+		return uintptr(fw.items[idx].uid), nil
+	case co.LVM_SETCALLBACKMASK:
+		return 1, nil
+	case co.LVM_SETEXTENDEDLISTVIEWSTYLE:
+		prev := fw.exStyle
+		mask := co.LVS_EX(wParam)
+		if mask == 0 {
+			mask = ^co.LVS_EX(0)
+		}
+		fw.exStyle = (fw.exStyle &^ mask) | (co.LVS_EX(lParam) & mask)
+		return uintptr(prev), nil
+	case co.LVM_SETITEMCOUNT:
+		count := int(int32(wParam))
+		if !fw.isOwnerData() || count < 0 {
+			return 0, nil
+		} else if count < len(fw.items) {
+			fw.items = fw.items[:count]
+		} else {
+			fw.items = append(fw.items, make([]_FakeItem, count-len(fw.items))...)
+		}
+		return 1, nil
+	case co.LVM_SETVIEW:
+		fw.view = co.LV_VIEW(wParam)
+		return 1, nil
+	case co.LVM_SORTITEMSEX:
+		me.lvSort(fw, uintptr(lParam), uintptr(wParam))
+		return 1, nil
+	case co.LVM_ENSUREVISIBLE, co.LVM_ISITEMVISIBLE, co.LVM_REDRAWITEMS, co.LVM_SCROLL,
+		co.LVM_UPDATE:
+		return 1, nil // no visual state, always succeed
+	}
+
+	return 0, nil
+}
+
+// Implements [Backend].
+func (me *FakeBackend) SendMessagePtr(
+	hWnd win.HWND, msg co.WM, wParam win.WPARAM, lParam unsafe.Pointer,
+) (uintptr, error) {
+	fw, ok := me.wnds[hWnd]
+	if !ok {
+		return 0, co.ERROR_INVALID_WINDOW_HANDLE
+	}
+	if fw.base != nil {
+		return me.dispatch(fw.base, Wm{msg, wParam, win.LPARAM(lParam)}), nil
+	}
+
+	switch msg {
+	case co.WM_SETTEXT:
+		fw.text = wstr.DecodePtr((*uint16)(lParam))
+		return 1, nil
+	case co.EM_SHOWBALLOONTIP:
+		ebt := (*win.EDITBALLOONTIP)(lParam)
+		fw.balloonShown = true
+		fw.balloonTitle = wstr.DecodePtr(ebt.PszTitle)
+		fw.balloonText = wstr.DecodePtr(ebt.PszText)
+		return 1, nil
+	case co.CB_ADDSTRING:
+		return me.cbInsert(fw, len(fw.items), wstr.DecodePtr((*uint16)(lParam))), nil
+	case co.CB_INSERTSTRING:
+		idx := int(int32(wParam))
+		if idx == -1 {
+			idx = len(fw.items)
+		}
+		return me.cbInsert(fw, idx, wstr.DecodePtr((*uint16)(lParam))), nil
+	case co.CB_FINDSTRINGEXACT:
+		text := wstr.DecodePtr((*uint16)(lParam))
+		for i := int(int32(wParam)) + 1; i < len(fw.items); i++ {
+			if strings.EqualFold(fw.items[i].text(0), text) {
+				return uintptr(i), nil
+			}
+		}
+		return fakeRet(-1), nil // CB_ERR
+	case co.CB_GETLBTEXT:
+		idx := int(int32(wParam))
+		if idx < 0 || idx >= len(fw.items) {
+			return fakeRet(-1), nil // CB_ERR
+		}
+		text := fw.items[idx].text(0)
+		buf := unsafe.Slice((*uint16)(lParam), wstr.CountUtf16Len(text)+1)
+		return uintptr(wstr.EncodeToBuf(buf, text) - 1), nil
+	case co.DTM_GETSYSTEMTIME:
+		if !fw.hasTime {
+			return uintptr(co.GDT_NONE), nil
+		}
+		*(*win.SYSTEMTIME)(lParam) = fw.st
+		return uintptr(co.GDT_VALID), nil
+	case co.DTM_SETSYSTEMTIME:
+		fw.hasTime = co.GDT(wParam) == co.GDT_VALID
+		if fw.hasTime {
+			fw.st = *(*win.SYSTEMTIME)(lParam)
+		}
+		return 1, nil
+	case co.LVM_FINDITEM:
+		lvfi := (*win.LVFINDINFO)(lParam)
+		if fw.isOwnerData() { // the parent searches
+			nm := win.NMLVFINDITEM{IStart: int32(wParam) + 1, Lvfi: *lvfi}
+			return me.notify(fw, hWnd, co.LVN_ODFINDITEM, unsafe.Pointer(&nm)), nil
+		}
+		text := wstr.DecodePtr(lvfi.Psz)
+		for i := int(int32(wParam)) + 1; i < len(fw.items); i++ {
+			itemText := fw.items[i].text(0)
+			if (lvfi.Flags&co.LVFI_PARTIAL) != 0 && len(itemText) >= len(text) {
+				itemText = itemText[:len(text)]
+			}
+			if strings.EqualFold(itemText, text) {
+				return uintptr(i), nil
+			}
+		}
+		return fakeRet(-1), nil
+	case co.LVM_GETITEMTEXT:
+		lvi := (*win.LVITEM)(lParam)
+		idx := int(int32(wParam))
+		text := ""
+		if idx >= 0 && idx < len(fw.items) {
+			text = me.itemText(fw, hWnd, idx, int(lvi.ISubItem))
+		}
+		return uintptr(wstr.EncodeToBuf(lvi.PszText(), text) - 1), nil
+	case co.LVM_INSERTCOLUMN:
+		lvc := (*win.LVCOLUMN)(lParam)
+		idx := fakeClampIndex(int(int32(wParam)), len(fw.cols))
+		title := ""
+		if (lvc.Mask & co.LVCF_TEXT) != 0 {
+			title = wstr.DecodeSlice(lvc.PszText())
+		}
+		fw.cols = append(fw.cols[:idx], append([]string{title}, fw.cols[idx:]...)...)
+		return uintptr(idx), nil
+	case co.LVM_INSERTITEM:
+		lvi := (*win.LVITEM)(lParam)
+		idx := fakeClampIndex(int(lvi.IItem), len(fw.items))
+		item := _FakeItem{texts: []string{""}, uid: fw.nextUid}
+		fw.nextUid++
+		if (lvi.Mask & co.LVIF_TEXT) != 0 {
+			item.texts[0] = wstr.DecodeSlice(lvi.PszText())
+		}
+		if (lvi.Mask & co.LVIF_STATE) != 0 {
+			item.state = lvi.State & lvi.StateMask
+		}
+		fw.items = append(fw.items[:idx], append([]_FakeItem{item}, fw.items[idx:]...)...)
+		return uintptr(idx), nil
+	case co.LVM_SETITEMSTATE:
+		lvi := (*win.LVITEM)(lParam)
+		idx := int(int32(wParam))
+		if idx == -1 {
+			for i := range fw.items {
+				me.lvSetState(fw, hWnd, i, lvi.State, lvi.StateMask)
+			}
+			return 1, nil
+		} else if idx < 0 || idx >= len(fw.items) {
+			return 0, nil
+		}
+		me.lvSetState(fw, hWnd, idx, lvi.State, lvi.StateMask)
+		return 1, nil
+	case co.LVM_SETITEMTEXT:
+		lvi := (*win.LVITEM)(lParam)
+		idx := int(int32(wParam))
+		if idx < 0 || idx >= len(fw.items) || lvi.ISubItem < 0 {
+			return 0, nil
+		}
+		item := &fw.items[idx]
+		for len(item.texts) <= int(lvi.ISubItem) {
+			item.texts = append(item.texts, "")
+		}
+		item.texts[lvi.ISubItem] = wstr.DecodeSlice(lvi.PszText())
+		return 1, nil
+	case co.LVM_GETITEM, co.LVM_GETITEMINDEXRECT, co.LVM_GETITEMRECT, co.LVM_SETITEM:
+		return 1, nil // no visual state, always succeed
+	}
+
+	return me.SendMessage(hWnd, msg, wParam, win.LPARAM(lParam))
+}
+
+// Allocates a new handle for the window.
+func (me *FakeBackend) newHwnd(fw *_FakeWnd) win.HWND {
+	me.nextHwnd++
+	me.wnds[me.nextHwnd] = fw
+	return me.nextHwnd
+}
+
+func (me *FakeBackend) newCtrl(
+	hParent win.HWND, ctrlId uint16, className string, style co.WS, text string,
+) win.HWND {
+	fwParent := me.wnd(hParent)
+	fw := &_FakeWnd{
+		hParent:   hParent,
+		ctrlId:    ctrlId,
+		className: className,
+		style:     style,
+		text:      text,
+		curSel:    -1,
+	}
+	if strings.EqualFold(className, "SysDateTimePick32") {
+		fw.st.SetTime(time.Now())
+		fw.hasTime = true
+	}
+
+	hCtrl := me.newHwnd(fw)
+	fwParent.children = append(fwParent.children, hCtrl)
+	return hCtrl
+}
+
+// Returns the state of the window; panics if unknown.
+func (me *FakeBackend) wnd(hWnd win.HWND) *_FakeWnd {
+	fw, ok := me.wnds[hWnd]
+	if !ok {
+		panic(fmt.Sprintf("Window not created by this FakeBackend: %#x.", hWnd))
+	}
+	return fw
+}
+
+// Runs the handlers of the parent window, the same way its window procedure
+// does.
+func (me *FakeBackend) dispatch(base *_BaseContainer, p Wm) uintptr {
+	base.beforeUserEvents.processAll(p)
+	userRet, hasUserRet := base.userEvents.processLast(p)
+	base.afterUserEvents.processAll(p)
+
+	if hasUserRet {
+		return userRet
+	}
+	return 0
+}
+
+func (me *FakeBackend) command(fw *_FakeWnd, hCtrl win.HWND, notifCode co.CMD) {
+	me.SendMessage(fw.hParent, co.WM_COMMAND,
+		win.MAKEWPARAM(fw.ctrlId, uint16(notifCode)), win.LPARAM(hCtrl))
+}
+
+func (me *FakeBackend) notify(fw *_FakeWnd, hCtrl win.HWND, code co.NM, pHdr unsafe.Pointer) uintptr {
+	var plainHdr win.NMHDR
+	if pHdr == nil {
+		pHdr = unsafe.Pointer(&plainHdr)
+	}
+
+	hdr := (*win.NMHDR)(pHdr)
+	hdr.HWndFrom = hCtrl
+	hdr.IdFrom = uintptr(fw.ctrlId)
+	hdr.Code = uint32(code)
+
+	ret, _ := me.SendMessage(fw.hParent, co.WM_NOTIFY,
+		win.WPARAM(fw.ctrlId), win.LPARAM(pHdr))
+	return ret
+}
+
+func (me *FakeBackend) click(fw *_FakeWnd, hCtrl win.HWND) {
+	switch co.BS(fw.style) & co.BS_TYPEMASK {
+	case co.BS_AUTOCHECKBOX:
+		if fw.check == co.BST_CHECKED {
+			fw.check = co.BST_UNCHECKED
+		} else {
+			fw.check = co.BST_CHECKED
+		}
+	case co.BS_AUTO3STATE:
+		fw.check = (fw.check + 1) % 3 // unchecked, checked, indeterminate
+	case co.BS_AUTORADIOBUTTON:
+		for _, hSibling := range me.radioGroup(fw, hCtrl) {
+			me.wnds[hSibling].check = co.BST_UNCHECKED
+		}
+		fw.check = co.BST_CHECKED
+	}
+	me.command(fw, hCtrl, co.BN_CLICKED)
+}
+
+// Returns the auto radio buttons in the same group of the given one, which
+// starts at a WS_GROUP control.
+func (me *FakeBackend) radioGroup(fw *_FakeWnd, hCtrl win.HWND) []win.HWND {
+	var group []win.HWND
+	found := false
+	for _, hSibling := range me.wnd(fw.hParent).children {
+		fwSibling := me.wnds[hSibling]
+		if (fwSibling.style & co.WS_GROUP) != 0 {
+			if found {
+				break
+			}
+			group = group[:0]
+		}
+		if hSibling == hCtrl {
+			found = true
+		}
+		if co.BS(fwSibling.style)&co.BS_TYPEMASK == co.BS_AUTORADIOBUTTON {
+			group = append(group, hSibling)
+		}
+	}
+	return group
+}
+
+func (me *FakeBackend) cbInsert(fw *_FakeWnd, idx int, text string) uintptr {
+	if idx < 0 || idx > len(fw.items) {
+		return fakeRet(-1) // CB_ERR
+	}
+	fw.items = append(fw.items[:idx], append([]_FakeItem{{texts: []string{text}}}, fw.items[idx:]...)...)
+	if fw.curSel >= idx {
+		fw.curSel++
+	}
+	return uintptr(idx)
+}
+
+// Sets the ComboBox selection, which is also reflected in its text.
+func (me *FakeBackend) cbSetCurSel(fw *_FakeWnd, idx int) {
+	if idx < 0 || idx >= len(fw.items) {
+		fw.curSel = -1
+		fw.text = ""
+	} else {
+		fw.curSel = idx
+		fw.text = fw.items[idx].text(0)
+	}
+}
+
+// Changes the state of a ListView item, firing LVN_ITEMCHANGED if changed.
+func (me *FakeBackend) lvSetState(fw *_FakeWnd, hCtrl win.HWND, idx int, state, mask co.LVIS) {
+	if (state & mask & co.LVIS_FOCUSED) != 0 { // only 1 item can have the focus
+		for i := range fw.items {
+			if i != idx {
+				me.lvSetState(fw, hCtrl, i, co.LVIS_NONE, co.LVIS_FOCUSED)
+			}
+		}
+	}
+
+	item := &fw.items[idx]
+	oldState := item.state
+	item.state = (oldState &^ mask) | (state & mask)
+	if item.state != oldState {
+		nm := win.NMLISTVIEW{
+			IItem:     int32(idx),
+			UNewState: item.state,
+			UOldState: oldState,
+			UChanged:  co.LVIF_STATE,
+		}
+		me.notify(fw, hCtrl, co.LVN_ITEMCHANGED, unsafe.Pointer(&nm))
+	}
+}
+
+// Returns the text of a ListView item, which is asked to the parent with
+// LVN_GETDISPINFO if the ListView has the LVS_OWNERDATA style.
+func (me *FakeBackend) itemText(fw *_FakeWnd, hCtrl win.HWND, idx, col int) string {
+	if !fw.isOwnerData() {
+		return fw.items[idx].text(col)
+	}
+
+	var buf [260]uint16 // arbitrary, like a real ListView
+	nm := win.NMLVDISPINFO{
+		Item: win.LVITEM{
+			Mask:     co.LVIF_TEXT,
+			IItem:    int32(idx),
+			ISubItem: int32(col),
+		},
+	}
+	nm.Item.SetPszText(buf[:])
+	me.notify(fw, hCtrl, co.LVN_GETDISPINFO, unsafe.Pointer(&nm))
+	return wstr.DecodeSlice(buf[:])
+}
+
+// Sorts the ListView items with a PFNLVCOMPARE callback, which receives the
+// indexes of the items, as LVM_SORTITEMSEX does.
+func (me *FakeBackend) lvSort(fw *_FakeWnd, pfnCompare, lParamSort uintptr) {
+	order := make([]int, len(fw.items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		ret, _, _ := syscall.SyscallN(pfnCompare,
+			uintptr(order[a]), uintptr(order[b]), lParamSort)
+		return int32(ret) < 0
+	})
+
+	sorted := make([]_FakeItem, 0, len(fw.items))
+	for _, idx := range order {
+		sorted = append(sorted, fw.items[idx])
+	}
+	fw.items = sorted
+}
+
+// Tells whether the window is a ListView with the LVS_OWNERDATA style.
+func (me *_FakeWnd) isOwnerData() bool {
+	return me.className == "SysListView32" && (co.LVS(me.style)&co.LVS_OWNERDATA) != 0
+}
+
+// Returns the text of the given column, or an empty string.
+func (me *_FakeItem) text(col int) string {
+	if col < 0 || col >= len(me.texts) {
+		return ""
+	}
+	return me.texts[col]
+}
+
+// Converts a signed result, like -1, to the value returned by SendMessage.
+func fakeRet(n int) uintptr {
+	return uintptr(n)
+}
+
+// Clamps an insertion index, where out of range values mean "at the end".
+func fakeClampIndex(idx, count int) int {
+	if idx < 0 || idx > count {
+		return count
+	}
+	return idx
+}
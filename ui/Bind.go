@@ -0,0 +1,193 @@
+//go:build windows
+
+package ui
+
+import (
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/uibind"
+	"github.com/rodrigocfd/windigo/wstr"
+)
+
+// Creates a new [uibind.Binder] whose validation errors are displayed to the
+// user: if the first failed field is bound to an [Edit], with a balloon tip;
+// otherwise, all errors are listed with [MsgError].
+//
+// Example:
+//
+//	type Person struct {
+//		Name    string `bind:"name,required"`
+//		Retired bool   `bind:"retired"`
+//	}
+//
+//	var wnd *ui.Modal // initialized somewhere
+//	var txtName *ui.Edit
+//	var chkRetired *ui.CheckBox
+//	var btnOk *ui.Button
+//
+//	person := Person{Name: "John"}
+//	binder := ui.NewBinder(wnd)
+//	uibind.Struct(binder, &person, map[string]any{
+//		"name":    ui.BindEdit(wnd, txtName),
+//		"retired": ui.BindCheckBox(wnd, chkRetired),
+//	})
+//
+//	wnd.On().WmInitDialog(func(_ ui.WmInitDialog) bool {
+//		binder.Load()
+//		return true
+//	})
+//	btnOk.On().BnClicked(func() {
+//		if binder.Save() == nil {
+//			wnd.Hwnd().SendMessage(co.WM_CLOSE, 0, 0)
+//		}
+//	})
+func NewBinder(parent Parent) *uibind.Binder {
+	binder := uibind.NewBinder()
+	binder.OnError(func(errs []*uibind.FieldError) {
+		if shower, ok := errs[0].Widget.(uibind.ErrorShower); ok {
+			shower.ShowError(errs[0])
+			return
+		}
+
+		lines := make([]string, 0, len(errs))
+		for _, err := range errs {
+			lines = append(lines, err.Name+": "+err.Err.Error()+".")
+		}
+		MsgError(parent, "Error", "Invalid values", strings.Join(lines, "\n"))
+	})
+	return binder
+}
+
+// Returns a [uibind.Widget] for the text of an [Edit]. Validation errors are
+// displayed with a balloon tip, and the field is updated on EN_CHANGE.
+//
+// The parent is used to receive the change notifications, so this function
+// must be called before the parent is created.
+func BindEdit(parent Parent, edit *Edit) uibind.Widget[string] {
+	return &_BindEdit{parent, edit}
+}
+
+type _BindEdit struct {
+	parent Parent
+	ctrl   *Edit
+}
+
+func (me *_BindEdit) Value() string       { return me.ctrl.Text() }
+func (me *_BindEdit) SetValue(val string) { me.ctrl.SetText(val) }
+func (me *_BindEdit) HideError()          { me.ctrl.HideBalloonTip() }
+
+func (me *_BindEdit) ShowError(err *uibind.FieldError) {
+	me.ctrl.ShowBalloonTip(err.Name, wstr.Capitalize(err.Err.Error())+".", co.TTI_ERROR)
+	me.ctrl.Focus()
+}
+
+func (me *_BindEdit) OnChange(fun func()) {
+	me.parent.base().afterUserEvents.wmCommand(me.ctrl.CtrlId(), co.EN_CHANGE, fun)
+}
+
+// Returns a [uibind.Widget] for the check state of a [CheckBox]. The field is
+// updated on BN_CLICKED.
+//
+// The parent is used to receive the change notifications, so this function
+// must be called before the parent is created.
+func BindCheckBox(parent Parent, checkBox *CheckBox) uibind.Widget[bool] {
+	return &_BindCheckBox{parent, checkBox}
+}
+
+type _BindCheckBox struct {
+	parent Parent
+	ctrl   *CheckBox
+}
+
+func (me *_BindCheckBox) Value() bool       { return me.ctrl.IsChecked() }
+func (me *_BindCheckBox) SetValue(val bool) { me.ctrl.SetCheck(val) }
+
+func (me *_BindCheckBox) OnChange(fun func()) {
+	me.parent.base().afterUserEvents.wmCommand(me.ctrl.CtrlId(), co.BN_CLICKED, fun)
+}
+
+// Returns a [uibind.Widget] for the selected index of a [ComboBox], which is
+// -1 if there is no selection. The field is updated on CBN_SELCHANGE.
+//
+// The parent is used to receive the change notifications, so this function
+// must be called before the parent is created.
+func BindComboBoxIndex(parent Parent, comboBox *ComboBox) uibind.Widget[int] {
+	return &_BindComboBoxIndex{parent, comboBox}
+}
+
+type _BindComboBoxIndex struct {
+	parent Parent
+	ctrl   *ComboBox
+}
+
+func (me *_BindComboBoxIndex) Value() int       { return me.ctrl.SelectedIndex() }
+func (me *_BindComboBoxIndex) SetValue(val int) { me.ctrl.SelectIndex(val) }
+
+func (me *_BindComboBoxIndex) OnChange(fun func()) {
+	me.parent.base().afterUserEvents.wmCommand(me.ctrl.CtrlId(), co.CBN_SELCHANGE, fun)
+}
+
+// Returns a [uibind.Widget] for the text of a [ComboBox]. When set, the item
+// with the same text, case-insensitive, is selected; if there is none, the
+// selection is cleared and, in editable combo boxes, the text is written. The
+// field is updated on CBN_SELCHANGE and CBN_EDITCHANGE.
+//
+// The parent is used to receive the change notifications, so this function
+// must be called before the parent is created.
+func BindComboBoxText(parent Parent, comboBox *ComboBox) uibind.Widget[string] {
+	return &_BindComboBoxText{parent, comboBox}
+}
+
+type _BindComboBoxText struct {
+	parent Parent
+	ctrl   *ComboBox
+}
+
+func (me *_BindComboBoxText) Value() string {
+	if idx := me.ctrl.SelectedIndex(); idx != -1 {
+		return me.ctrl.Item(idx) // CBN_SELCHANGE is sent before the text is updated
+	}
+	return me.ctrl.CurrentText()
+}
+
+func (me *_BindComboBoxText) SetValue(val string) {
+	for idx, item := range me.ctrl.AllItems() {
+		if strings.EqualFold(item, val) {
+			me.ctrl.SelectIndex(idx)
+			return
+		}
+	}
+	me.ctrl.SelectIndex(-1)
+	me.ctrl.setWindowText(val)
+}
+
+func (me *_BindComboBoxText) OnChange(fun func()) {
+	ctrlId := me.ctrl.CtrlId()
+	me.parent.base().afterUserEvents.wmCommand(ctrlId, co.CBN_SELCHANGE, fun)
+	me.parent.base().afterUserEvents.wmCommand(ctrlId, co.CBN_EDITCHANGE, fun)
+}
+
+// Returns a [uibind.Widget] for the time of a [DateTimePicker]. The field is
+// updated on DTN_DATETIMECHANGE.
+//
+// The parent is used to receive the change notifications, so this function
+// must be called before the parent is created.
+func BindDateTimePicker(parent Parent, dtp *DateTimePicker) uibind.Widget[time.Time] {
+	return &_BindDateTimePicker{parent, dtp}
+}
+
+type _BindDateTimePicker struct {
+	parent Parent
+	ctrl   *DateTimePicker
+}
+
+func (me *_BindDateTimePicker) Value() time.Time       { return me.ctrl.Time() }
+func (me *_BindDateTimePicker) SetValue(val time.Time) { me.ctrl.SetTime(val) }
+
+func (me *_BindDateTimePicker) OnChange(fun func()) {
+	me.parent.base().afterUserEvents.wmNotify(me.ctrl.CtrlId(), co.DTN_DATETIMECHANGE,
+		func(_ unsafe.Pointer) { fun() })
+}
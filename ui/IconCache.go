@@ -4,30 +4,48 @@ package ui
 
 import (
 	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/ico"
 	"github.com/rodrigocfd/windigo/win"
 )
 
-// An icon to be loaded, either from resource or from a Windows Explorer file
-// extension.
+// An icon to be loaded, either from resource, from a Windows Explorer file
+// extension or from an .ico file.
 type Ico struct {
-	id  uint16 // Resource ID.
-	ext string // File extension.
+	id   uint16    // Resource ID.
+	ext  string    // File extension.
+	file *ico.File // Parsed .ico file.
 }
 
 // Will load the icon with the given resource ID from the resource.
 func IcoId(iconId uint16) Ico {
-	return Ico{iconId, ""}
+	return Ico{iconId, "", nil}
 }
 
 // Will load the icon of the given file extension, as displayed by the Windows
 // Explorer, like "mp3".
 func IcoExt(fileExtension string) Ico {
-	return Ico{0, fileExtension}
+	return Ico{0, fileExtension, nil}
 }
 
-// Returns true if there is an icon ID or a Windows Explorer file extension.
+// Will create the icon from the entry of the .ico file which best fits the
+// needed size. The same *ico.File pointer must be passed to reuse a cached
+// icon.
+//
+// Example:
+//
+//	//go:embed save.ico
+//	var saveIco []byte
+//
+//	saveFile, _ := ico.Parse(saveIco)
+//	lv.Item(0).SetIcon16(ui.IcoFile(saveFile))
+func IcoFile(file *ico.File) Ico {
+	return Ico{0, "", file}
+}
+
+// Returns true if there is an icon ID, a Windows Explorer file extension or an
+// .ico file.
 func (me *Ico) isValid() bool {
-	return me.id > 0 || len(me.ext) > 0
+	return me.id > 0 || len(me.ext) > 0 || me.file != nil
 }
 
 // If the icon is a resource ID, returns it and true.
@@ -37,7 +55,12 @@ func (me *Ico) Id() (uint16, bool) {
 
 // If the icon is a shell file extension, returns it and true.
 func (me *Ico) Ext() (string, bool) {
-	return me.ext, me.id <= 0
+	return me.ext, me.id <= 0 && me.file == nil
+}
+
+// If the icon is an .ico file, returns it and true.
+func (me *Ico) File() (*ico.File, bool) {
+	return me.file, me.file != nil
 }
 
 // Caches icons in a [win.HIMAGELIST], and returns them on-demand.
@@ -75,10 +98,10 @@ func (me *_IconCacheImgList) EntryByIndex(index int) (Ico, bool) {
 //   - zero-based index of the icon within the image list.
 func (me *_IconCacheImgList) IconIndex(
 	resolution int,
-	ico Ico,
+	icon Ico,
 ) (hImgList win.HIMAGELIST, newImgList bool, idxIcon int) {
 	for idx, entry := range me.entries {
-		if entry == ico {
+		if entry == icon {
 			return me.hImgList, false, idx // already cached
 		}
 	}
@@ -94,17 +117,26 @@ func (me *_IconCacheImgList) IconIndex(
 		justCreateImgList = true
 	}
 
-	if ico.id > 0 {
-		if err := me.hImgList.AddIconFromResource(ico.id); err != nil {
+	if icon.id > 0 {
+		if err := me.hImgList.AddIconFromResource(icon.id); err != nil {
 			panic("AddIconFromResource failed " + err.Error())
 		}
+	} else if icon.file != nil {
+		hIcon, err := win.CreateIconFromIco(icon.file, resolution)
+		if err != nil {
+			panic("CreateIconFromIco failed " + err.Error())
+		}
+		defer hIcon.DestroyIcon()
+		if err := me.hImgList.AddIcon(hIcon); err != nil {
+			panic("AddIcon failed " + err.Error())
+		}
 	} else {
-		if err := me.hImgList.AddIconFromShell(ico.ext); err != nil {
+		if err := me.hImgList.AddIconFromShell(icon.ext); err != nil {
 			panic("AddIconFromResource failed " + err.Error())
 		}
 	}
 
-	me.entries = append(me.entries, ico)
+	me.entries = append(me.entries, icon)
 	return me.hImgList, justCreateImgList, len(me.entries) - 1 // index of last icon
 }
 
@@ -130,23 +162,29 @@ func (me *_IconCacheHicon) Release() {
 }
 
 // Loads the icon, if not yet. Returns its handle.
-func (me *_IconCacheHicon) Handle(resolution int, ico Ico) win.HICON {
-	for _, icon := range me.icons {
-		if ico == icon.entry {
-			return icon.hIcon // already cached
+func (me *_IconCacheHicon) Handle(resolution int, icon Ico) win.HICON {
+	for _, cached := range me.icons {
+		if icon == cached.entry {
+			return cached.hIcon // already cached
 		}
 	}
 
 	var hIconNew win.HICON
-	if ico.id > 0 {
+	if icon.id > 0 {
 		hInst, _ := win.GetModuleHandle("")
-		hIcon, err := hInst.LoadIcon(win.IconResId(ico.id))
+		hIcon, err := hInst.LoadIcon(win.IconResId(icon.id))
 		if err != nil {
 			panic("LoadIcon failed " + err.Error())
 		}
 		hIconNew = hIcon
+	} else if icon.file != nil {
+		hIcon, err := win.CreateIconFromIco(icon.file, resolution)
+		if err != nil {
+			panic("CreateIconFromIco failed " + err.Error())
+		}
+		hIconNew = hIcon
 	} else {
-		hIcon, err := win.LoadIconOfFileExt(ico.ext, resolution)
+		hIcon, err := win.LoadIconOfFileExt(icon.ext, resolution)
 		if err != nil {
 			panic("Failed to load file extension icon.")
 		}
@@ -156,6 +194,6 @@ func (me *_IconCacheHicon) Handle(resolution int, ico Ico) win.HICON {
 	me.icons = append(me.icons, struct {
 		hIcon win.HICON
 		entry Ico
-	}{hIconNew, ico})
+	}{hIconNew, icon})
 	return hIconNew
 }
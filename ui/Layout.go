@@ -57,6 +57,7 @@ const (
 type _Layout struct {
 	ctrls  []_LayoutCtrl
 	szOrig win.SIZE // Original size of parent's client area.
+	box    *Box     // Flexible layout, if any; set by Box.Attach.
 }
 
 type _LayoutCtrl struct {
@@ -94,6 +95,11 @@ func (me *_Layout) Add(parent Parent, hCtrl win.HWND, layout LAY) {
 
 // Rearrange all children. To be called during WM_SIZE processing.
 func (me *_Layout) Rearrange(parm WmSize) {
+	if me.box != nil && parm.Request() != co.SIZE_REQ_MINIMIZED {
+		sz := parm.ClientAreaSize()
+		me.box.arrange(win.RECT{Right: sz.Cx, Bottom: sz.Cy})
+	}
+
 	if len(me.ctrls) == 0 || // no controls to resize
 		parm.Request() == co.SIZE_REQ_MINIMIZED || // window is minimized
 		me.szOrig == parm.ClientAreaSize() { // no change in window size
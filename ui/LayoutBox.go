@@ -0,0 +1,649 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/win"
+)
+
+// Kind of a [Box] layout.
+type BOX uint8
+
+const (
+	// Items are placed side by side, from left to right.
+	BOX_ROW BOX = iota
+	// Items are stacked from top to bottom.
+	BOX_COLUMN
+	// Items are placed in a grid, filled row by row.
+	BOX_GRID
+)
+
+// Alignment of a [Box] item within the space given to it, in the cross axis of
+// rows and columns, and in both axes of grid cells.
+type BOXALIGN uint8
+
+const (
+	// Item takes all the available space.
+	BOXALIGN_FILL BOXALIGN = iota
+	// Item keeps its preferred size, aligned to the left or top.
+	BOXALIGN_START
+	// Item keeps its preferred size, centered.
+	BOXALIGN_CENTER
+	// Item keeps its preferred size, aligned to the right or bottom.
+	BOXALIGN_END
+)
+
+// Flexible layout manager, which arranges controls and nested boxes in rows,
+// columns and grids. It's an alternative to the LAY anchors, allowing controls
+// to share the extra space, and to wrap.
+//
+// Each item has a preferred size, which by default is the size the control had
+// when it was created. Extra space along the main axis is distributed among the
+// items according to their stretch factors, respecting their min and max sizes;
+// items with zero stretch keep their preferred size.
+//
+// Example:
+//
+//	var wnd *ui.Main // initialized somewhere
+//	var txtSearch *ui.Edit
+//	var btnGo *ui.Button
+//	var lstItems *ui.ListView
+//
+//	ui.NewBox(ui.OptsBox().Kind(ui.BOX_COLUMN).Margin(ui.Dpi(8, 8)).Spacing(ui.DpiY(8))).
+//		AddBox(
+//			ui.NewBox(ui.OptsBox().Kind(ui.BOX_ROW).Spacing(ui.DpiX(8))).
+//				AddCtrl(txtSearch, ui.OptsBoxItem().Stretch(1).Align(ui.BOXALIGN_CENTER)).
+//				AddCtrl(btnGo, ui.OptsBoxItem()),
+//			ui.OptsBoxItem(),
+//		).
+//		AddCtrl(lstItems, ui.OptsBoxItem().Stretch(1)).
+//		Attach(wnd)
+type Box struct {
+	opts  VarOptsBox
+	items []_BoxItem
+}
+
+type _BoxItem struct {
+	ctrl Window // either ctrl or box is set
+	box  *Box
+	opts VarOptsBoxItem
+	pref win.SIZE // preferred size, without margins
+}
+
+// Creates a new [Box].
+func NewBox(opts *VarOptsBox) *Box {
+	return &Box{
+		opts:  *opts,
+		items: make([]_BoxItem, 0, 4), // arbitrary
+	}
+}
+
+// Adds a control to the box.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Box) AddCtrl(ctrl Window, opts *VarOptsBoxItem) *Box {
+	me.items = append(me.items, _BoxItem{ctrl: ctrl, opts: *opts, pref: opts.size})
+	return me
+}
+
+// Adds a nested box, whose preferred size is computed from its items.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Box) AddBox(box *Box, opts *VarOptsBoxItem) *Box {
+	me.items = append(me.items, _BoxItem{box: box, opts: *opts, pref: opts.size})
+	return me
+}
+
+// Makes the box the layout manager of the parent window. The controls are
+// arranged when the parent is created, and whenever it's resized.
+//
+// Must be called after all the controls were constructed. Controls with LAY
+// anchors other than LAY_HOLD_HOLD should not be added to the box.
+//
+// Panics if called after the parent has been created.
+func (me *Box) Attach(parent Parent) {
+	if parent.Hwnd() != 0 {
+		panic("Cannot attach a Box after the parent has been created.")
+	}
+
+	parent.base().afterUserEvents.wmCreateOrInitdialog(func() {
+		me.capturePrefSizes()
+		parent.base().layout.box = me
+		rc, _ := parent.Hwnd().GetClientRect()
+		me.arrange(rc)
+	})
+}
+
+// Computes the rectangles of all the controls of the box, and its nested
+// boxes, within the given rectangle. The rectangles are returned in the same
+// order the controls were added, depth-first.
+//
+// This is a pure function, which doesn't touch any window: unset preferred
+// sizes are taken as zero, because they are only read from the controls by
+// [Box.Attach].
+func (me *Box) Solve(rc win.RECT) []win.RECT {
+	rects := make([]win.RECT, 0, len(me.items))
+	me.solve(rc, &rects)
+	return rects
+}
+
+// Fills the preferred sizes not informed by the user with the current sizes of
+// the controls, recursively.
+func (me *Box) capturePrefSizes() {
+	for i := range me.items {
+		item := &me.items[i]
+		if item.box != nil {
+			item.box.capturePrefSizes()
+		} else if item.pref.Cx == 0 || item.pref.Cy == 0 {
+			rc, _ := item.ctrl.Hwnd().GetWindowRect()
+			if item.pref.Cx == 0 {
+				item.pref.Cx = rc.Right - rc.Left
+			}
+			if item.pref.Cy == 0 {
+				item.pref.Cy = rc.Bottom - rc.Top
+			}
+		}
+	}
+}
+
+// Moves and resizes all the controls to fit the given rectangle.
+func (me *Box) arrange(rc win.RECT) {
+	rects := me.Solve(rc)
+	ctrls := make([]Window, 0, len(rects))
+	me.collectCtrls(&ctrls)
+
+	hdwp, _ := win.BeginDeferWindowPos(len(ctrls))
+	defer hdwp.EndDeferWindowPos()
+
+	for i, ctrl := range ctrls {
+		r := rects[i]
+		hdwp.DeferWindowPos(ctrl.Hwnd(), win.HWND(0),
+			win.POINT{X: r.Left, Y: r.Top},
+			win.SIZE{Cx: r.Right - r.Left, Cy: r.Bottom - r.Top},
+			co.SWP_NOZORDER)
+	}
+}
+
+// Appends the controls in the same order of Solve.
+func (me *Box) collectCtrls(ctrls *[]Window) {
+	for i := range me.items {
+		if me.items[i].box != nil {
+			me.items[i].box.collectCtrls(ctrls)
+		} else {
+			*ctrls = append(*ctrls, me.items[i].ctrl)
+		}
+	}
+}
+
+// Returns the preferred size of the item, clamped to its min and max sizes,
+// without margins.
+func (me *_BoxItem) prefSize() win.SIZE {
+	pref := me.pref
+	if me.box != nil {
+		natural := me.box.naturalSize()
+		if pref.Cx == 0 {
+			pref.Cx = natural.Cx
+		}
+		if pref.Cy == 0 {
+			pref.Cy = natural.Cy
+		}
+	}
+	return win.SIZE{
+		Cx: boxClamp(pref.Cx, me.opts.minSize.Cx, me.opts.maxSize.Cx),
+		Cy: boxClamp(pref.Cy, me.opts.minSize.Cy, me.opts.maxSize.Cy),
+	}
+}
+
+// Returns the size needed by the box to give all items their preferred sizes,
+// including margins and spacing.
+func (me *Box) naturalSize() win.SIZE {
+	var sz win.SIZE
+	m := me.opts.margin
+
+	switch me.opts.kind {
+	case BOX_ROW, BOX_COLUMN:
+		horz := me.opts.kind == BOX_ROW
+		for i := range me.items {
+			outer := me.items[i].outerPrefSize()
+			if horz {
+				sz.Cx += outer.Cx
+				sz.Cy = max32(sz.Cy, outer.Cy)
+			} else {
+				sz.Cx = max32(sz.Cx, outer.Cx)
+				sz.Cy += outer.Cy
+			}
+		}
+		if n := int32(len(me.items)); n > 1 {
+			if horz {
+				sz.Cx += me.opts.spacing * (n - 1)
+			} else {
+				sz.Cy += me.opts.spacing * (n - 1)
+			}
+		}
+
+	case BOX_GRID:
+		cols, rows := me.gridTracks()
+		for _, col := range cols {
+			sz.Cx += col.base
+		}
+		for _, row := range rows {
+			sz.Cy += row.base
+		}
+		if len(cols) > 1 {
+			sz.Cx += me.opts.spacing * int32(len(cols)-1)
+		}
+		if len(rows) > 1 {
+			sz.Cy += me.opts.spacing * int32(len(rows)-1)
+		}
+	}
+
+	sz.Cx += m.Left + m.Right
+	sz.Cy += m.Top + m.Bottom
+	return sz
+}
+
+// Returns the preferred size including margins.
+func (me *_BoxItem) outerPrefSize() win.SIZE {
+	pref := me.prefSize()
+	m := me.opts.margin
+	return win.SIZE{Cx: pref.Cx + m.Left + m.Right, Cy: pref.Cy + m.Top + m.Bottom}
+}
+
+// Places the box items within the rectangle, appending the control rectangles.
+func (me *Box) solve(rc win.RECT, out *[]win.RECT) {
+	m := me.opts.margin
+	inner := win.RECT{
+		Left:   rc.Left + m.Left,
+		Top:    rc.Top + m.Top,
+		Right:  max32(rc.Left+m.Left, rc.Right-m.Right),
+		Bottom: max32(rc.Top+m.Top, rc.Bottom-m.Bottom),
+	}
+
+	if me.opts.kind == BOX_GRID {
+		me.solveGrid(inner, out)
+	} else {
+		me.solveFlex(inner, out)
+	}
+}
+
+// Places the items of a row or column box, wrapping them into multiple lines,
+// if enabled.
+func (me *Box) solveFlex(inner win.RECT, out *[]win.RECT) {
+	horz := me.opts.kind == BOX_ROW
+	mainStart, mainLen := inner.Left, inner.Right-inner.Left
+	crossStart, crossLen := inner.Top, inner.Bottom-inner.Top
+	if !horz {
+		mainStart, mainLen, crossStart, crossLen = crossStart, crossLen, mainStart, mainLen
+	}
+
+	// Split the items into lines; without wrapping, there's a single one.
+	lines := make([][]int, 0, 1)
+	line := make([]int, 0, len(me.items))
+	var lineLen int32
+	for i := range me.items {
+		outer := boxAxis(me.items[i].outerPrefSize(), horz)
+		if me.opts.wrap && len(line) > 0 && lineLen+me.opts.spacing+outer > mainLen {
+			lines = append(lines, line)
+			line, lineLen = make([]int, 0, len(me.items)-i), 0
+		}
+		if len(line) > 0 {
+			lineLen += me.opts.spacing
+		}
+		line = append(line, i)
+		lineLen += outer
+	}
+	lines = append(lines, line)
+
+	crossPos := crossStart
+	for _, line := range lines {
+		lineCross := crossLen
+		if me.opts.wrap { // each line takes its preferred cross size
+			lineCross = 0
+			for _, idx := range line {
+				lineCross = max32(lineCross, boxAxis(me.items[idx].outerPrefSize(), !horz))
+			}
+		}
+
+		tracks := make([]_BoxTrack, 0, len(line))
+		avail := mainLen - me.opts.spacing*int32(len(line)-1)
+		for _, idx := range line {
+			item := &me.items[idx]
+			mainMargin := boxMargins(item.opts.margin, horz)
+			avail -= mainMargin
+			tracks = append(tracks, _BoxTrack{
+				base:    boxAxis(item.prefSize(), horz),
+				min:     boxAxis(item.opts.minSize, horz),
+				max:     boxAxis(item.opts.maxSize, horz),
+				stretch: item.opts.stretch,
+			})
+		}
+		sizes := boxDistribute(tracks, avail)
+
+		mainPos := mainStart
+		for j, idx := range line {
+			item := &me.items[idx]
+			mMain, mCross := boxMarginStart(item.opts.margin, horz), boxMarginStart(item.opts.margin, !horz)
+			mainPos += mMain
+			cross, crossSz := item.align(crossPos+mCross, lineCross-boxMargins(item.opts.margin, !horz), !horz)
+
+			var r win.RECT
+			if horz {
+				r = win.RECT{Left: mainPos, Top: cross, Right: mainPos + sizes[j], Bottom: cross + crossSz}
+			} else {
+				r = win.RECT{Left: cross, Top: mainPos, Right: cross + crossSz, Bottom: mainPos + sizes[j]}
+			}
+			item.place(r, out)
+			mainPos += sizes[j] + boxMargins(item.opts.margin, horz) - mMain + me.opts.spacing
+		}
+		crossPos += lineCross + me.opts.spacing
+	}
+}
+
+// Places the items of a grid box.
+func (me *Box) solveGrid(inner win.RECT, out *[]win.RECT) {
+	cols, rows := me.gridTracks()
+	if len(cols) == 0 {
+		return
+	}
+	widths := boxDistribute(cols, inner.Right-inner.Left-me.opts.spacing*int32(len(cols)-1))
+	heights := boxDistribute(rows, inner.Bottom-inner.Top-me.opts.spacing*int32(len(rows)-1))
+
+	y := inner.Top
+	for r := range rows {
+		x := inner.Left
+		for c := range cols {
+			idx := r*len(cols) + c
+			if idx >= len(me.items) {
+				break
+			}
+			item := &me.items[idx]
+			m := item.opts.margin
+			left, cx := item.align(x+m.Left, widths[c]-m.Left-m.Right, true)
+			top, cy := item.align(y+m.Top, heights[r]-m.Top-m.Bottom, false)
+			item.place(win.RECT{Left: left, Top: top, Right: left + cx, Bottom: top + cy}, out)
+			x += widths[c] + me.opts.spacing
+		}
+		y += heights[r] + me.opts.spacing
+	}
+}
+
+// Returns the column and row tracks of a grid box. A track takes the largest
+// preferred and min sizes of its items, including margins, and their largest
+// stretch factor.
+func (me *Box) gridTracks() (cols, rows []_BoxTrack) {
+	numCols := me.opts.cols
+	if numCols < 1 {
+		numCols = 1
+	}
+	numRows := (len(me.items) + numCols - 1) / numCols
+	if numCols > len(me.items) {
+		cols = make([]_BoxTrack, len(me.items))
+	} else {
+		cols = make([]_BoxTrack, numCols)
+	}
+	rows = make([]_BoxTrack, numRows)
+
+	for i := range me.items {
+		item := &me.items[i]
+		col, row := &cols[i%numCols], &rows[i/numCols]
+		outer := item.outerPrefSize()
+		m := item.opts.margin
+
+		col.base = max32(col.base, outer.Cx)
+		col.min = max32(col.min, item.opts.minSize.Cx+m.Left+m.Right)
+		if item.opts.stretch > col.stretch {
+			col.stretch = item.opts.stretch
+		}
+		row.base = max32(row.base, outer.Cy)
+		row.min = max32(row.min, item.opts.minSize.Cy+m.Top+m.Bottom)
+		if item.opts.stretch > row.stretch {
+			row.stretch = item.opts.stretch
+		}
+	}
+	return cols, rows
+}
+
+// Returns the position and size of the item along an axis, within the given
+// space, according to its alignment.
+func (me *_BoxItem) align(start, avail int32, horz bool) (int32, int32) {
+	pref := boxAxis(me.prefSize(), horz)
+	sz := avail
+	if me.opts.align != BOXALIGN_FILL {
+		sz = min32(pref, avail)
+	}
+	sz = boxClamp(sz, boxAxis(me.opts.minSize, horz), boxAxis(me.opts.maxSize, horz))
+
+	switch me.opts.align {
+	case BOXALIGN_CENTER:
+		return start + (avail-sz)/2, sz
+	case BOXALIGN_END:
+		return start + avail - sz, sz
+	default:
+		return start, sz
+	}
+}
+
+// Appends the control rectangle, or solves the nested box within it.
+func (me *_BoxItem) place(rc win.RECT, out *[]win.RECT) {
+	if me.box != nil {
+		me.box.solve(rc, out)
+	} else {
+		*out = append(*out, rc)
+	}
+}
+
+// A row, column, or line item being sized along an axis.
+type _BoxTrack struct {
+	base, min, max int32 // zero min or max means no constraint
+	stretch        int
+}
+
+// Distributes the available length among the tracks. Extra space is given to
+// the tracks with stretch factors, proportionally, up to their max sizes.
+// Missing space is taken from the same tracks, down to their min sizes; if
+// there are no stretch factors, the tracks overflow.
+func boxDistribute(tracks []_BoxTrack, avail int32) []int32 {
+	sizes := make([]int32, len(tracks))
+	var total int32
+	for i, t := range tracks {
+		sizes[i] = boxClamp(t.base, t.min, t.max)
+		total += sizes[i]
+	}
+
+	frozen := make([]bool, len(tracks))
+	for extra := avail - total; extra != 0; {
+		var sumStretch int
+		lastIdx := -1
+		for i, t := range tracks {
+			if !frozen[i] && t.stretch > 0 {
+				sumStretch += t.stretch
+				lastIdx = i
+			}
+		}
+		if sumStretch == 0 {
+			break
+		}
+
+		var given int32
+		anyFrozen := false
+		for i, t := range tracks {
+			if frozen[i] || t.stretch == 0 {
+				continue
+			}
+			share := int32(int64(extra) * int64(t.stretch) / int64(sumStretch))
+			if i == lastIdx {
+				share = extra - given // rounding leftovers go to the last one
+			}
+			newSz := boxClamp(sizes[i]+share, t.min, t.max)
+			if newSz < 0 {
+				newSz = 0
+			}
+			if newSz != sizes[i]+share {
+				frozen[i] = true // hit a limit, won't take part in next rounds
+				anyFrozen = true
+			}
+			given += newSz - sizes[i]
+			sizes[i] = newSz
+		}
+
+		extra -= given
+		if !anyFrozen {
+			break
+		}
+	}
+	return sizes
+}
+
+// Clamps the value to min and max, where zero means no constraint.
+func boxClamp(val, minVal, maxVal int32) int32 {
+	if maxVal > 0 && val > maxVal {
+		val = maxVal
+	}
+	if val < minVal {
+		val = minVal
+	}
+	return val
+}
+
+// Returns the width, if horz, or the height.
+func boxAxis(sz win.SIZE, horz bool) int32 {
+	if horz {
+		return sz.Cx
+	}
+	return sz.Cy
+}
+
+// Returns the sum of both margins along the axis.
+func boxMargins(m win.RECT, horz bool) int32 {
+	if horz {
+		return m.Left + m.Right
+	}
+	return m.Top + m.Bottom
+}
+
+// Returns the left or top margin.
+func boxMarginStart(m win.RECT, horz bool) int32 {
+	if horz {
+		return m.Left
+	}
+	return m.Top
+}
+
+func max32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Options for [NewBox]; returned by [OptsBox].
+type VarOptsBox struct {
+	kind    BOX
+	cols    int
+	spacing int32
+	margin  win.RECT
+	wrap    bool
+}
+
+// Options for [NewBox].
+func OptsBox() *VarOptsBox {
+	return &VarOptsBox{}
+}
+
+// Kind of the box.
+//
+// Defaults to ui.BOX_ROW.
+func (o *VarOptsBox) Kind(k BOX) *VarOptsBox { o.kind = k; return o }
+
+// Number of columns, for a ui.BOX_GRID box.
+//
+// Defaults to 1.
+func (o *VarOptsBox) Cols(n int) *VarOptsBox { o.cols = n; return o }
+
+// Space between items, and between wrapped lines, in pixels.
+//
+// Defaults to 0.
+func (o *VarOptsBox) Spacing(s int) *VarOptsBox { o.spacing = int32(s); return o }
+
+// Inner margins of the box, between its borders and its items, in pixels.
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsBox) Margin(horz, vert int) *VarOptsBox {
+	o.margin = win.RECT{Left: int32(horz), Top: int32(vert), Right: int32(horz), Bottom: int32(vert)}
+	return o
+}
+
+// If true, the items of a ui.BOX_ROW or ui.BOX_COLUMN box which don't fit are
+// moved into a new line. Each line takes the largest preferred size of its
+// items.
+//
+// Defaults to false.
+func (o *VarOptsBox) Wrap(w bool) *VarOptsBox { o.wrap = w; return o }
+
+// Options for [Box.AddCtrl] and [Box.AddBox]; returned by [OptsBoxItem].
+type VarOptsBoxItem struct {
+	stretch int
+	size    win.SIZE
+	minSize win.SIZE
+	maxSize win.SIZE
+	margin  win.RECT
+	align   BOXALIGN
+}
+
+// Options for [Box.AddCtrl] and [Box.AddBox].
+func OptsBoxItem() *VarOptsBoxItem {
+	return &VarOptsBoxItem{}
+}
+
+// Share of the extra space given to the item, relative to the other items. In
+// a grid, applies to the item's row and column.
+//
+// Defaults to 0, so the item keeps its preferred size.
+func (o *VarOptsBoxItem) Stretch(s int) *VarOptsBoxItem { o.stretch = s; return o }
+
+// Preferred size, in pixels. Zero values are replaced by the size of the
+// control when the parent is created, or by the computed size of a nested box.
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsBoxItem) Size(cx, cy int) *VarOptsBoxItem {
+	o.size = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	return o
+}
+
+// Minimum size, in pixels.
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsBoxItem) MinSize(cx, cy int) *VarOptsBoxItem {
+	o.minSize = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	return o
+}
+
+// Maximum size, in pixels. Zero values mean no limit.
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsBoxItem) MaxSize(cx, cy int) *VarOptsBoxItem {
+	o.maxSize = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	return o
+}
+
+// Outer margins of the item, in pixels.
+//
+// Defaults to 0 on all sides.
+func (o *VarOptsBoxItem) Margin(left, top, right, bottom int) *VarOptsBoxItem {
+	o.margin = win.RECT{Left: int32(left), Top: int32(top), Right: int32(right), Bottom: int32(bottom)}
+	return o
+}
+
+// Alignment of the item within the space given to it, in the cross axis of
+// rows and columns, and in both axes of grid cells.
+//
+// Defaults to ui.BOXALIGN_FILL.
+func (o *VarOptsBoxItem) Align(a BOXALIGN) *VarOptsBoxItem { o.align = a; return o }
@@ -82,11 +82,10 @@ func (me *_BaseCtrl) createWindow(
 		panic("Cannot create control twice.")
 	}
 
-	hInst, _ := parent.Hwnd().HInstance()
-	me.hWnd, _ = win.CreateWindowEx(exStyle, win.ClassNameStr(className),
-		title, style, pos, size, parent.Hwnd(), win.HMENU(me.ctrlId), hInst, win.LPARAM(0))
+	me.hWnd, _ = _backend.CreateWindowEx(exStyle, className,
+		title, style, pos, size, parent.Hwnd(), me.ctrlId)
 	if setGlobalUiFont {
-		me.hWnd.SendMessage(co.WM_SETFONT, win.WPARAM(globalUiFont), win.LPARAM(1))
+		me.sendMessage(co.WM_SETFONT, win.WPARAM(globalUiFont), win.LPARAM(1))
 	}
 	me.installSubclass()
 }
@@ -99,10 +98,36 @@ func (me *_BaseCtrl) assignDialog(parent Parent) {
 		panic("Cannot create control before parent window creation.")
 	}
 
-	me.hWnd, _ = parent.Hwnd().GetDlgItem(me.ctrlId)
+	me.hWnd, _ = _backend.GetDlgItem(parent.Hwnd(), me.ctrlId)
 	me.installSubclass()
 }
 
+// Sends a message to the control through the current [Backend].
+func (me *_BaseCtrl) sendMessage(msg co.WM, wParam win.WPARAM, lParam win.LPARAM) (uintptr, error) {
+	return _backend.SendMessage(me.hWnd, msg, wParam, lParam)
+}
+
+// Sends a message whose LPARAM is a pointer to the control through the current
+// [Backend].
+func (me *_BaseCtrl) sendMessagePtr(msg co.WM, wParam win.WPARAM, lParam unsafe.Pointer) (uintptr, error) {
+	return _backend.SendMessagePtr(me.hWnd, msg, wParam, lParam)
+}
+
+// Sends a WM_COMMAND notification to the parent, as if fired by the control.
+func (me *_BaseCtrl) sendCommandToParent(notifCode co.CMD) {
+	hParent, _ := _backend.GetParent(me.hWnd)
+	_backend.SendMessage(hParent, co.WM_COMMAND,
+		win.MAKEWPARAM(me.ctrlId, uint16(notifCode)), win.LPARAM(me.hWnd))
+}
+
+func (me *_BaseCtrl) getWindowText() (string, error) {
+	return _backend.GetWindowText(me.hWnd)
+}
+
+func (me *_BaseCtrl) setWindowText(text string) error {
+	return _backend.SetWindowText(me.hWnd, text)
+}
+
 func (me *_BaseCtrl) panicIfAddingEventAfterCreated() {
 	if me.hWnd != 0 {
 		panic("Cannot add event handling after the control has been created.")
@@ -114,7 +139,7 @@ func (me *_BaseCtrl) installSubclass() {
 		subclassProcCallback()
 		_subclassId++
 		me.subclassProc = subclassProcCallback()
-		err := me.hWnd.SetWindowSubclass(me.subclassProc, _subclassId, unsafe.Pointer(me)) // pass pointer to object itself
+		err := _backend.SetWindowSubclass(me.hWnd, me.subclassProc, _subclassId, unsafe.Pointer(me)) // pass pointer to object itself
 		if err != nil {
 			panic(err)
 		}
@@ -94,13 +94,13 @@ func (me *Button) On() *ButtonEvents {
 //
 // Returns the same object, so further operations can be chained.
 func (me *Button) SetText(text string) *Button {
-	me.hWnd.SetWindowText(text)
+	me.setWindowText(text)
 	return me
 }
 
 // Calls [win.HWND.GetWindowText].
 func (me *Button) Text() string {
-	t, _ := me.hWnd.GetWindowText()
+	t, _ := me.getWindowText()
 	return t
 }
 
@@ -110,7 +110,7 @@ func (me *Button) Text() string {
 //
 // [BM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/bm-click
 func (me *Button) TriggerClick() *Button {
-	me.hWnd.SendMessage(co.BM_CLICK, 0, 0)
+	me.sendMessage(co.BM_CLICK, 0, 0)
 	return me
 }
 
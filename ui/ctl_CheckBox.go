@@ -139,7 +139,7 @@ func (me *CheckBox) SetCheckAndTrigger(checked bool) *CheckBox {
 //
 // [BM_SETCHECK]: https://learn.microsoft.com/en-us/windows/win32/controls/bm-setcheck
 func (me *CheckBox) SetState(state co.BST) *CheckBox {
-	me.hWnd.SendMessage(co.BM_SETCHECK, win.WPARAM(state), 0)
+	me.sendMessage(co.BM_SETCHECK, win.WPARAM(state), 0)
 	return me
 }
 
@@ -152,10 +152,7 @@ func (me *CheckBox) SetState(state co.BST) *CheckBox {
 // [BN_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-clicked
 func (me *CheckBox) SetStateAndTrigger(state co.BST) *CheckBox {
 	me.SetState(state)
-	hParent, _ := me.hWnd.GetParent()
-	hParent.SendMessage(co.WM_COMMAND,
-		win.MAKEWPARAM(uint16(me.CtrlId()), uint16(co.BN_CLICKED)),
-		win.LPARAM(me.Hwnd()))
+	me.sendCommandToParent(co.BN_CLICKED)
 	return me
 }
 
@@ -163,7 +160,7 @@ func (me *CheckBox) SetStateAndTrigger(state co.BST) *CheckBox {
 //
 // Returns the same object, so further operations can be chained.
 func (me *CheckBox) SetTextAndResize(text string) *CheckBox {
-	me.hWnd.SetWindowText(text)
+	me.setWindowText(text)
 	boundBox, _ := calcBcmBoundBox(me.hWnd)
 	me.hWnd.SetWindowPos(win.HWND(0), win.POINT{}, boundBox, co.SWP_NOZORDER|co.SWP_NOMOVE)
 	return me
@@ -173,13 +170,13 @@ func (me *CheckBox) SetTextAndResize(text string) *CheckBox {
 //
 // [BM_GETCHECK]: https://learn.microsoft.com/en-us/windows/win32/controls/bm-getcheck
 func (me *CheckBox) State() co.BST {
-	state, _ := me.hWnd.SendMessage(co.BM_GETCHECK, 0, 0)
+	state, _ := me.sendMessage(co.BM_GETCHECK, 0, 0)
 	return co.BST(state)
 }
 
 // Calls [win.HWND.GetWindowText].
 func (me *CheckBox) Text() string {
-	t, _ := me.hWnd.GetWindowText()
+	t, _ := me.getWindowText()
 	return t
 }
 
@@ -104,8 +104,8 @@ func (me *ComboBox) On() *ComboBoxEvents {
 func (me *ComboBox) AddItem(texts ...string) {
 	var wText wstr.BufEncoder
 	for _, text := range texts {
-		ret, _ := me.Hwnd().SendMessage(co.CB_ADDSTRING,
-			0, win.LPARAM(wText.AllowEmpty(text)))
+		ret, _ := me.sendMessagePtr(co.CB_ADDSTRING,
+			0, wText.AllowEmpty(text))
 
 		if int32(ret) == utl.CB_ERR || int32(ret) == utl.CB_ERRSPACE {
 			panic("CB_ADDSTRING failed.")
@@ -124,11 +124,11 @@ func (me *ComboBox) AllItems() []string {
 	items := make([]string, 0, nItems)
 
 	for i := 0; i < nItems; i++ {
-		nChars, _ := me.Hwnd().SendMessage(co.CB_GETLBTEXTLEN, win.WPARAM(int32(i)), 0)
+		nChars, _ := me.sendMessage(co.CB_GETLBTEXTLEN, win.WPARAM(int32(i)), 0)
 		wBuf.AllocAndZero(int(nChars) + 1)
 
-		me.Hwnd().SendMessage(co.CB_GETLBTEXT,
-			win.WPARAM(int32(i)), win.LPARAM(wBuf.Ptr()))
+		me.sendMessagePtr(co.CB_GETLBTEXT,
+			win.WPARAM(int32(i)), wBuf.Ptr())
 
 		items = append(items, wBuf.String())
 	}
@@ -141,7 +141,7 @@ func (me *ComboBox) AllItems() []string {
 // If the ComboBox doesn't have the co.CBS_DROPDOWNLIST style, the user can type
 // freely, so this text may not be on the list.
 func (me *ComboBox) CurrentText() string {
-	txt, _ := me.hWnd.GetWindowText()
+	txt, _ := me.getWindowText()
 	return txt
 }
 
@@ -149,7 +149,7 @@ func (me *ComboBox) CurrentText() string {
 //
 // [CB_RESETCONTENT]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-resetcontent
 func (me *ComboBox) DeleteAllItems() {
-	me.Hwnd().SendMessage(co.CB_RESETCONTENT, 0, 0)
+	me.sendMessage(co.CB_RESETCONTENT, 0, 0)
 }
 
 // Returns the text at the given zero-based index with [CB_GETLBTEXT].
@@ -161,14 +161,14 @@ func (me *ComboBox) Item(index int) string {
 	var wBuf wstr.BufDecoder
 	wBuf.Alloc(wstr.BUF_MAX)
 
-	nChars, _ := me.Hwnd().SendMessage(co.CB_GETLBTEXTLEN, win.WPARAM(int32(index)), 0)
+	nChars, _ := me.sendMessage(co.CB_GETLBTEXTLEN, win.WPARAM(int32(index)), 0)
 	if int32(nChars) == utl.CB_ERR {
 		panic(fmt.Sprintf("Invalid ComboBox index: %d", index))
 	}
 	wBuf.Alloc(int(nChars) + 1)
 
-	me.Hwnd().SendMessage(co.CB_GETLBTEXT,
-		win.WPARAM(int32(index)), win.LPARAM(wBuf.Ptr()))
+	me.sendMessagePtr(co.CB_GETLBTEXT,
+		win.WPARAM(int32(index)), wBuf.Ptr())
 	return wBuf.String()
 }
 
@@ -176,7 +176,7 @@ func (me *ComboBox) Item(index int) string {
 //
 // [CB_GETCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-getcount
 func (me *ComboBox) ItemCount() int {
-	n, _ := me.Hwnd().SendMessage(co.CB_GETCOUNT, 0, 0)
+	n, _ := me.sendMessage(co.CB_GETCOUNT, 0, 0)
 	return int(n)
 }
 
@@ -195,7 +195,7 @@ func (me *ComboBox) LastItem() string {
 //
 // [CB_SETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-setcursel
 func (me *ComboBox) SelectIndex(index int) {
-	me.Hwnd().SendMessage(co.CB_SETCURSEL, win.WPARAM(int32(index)), 0)
+	me.sendMessage(co.CB_SETCURSEL, win.WPARAM(int32(index)), 0)
 }
 
 // Retrieves the selected zero-based index with [CB_GETCURSEL].
@@ -204,7 +204,7 @@ func (me *ComboBox) SelectIndex(index int) {
 //
 // [CB_GETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-getcursel
 func (me *ComboBox) SelectedIndex() int {
-	n, _ := me.Hwnd().SendMessage(co.CB_GETCURSEL, 0, 0)
+	n, _ := me.sendMessage(co.CB_GETCURSEL, 0, 0)
 	return int(n)
 }
 
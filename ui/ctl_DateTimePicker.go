@@ -90,8 +90,8 @@ func (me *DateTimePicker) On() *DateTimePickerEvents {
 func (me *DateTimePicker) SetTime(newTime time.Time) *DateTimePicker {
 	var st win.SYSTEMTIME
 	st.SetTime(newTime)
-	me.hWnd.SendMessage(co.DTM_SETSYSTEMTIME,
-		win.WPARAM(co.GDT_VALID), win.LPARAM(unsafe.Pointer(&st)))
+	me.sendMessagePtr(co.DTM_SETSYSTEMTIME,
+		win.WPARAM(co.GDT_VALID), unsafe.Pointer(&st))
 	return me
 }
 
@@ -102,8 +102,8 @@ func (me *DateTimePicker) SetTime(newTime time.Time) *DateTimePicker {
 // [DTM_GETSYSTEMTIME]: https://learn.microsoft.com/en-us/windows/win32/controls/dtm-getsystemtime
 func (me *DateTimePicker) Time() time.Time {
 	var st win.SYSTEMTIME
-	ret, _ := me.hWnd.SendMessage(co.DTM_GETSYSTEMTIME,
-		0, win.LPARAM(unsafe.Pointer(&st)))
+	ret, _ := me.sendMessagePtr(co.DTM_GETSYSTEMTIME,
+		0, unsafe.Pointer(&st))
 	if co.GDT(ret) != co.GDT_VALID {
 		panic("DTM_GETSYSTEMTIME failed.")
 	}
@@ -96,7 +96,7 @@ func (me *Edit) On() *EditEvents {
 //
 // [EM_HIDEBALLOONTIP]: https://learn.microsoft.com/en-us/windows/win32/controls/em-hideballoontip
 func (me *Edit) HideBalloonTip() *Edit {
-	me.hWnd.SendMessage(co.EM_HIDEBALLOONTIP, 0, 0)
+	me.sendMessage(co.EM_HIDEBALLOONTIP, 0, 0)
 	return me
 }
 
@@ -106,7 +106,7 @@ func (me *Edit) HideBalloonTip() *Edit {
 //
 // [EM_SETLIMITTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setlimittext
 func (me *Edit) LimitText(maxChars int) *Edit {
-	me.hWnd.SendMessage(co.EM_SETLIMITTEXT, win.WPARAM(int32(maxChars)), 0)
+	me.sendMessage(co.EM_SETLIMITTEXT, win.WPARAM(int32(maxChars)), 0)
 	return me
 }
 
@@ -119,7 +119,7 @@ func (me *Edit) LimitText(maxChars int) *Edit {
 //
 // [EM_SETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setsel
 func (me *Edit) SetSelection(startPos, endPos int) *Edit {
-	me.hWnd.SendMessage(co.EM_SETSEL, win.WPARAM(int32(startPos)), win.LPARAM(int32(endPos)))
+	me.sendMessage(co.EM_SETSEL, win.WPARAM(int32(startPos)), win.LPARAM(int32(endPos)))
 	return me
 }
 
@@ -127,7 +127,7 @@ func (me *Edit) SetSelection(startPos, endPos int) *Edit {
 //
 // Returns the same object, so further operations can be chained.
 func (me *Edit) SetText(text string) *Edit {
-	me.hWnd.SetWindowText(text)
+	me.setWindowText(text)
 	return me
 }
 
@@ -144,13 +144,13 @@ func (me *Edit) ShowBalloonTip(title, text string, icon co.TTI) *Edit {
 		TtiIcon:  icon,
 	}
 	ebt.SetCbStruct()
-	me.hWnd.SendMessage(co.EM_SHOWBALLOONTIP, 0, win.LPARAM(unsafe.Pointer(&ebt)))
+	me.sendMessagePtr(co.EM_SHOWBALLOONTIP, 0, unsafe.Pointer(&ebt))
 	return me
 }
 
 // Calls [win.HWND.GetWindowText].
 func (me *Edit) Text() string {
-	t, _ := me.hWnd.GetWindowText()
+	t, _ := me.getWindowText()
 	return t
 }
 
@@ -97,9 +97,9 @@ func (me *Header) defaultMessageHandlers(parent Parent) {
 	parent.base().afterUserEvents.wm(co.WM_DESTROY, func(_ Wm) {
 		kinds := []co.HDSIL{co.HDSIL_NORMAL, co.HDSIL_STATE}
 		for _, kind := range kinds {
-			h, _ := me.hWnd.SendMessage(co.HDM_GETIMAGELIST, win.WPARAM(kind), 0)
+			h, _ := me.sendMessage(co.HDM_GETIMAGELIST, win.WPARAM(kind), 0)
 			if h != 0 {
-				me.hWnd.SendMessage(co.HDM_SETIMAGELIST, win.WPARAM(kind), 0) // release image list
+				me.sendMessage(co.HDM_SETIMAGELIST, win.WPARAM(kind), 0) // release image list
 				win.HIMAGELIST(h).Destroy()
 			}
 		}
@@ -129,8 +129,8 @@ func (me *Header) AddItem(text string, width int) HeaderItem {
 	var wText wstr.BufEncoder
 	hdi.SetPszText(wText.Slice(text))
 
-	newIdxRet, err := me.Hwnd().SendMessage(co.HDM_INSERTITEM,
-		0xffff, win.LPARAM(unsafe.Pointer(&hdi)))
+	newIdxRet, err := me.sendMessagePtr(co.HDM_INSERTITEM,
+		0xffff, unsafe.Pointer(&hdi))
 	newIdx := int(newIdxRet)
 	if err != nil || newIdx == -1 {
 		panic(fmt.Sprintf("HDM_INSERTITEM \"%s\" failed.", text))
@@ -158,11 +158,11 @@ func (me *Header) AllItems() []HeaderItem {
 // [HDM_GETIMAGELIST]: https://learn.microsoft.com/en-us/windows/win32/controls/hdm-getimagelist
 // [HDM_SETIMAGELIST]: https://learn.microsoft.com/en-us/windows/win32/controls/hdm-setimagelist
 func (me *Header) ImageList(which co.HDSIL) win.HIMAGELIST {
-	h, _ := me.hWnd.SendMessage(co.HDM_GETIMAGELIST, win.WPARAM(which), 0)
+	h, _ := me.sendMessage(co.HDM_GETIMAGELIST, win.WPARAM(which), 0)
 	hImg := win.HIMAGELIST(h)
 	if hImg == win.HIMAGELIST(0) {
 		hImg, _ = win.ImageListCreate(16, 16, co.ILC_COLOR32, 1, 1)
-		me.hWnd.SendMessage(co.HDM_SETIMAGELIST, win.WPARAM(which), win.LPARAM(hImg))
+		me.sendMessage(co.HDM_SETIMAGELIST, win.WPARAM(which), win.LPARAM(hImg))
 	}
 	return hImg
 }
@@ -178,7 +178,7 @@ func (me *Header) Item(index int) HeaderItem {
 //
 // [HDM_ORDERTOINDEX]: https://learn.microsoft.com/en-us/windows/win32/controls/hdm-ordertoindex
 func (me *Header) ItemByOrder(order int) HeaderItem {
-	idx, _ := me.Hwnd().SendMessage(co.HDM_ORDERTOINDEX, win.WPARAM(int32(order)), 0)
+	idx, _ := me.sendMessage(co.HDM_ORDERTOINDEX, win.WPARAM(int32(order)), 0)
 	return me.Item(int(idx))
 }
 
@@ -188,7 +188,7 @@ func (me *Header) ItemByOrder(order int) HeaderItem {
 //
 // [HDM_GETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/hdm-getitemcount
 func (me *Header) ItemCount() int {
-	countRet, err := me.Hwnd().SendMessage(co.HDM_GETITEMCOUNT, 0, 0)
+	countRet, err := me.sendMessage(co.HDM_GETITEMCOUNT, 0, 0)
 	count := int(countRet)
 	if err != nil || count == -1 {
 		panic("HDM_GETITEMCOUNT failed.")
@@ -208,8 +208,8 @@ func (me *Header) OrderedItems() []HeaderItem {
 	nItems := me.ItemCount()
 	indexes := make([]int32, nItems)
 
-	me.Hwnd().SendMessage(co.HDM_GETORDERARRAY,
-		win.WPARAM(int32(nItems)), win.LPARAM(unsafe.Pointer(&indexes[0])))
+	me.sendMessagePtr(co.HDM_GETORDERARRAY,
+		win.WPARAM(int32(nItems)), unsafe.Pointer(&indexes[0]))
 
 	items := make([]HeaderItem, 0, nItems)
 	for _, index := range indexes {
@@ -228,8 +228,8 @@ func (me *Header) ReorderItems(indexes []int) {
 		buf = append(buf, int32(index))
 	}
 
-	me.Hwnd().SendMessage(co.HDM_SETORDERARRAY,
-		win.WPARAM(int32(len(buf))), win.LPARAM(unsafe.Pointer(&buf[0])))
+	me.sendMessagePtr(co.HDM_SETORDERARRAY,
+		win.WPARAM(int32(len(buf))), unsafe.Pointer(&buf[0]))
 }
 
 // Options for [NewHeader]; returned by [OptsHeader].
@@ -38,8 +38,8 @@ func (me HeaderItem) Justification() co.HDF {
 	hdi := win.HDITEM{
 		Mask: co.HDI_FORMAT,
 	}
-	me.owner.hWnd.SendMessage(co.HDM_GETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	me.owner.sendMessagePtr(co.HDM_GETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 
 	return hdi.Fmt & (co.HDF_LEFT | co.HDF_CENTER | co.HDF_RIGHT) // restrict bits
 }
@@ -51,8 +51,8 @@ func (me HeaderItem) Order() int {
 	hdi := win.HDITEM{
 		Mask: co.HDI_ORDER,
 	}
-	me.owner.hWnd.SendMessage(co.HDM_GETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	me.owner.sendMessagePtr(co.HDM_GETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 
 	return int(hdi.IOrder)
 }
@@ -71,13 +71,13 @@ func (me HeaderItem) SetJustification(hdf co.HDF) HeaderItem {
 	hdi := win.HDITEM{
 		Mask: co.HDI_FORMAT,
 	}
-	me.owner.hWnd.SendMessage(co.HDM_GETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	me.owner.sendMessagePtr(co.HDM_GETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 
 	hdi.Fmt &^= (co.HDF_LEFT | co.HDF_CENTER | co.HDF_RIGHT)        // remove bits
 	hdi.Fmt |= (hdf & (co.HDF_LEFT | co.HDF_CENTER | co.HDF_RIGHT)) // restrict bits
-	me.owner.hWnd.SendMessage(co.HDM_SETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	me.owner.sendMessagePtr(co.HDM_SETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 
 	return me
 }
@@ -100,16 +100,16 @@ func (me HeaderItem) SetSortArrow(hdf co.HDF) HeaderItem {
 		hdi := win.HDITEM{
 			Mask: co.HDI_FORMAT,
 		}
-		me.owner.hWnd.SendMessage(co.HDM_GETITEM,
-			win.WPARAM(int32(i)), win.LPARAM(unsafe.Pointer(&hdi))) // retrieve current style
+		me.owner.sendMessagePtr(co.HDM_GETITEM,
+			win.WPARAM(int32(i)), unsafe.Pointer(&hdi)) // retrieve current style
 
 		hdi.Fmt &^= (co.HDF_SORTDOWN | co.HDF_SORTUP) // remove bits
 
 		if i == int(me.index) { // only our item will be set
 			hdi.Fmt |= (hdf & (co.HDF_SORTDOWN | co.HDF_SORTUP)) // restrict bits
 		}
-		me.owner.hWnd.SendMessage(co.HDM_SETITEM,
-			win.WPARAM(int32(i)), win.LPARAM(unsafe.Pointer(&hdi)))
+		me.owner.sendMessagePtr(co.HDM_SETITEM,
+			win.WPARAM(int32(i)), unsafe.Pointer(&hdi))
 	}
 	return me
 }
@@ -129,8 +129,8 @@ func (me HeaderItem) SetText(text string) HeaderItem {
 	var wText wstr.BufEncoder
 	hdi.SetPszText(wText.Slice(text))
 
-	ret, err := me.owner.hWnd.SendMessage(co.HDM_SETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	ret, err := me.owner.sendMessagePtr(co.HDM_SETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("HDM_SETITEM %d to \"%s\" failed.", me.index, text))
 	}
@@ -151,8 +151,8 @@ func (me HeaderItem) SetWidth(width int) HeaderItem {
 		Cxy:  int32(width),
 	}
 
-	me.owner.hWnd.SendMessage(co.HDM_SETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	me.owner.sendMessagePtr(co.HDM_SETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 	return me
 }
 
@@ -168,8 +168,8 @@ func (me HeaderItem) SortArrow() co.HDF {
 	hdi := win.HDITEM{
 		Mask: co.HDI_FORMAT,
 	}
-	me.owner.hWnd.SendMessage(co.HDM_GETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	me.owner.sendMessagePtr(co.HDM_GETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 
 	return hdi.Fmt & (co.HDF_SORTDOWN | co.HDF_SORTUP) // restrict bits
 }
@@ -188,8 +188,8 @@ func (me HeaderItem) Text() string {
 	wBuf.Alloc(wstr.BUF_MAX)
 	hdi.SetPszText(wBuf.HotSlice())
 
-	ret, err := me.owner.hWnd.SendMessage(co.HDM_GETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	ret, err := me.owner.sendMessagePtr(co.HDM_GETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("HDM_GETITEM %d failed.", me.index))
 	}
@@ -207,8 +207,8 @@ func (me HeaderItem) Width() int {
 		Mask: co.HDI_WIDTH,
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.HDM_GETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&hdi)))
+	ret, err := me.owner.sendMessagePtr(co.HDM_GETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&hdi))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("HDM_GETITEM %d failed.", me.index))
 	}
@@ -24,6 +24,9 @@ type ListView struct {
 	hContextMenu             win.HMENU
 	itemsData                map[int]interface{} // data associated with each item; replaces LPARAM approach
 	header                   *Header
+	data                     ListViewData // data source of a virtual list view
+	sortCol                  int          // column sorted by SortData, or -1
+	sortAsc                  bool
 }
 
 // Creates a new [ListView] with [win.CreateWindowEx].
@@ -52,11 +55,20 @@ func NewListView(parent Parent, opts *VarOptsListView) *ListView {
 		hContextMenu: lvLoadContextMenu(opts.contextMenu, opts.contextMenuId),
 		itemsData:    make(map[int]interface{}),
 		header:       newHeaderFromListView(parent),
+		data:         opts.data,
+		sortCol:      -1,
 	}
 
 	parent.base().beforeUserEvents.wmCreateOrInitdialog(func() {
+		ctrlStyle := opts.ctrlStyle
+		if opts.data != nil {
+			ctrlStyle |= co.LVS_OWNERDATA
+			if _, ok := opts.data.(ListViewDataSorter); ok {
+				ctrlStyle &^= co.LVS_NOSORTHEADER // clicking the headers will sort
+			}
+		}
 		me.createWindow(opts.wndExStyle, "SysListView32", "",
-			opts.wndStyle|co.WS(opts.ctrlStyle), opts.position, opts.size, parent, false)
+			opts.wndStyle|co.WS(ctrlStyle), opts.position, opts.size, parent, false)
 		if opts.ctrlExStyle != co.LVS_EX(0) {
 			me.SetExtendedStyle(true, opts.ctrlExStyle)
 		}
@@ -65,6 +77,9 @@ func NewListView(parent Parent, opts *VarOptsListView) *ListView {
 		for _, col := range opts.cols {
 			me.AddCol(col.title, col.width)
 		}
+		if me.data != nil {
+			me.applyData()
+		}
 	})
 
 	me.defaultMessageHandlers(parent)
@@ -98,12 +113,16 @@ func NewListViewDlg(parent Parent, ctrlId uint16, contextMenuId uint16, layout L
 		hContextMenu: lvLoadContextMenu(win.HMENU(0), contextMenuId),
 		itemsData:    make(map[int]interface{}),
 		header:       newHeaderFromListView(parent),
+		sortCol:      -1,
 	}
 
 	parent.base().beforeUserEvents.wmCreateOrInitdialog(func() {
 		me.assignDialog(parent)
 		parent.base().layout.Add(parent, me.hWnd, layout)
 		me.assignOrClearHeader()
+		if me.data != nil {
+			me.applyData()
+		}
 	})
 
 	me.defaultMessageHandlers(parent)
@@ -156,6 +175,8 @@ func (me *ListView) defaultMessageHandlers(parent Parent) {
 		delete(me.itemsData, item.Uid())
 	})
 
+	me.dataMessageHandlers(parent)
+
 	parent.base().afterUserEvents.wm(co.WM_DESTROY, func(_ Wm) {
 		if me.hContextMenu != 0 {
 			me.hContextMenu.DestroyMenu()
@@ -214,7 +235,7 @@ func (me *ListView) showContextMenu(followCursor, hasCtrl, hasShift bool) {
 }
 
 func (me *ListView) assignOrClearHeader() {
-	hHeader, err := me.hWnd.SendMessage(co.LVM_GETHEADER, 0, 0)
+	hHeader, err := me.sendMessage(co.LVM_GETHEADER, 0, 0)
 	if hHeader != 0 && err == nil { // the list has a header
 		me.header.assignToListView(win.HWND(hHeader))
 	} else {
@@ -222,6 +243,20 @@ func (me *ListView) assignOrClearHeader() {
 	}
 }
 
+// Loads the icon in the cache, creating and setting the image list if needed.
+// Returns the index of the icon within the image list.
+func (me *ListView) loadIcon(resolution int, iconCache *_IconCacheImgList, icon Ico) int {
+	hImgList, newImgList, idxIcon := iconCache.IconIndex(resolution, icon)
+	if newImgList { // image list has just been created
+		lvsil := co.LVSIL_NORMAL
+		if resolution == 16 {
+			lvsil = co.LVSIL_SMALL
+		}
+		me.sendMessage(co.LVM_SETIMAGELIST, win.WPARAM(lvsil), win.LPARAM(hImgList))
+	}
+	return idxIcon
+}
+
 // Exposes all the control notifications the can be handled.
 //
 // Panics if called after the control has been created.
@@ -251,8 +286,8 @@ func (me *ListView) AddCol(title string, width int) ListViewCol {
 	var wTitle wstr.BufEncoder
 	lvc.SetPszText(wTitle.Slice(title))
 
-	newIdxRet, err := me.Hwnd().SendMessage(co.LVM_INSERTCOLUMN,
-		0xffff, win.LPARAM(unsafe.Pointer(&lvc)))
+	newIdxRet, err := me.sendMessagePtr(co.LVM_INSERTCOLUMN,
+		0xffff, unsafe.Pointer(&lvc))
 	newIdx := int(newIdxRet)
 	if err != nil || newIdx == -1 {
 		panic(fmt.Sprintf("LVM_INSERTCOLUMN \"%s\" failed.", title))
@@ -280,8 +315,8 @@ func (me *ListView) AddItem(texts ...string) ListViewItem {
 	var wText wstr.BufEncoder
 	lvi.SetPszText(wText.Slice(texts[0]))
 
-	newIdxRet, err := me.Hwnd().SendMessage(co.LVM_INSERTITEM, // first column is inserted right away
-		0, win.LPARAM(unsafe.Pointer(&lvi)))
+	newIdxRet, err := me.sendMessagePtr(co.LVM_INSERTITEM, // first column is inserted right away
+		0, unsafe.Pointer(&lvi))
 	newIdx := int(newIdxRet)
 	if err != nil || newIdx == -1 {
 		panic(fmt.Sprintf("LVM_INSERTITEM col %d, \"%s\" failed.", 0, texts[0]))
@@ -291,8 +326,8 @@ func (me *ListView) AddItem(texts ...string) ListViewItem {
 		lvi.ISubItem = int32(i)
 		lvi.SetPszText(wText.Slice(texts[i]))
 
-		ret, err := me.Hwnd().SendMessage(co.LVM_SETITEMTEXT,
-			win.WPARAM(int32(newIdx)), win.LPARAM(unsafe.Pointer(&lvi)))
+		ret, err := me.sendMessagePtr(co.LVM_SETITEMTEXT,
+			win.WPARAM(int32(newIdx)), unsafe.Pointer(&lvi))
 		if err != nil || ret == 0 {
 			panic(fmt.Sprintf("LVM_SETITEMTEXT col %d, \"%s\" failed.", i, texts[i]))
 		}
@@ -341,7 +376,7 @@ func (me *ListView) ContextMenu() win.HMENU {
 //
 // [LVM_DELETEALLITEMS]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-deleteallitems
 func (me *ListView) DeleteAllItems() {
-	me.Hwnd().SendMessage(co.LVM_DELETEALLITEMS, 0, 0)
+	me.sendMessage(co.LVM_DELETEALLITEMS, 0, 0)
 }
 
 // Deletes all selected items at once by searching them with [LVM_GETNEXTITEM],
@@ -354,14 +389,14 @@ func (me *ListView) DeleteAllItems() {
 func (me *ListView) DeleteSelectedItems() {
 	for {
 		idxBaseSearch := -1 // always search the first one
-		idxRet, _ := me.Hwnd().SendMessage(co.LVM_GETNEXTITEM,
+		idxRet, _ := me.sendMessage(co.LVM_GETNEXTITEM,
 			win.WPARAM(int32(idxBaseSearch)), win.LPARAM(co.LVNI_SELECTED))
 		idx := int(idxRet)
 		if idx == -1 {
 			break
 		}
 
-		delRet, err := me.Hwnd().SendMessage(co.LVM_DELETEITEM,
+		delRet, err := me.sendMessage(co.LVM_DELETEITEM,
 			win.WPARAM(int32(idx)), 0)
 		if err != nil || delRet == 0 {
 			panic(fmt.Sprintf("LVM_DELETEITEM %d failed.", idx))
@@ -382,8 +417,8 @@ func (me *ListView) FindItem(text string) (ListViewItem, bool) {
 	}
 
 	idxBaseSearch := -1
-	idxRet, _ := me.Hwnd().SendMessage(co.LVM_FINDITEM,
-		win.WPARAM(int32(idxBaseSearch)), win.LPARAM(unsafe.Pointer(&lvfi)))
+	idxRet, _ := me.sendMessagePtr(co.LVM_FINDITEM,
+		win.WPARAM(int32(idxBaseSearch)), unsafe.Pointer(&lvfi))
 	idx := int(idxRet)
 	if idx == -1 {
 		return ListViewItem{}, false // not found
@@ -397,7 +432,7 @@ func (me *ListView) FindItem(text string) (ListViewItem, bool) {
 // [LVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getnextitem
 func (me *ListView) FocusedItem() (ListViewItem, bool) {
 	idxBaseSearch := -1
-	idxRet, _ := me.Hwnd().SendMessage(co.LVM_GETNEXTITEM,
+	idxRet, _ := me.sendMessage(co.LVM_GETNEXTITEM,
 		win.WPARAM(int32(idxBaseSearch)), win.LPARAM(co.LVNI_FOCUSED))
 	idx := int(idxRet)
 	if idx == -1 {
@@ -423,8 +458,8 @@ func (me *ListView) HitTest(pos win.POINT) (ListViewItem, bool) {
 	}
 
 	idxBaseSearch := -1 // Vista: retrieve iGroup and iSubItem
-	me.Hwnd().SendMessage(co.LVM_HITTEST,
-		win.WPARAM(int32(idxBaseSearch)), win.LPARAM(unsafe.Pointer(&lvhti)))
+	me.sendMessagePtr(co.LVM_HITTEST,
+		win.WPARAM(int32(idxBaseSearch)), unsafe.Pointer(&lvhti))
 
 	if lvhti.IItem == -1 {
 		return me.Item(-1), false
@@ -443,7 +478,7 @@ func (me *ListView) Item(index int) ListViewItem {
 //
 // [LVM_MAPIDTOINDEX]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-mapidtoindex
 func (me *ListView) ItemByUid(uid int) ListViewItem {
-	idx, _ := me.Hwnd().SendMessage(co.LVM_MAPIDTOINDEX, win.WPARAM(int32(uid)), 0)
+	idx, _ := me.sendMessage(co.LVM_MAPIDTOINDEX, win.WPARAM(int32(uid)), 0)
 	return me.Item(int(idx))
 }
 
@@ -451,7 +486,7 @@ func (me *ListView) ItemByUid(uid int) ListViewItem {
 //
 // [LVM_GETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getitemcount
 func (me *ListView) ItemCount() int {
-	count, _ := me.Hwnd().SendMessage(co.LVM_GETITEMCOUNT, 0, 0)
+	count, _ := me.sendMessage(co.LVM_GETITEMCOUNT, 0, 0)
 	return int(count)
 }
 
@@ -481,7 +516,7 @@ func (me *ListView) LastItem() ListViewItem {
 //
 // [LVM_SETITEMSTATE]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setitemstate
 func (me *ListView) SelectAllItems(doSelect bool) {
-	stylesRet, _ := me.Hwnd().GetWindowLongPtr(co.GWLP_STYLE)
+	stylesRet, _ := _backend.GetWindowLongPtr(me.hWnd, co.GWLP_STYLE)
 	styles := co.LVS(stylesRet)
 	if (styles & co.LVS_SINGLESEL) != 0 {
 		return // single-sel list views cannot have all items selected
@@ -498,8 +533,8 @@ func (me *ListView) SelectAllItems(doSelect bool) {
 	}
 
 	idxBaseSearch := -1
-	ret, err := me.Hwnd().SendMessage(co.LVM_SETITEMSTATE,
-		win.WPARAM(int32(idxBaseSearch)), win.LPARAM(unsafe.Pointer(&lvi)))
+	ret, err := me.sendMessagePtr(co.LVM_SETITEMSTATE,
+		win.WPARAM(int32(idxBaseSearch)), unsafe.Pointer(&lvi))
 	if err != nil || ret == 0 {
 		panic("LVM_SETITEMSTATE failed.")
 	}
@@ -509,7 +544,7 @@ func (me *ListView) SelectAllItems(doSelect bool) {
 //
 // [LVM_GETSELECTEDCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getselectedcount
 func (me *ListView) SelectedItemCount() int {
-	ret, _ := me.Hwnd().SendMessage(co.LVM_GETSELECTEDCOUNT, 0, 0)
+	ret, _ := me.sendMessage(co.LVM_GETSELECTEDCOUNT, 0, 0)
 	return int(ret)
 }
 
@@ -522,7 +557,7 @@ func (me *ListView) SelectedItems() []ListViewItem {
 
 	idx := -1
 	for {
-		idxRet, _ := me.Hwnd().SendMessage(co.LVM_GETNEXTITEM,
+		idxRet, _ := me.sendMessage(co.LVM_GETNEXTITEM,
 			win.WPARAM(int32(idx)), win.LPARAM(co.LVNI_SELECTED))
 		idx = int(idxRet)
 		if idx == -1 {
@@ -534,7 +569,11 @@ func (me *ListView) SelectedItems() []ListViewItem {
 	return items
 }
 
-// Sorts the items according to the callback with [LVM_SORTITEMSEX].
+// Sorts the items according to the callback with [LVM_SORTITEMSEX]. To sort
+// file names as Windows Explorer does, use [wstr.CmpLogical].
+//
+// Panics if the list view has a data source, which must be sorted with
+// [ListView.SortData].
 //
 // Example:
 //
@@ -546,9 +585,12 @@ func (me *ListView) SelectedItems() []ListViewItem {
 //
 // [LVM_SORTITEMSEX]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-sortitemsex
 func (me *ListView) SortItems(fun func(a, b ListViewItem) int) {
+	if me.data != nil {
+		panic("A ListView with a data source must be sorted with SortData.")
+	}
 	listViewSortCallback()
 	pPack := &_ListViewSortPack{me, fun}
-	me.Hwnd().SendMessage(co.LVM_SORTITEMSEX,
+	me.sendMessage(co.LVM_SORTITEMSEX,
 		win.WPARAM(unsafe.Pointer(pPack)), win.LPARAM(listViewSortCallback()))
 	runtime.KeepAlive(pPack)
 }
@@ -578,7 +620,7 @@ func listViewSortCallback() uintptr {
 //
 // [LVM_GETTOPINDEX]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-gettopindex
 func (me *ListView) TopmostVisible() (ListViewItem, bool) {
-	idxRet, _ := me.Hwnd().SendMessage(co.LVM_GETTOPINDEX, 0, 0)
+	idxRet, _ := me.sendMessage(co.LVM_GETTOPINDEX, 0, 0)
 	idx := int(idxRet)
 	if idx == -1 {
 		return me.Item(-1), false
@@ -594,7 +636,7 @@ func (me *ListView) TopmostVisible() (ListViewItem, bool) {
 //
 // [LVM_SCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-scroll
 func (me *ListView) Scroll(horz, vert int) *ListView {
-	ret, err := me.hWnd.SendMessage(co.LVM_SCROLL,
+	ret, err := me.sendMessage(co.LVM_SCROLL,
 		win.WPARAM(int32(horz)), win.LPARAM(int32(vert)))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("ListView scrolling failed: %d, %d.", horz, vert))
@@ -612,7 +654,7 @@ func (me *ListView) SetExtendedStyle(doSet bool, style co.LVS_EX) *ListView {
 	if doSet {
 		affected = style
 	}
-	me.hWnd.SendMessage(co.LVM_SETEXTENDEDLISTVIEWSTYLE,
+	me.sendMessage(co.LVM_SETEXTENDEDLISTVIEWSTYLE,
 		win.WPARAM(affected), win.LPARAM(style))
 	return me
 }
@@ -626,7 +668,7 @@ func (me *ListView) SetExtendedStyle(doSet bool, style co.LVS_EX) *ListView {
 //
 // [WM_SETREDRAW]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-setredraw
 func (me *ListView) SetRedraw(allowRedraw bool) *ListView {
-	me.hWnd.SendMessage(co.WM_SETREDRAW,
+	me.sendMessage(co.WM_SETREDRAW,
 		win.WPARAM(utl.BoolToUintptr(allowRedraw)), 0)
 	return me
 }
@@ -639,7 +681,7 @@ func (me *ListView) SetRedraw(allowRedraw bool) *ListView {
 //
 // [LVM_SETVIEW]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setview
 func (me *ListView) SetView(view co.LV_VIEW) *ListView {
-	ret, err := me.hWnd.SendMessage(co.LVM_SETVIEW, win.WPARAM(view), 0)
+	ret, err := me.sendMessage(co.LVM_SETVIEW, win.WPARAM(view), 0)
 	if err != nil || int32(ret) == -1 {
 		panic(fmt.Sprintf("LVM_SETVIEW failed for %d.", view))
 	}
@@ -650,7 +692,7 @@ func (me *ListView) SetView(view co.LV_VIEW) *ListView {
 //
 // [LVM_GETVIEW]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getview
 func (me *ListView) View() co.LV_VIEW {
-	viewRet, _ := me.hWnd.SendMessage(co.LVM_GETVIEW, 0, 0)
+	viewRet, _ := me.sendMessage(co.LVM_GETVIEW, 0, 0)
 	return co.LV_VIEW(viewRet)
 }
 
@@ -667,6 +709,7 @@ type VarOptsListView struct {
 	contextMenu   win.HMENU
 	contextMenuId uint16
 	cols          []_ListViewAddCol
+	data          ListViewData
 }
 
 type _ListViewAddCol struct {
@@ -774,6 +817,20 @@ func (o *VarOptsListView) Column(title string, width int) *VarOptsListView {
 	return o
 }
 
+// Data source of a virtual list view. The co.LVS_OWNERDATA style is
+// automatically added and, if the data source implements
+// [ListViewDataSorter], co.LVS_NOSORTHEADER is removed.
+//
+// Defaults to none.
+//
+// Example:
+//
+//	var rows ui.ListViewData // initialized somewhere
+//
+//	ui.OptsListView().
+//		Data(rows)
+func (o *VarOptsListView) Data(data ListViewData) *VarOptsListView { o.data = data; return o }
+
 // Native [list view] control events.
 //
 // You cannot create this object directly, it will be created automatically
@@ -90,7 +90,7 @@ func (me ListViewCol) SelectedTexts() []string {
 
 	idx := -1
 	for {
-		idxRet, _ := me.owner.hWnd.SendMessage(co.LVM_GETNEXTITEM,
+		idxRet, _ := me.owner.sendMessage(co.LVM_GETNEXTITEM,
 			win.WPARAM(int32(idx)), win.LPARAM(co.LVNI_SELECTED))
 		idx = int(idxRet)
 		if idx == -1 {
@@ -142,8 +142,8 @@ func (me ListViewCol) SetTitle(title string) ListViewCol {
 	var wTitle wstr.BufEncoder
 	lvc.SetPszText(wTitle.Slice(title))
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETCOLUMN,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&lvc)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_SETCOLUMN,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&lvc))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_SETCOLUMN %d to \"%s\" failed.", me.index, title))
 	}
@@ -159,7 +159,7 @@ func (me ListViewCol) SetTitle(title string) ListViewCol {
 //
 // [LVM_SETCOLUMNWIDTH]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setcolumnwidth
 func (me ListViewCol) SetWidth(width int) ListViewCol {
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETCOLUMNWIDTH,
+	ret, err := me.owner.sendMessage(co.LVM_SETCOLUMNWIDTH,
 		win.WPARAM(int32(me.index)), win.LPARAM(int32(width)))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_SETCOLUMNWIDTH %d to %d failed.", me.index, width))
@@ -188,7 +188,7 @@ func (me ListViewCol) SetWidthToFill() ListViewCol {
 	rc, _ := me.owner.hWnd.GetClientRect() // list view client area
 	fillWidth := int(rc.Right) - cxUsed
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETCOLUMNWIDTH,
+	ret, err := me.owner.sendMessage(co.LVM_SETCOLUMNWIDTH,
 		win.WPARAM(int32(me.index)), win.LPARAM(int32(fillWidth)))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf(
@@ -230,8 +230,8 @@ func (me ListViewCol) Title() string {
 	wBuf.Alloc(wstr.BUF_MAX)
 	lvc.SetPszText(wBuf.HotSlice())
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_GETCOLUMN,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&lvc)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_GETCOLUMN,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&lvc))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_GETCOLUMN %d failed.", me.index))
 	}
@@ -245,7 +245,7 @@ func (me ListViewCol) Title() string {
 //
 // [LVM_GETCOLUMNWIDTH]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getcolumnwidth
 func (me ListViewCol) Width() int {
-	cx, err := me.owner.hWnd.SendMessage(co.LVM_GETCOLUMNWIDTH,
+	cx, err := me.owner.sendMessage(co.LVM_GETCOLUMNWIDTH,
 		win.WPARAM(int32(me.index)), 0)
 	if err != nil || cx == 0 {
 		panic(fmt.Sprintf("LVM_GETCOLUMNWIDTH %d failed.", me.index))
@@ -0,0 +1,295 @@
+//go:build windows
+
+package ui
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/wstr"
+)
+
+// Data source of a virtual [ListView], which has the co.LVS_OWNERDATA style.
+// The items are not stored in the control: it asks the data source only for
+// the rows being displayed, so it can show millions of them.
+//
+// The data source may also implement [ListViewDataIcons],
+// [ListViewDataSelection], [ListViewDataCacheHint] and [ListViewDataSorter].
+//
+// Example:
+//
+//	type LogLines struct {
+//		lines []string
+//	}
+//
+//	func (me *LogLines) RowCount() int { return len(me.lines) }
+//
+//	func (me *LogLines) CellText(row, col int) string {
+//		if col == 0 {
+//			return strconv.Itoa(row + 1)
+//		}
+//		return me.lines[row]
+//	}
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	lv := ui.NewListView(
+//		wnd,
+//		ui.OptsListView().
+//			Column("Line", ui.DpiX(50)).
+//			Column("Text", ui.DpiX(400)).
+//			Data(&LogLines{}),
+//	)
+type ListViewData interface {
+	// Returns the number of rows.
+	RowCount() int
+	// Returns the text of the given row, at the given column.
+	CellText(row, col int) string
+}
+
+// Optionally implemented by a [ListViewData] to display an icon in the first
+// column of each row.
+type ListViewDataIcons interface {
+	// Returns the icon of the row, if any.
+	Icon(row int) (Ico, bool)
+}
+
+// Optionally implemented by a [ListViewData] to keep the selection state of
+// the rows, which is then no longer stored by the control. This way, the
+// selection follows the rows when they're sorted or changed.
+type ListViewDataSelection interface {
+	// Returns whether the row is selected.
+	IsSelected(row int) bool
+	// Called when the rows between first and last, inclusive, are selected or
+	// deselected.
+	SetSelected(first, last int, isSelected bool)
+}
+
+// Optionally implemented by a [ListViewData] to prepare the rows about to be
+// displayed, upon [LVN_ODCACHEHINT].
+//
+// [LVN_ODCACHEHINT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-odcachehint
+type ListViewDataCacheHint interface {
+	// Called with the range of rows, inclusive, which will be requested next.
+	CacheHint(first, last int)
+}
+
+// Optionally implemented by a [ListViewData] to sort its rows when a column
+// header is clicked, or when [ListView.SortData] is called.
+type ListViewDataSorter interface {
+	// Sorts the rows by the given column.
+	Sort(col int, ascending bool)
+}
+
+func (me *ListView) dataMessageHandlers(parent Parent) {
+	parent.base().beforeUserEvents.wmNotify(me.ctrlId, co.LVN_GETDISPINFO, func(p unsafe.Pointer) {
+		if me.data == nil {
+			return
+		}
+		lvi := &(*win.NMLVDISPINFO)(p).Item
+		row := int(lvi.IItem)
+		if row < 0 || row >= me.data.RowCount() {
+			return
+		}
+
+		if (lvi.Mask & co.LVIF_TEXT) != 0 {
+			wstr.EncodeToBuf(lvi.PszText(), me.data.CellText(row, int(lvi.ISubItem)))
+		}
+		if (lvi.Mask & co.LVIF_IMAGE) != 0 {
+			lvi.IImage = -2 // I_IMAGENONE
+			if icons, ok := me.data.(ListViewDataIcons); ok && lvi.ISubItem == 0 {
+				if ico, hasIcon := icons.Icon(row); hasIcon {
+					me.loadIcon(16, &me.iconCache16, ico) // same index in both image lists
+					lvi.IImage = int32(me.loadIcon(32, &me.iconCache32, ico))
+				}
+			}
+		}
+		if (lvi.Mask & co.LVIF_STATE) != 0 {
+			if sel, ok := me.data.(ListViewDataSelection); ok {
+				lvi.StateMask |= co.LVIS_SELECTED
+				lvi.State &^= co.LVIS_SELECTED
+				if sel.IsSelected(row) {
+					lvi.State |= co.LVIS_SELECTED
+				}
+			}
+		}
+	})
+
+	parent.base().beforeUserEvents.wmNotify(me.ctrlId, co.LVN_ODCACHEHINT, func(p unsafe.Pointer) {
+		if hint, ok := me.data.(ListViewDataCacheHint); ok {
+			nmc := (*win.NMLVCACHEHINT)(p)
+			hint.CacheHint(int(nmc.IFrom), int(nmc.ITo))
+		}
+	})
+
+	parent.base().beforeUserEvents.wmNotify(me.ctrlId, co.LVN_ITEMCHANGED, func(p unsafe.Pointer) {
+		sel, ok := me.data.(ListViewDataSelection)
+		nmlv := (*win.NMLISTVIEW)(p)
+		if !ok || (nmlv.UChanged&co.LVIF_STATE) == 0 ||
+			((nmlv.UNewState^nmlv.UOldState)&co.LVIS_SELECTED) == 0 {
+			return
+		}
+
+		isSelected := (nmlv.UNewState & co.LVIS_SELECTED) != 0
+		if nmlv.IItem != -1 {
+			sel.SetSelected(int(nmlv.IItem), int(nmlv.IItem), isSelected)
+		} else if count := me.data.RowCount(); count > 0 { // all items
+			sel.SetSelected(0, count-1, isSelected)
+		}
+	})
+
+	parent.base().beforeUserEvents.wmNotify(me.ctrlId, co.LVN_ODSTATECHANGED, func(p unsafe.Pointer) {
+		sel, ok := me.data.(ListViewDataSelection)
+		nmod := (*win.NMLVODSTATECHANGE)(p)
+		if ok && ((nmod.UNewState^nmod.UOldState)&co.LVIS_SELECTED) != 0 {
+			isSelected := (nmod.UNewState & co.LVIS_SELECTED) != 0
+			sel.SetSelected(int(nmod.IFrom), int(nmod.ITo), isSelected)
+		}
+	})
+
+	parent.base().beforeUserEvents.wmNotify(me.ctrlId, co.LVN_COLUMNCLICK, func(p unsafe.Pointer) {
+		if _, ok := me.data.(ListViewDataSorter); ok {
+			col := int((*win.NMLISTVIEW)(p).ISubItem)
+			me.SortData(col, col != me.sortCol || !me.sortAsc) // 2nd click reverses
+		}
+	})
+
+	// LVN_ODFINDITEM must return the index, so it's handled as a user event;
+	// a handler added by the user replaces ours.
+	parent.base().userEvents.WmNotify(me.ctrlId, co.LVN_ODFINDITEM, func(p unsafe.Pointer) uintptr {
+		if me.data == nil {
+			return uintptr(^uint32(0)) // -1
+		}
+		nmfi := (*win.NMLVFINDITEM)(p)
+		return uintptr(int32(me.dataFind(int(nmfi.IStart), &nmfi.Lvfi)))
+	})
+}
+
+// Returns the index of the first row whose text in the first column matches
+// the search, or -1.
+func (me *ListView) dataFind(start int, lvfi *win.LVFINDINFO) int {
+	if (lvfi.Flags & (co.LVFI_STRING | co.LVFI_PARTIAL | co.LVFI_SUBSTRING)) == 0 {
+		return -1 // only text searches are supported
+	}
+	text := strings.ToLower(wstr.DecodePtr(lvfi.Psz))
+	isPartial := (lvfi.Flags & (co.LVFI_PARTIAL | co.LVFI_SUBSTRING)) != 0
+
+	count := me.data.RowCount()
+	if start < 0 || start >= count {
+		start = 0
+	}
+	numRows := count - start
+	if (lvfi.Flags & co.LVFI_WRAP) != 0 {
+		numRows = count
+	}
+
+	for i := 0; i < numRows; i++ {
+		row := (start + i) % count
+		cellText := strings.ToLower(me.data.CellText(row, 0))
+		if (isPartial && strings.HasPrefix(cellText, text)) || cellText == text {
+			return row
+		}
+	}
+	return -1
+}
+
+// Applies the data source to the created control.
+func (me *ListView) applyData() {
+	mask := co.LVIS_NONE
+	if _, ok := me.data.(ListViewDataSelection); ok {
+		mask = co.LVIS_SELECTED // selection state is asked to the data source
+	}
+	me.sendMessage(co.LVM_SETCALLBACKMASK, win.WPARAM(mask), 0)
+
+	if me.sortCol != -1 && me.header != nil {
+		me.Col(me.sortCol).SetSortArrow(co.HDF_NONE)
+	}
+	me.sortCol = -1
+	me.RefreshData()
+}
+
+// Returns the data source of a virtual list view, or nil if none.
+func (me *ListView) Data() ListViewData {
+	return me.data
+}
+
+// Sets the data source of a virtual list view, replacing the current one, if
+// any. Passing nil only removes the data source.
+//
+// The control must have the co.LVS_OWNERDATA style. When created with
+// [NewListView], prefer [VarOptsListView.Data], which sets the style; when
+// loaded from a dialog resource, the style must be set in the resource.
+//
+// Returns the same object, so further operations can be chained.
+func (me *ListView) SetData(data ListViewData) *ListView {
+	me.data = data
+	if me.hWnd != 0 && data != nil {
+		me.applyData()
+	}
+	return me
+}
+
+// Updates the number of rows of a virtual list view with [LVM_SETITEMCOUNT],
+// and redraws the visible ones. Call this method whenever the data source is
+// changed.
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics if the list view has no data source; panics on error.
+//
+// [LVM_SETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setitemcount
+func (me *ListView) RefreshData() *ListView {
+	if me.data == nil {
+		panic("This ListView has no data source.")
+	}
+	ret, err := me.sendMessage(co.LVM_SETITEMCOUNT,
+		win.WPARAM(int32(me.data.RowCount())), win.LPARAM(co.LVSICF_NOSCROLL))
+	if err != nil || ret == 0 {
+		panic("LVM_SETITEMCOUNT failed.")
+	}
+	return me
+}
+
+// Sorts the rows of a virtual list view by calling [ListViewDataSorter.Sort],
+// and displays the sort arrow on the column. This is automatically called when
+// a column header is clicked.
+//
+// If the data source doesn't implement [ListViewDataSelection], the selection
+// is cleared, since it would no longer match the rows.
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics if the data source doesn't implement [ListViewDataSorter].
+func (me *ListView) SortData(col int, ascending bool) *ListView {
+	sorter, ok := me.data.(ListViewDataSorter)
+	if !ok {
+		panic("The ListView data source doesn't implement ListViewDataSorter.")
+	}
+	sorter.Sort(col, ascending)
+	me.sortCol, me.sortAsc = col, ascending
+
+	if me.header != nil {
+		arrow := co.HDF_SORTDOWN
+		if ascending {
+			arrow = co.HDF_SORTUP
+		}
+		me.Col(col).SetSortArrow(arrow)
+	}
+
+	if _, ok := me.data.(ListViewDataSelection); !ok {
+		lvi := win.LVITEM{
+			State:     co.LVIS_NONE,
+			StateMask: co.LVIS_SELECTED,
+		}
+		idxAllItems := -1
+		me.sendMessagePtr(co.LVM_SETITEMSTATE,
+			win.WPARAM(int32(idxAllItems)), unsafe.Pointer(&lvi))
+	}
+
+	if count := me.data.RowCount(); count > 0 {
+		me.sendMessage(co.LVM_REDRAWITEMS, 0, win.LPARAM(int32(count-1)))
+	}
+	return me
+}
@@ -25,7 +25,7 @@ type ListViewItem struct {
 //
 // [LVM_DELETEITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-deleteitem
 func (me ListViewItem) Delete() {
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_DELETEITEM, win.WPARAM(int32(me.index)), 0)
+	ret, err := me.owner.sendMessage(co.LVM_DELETEITEM, win.WPARAM(int32(me.index)), 0)
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_DELETEITEM %d failed.", me.index))
 	}
@@ -68,7 +68,7 @@ func (me ListViewItem) EnsureVisible() ListViewItem {
 		rc, _ := me.owner.hWnd.GetClientRect()
 		cyList := rc.Bottom // total height of the list view
 
-		idxRet, _ := me.owner.hWnd.SendMessage(co.LVM_GETTOPINDEX, 0, 0) // 1st visible item
+		idxRet, _ := me.owner.sendMessage(co.LVM_GETTOPINDEX, 0, 0) // 1st visible item
 		lvii := win.LVITEMINDEX{
 			IItem: int32(idxRet),
 		}
@@ -76,8 +76,8 @@ func (me ListViewItem) EnsureVisible() ListViewItem {
 			Left: int32(co.LVIR_BOUNDS),
 		}
 
-		ret, err := me.owner.hWnd.SendMessage(co.LVM_GETITEMINDEXRECT,
-			win.WPARAM(unsafe.Pointer(&lvii)), win.LPARAM(unsafe.Pointer(&rc)))
+		ret, err := me.owner.sendMessagePtr(co.LVM_GETITEMINDEXRECT,
+			win.WPARAM(unsafe.Pointer(&lvii)), unsafe.Pointer(&rc))
 		if err != nil || ret == 0 {
 			panic(fmt.Sprintf("LVM_GETITEMINDEXRECT %d failed.", lvii.IItem))
 		}
@@ -89,8 +89,8 @@ func (me ListViewItem) EnsureVisible() ListViewItem {
 		}
 		rc = win.RECT{}
 
-		ret, err = me.owner.hWnd.SendMessage(co.LVM_GETITEMINDEXRECT,
-			win.WPARAM(unsafe.Pointer(&lvii)), win.LPARAM(unsafe.Pointer(&rc)))
+		ret, err = me.owner.sendMessagePtr(co.LVM_GETITEMINDEXRECT,
+			win.WPARAM(unsafe.Pointer(&lvii)), unsafe.Pointer(&rc))
 		if err != nil || ret == 0 {
 			panic(fmt.Sprintf("LVM_GETITEMINDEXRECT %d failed.", lvii.IItem))
 		}
@@ -101,7 +101,7 @@ func (me ListViewItem) EnsureVisible() ListViewItem {
 		}
 
 	} else {
-		ret, err := me.owner.hWnd.SendMessage(co.LVM_ENSUREVISIBLE,
+		ret, err := me.owner.sendMessage(co.LVM_ENSUREVISIBLE,
 			win.WPARAM(int32(me.index)), win.LPARAM(1)) // always entirely visible
 		if err != nil || ret == 0 {
 			panic(fmt.Sprintf("LVM_ENSUREVISIBLE %d failed.", me.index))
@@ -123,8 +123,8 @@ func (me ListViewItem) Focus() ListViewItem {
 		StateMask: co.LVIS_FOCUSED,
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETITEMSTATE,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&lvi)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_SETITEMSTATE,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&lvi))
 	if err != nil || int32(ret) == -1 {
 		panic(fmt.Sprintf("LVM_SETITEMSTATE %d failed.", me.index))
 	}
@@ -182,8 +182,8 @@ func (me ListViewItem) iconRaw(iconCache *_IconCacheImgList) (Ico, bool) {
 		Mask:  co.LVIF_IMAGE,
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_GETITEM,
-		0, win.LPARAM(unsafe.Pointer(&lvi)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_GETITEM,
+		0, unsafe.Pointer(&lvi))
 	if ret == 0 || err != nil {
 		panic("LVM_GETITEM failed.")
 	}
@@ -202,7 +202,7 @@ func (me ListViewItem) Index() int {
 //
 // [LVM_GETITEMSTATE]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getitemstate
 func (me ListViewItem) IsSelected() bool {
-	lvisRet, _ := me.owner.hWnd.SendMessage(co.LVM_GETITEMSTATE,
+	lvisRet, _ := me.owner.sendMessage(co.LVM_GETITEMSTATE,
 		win.WPARAM(int32(me.index)), win.LPARAM(co.LVIS_SELECTED))
 	return co.LVIS(lvisRet) == co.LVIS_SELECTED
 }
@@ -211,7 +211,7 @@ func (me ListViewItem) IsSelected() bool {
 //
 // [LVM_ISITEMVISIBLE]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-isitemvisible
 func (me ListViewItem) IsVisible() bool {
-	ret, _ := me.owner.hWnd.SendMessage(co.LVM_ISITEMVISIBLE,
+	ret, _ := me.owner.sendMessage(co.LVM_ISITEMVISIBLE,
 		win.WPARAM(int32(me.index)), 0)
 	return ret != 0
 }
@@ -227,8 +227,8 @@ func (me ListViewItem) ItemRect(portion co.LVIR) win.RECT {
 		Left: int32(portion),
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_GETITEMRECT,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&rcItem)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_GETITEMRECT,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&rcItem))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_GETITEMRECT %d failed.", me.index))
 	}
@@ -270,8 +270,8 @@ func (me ListViewItem) Select(isSelected bool) ListViewItem {
 		StateMask: co.LVIS_SELECTED,
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETITEMSTATE,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&lvi)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_SETITEMSTATE,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&lvi))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_SETITEMSTATE %d failed.", me.index))
 	}
@@ -343,14 +343,7 @@ func (me ListViewItem) SetIcon32(icon Ico) ListViewItem {
 }
 
 func (me ListViewItem) setIconRaw(resolution int, iconCache *_IconCacheImgList, icon Ico) ListViewItem {
-	hImgList, newImgList, idxIcon := iconCache.IconIndex(resolution, icon)
-	if newImgList { // image list has just been created
-		lvsil := co.LVSIL_NORMAL
-		if resolution == 16 {
-			lvsil = co.LVSIL_SMALL
-		}
-		me.owner.Hwnd().SendMessage(co.LVM_SETIMAGELIST, win.WPARAM(lvsil), win.LPARAM(hImgList))
-	}
+	idxIcon := me.owner.loadIcon(resolution, iconCache, icon)
 
 	lvi := win.LVITEM{
 		IItem:  me.index,
@@ -358,8 +351,8 @@ func (me ListViewItem) setIconRaw(resolution int, iconCache *_IconCacheImgList,
 		IImage: int32(idxIcon),
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETITEM,
-		0, win.LPARAM(unsafe.Pointer(&lvi)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_SETITEM,
+		0, unsafe.Pointer(&lvi))
 	if ret == 0 || err != nil {
 		panic("LVM_SETITEM failed.")
 	}
@@ -382,8 +375,8 @@ func (me ListViewItem) SetText(columnIndex int, text string) ListViewItem {
 	var wText wstr.BufEncoder
 	lvi.SetPszText(wText.Slice(text))
 
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETITEMTEXT,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&lvi)))
+	ret, err := me.owner.sendMessagePtr(co.LVM_SETITEMTEXT,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&lvi))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_SETITEMTEXT %d/%d failed \"%s\".",
 			me.index, columnIndex, text))
@@ -406,8 +399,8 @@ func (me ListViewItem) Text(columnIndex int) string {
 	for {
 		lvi.SetPszText(wBuf.HotSlice())
 
-		nCharsRet, _ := me.owner.hWnd.SendMessage(co.LVM_GETITEMTEXT,
-			win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&lvi)))
+		nCharsRet, _ := me.owner.sendMessagePtr(co.LVM_GETITEMTEXT,
+			win.WPARAM(int32(me.index)), unsafe.Pointer(&lvi))
 		nChars := int(nCharsRet)
 
 		if nChars+1 < wBuf.Len() { // to break, must have at least 1 char gap
@@ -424,7 +417,7 @@ func (me ListViewItem) Text(columnIndex int) string {
 //
 // [LVM_MAPINDEXTOID]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-mapindextoid
 func (me ListViewItem) Uid() int {
-	uidRet, _ := me.owner.hWnd.SendMessage(co.LVM_MAPINDEXTOID, win.WPARAM(int32(me.index)), 0)
+	uidRet, _ := me.owner.sendMessage(co.LVM_MAPINDEXTOID, win.WPARAM(int32(me.index)), 0)
 	return int(uidRet)
 }
 
@@ -436,7 +429,7 @@ func (me ListViewItem) Uid() int {
 //
 // [LVM_UPDATE]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-update
 func (me ListViewItem) Update() ListViewItem {
-	ret, err := me.owner.hWnd.SendMessage(co.LVM_UPDATE, win.WPARAM(int32(me.index)), 0)
+	ret, err := me.owner.sendMessage(co.LVM_UPDATE, win.WPARAM(int32(me.index)), 0)
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("LVM_UPDATE %d failed.", me.index))
 	}
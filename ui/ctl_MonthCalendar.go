@@ -42,8 +42,8 @@ func NewMonthCalendar(parent Parent, opts *VarOptsMonthCalendar) *MonthCalendar
 			opts.wndStyle|co.WS(opts.ctrlStyle), opts.position, win.SIZE{}, parent, false)
 
 		var rcBound win.RECT
-		me.hWnd.SendMessage(co.MCM_GETMINREQRECT, // request the ideal size
-			0, win.LPARAM(unsafe.Pointer(&rcBound)))
+		me.sendMessagePtr(co.MCM_GETMINREQRECT, // request the ideal size
+			0, unsafe.Pointer(&rcBound))
 		me.hWnd.SetWindowPos(win.HWND(0), win.POINT{},
 			win.SIZE{Cx: rcBound.Right, Cy: rcBound.Bottom},
 			co.SWP_NOZORDER|co.SWP_NOMOVE)
@@ -95,7 +95,7 @@ func (me *MonthCalendar) On() *MonthCalendarEvents {
 // [MCM_GETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/mcm-getcursel
 func (me *MonthCalendar) Date() time.Time {
 	var st win.SYSTEMTIME
-	me.hWnd.SendMessage(co.MCM_GETCURSEL, 0, win.LPARAM(unsafe.Pointer(&st)))
+	me.sendMessagePtr(co.MCM_GETCURSEL, 0, unsafe.Pointer(&st))
 	return st.ToTime()
 }
 
@@ -107,7 +107,7 @@ func (me *MonthCalendar) Date() time.Time {
 func (me *MonthCalendar) SetDate(date time.Time) *MonthCalendar {
 	var st win.SYSTEMTIME
 	st.SetTime(date)
-	me.hWnd.SendMessage(co.MCM_SETCURSEL, 0, win.LPARAM(unsafe.Pointer(&st)))
+	me.sendMessagePtr(co.MCM_SETCURSEL, 0, unsafe.Pointer(&st))
 	return me
 }
 
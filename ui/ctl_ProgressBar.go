@@ -63,7 +63,7 @@ func NewProgressBarDlg(parent Parent, ctrlId uint16, layout LAY) *ProgressBar {
 //
 // [PBM_GETPOS]: https://learn.microsoft.com/en-us/windows/win32/controls/pbm-getpos
 func (me *ProgressBar) Pos() int {
-	pos, _ := me.hWnd.SendMessage(co.PBM_GETPOS, 0, 0)
+	pos, _ := me.sendMessage(co.PBM_GETPOS, 0, 0)
 	return int(pos)
 }
 
@@ -72,7 +72,7 @@ func (me *ProgressBar) Pos() int {
 // [PBM_GETRANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/pbm-getrange
 func (me *ProgressBar) Range() (int, int) {
 	var r win.PBRANGE
-	me.hWnd.SendMessage(co.PBM_GETRANGE, 0, win.LPARAM(unsafe.Pointer(&r)))
+	me.sendMessagePtr(co.PBM_GETRANGE, 0, unsafe.Pointer(&r))
 	return int(r.ILow), int(r.IHigh)
 }
 
@@ -90,7 +90,7 @@ func (me *ProgressBar) SetMarquee(isMarquee bool) *ProgressBar {
 			uintptr(curStyle)|uintptr(co.PBS_MARQUEE))
 	}
 
-	me.hWnd.SendMessage(co.PBM_SETMARQUEE,
+	me.sendMessage(co.PBM_SETMARQUEE,
 		win.WPARAM(utl.BoolToUintptr(isMarquee)), 0)
 
 	if !isMarquee {
@@ -111,7 +111,7 @@ func (me *ProgressBar) SetPos(pos int) *ProgressBar {
 	if me.isMarquee {
 		me.SetMarquee(false) // avoid crash
 	}
-	me.hWnd.SendMessage(co.PBM_SETPOS, win.WPARAM(int32(pos)), 0)
+	me.sendMessage(co.PBM_SETPOS, win.WPARAM(int32(pos)), 0)
 	return me
 }
 
@@ -121,7 +121,7 @@ func (me *ProgressBar) SetPos(pos int) *ProgressBar {
 //
 // [PBM_SETRANGE32]: https://learn.microsoft.com/en-us/windows/win32/controls/pbm-setrange32
 func (me *ProgressBar) SetRange(min, max int) *ProgressBar {
-	me.hWnd.SendMessage(co.PBM_SETRANGE32,
+	me.sendMessage(co.PBM_SETRANGE32,
 		win.WPARAM(int32(min)), win.LPARAM(int32(max)))
 	return me
 }
@@ -132,7 +132,7 @@ func (me *ProgressBar) SetRange(min, max int) *ProgressBar {
 //
 // [PBM_SETSTATE]: https://learn.microsoft.com/en-us/windows/win32/controls/pbm-setstate
 func (me *ProgressBar) SetState(state co.PBST) *ProgressBar {
-	me.hWnd.SendMessage(co.PBM_SETSTATE, win.WPARAM(state), 0)
+	me.sendMessage(co.PBM_SETSTATE, win.WPARAM(state), 0)
 	return me
 }
 
@@ -39,7 +39,7 @@ func (me *RadioButton) Index() int {
 //
 // [BM_GETSTATE]: https://learn.microsoft.com/en-us/windows/win32/controls/bm-getstate
 func (me *RadioButton) IsSelected() bool {
-	s, _ := me.hWnd.SendMessage(co.BM_GETSTATE, 0, 0)
+	s, _ := me.sendMessage(co.BM_GETSTATE, 0, 0)
 	return (co.BST(s) & co.BST_CHECKED) != 0
 }
 
@@ -49,7 +49,7 @@ func (me *RadioButton) IsSelected() bool {
 //
 // [BM_SETCHECK]: https://learn.microsoft.com/en-us/windows/win32/controls/bm-setcheck
 func (me *RadioButton) Select() *RadioButton {
-	me.hWnd.SendMessage(co.BM_SETCHECK, win.WPARAM(co.BST_CHECKED), 0)
+	me.sendMessage(co.BM_SETCHECK, win.WPARAM(co.BST_CHECKED), 0)
 	return me
 }
 
@@ -62,10 +62,7 @@ func (me *RadioButton) Select() *RadioButton {
 // [BN_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-clicked
 func (me *RadioButton) SelectAndTrigger() *RadioButton {
 	me.Select()
-	hParent, _ := me.hWnd.GetParent()
-	hParent.SendMessage(co.WM_COMMAND,
-		win.MAKEWPARAM(uint16(me.CtrlId()), uint16(co.BN_CLICKED)),
-		win.LPARAM(me.Hwnd()))
+	me.sendCommandToParent(co.BN_CLICKED)
 	return me
 }
 
@@ -73,7 +70,7 @@ func (me *RadioButton) SelectAndTrigger() *RadioButton {
 //
 // Returns the same object, so further operations can be chained.
 func (me *RadioButton) SetTextAndResize(text string) *RadioButton {
-	me.hWnd.SetWindowText(text)
+	me.setWindowText(text)
 	boundBox, _ := calcBcmBoundBox(me.hWnd)
 	me.hWnd.SetWindowPos(win.HWND(0), win.POINT{}, boundBox, co.SWP_NOZORDER|co.SWP_NOMOVE)
 	return me
@@ -94,7 +94,7 @@ func (me *Static) On() *StaticEvents {
 
 // Calls [win.HWND.SetWindowText] and resizes the control to exactly fit it.
 func (me *Static) SetTextAndResize(text string) *Static {
-	me.hWnd.SetWindowText(text)
+	me.setWindowText(text)
 	boundBox, _ := calcTextBoundBox(utl.RemoveAccelAmpersands(text))
 	me.hWnd.SetWindowPos(win.HWND(0), win.POINT{}, boundBox, co.SWP_NOZORDER|co.SWP_NOMOVE)
 	return me
@@ -102,7 +102,7 @@ func (me *Static) SetTextAndResize(text string) *Static {
 
 // Calls [win.HWND.GetWindowText].
 func (me *Static) Text() string {
-	t, _ := me.hWnd.GetWindowText()
+	t, _ := me.getWindowText()
 	return t
 }
 
@@ -122,7 +122,7 @@ func (me *StatusBar) resizeToFitParent(parm WmSize) {
 	if parm.Request() == co.SIZE_REQ_MINIMIZED || me.Hwnd() == 0 {
 		return
 	}
-	me.Hwnd().SendMessage(co.WM_SIZE, 0, 0) // tell status bar to fit parent
+	me.sendMessage(co.WM_SIZE, 0, 0) // tell status bar to fit parent
 
 	if len(me.partsData) == 0 {
 		return // no parts added, nothing else to do
@@ -149,9 +149,9 @@ func (me *StatusBar) resizeToFitParent(parm WmSize) {
 			cxTotal -= (cxVariable / totalWeight) * me.partsData[i].resizeWeight
 		}
 	}
-	me.Hwnd().SendMessage(co.SB_SETPARTS,
+	me.sendMessagePtr(co.SB_SETPARTS,
 		win.WPARAM(int32(len(me.rightEdges))),
-		win.LPARAM(unsafe.Pointer(&me.rightEdges[0])))
+		unsafe.Pointer(&me.rightEdges[0]))
 }
 
 // Exposes all the control notifications the can be handled.
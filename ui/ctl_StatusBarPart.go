@@ -24,7 +24,7 @@ type StatusBarPart struct {
 //
 // [SB_GETICON]: https://learn.microsoft.com/en-us/windows/win32/controls/sb-geticon
 func (me StatusBarPart) Icon() win.HICON {
-	h, _ := me.owner.hWnd.SendMessage(co.SB_GETICON, win.WPARAM(me.index), 0)
+	h, _ := me.owner.sendMessage(co.SB_GETICON, win.WPARAM(me.index), 0)
 	return win.HICON(h)
 }
 
@@ -48,7 +48,7 @@ func (me StatusBarPart) Index() int {
 // [SB_SETICON]: https://learn.microsoft.com/en-us/windows/win32/controls/sb-seticon
 func (me StatusBarPart) SetIcon(icon Ico) StatusBarPart {
 	hIcon := me.owner.iconCache16.Handle(16, icon)
-	me.owner.hWnd.SendMessage(co.SB_SETICON,
+	me.owner.sendMessage(co.SB_SETICON,
 		win.WPARAM(int32(me.index)), win.LPARAM(hIcon))
 	return me
 }
@@ -62,9 +62,9 @@ func (me StatusBarPart) SetIcon(icon Ico) StatusBarPart {
 // [SB_SETTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/sb-settext
 func (me StatusBarPart) SetText(text string) StatusBarPart {
 	var wText wstr.BufEncoder
-	ret, _ := me.owner.hWnd.SendMessage(co.SB_SETTEXT,
+	ret, _ := me.owner.sendMessagePtr(co.SB_SETTEXT,
 		win.MAKEWPARAM(win.MAKEWORD(uint8(me.index), 0), 0),
-		win.LPARAM(wText.AllowEmpty(text)))
+		wText.AllowEmpty(text))
 	if ret == 0 {
 		panic(fmt.Sprintf("SB_SETTEXT %d failed \"%s\".", me.index, text))
 	}
@@ -76,7 +76,7 @@ func (me StatusBarPart) SetText(text string) StatusBarPart {
 //
 // [SB_GETTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/sb-gettext
 func (me StatusBarPart) Text() string {
-	nLen, _ := me.owner.hWnd.SendMessage(co.SB_GETTEXTLENGTH,
+	nLen, _ := me.owner.sendMessage(co.SB_GETTEXTLENGTH,
 		win.WPARAM(int32(me.index)), 0)
 	if nLen == 0 {
 		return ""
@@ -85,7 +85,7 @@ func (me StatusBarPart) Text() string {
 	var wBuf wstr.BufDecoder
 	wBuf.Alloc(wstr.BUF_MAX)
 
-	me.owner.hWnd.SendMessage(co.SB_GETTEXT,
-		win.WPARAM(int32(me.index)), win.LPARAM(wBuf.Ptr()))
+	me.owner.sendMessagePtr(co.SB_GETTEXT,
+		win.WPARAM(int32(me.index)), wBuf.Ptr())
 	return wBuf.String()
 }
@@ -71,7 +71,7 @@ func (me *SysLink) On() *SysLinkEvents {
 //	link.SetTextAndResize(
 //		"Link <a href=\"https://google.com\">here</a>")
 func (me *SysLink) SetTextAndResize(text string) *SysLink {
-	me.hWnd.SetWindowText(text)
+	me.setWindowText(text)
 	boundBox, _ := calcTextBoundBox(utl.RemoveAccelAmpersands(utl.RemoveHtmlAnchor(text)))
 	me.hWnd.SetWindowPos(win.HWND(0), win.POINT{}, boundBox, co.SWP_NOZORDER|co.SWP_NOMOVE)
 	return me
@@ -79,7 +79,7 @@ func (me *SysLink) SetTextAndResize(text string) *SysLink {
 
 // Calls [win.HWND.GetWindowText].
 func (me *SysLink) Text() string {
-	t, _ := me.hWnd.GetWindowText()
+	t, _ := me.getWindowText()
 	return t
 }
 
@@ -159,8 +159,8 @@ func (me *Tab) addTab(title string) TabItem {
 	var wBuf wstr.BufEncoder
 	tci.SetPszText(wBuf.Slice(title))
 
-	newIdxRet, err := me.Hwnd().SendMessage(co.TCM_INSERTITEM,
-		0x0fff_ffff, win.LPARAM(unsafe.Pointer(&tci)))
+	newIdxRet, err := me.sendMessagePtr(co.TCM_INSERTITEM,
+		0x0fff_ffff, unsafe.Pointer(&tci))
 	newIdx := int(newIdxRet)
 	if err != nil || newIdx == -1 {
 		panic(fmt.Sprintf("TCM_INSERTITEM \"%s\" failed.", title))
@@ -173,7 +173,7 @@ func (me *Tab) resizeChildContainer(hChild win.HWND) {
 	rcTab, _ := me.Hwnd().GetWindowRect()
 	hParent, _ := me.Hwnd().GetParent()
 	hParent.ScreenToClientRc(&rcTab)
-	me.Hwnd().SendMessage(co.TCM_ADJUSTRECT, 0, win.LPARAM(unsafe.Pointer(&rcTab))) // ideal child size
+	me.sendMessagePtr(co.TCM_ADJUSTRECT, 0, unsafe.Pointer(&rcTab)) // ideal child size
 	hChild.SetWindowPos(win.HWND(0),
 		win.POINT{X: rcTab.Left, Y: rcTab.Top},
 		win.SIZE{Cx: rcTab.Right - rcTab.Left, Cy: rcTab.Bottom - rcTab.Top},
@@ -215,7 +215,7 @@ func (me *Tab) Item(index int) TabItem {
 //
 // [TCM_GETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/tcm-getitemcount
 func (me *Tab) ItemCount() int {
-	countRet, err := me.Hwnd().SendMessage(co.TCM_GETITEMCOUNT, 0, 0)
+	countRet, err := me.sendMessage(co.TCM_GETITEMCOUNT, 0, 0)
 	count := int(countRet)
 	if err != nil || count == -1 {
 		panic("TCM_GETITEMCOUNT failed.")
@@ -227,7 +227,7 @@ func (me *Tab) ItemCount() int {
 //
 // [TCM_GETCURFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/tcm-getcurfocus
 func (me *Tab) ItemFocused() (TabItem, bool) {
-	idxRet, _ := me.Hwnd().SendMessage(co.TCM_GETCURFOCUS, 0, 0)
+	idxRet, _ := me.sendMessage(co.TCM_GETCURFOCUS, 0, 0)
 	idx := int(idxRet)
 	if idx == -1 {
 		return TabItem{}, false
@@ -239,7 +239,7 @@ func (me *Tab) ItemFocused() (TabItem, bool) {
 //
 // [TCM_GETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/tcm-getcursel
 func (me *Tab) ItemSelected() (TabItem, bool) {
-	idxRet, _ := me.Hwnd().SendMessage(co.TCM_GETCURSEL, 0, 0)
+	idxRet, _ := me.sendMessage(co.TCM_GETCURSEL, 0, 0)
 	idx := int(idxRet)
 	if idx == -1 {
 		return TabItem{}, false
@@ -257,7 +257,7 @@ func (me *Tab) SetExtendedStyle(doSet bool, style co.TCS_EX) *Tab {
 	if doSet {
 		affected = style
 	}
-	me.hWnd.SendMessage(co.TCM_SETEXTENDEDSTYLE,
+	me.sendMessage(co.TCM_SETEXTENDEDSTYLE,
 		win.WPARAM(affected), win.LPARAM(style))
 	return me
 }
@@ -35,7 +35,7 @@ func (me TabItem) Index() int {
 //
 // [TCM_SETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/tcm-setcursel
 func (me TabItem) Select() TabItem {
-	me.owner.hWnd.SendMessage(co.TCM_SETCURSEL, win.WPARAM(int32(me.index)), 0)
+	me.owner.sendMessage(co.TCM_SETCURSEL, win.WPARAM(int32(me.index)), 0)
 	me.owner.displayContent(int(me.index)) // because notification is not sent
 	return me
 }
@@ -55,8 +55,8 @@ func (me TabItem) SetText(text string) TabItem {
 	var wText wstr.BufEncoder
 	tci.SetPszText(wText.Slice(text))
 
-	ret, err := me.owner.hWnd.SendMessage(co.TCM_SETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&tci)))
+	ret, err := me.owner.sendMessagePtr(co.TCM_SETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&tci))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("TCM_SETITEM %d to \"%s\" failed.", me.index, text))
 	}
@@ -78,8 +78,8 @@ func (me TabItem) Text() string {
 	wBuf.Alloc(wstr.BUF_MAX)
 	tci.SetPszText(wBuf.HotSlice())
 
-	ret, err := me.owner.hWnd.SendMessage(co.TCM_GETITEM,
-		win.WPARAM(int32(me.index)), win.LPARAM(unsafe.Pointer(&tci)))
+	ret, err := me.owner.sendMessagePtr(co.TCM_GETITEM,
+		win.WPARAM(int32(me.index)), unsafe.Pointer(&tci))
 	if err != nil || ret == 0 {
 		panic(fmt.Sprintf("TCM_GETITEM %d failed.", me.index))
 	}
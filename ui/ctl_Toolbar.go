@@ -31,9 +31,9 @@ func NewToolbar(parent Parent, opts *VarOptsToolbar) *Toolbar {
 	parent.base().beforeUserEvents.wmCreateOrInitdialog(func() {
 		me.createWindow(opts.wndExStyle, "ToolbarWindow32", "",
 			opts.wndStyle|co.WS(opts.ctrlStyle), win.POINT{}, win.SIZE{}, parent, false)
-		me.hWnd.SendMessage(co.TB_BUTTONSTRUCTSIZE,
+		me.sendMessage(co.TB_BUTTONSTRUCTSIZE,
 			win.WPARAM(int32(unsafe.Sizeof(win.TBBUTTON{}))), 0) // necessary before TB_ADDBUTTONS
-		me.hWnd.SendMessage(co.CCM_SETVERSION, 5, 0)
+		me.sendMessage(co.CCM_SETVERSION, 5, 0)
 		if opts.ctrlExStyle != co.TBSTYLE_EX_NONE {
 			me.SetExtendedStyle(true, opts.ctrlExStyle)
 		}
@@ -45,9 +45,9 @@ func NewToolbar(parent Parent, opts *VarOptsToolbar) *Toolbar {
 
 func (me *Toolbar) defaultMessageHandlers(parent Parent) {
 	parent.base().afterUserEvents.wm(co.WM_DESTROY, func(_ Wm) {
-		h, _ := me.hWnd.SendMessage(co.TB_GETIMAGELIST, 0, 0)
+		h, _ := me.sendMessage(co.TB_GETIMAGELIST, 0, 0)
 		if h != 0 {
-			me.hWnd.SendMessage(co.TB_SETIMAGELIST, 0, 0)
+			me.sendMessage(co.TB_SETIMAGELIST, 0, 0)
 			win.HIMAGELIST(h).Destroy()
 		}
 	})
@@ -78,20 +78,20 @@ func (me *Toolbar) AddButton(cmdId uint16, text string, iconIndex int) {
 		IString:   (*uint16)(wText.AllowEmpty(text)),
 	}
 
-	ret, _ := me.Hwnd().SendMessage(co.TB_ADDBUTTONS,
-		1, win.LPARAM(unsafe.Pointer(&tbb)))
+	ret, _ := me.sendMessagePtr(co.TB_ADDBUTTONS,
+		1, unsafe.Pointer(&tbb))
 	if ret == 0 {
 		panic(fmt.Sprintf("TB_ADDBUTTONS \"%s\" failed.", text))
 	}
 
-	me.Hwnd().SendMessage(co.TB_AUTOSIZE, 0, 0)
+	me.sendMessage(co.TB_AUTOSIZE, 0, 0)
 }
 
 // Retrieves the number of buttons with [TB_BUTTONCOUNT].
 //
 // [TB_BUTTONCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/tb-buttoncount
 func (me *Toolbar) ButtonCount() int {
-	ret, _ := me.Hwnd().SendMessage(co.TB_BUTTONCOUNT, 0, 0)
+	ret, _ := me.sendMessage(co.TB_BUTTONCOUNT, 0, 0)
 	return int(ret)
 }
 
@@ -99,7 +99,7 @@ func (me *Toolbar) ButtonCount() int {
 //
 // [TB_GETEXTENDEDSTYLE]: https://learn.microsoft.com/en-us/windows/win32/controls/tb-getextendedstyle
 func (me *Toolbar) ExtendedStyle() co.TBSTYLE_EX {
-	ret, _ := me.hWnd.SendMessage(co.TB_GETEXTENDEDSTYLE, 0, 0)
+	ret, _ := me.sendMessage(co.TB_GETEXTENDEDSTYLE, 0, 0)
 	return co.TBSTYLE_EX(ret)
 }
 
@@ -112,14 +112,24 @@ func (me *Toolbar) ExtendedStyle() co.TBSTYLE_EX {
 //
 // The image list will be automatically destroyed.
 //
+// Example:
+//
+//	//go:embed save.ico
+//	var saveIco []byte
+//
+//	saveFile, _ := ico.Parse(saveIco)
+//	hIcon, _ := win.CreateIconFromIco(saveFile, ui.DpiX(16))
+//	defer hIcon.DestroyIcon()
+//	_ = toolbar.ImageList(ui.DpiX(16), ui.DpiY(16)).AddIcon(hIcon)
+//
 // [TB_GETIMAGELIST]: https://learn.microsoft.com/en-us/windows/win32/controls/tb-getimagelist
 // [TB_SETIMAGELIST]: https://learn.microsoft.com/en-us/windows/win32/controls/tb-setimagelist
 func (me *Toolbar) ImageList(cx, cy int) win.HIMAGELIST {
-	h, _ := me.hWnd.SendMessage(co.TB_GETIMAGELIST, 0, 0)
+	h, _ := me.sendMessage(co.TB_GETIMAGELIST, 0, 0)
 	hImg := win.HIMAGELIST(h)
 	if hImg == win.HIMAGELIST(0) {
 		hImg, _ = win.ImageListCreate(cx, cy, co.ILC_COLOR32, 1, 1)
-		me.hWnd.SendMessage(co.TB_SETIMAGELIST, 0, win.LPARAM(hImg))
+		me.sendMessage(co.TB_SETIMAGELIST, 0, win.LPARAM(hImg))
 	}
 	return hImg
 }
@@ -136,7 +146,7 @@ func (me *Toolbar) SetExtendedStyle(doSet bool, style co.TBSTYLE_EX) *Toolbar {
 	} else {
 		newStyle &= ^style
 	}
-	me.Hwnd().SendMessage(co.TB_SETEXTENDEDSTYLE, 0, win.LPARAM(newStyle))
+	me.sendMessage(co.TB_SETEXTENDEDSTYLE, 0, win.LPARAM(newStyle))
 	return me
 }
 
@@ -89,7 +89,7 @@ func (me *Trackbar) On() *TrackbarEvents {
 //
 // [TBM_GETPAGESIZE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-getpagesize
 func (me *Trackbar) PageSize() int {
-	s, _ := me.Hwnd().SendMessage(co.TBM_GETPAGESIZE, 0, 0)
+	s, _ := me.sendMessage(co.TBM_GETPAGESIZE, 0, 0)
 	return int(s)
 }
 
@@ -97,7 +97,7 @@ func (me *Trackbar) PageSize() int {
 //
 // [TBM_GETPOS]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-getpos
 func (me *Trackbar) Pos() int {
-	p, _ := me.Hwnd().SendMessage(co.TBM_GETPOS, 0, 0)
+	p, _ := me.sendMessage(co.TBM_GETPOS, 0, 0)
 	return int(p)
 }
 
@@ -107,8 +107,8 @@ func (me *Trackbar) Pos() int {
 // [TBM_GETRANGEMIN]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-getrangemin
 // [TBM_GETRANGEMAX]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-getrangemax
 func (me *Trackbar) Range() (int, int) {
-	min, _ := me.Hwnd().SendMessage(co.TBM_GETRANGEMIN, 0, 0)
-	max, _ := me.Hwnd().SendMessage(co.TBM_GETRANGEMAX, 0, 0)
+	min, _ := me.sendMessage(co.TBM_GETRANGEMIN, 0, 0)
+	max, _ := me.sendMessage(co.TBM_GETRANGEMAX, 0, 0)
 	return int(min), int(max)
 }
 
@@ -116,7 +116,7 @@ func (me *Trackbar) Range() (int, int) {
 //
 // [TBM_SETPAGESIZE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-setpagesize
 func (me *Trackbar) SetPageSize(pageSize int) *Trackbar {
-	me.Hwnd().SendMessage(co.TBM_SETPAGESIZE, 0, win.LPARAM(int32(pageSize)))
+	me.sendMessage(co.TBM_SETPAGESIZE, 0, win.LPARAM(int32(pageSize)))
 	return me
 }
 
@@ -124,7 +124,7 @@ func (me *Trackbar) SetPageSize(pageSize int) *Trackbar {
 //
 // [TBM_SETPOS]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-setpos
 func (me *Trackbar) SetPos(pos int) *Trackbar {
-	me.Hwnd().SendMessage(co.TBM_SETPOS, 1, win.LPARAM(int32(pos)))
+	me.sendMessage(co.TBM_SETPOS, 1, win.LPARAM(int32(pos)))
 	return me
 }
 
@@ -134,8 +134,8 @@ func (me *Trackbar) SetPos(pos int) *Trackbar {
 // [TBM_SETRANGEMIN]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-setrangemin
 // [TBM_SETRANGEMAX]: https://learn.microsoft.com/en-us/windows/win32/controls/tbm-setrangemax
 func (me *Trackbar) SetRange(min, max int) *Trackbar {
-	me.Hwnd().SendMessage(co.TBM_SETRANGEMIN, 1, win.LPARAM(int32(min)))
-	me.Hwnd().SendMessage(co.TBM_SETRANGEMAX, 1, win.LPARAM(int32(max)))
+	me.sendMessage(co.TBM_SETRANGEMIN, 1, win.LPARAM(int32(min)))
+	me.sendMessage(co.TBM_SETRANGEMAX, 1, win.LPARAM(int32(max)))
 	return me
 }
 
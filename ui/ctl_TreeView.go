@@ -129,7 +129,7 @@ func (me *TreeView) AddRoot(text string) TreeViewItem {
 //
 // [TVM_DELETEITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-deleteitem
 func (me *TreeView) DeleteAllItems() {
-	ret, err := me.Hwnd().SendMessage(co.TVM_DELETEITEM,
+	ret, err := me.sendMessage(co.TVM_DELETEITEM,
 		0, win.LPARAM(win.HTREEITEM(0)))
 	if ret == 0 || err != nil {
 		panic("TVM_DELETEITEM for all items failed.")
@@ -140,7 +140,7 @@ func (me *TreeView) DeleteAllItems() {
 //
 // [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
 func (me *TreeView) FirstVisibleItem() (TreeViewItem, bool) {
-	hVisible, _ := me.Hwnd().SendMessage(co.TVM_GETNEXTITEM,
+	hVisible, _ := me.sendMessage(co.TVM_GETNEXTITEM,
 		win.WPARAM(co.TVGN_FIRSTVISIBLE), win.LPARAM(win.HTREEITEM(0)))
 	if hVisible != 0 {
 		return TreeViewItem{me, win.HTREEITEM(hVisible)}, true
@@ -157,7 +157,7 @@ func (me *TreeView) Item(hItem win.HTREEITEM) TreeViewItem {
 //
 // [TVM_GETCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getcount
 func (me *TreeView) ItemCount() int {
-	c, _ := me.Hwnd().SendMessage(co.TVM_GETCOUNT, 0, 0)
+	c, _ := me.sendMessage(co.TVM_GETCOUNT, 0, 0)
 	return int(c)
 }
 
@@ -173,7 +173,7 @@ func (me *TreeView) Roots() []TreeViewItem {
 //
 // [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
 func (me *TreeView) SelectedItem() (TreeViewItem, bool) {
-	hItem, _ := me.Hwnd().SendMessage(co.TVM_GETNEXTITEM,
+	hItem, _ := me.sendMessage(co.TVM_GETNEXTITEM,
 		win.WPARAM(co.TVGN_CARET), win.LPARAM(win.HTREEITEM(0)))
 	if hItem != 0 {
 		return TreeViewItem{me, win.HTREEITEM(hItem)}, true
@@ -191,7 +191,7 @@ func (me *TreeView) SetExtendedStyle(doSet bool, style co.TVS_EX) *TreeView {
 	if doSet {
 		affected = style
 	}
-	me.hWnd.SendMessage(co.TVM_SETEXTENDEDSTYLE,
+	me.sendMessage(co.TVM_SETEXTENDEDSTYLE,
 		win.WPARAM(affected), win.LPARAM(style))
 	return me
 }
@@ -36,8 +36,8 @@ func (me TreeViewItem) AddChild(text string) TreeViewItem {
 	var wText wstr.BufEncoder
 	tvi.Itemex.SetPszText(wText.Slice(text))
 
-	hItemRet, err := me.owner.hWnd.SendMessage(co.TVM_INSERTITEM,
-		0, win.LPARAM(unsafe.Pointer(&tvi)))
+	hItemRet, err := me.owner.sendMessagePtr(co.TVM_INSERTITEM,
+		0, unsafe.Pointer(&tvi))
 	if hItemRet == 0 || err != nil {
 		panic(fmt.Sprintf("TVM_INSERTITEM \"%s\" failed.", text))
 	}
@@ -50,7 +50,7 @@ func (me TreeViewItem) AddChild(text string) TreeViewItem {
 //
 // [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
 func (me TreeViewItem) Children() []TreeViewItem {
-	hItem, _ := me.owner.hWnd.SendMessage(co.TVM_GETNEXTITEM,
+	hItem, _ := me.owner.sendMessage(co.TVM_GETNEXTITEM,
 		win.WPARAM(co.TVGN_CHILD), win.LPARAM(me.hItem)) // retrieve first child
 	hasSibling := hItem != 0 // has first child?
 
@@ -59,7 +59,7 @@ func (me TreeViewItem) Children() []TreeViewItem {
 	for hasSibling {
 		items = append(items, me.owner.Item(win.HTREEITEM(hItem)))
 
-		hItem, _ = me.owner.hWnd.SendMessage(co.TVM_GETNEXTITEM,
+		hItem, _ = me.owner.sendMessage(co.TVM_GETNEXTITEM,
 			win.WPARAM(co.TVGN_NEXT), win.LPARAM(hItem)) // retrieve next siblings
 		hasSibling = hItem != 0
 	}
@@ -95,7 +95,7 @@ func (me TreeViewItem) Data() interface{} {
 //
 // [TVM_DELETEITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-deleteitem
 func (me TreeViewItem) Delete() {
-	ret, _ := me.owner.hWnd.SendMessage(co.TVM_DELETEITEM, 0, win.LPARAM(me.hItem))
+	ret, _ := me.owner.sendMessage(co.TVM_DELETEITEM, 0, win.LPARAM(me.hItem))
 	if ret == 0 {
 		panic("TVM_DELETEITEM failed.")
 	}
@@ -108,7 +108,7 @@ func (me TreeViewItem) Delete() {
 //
 // [TVM_ENSUREVISIBLE]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-ensurevisible
 func (me TreeViewItem) EnsureVisible() TreeViewItem {
-	me.owner.hWnd.SendMessage(co.TVM_ENSUREVISIBLE, 0, win.LPARAM(me.hItem))
+	me.owner.sendMessage(co.TVM_ENSUREVISIBLE, 0, win.LPARAM(me.hItem))
 	return me
 }
 
@@ -122,7 +122,7 @@ func (me TreeViewItem) Expand(doExpand bool) TreeViewItem {
 	if doExpand {
 		flag = co.TVE_EXPAND
 	}
-	me.owner.hWnd.SendMessage(co.TVM_EXPAND, win.WPARAM(flag), win.LPARAM(me.hItem))
+	me.owner.sendMessage(co.TVM_EXPAND, win.WPARAM(flag), win.LPARAM(me.hItem))
 	return me
 }
 
@@ -142,8 +142,8 @@ func (me TreeViewItem) Icon() (Ico, bool) {
 		Mask:  co.TVIF_IMAGE,
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.TVM_GETITEM,
-		0, win.LPARAM(unsafe.Pointer(&tvi)))
+	ret, err := me.owner.sendMessagePtr(co.TVM_GETITEM,
+		0, unsafe.Pointer(&tvi))
 	if ret == 0 || err != nil {
 		panic("TVM_GETITEM failed.")
 	}
@@ -155,7 +155,7 @@ func (me TreeViewItem) Icon() (Ico, bool) {
 //
 // [TVM_GETITEMSTATE]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getitemstate
 func (me TreeViewItem) IsExpanded() bool {
-	tvis, _ := me.owner.hWnd.SendMessage(co.TVM_GETITEMSTATE,
+	tvis, _ := me.owner.sendMessage(co.TVM_GETITEMSTATE,
 		win.WPARAM(me.hItem), win.LPARAM(co.TVIS_EXPANDED))
 	return (co.TVIS(tvis) & co.TVIS_EXPANDED) != 0
 }
@@ -170,7 +170,7 @@ func (me TreeViewItem) IsRoot() bool {
 //
 // [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
 func (me TreeViewItem) NextSibling() (TreeViewItem, bool) {
-	hSibling, _ := me.owner.hWnd.SendMessage(co.TVM_GETNEXTITEM,
+	hSibling, _ := me.owner.sendMessage(co.TVM_GETNEXTITEM,
 		win.WPARAM(co.TVGN_NEXT), win.LPARAM(me.hItem))
 	if hSibling != 0 {
 		return TreeViewItem{me.owner, win.HTREEITEM(hSibling)}, true
@@ -182,7 +182,7 @@ func (me TreeViewItem) NextSibling() (TreeViewItem, bool) {
 //
 // [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
 func (me TreeViewItem) Parent() (TreeViewItem, bool) {
-	hParent, _ := me.owner.hWnd.SendMessage(co.TVM_GETNEXTITEM,
+	hParent, _ := me.owner.sendMessage(co.TVM_GETNEXTITEM,
 		win.WPARAM(co.TVGN_PARENT), win.LPARAM(me.hItem))
 	if hParent != 0 {
 		return TreeViewItem{me.owner, win.HTREEITEM(hParent)}, true
@@ -194,7 +194,7 @@ func (me TreeViewItem) Parent() (TreeViewItem, bool) {
 //
 // [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
 func (me TreeViewItem) PrevSibling() (TreeViewItem, bool) {
-	hSibling, _ := me.owner.hWnd.SendMessage(co.TVM_GETNEXTITEM,
+	hSibling, _ := me.owner.sendMessage(co.TVM_GETNEXTITEM,
 		win.WPARAM(co.TVGN_PREVIOUS), win.LPARAM(me.hItem))
 	if hSibling != 0 {
 		return TreeViewItem{me.owner, win.HTREEITEM(hSibling)}, true
@@ -236,7 +236,7 @@ func (me TreeViewItem) SetData(data interface{}) {
 func (me TreeViewItem) SetIcon(icon Ico) TreeViewItem {
 	hImgList, newImgList, idxIcon := me.owner.iconCache16.IconIndex(16, icon)
 	if newImgList { // image list has just been created
-		me.owner.Hwnd().SendMessage(co.TVM_SETIMAGELIST,
+		me.owner.sendMessage(co.TVM_SETIMAGELIST,
 			win.WPARAM(co.TVSIL_NORMAL), win.LPARAM(hImgList))
 	}
 
@@ -246,8 +246,8 @@ func (me TreeViewItem) SetIcon(icon Ico) TreeViewItem {
 		IImage: int32(idxIcon),
 	}
 
-	ret, err := me.owner.hWnd.SendMessage(co.TVM_SETITEM,
-		0, win.LPARAM(unsafe.Pointer(&tvi)))
+	ret, err := me.owner.sendMessagePtr(co.TVM_SETITEM,
+		0, unsafe.Pointer(&tvi))
 	if ret == 0 || err != nil {
 		panic("TVM_SETITEM failed.")
 	}
@@ -271,8 +271,8 @@ func (me TreeViewItem) SetText(text string) TreeViewItem {
 	var wText wstr.BufEncoder
 	tvi.SetPszText(wText.Slice(text))
 
-	ret, err := me.owner.hWnd.SendMessage(co.TVM_SETITEM,
-		0, win.LPARAM(unsafe.Pointer(&tvi)))
+	ret, err := me.owner.sendMessagePtr(co.TVM_SETITEM,
+		0, unsafe.Pointer(&tvi))
 	if ret == 0 || err != nil {
 		panic(fmt.Sprintf("TVM_SETITEM failed \"%s\".", text))
 	}
@@ -294,8 +294,8 @@ func (me TreeViewItem) Text() string {
 	wBuf.Alloc(wstr.BUF_MAX)
 	tvi.SetPszText(wBuf.HotSlice())
 
-	ret, err := me.owner.hWnd.SendMessage(co.TVM_GETITEM,
-		0, win.LPARAM(unsafe.Pointer(&tvi)))
+	ret, err := me.owner.sendMessagePtr(co.TVM_GETITEM,
+		0, unsafe.Pointer(&tvi))
 	if ret == 0 || err != nil {
 		panic("TVM_GETITEM failed.")
 	}
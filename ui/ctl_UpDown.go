@@ -96,7 +96,7 @@ func (me *UpDown) On() *UpDownEvents {
 //
 // [UDM_GETBASE]: https://learn.microsoft.com/en-us/windows/win32/controls/udm-getbase
 func (me *UpDown) Radix() int {
-	ret, _ := me.hWnd.SendMessage(co.UDM_GETBASE, 0, 0)
+	ret, _ := me.sendMessage(co.UDM_GETBASE, 0, 0)
 	return int(ret)
 }
 
@@ -105,8 +105,8 @@ func (me *UpDown) Radix() int {
 // [UDM_GETRANGE32]: https://learn.microsoft.com/en-us/windows/win32/controls/udm-getrange32
 func (me *UpDown) Range() (int, int) {
 	var min, max int32
-	me.hWnd.SendMessage(co.UDM_GETRANGE32,
-		win.WPARAM(unsafe.Pointer(&min)), win.LPARAM(unsafe.Pointer(&max)))
+	me.sendMessagePtr(co.UDM_GETRANGE32,
+		win.WPARAM(unsafe.Pointer(&min)), unsafe.Pointer(&max))
 	return int(min), int(max)
 }
 
@@ -116,7 +116,7 @@ func (me *UpDown) Range() (int, int) {
 //
 // [UDM_SETBASE]: https://learn.microsoft.com/en-us/windows/win32/controls/udm-setbase
 func (me *UpDown) SetRadix(radix int) *UpDown {
-	me.hWnd.SendMessage(co.UDM_SETBASE, win.WPARAM(uint32(radix)), 0)
+	me.sendMessage(co.UDM_SETBASE, win.WPARAM(uint32(radix)), 0)
 	return me
 }
 
@@ -126,7 +126,7 @@ func (me *UpDown) SetRadix(radix int) *UpDown {
 //
 // [UDM_SETRANGE32]: https://learn.microsoft.com/en-us/windows/win32/controls/udm-setrange32
 func (me *UpDown) SetRange(min, max int) *UpDown {
-	me.hWnd.SendMessage(co.UDM_SETRANGE32,
+	me.sendMessage(co.UDM_SETRANGE32,
 		win.WPARAM(int32(min)), win.LPARAM(int32(max)))
 	return me
 }
@@ -137,7 +137,7 @@ func (me *UpDown) SetRange(min, max int) *UpDown {
 //
 // [UDM_SETPOS32]: https://learn.microsoft.com/en-us/windows/win32/controls/udm-setpos32
 func (me *UpDown) SetValue(val int) *UpDown {
-	me.hWnd.SendMessage(co.UDM_SETPOS32, 0, win.LPARAM(int32(val)))
+	me.sendMessage(co.UDM_SETPOS32, 0, win.LPARAM(int32(val)))
 	return me
 }
 
@@ -146,8 +146,8 @@ func (me *UpDown) SetValue(val int) *UpDown {
 // [UDM_GETPOS32]: https://learn.microsoft.com/en-us/windows/win32/controls/udm-getpos32
 func (me *UpDown) Value() (int, bool) {
 	var valid win.BOOL
-	ret, err := me.hWnd.SendMessage(co.UDM_GETPOS32,
-		0, win.LPARAM(unsafe.Pointer(&valid)))
+	ret, err := me.sendMessagePtr(co.UDM_GETPOS32,
+		0, unsafe.Pointer(&valid))
 	if !valid.Ok() || err != nil {
 		return 0, false
 	}
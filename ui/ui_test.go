@@ -0,0 +1,280 @@
+//go:build windows
+
+package ui_test
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/ui"
+	"github.com/rodrigocfd/windigo/win"
+)
+
+func TestFakeBackendEdit(t *testing.T) {
+	fake := ui.NewFakeBackend()
+	defer ui.SetBackend(ui.SetBackend(fake))
+
+	wnd := ui.NewMain(ui.OptsMain())
+	txt := ui.NewEdit(wnd, ui.OptsEdit().Text("initial"))
+
+	var changes []string
+	txt.On().EnChange(func() {
+		changes = append(changes, txt.Text())
+	})
+
+	fake.Create(wnd)
+	if got := txt.Text(); got != "initial" {
+		t.Errorf("Text after creation: %q", got)
+	}
+
+	txt.SetText("from code")
+	if got := fake.Text(txt); got != "from code" {
+		t.Errorf("Text after SetText: %q", got)
+	}
+
+	fake.Type(txt, "typed ção")
+	if !reflect.DeepEqual(changes, []string{"typed ção"}) {
+		t.Errorf("EN_CHANGE handler got %q", changes)
+	}
+
+	txt.ShowBalloonTip("Title", "Some text", co.TTI_INFO)
+	if title, text, shown := fake.Balloon(txt); !shown || title != "Title" || text != "Some text" {
+		t.Errorf("Balloon: %q, %q, %v", title, text, shown)
+	}
+	txt.HideBalloonTip()
+	if _, _, shown := fake.Balloon(txt); shown {
+		t.Errorf("Balloon still shown")
+	}
+
+	fake.Destroy(wnd)
+	if wnd.Hwnd() != 0 {
+		t.Errorf("Window still has a handle after Destroy")
+	}
+}
+
+func TestFakeBackendButton(t *testing.T) {
+	fake := ui.NewFakeBackend()
+	defer ui.SetBackend(ui.SetBackend(fake))
+
+	wnd := ui.NewMain(ui.OptsMain())
+	btn := ui.NewButton(wnd, ui.OptsButton().Text("&Go"))
+	chk := ui.NewCheckBox(wnd, ui.OptsCheckBox().Text("Check"))
+
+	clicks := 0
+	btn.On().BnClicked(func() { clicks++ })
+	chkClicks := 0
+	chk.On().BnClicked(func() { chkClicks++ })
+
+	fake.Create(wnd)
+	if got := btn.Text(); got != "&Go" {
+		t.Errorf("Button text: %q", got)
+	}
+
+	fake.Click(btn)
+	btn.TriggerClick()
+	if clicks != 2 {
+		t.Errorf("BN_CLICKED fired %d times, expected 2", clicks)
+	}
+
+	fake.Click(chk)
+	if !chk.IsChecked() || fake.Check(chk) != co.BST_CHECKED {
+		t.Errorf("CheckBox not checked after click")
+	}
+	fake.Click(chk)
+	if chk.IsChecked() {
+		t.Errorf("CheckBox still checked after 2nd click")
+	}
+	chk.SetCheck(true) // doesn't fire BN_CLICKED
+	if fake.Check(chk) != co.BST_CHECKED || chkClicks != 2 {
+		t.Errorf("SetCheck: state %d, %d clicks", fake.Check(chk), chkClicks)
+	}
+}
+
+func TestFakeBackendListView(t *testing.T) {
+	fake := ui.NewFakeBackend()
+	defer ui.SetBackend(ui.SetBackend(fake))
+
+	wnd := ui.NewMain(ui.OptsMain())
+	lv := ui.NewListView(wnd,
+		ui.OptsListView().
+			Column("Name", 100).
+			Column("Age", 50),
+	)
+
+	var changed []int
+	lv.On().LvnItemChanged(func(p *win.NMLISTVIEW) {
+		changed = append(changed, int(p.IItem))
+	})
+
+	fake.Create(wnd)
+	lv.AddItem("Mary", "30")
+	lv.AddItem("John", "25")
+	lv.AddItem("Ann", "41")
+
+	if got := fake.Cols(lv); !reflect.DeepEqual(got, []string{"Name", "Age"}) {
+		t.Errorf("Cols: %q", got)
+	}
+	if got := fake.Items(lv); !reflect.DeepEqual(got, []string{"Mary", "John", "Ann"}) {
+		t.Errorf("Items: %q", got)
+	}
+	if got := lv.Item(1).Text(1); got != "25" {
+		t.Errorf("Item(1).Text(1): %q", got)
+	}
+
+	if item, ok := lv.FindItem("ann"); !ok || item.Index() != 2 {
+		t.Errorf("FindItem: %d, %v", item.Index(), ok)
+	}
+	if _, ok := lv.FindItem("Bob"); ok {
+		t.Errorf("FindItem found an inexistent item")
+	}
+
+	fake.Select(lv, 0, 2)
+	if !reflect.DeepEqual(changed, []int{0, 2}) {
+		t.Errorf("LVN_ITEMCHANGED fired for %v", changed)
+	}
+	if got := lv.SelectedItemCount(); got != 2 {
+		t.Errorf("SelectedItemCount: %d", got)
+	}
+
+	lv.SelectAllItems(true)
+	if got := fake.Selection(lv); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("Selection after SelectAllItems: %v", got)
+	}
+
+	lv.SortItems(func(a, b ui.ListViewItem) int {
+		return strings.Compare(a.Text(0), b.Text(0))
+	})
+	if got := fake.Items(lv); !reflect.DeepEqual(got, []string{"Ann", "John", "Mary"}) {
+		t.Errorf("Items after SortItems: %q", got)
+	}
+
+	lv.Item(0).Delete()
+	if got := lv.ItemCount(); got != 2 {
+		t.Errorf("ItemCount after Delete: %d", got)
+	}
+}
+
+// Virtual list data source, which implements ListViewDataSorter.
+type _People struct {
+	rows    [][2]string
+	sortCol int
+	sortAsc bool
+}
+
+func (me *_People) RowCount() int { return len(me.rows) }
+
+func (me *_People) CellText(row, col int) string { return me.rows[row][col] }
+
+func (me *_People) Sort(col int, ascending bool) {
+	me.sortCol, me.sortAsc = col, ascending
+	sort.SliceStable(me.rows, func(a, b int) bool {
+		if ascending {
+			return me.rows[a][col] < me.rows[b][col]
+		}
+		return me.rows[a][col] > me.rows[b][col]
+	})
+}
+
+func newVirtualListView(fake *ui.FakeBackend) (*ui.ListView, *_People) {
+	people := &_People{
+		rows: [][2]string{{"Mary", "30"}, {"John", "25"}, {"Ann", "41"}, {"Johnny", "19"}},
+	}
+	wnd := ui.NewMain(ui.OptsMain())
+	lv := ui.NewListView(wnd,
+		ui.OptsListView().
+			Column("Name", 100).
+			Column("Age", 50).
+			Data(people),
+	)
+	fake.Create(wnd)
+	return lv, people
+}
+
+func TestFakeBackendListViewDataDispInfo(t *testing.T) {
+	fake := ui.NewFakeBackend()
+	defer ui.SetBackend(ui.SetBackend(fake))
+	lv, people := newVirtualListView(fake)
+
+	if got := lv.ItemCount(); got != 4 {
+		t.Errorf("ItemCount: %d", got)
+	}
+	if got := fake.Items(lv); !reflect.DeepEqual(got, []string{"Mary", "John", "Ann", "Johnny"}) {
+		t.Errorf("Items: %q", got)
+	}
+	if got := fake.SubItems(lv, 2); !reflect.DeepEqual(got, []string{"Ann", "41"}) {
+		t.Errorf("SubItems(2): %q", got)
+	}
+	if got := lv.Item(3).Text(1); got != "19" {
+		t.Errorf("Item(3).Text(1): %q", got)
+	}
+
+	people.rows = append(people.rows, [2]string{"Paul", "52"})
+	lv.RefreshData()
+	if got := fake.SubItems(lv, 4); !reflect.DeepEqual(got, []string{"Paul", "52"}) {
+		t.Errorf("SubItems(4) after RefreshData: %q", got)
+	}
+}
+
+func TestFakeBackendListViewDataFind(t *testing.T) {
+	fake := ui.NewFakeBackend()
+	defer ui.SetBackend(ui.SetBackend(fake))
+	lv, _ := newVirtualListView(fake)
+
+	tests := []struct {
+		text  string
+		found bool
+		index int
+	}{
+		{"ann", true, 2},
+		{"JOHN", true, 1},
+		{"Johnny", true, 3},
+		{"Jo", false, 0},
+		{"Bob", false, 0},
+	}
+
+	for _, test := range tests {
+		item, ok := lv.FindItem(test.text)
+		if ok != test.found || (ok && item.Index() != test.index) {
+			t.Errorf("FindItem(%q): %d, %v; expected %d, %v",
+				test.text, item.Index(), ok, test.index, test.found)
+		}
+	}
+}
+
+func TestFakeBackendListViewDataSort(t *testing.T) {
+	fake := ui.NewFakeBackend()
+	defer ui.SetBackend(ui.SetBackend(fake))
+	lv, people := newVirtualListView(fake)
+
+	fake.Select(lv, 0)
+
+	tests := []struct {
+		col      int
+		expected []string
+		asc      bool
+	}{
+		{0, []string{"Ann", "John", "Johnny", "Mary"}, true},
+		{0, []string{"Mary", "Johnny", "John", "Ann"}, false}, // 2nd click reverses
+		{1, []string{"Johnny", "John", "Mary", "Ann"}, true},
+		{0, []string{"Ann", "John", "Johnny", "Mary"}, true},
+	}
+
+	for _, test := range tests {
+		nm := win.NMLISTVIEW{ISubItem: int32(test.col)}
+		fake.Notify(lv, co.LVN_COLUMNCLICK, unsafe.Pointer(&nm))
+
+		if people.sortCol != test.col || people.sortAsc != test.asc {
+			t.Errorf("Click col %d: sorted col %d, asc %v", test.col, people.sortCol, people.sortAsc)
+		}
+		if got := fake.Items(lv); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("Click col %d: items %q", test.col, got)
+		}
+		if got := fake.Selection(lv); len(got) != 0 {
+			t.Errorf("Click col %d: selection not cleared: %v", test.col, got)
+		}
+	}
+}
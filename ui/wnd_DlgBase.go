@@ -8,28 +8,41 @@ import (
 
 	"github.com/rodrigocfd/windigo/co"
 	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/res"
 	"github.com/rodrigocfd/windigo/win"
 )
 
 // Base to all dialog-based windows created with CreateDialogParam and
-// DialogBoxParam.
+// DialogBoxParam, or their Indirect counterparts, if an in-memory template is
+// given.
 type _DlgBase struct {
 	_BaseContainer
 	dlgId uint16
+	tmpl  *res.Dialog
 }
 
 // Constructor.
-func newBaseDlg(dlgId uint16) _DlgBase {
-	if dlgId == 0 {
-		panic("Dialog ID must be specified.")
+func newBaseDlg(dlgId uint16, tmpl *res.Dialog) _DlgBase {
+	if dlgId == 0 && tmpl == nil {
+		panic("Dialog ID or template must be specified.")
 	}
 
 	return _DlgBase{
 		_BaseContainer: newBaseContainer(_WNDTY_DLG),
 		dlgId:          dlgId,
+		tmpl:           tmpl,
 	}
 }
 
+// Serializes the in-memory template into a DWORD-aligned buffer, as required by
+// CreateDialogIndirectParam and DialogBoxIndirectParam.
+func (me *_DlgBase) serializeTemplate() []uint32 {
+	data := me.tmpl.Serialize()
+	buf := make([]uint32, (len(data)+3)/4)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&buf[0])), len(buf)*4), data)
+	return buf
+}
+
 func (me *_DlgBase) createDialogParam(hInst win.HINSTANCE, hParent win.HWND) {
 	if me.hWnd != 0 {
 		panic("Cannot create dialog twice.")
@@ -37,8 +50,15 @@ func (me *_DlgBase) createDialogParam(hInst win.HINSTANCE, hParent win.HWND) {
 	dlgProcCallback()
 
 	// The hWnd member is saved in WM_INITDIALOG processing in dlgProc.
-	_, err := hInst.CreateDialogParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
-		win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	var err error
+	if me.tmpl != nil {
+		buf := me.serializeTemplate()
+		_, err = hInst.CreateDialogIndirectParam((*win.DLGTEMPLATE)(unsafe.Pointer(&buf[0])),
+			hParent, dlgProcCallback(), win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		_, err = hInst.CreateDialogParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -50,8 +70,15 @@ func (me *_DlgBase) dialogBoxParam(hInst win.HINSTANCE, hParent win.HWND) {
 	}
 
 	// The hWnd member is saved in WM_INITDIALOG processing in dlgProc.
-	_, err := hInst.DialogBoxParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
-		win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	var err error
+	if me.tmpl != nil {
+		buf := me.serializeTemplate()
+		_, err = hInst.DialogBoxIndirectParam((*win.DLGTEMPLATE)(unsafe.Pointer(&buf[0])),
+			hParent, dlgProcCallback(), win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		_, err = hInst.DialogBoxParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -4,6 +4,7 @@ package ui
 
 import (
 	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/res"
 	"github.com/rodrigocfd/windigo/win"
 )
 
@@ -17,7 +18,7 @@ type _DlgControl struct {
 func newControlDlg(parent Parent, opts *VarOptsControlDlg) *_DlgControl {
 	setUniqueCtrlId(&opts.ctrlId)
 	me := &_DlgControl{
-		_DlgBase: newBaseDlg(opts.dlgId),
+		_DlgBase: newBaseDlg(opts.dlgId, opts.dlgTemplate),
 		ctrlId:   opts.ctrlId,
 	}
 
@@ -52,10 +53,11 @@ func (me *_DlgControl) defaultMessageHandlers(ownerTab *Tab) {
 
 // Options for [NewControlDlg]; returned by [OptsControlDlg].
 type VarOptsControlDlg struct {
-	dlgId    uint16
-	ctrlId   uint16
-	layout   LAY
-	position win.POINT
+	dlgId       uint16
+	dlgTemplate *res.Dialog
+	ctrlId      uint16
+	layout      LAY
+	position    win.POINT
 
 	ownerTab *Tab // if the Control is a Tab container
 }
@@ -67,11 +69,23 @@ func OptsControlDlg() *VarOptsControlDlg {
 
 // Dialog resource ID passed to [CreateDialogParam].
 //
-// Panics if not informed.
+// Panics if neither DlgId nor DlgTemplate is informed.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
 func (o *VarOptsControlDlg) DlgId(id uint16) *VarOptsControlDlg { o.dlgId = id; return o }
 
+// In-memory dialog template passed to [CreateDialogIndirectParam], used
+// instead of a dialog resource. It's serialized when the control is created.
+// The template should have the WS_CHILD style, and not WS_POPUP.
+//
+// Panics if neither DlgId nor DlgTemplate is informed.
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (o *VarOptsControlDlg) DlgTemplate(t *res.Dialog) *VarOptsControlDlg {
+	o.dlgTemplate = t
+	return o
+}
+
 // Control ID. Must be unique within a same parent window.
 //
 // Defaults to an auto-generated ID.
@@ -4,6 +4,7 @@ package ui
 
 import (
 	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/res"
 	"github.com/rodrigocfd/windigo/win"
 )
 
@@ -17,7 +18,7 @@ type _DlgMain struct {
 // Constructor.
 func newMainDlg(opts *VarOptsMainDlg) *_DlgMain {
 	me := &_DlgMain{
-		_DlgBase:     newBaseDlg(opts.dlgId),
+		_DlgBase:     newBaseDlg(opts.dlgId, opts.dlgTemplate),
 		iconId:       opts.iconId,
 		accelTableId: opts.accelTableId,
 	}
@@ -60,6 +61,7 @@ func (me *_DlgMain) defaultMessageHandlers() {
 // Options for [NewMainDlg]; returned by [OptsMainDlg].
 type VarOptsMainDlg struct {
 	dlgId        uint16
+	dlgTemplate  *res.Dialog
 	iconId       uint16
 	accelTableId uint16
 }
@@ -71,11 +73,19 @@ func OptsMainDlg() *VarOptsMainDlg {
 
 // Dialog resource ID passed to [CreateDialogParam].
 //
-// Panics if not informed.
+// Panics if neither DlgId nor DlgTemplate is informed.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
 func (o *VarOptsMainDlg) DlgId(id uint16) *VarOptsMainDlg { o.dlgId = id; return o }
 
+// In-memory dialog template passed to [CreateDialogIndirectParam], used
+// instead of a dialog resource. It's serialized when the window is created.
+//
+// Panics if neither DlgId nor DlgTemplate is informed.
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (o *VarOptsMainDlg) DlgTemplate(t *res.Dialog) *VarOptsMainDlg { o.dlgTemplate = t; return o }
+
 // Dialog icon ID passed to [WM_SETICON].
 //
 // Defaults to none.
@@ -4,6 +4,7 @@ package ui
 
 import (
 	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/res"
 	"github.com/rodrigocfd/windigo/win"
 )
 
@@ -13,9 +14,9 @@ type _DlgModal struct {
 	parent Parent
 }
 
-func newModalDlg(parent Parent, dlgId uint16) *_DlgModal {
+func newModalDlg(parent Parent, dlgId uint16, tmpl *res.Dialog) *_DlgModal {
 	me := &_DlgModal{
-		_DlgBase: newBaseDlg(dlgId),
+		_DlgBase: newBaseDlg(dlgId, tmpl),
 		parent:   parent,
 	}
 	me.defaultMessageHandlers()
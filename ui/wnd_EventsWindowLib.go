@@ -14,6 +14,11 @@ type (
 		id  co.WM
 		fun func(p Wm)
 	}
+	_StorageCmdLib struct { // WM_COMMAND
+		cmdId     uint16
+		notifCode co.CMD
+		fun       func()
+	}
 	_StorageNfyLib struct { // WM_NOTIFY
 		idFrom uint16
 		code   co.NM
@@ -25,6 +30,7 @@ type (
 type _WindowLibEvents struct {
 	inits []func()         // WM_CREATE and WM_INITDIALOG
 	msgs  []_StorageMsgLib // ordinary WM messages
+	cmds  []_StorageCmdLib // WM_COMMAND
 	nfys  []_StorageNfyLib // WM_NOTIFY
 }
 
@@ -33,6 +39,7 @@ func newEventsWindowLib() _WindowLibEvents {
 	return _WindowLibEvents{
 		inits: make([]func(), 0),
 		msgs:  make([]_StorageMsgLib, 0),
+		cmds:  make([]_StorageCmdLib, 0),
 		nfys:  make([]_StorageNfyLib, 0),
 	}
 }
@@ -47,6 +54,7 @@ func (me *_WindowLibEvents) removeWmCreateInitdialog() {
 func (me *_WindowLibEvents) clear() {
 	me.removeWmCreateInitdialog()
 	me.msgs = nil
+	me.cmds = nil
 	me.nfys = nil
 }
 
@@ -58,6 +66,15 @@ func (me *_WindowLibEvents) processAll(p Wm) (atLeastOne bool) {
 			fun()
 			atLeastOne = true
 		}
+	case co.WM_COMMAND:
+		cmdId := p.WParam.LoWord()
+		notifCode := co.CMD(p.WParam.HiWord())
+		for _, obj := range me.cmds {
+			if obj.cmdId == cmdId && obj.notifCode == notifCode {
+				obj.fun()
+				atLeastOne = true
+			}
+		}
 	case co.WM_NOTIFY:
 		pHdr := unsafe.Pointer(p.LParam)
 		hdr := (*win.NMHDR)(pHdr)
@@ -86,6 +103,10 @@ func (me *_WindowLibEvents) wm(id co.WM, fun func(p Wm)) {
 	me.msgs = append(me.msgs, _StorageMsgLib{id, fun})
 }
 
+func (me *_WindowLibEvents) wmCommand(cmdId uint16, notifCode co.CMD, fun func()) {
+	me.cmds = append(me.cmds, _StorageCmdLib{cmdId, notifCode, fun})
+}
+
 func (me *_WindowLibEvents) wmNotify(idFrom uint16, code co.NM, fun func(p unsafe.Pointer)) {
 	me.nfys = append(me.nfys, _StorageNfyLib{idFrom, code, fun})
 }
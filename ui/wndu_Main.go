@@ -61,6 +61,16 @@ func NewMain(opts *VarOptsMain) *Main {
 //	)
 //	wnd.RunAsMain()
 //
+// The dialog can also be created from an in-memory template, without a dialog
+// resource:
+//
+//	dlg := res.NewDialog("Hello world", 300, 200)
+//	wnd := ui.NewMainDlg(
+//		ui.OptsMainDlg().
+//			DlgTemplate(dlg),
+//	)
+//	wnd.RunAsMain()
+//
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
 func NewMainDlg(opts *VarOptsMainDlg) *Main {
 	return &Main{
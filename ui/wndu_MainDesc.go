@@ -0,0 +1,252 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/uidesc"
+)
+
+// Creates a new main window, and its child controls, from a declarative
+// description, which is validated first. Returns the controls indexed by their
+// names.
+//
+// Positions and sizes of the description are scaled to the current DPI.
+//
+// Example:
+//
+//	runtime.LockOSThread()
+//
+//	data, _ := os.ReadFile("main.json")
+//	desc, _ := uidesc.Parse(data)
+//
+//	wnd, ctrls, _ := ui.NewMainDesc(desc)
+//	ctrls.Button("go").On().BnClicked(func() {
+//		ctrls.ListView("list").DeleteAllItems()
+//	})
+//	wnd.RunAsMain()
+func NewMainDesc(desc *uidesc.Window) (*Main, DescCtrls, error) {
+	if err := desc.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("NewMainDesc: %w", err)
+	}
+
+	opts := OptsMain().
+		Title(desc.Title).
+		Center(desc.Center).
+		Position(DpiX(desc.X), DpiY(desc.Y))
+	if desc.Width != 0 {
+		opts.size.Cx = int32(DpiX(desc.Width))
+	}
+	if desc.Height != 0 {
+		opts.size.Cy = int32(DpiY(desc.Height))
+	}
+	if s, ok, _ := desc.Style.Value(); ok {
+		opts.Style(co.WS(s))
+	}
+	if s, ok, _ := desc.ExStyle.Value(); ok {
+		opts.ExStyle(co.WS_EX(s))
+	}
+
+	wnd := NewMain(opts)
+	ctrls := make(DescCtrls, len(desc.Controls))
+	for i := range desc.Controls {
+		ctrl := newDescCtrl(wnd, &desc.Controls[i])
+		if name := desc.Controls[i].Name; name != "" {
+			ctrls[name] = ctrl
+		}
+	}
+	return wnd, ctrls, nil
+}
+
+// Creates a control from its description, which must be already validated.
+func newDescCtrl(parent Parent, desc *uidesc.Control) ChildControl {
+	lay, _ := desc.Layout.Value()
+	layout := LAY(lay)
+	x, y := Dpi(desc.X, desc.Y)
+	ctrlStyle, hasCtrlStyle, _ := desc.CtrlStyle.Value()
+	ctrlExStyle, hasCtrlExStyle, _ := desc.CtrlExStyle.Value()
+	wndStyle, hasWndStyle, _ := desc.WndStyle.Value()
+	wndExStyle, hasWndExStyle, _ := desc.WndExStyle.Value()
+
+	// Sets the styles which are present, and the size, keeping the defaults.
+	apply := func(pCtrlStyle *uint32, pWndStyle *co.WS, pWndExStyle *co.WS_EX, pCx, pCy *int32) {
+		if hasCtrlStyle && pCtrlStyle != nil {
+			*pCtrlStyle = ctrlStyle
+		}
+		if hasWndStyle {
+			*pWndStyle = co.WS(wndStyle)
+		}
+		if hasWndExStyle {
+			*pWndExStyle = co.WS_EX(wndExStyle)
+		}
+		if desc.Width != 0 && pCx != nil {
+			*pCx = int32(DpiX(desc.Width))
+		}
+		if desc.Height != 0 && pCy != nil {
+			*pCy = int32(DpiY(desc.Height))
+		}
+	}
+
+	switch desc.Type {
+	case "Button":
+		o := OptsButton().CtrlId(desc.CtrlId).Layout(layout).Text(desc.Text).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		return NewButton(parent, o)
+
+	case "CheckBox":
+		o := OptsCheckBox().CtrlId(desc.CtrlId).Layout(layout).Text(desc.Text).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		if desc.Checked {
+			o.State(co.BST_CHECKED)
+		}
+		return NewCheckBox(parent, o)
+
+	case "ComboBox":
+		o := OptsComboBox().CtrlId(desc.CtrlId).Layout(layout).Position(x, y).Texts(desc.Items...)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, nil, nil)
+		if desc.Width != 0 {
+			o.Width(DpiX(desc.Width))
+		}
+		return NewComboBox(parent, o)
+
+	case "DateTimePicker":
+		o := OptsDateTimePicker().CtrlId(desc.CtrlId).Layout(layout).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		return NewDateTimePicker(parent, o)
+
+	case "Edit":
+		o := OptsEdit().CtrlId(desc.CtrlId).Layout(layout).Text(desc.Text).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		return NewEdit(parent, o)
+
+	case "ListView":
+		o := OptsListView().CtrlId(desc.CtrlId).Layout(layout).Position(x, y)
+		apply(nil, &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		if hasCtrlStyle {
+			o.CtrlStyle(co.LVS(ctrlStyle)) // keeps LVS_SHAREIMAGELISTS
+		}
+		if hasCtrlExStyle {
+			o.CtrlExStyle(co.LVS_EX(ctrlExStyle))
+		}
+		for _, col := range desc.Columns {
+			o.Column(col.Title, DpiX(col.Width))
+		}
+		return NewListView(parent, o)
+
+	case "MonthCalendar":
+		o := OptsMonthCalendar().CtrlId(desc.CtrlId).Layout(layout).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, nil, nil)
+		return NewMonthCalendar(parent, o)
+
+	case "ProgressBar":
+		o := OptsProgressBar().CtrlId(desc.CtrlId).Layout(layout).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		return NewProgressBar(parent, o)
+
+	case "Static":
+		o := OptsStatic().CtrlId(desc.CtrlId).Layout(layout).Text(desc.Text).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		return NewStatic(parent, o)
+
+	case "SysLink":
+		o := OptsSysLink().CtrlId(desc.CtrlId).Layout(layout).Text(desc.Text).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		return NewSysLink(parent, o)
+
+	case "Trackbar":
+		o := OptsTrackbar().CtrlId(desc.CtrlId).Layout(layout).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		return NewTrackbar(parent, o)
+
+	case "TreeView":
+		o := OptsTreeView().CtrlId(desc.CtrlId).Layout(layout).Position(x, y)
+		apply((*uint32)(&o.ctrlStyle), &o.wndStyle, &o.wndExStyle, &o.size.Cx, &o.size.Cy)
+		if hasCtrlExStyle {
+			o.CtrlExStyle(co.TVS_EX(ctrlExStyle))
+		}
+		return NewTreeView(parent, o)
+
+	default:
+		panic(fmt.Sprintf("Unknown control type: %s.", desc.Type)) // already validated
+	}
+}
+
+// Controls created by [NewMainDesc], indexed by their names.
+//
+// The typed getters panic if the name doesn't exist, or if the control has
+// another type.
+type DescCtrls map[string]ChildControl
+
+// Returns the [Button] with the given name.
+func (me DescCtrls) Button(name string) *Button {
+	return descGet[*Button](me, name)
+}
+
+// Returns the [CheckBox] with the given name.
+func (me DescCtrls) CheckBox(name string) *CheckBox {
+	return descGet[*CheckBox](me, name)
+}
+
+// Returns the [ComboBox] with the given name.
+func (me DescCtrls) ComboBox(name string) *ComboBox {
+	return descGet[*ComboBox](me, name)
+}
+
+// Returns the [DateTimePicker] with the given name.
+func (me DescCtrls) DateTimePicker(name string) *DateTimePicker {
+	return descGet[*DateTimePicker](me, name)
+}
+
+// Returns the [Edit] with the given name.
+func (me DescCtrls) Edit(name string) *Edit {
+	return descGet[*Edit](me, name)
+}
+
+// Returns the [ListView] with the given name.
+func (me DescCtrls) ListView(name string) *ListView {
+	return descGet[*ListView](me, name)
+}
+
+// Returns the [MonthCalendar] with the given name.
+func (me DescCtrls) MonthCalendar(name string) *MonthCalendar {
+	return descGet[*MonthCalendar](me, name)
+}
+
+// Returns the [ProgressBar] with the given name.
+func (me DescCtrls) ProgressBar(name string) *ProgressBar {
+	return descGet[*ProgressBar](me, name)
+}
+
+// Returns the [Static] with the given name.
+func (me DescCtrls) Static(name string) *Static {
+	return descGet[*Static](me, name)
+}
+
+// Returns the [SysLink] with the given name.
+func (me DescCtrls) SysLink(name string) *SysLink {
+	return descGet[*SysLink](me, name)
+}
+
+// Returns the [Trackbar] with the given name.
+func (me DescCtrls) Trackbar(name string) *Trackbar {
+	return descGet[*Trackbar](me, name)
+}
+
+// Returns the [TreeView] with the given name.
+func (me DescCtrls) TreeView(name string) *TreeView {
+	return descGet[*TreeView](me, name)
+}
+
+func descGet[T ChildControl](ctrls DescCtrls, name string) T {
+	ctrl, ok := ctrls[name]
+	if !ok {
+		panic(fmt.Sprintf("Control not found: %s.", name))
+	}
+	typed, ok := ctrl.(T)
+	if !ok {
+		panic(fmt.Sprintf("Control %s has type %T.", name, ctrl))
+	}
+	return typed
+}
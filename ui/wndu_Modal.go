@@ -3,6 +3,7 @@
 package ui
 
 import (
+	"github.com/rodrigocfd/windigo/res"
 	"github.com/rodrigocfd/windigo/win"
 )
 
@@ -52,7 +53,29 @@ func NewModal(parent Parent, opts *VarOptsModal) *Modal {
 func NewModalDlg(parent Parent, dlgId uint16) *Modal {
 	return &Modal{
 		raw: nil,
-		dlg: newModalDlg(parent, dlgId),
+		dlg: newModalDlg(parent, dlgId, nil),
+	}
+}
+
+// Creates a new dialog-based Modal with [DialogBoxIndirectParam], from an
+// in-memory dialog template, so no dialog resource is needed.
+//
+// Example:
+//
+//	var wndParent ui.Parent // initialized somewhere
+//
+//	dlg := res.NewDialog("Hello modal", 200, 100).
+//		AddControl("Button", "OK", uint32(co.ID_OK), 143, 79, 50, 14,
+//			uint32(co.BS_DEFPUSHBUTTON|co.WS_TABSTOP))
+//
+//	wndModal := ui.NewModalDlgTemplate(wndParent, dlg)
+//	wndModal.ShowModal()
+//
+// [DialogBoxIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-dialogboxindirectparamw
+func NewModalDlgTemplate(parent Parent, tmpl *res.Dialog) *Modal {
+	return &Modal{
+		raw: nil,
+		dlg: newModalDlg(parent, 0, tmpl),
 	}
 }
 
@@ -32,6 +32,16 @@
 //   - [TreeView]
 //   - [UpDown]
 //
+// Child controls can be repositioned automatically when the parent is resized,
+// either with the LAY anchors given at creation, or with a [Box] layout, which
+// arranges them in rows, columns and grids.
+//
+// Struct fields can be bound to controls with [NewBinder] and the Bind
+// functions, like [BindEdit], which use the uibind package.
+//
+// For automated tests, the native controls can run without real windows by
+// installing a [FakeBackend] with [SetBackend].
+//
 // The following interfaces are declared:
 //
 //   - [Window] – any window
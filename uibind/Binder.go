@@ -0,0 +1,241 @@
+package uibind
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A control seen by a [Binder], which holds a value of type V.
+type Widget[V any] interface {
+	// Returns the current value of the control.
+	Value() V
+	// Replaces the value of the control.
+	SetValue(val V)
+}
+
+// Optionally implemented by a [Widget] which can display a validation error,
+// like an Edit showing a balloon tip.
+type ErrorShower interface {
+	ShowError(err *FieldError)
+	HideError()
+}
+
+// Optionally implemented by a [Widget] which notifies when its value is
+// changed by the user. The [Binder] then keeps the field in sync, as long as
+// the new value is valid.
+type ChangeNotifier interface {
+	OnChange(fun func())
+}
+
+// Validation or conversion error of a bound field, returned by [Binder.Save]
+// and [Binder.Validate].
+type FieldError struct {
+	Name   string // Name given to the field when it was bound.
+	Widget any    // Widget the field is bound to.
+	Err    error  // The underlying error.
+}
+
+// Implements error.
+func (e *FieldError) Error() string {
+	return e.Name + ": " + e.Err.Error()
+}
+
+// Returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Keeps a set of struct fields bound to widgets.
+//
+// Example:
+//
+//	type Person struct {
+//		Name string
+//		Age  int
+//	}
+//
+//	var txtName, txtAge uibind.Widget[string] // initialized somewhere
+//
+//	person := Person{Name: "John", Age: 40}
+//
+//	b := uibind.NewBinder()
+//	uibind.Field(b, "Name", &person.Name, txtName, uibind.Required[string]())
+//	uibind.FieldConv(b, "Age", &person.Age, txtAge, uibind.IntText[int](),
+//		uibind.Range(0, 150))
+//
+//	b.Load()
+//	// ...
+//	if err := b.Save(); err == nil {
+//		// person now has the new values
+//	}
+type Binder struct {
+	fields  []*_Field
+	onError func(errs []*FieldError)
+}
+
+// A field bound to a widget, with its conversion and validation already
+// resolved.
+type _Field struct {
+	name   string
+	widget any
+	load   func()                            // field -> widget
+	check  func() (commit func(), err error) // widget -> converted and validated value
+}
+
+// Creates a new [Binder].
+func NewBinder() *Binder {
+	return &Binder{
+		fields: make([]*_Field, 0, 8), // arbitrary
+	}
+}
+
+// Replaces the function called by [Binder.Save] when validation fails.
+//
+// By default, the error is displayed by the first failed widget which
+// implements [ErrorShower].
+func (me *Binder) OnError(fun func(errs []*FieldError)) {
+	me.onError = fun
+}
+
+// Copies the values of all fields into their widgets, and hides any displayed
+// validation errors.
+func (me *Binder) Load() {
+	for _, f := range me.fields {
+		f.load()
+		hideError(f.widget)
+	}
+}
+
+// Converts and validates the values of all widgets, and copies them into their
+// fields. If any of them fail, no field is changed, the errors are displayed,
+// and returned joined; each one is a [*FieldError].
+func (me *Binder) Save() error {
+	commits, errs := me.checkAll()
+	for _, f := range me.fields {
+		hideError(f.widget)
+	}
+
+	if len(errs) > 0 {
+		if me.onError != nil {
+			me.onError(errs)
+		} else {
+			showFirstError(errs)
+		}
+		return joinFieldErrors(errs)
+	}
+
+	for _, commit := range commits {
+		commit()
+	}
+	return nil
+}
+
+// Converts and validates the values of all widgets, without changing any field
+// or displaying the errors. Returns the errors joined; each one is a
+// [*FieldError].
+func (me *Binder) Validate() error {
+	_, errs := me.checkAll()
+	return joinFieldErrors(errs)
+}
+
+func (me *Binder) checkAll() ([]func(), []*FieldError) {
+	commits := make([]func(), 0, len(me.fields))
+	var errs []*FieldError
+	for _, f := range me.fields {
+		if commit, err := f.check(); err != nil {
+			errs = append(errs, &FieldError{Name: f.name, Widget: f.widget, Err: err})
+		} else {
+			commits = append(commits, commit)
+		}
+	}
+	return commits, errs
+}
+
+func (me *Binder) add(f *_Field) {
+	for _, other := range me.fields {
+		if other.name == f.name {
+			panic(fmt.Sprintf("Field already bound: %s.", f.name))
+		}
+	}
+	me.fields = append(me.fields, f)
+
+	if notifier, ok := f.widget.(ChangeNotifier); ok {
+		notifier.OnChange(func() {
+			if commit, err := f.check(); err == nil { // invalid values are reported only by Save
+				commit()
+				hideError(f.widget)
+			}
+		})
+	}
+}
+
+// Binds a field to a widget which holds a value of the same type.
+//
+// Validators are run in the given order, stopping at the first error.
+//
+// Panics if the name was already bound.
+func Field[T any](b *Binder, name string, field *T, widget Widget[T], validators ...Validator[T]) {
+	FieldConv(b, name, field, widget, identity[T](), validators...)
+}
+
+// Binds a field to a widget which holds a value of another type, using a
+// converter.
+//
+// Validators are run in the given order, after the conversion, stopping at the
+// first error.
+//
+// Panics if the name was already bound.
+func FieldConv[F, V any](
+	b *Binder,
+	name string,
+	field *F,
+	widget Widget[V],
+	conv Converter[F, V],
+	validators ...Validator[F],
+) {
+	b.add(&_Field{
+		name:   name,
+		widget: widget,
+		load: func() {
+			widget.SetValue(conv.ToWidget(*field))
+		},
+		check: func() (func(), error) {
+			val, err := conv.FromWidget(widget.Value())
+			if err != nil {
+				return nil, err
+			}
+			for _, validator := range validators {
+				if err := validator(val); err != nil {
+					return nil, err
+				}
+			}
+			return func() { *field = val }, nil
+		},
+	})
+}
+
+func hideError(widget any) {
+	if shower, ok := widget.(ErrorShower); ok {
+		shower.HideError()
+	}
+}
+
+func showFirstError(errs []*FieldError) {
+	for _, err := range errs {
+		if shower, ok := err.Widget.(ErrorShower); ok {
+			shower.ShowError(err)
+			return
+		}
+	}
+}
+
+func joinFieldErrors(errs []*FieldError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	plain := make([]error, 0, len(errs))
+	for _, err := range errs {
+		plain = append(plain, err)
+	}
+	return errors.Join(plain...)
+}
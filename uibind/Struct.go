@@ -0,0 +1,291 @@
+package uibind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Binds the fields of a struct which have a "bind" tag to the widgets with the
+// same names, which must implement [Widget] of string, bool, int or time.Time.
+//
+// The tag has the widget name, optionally followed by rules:
+//   - required – the value cannot be zero, or a blank string;
+//   - min=N – minimum number, or minimum characters of a string;
+//   - max=N – maximum number, or maximum characters of a string.
+//
+// The supported combinations of field and widget types are:
+//   - string, numbers – Widget[string], numbers are formatted and parsed;
+//   - bool – Widget[bool];
+//   - integers – Widget[int], usually a selected index;
+//   - time.Time – Widget[time.Time].
+//
+// Returns all the problems found, joined: missing or unused widgets,
+// unsupported types and invalid rules. In this case, no field is bound.
+//
+// Example:
+//
+//	type Person struct {
+//		Name    string `bind:"name,required,max=40"`
+//		Age     int    `bind:"age,min=0,max=150"`
+//		Retired bool   `bind:"retired"`
+//	}
+//
+//	var txtName, txtAge uibind.Widget[string] // initialized somewhere
+//	var chkRetired uibind.Widget[bool]
+//
+//	var person Person
+//	b := uibind.NewBinder()
+//	err := uibind.Struct(b, &person, map[string]any{
+//		"name":    txtName,
+//		"age":     txtAge,
+//		"retired": chkRetired,
+//	})
+func Struct(b *Binder, pStruct any, widgets map[string]any) error {
+	rv := reflect.ValueOf(pStruct)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("Struct: expected pointer to struct, got %T", pStruct)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	var errs []error
+	var fields []*_Field
+	used := make(map[string]struct{}, len(widgets))
+
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag, ok := sf.Tag.Lookup("bind")
+		if !ok || tag == "-" {
+			continue
+		}
+
+		name, rules, _ := strings.Cut(tag, ",")
+		if !sf.IsExported() {
+			errs = append(errs, fmt.Errorf("field %s is not exported", sf.Name))
+			continue
+		}
+		widget, ok := widgets[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("field %s: no widget %q", sf.Name, name))
+			continue
+		}
+		used[name] = struct{}{}
+
+		f, err := newStructField(name, rv.Field(i), widget, rules)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", sf.Name, err))
+			continue
+		}
+		fields = append(fields, f)
+	}
+
+	unused := make([]string, 0, len(widgets))
+	for name := range widgets {
+		if _, ok := used[name]; !ok {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	for _, name := range unused {
+		errs = append(errs, fmt.Errorf("widget %q not bound to any field", name))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("Struct: %w", errors.Join(errs...))
+	}
+	for _, f := range fields {
+		b.add(f)
+	}
+	return nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Creates a field binding with conversions resolved from the field and widget
+// types.
+func newStructField(name string, fv reflect.Value, widget any, rules string) (*_Field, error) {
+	var toWidget func()
+	var fromWidget func() (reflect.Value, error)
+	kind := fv.Kind()
+	mismatch := fmt.Errorf("cannot bind %s to %T", fv.Type(), widget)
+
+	switch w := widget.(type) {
+	case Widget[string]:
+		switch {
+		case kind == reflect.String:
+			toWidget = func() { w.SetValue(fv.String()) }
+			fromWidget = func() (reflect.Value, error) {
+				return reflect.ValueOf(w.Value()).Convert(fv.Type()), nil
+			}
+		case isInt(kind):
+			toWidget = func() { w.SetValue(strconv.FormatInt(fv.Int(), 10)) }
+			fromWidget = func() (reflect.Value, error) {
+				n, err := parseNum(w.Value(), func(s string) (int64, error) {
+					return strconv.ParseInt(s, 10, fv.Type().Bits())
+				})
+				return reflect.ValueOf(n).Convert(fv.Type()), err
+			}
+		case isUint(kind):
+			toWidget = func() { w.SetValue(strconv.FormatUint(fv.Uint(), 10)) }
+			fromWidget = func() (reflect.Value, error) {
+				n, err := parseNum(w.Value(), func(s string) (uint64, error) {
+					return strconv.ParseUint(s, 10, fv.Type().Bits())
+				})
+				return reflect.ValueOf(n).Convert(fv.Type()), err
+			}
+		case kind == reflect.Float32 || kind == reflect.Float64:
+			toWidget = func() {
+				w.SetValue(strconv.FormatFloat(fv.Float(), 'g', -1, fv.Type().Bits()))
+			}
+			fromWidget = func() (reflect.Value, error) {
+				n, err := parseNum(w.Value(), func(s string) (float64, error) {
+					return strconv.ParseFloat(s, fv.Type().Bits())
+				})
+				return reflect.ValueOf(n).Convert(fv.Type()), err
+			}
+		default:
+			return nil, mismatch
+		}
+
+	case Widget[bool]:
+		if kind != reflect.Bool {
+			return nil, mismatch
+		}
+		toWidget = func() { w.SetValue(fv.Bool()) }
+		fromWidget = func() (reflect.Value, error) {
+			return reflect.ValueOf(w.Value()).Convert(fv.Type()), nil
+		}
+
+	case Widget[int]:
+		if !isInt(kind) {
+			return nil, mismatch
+		}
+		toWidget = func() { w.SetValue(int(fv.Int())) }
+		fromWidget = func() (reflect.Value, error) {
+			return reflect.ValueOf(w.Value()).Convert(fv.Type()), nil
+		}
+
+	case Widget[time.Time]:
+		if fv.Type() != timeType {
+			return nil, mismatch
+		}
+		toWidget = func() { w.SetValue(fv.Interface().(time.Time)) }
+		fromWidget = func() (reflect.Value, error) {
+			return reflect.ValueOf(w.Value()), nil
+		}
+
+	default:
+		return nil, fmt.Errorf("%T is not a supported widget", widget)
+	}
+
+	validators, err := parseRules(fv.Type(), rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &_Field{
+		name:   name,
+		widget: widget,
+		load:   toWidget,
+		check: func() (func(), error) {
+			val, err := fromWidget()
+			if err != nil {
+				return nil, err
+			}
+			for _, validator := range validators {
+				if err := validator(val); err != nil {
+					return nil, err
+				}
+			}
+			return func() { fv.Set(val) }, nil
+		},
+	}, nil
+}
+
+// Parses the rules of a "bind" tag, after the widget name.
+func parseRules(typ reflect.Type, rules string) ([]Validator[reflect.Value], error) {
+	var validators []Validator[reflect.Value]
+	if rules == "" {
+		return validators, nil
+	}
+
+	for _, rule := range strings.Split(rules, ",") {
+		key, arg, hasArg := strings.Cut(strings.TrimSpace(rule), "=")
+		switch key {
+		case "required":
+			if hasArg {
+				return nil, fmt.Errorf("rule %q takes no value", key)
+			}
+			validators = append(validators, func(val reflect.Value) error {
+				if val.Kind() == reflect.String && strings.TrimSpace(val.String()) == "" {
+					return ErrRequired
+				} else if val.IsZero() {
+					return ErrRequired
+				}
+				return nil
+			})
+
+		case "min", "max":
+			limit, err := strconv.ParseFloat(arg, 64)
+			if !hasArg || err != nil {
+				return nil, fmt.Errorf("rule %q needs a number", key)
+			}
+			isMin := key == "min"
+			measure, err := ruleMeasure(typ)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: %w", key, err)
+			}
+			validators = append(validators, func(val reflect.Value) error {
+				n, isText := measure(val)
+				switch {
+				case isMin && n < limit && isText:
+					return fmt.Errorf("must have at least %v characters", limit)
+				case isMin && n < limit:
+					return fmt.Errorf("must be at least %v", limit)
+				case !isMin && n > limit && isText:
+					return fmt.Errorf("must have at most %v characters", limit)
+				case !isMin && n > limit:
+					return fmt.Errorf("must be at most %v", limit)
+				}
+				return nil
+			})
+
+		default:
+			return nil, fmt.Errorf("unknown rule %q", key)
+		}
+	}
+	return validators, nil
+}
+
+// Returns the function which measures a value for the min and max rules: the
+// number itself, or the number of characters of a string.
+func ruleMeasure(typ reflect.Type) (func(val reflect.Value) (float64, bool), error) {
+	switch kind := typ.Kind(); {
+	case kind == reflect.String:
+		return func(val reflect.Value) (float64, bool) {
+			return float64(utf8.RuneCountInString(val.String())), true
+		}, nil
+	case isInt(kind):
+		return func(val reflect.Value) (float64, bool) { return float64(val.Int()), false }, nil
+	case isUint(kind):
+		return func(val reflect.Value) (float64, bool) { return float64(val.Uint()), false }, nil
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		return func(val reflect.Value) (float64, bool) { return val.Float(), false }, nil
+	default:
+		return nil, fmt.Errorf("not supported by %s", typ)
+	}
+}
+
+func isInt(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isUint(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uint64
+}
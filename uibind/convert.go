@@ -0,0 +1,192 @@
+package uibind
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// Converts values between a field, of type F, and a widget, of type V.
+type Converter[F, V any] struct {
+	ToWidget   func(val F) V
+	FromWidget func(val V) (F, error)
+}
+
+// Signed integer types.
+type _Int interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned integer types.
+type _Uint interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Floating point types.
+type _Float interface {
+	~float32 | ~float64
+}
+
+// All numeric types.
+type _Number interface {
+	_Int | _Uint | _Float
+}
+
+func identity[T any]() Converter[T, T] {
+	return Converter[T, T]{
+		ToWidget:   func(val T) T { return val },
+		FromWidget: func(val T) (T, error) { return val, nil },
+	}
+}
+
+// Returned when a text cannot be converted to a number.
+var ErrNotNumber = errors.New("must be a number")
+
+// Returned when a number doesn't fit the type of the field.
+var ErrOutOfRange = errors.New("is out of range")
+
+// Converts a signed integer field to a text widget. Surrounding spaces are
+// ignored, and an empty text is converted to zero.
+//
+// Example:
+//
+//	type Person struct{ Age int }
+//
+//	var b *uibind.Binder // initialized somewhere
+//	var txtAge uibind.Widget[string]
+//	var person Person
+//
+//	uibind.FieldConv(b, "Age", &person.Age, txtAge, uibind.IntText[int]())
+func IntText[T _Int]() Converter[T, string] {
+	var zero T
+	bits := int(8 * unsafe.Sizeof(zero))
+	return Converter[T, string]{
+		ToWidget: func(val T) string {
+			return strconv.FormatInt(int64(val), 10)
+		},
+		FromWidget: func(val string) (T, error) {
+			n, err := parseNum(val, func(s string) (int64, error) {
+				return strconv.ParseInt(s, 10, bits)
+			})
+			return T(n), err
+		},
+	}
+}
+
+// Converts an unsigned integer field to a text widget. Surrounding spaces are
+// ignored, and an empty text is converted to zero.
+func UintText[T _Uint]() Converter[T, string] {
+	var zero T
+	bits := int(8 * unsafe.Sizeof(zero))
+	return Converter[T, string]{
+		ToWidget: func(val T) string {
+			return strconv.FormatUint(uint64(val), 10)
+		},
+		FromWidget: func(val string) (T, error) {
+			n, err := parseNum(val, func(s string) (uint64, error) {
+				return strconv.ParseUint(s, 10, bits)
+			})
+			return T(n), err
+		},
+	}
+}
+
+// Converts a floating point field to a text widget, formatted with
+// [strconv.FormatFloat]. Surrounding spaces are ignored, and an empty text is
+// converted to zero.
+func FloatText[T _Float](format byte, prec int) Converter[T, string] {
+	var zero T
+	bits := int(8 * unsafe.Sizeof(zero))
+	return Converter[T, string]{
+		ToWidget: func(val T) string {
+			return strconv.FormatFloat(float64(val), format, prec, bits)
+		},
+		FromWidget: func(val string) (T, error) {
+			n, err := parseNum(val, func(s string) (float64, error) {
+				return strconv.ParseFloat(s, bits)
+			})
+			return T(n), err
+		},
+	}
+}
+
+func parseNum[N int64 | uint64 | float64](text string, parse func(string) (N, error)) (N, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return 0, nil
+	}
+	n, err := parse(text)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, ErrOutOfRange
+		}
+		return 0, ErrNotNumber
+	}
+	return n, nil
+}
+
+// Checks a value, returning an error with a message for the user.
+type Validator[T any] func(val T) error
+
+// Returned by the validator of [Required].
+var ErrRequired = errors.New("is required")
+
+// Fails if the value is the zero value of its type. Strings made only of
+// spaces are also considered empty.
+func Required[T comparable]() Validator[T] {
+	return func(val T) error {
+		var zero T
+		if s, ok := any(val).(string); ok {
+			if strings.TrimSpace(s) == "" {
+				return ErrRequired
+			}
+		} else if val == zero {
+			return ErrRequired
+		}
+		return nil
+	}
+}
+
+// Fails if the number is outside the inclusive range.
+func Range[T _Number](min, max T) Validator[T] {
+	return func(val T) error {
+		if val < min || val > max {
+			return fmt.Errorf("must be between %v and %v", min, max)
+		}
+		return nil
+	}
+}
+
+// Fails if the number of characters of the string is outside the inclusive
+// range.
+func Length(min, max int) Validator[string] {
+	return func(val string) error {
+		if n := utf8.RuneCountInString(val); n < min || n > max {
+			if min == 0 {
+				return fmt.Errorf("must have at most %d characters", max)
+			}
+			return fmt.Errorf("must have between %d and %d characters", min, max)
+		}
+		return nil
+	}
+}
+
+// Fails if the string doesn't match the regular expression, returning the given
+// message.
+//
+// Example:
+//
+//	reZip := regexp.MustCompile(`^\d{5}$`)
+//	v := uibind.Pattern(reZip, "must have 5 digits")
+func Pattern(re *regexp.Regexp, message string) Validator[string] {
+	return func(val string) error {
+		if !re.MatchString(val) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
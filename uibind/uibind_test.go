@@ -0,0 +1,150 @@
+package uibind_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/uibind"
+)
+
+// Text widget which keeps its value in memory, like an Edit.
+type textBox struct {
+	text    string
+	errText string
+	change  func()
+}
+
+func (me *textBox) Value() string                    { return me.text }
+func (me *textBox) SetValue(val string)              { me.text = val }
+func (me *textBox) ShowError(err *uibind.FieldError) { me.errText = err.Err.Error() }
+func (me *textBox) HideError()                       { me.errText = "" }
+func (me *textBox) OnChange(fun func())              { me.change = fun }
+
+func (me *textBox) typeText(text string) {
+	me.text = text
+	me.change()
+}
+
+// Check widget which keeps its value in memory, like a CheckBox.
+type checkBox struct{ checked bool }
+
+func (me *checkBox) Value() bool       { return me.checked }
+func (me *checkBox) SetValue(val bool) { me.checked = val }
+
+func ExampleBinder_Save() {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	person := Person{Name: "John", Age: 40}
+	txtName, txtAge := &textBox{}, &textBox{}
+
+	b := uibind.NewBinder()
+	uibind.Field[string](b, "Name", &person.Name, txtName, uibind.Required[string]())
+	uibind.FieldConv[int, string](b, "Age", &person.Age, txtAge, uibind.IntText[int](),
+		uibind.Range(0, 150))
+
+	b.Load()
+	fmt.Printf("%q %q\n", txtName.text, txtAge.text)
+
+	txtName.text = "  "
+	txtAge.text = "two hundred"
+	err := b.Save()
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, uibind.ErrNotNumber), txtName.errText)
+	fmt.Println(person)
+
+	txtName.text = "Mary"
+	txtAge.text = " 35 "
+	fmt.Println(b.Save(), person, txtName.errText == "")
+	// Output:
+	// "John" "40"
+	// Name: is required
+	// Age: must be a number
+	// true is required
+	// {John 40}
+	// <nil> {Mary 35} true
+}
+
+func ExampleChangeNotifier() {
+	var price float64
+	txtPrice := &textBox{}
+
+	b := uibind.NewBinder()
+	uibind.FieldConv[float64, string](b, "Price", &price, txtPrice, uibind.FloatText[float64]('f', 2))
+
+	b.Load()
+	fmt.Println(txtPrice.text)
+
+	txtPrice.typeText("9.5")
+	fmt.Println(price)
+
+	txtPrice.typeText("9.5x") // invalid, field is kept
+	fmt.Println(price)
+	// Output:
+	// 0.00
+	// 9.5
+	// 9.5
+}
+
+func ExampleStruct() {
+	type Person struct {
+		Name    string `bind:"name,required,max=10"`
+		Age     uint8  `bind:"age,min=18"`
+		Retired bool   `bind:"retired"`
+		Notes   string
+	}
+
+	person := Person{Name: "John", Age: 40}
+	txtName, txtAge, chkRetired := &textBox{}, &textBox{}, &checkBox{}
+
+	b := uibind.NewBinder()
+	err := uibind.Struct(b, &person, map[string]any{
+		"name":    txtName,
+		"age":     txtAge,
+		"retired": chkRetired,
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	b.Load()
+	fmt.Printf("%q %q %v\n", txtName.text, txtAge.text, chkRetired.checked)
+
+	txtName.text = "Maximilian Jr."
+	txtAge.text = "300"
+	fmt.Println(b.Validate())
+
+	txtName.text = "Max"
+	txtAge.text = "70"
+	chkRetired.checked = true
+	fmt.Println(b.Save(), person)
+	// Output:
+	// "John" "40" false
+	// name: must have at most 10 characters
+	// age: is out of range
+	// <nil> {Max 70 true }
+}
+
+func ExampleStruct_errors() {
+	type Order struct {
+		Id     int     `bind:"id,positive"`
+		Total  float64 `bind:"total"`
+		Client string  `bind:"client"`
+	}
+
+	var order Order
+	err := uibind.Struct(uibind.NewBinder(), &order, map[string]any{
+		"id":    &textBox{},
+		"total": &checkBox{},
+		"notes": &textBox{},
+	})
+	fmt.Println(err)
+	// Output:
+	// Struct: field Id: unknown rule "positive"
+	// field Total: cannot bind float64 to *uibind_test.checkBox
+	// field Client: no widget "client"
+	// widget "notes" not bound to any field
+}
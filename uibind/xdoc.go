@@ -0,0 +1,21 @@
+// This package binds the fields of Go structs to the controls of a window,
+// with conversions and validation.
+//
+// Controls are seen through the [Widget] interface, which only gets and sets
+// values, so all the binding logic can run, and be tested, on any platform. On
+// Windows, the ui package provides widgets for its Edit, CheckBox, ComboBox
+// and DateTimePicker controls, and a [Binder] which displays the validation
+// errors with balloon tips or message boxes.
+//
+// Fields can be bound explicitly, with [Field] and [FieldConv], or by their
+// "bind" struct tags, with [Struct]:
+//
+//	type Person struct {
+//		Name    string `bind:"name,required,max=40"`
+//		Age     int    `bind:"age,min=0,max=150"`
+//		Retired bool   `bind:"retired"`
+//	}
+//
+// Typical usage is calling [Binder.Load] when the window is created, and
+// [Binder.Save] when the user confirms the changes.
+package uibind
@@ -0,0 +1,200 @@
+package uidesc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Description of a main window, with its child controls.
+type Window struct {
+	Title    string    `json:"title"`
+	Width    int       `json:"width"`  // Client area width; zero keeps the default.
+	Height   int       `json:"height"` // Client area height; zero keeps the default.
+	Center   bool      `json:"center"` // If true, X and Y are ignored.
+	X        int       `json:"x"`
+	Y        int       `json:"y"`
+	Style    Style     `json:"style"`   // WS_ constants; empty keeps the default.
+	ExStyle  Style     `json:"exStyle"` // WS_EX_ constants; empty keeps the default.
+	Controls []Control `json:"controls"`
+}
+
+// Description of a child control.
+type Control struct {
+	Type        string   `json:"type"`   // Name of the ui type, like Button or ListView.
+	Name        string   `json:"name"`   // Used to retrieve the control; optional.
+	CtrlId      uint16   `json:"ctrlId"` // Zero for an auto-generated ID.
+	Text        string   `json:"text"`
+	X           int      `json:"x"`
+	Y           int      `json:"y"`
+	Width       int      `json:"width"`  // Zero keeps the default.
+	Height      int      `json:"height"` // Zero keeps the default.
+	Layout      Lay      `json:"layout"`
+	CtrlStyle   Style    `json:"ctrlStyle"`   // Empty keeps the default.
+	CtrlExStyle Style    `json:"ctrlExStyle"` // Empty keeps the default.
+	WndStyle    Style    `json:"wndStyle"`    // Empty keeps the default.
+	WndExStyle  Style    `json:"wndExStyle"`  // Empty keeps the default.
+	Checked     bool     `json:"checked"`     // CheckBox only.
+	Items       []string `json:"items"`       // ComboBox only.
+	Columns     []Column `json:"columns"`     // ListView only.
+}
+
+// A column of a ListView control.
+type Column struct {
+	Title string `json:"title"`
+	Width int    `json:"width"`
+}
+
+// Parses and validates a JSON window description. Unknown fields are reported
+// as errors, so typos don't go unnoticed.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("main.json")
+//	desc, err := uidesc.Parse(data)
+//	if err != nil {
+//		println(err.Error())
+//	}
+func Parse(data []byte) (*Window, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var wnd Window
+	if err := dec.Decode(&wnd); err != nil {
+		return nil, fmt.Errorf("Parse: %w", err)
+	}
+	if err := wnd.Validate(); err != nil {
+		return nil, fmt.Errorf("Parse: %w", err)
+	}
+	return &wnd, nil
+}
+
+// Checks the whole description, returning all the problems found, joined.
+// Each one wraps [ErrInvalid].
+//
+// The following are verified:
+//   - control types;
+//   - fields not supported by the control type;
+//   - duplicated control IDs and names;
+//   - LAY values;
+//   - style constant names.
+func (me *Window) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format+": %w", append(args, ErrInvalid)...))
+	}
+
+	for _, style := range []struct {
+		field string
+		val   Style
+	}{{"style", me.Style}, {"exStyle", me.ExStyle}} {
+		if _, _, err := style.val.Value(); err != nil {
+			fail("window %s: %v", style.field, err)
+		}
+	}
+	if me.Width < 0 || me.Height < 0 {
+		fail("window: negative size")
+	}
+
+	ids := make(map[uint16]int, len(me.Controls))
+	names := make(map[string]int, len(me.Controls))
+
+	for i := range me.Controls {
+		ctrl := &me.Controls[i]
+		where := fmt.Sprintf("controls[%d]", i)
+		if ctrl.Name != "" {
+			where += fmt.Sprintf(" %q", ctrl.Name)
+		}
+
+		def, ok := ctlDefs[ctrl.Type]
+		if !ok {
+			fail("%s: unknown control type %q", where, ctrl.Type)
+			continue
+		}
+
+		if ctrl.CtrlId != 0 {
+			if prev, dup := ids[ctrl.CtrlId]; dup {
+				fail("%s: ctrlId %d already used by controls[%d]", where, ctrl.CtrlId, prev)
+			} else {
+				ids[ctrl.CtrlId] = i
+			}
+		}
+		if ctrl.Name != "" {
+			if prev, dup := names[ctrl.Name]; dup {
+				fail("%s: name already used by controls[%d]", where, prev)
+			} else {
+				names[ctrl.Name] = i
+			}
+		}
+
+		if _, err := ctrl.Layout.Value(); err != nil {
+			fail("%s: %v", where, err)
+		}
+		for _, style := range []struct {
+			field string
+			val   Style
+		}{
+			{"ctrlStyle", ctrl.CtrlStyle},
+			{"ctrlExStyle", ctrl.CtrlExStyle},
+			{"wndStyle", ctrl.WndStyle},
+			{"wndExStyle", ctrl.WndExStyle},
+		} {
+			if _, _, err := style.val.Value(); err != nil {
+				fail("%s: %s: %v", where, style.field, err)
+			}
+		}
+
+		if ctrl.Width < 0 || ctrl.Height < 0 {
+			fail("%s: negative size", where)
+		}
+		for _, unsupported := range []struct {
+			field string
+			set   bool
+			ok    bool
+		}{
+			{"text", ctrl.Text != "", def.text},
+			{"width", ctrl.Width != 0, def.width},
+			{"height", ctrl.Height != 0, def.height},
+			{"ctrlExStyle", ctrl.CtrlExStyle != "", def.ctrlExStyle},
+			{"checked", ctrl.Checked, def.checked},
+			{"items", len(ctrl.Items) > 0, def.items},
+			{"columns", len(ctrl.Columns) > 0, def.columns},
+		} {
+			if unsupported.set && !unsupported.ok {
+				fail("%s: %s not supported by %s", where, unsupported.field, ctrl.Type)
+			}
+		}
+		for j, col := range ctrl.Columns {
+			if col.Width <= 0 {
+				fail("%s: columns[%d]: width must be positive", where, j)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Returned, wrapped, by [Window.Validate] for each problem found.
+var ErrInvalid = errors.New("invalid window description")
+
+// Fields supported by each control type, besides the common ones.
+type _CtlDef struct {
+	text, width, height, ctrlExStyle, checked, items, columns bool
+}
+
+// Supported control types, named after the ui types.
+var ctlDefs = map[string]_CtlDef{
+	"Button":         {text: true, width: true, height: true},
+	"CheckBox":       {text: true, width: true, height: true, checked: true},
+	"ComboBox":       {width: true, items: true},
+	"DateTimePicker": {width: true, height: true},
+	"Edit":           {text: true, width: true, height: true},
+	"ListView":       {width: true, height: true, ctrlExStyle: true, columns: true},
+	"MonthCalendar":  {},
+	"ProgressBar":    {width: true, height: true},
+	"Static":         {text: true, width: true, height: true},
+	"SysLink":        {text: true, width: true, height: true},
+	"Trackbar":       {width: true, height: true},
+	"TreeView":       {width: true, height: true, ctrlExStyle: true},
+}
@@ -0,0 +1,53 @@
+package uidesc_test
+
+import (
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/uidesc"
+)
+
+func ExampleParse() {
+	desc, err := uidesc.Parse([]byte(`{
+		"title": "Contacts",
+		"width": 420,
+		"height": 280,
+		"controls": [
+			{"type": "Edit", "name": "search", "x": 10, "y": 10, "layout": "LAY_RESIZE_HOLD"},
+			{
+				"type": "ListView", "name": "list", "x": 10, "y": 45,
+				"layout": "RESIZE_RESIZE", "ctrlExStyle": "LVS_EX_FULLROWSELECT | LVS_EX_GRIDLINES",
+				"columns": [{"title": "Name", "width": 200}]
+			}
+		]
+	}`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	lay, _ := desc.Controls[1].Layout.Value()
+	exStyle, _, _ := desc.Controls[1].CtrlExStyle.Value()
+	fmt.Println(desc.Title, len(desc.Controls))
+	fmt.Printf("%#x %#x\n", lay, exStyle)
+	// Output:
+	// Contacts 2
+	// 0xa 0x21
+}
+
+func ExampleWindow_Validate() {
+	desc := uidesc.Window{
+		Controls: []uidesc.Control{
+			{Type: "Button", CtrlId: 1001, Layout: "LAY_MOVE_HOLD"},
+			{Type: "Button", CtrlId: 1001, Layout: "LAY_SIDEWAYS"},
+			{Type: "Slider"},
+			{Type: "Edit", WndStyle: "WS_CHILD | WS_VISIBLE | WS_BOGUS"},
+		},
+	}
+
+	fmt.Println(desc.Validate())
+	// Output:
+	// controls[1]: ctrlId 1001 already used by controls[0]: invalid window description
+	// controls[1]: invalid layout "LAY_SIDEWAYS": invalid window description
+	// controls[2]: unknown control type "Slider": invalid window description
+	// controls[3]: wndStyle: unknown style "WS_BOGUS": invalid window description
+}
@@ -0,0 +1,135 @@
+package uidesc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/res"
+)
+
+// A style value, written as constant names and numbers joined with "|":
+//
+//	"WS_CHILD | WS_VISIBLE | 0x0004"
+//
+// A plain JSON number is also accepted. The names are the ones defined by the
+// Windows SDK headers, plus the LVS_EX_, TVS_EX_ and LWS_ constants.
+type Style string
+
+// Accepts either a JSON string or a JSON number.
+func (me *Style) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] != '"' {
+		var n uint32
+		if err := json.Unmarshal(data, &n); err != nil {
+			return err
+		}
+		*me = Style(strconv.FormatUint(uint64(n), 10))
+		return nil
+	}
+	return json.Unmarshal(data, (*string)(me))
+}
+
+// Returns the numeric value of the style. If the style is empty, returns false,
+// which means the default style should be kept.
+func (me Style) Value() (uint32, bool, error) {
+	if strings.TrimSpace(string(me)) == "" {
+		return 0, false, nil
+	}
+
+	var val uint32
+	for _, part := range strings.Split(string(me), "|") {
+		part = strings.TrimSpace(part)
+		if n, err := strconv.ParseUint(part, 0, 32); err == nil {
+			val |= uint32(n)
+		} else if n, ok := res.LookupSymbol(part); ok {
+			val |= uint32(n)
+		} else if n, ok := extraStyles[part]; ok {
+			val |= n
+		} else {
+			return 0, false, fmt.Errorf("unknown style %q", part)
+		}
+	}
+	return val, true, nil
+}
+
+// Control styles not defined by the SDK headers of the resource compiler.
+var extraStyles = map[string]uint32{
+	"LVS_EX_AUTOAUTOARRANGE":       0x0100_0000,
+	"LVS_EX_AUTOCHECKSELECT":       0x0800_0000,
+	"LVS_EX_AUTOSIZECOLUMNS":       0x1000_0000,
+	"LVS_EX_BORDERSELECT":          0x0000_8000,
+	"LVS_EX_CHECKBOXES":            0x0000_0004,
+	"LVS_EX_COLUMNOVERFLOW":        0x8000_0000,
+	"LVS_EX_COLUMNSNAPPOINTS":      0x4000_0000,
+	"LVS_EX_DOUBLEBUFFER":          0x0001_0000,
+	"LVS_EX_FLATSB":                0x0000_0100,
+	"LVS_EX_FULLROWSELECT":         0x0000_0020,
+	"LVS_EX_GRIDLINES":             0x0000_0001,
+	"LVS_EX_HEADERDRAGDROP":        0x0000_0010,
+	"LVS_EX_HEADERINALLVIEWS":      0x0200_0000,
+	"LVS_EX_HIDELABELS":            0x0002_0000,
+	"LVS_EX_INFOTIP":               0x0000_0400,
+	"LVS_EX_JUSTIFYCOLUMNS":        0x0020_0000,
+	"LVS_EX_LABELTIP":              0x0000_4000,
+	"LVS_EX_MULTIWORKAREAS":        0x0000_2000,
+	"LVS_EX_ONECLICKACTIVATE":      0x0000_0040,
+	"LVS_EX_REGIONAL":              0x0000_0200,
+	"LVS_EX_SIMPLESELECT":          0x0010_0000,
+	"LVS_EX_SINGLEROW":             0x0004_0000,
+	"LVS_EX_SNAPTOGRID":            0x0008_0000,
+	"LVS_EX_SUBITEMIMAGES":         0x0000_0002,
+	"LVS_EX_TRACKSELECT":           0x0000_0008,
+	"LVS_EX_TRANSPARENTBKGND":      0x0040_0000,
+	"LVS_EX_TRANSPARENTSHADOWTEXT": 0x0080_0000,
+	"LVS_EX_TWOCLICKACTIVATE":      0x0000_0080,
+	"LVS_EX_UNDERLINECOLD":         0x0000_1000,
+	"LVS_EX_UNDERLINEHOT":          0x0000_0800,
+	"LWS_IGNORERETURN":             0x0002,
+	"LWS_NOPREFIX":                 0x0004,
+	"LWS_RIGHT":                    0x0020,
+	"LWS_TRANSPARENT":              0x0001,
+	"LWS_USECUSTOMTEXT":            0x0010,
+	"LWS_USEVISUALSTYLE":           0x0008,
+	"TVS_EX_AUTOHSCROLL":           0x0020,
+	"TVS_EX_DIMMEDCHECKBOXES":      0x0200,
+	"TVS_EX_DOUBLEBUFFER":          0x0004,
+	"TVS_EX_DRAWIMAGEASYNC":        0x0400,
+	"TVS_EX_EXCLUSIONCHECKBOXES":   0x0100,
+	"TVS_EX_FADEINOUTEXPANDOS":     0x0040,
+	"TVS_EX_MULTISELECT":           0x0002,
+	"TVS_EX_NOINDENTSTATE":         0x0008,
+	"TVS_EX_NOSINGLECOLLAPSE":      0x0001,
+	"TVS_EX_PARTIALCHECKBOXES":     0x0080,
+	"TVS_EX_RICHTOOLTIP":           0x0010,
+}
+
+// Horizontal and vertical behavior of a control when the parent window is
+// resized, named after the ui.LAY constants, like "LAY_RESIZE_HOLD". The "LAY_"
+// prefix is optional. Empty means LAY_HOLD_HOLD.
+type Lay string
+
+// Returns the numeric value of the layout, which has the same bits of ui.LAY.
+func (me Lay) Value() (uint8, error) {
+	name := strings.TrimPrefix(strings.TrimSpace(string(me)), "LAY_")
+	if name == "" {
+		return 0, nil
+	}
+
+	horz, vert, ok := strings.Cut(name, "_")
+	if ok {
+		h, okH := layBits[horz]
+		v, okV := layBits[vert]
+		if okH && okV {
+			return h | v<<2, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid layout %q", string(me))
+}
+
+// Bits of a layout direction; vertical bits are shifted by 2.
+var layBits = map[string]uint8{
+	"HOLD":   0b00,
+	"MOVE":   0b01,
+	"RESIZE": 0b10,
+}
@@ -0,0 +1,30 @@
+// This package contains a declarative description of a window and its child
+// controls, which can be written as a JSON document.
+//
+// The description is plain Go data, so it can be parsed and validated on any
+// platform. On Windows, it is instantiated by ui.NewMainDesc, which makes the
+// matching ui.New* calls and returns the controls by name.
+//
+// Example of a document:
+//
+//	{
+//		"title": "Contacts",
+//		"width": 420,
+//		"height": 280,
+//		"center": true,
+//		"style": "WS_CAPTION | WS_SYSMENU | WS_CLIPCHILDREN | WS_BORDER | WS_VISIBLE | WS_SIZEBOX",
+//		"controls": [
+//			{"type": "Edit", "name": "search", "x": 10, "y": 10, "width": 300, "layout": "LAY_RESIZE_HOLD"},
+//			{"type": "Button", "name": "go", "text": "&Search", "x": 320, "y": 9, "layout": "LAY_MOVE_HOLD"},
+//			{
+//				"type": "ListView", "name": "list", "x": 10, "y": 45, "width": 400, "height": 225,
+//				"layout": "LAY_RESIZE_RESIZE", "ctrlExStyle": "LVS_EX_FULLROWSELECT",
+//				"columns": [{"title": "Name", "width": 200}, {"title": "E-mail", "width": 180}]
+//			}
+//		]
+//	}
+//
+// Positions and sizes are given in pixels at 96 DPI, and they are scaled to the
+// current DPI when the controls are created. Zero sizes keep the defaults of
+// each control.
+package uidesc
@@ -3,6 +3,7 @@
 package win
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 	"syscall"
@@ -34,6 +35,31 @@ const (
 	HKEY_CURRENT_CONFIG      HKEY = 0x8000_0005
 )
 
+// Returns the name of a predefined key, like "HKEY_CURRENT_USER", or the
+// handle value in hex.
+func (hKey HKEY) String() string {
+	switch hKey {
+	case HKEY_CLASSES_ROOT:
+		return "HKEY_CLASSES_ROOT"
+	case HKEY_CURRENT_USER:
+		return "HKEY_CURRENT_USER"
+	case HKEY_LOCAL_MACHINE:
+		return "HKEY_LOCAL_MACHINE"
+	case HKEY_USERS:
+		return "HKEY_USERS"
+	case HKEY_PERFORMANCE_DATA:
+		return "HKEY_PERFORMANCE_DATA"
+	case HKEY_PERFORMANCE_TEXT:
+		return "HKEY_PERFORMANCE_TEXT"
+	case HKEY_PERFORMANCE_NLSTEXT:
+		return "HKEY_PERFORMANCE_NLSTEXT"
+	case HKEY_CURRENT_CONFIG:
+		return "HKEY_CURRENT_CONFIG"
+	default:
+		return fmt.Sprintf("HKEY(0x%x)", uintptr(hKey))
+	}
+}
+
 // [RegConnectRegistry] function.
 //
 // Panics if predef_key is different from:
@@ -417,7 +443,7 @@ func (hKey HKEY) RegEnumValue() ([]HkeyNameVal, error) {
 	var wValueNameBuf wstr.BufDecoder
 	wValueNameBuf.Alloc(nfo.MaxValueNameLen + 1)
 
-	dataBuf := make([]byte, nfo.MaxValueDataLen)
+	dataBuf := make([]byte, nfo.MaxValueDataLen+1) // +1 so it's never empty
 
 	for i := 0; i < nfo.NumValues; i++ {
 		szValueNameBuf := uint32(wValueNameBuf.Len())
@@ -937,7 +963,7 @@ func (hKey HKEY) RegSetKeyValue(subKey, valueName string, data RegVal) error {
 		uintptr(wSubKey.AllowEmpty(subKey)),
 		uintptr(wValueName.EmptyIsNil(valueName)),
 		uintptr(data.Type()),
-		uintptr(unsafe.Pointer(&data.data[0])),
+		uintptr(data.dataPtr()),
 		uintptr(uint32(len(data.data))))
 	return utl.ZeroAsSysError(ret)
 }
@@ -955,7 +981,7 @@ func (hKey HKEY) RegSetValueEx(valueName string, data RegVal) error {
 		uintptr(wValueName.EmptyIsNil(valueName)),
 		0,
 		uintptr(data.Type()),
-		uintptr(unsafe.Pointer(&data.data[0])),
+		uintptr(data.dataPtr()),
 		uintptr(uint32(len(data.data))))
 	return utl.ZeroAsSysError(ret)
 }
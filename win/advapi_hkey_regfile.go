@@ -0,0 +1,178 @@
+//go:build windows
+
+package win
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/reg"
+)
+
+// Reads the subkey with all its values and subkeys, recursively, into a
+// [reg.File], which can be written as a .reg file.
+//
+// The paths of the keys start with the name of hKey, so it should be one of
+// the predefined keys, like [HKEY_CURRENT_USER].
+//
+// Example:
+//
+//	f, _ := win.HKEY_CURRENT_USER.RegExport("Software\\MyApp")
+//
+//	fout, _ := os.Create("C:\\Temp\\MyApp.reg")
+//	defer fout.Close()
+//	_, _ = f.WriteTo(fout)
+func (hKey HKEY) RegExport(subKey string) (*reg.File, error) {
+	hSubKey, err := hKey.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_READ)
+	if err != nil {
+		return nil, fmt.Errorf("RegExport: %w", err)
+	}
+	defer hSubKey.RegCloseKey()
+
+	key, err := hSubKey.readKey()
+	if err != nil {
+		return nil, fmt.Errorf("RegExport: %w", err)
+	}
+
+	path := hKey.String()
+	if subKey = strings.Trim(subKey, "\\"); subKey != "" {
+		path += "\\" + subKey
+	}
+	return reg.FileFromKey(path, key), nil
+}
+
+// Applies the keys and values of a [reg.File] as regedit imports them: keys
+// are created, values are set, and the keys and values marked for deletion
+// are removed, if they exist.
+//
+// The first component of each path is the root key, which is replaced by
+// hKey. If hKey is a predefined key, like [HKEY_CURRENT_USER], the root key
+// must match it, either by the full name or the abbreviation, like "HKCU".
+//
+// If hTransaction is not zero, all changes are made within the transaction,
+// which must be committed afterwards; otherwise the changes made before an
+// error are kept.
+//
+// Example:
+//
+//	fin, _ := os.Open("C:\\Temp\\MyApp.reg")
+//	defer fin.Close()
+//	f, _ := reg.ParseFile(fin)
+//
+//	hTrans, _ := win.HTRANSACTION(0).CreateTransaction(
+//		nil, co.TRANSACTION_OPT_NONE, win.TimeoutInfinite(), "import")
+//	defer hTrans.CloseHandle()
+//
+//	if err := win.HKEY_CURRENT_USER.RegImport(f, hTrans); err != nil {
+//		_ = hTrans.RollbackTransaction()
+//	} else {
+//		_ = hTrans.CommitTransaction()
+//	}
+func (hKey HKEY) RegImport(file *reg.File, hTransaction HTRANSACTION) error {
+	for _, fileKey := range file.Keys {
+		subKey, err := hKey.regImportSubKey(fileKey.Path)
+		if err == nil {
+			if fileKey.Delete {
+				err = hKey.regImportDelete(subKey, hTransaction)
+			} else {
+				err = hKey.regImportValues(subKey, fileKey.Values, hTransaction)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("RegImport: key %s: %w", fileKey.Path, err)
+		}
+	}
+	return nil
+}
+
+// Validates the root key of the path, returning the path without it.
+func (hKey HKEY) regImportSubKey(path string) (string, error) {
+	root, subKey, _ := strings.Cut(path, "\\")
+
+	if name := hKey.String(); !strings.HasPrefix(name, "HKEY(") { // predefined key
+		abbr := map[HKEY]string{
+			HKEY_CLASSES_ROOT:   "HKCR",
+			HKEY_CURRENT_USER:   "HKCU",
+			HKEY_LOCAL_MACHINE:  "HKLM",
+			HKEY_USERS:          "HKU",
+			HKEY_CURRENT_CONFIG: "HKCC",
+		}[hKey]
+		if !strings.EqualFold(root, name) && !strings.EqualFold(root, abbr) {
+			return "", fmt.Errorf("%w: root key is not %s", co.ERROR_INVALID_PARAMETER, name)
+		}
+	}
+	return strings.Trim(subKey, "\\"), nil
+}
+
+// Deletes the subkey and its subkeys, if it exists.
+func (hKey HKEY) regImportDelete(subKey string, hTransaction HTRANSACTION) error {
+	if subKey == "" {
+		return fmt.Errorf("%w: cannot delete a root key", co.ERROR_INVALID_PARAMETER)
+	}
+
+	parent, name := "", subKey
+	if idx := strings.LastIndexByte(subKey, '\\'); idx != -1 {
+		parent, name = subKey[:idx], subKey[idx+1:]
+	}
+
+	hParent, err := hKey.regImportOpen(parent, hTransaction)
+	if errors.Is(err, co.ERROR_FILE_NOT_FOUND) {
+		return nil // parent doesn't exist, nothing to delete
+	} else if err != nil {
+		return err
+	}
+	defer hParent.RegCloseKey()
+
+	if err := hParent.RegDeleteTree(name); err != nil && !errors.Is(err, co.ERROR_FILE_NOT_FOUND) {
+		return err
+	}
+	return nil
+}
+
+// Creates the subkey, if needed, then sets and deletes its values.
+func (hKey HKEY) regImportValues(
+	subKey string,
+	fileVals []reg.FileValue,
+	hTransaction HTRANSACTION,
+) error {
+	var hSubKey HKEY
+	var err error
+	if subKey == "" {
+		hSubKey, err = hKey.regImportOpen("", hTransaction)
+	} else if hTransaction != 0 {
+		hSubKey, _, err = hKey.RegCreateKeyTransacted(subKey, "",
+			co.REG_OPTION_NONE, co.KEY_READ|co.KEY_WRITE, nil, hTransaction)
+	} else {
+		hSubKey, _, err = hKey.RegCreateKeyEx(subKey, "",
+			co.REG_OPTION_NONE, co.KEY_READ|co.KEY_WRITE, nil)
+	}
+	if err != nil {
+		return err
+	}
+	defer hSubKey.RegCloseKey()
+
+	for _, fileVal := range fileVals {
+		if fileVal.Delete {
+			err = hSubKey.RegDeleteValue(fileVal.Name)
+			if errors.Is(err, co.ERROR_FILE_NOT_FOUND) {
+				err = nil
+			}
+		} else {
+			err = hSubKey.RegSetValueEx(fileVal.Name, RegValRaw(fileVal.Value))
+		}
+		if err != nil {
+			return fmt.Errorf("value %q: %w", fileVal.Name, err)
+		}
+	}
+	return nil
+}
+
+// Opens the subkey with full access, within the transaction, if any.
+func (hKey HKEY) regImportOpen(subKey string, hTransaction HTRANSACTION) (HKEY, error) {
+	if hTransaction != 0 {
+		return hKey.RegOpenKeyTransacted(subKey, co.REG_OPTION_NONE, co.KEY_ALL_ACCESS, hTransaction)
+	}
+	return hKey.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_ALL_ACCESS)
+}
@@ -0,0 +1,122 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/reg"
+)
+
+// Reads the values of the key into the fields of the struct pointed to by
+// pStruct, and its subkeys into the nested structs, as [reg.DecodeStruct]
+// does. Fields without a corresponding value are left untouched.
+//
+// Example:
+//
+//	type Settings struct {
+//		LastFile string   `reg:"Last File"`
+//		Recent   []string `reg:"Recent Files"`
+//		Width    uint32
+//	}
+//
+//	settings := Settings{Width: 800} // default
+//	err := win.HKEY_CURRENT_USER.Load("Software\\MyApp", &settings)
+//	if errors.Is(err, co.ERROR_FILE_NOT_FOUND) {
+//		// key doesn't exist yet
+//	}
+func (hKey HKEY) Load(subKey string, pStruct any) error {
+	hSubKey, err := hKey.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_READ)
+	if err != nil {
+		return fmt.Errorf("Load: %w", err)
+	}
+	defer hSubKey.RegCloseKey()
+
+	key, err := hSubKey.readKey()
+	if err != nil {
+		return fmt.Errorf("Load: %w", err)
+	}
+	if err := reg.DecodeStruct(key, pStruct); err != nil {
+		return fmt.Errorf("Load: %w", err)
+	}
+	return nil
+}
+
+// Writes the fields of the struct into values of the key, and the nested
+// structs into subkeys, as [reg.EncodeStruct] does. The key and subkeys are
+// created if they don't exist. Other values of the key are left untouched.
+//
+// Example:
+//
+//	type Settings struct {
+//		LastFile string `reg:"Last File"`
+//		Width    uint32
+//	}
+//
+//	settings := Settings{LastFile: "C:\\Temp\\foo.txt", Width: 800}
+//	_ = win.HKEY_CURRENT_USER.Store("Software\\MyApp", settings)
+func (hKey HKEY) Store(subKey string, v any) error {
+	key, err := reg.EncodeStruct(v)
+	if err != nil {
+		return fmt.Errorf("Store: %w", err)
+	}
+	if err := hKey.writeKey(subKey, key); err != nil {
+		return fmt.Errorf("Store: %w", err)
+	}
+	return nil
+}
+
+// Reads all values and subkeys, recursively.
+func (hKey HKEY) readKey() (*reg.Key, error) {
+	key := reg.NewKey()
+
+	namesVals, err := hKey.RegEnumValue()
+	if err != nil {
+		return nil, err
+	}
+	for _, nameVal := range namesVals {
+		key.Values[nameVal.Name] = nameVal.Val.Raw()
+	}
+
+	subNames, err := hKey.RegEnumKeyEx()
+	if err != nil {
+		return nil, err
+	}
+	for _, subName := range subNames {
+		hSubKey, err := hKey.RegOpenKeyEx(subName, co.REG_OPTION_NONE, co.KEY_READ)
+		if err != nil {
+			return nil, err
+		}
+		sub, err := hSubKey.readKey()
+		hSubKey.RegCloseKey()
+		if err != nil {
+			return nil, err
+		}
+		key.Subkeys[subName] = sub
+	}
+
+	return key, nil
+}
+
+// Creates the subkey and writes all values and subkeys, recursively.
+func (hKey HKEY) writeKey(subKey string, key *reg.Key) error {
+	hSubKey, _, err := hKey.RegCreateKeyEx(subKey, "",
+		co.REG_OPTION_NONE, co.KEY_READ|co.KEY_WRITE, nil)
+	if err != nil {
+		return err
+	}
+	defer hSubKey.RegCloseKey()
+
+	for _, name := range key.ValueNames() {
+		if err := hSubKey.RegSetValueEx(name, RegValRaw(key.Values[name])); err != nil {
+			return err
+		}
+	}
+	for _, name := range key.SubkeyNames() {
+		if err := hSubKey.writeKey(name, key.Subkeys[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
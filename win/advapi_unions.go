@@ -7,6 +7,7 @@ import (
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/reg"
 	"github.com/rodrigocfd/windigo/wstr"
 )
 
@@ -148,7 +149,7 @@ func (me *RegVal) MultiSz() ([]string, bool) {
 //
 // Same as [co.REG_QWORD_LITTLE_ENDIAN].
 func RegValQword(n uint64) RegVal {
-	var data [4]byte
+	var data [8]byte
 	binary.LittleEndian.PutUint64(data[:], n)
 
 	return RegVal{
@@ -166,6 +167,42 @@ func (me *RegVal) Qword() (uint64, bool) {
 	return 0, false
 }
 
+// Constructs a new [RegVal] from a [reg.Value], which can be created from Go
+// values with [reg.Encode].
+//
+// Note that the data content is not copied, the slice pointer is simply stored.
+//
+// Example:
+//
+//	val, _ := reg.Encode([]string{"a", "b"})
+//	regVal := win.RegValRaw(val)
+func RegValRaw(val reg.Value) RegVal {
+	return RegVal{
+		tag:  co.REG(val.Type),
+		data: val.Data,
+	}
+}
+
+// Returns the type and the raw data as a [reg.Value], which can be converted
+// to Go values with [reg.Decode].
+//
+// Note that the data content is not copied, the slice pointer is simply
+// returned.
+//
+// Example:
+//
+//	regVal, _ := win.HKEY_CURRENT_USER.RegGetValue(
+//		"Control Panel\\Desktop", "Wallpaper", co.RRF_RT_ANY)
+//
+//	var path string
+//	_ = reg.Decode(regVal.Raw(), &path)
+func (me *RegVal) Raw() reg.Value {
+	return reg.Value{
+		Type: reg.REG(me.tag),
+		Data: me.data,
+	}
+}
+
 // Constructs a new [RegVal] with a [co.REG_SZ] value.
 func RegValSz(s string) RegVal {
 	str16 := wstr.EncodeToSlice(s)
@@ -208,3 +245,11 @@ func regValParse(data []byte, regType co.REG) (RegVal, error) {
 
 	return RegVal{regType, data}, nil
 }
+
+// Returns a pointer to the data, or nil if empty.
+func (me *RegVal) dataPtr() unsafe.Pointer {
+	if len(me.data) == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&me.data[0])
+}
@@ -4,9 +4,11 @@ package win
 
 import (
 	"encoding/binary"
+	"image"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/dib"
 	"github.com/rodrigocfd/windigo/internal/utl"
 	"github.com/rodrigocfd/windigo/wstr"
 )
@@ -131,6 +133,22 @@ func (bih *BITMAPINFOHEADER) Serialize() []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(bih)), unsafe.Sizeof(*bih))
 }
 
+// Decodes the pixels described by the header, with [dib.DecodeBits].
+//
+// The colors are the masks which follow the header with co.BI_BITFIELDS, and
+// the color table, if any.
+//
+// Example:
+//
+//	var bi win.BITMAPINFO
+//	bi.BmiHeader.SetBiSize()
+//	// ...call HDC.GetDIBits to fill the header and the pixels...
+//	img, _ := bi.BmiHeader.ToImage(nil, pixels)
+func (bih *BITMAPINFOHEADER) ToImage(colors, bits []byte) (image.Image, error) {
+	info := append(append([]byte{}, bih.Serialize()...), colors...)
+	return dib.DecodeBits(info, bits)
+}
+
 // [BITMAPV5HEADER] struct, with C memory layout.
 //
 // ⚠️ You must call [BITMAPV5HEADER.SetBV5Size] to initialize the struct.
@@ -174,6 +192,19 @@ func (bvh *BITMAPV5HEADER) SetBV5Size() {
 	bvh.bV5Size = uint32(unsafe.Sizeof(*bvh))
 }
 
+func (bvh *BITMAPV5HEADER) Serialize() []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(bvh)), unsafe.Sizeof(*bvh))
+}
+
+// Decodes the pixels described by the header, with [dib.DecodeBits]. With 32
+// bits per pixel and an AlphaMask, the image has an alpha channel.
+//
+// The colors are the color table, if any.
+func (bvh *BITMAPV5HEADER) ToImage(colors, bits []byte) (image.Image, error) {
+	info := append(append([]byte{}, bvh.Serialize()...), colors...)
+	return dib.DecodeBits(info, bits)
+}
+
 // [COLORREF] struct, with C memory layout.
 //
 // Specifies an RGB color.
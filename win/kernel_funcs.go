@@ -577,6 +577,46 @@ func GetLocalTime() SYSTEMTIME {
 
 var _kernel_GetLocalTime *syscall.Proc
 
+// [GetLocaleInfoEx] function.
+//
+// If localeName is empty, the user default locale is used. The
+// co.LOCALE_RETURN_NUMBER flag is not supported.
+//
+// Example:
+//
+//	decimalSep, _ := win.GetLocaleInfoEx("", co.LOCALE_SDECIMAL)
+//
+// [GetLocaleInfoEx]: https://learn.microsoft.com/en-us/windows/win32/api/winnls/nf-winnls-getlocaleinfoex
+func GetLocaleInfoEx(localeName string, lcType co.LOCALE) (string, error) {
+	var wLocaleName wstr.BufEncoder
+	pLocaleName := wLocaleName.EmptyIsNil(localeName)
+
+	ret, _, err := syscall.SyscallN(
+		dll.Kernel.Load(&_kernel_GetLocaleInfoEx, "GetLocaleInfoEx"),
+		uintptr(pLocaleName),
+		uintptr(lcType),
+		0, 0) // 1st call to retrieve the required length
+	if ret == 0 {
+		return "", co.ERROR(err)
+	}
+
+	var wBuf wstr.BufDecoder
+	wBuf.AllocAndZero(int(ret)) // includes terminating null
+
+	ret, _, err = syscall.SyscallN(
+		dll.Kernel.Load(&_kernel_GetLocaleInfoEx, "GetLocaleInfoEx"),
+		uintptr(pLocaleName),
+		uintptr(lcType),
+		uintptr(wBuf.Ptr()),
+		uintptr(int32(wBuf.Len())))
+	if ret == 0 {
+		return "", co.ERROR(err)
+	}
+	return wBuf.String(), nil
+}
+
+var _kernel_GetLocaleInfoEx *syscall.Proc
+
 // [GetNumberOfConsoleMouseButtons] function.
 //
 // [GetNumberOfConsoleMouseButtons]: https://learn.microsoft.com/en-us/windows/console/getnumberofconsolemousebuttons
@@ -663,6 +703,25 @@ func GetTimeZoneInformation() (TIME_ZONE_INFORMATION, co.TIME_ZONE_ID, error) {
 
 var _kernel_GetTimeZoneInformation *syscall.Proc
 
+// [GetUserDefaultLocaleName] function.
+//
+// [GetUserDefaultLocaleName]: https://learn.microsoft.com/en-us/windows/win32/api/winnls/nf-winnls-getuserdefaultlocalename
+func GetUserDefaultLocaleName() (string, error) {
+	var wBuf wstr.BufDecoder
+	wBuf.AllocAndZero(utl.LOCALE_NAME_MAX_LENGTH)
+
+	ret, _, err := syscall.SyscallN(
+		dll.Kernel.Load(&_kernel_GetUserDefaultLocaleName, "GetUserDefaultLocaleName"),
+		uintptr(wBuf.Ptr()),
+		uintptr(int32(wBuf.Len())))
+	if ret == 0 {
+		return "", co.ERROR(err)
+	}
+	return wBuf.String(), nil
+}
+
+var _kernel_GetUserDefaultLocaleName *syscall.Proc
+
 // [GetSystemInfo] function.
 //
 // [GetSystemInfo]: https://learn.microsoft.com/en-us/windows/win32/api/sysinfoapi/nf-sysinfoapi-getsysteminfo
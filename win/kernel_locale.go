@@ -0,0 +1,106 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/locale"
+)
+
+// Returns the locale of the current user, with the regional settings as
+// customized in the Control Panel, like the separators and date formats.
+// Calls:
+//   - [GetUserDefaultLocaleName]
+//   - [GetLocaleInfoEx]
+//
+// If the user locale is not embedded in the locale package, the invariant
+// locale is used as a base.
+//
+// Example:
+//
+//	loc, _ := win.UserLocale()
+//	println(loc.FmtBytes(1500, locale.UNITS_WINDOWS))
+func UserLocale() (*locale.Locale, error) {
+	name, err := GetUserDefaultLocaleName()
+	if err != nil {
+		return nil, fmt.Errorf("UserLocale: %w", err)
+	}
+
+	loc, err := locale.ByTag(name)
+	if err != nil {
+		loc = locale.Invariant()
+	}
+	loc.Tag = name
+
+	type field struct {
+		lcType co.LOCALE
+		dest   *string
+	}
+	fields := []field{
+		{co.LOCALE_SENGLISHDISPLAYNAME, &loc.Name},
+		{co.LOCALE_SDECIMAL, &loc.Decimal},
+		{co.LOCALE_STHOUSAND, &loc.Thousand},
+		{co.LOCALE_SNEGATIVESIGN, &loc.Negative},
+		{co.LOCALE_SSHORTDATE, &loc.ShortDate},
+		{co.LOCALE_SLONGDATE, &loc.LongDate},
+	}
+	for i := 0; i < 12; i++ {
+		fields = append(fields,
+			field{co.LOCALE_SMONTHNAME1 + co.LOCALE(i), &loc.Months[i]},
+			field{co.LOCALE_SABBREVMONTHNAME1 + co.LOCALE(i), &loc.MonthsAbbr[i]},
+			field{(co.LOCALE_SMONTHNAME1 + co.LOCALE(i)) | co.LOCALE_RETURN_GENITIVE_NAMES, &loc.MonthsGenitive[i]},
+		)
+	}
+	for i := 0; i < 7; i++ {
+		day := (i + 1) % 7 // Windows starts at Monday
+		fields = append(fields,
+			field{co.LOCALE_SDAYNAME1 + co.LOCALE(i), &loc.Days[day]},
+			field{co.LOCALE_SABBREVDAYNAME1 + co.LOCALE(i), &loc.DaysAbbr[day]},
+		)
+	}
+
+	for _, f := range fields {
+		if *f.dest, err = GetLocaleInfoEx(name, f.lcType); err != nil {
+			return nil, fmt.Errorf("UserLocale: %w", err)
+		}
+	}
+
+	langId, err := GetLocaleInfoEx(name, co.LOCALE_ILANGUAGE)
+	if err != nil {
+		return nil, fmt.Errorf("UserLocale: %w", err)
+	}
+	if n, err := strconv.ParseUint(langId, 16, 16); err == nil {
+		loc.LangId = uint16(n)
+	}
+
+	grouping, err := GetLocaleInfoEx(name, co.LOCALE_SGROUPING)
+	if err != nil {
+		return nil, fmt.Errorf("UserLocale: %w", err)
+	}
+	loc.Grouping = parseGrouping(grouping)
+
+	return loc, nil
+}
+
+// Parses the LOCALE_SGROUPING format, like "3;2;0", where a trailing zero
+// means the last size is repeated, into the locale.Locale.Grouping format,
+// where the last size is always repeated.
+func parseGrouping(s string) []int {
+	parts := strings.Split(s, ";")
+	sizes := make([]int, 0, len(parts)+1)
+	for _, part := range parts {
+		n, _ := strconv.Atoi(part)
+		sizes = append(sizes, n)
+	}
+
+	if last := len(sizes) - 1; sizes[last] == 0 {
+		sizes = sizes[:last] // repeat the previous one
+	} else {
+		sizes = append(sizes, 0) // no more groups
+	}
+	return sizes
+}
@@ -29,7 +29,7 @@ func CreateCursorFromResourceEx(
 	szDesired SIZE,
 	flags co.LR,
 ) (HCURSOR, error) {
-	hIcon, err := CreateIconFromResourceEx(resBits, fmtVersion, szDesired, flags)
+	hIcon, err := createIconFromResourceEx(resBits, false, fmtVersion, szDesired, flags)
 	return HCURSOR(hIcon), err
 }
 
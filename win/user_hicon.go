@@ -30,12 +30,22 @@ func CreateIconFromResourceEx(
 	fmtVersion uint32,
 	szDesired SIZE,
 	flags co.LR,
+) (HICON, error) {
+	return createIconFromResourceEx(resBits, true, fmtVersion, szDesired, flags)
+}
+
+func createIconFromResourceEx(
+	resBits []byte,
+	isIcon bool,
+	fmtVersion uint32,
+	szDesired SIZE,
+	flags co.LR,
 ) (HICON, error) {
 	ret, _, err := syscall.SyscallN(
 		dll.User.Load(&_user_CreateIconFromResourceEx, "CreateIconFromResourceEx"),
 		uintptr(unsafe.Pointer(&resBits[0])),
 		uintptr(uint32(len(resBits))),
-		1,
+		utl.BoolToUintptr(isIcon),
 		uintptr(fmtVersion),
 		uintptr(szDesired.Cx),
 		uintptr(szDesired.Cy),
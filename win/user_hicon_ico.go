@@ -0,0 +1,87 @@
+//go:build windows
+
+package win
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/ico"
+)
+
+// Creates an icon from the image, with [CreateIconFromResourceEx]. The image
+// is scaled by the system to the given size in pixels, which should take the
+// DPI into account, like ui.DpiX(32).
+//
+// ⚠️ You must defer [HICON.DestroyIcon].
+//
+// Example:
+//
+//	img, _ := png.Decode(bytes.NewReader(pngData))
+//	hIcon, _ := win.CreateIconFromImage(img, 32)
+//	defer hIcon.DestroyIcon()
+func CreateIconFromImage(img image.Image, size int) (HICON, error) {
+	data, err := (&ico.Entry{Image: img}).Encode()
+	if err != nil {
+		return HICON(0), fmt.Errorf("CreateIconFromImage: %w", err)
+	}
+	return CreateIconFromResourceEx(data, 0x0003_0000, SIZE{Cx: int32(size), Cy: int32(size)}, co.LR_DEFAULTCOLOR)
+}
+
+// Creates an icon from the entry of the [ico.File] which best fits the given
+// size in pixels, as chosen by [ico.File.Nearest], scaling it if needed. The
+// size should take the DPI into account, like ui.DpiX(32).
+//
+// ⚠️ You must defer [HICON.DestroyIcon].
+//
+// Example:
+//
+//	//go:embed app.ico
+//	var appIco []byte
+//
+//	file, _ := ico.Parse(appIco)
+//	hIcon, _ := win.CreateIconFromIco(file, 32)
+//	defer hIcon.DestroyIcon()
+func CreateIconFromIco(file *ico.File, size int) (HICON, error) {
+	entry := file.Nearest(size)
+	if entry == nil {
+		return HICON(0), fmt.Errorf("CreateIconFromIco: %w: no entries", ico.ErrFormat)
+	}
+	data, err := entry.Encode()
+	if err != nil {
+		return HICON(0), fmt.Errorf("CreateIconFromIco: %w", err)
+	}
+	return CreateIconFromResourceEx(data, 0x0003_0000, SIZE{Cx: int32(size), Cy: int32(size)}, co.LR_DEFAULTCOLOR)
+}
+
+// Creates a cursor from the entry of the [ico.File] which best fits the given
+// size in pixels, as chosen by [ico.File.Nearest], scaling it and its hotspot
+// if needed. The size should take the DPI into account, like ui.DpiX(32).
+//
+// ⚠️ You must defer [HCURSOR.DestroyCursor].
+//
+// Example:
+//
+//	//go:embed hand.cur
+//	var handCur []byte
+//
+//	file, _ := ico.Parse(handCur)
+//	hCursor, _ := win.CreateCursorFromIco(file, 32)
+//	defer hCursor.DestroyCursor()
+func CreateCursorFromIco(file *ico.File, size int) (HCURSOR, error) {
+	entry := file.Nearest(size)
+	if entry == nil {
+		return HCURSOR(0), fmt.Errorf("CreateCursorFromIco: %w: no entries", ico.ErrFormat)
+	}
+	img, err := entry.Encode()
+	if err != nil {
+		return HCURSOR(0), fmt.Errorf("CreateCursorFromIco: %w", err)
+	}
+
+	data := binary.LittleEndian.AppendUint16(nil, entry.HotspotX) // RT_CURSOR data starts with the hotspot
+	data = binary.LittleEndian.AppendUint16(data, entry.HotspotY)
+	data = append(data, img...)
+	return CreateCursorFromResourceEx(data, 0x0003_0000, SIZE{Cx: int32(size), Cy: int32(size)}, co.LR_DEFAULTCOLOR)
+}
@@ -33,6 +33,30 @@ func (hInst HINSTANCE) CreateCursor(hotSpot POINT, sz SIZE, andPlane, xorPlane [
 
 var _user_CreateCursor *syscall.Proc
 
+// [CreateDialogIndirectParam] function.
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (hInst HINSTANCE) CreateDialogIndirectParam(
+	pTemplate *DLGTEMPLATE,
+	hwndParent HWND,
+	dialogFunc uintptr,
+	dwInitParam LPARAM,
+) (HWND, error) {
+	ret, _, err := syscall.SyscallN(
+		dll.User.Load(&_user_CreateDialogIndirectParamW, "CreateDialogIndirectParamW"),
+		uintptr(hInst),
+		uintptr(unsafe.Pointer(pTemplate)),
+		uintptr(hwndParent),
+		dialogFunc,
+		uintptr(dwInitParam))
+	if ret == 0 {
+		return HWND(0), co.ERROR(err)
+	}
+	return HWND(ret), nil
+}
+
+var _user_CreateDialogIndirectParamW *syscall.Proc
+
 // [CreateDialogParam] function.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
@@ -100,12 +100,19 @@ type File struct {
 
 // Constructs a new [File] by calling [CreateFile].
 //
+// The returned error can be checked with errors.Is against fs.ErrNotExist,
+// fs.ErrPermission and fs.ErrExist.
+//
 // ⚠️ You must defer [File.Close].
 //
 // Example:
 //
-//	f, _ := win.FileOpen("C:\\Temp\\foo.txt", co.FOPEN_RW_OPEN_OR_CREATE)
-//	defer f.Close()
+//	f, err := win.FileOpen("C:\\Temp\\foo.txt", co.FOPEN_READ_EXISTING)
+//	if errors.Is(err, fs.ErrNotExist) {
+//		println("File not found.")
+//	} else if err == nil {
+//		defer f.Close()
+//	}
 func FileOpen(filePath string, desiredAccess co.FOPEN) (*File, error) {
 	var access co.GENERIC
 	var share co.FILE_SHARE
@@ -538,6 +545,9 @@ func (me *FileMap) Size() int {
 }
 
 // High-level abstraction for .ini file contents, loaded with [IniLoad].
+//
+// Deprecated: Comments and blank lines are not preserved. Use the ini package,
+// which keeps the file as it was written.
 type Ini struct {
 	// Path to the .ini file that has been loaded. If you want to save the
 	// .ini somewhere else, you may change this value.
@@ -567,6 +577,8 @@ type IniEntry struct {
 
 // Constructs a new [Ini] object by reading an .ini file, parsing its contents.
 //
+// Deprecated: Use ini.Load.
+//
 // Example:
 //
 //	ini, _ := win.IniLoad("C:\\Temp\\foo.ini")
@@ -593,11 +605,10 @@ func IniLoad(iniPath string) (*Ini, error) {
 				Name:    strings.TrimSpace(line[1 : len(line)-1]),
 				Entries: make([]IniEntry, 0),
 			}
-		} else if curSection.Name != "" {
-			keyVal := strings.SplitN(line, "=", 2)
+		} else if key, val, hasEq := strings.Cut(line, "="); hasEq && curSection.Name != "" {
 			curSection.Entries = append(curSection.Entries, IniEntry{
-				Key:   strings.TrimSpace(keyVal[0]),
-				Value: strings.TrimSpace(keyVal[1]),
+				Key:   strings.TrimSpace(key),
+				Value: strings.TrimSpace(val),
 			})
 		}
 	}
@@ -946,10 +957,11 @@ func PathIsHidden(path string) bool {
 		(attr&co.FILE_ATTRIBUTE_HIDDEN) != 0
 }
 
-// Sorts the paths alphabetically, case insensitive, in-place.
+// Sorts the paths in-place, in the same order of Windows Explorer, as
+// [wstr.CmpLogical] does.
 func PathSort(paths []string) {
 	sort.Slice(paths, func(a, b int) bool {
-		return strings.ToUpper(paths[a]) < strings.ToUpper(paths[b])
+		return wstr.CmpLogical(paths[a], paths[b]) < 0
 	})
 }
 
@@ -0,0 +1,158 @@
+package winerr
+
+//go:generate go run ../internal/errgen
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed data/errors.tsv
+var errorsTsv []byte
+
+// An error code in the catalog.
+type Entry struct {
+	Code    uint32 // Numeric value.
+	Name    string // Symbolic name, like "ERROR_FILE_NOT_FOUND" or "E_NOINTERFACE".
+	Message string // English description; may be empty.
+}
+
+var (
+	catalogOnce sync.Once
+	win32Codes  map[uint32]Entry
+	hrCodes     map[uint32]Entry
+	facilities  map[uint32]string
+	byName      map[string]Entry
+)
+
+// Parses the embedded catalog, once.
+func loadCatalog() {
+	catalogOnce.Do(func() {
+		win32Codes = make(map[uint32]Entry, 2700)
+		hrCodes = make(map[uint32]Entry, 300)
+		facilities = make(map[uint32]string, 150)
+		byName = make(map[string]Entry, 3000)
+
+		scanner := bufio.NewScanner(bytes.NewReader(errorsTsv))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" || line[0] == '#' {
+				continue
+			}
+			fields := strings.SplitN(line, "\t", 4)
+			code, err := strconv.ParseUint(fields[1], 0, 32)
+			if err != nil {
+				panic(err) // embedded, should never happen
+			}
+			entry := Entry{uint32(code), fields[2], fields[3]}
+
+			switch fields[0] {
+			case "E":
+				win32Codes[entry.Code] = entry
+				byName[entry.Name] = entry
+			case "H":
+				hrCodes[entry.Code] = entry
+				byName[entry.Name] = entry
+			case "F":
+				facilities[entry.Code] = entry.Name
+			}
+		}
+	})
+}
+
+// Returns the Win32 error code, which is a co.ERROR, like the ones returned
+// by GetLastError.
+//
+// Example:
+//
+//	e, _ := winerr.Win32(2)
+//	println(e.Name, e.Message) // ERROR_FILE_NOT_FOUND The system cannot find the file specified.
+func Win32(code uint32) (Entry, bool) {
+	loadCatalog()
+	entry, ok := win32Codes[code]
+	return entry, ok
+}
+
+// Returns the HRESULT code. If the code is not in the catalog, but it has
+// FACILITY_WIN32, the Win32 error is returned with the HRESULT code, and a name
+// like "HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND)".
+//
+// Example:
+//
+//	e, _ := winerr.Hresult(0x8007_0002)
+//	println(e.Name) // HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND)
+func Hresult(hr uint32) (Entry, bool) {
+	loadCatalog()
+	if entry, ok := hrCodes[hr]; ok {
+		return entry, true
+	}
+
+	const facilityWin32 = 7
+	if hr&0x8000_0000 != 0 && (hr>>16)&0x1fff == facilityWin32 {
+		if entry, ok := win32Codes[hr&0xffff]; ok {
+			return Entry{hr, "HRESULT_FROM_WIN32(" + entry.Name + ")", entry.Message}, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Returns the name of the facility of the HRESULT code, like "FACILITY_ITF".
+func Facility(hr uint32) (string, bool) {
+	loadCatalog()
+	name, ok := facilities[(hr>>16)&0x1fff]
+	return name, ok
+}
+
+// Returns the Win32 or HRESULT code with the given symbolic name. The
+// "HRESULT_" prefix used by the windigo constants is accepted.
+//
+// Example:
+//
+//	e, _ := winerr.ByName("HRESULT_E_NOINTERFACE")
+//	println(e.Code) // 2147500034
+func ByName(name string) (Entry, bool) {
+	loadCatalog()
+	if entry, ok := byName[name]; ok {
+		return entry, true
+	}
+	entry, ok := byName[strings.TrimPrefix(name, "HRESULT_")]
+	return entry, ok
+}
+
+// Returns a line describing the code, for logs. Codes up to 0xffff are taken
+// as Win32 error codes, and written in decimal; the others are taken as HRESULT
+// codes, and written in hex, with the facility if the code is not in the
+// catalog.
+//
+// Example:
+//
+//	println(winerr.Format(5))           // 5 ERROR_ACCESS_DENIED: Access is denied.
+//	println(winerr.Format(0x8004_1234)) // 0x80041234 (FACILITY_ITF)
+func Format(code uint32) string {
+	if code <= 0xffff {
+		entry, ok := Win32(code)
+		return format(strconv.FormatUint(uint64(code), 10), entry, ok, "")
+	}
+
+	entry, ok := Hresult(code)
+	facility, _ := Facility(code)
+	return format(fmt.Sprintf("0x%08x", code), entry, ok, facility)
+}
+
+func format(num string, entry Entry, ok bool, facility string) string {
+	switch {
+	case !ok && facility != "":
+		return num + " (" + facility + ")"
+	case !ok:
+		return num
+	case entry.Message == "":
+		return num + " " + entry.Name
+	default:
+		return num + " " + entry.Name + ": " + entry.Message
+	}
+}
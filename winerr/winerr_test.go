@@ -0,0 +1,105 @@
+package winerr_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/winerr"
+)
+
+func ExampleWin32() {
+	e, ok := winerr.Win32(2)
+	fmt.Println(ok, e.Name, e.Message)
+	_, ok = winerr.Win32(0xfffe)
+	fmt.Println(ok)
+	// Output:
+	// true ERROR_FILE_NOT_FOUND The system cannot find the file specified.
+	// false
+}
+
+func ExampleHresult() {
+	for _, hr := range []uint32{0x8000_4002, 0x8007_0002, 0x8898_2f04, 0x8004_1234} {
+		e, ok := winerr.Hresult(hr)
+		fmt.Println(ok, e.Name)
+	}
+	// Output:
+	// true E_NOINTERFACE
+	// true HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND)
+	// true WINCODEC_ERR_WRONGSTATE
+	// false
+}
+
+func ExampleByName() {
+	for _, name := range []string{"ERROR_ACCESS_DENIED", "HRESULT_E_NOINTERFACE", "DXGI_ERROR_INVALID_CALL"} {
+		e, _ := winerr.ByName(name)
+		fmt.Printf("0x%08x\n", e.Code)
+	}
+	// Output:
+	// 0x00000005
+	// 0x80004002
+	// 0x887a0001
+}
+
+func ExampleFormat() {
+	for _, code := range []uint32{5, 1460, 0xfffe, 0x8007_0005, 0x8004_1234} {
+		fmt.Println(winerr.Format(code))
+	}
+	// Output:
+	// 5 ERROR_ACCESS_DENIED: Access is denied.
+	// 1460 ERROR_TIMEOUT: This operation returned because the timeout period expired.
+	// 65534
+	// 0x80070005 E_ACCESSDENIED: Access is denied.
+	// 0x80041234 (FACILITY_ITF)
+}
+
+// Every co.ERROR constant must be in the catalog, with its message.
+func TestWin32Messages(t *testing.T) {
+	tsv, err := os.ReadFile("data/errors.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	isFromSystem := strings.Contains(string(tsv), "\n# messages: FormatMessage, en-US\n")
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "../co/err_error.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numConsts, noMessage := 0, []string{}
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vspec := spec.(*ast.ValueSpec)
+			if ident, ok := vspec.Type.(*ast.Ident); !ok || ident.Name != "ERROR" {
+				continue
+			}
+			name := vspec.Names[0].Name
+			numConsts++
+			if e, ok := winerr.ByName(name); !ok {
+				t.Errorf("%s: not in the catalog", name)
+			} else if e.Message == "" {
+				noMessage = append(noMessage, name)
+			}
+		}
+	}
+
+	if numConsts < 2000 {
+		t.Fatalf("Only %d ERROR constants found", numConsts)
+	} else if len(noMessage) > 0 && !isFromSystem {
+		t.Skipf("%d of %d ERROR constants have no message, because the catalog "+
+			"was not generated on Windows; run go generate ./winerr there.",
+			len(noMessage), numConsts)
+	}
+	for _, name := range noMessage {
+		t.Errorf("%s: no message", name)
+	}
+}
@@ -0,0 +1,16 @@
+// This package is an offline catalog of Windows error codes, with the symbolic
+// names and English messages of the Win32 error codes (co.ERROR) and the
+// HRESULT codes (co.HRESULT) known by windigo.
+//
+// Since the catalog is embedded, it works on any OS, so it can be used to
+// decode the numeric codes found in logs. On Windows, the String methods of
+// co.ERROR and co.HRESULT use this catalog, falling back to the OS message.
+//
+// The catalog is generated by internal/errgen: the names come from the
+// constants, and the messages from FormatMessage in en-US, so it must be
+// regenerated on Windows with go generate.
+//
+// Example:
+//
+//	println(winerr.Format(0x8007_0005)) // 0x80070005 E_ACCESSDENIED: Access is denied.
+package winerr
@@ -0,0 +1,224 @@
+package wstr
+
+//go:generate python3 cpdata/gen.py
+
+import (
+	"embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:embed cpdata/*.bin
+var cpData embed.FS
+
+// Returned by [GetCodePage] when the code page is not supported.
+var ErrCodePage = errors.New("unsupported code page")
+
+// A legacy Windows [code page], which converts text without calling
+// MultiByteToWideChar and WideCharToMultiByte, so it works on any OS.
+//
+// Obtain it with [GetCodePage].
+//
+// [code page]: https://learn.microsoft.com/en-us/windows/win32/intl/code-page-identifiers
+type CodePage struct {
+	number  uint16
+	name    string
+	defRune rune // used by Decode for unmapped bytes
+
+	once    sync.Once
+	single  [256]uint16       // 0xfffe for lead bytes, 0xffff for undefined
+	doubles [256]*[256]uint16 // indexed by lead byte
+	encode  map[rune]uint16   // bytes, lead byte in the high byte if two
+	bestFit map[rune]rune
+}
+
+var codePages = map[uint16]*CodePage{
+	437:  {number: 437, name: "OEM United States", defRune: '?'},
+	850:  {number: 850, name: "OEM Multilingual Latin 1", defRune: '?'},
+	852:  {number: 852, name: "OEM Latin 2", defRune: '?'},
+	866:  {number: 866, name: "OEM Russian", defRune: '?'},
+	874:  {number: 874, name: "ANSI/OEM Thai", defRune: '?'},
+	932:  {number: 932, name: "ANSI/OEM Japanese (Shift-JIS)", defRune: '・'},
+	936:  {number: 936, name: "ANSI/OEM Simplified Chinese (GBK)", defRune: '?'},
+	949:  {number: 949, name: "ANSI/OEM Korean (Unified Hangul Code)", defRune: '?'},
+	950:  {number: 950, name: "ANSI/OEM Traditional Chinese (Big5)", defRune: '?'},
+	1250: {number: 1250, name: "ANSI Central European", defRune: '?'},
+	1251: {number: 1251, name: "ANSI Cyrillic", defRune: '?'},
+	1252: {number: 1252, name: "ANSI Latin 1", defRune: '?'},
+	1253: {number: 1253, name: "ANSI Greek", defRune: '?'},
+	1254: {number: 1254, name: "ANSI Turkish", defRune: '?'},
+	1255: {number: 1255, name: "ANSI Hebrew", defRune: '?'},
+	1256: {number: 1256, name: "ANSI Arabic", defRune: '?'},
+	1257: {number: 1257, name: "ANSI Baltic", defRune: '?'},
+	1258: {number: 1258, name: "ANSI/OEM Vietnamese", defRune: '?'},
+}
+
+// Returns the code page with the given number, which is the same of
+// co.CP constants.
+//
+// Example:
+//
+//	cp, _ := wstr.GetCodePage(1252)
+//	s := cp.Decode([]byte{0x80, 0x31}) // "€1"
+func GetCodePage(number uint16) (*CodePage, error) {
+	if cp, ok := codePages[number]; ok {
+		return cp, nil
+	}
+	return nil, fmt.Errorf("GetCodePage: %w: %d", ErrCodePage, number)
+}
+
+// Returns the numbers of all supported code pages, sorted.
+func CodePages() []uint16 {
+	numbers := make([]uint16, 0, len(codePages))
+	for number := range codePages {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(a, b int) bool { return numbers[a] < numbers[b] })
+	return numbers
+}
+
+// Returns the code page number.
+func (me *CodePage) Number() uint16 {
+	return me.number
+}
+
+// Returns the code page name, like "ANSI Latin 1".
+func (me *CodePage) Name() string {
+	return me.name
+}
+
+// Loads the embedded tables, once.
+func (me *CodePage) load() {
+	me.once.Do(func() {
+		data, err := cpData.ReadFile(fmt.Sprintf("cpdata/cp%d.bin", me.number))
+		if err != nil {
+			panic(err) // embedded, should never happen
+		}
+		next := func() uint16 {
+			n := binary.LittleEndian.Uint16(data)
+			data = data[2:]
+			return n
+		}
+
+		me.encode = make(map[rune]uint16, 256)
+		for b := range me.single {
+			me.single[b] = next()
+		}
+		for b := range me.single {
+			if me.single[b] < 0xfffe {
+				if _, has := me.encode[rune(me.single[b])]; !has {
+					me.encode[rune(me.single[b])] = uint16(b)
+				}
+			}
+		}
+		for lead := range me.single {
+			if me.single[lead] != 0xfffe {
+				continue
+			}
+			row := &[256]uint16{}
+			for trail := range row {
+				row[trail] = next()
+				if row[trail] != 0xffff {
+					if _, has := me.encode[rune(row[trail])]; !has {
+						me.encode[rune(row[trail])] = uint16(lead)<<8 | uint16(trail)
+					}
+				}
+			}
+			me.doubles[lead] = row
+		}
+
+		for n := next(); n > 0; n-- { // overrides
+			ch := next()
+			me.encode[rune(ch)] = next()
+		}
+
+		numBestFit := next()
+		me.bestFit = make(map[rune]rune, numBestFit)
+		for ; numBestFit > 0; numBestFit-- {
+			ch := next()
+			me.bestFit[rune(ch)] = rune(next())
+		}
+	})
+}
+
+// Converts text in this code page to a Go string.
+//
+// As MultiByteToWideChar does, bytes without a mapping are replaced by the
+// default character of the code page, which is "?" for most of them.
+//
+// Example:
+//
+//	cp, _ := wstr.GetCodePage(437)
+//	s := cp.Decode([]byte{0xc9, 0xcd, 0xbb}) // "╔═╗"
+func (me *CodePage) Decode(data []byte) string {
+	me.load()
+	out := make([]rune, 0, len(data))
+
+	for i := 0; i < len(data); i++ {
+		ch := me.single[data[i]]
+		if ch == 0xfffe { // lead byte
+			if i+1 == len(data) {
+				out = append(out, me.defRune) // truncated
+				break
+			}
+			trail := data[i+1]
+			ch = me.doubles[data[i]][trail]
+			if ch != 0xffff || trail >= 0x40 { // invalid trail bytes are not consumed
+				i++
+			}
+		}
+		if ch == 0xffff {
+			out = append(out, me.defRune)
+		} else {
+			out = append(out, rune(ch))
+		}
+	}
+	return string(out)
+}
+
+// Converts a Go string to text in this code page.
+//
+// Characters which don't exist in the code page are replaced by "?". If
+// bestFit is true, they are first replaced by a similar character, when
+// possible, as WideCharToMultiByte does without WC_NO_BEST_FIT_CHARS flag: "Ā"
+// becomes "A", "“" becomes a quote, fullwidth "Ａ" becomes "A", and so on.
+//
+// Returns false if any character was replaced.
+//
+// Example:
+//
+//	cp, _ := wstr.GetCodePage(1252)
+//	data, lossless := cp.Encode("Łódź", true) // "Lódz", false
+func (me *CodePage) Encode(s string, bestFit bool) ([]byte, bool) {
+	me.load()
+	out := make([]byte, 0, len(s))
+	lossless := true
+
+	for i, ch := range s {
+		bytes, ok := me.encode[ch]
+		if ch == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+				ok = false // invalid UTF-8
+			}
+		}
+		if !ok {
+			lossless = false
+			if fit, hasFit := me.bestFit[ch]; bestFit && hasFit {
+				bytes = me.encode[fit]
+			} else {
+				bytes = '?'
+			}
+		}
+
+		if bytes > 0xff {
+			out = append(out, byte(bytes>>8), byte(bytes))
+		} else {
+			out = append(out, byte(bytes))
+		}
+	}
+	return out, lossless
+}
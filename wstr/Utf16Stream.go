@@ -0,0 +1,392 @@
+package wstr
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Byte order of an UTF-16 stream.
+type Utf16Order uint8
+
+const (
+	Utf16LE Utf16Order = iota // Little-endian, used by Windows.
+	Utf16BE                   // Big-endian.
+)
+
+// Returns the byte order as an [binary.ByteOrder].
+func (o Utf16Order) binary() binary.ByteOrder {
+	if o == Utf16BE {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
+// How [Utf16Reader] and [Utf16Writer] handle lone surrogates – an UTF-16 high
+// surrogate not followed by a low one, or a low surrogate alone – which are
+// invalid, but frequently found in Windows file names.
+type LoneSurrogate uint8
+
+const (
+	// Replaced by U+FFFD, the Unicode replacement character.
+	LoneReplace LoneSurrogate = iota
+	// Stops with an error wrapping [ErrLoneSurrogate].
+	LoneError
+	// Kept as a 3-byte sequence in [WTF-8], which is converted back by
+	// [Utf16Writer], so the original UTF-16 is preserved.
+	//
+	// [WTF-8]: https://simonsapin.github.io/wtf-8/
+	LoneKeep
+)
+
+// Returned by [Utf16Reader] and [Utf16Writer] on a lone surrogate, when
+// [LoneError] is used.
+var ErrLoneSurrogate = errors.New("lone surrogate")
+
+// Returned by [Utf16Reader] when the stream ends with an odd byte, and by
+// [Utf16Writer] on invalid UTF-8, when [LoneError] is used. Otherwise they are
+// replaced by U+FFFD.
+var ErrInvalidEncoding = errors.New("invalid encoding")
+
+const _UTF16_CHUNK = 32 * 1024 // arbitrary
+
+// An [io.Reader] which reads UTF-16 from another reader, and returns UTF-8. The
+// conversion is made in chunks, so the stream can be arbitrarily large.
+//
+// Create it with [NewUtf16Reader].
+type Utf16Reader struct {
+	src      io.Reader
+	order    Utf16Order
+	lone     LoneSurrogate
+	sniffed  bool
+	raw      []byte // bytes read from src, not converted yet
+	out      []byte // converted UTF-8, not returned yet
+	high     uint16 // pending high surrogate, or zero
+	offset   int64  // position in src of raw[0]
+	err      error  // error to be returned once out is empty
+	srcEnded bool
+}
+
+// Creates a new [Utf16Reader]. If the stream starts with a BOM, it determines
+// the byte order and it's skipped; otherwise the given order is used.
+//
+// Example:
+//
+//	fin, _ := os.Open("export.reg")
+//	defer fin.Close()
+//
+//	scanner := bufio.NewScanner(
+//		wstr.NewUtf16Reader(fin, wstr.Utf16LE, wstr.LoneReplace))
+//	for scanner.Scan() {
+//		println(scanner.Text())
+//	}
+func NewUtf16Reader(src io.Reader, order Utf16Order, lone LoneSurrogate) *Utf16Reader {
+	return &Utf16Reader{
+		src:   src,
+		order: order,
+		lone:  lone,
+		raw:   make([]byte, 0, _UTF16_CHUNK),
+	}
+}
+
+// Returns the byte order of the stream, which is known after the first call to
+// [Utf16Reader.Read].
+func (me *Utf16Reader) Order() Utf16Order {
+	return me.order
+}
+
+// Implements [io.Reader].
+func (me *Utf16Reader) Read(p []byte) (int, error) {
+	for len(me.out) == 0 {
+		if me.err != nil {
+			return 0, me.err
+		} else if me.srcEnded {
+			me.finish()
+			if len(me.out) == 0 && me.err == nil {
+				me.err = io.EOF
+			}
+			continue
+		}
+		me.fill()
+		me.convert()
+	}
+
+	n := copy(p, me.out)
+	me.out = me.out[n:]
+	return n, nil
+}
+
+// Reads the next chunk from the source.
+func (me *Utf16Reader) fill() {
+	if len(me.raw) == cap(me.raw) { // should not happen, at most 1 byte is left
+		return
+	}
+	n, err := me.src.Read(me.raw[len(me.raw):cap(me.raw)])
+	me.raw = me.raw[:len(me.raw)+n]
+	if err == io.EOF {
+		me.srcEnded = true
+	} else if err != nil {
+		me.err = err
+	}
+}
+
+// Converts all complete code units in raw to UTF-8 in out.
+func (me *Utf16Reader) convert() {
+	if !me.sniffed {
+		if len(me.raw) < 2 && !me.srcEnded {
+			return // wait for more bytes
+		}
+		me.sniffed = true
+		if len(me.raw) >= 2 {
+			if me.raw[0] == 0xff && me.raw[1] == 0xfe {
+				me.order = Utf16LE
+				me.consume(2)
+			} else if me.raw[0] == 0xfe && me.raw[1] == 0xff {
+				me.order = Utf16BE
+				me.consume(2)
+			}
+		}
+	}
+
+	bo := me.order.binary()
+	out := me.out
+	i := 0
+	for ; i+1 < len(me.raw); i += 2 {
+		unit := bo.Uint16(me.raw[i:])
+
+		if me.high != 0 {
+			if unit >= 0xdc00 && unit < 0xe000 { // low surrogate completes the pair
+				out = utf8.AppendRune(out, utf16.DecodeRune(rune(me.high), rune(unit)))
+				me.high = 0
+				continue
+			}
+			if out = me.appendLone(out, me.high, me.offset+int64(i)-2); me.err != nil {
+				break
+			}
+			me.high = 0
+		}
+
+		if unit >= 0xd800 && unit < 0xdc00 { // high surrogate, may be completed in the next chunk
+			me.high = unit
+		} else if unit >= 0xdc00 && unit < 0xe000 {
+			if out = me.appendLone(out, unit, me.offset+int64(i)); me.err != nil {
+				break
+			}
+		} else {
+			out = utf8.AppendRune(out, rune(unit))
+		}
+	}
+	me.out = out
+	me.consume(i)
+}
+
+// Called when the source ended, handles the pending bytes.
+func (me *Utf16Reader) finish() {
+	me.convert() // sniffing may be pending
+	if me.high != 0 {
+		me.out = me.appendLone(me.out, me.high, me.offset-2)
+		me.high = 0
+	}
+	if len(me.raw) > 0 && me.err == nil { // odd byte
+		if me.lone == LoneError {
+			me.err = fmt.Errorf("%w: odd byte at %d", ErrInvalidEncoding, me.offset)
+		} else {
+			me.out = utf8.AppendRune(me.out, utf8.RuneError)
+		}
+		me.consume(len(me.raw))
+	}
+}
+
+// Removes n bytes from the beginning of raw.
+func (me *Utf16Reader) consume(n int) {
+	rest := copy(me.raw, me.raw[n:])
+	me.raw = me.raw[:rest]
+	me.offset += int64(n)
+}
+
+func (me *Utf16Reader) appendLone(out []byte, unit uint16, offset int64) []byte {
+	switch me.lone {
+	case LoneError:
+		me.err = fmt.Errorf("%w: 0x%04x at %d", ErrLoneSurrogate, unit, offset)
+		return out
+	case LoneKeep:
+		return append(out, // WTF-8, the same 3-byte sequence of a valid code point
+			0xe0|byte(unit>>12), 0x80|byte(unit>>6)&0x3f, 0x80|byte(unit)&0x3f)
+	default:
+		return utf8.AppendRune(out, utf8.RuneError)
+	}
+}
+
+// An [io.Writer] which receives UTF-8, and writes UTF-16 to another writer.
+// UTF-8 sequences split between calls to [Utf16Writer.Write] are handled
+// correctly.
+//
+// Create it with [NewUtf16Writer], and call [Utf16Writer.Flush] when done.
+type Utf16Writer struct {
+	dest    io.Writer
+	order   Utf16Order
+	lone    LoneSurrogate
+	needBom bool
+	pending []byte // incomplete UTF-8 sequence from the previous write
+	work    []byte // pending plus the bytes being converted
+	buf     []byte // output buffer
+	offset  int64  // position in the input of pending[0]
+}
+
+// Creates a new [Utf16Writer]. If writeBom is true, the BOM is written before
+// the text.
+//
+// Example:
+//
+//	fout, _ := os.Create("export.reg")
+//	defer fout.Close()
+//
+//	w := wstr.NewUtf16Writer(fout, wstr.Utf16LE, true, wstr.LoneReplace)
+//	fmt.Fprintf(w, "Windows Registry Editor Version 5.00\r\n")
+//	w.Flush()
+func NewUtf16Writer(dest io.Writer, order Utf16Order, writeBom bool, lone LoneSurrogate) *Utf16Writer {
+	return &Utf16Writer{
+		dest:    dest,
+		order:   order,
+		lone:    lone,
+		needBom: writeBom,
+		pending: make([]byte, 0, utf8.UTFMax),
+		buf:     make([]byte, 0, _UTF16_CHUNK),
+	}
+}
+
+// Implements [io.Writer]. Returns len(p) if no error occurred, even if the last
+// bytes are an incomplete UTF-8 sequence, which is kept until the next call.
+func (me *Utf16Writer) Write(p []byte) (int, error) {
+	consumed := 0
+	for len(p) > 0 {
+		take := len(p)
+		if take > _UTF16_CHUNK/2 {
+			take = _UTF16_CHUNK / 2
+		}
+		nPending := len(me.pending)
+		me.work = append(append(me.work[:0], me.pending...), p[:take]...)
+
+		n, err := me.encode(me.work, false)
+		if err != nil {
+			if n > nPending {
+				consumed += n - nPending
+			}
+			return consumed, err
+		}
+		me.offset += int64(n)
+		me.pending = append(me.pending[:0], me.work[n:]...) // at most 3 bytes
+		p = p[take:]
+		consumed += take
+
+		if err := me.flushBuf(); err != nil {
+			return consumed, err
+		}
+	}
+	return consumed, nil
+}
+
+// Writes any pending bytes, including the BOM if nothing was written yet. An
+// incomplete UTF-8 sequence at the end is handled as invalid.
+//
+// Does not close the underlying writer.
+func (me *Utf16Writer) Flush() error {
+	if len(me.pending) > 0 {
+		if _, err := me.encode(me.pending, true); err != nil {
+			return err
+		}
+		me.pending = me.pending[:0]
+	}
+	if me.needBom {
+		me.appendUnit(0xfeff)
+		me.needBom = false
+	}
+	return me.flushBuf()
+}
+
+// Converts the UTF-8 input to UTF-16 in buf, returning how many bytes were
+// consumed. An incomplete sequence at the end is left unconsumed, unless atEnd.
+func (me *Utf16Writer) encode(input []byte, atEnd bool) (int, error) {
+	if me.needBom {
+		me.appendUnit(0xfeff)
+		me.needBom = false
+	}
+
+	i := 0
+	for i < len(input) {
+		if input[i] < utf8.RuneSelf {
+			me.appendUnit(uint16(input[i]))
+			i++
+			continue
+		}
+
+		if unit, ok := wtf8Surrogate(input[i:]); ok {
+			switch me.lone {
+			case LoneError:
+				return i, fmt.Errorf("%w: 0x%04x at %d", ErrLoneSurrogate, unit, me.offset+int64(i))
+			case LoneKeep:
+				me.appendUnit(unit)
+			default:
+				me.appendUnit(utf8.RuneError)
+			}
+			i += 3
+			continue
+		}
+
+		if !atEnd && (!utf8.FullRune(input[i:]) || isPartialWtf8Surrogate(input[i:])) {
+			break // incomplete sequence, wait for more bytes
+		}
+
+		r, size := utf8.DecodeRune(input[i:])
+		if r == utf8.RuneError && size <= 1 { // invalid byte
+			if me.lone == LoneError {
+				return i, fmt.Errorf("%w: UTF-8 byte 0x%02x at %d",
+					ErrInvalidEncoding, input[i], me.offset+int64(i))
+			}
+			size = 1
+		}
+		if r >= 0x1_0000 {
+			r1, r2 := utf16.EncodeRune(r)
+			me.appendUnit(uint16(r1))
+			me.appendUnit(uint16(r2))
+		} else {
+			me.appendUnit(uint16(r))
+		}
+		i += size
+	}
+	return i, nil
+}
+
+// If the bytes start with a surrogate encoded as WTF-8, returns it.
+func wtf8Surrogate(b []byte) (uint16, bool) {
+	if len(b) >= 3 && b[0] == 0xed && b[1] >= 0xa0 && b[1] <= 0xbf && b[2] >= 0x80 && b[2] <= 0xbf {
+		return 0xd000 | uint16(b[1]&0x3f)<<6 | uint16(b[2]&0x3f), true
+	}
+	return 0, false
+}
+
+// Tells whether the bytes are the beginning of a surrogate encoded as WTF-8,
+// which [utf8.FullRune] considers invalid, thus complete.
+func isPartialWtf8Surrogate(b []byte) bool {
+	return len(b) < 3 && b[0] == 0xed && (len(b) == 1 || (b[1] >= 0xa0 && b[1] <= 0xbf))
+}
+
+func (me *Utf16Writer) appendUnit(unit uint16) {
+	if me.order == Utf16BE {
+		me.buf = binary.BigEndian.AppendUint16(me.buf, unit)
+	} else {
+		me.buf = binary.LittleEndian.AppendUint16(me.buf, unit)
+	}
+}
+
+func (me *Utf16Writer) flushBuf() error {
+	if len(me.buf) == 0 {
+		return nil
+	}
+	_, err := me.dest.Write(me.buf)
+	me.buf = me.buf[:0]
+	return err
+}
@@ -0,0 +1,198 @@
+package wstr
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Compares two strings the way Windows Explorer sorts file names, with the
+// same semantics of [StrCmpLogicalW], but without calling the Windows API:
+//   - runs of digits are compared as numbers, so "file2" comes before
+//     "file10", regardless of their length;
+//   - case is ignored;
+//   - punctuation and symbols come before digits, which come before letters;
+//   - accented letters are sorted along with their base letters, and accents
+//     only decide when the strings are otherwise equal, so "resume" <
+//     "résumé" < "resumes";
+//   - hyphens and apostrophes are ignored, unless the strings are otherwise
+//     equal, so "coop" < "co-op" < "coops".
+//
+// Returns -1, 0 or 1.
+//
+// Example:
+//
+//	names := []string{"file10.txt", "File2.txt", "file1.txt"}
+//	sort.Slice(names, func(a, b int) bool {
+//		return wstr.CmpLogical(names[a], names[b]) < 0
+//	}) // file1.txt, File2.txt, file10.txt
+//
+// [StrCmpLogicalW]: https://learn.microsoft.com/en-us/windows/win32/api/shlwapi/nf-shlwapi-strcmplogicalw
+func CmpLogical(a, b string) int {
+	for level := 1; level <= 3; level++ {
+		if c := cmpLogicalLevel(a, b, level); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Compares the strings at one collation level: 1 for the base characters and
+// numeric values, 2 for the accents and leading zeros, 3 for the ignorable
+// characters. Each level assumes the previous ones are equal.
+func cmpLogicalLevel(a, b string, level int) int {
+	for {
+		if level < 3 {
+			a = strings.TrimLeftFunc(a, isLogicalIgnorable)
+			b = strings.TrimLeftFunc(b, isLogicalIgnorable)
+		}
+		if a == "" || b == "" {
+			break
+		}
+
+		if isAsciiDigit(a[0]) && isAsciiDigit(b[0]) {
+			numA, restA := digitRun(a)
+			numB, restB := digitRun(b)
+			switch level {
+			case 1:
+				if c := cmpNumbers(numA, numB); c != 0 {
+					return c
+				}
+			case 2:
+				if c := cmpInt(len(numA), len(numB)); c != 0 { // leading zeros
+					return c
+				}
+			}
+			a, b = restA, restB
+			continue
+		}
+
+		chA, sizeA := utf8.DecodeRuneInString(a)
+		chB, sizeB := utf8.DecodeRuneInString(b)
+		var c int
+		switch level {
+		case 1:
+			c = cmpInt(logicalWeight(chA), logicalWeight(chB))
+		case 2:
+			c = cmpInt(accentWeight(chA), accentWeight(chB))
+		case 3:
+			ignA, ignB := isLogicalIgnorable(chA), isLogicalIgnorable(chB)
+			if ignA && ignB {
+				c = cmpInt(int(chA), int(chB))
+			} else if ignA {
+				return 1
+			} else if ignB {
+				return -1
+			}
+		}
+		if c != 0 {
+			return c
+		}
+		a, b = a[sizeA:], b[sizeB:]
+	}
+
+	switch {
+	case a != "":
+		return 1
+	case b != "":
+		return -1
+	default:
+		return 0
+	}
+}
+
+func isAsciiDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+func isLogicalIgnorable(ch rune) bool {
+	return ch == '\'' || ch == '-' || ch == '\u00ad' // soft hyphen
+}
+
+// Splits the leading run of digits.
+func digitRun(s string) (digits, rest string) {
+	i := 0
+	for i < len(s) && isAsciiDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// Compares two runs of digits by their numeric value, with no size limit.
+func cmpNumbers(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if c := cmpInt(len(a), len(b)); c != 0 {
+		return c
+	}
+	return Cmp(a, b)
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Order of punctuation and symbols in the Windows sort tables.
+const _LOGICAL_PUNCT = " !\"#$%&()*,./:;?@[\\]^_`{|}~¡¦¨¯´¸¿˜‘’‚“”„‹›¢£¤¥€+<=>±«»×÷§©¬®°¶·…"
+
+// Base letters of U+00C0 to U+017F, or a dot for the other characters, which
+// include letters with no base letter, like ß.
+const _LOGICAL_LATIN = "aaaaaaaceeeeiiiidnooooo.ouuuuyt.aaaaaaaceeeeiiiidnooooo.ouuuuyty" +
+	"aaaaaaccccccccddddeeeeeeeeeegggggggghhhhiiiiiiiiiiiijjkkklllllll" +
+	"lllnnnnnnnnnoooooooorrrrrrssssssssttttttuuuuuuuuuuuuwwyyyzzzzzzs"
+
+// Returns the primary weight of the character: its class in the higher bits,
+// then its position within the class.
+func logicalWeight(ch rune) int {
+	const (
+		classPunct = iota + 1
+		classDigit
+		classLetter
+		classOther
+	)
+
+	if base, ok := latinBase(ch); ok {
+		return classLetter<<24 | int(base)
+	} else if unicode.IsSpace(ch) {
+		return classPunct << 24
+	} else if idx := strings.IndexRune(_LOGICAL_PUNCT, ch); idx != -1 {
+		return classPunct<<24 | (1 + idx)
+	} else if unicode.IsPunct(ch) || unicode.IsSymbol(ch) {
+		return classPunct<<24 | (len(_LOGICAL_PUNCT) + int(ch))
+	} else if unicode.IsDigit(ch) {
+		return classDigit<<24 | int(ch)
+	} else if unicode.IsLetter(ch) {
+		return classLetter<<24 | int(unicode.ToLower(ch))
+	}
+	return classOther<<24 | int(ch)
+}
+
+// Returns the secondary weight of the character, which is zero for anything
+// but accented Latin letters.
+func accentWeight(ch rune) int {
+	if base, ok := latinBase(ch); ok && ch > unicode.MaxASCII {
+		return 1 + int(unicode.ToLower(ch)) - int(base)
+	}
+	return 0
+}
+
+// Returns the lowercase unaccented letter of a Latin letter.
+func latinBase(ch rune) (rune, bool) {
+	if ch >= 'a' && ch <= 'z' {
+		return ch, true
+	} else if ch >= 'A' && ch <= 'Z' {
+		return ch + ('a' - 'A'), true
+	} else if ch >= 0xc0 && ch <= 0x17f {
+		if base := _LOGICAL_LATIN[ch-0xc0]; base != '.' {
+			return rune(base), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,111 @@
+#!/usr/bin/env python3
+# Generates the code page tables embedded by codepage.go, from the Python
+# codecs, which are built from the Microsoft mapping files published by the
+# Unicode Consortium. Run with "go generate" in the wstr directory.
+#
+# File format, all numbers are little-endian uint16:
+#   - 256 entries for the single bytes: the character, 0xfffe for lead bytes
+#     or 0xffff for undefined;
+#   - 256 entries for each lead byte, in ascending order: the character of each
+#     trail byte, or 0xffff for undefined;
+#   - count of encoding overrides, followed by (character, bytes) pairs, for
+#     characters which have more than one encoding and the preferred one isn't
+#     the first;
+#   - count of best-fit mappings, followed by (character, replacement) pairs.
+
+import codecs, os, struct, unicodedata
+
+SBCS = [437, 850, 852, 866, 874, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258]
+DBCS = [932, 936, 949, 950]
+
+# Characters without a Unicode decomposition, which Windows maps to similar ones
+# when the code page lacks them.
+EXTRA = {
+	0x00a0: 0x20, 0x2002: 0x20, 0x2003: 0x20, 0x2009: 0x20,
+	0x2010: 0x2d, 0x2011: 0x2d, 0x2013: 0x2d, 0x2014: 0x2d,
+	0x2018: 0x27, 0x2019: 0x27, 0x201a: 0x2c, 0x2032: 0x27,
+	0x201c: 0x22, 0x201d: 0x22, 0x201e: 0x22, 0x2033: 0x22,
+	0x2039: 0x3c, 0x203a: 0x3e, 0x00ab: 0x3c, 0x00bb: 0x3e,
+	0x2022: 0xb7, 0x00a6: 0x7c, 0x02c6: 0x5e, 0x02dc: 0x7e,
+	0x0110: 0x44, 0x0111: 0x64, 0x0126: 0x48, 0x0127: 0x68, # letters with stroke
+	0x0141: 0x4c, 0x0142: 0x6c, 0x0166: 0x54, 0x0167: 0x74,
+	0x00d8: 0x4f, 0x00f8: 0x6f, 0x0180: 0x62, 0x0197: 0x49,
+}
+
+def dec(cp, b):
+	try:
+		s = b.decode('cp%d' % cp)
+	except UnicodeDecodeError:
+		return None
+	return ord(s) if len(s) == 1 and ord(s) < 0x10000 else None
+
+def enc(cp, c):
+	try:
+		return chr(c).encode('cp%d' % cp)
+	except UnicodeEncodeError:
+		return None
+
+def gen(cp):
+	isDbcs = cp in DBCS
+	single = []
+	leads = []
+	for b in range(256):
+		c = dec(cp, bytes([b]))
+		if c is None and isDbcs and b >= 0x80 and any(dec(cp, bytes([b, t])) is not None for t in range(0x40, 0xff)):
+			single.append(0xfffe)
+			leads.append(b)
+		elif c is None and not isDbcs and 125 == cp // 10 and 0x80 <= b <= 0x9f:
+			single.append(b) # Windows maps undefined C1 bytes to themselves
+		else:
+			single.append(0xffff if c is None else c)
+
+	firstEnc = {}
+	for b, c in enumerate(single):
+		if c < 0xfffe:
+			firstEnc.setdefault(c, bytes([b]))
+	doubles = []
+	for lead in leads:
+		row = []
+		for t in range(256):
+			c = dec(cp, bytes([lead, t]))
+			row.append(0xffff if c is None else c)
+			if c is not None:
+				firstEnc.setdefault(c, bytes([lead, t]))
+		doubles.append(row)
+
+	overrides = []
+	for c, b in sorted(firstEnc.items()):
+		pref = enc(cp, c)
+		if pref is not None and pref != b:
+			overrides.append((c, int.from_bytes(pref, 'big')))
+
+	bestFit = []
+	for c in range(0x80, 0x10000):
+		if 0xd800 <= c < 0xe000 or c in firstEnc:
+			continue
+		target = EXTRA.get(c)
+		if target is None or target not in firstEnc:
+			nfkd = ''.join(ch for ch in unicodedata.normalize('NFKD', chr(c))
+				if unicodedata.category(ch) != 'Mn')
+			target = ord(nfkd) if len(nfkd) == 1 and ord(nfkd) != c else None
+		if target is not None and target in firstEnc:
+			bestFit.append((c, target))
+
+	out = bytearray()
+	for c in single:
+		out += struct.pack('<H', c)
+	for row in doubles:
+		for c in row:
+			out += struct.pack('<H', c)
+	out += struct.pack('<H', len(overrides))
+	for c, b in overrides:
+		out += struct.pack('<HH', c, b)
+	out += struct.pack('<H', len(bestFit))
+	for c, t in bestFit:
+		out += struct.pack('<HH', c, t)
+	return bytes(out)
+
+here = os.path.dirname(os.path.abspath(__file__))
+for cp in SBCS + DBCS:
+	with open(os.path.join(here, 'cp%d.bin' % cp), 'wb') as f:
+		f.write(gen(cp))
@@ -0,0 +1,66 @@
+//go:build ignore
+
+// Rewrites the results in cmplogical.tsv by calling the real StrCmpLogicalW,
+// so the table can be updated with new pairs. The "# results:" line records the
+// Windows version. Must be run on Windows, from the wstr directory:
+//
+//	go run testdata/gen_cmplogical.go
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const tsvPath = "testdata/cmplogical.tsv"
+
+func main() {
+	strCmpLogicalW := syscall.NewLazyDLL("shlwapi.dll").NewProc("StrCmpLogicalW")
+
+	contents, err := os.ReadFile(tsvPath)
+	if err != nil {
+		panic(err)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(contents), "\n"), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "# results:") {
+			lines[i] = "# results: StrCmpLogicalW, Windows " + windowsVersion()
+			continue
+		} else if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		a, _ := syscall.UTF16PtrFromString(fields[0])
+		b, _ := syscall.UTF16PtrFromString(fields[1])
+		ret, _, _ := strCmpLogicalW.Call(uintptr(unsafe.Pointer(a)), uintptr(unsafe.Pointer(b)))
+		lines[i] = fields[0] + "\t" + fields[1] + "\t" + strconv.Itoa(int(int32(ret)))
+	}
+
+	if err := os.WriteFile(tsvPath, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		panic(err)
+	}
+}
+
+// Returns the version of Windows, like "10.0.19045", with RtlGetVersion, which
+// is not affected by the application manifest.
+func windowsVersion() string {
+	type OSVERSIONINFOW struct {
+		DwOSVersionInfoSize uint32
+		DwMajorVersion      uint32
+		DwMinorVersion      uint32
+		DwBuildNumber       uint32
+		DwPlatformId        uint32
+		SzCSDVersion        [128]uint16
+	}
+
+	var ovi OSVERSIONINFOW
+	ovi.DwOSVersionInfoSize = uint32(unsafe.Sizeof(ovi))
+	rtlGetVersion := syscall.NewLazyDLL("ntdll.dll").NewProc("RtlGetVersion")
+	rtlGetVersion.Call(uintptr(unsafe.Pointer(&ovi)))
+	return fmt.Sprintf("%d.%d.%d", ovi.DwMajorVersion, ovi.DwMinorVersion, ovi.DwBuildNumber)
+}
@@ -80,7 +80,8 @@ func CountUtf16Len(s string) int {
 	return numWords
 }
 
-// Converts the number to a string with thousand separators.
+// Converts the number to a string with comma as thousand separator. For
+// locale-aware formatting, see the locale package.
 func FmtThousands(n int) string {
 	if n == 0 {
 		return "0"
@@ -5,7 +5,7 @@ import (
 )
 
 // Formats a number of bytes into KB, MB, GB, TB, PB or EB, rounding to 2
-// decimal places.
+// decimal places. For locale-aware formatting, see the locale package.
 func FmtBytes(numBytes uint64) string {
 	const (
 		KB = 1024
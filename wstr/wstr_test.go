@@ -1,7 +1,17 @@
 package wstr_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/wstr"
@@ -117,6 +127,35 @@ func ExampleCmpI() {
 	// Output: -1 1 0
 }
 
+func ExampleCmpLogical() {
+	names := []string{"file10.txt", "File2.txt", "_readme.txt", "file1.txt", "file1 (copy).txt"}
+	sort.Slice(names, func(a, b int) bool {
+		return wstr.CmpLogical(names[a], names[b]) < 0
+	})
+	fmt.Println(strings.Join(names, ", "))
+	// Output: _readme.txt, file1 (copy).txt, file1.txt, File2.txt, file10.txt
+}
+
+func TestCmpLogical(t *testing.T) {
+	contents, err := os.ReadFile("testdata/cmplogical.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		expected, _ := strconv.Atoi(fields[2])
+		if got := wstr.CmpLogical(fields[0], fields[1]); got != expected {
+			t.Errorf("CmpLogical(%q, %q) = %d, expected %d", fields[0], fields[1], got, expected)
+		}
+		if got := wstr.CmpLogical(fields[1], fields[0]); got != -expected {
+			t.Errorf("CmpLogical(%q, %q) = %d, expected %d", fields[1], fields[0], got, -expected)
+		}
+	}
+}
+
 func ExampleCountRunes() {
 	c1 := wstr.CountRunes("foo")
 	c2 := wstr.CountRunes("🙂")
@@ -163,3 +202,146 @@ func ExampleSubstrRunes() {
 	fmt.Println(s)
 	// Output: b🙂c
 }
+
+func ExampleNewUtf16Reader() {
+	raw := []byte{0xfe, 0xff, 0x00, 'a', 0xd8, 0x3d, 0xde, 0x42, 0xdc, 0x00, 0x00, 'z'} // BOM, UTF-16BE
+	for _, lone := range []wstr.LoneSurrogate{wstr.LoneReplace, wstr.LoneKeep, wstr.LoneError} {
+		r := wstr.NewUtf16Reader(iotest.OneByteReader(bytes.NewReader(raw)), wstr.Utf16LE, lone)
+		s, err := io.ReadAll(r)
+		fmt.Printf("%q %v %v\n", s, r.Order() == wstr.Utf16BE, err)
+	}
+	// Output:
+	// "a🙂�z" true <nil>
+	// "a🙂\xed\xb0\x80z" true <nil>
+	// "a🙂" true lone surrogate: 0xdc00 at 8
+}
+
+// Encodes the UTF-16 code units as bytes.
+func utf16Bytes(order binary.AppendByteOrder, units ...uint16) []byte {
+	b := make([]byte, 0, len(units)*2)
+	for _, unit := range units {
+		b = order.AppendUint16(b, unit)
+	}
+	return b
+}
+
+func TestUtf16Reader(t *testing.T) {
+	const chunkUnits = 32 * 1024 / 2 // internal chunk size, in code units
+
+	// The high surrogate is the last code unit of the first chunk.
+	splitPair := append(bytes.Repeat([]byte{'a', 0}, chunkUnits-1),
+		utf16Bytes(binary.LittleEndian, 0xd83d, 0xde42, 'z')...)
+	splitPairWant := strings.Repeat("a", chunkUnits-1) + "🙂z"
+
+	// With the BOM, the high surrogate is the first unit of the second chunk,
+	// and the first chunk ends with a whole code unit.
+	splitPairBom := append([]byte{0xff, 0xfe}, splitPair...)
+
+	loneHigh := utf16Bytes(binary.LittleEndian, 'a', 0xd83d)
+
+	tests := []struct {
+		name      string
+		input     []byte
+		order     wstr.Utf16Order
+		lone      wstr.LoneSurrogate
+		want      string
+		wantErr   error
+		wantOrder wstr.Utf16Order
+	}{
+		{"pair split at chunk end", splitPair, wstr.Utf16LE, wstr.LoneError, splitPairWant, nil, wstr.Utf16LE},
+		{"pair after chunk end, BOM", splitPairBom, wstr.Utf16BE, wstr.LoneError, splitPairWant, nil, wstr.Utf16LE},
+		{"lone high at EOF, replace", loneHigh, wstr.Utf16LE, wstr.LoneReplace, "a\uFFFD", nil, wstr.Utf16LE},
+		{"lone high at EOF, keep", loneHigh, wstr.Utf16LE, wstr.LoneKeep, "a\xed\xa0\xbd", nil, wstr.Utf16LE},
+		{"lone high at EOF, error", loneHigh, wstr.Utf16LE, wstr.LoneError, "a", wstr.ErrLoneSurrogate, wstr.Utf16LE},
+		{"lone high before ASCII, replace", utf16Bytes(binary.LittleEndian, 0xd83d, 'a'), wstr.Utf16LE, wstr.LoneReplace, "\uFFFDa", nil, wstr.Utf16LE},
+		{"odd byte at EOF, replace", []byte{'a', 0, 'b'}, wstr.Utf16LE, wstr.LoneReplace, "a\uFFFD", nil, wstr.Utf16LE},
+		{"odd byte at EOF, error", []byte{'a', 0, 'b'}, wstr.Utf16LE, wstr.LoneError, "a", wstr.ErrInvalidEncoding, wstr.Utf16LE},
+		{"LE BOM overrides BE", []byte{0xff, 0xfe, 'a', 0}, wstr.Utf16BE, wstr.LoneError, "a", nil, wstr.Utf16LE},
+		{"BE BOM overrides LE", []byte{0xfe, 0xff, 0, 'a'}, wstr.Utf16LE, wstr.LoneError, "a", nil, wstr.Utf16BE},
+		{"no BOM, BE", []byte{0, 'a'}, wstr.Utf16BE, wstr.LoneError, "a", nil, wstr.Utf16BE},
+		{"no BOM, LE", []byte{0, 'a'}, wstr.Utf16LE, wstr.LoneError, "\u6100", nil, wstr.Utf16LE},
+		{"BOM only", []byte{0xfe, 0xff}, wstr.Utf16LE, wstr.LoneError, "", nil, wstr.Utf16BE},
+		{"empty", []byte{}, wstr.Utf16BE, wstr.LoneError, "", nil, wstr.Utf16BE},
+	}
+
+	readers := []struct {
+		name string
+		wrap func(io.Reader) io.Reader
+	}{
+		{"whole", func(r io.Reader) io.Reader { return r }},
+		{"one byte", iotest.OneByteReader},
+		{"half", iotest.HalfReader},
+	}
+
+	for _, test := range tests {
+		for _, rd := range readers {
+			r := wstr.NewUtf16Reader(rd.wrap(bytes.NewReader(test.input)), test.order, test.lone)
+			got, err := io.ReadAll(r)
+			if string(got) != test.want {
+				t.Errorf("%s, %s reader: got %q, expected %q", test.name, rd.name, got, test.want)
+			}
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("%s, %s reader: error %v, expected %v", test.name, rd.name, err, test.wantErr)
+			}
+			if r.Order() != test.wantOrder {
+				t.Errorf("%s, %s reader: order %d, expected %d", test.name, rd.name, r.Order(), test.wantOrder)
+			}
+		}
+	}
+}
+
+func ExampleNewUtf16Writer() {
+	var buf bytes.Buffer
+	w := wstr.NewUtf16Writer(&buf, wstr.Utf16LE, true, wstr.LoneKeep)
+	for _, b := range []byte("a🙂\xed\xb0\x80") { // one byte at a time
+		w.Write([]byte{b})
+	}
+	w.Flush()
+	fmt.Printf("% x\n", buf.Bytes())
+	// Output: ff fe 61 00 3d d8 42 de 00 dc
+}
+
+func ExampleCodePages() {
+	for _, number := range wstr.CodePages()[:3] {
+		cp, _ := wstr.GetCodePage(number)
+		fmt.Println(cp.Number(), cp.Name())
+	}
+	_, err := wstr.GetCodePage(65001)
+	fmt.Println(err)
+	// Output:
+	// 437 OEM United States
+	// 850 OEM Multilingual Latin 1
+	// 852 OEM Latin 2
+	// GetCodePage: unsupported code page: 65001
+}
+
+func ExampleCodePage_Decode() {
+	cp1252, _ := wstr.GetCodePage(1252)
+	fmt.Println(cp1252.Decode([]byte{0x80, 0x20, 0x35, 0xe9}))
+
+	cp437, _ := wstr.GetCodePage(437)
+	fmt.Println(cp437.Decode([]byte{0xc9, 0xcd, 0xbb}))
+
+	cp932, _ := wstr.GetCodePage(932)
+	fmt.Println(cp932.Decode([]byte{0x93, 0xfa, 0x96, 0x7b, 0xb1, 0x85, 0x40}))
+	// Output:
+	// € 5é
+	// ╔═╗
+	// 日本ｱ・@
+}
+
+func ExampleCodePage_Encode() {
+	cp1252, _ := wstr.GetCodePage(1252)
+	for _, bestFit := range []bool{false, true} {
+		data, lossless := cp1252.Encode("Łódź ＡＢ", bestFit)
+		fmt.Printf("%q %v\n", data, lossless)
+	}
+
+	cp932, _ := wstr.GetCodePage(932)
+	data, _ := cp932.Encode("日本", false)
+	fmt.Printf("% x\n", data)
+	// Output:
+	// "?\xf3d? ??" false
+	// "L\xf3dz AB" false
+	// 93 fa 96 7b
+}
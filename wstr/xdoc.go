@@ -1,4 +1,8 @@
 // This package provides support to convert between Go strings and native,
 // null-terminated Windows UTF-16 strings. It's mainly used within the library,
 // but it's available if you need this kind of encoding/decoding.
+//
+// Streams of UTF-16 text, like files, can be converted with [Utf16Reader] and
+// [Utf16Writer]. Text in legacy Windows code pages, like Windows-1252 and
+// Shift-JIS, can be converted with [CodePage], without calling the Windows API.
 package wstr
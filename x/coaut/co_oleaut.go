@@ -102,10 +102,11 @@ const (
 
 // Oleaut IID identifier.
 var (
-	IID_IDispatch = co.IID(co.GUID{0x00020400, 0x0000, 0x0000, [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}})
-	IID_IPicture  = co.IID(co.GUID{0x7bf80980, 0xbf32, 0x101a, [8]byte{0x8b, 0xbb, 0x00, 0xaa, 0x00, 0x30, 0x0c, 0xab}})
-	IID_ITypeInfo = co.IID(co.GUID{0x00020401, 0x0000, 0x0000, [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}})
-	IID_ITypeLib  = co.IID(co.GUID{0x00020402, 0x0000, 0x0000, [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}})
+	IID_IDispatch    = co.IID(co.GUID{0x00020400, 0x0000, 0x0000, [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}})
+	IID_IEnumVARIANT = co.IID(co.GUID{0x00020404, 0x0000, 0x0000, [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}})
+	IID_IPicture     = co.IID(co.GUID{0x7bf80980, 0xbf32, 0x101a, [8]byte{0x8b, 0xbb, 0x00, 0xaa, 0x00, 0x30, 0x0c, 0xab}})
+	IID_ITypeInfo    = co.IID(co.GUID{0x00020401, 0x0000, 0x0000, [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}})
+	IID_ITypeLib     = co.IID(co.GUID{0x00020402, 0x0000, 0x0000, [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}})
 )
 
 // [IMPLTYPEFLAG] constants.
@@ -0,0 +1,321 @@
+// Code generated by internal/cogen; DO NOT EDIT.
+
+//go:build windows
+
+package coaut
+
+import "github.com/rodrigocfd/windigo/internal/enum"
+
+var _CALLCONV_names = enum.Enum[CALLCONV]{
+	Type: "CALLCONV",
+	Values: []enum.Name[CALLCONV]{
+		{Val: CALLCONV_CDECL, Name: "CALLCONV_CDECL"},
+		{Val: CALLCONV_MSCPASCAL, Name: "CALLCONV_MSCPASCAL"},
+		{Val: CALLCONV_PASCAL, Name: "CALLCONV_PASCAL"},
+		{Val: CALLCONV_MACPASCAL, Name: "CALLCONV_MACPASCAL"},
+		{Val: CALLCONV_STDCALL, Name: "CALLCONV_STDCALL"},
+		{Val: CALLCONV_FPFASTCALL, Name: "CALLCONV_FPFASTCALL"},
+		{Val: CALLCONV_SYSCALL, Name: "CALLCONV_SYSCALL"},
+		{Val: CALLCONV_MPWCDECL, Name: "CALLCONV_MPWCDECL"},
+		{Val: CALLCONV_MPWPASCAL, Name: "CALLCONV_MPWPASCAL"},
+		{Val: CALLCONV_MAX, Name: "CALLCONV_MAX"},
+		{Val: CALLCONV_FASTCALL, Name: "CALLCONV_FASTCALL"},
+	},
+}
+
+// Returns the name of the constant, like "CALLCONV_CDECL".
+func (v CALLCONV) String() string {
+	return _CALLCONV_names.String(v)
+}
+
+// Parses the name of a [CALLCONV] constant, or a number.
+func ParseCALLCONV(s string) (CALLCONV, error) {
+	return _CALLCONV_names.Parse(s)
+}
+
+var _DISPATCH_names = enum.Flags[DISPATCH]{
+	Type: "DISPATCH",
+	Values: []enum.Name[DISPATCH]{
+		{Val: DISPATCH_METHOD, Name: "DISPATCH_METHOD"},
+		{Val: DISPATCH_PROPERTYGET, Name: "DISPATCH_PROPERTYGET"},
+		{Val: DISPATCH_PROPERTYPUT, Name: "DISPATCH_PROPERTYPUT"},
+		{Val: DISPATCH_PROPERTYPUTREF, Name: "DISPATCH_PROPERTYPUTREF"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DISPATCH_METHOD|DISPATCH_PROPERTYGET".
+func (v DISPATCH) String() string {
+	return _DISPATCH_names.String(v)
+}
+
+// Parses names of [DISPATCH] flags joined by "|", or numbers.
+func ParseDISPATCH(s string) (DISPATCH, error) {
+	return _DISPATCH_names.Parse(s)
+}
+
+var _DISPID_names = enum.Enum[DISPID]{
+	Type: "DISPID",
+	Values: []enum.Name[DISPID]{
+		{Val: DISPID_UNKNOWN, Name: "DISPID_UNKNOWN"},
+		{Val: DISPID_VALUE, Name: "DISPID_VALUE"},
+		{Val: DISPID_PROPERTYPUT, Name: "DISPID_PROPERTYPUT"},
+		{Val: DISPID_NEWENUM, Name: "DISPID_NEWENUM"},
+		{Val: DISPID_EVALUATE, Name: "DISPID_EVALUATE"},
+		{Val: DISPID_CONSTRUCTOR, Name: "DISPID_CONSTRUCTOR"},
+		{Val: DISPID_DESTRUCTOR, Name: "DISPID_DESTRUCTOR"},
+		{Val: DISPID_COLLECT, Name: "DISPID_COLLECT"},
+	},
+}
+
+// Returns the name of the constant, like "DISPID_UNKNOWN".
+func (v DISPID) String() string {
+	return _DISPID_names.String(v)
+}
+
+// Parses the name of a [DISPID] constant, or a number.
+func ParseDISPID(s string) (DISPID, error) {
+	return _DISPID_names.Parse(s)
+}
+
+var _FUNCFLAG_names = enum.Flags[FUNCFLAG]{
+	Type: "FUNCFLAG",
+	Values: []enum.Name[FUNCFLAG]{
+		{Val: FUNCFLAG_FRESTRICTED, Name: "FUNCFLAG_FRESTRICTED"},
+		{Val: FUNCFLAG_FSOURCE, Name: "FUNCFLAG_FSOURCE"},
+		{Val: FUNCFLAG_FBINDABLE, Name: "FUNCFLAG_FBINDABLE"},
+		{Val: FUNCFLAG_FREQUESTEDIT, Name: "FUNCFLAG_FREQUESTEDIT"},
+		{Val: FUNCFLAG_FDISPLAYBIND, Name: "FUNCFLAG_FDISPLAYBIND"},
+		{Val: FUNCFLAG_FDEFAULTBIND, Name: "FUNCFLAG_FDEFAULTBIND"},
+		{Val: FUNCFLAG_FHIDDEN, Name: "FUNCFLAG_FHIDDEN"},
+		{Val: FUNCFLAG_FUSESGETLASTERROR, Name: "FUNCFLAG_FUSESGETLASTERROR"},
+		{Val: FUNCFLAG_FDEFAULTCOLLELEM, Name: "FUNCFLAG_FDEFAULTCOLLELEM"},
+		{Val: FUNCFLAG_FUIDEFAULT, Name: "FUNCFLAG_FUIDEFAULT"},
+		{Val: FUNCFLAG_FNONBROWSABLE, Name: "FUNCFLAG_FNONBROWSABLE"},
+		{Val: FUNCFLAG_FREPLACEABLE, Name: "FUNCFLAG_FREPLACEABLE"},
+		{Val: FUNCFLAG_FIMMEDIATEBIND, Name: "FUNCFLAG_FIMMEDIATEBIND"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FUNCFLAG_FRESTRICTED|FUNCFLAG_FSOURCE".
+func (v FUNCFLAG) String() string {
+	return _FUNCFLAG_names.String(v)
+}
+
+// Parses names of [FUNCFLAG] flags joined by "|", or numbers.
+func ParseFUNCFLAG(s string) (FUNCFLAG, error) {
+	return _FUNCFLAG_names.Parse(s)
+}
+
+var _FUNCKIND_names = enum.Enum[FUNCKIND]{
+	Type: "FUNCKIND",
+	Values: []enum.Name[FUNCKIND]{
+		{Val: FUNCKIND_PUREVIRTUAL, Name: "FUNCKIND_PUREVIRTUAL"},
+		{Val: FUNCKIND_NONVIRTUAL, Name: "FUNCKIND_NONVIRTUAL"},
+		{Val: FUNCKIND_STATIC, Name: "FUNCKIND_STATIC"},
+		{Val: FUNCKIND_DISPATCH, Name: "FUNCKIND_DISPATCH"},
+		{Val: FUNCKIND_VIRTUAL, Name: "FUNCKIND_VIRTUAL"},
+	},
+}
+
+// Returns the name of the constant, like "FUNCKIND_PUREVIRTUAL".
+func (v FUNCKIND) String() string {
+	return _FUNCKIND_names.String(v)
+}
+
+// Parses the name of a [FUNCKIND] constant, or a number.
+func ParseFUNCKIND(s string) (FUNCKIND, error) {
+	return _FUNCKIND_names.Parse(s)
+}
+
+var _IDLFLAG_names = enum.Flags[IDLFLAG]{
+	Type: "IDLFLAG",
+	Values: []enum.Name[IDLFLAG]{
+		{Val: IDLFLAG_FIN, Name: "IDLFLAG_FIN"},
+		{Val: IDLFLAG_FOUT, Name: "IDLFLAG_FOUT"},
+		{Val: IDLFLAG_FLCID, Name: "IDLFLAG_FLCID"},
+		{Val: IDLFLAG_FRETVAL, Name: "IDLFLAG_FRETVAL"},
+		{Val: IDLFLAG_NONE, Name: "IDLFLAG_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "IDLFLAG_FIN|IDLFLAG_FOUT".
+func (v IDLFLAG) String() string {
+	return _IDLFLAG_names.String(v)
+}
+
+// Parses names of [IDLFLAG] flags joined by "|", or numbers.
+func ParseIDLFLAG(s string) (IDLFLAG, error) {
+	return _IDLFLAG_names.Parse(s)
+}
+
+var _IMPLTYPEFLAG_names = enum.Flags[IMPLTYPEFLAG]{
+	Type: "IMPLTYPEFLAG",
+	Values: []enum.Name[IMPLTYPEFLAG]{
+		{Val: IMPLTYPEFLAG_FDEFAULT, Name: "IMPLTYPEFLAG_FDEFAULT"},
+		{Val: IMPLTYPEFLAG_FSOURCE, Name: "IMPLTYPEFLAG_FSOURCE"},
+		{Val: IMPLTYPEFLAG_FRESTRICTED, Name: "IMPLTYPEFLAG_FRESTRICTED"},
+		{Val: IMPLTYPEFLAG_FDEFAULTVTABLE, Name: "IMPLTYPEFLAG_FDEFAULTVTABLE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "IMPLTYPEFLAG_FDEFAULT|IMPLTYPEFLAG_FSOURCE".
+func (v IMPLTYPEFLAG) String() string {
+	return _IMPLTYPEFLAG_names.String(v)
+}
+
+// Parses names of [IMPLTYPEFLAG] flags joined by "|", or numbers.
+func ParseIMPLTYPEFLAG(s string) (IMPLTYPEFLAG, error) {
+	return _IMPLTYPEFLAG_names.Parse(s)
+}
+
+var _INVOKEKIND_names = enum.Flags[INVOKEKIND]{
+	Type: "INVOKEKIND",
+	Values: []enum.Name[INVOKEKIND]{
+		{Val: INVOKEKIND_FUNC, Name: "INVOKEKIND_FUNC"},
+		{Val: INVOKEKIND_PROPERTYGET, Name: "INVOKEKIND_PROPERTYGET"},
+		{Val: INVOKEKIND_PROPERTYPUT, Name: "INVOKEKIND_PROPERTYPUT"},
+		{Val: INVOKEKIND_PROPERTYPUTREF, Name: "INVOKEKIND_PROPERTYPUTREF"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "INVOKEKIND_FUNC|INVOKEKIND_PROPERTYGET".
+func (v INVOKEKIND) String() string {
+	return _INVOKEKIND_names.String(v)
+}
+
+// Parses names of [INVOKEKIND] flags joined by "|", or numbers.
+func ParseINVOKEKIND(s string) (INVOKEKIND, error) {
+	return _INVOKEKIND_names.Parse(s)
+}
+
+var _PARAMFLAG_names = enum.Flags[PARAMFLAG]{
+	Type: "PARAMFLAG",
+	Values: []enum.Name[PARAMFLAG]{
+		{Val: PARAMFLAG_FIN, Name: "PARAMFLAG_FIN"},
+		{Val: PARAMFLAG_FOUT, Name: "PARAMFLAG_FOUT"},
+		{Val: PARAMFLAG_FLCID, Name: "PARAMFLAG_FLCID"},
+		{Val: PARAMFLAG_FRETVAL, Name: "PARAMFLAG_FRETVAL"},
+		{Val: PARAMFLAG_FOPT, Name: "PARAMFLAG_FOPT"},
+		{Val: PARAMFLAG_FHASDEFAULT, Name: "PARAMFLAG_FHASDEFAULT"},
+		{Val: PARAMFLAG_FHASCUSTDATA, Name: "PARAMFLAG_FHASCUSTDATA"},
+		{Val: PARAMFLAG_NONE, Name: "PARAMFLAG_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PARAMFLAG_FIN|PARAMFLAG_FOUT".
+func (v PARAMFLAG) String() string {
+	return _PARAMFLAG_names.String(v)
+}
+
+// Parses names of [PARAMFLAG] flags joined by "|", or numbers.
+func ParsePARAMFLAG(s string) (PARAMFLAG, error) {
+	return _PARAMFLAG_names.Parse(s)
+}
+
+var _PICATTR_names = enum.Flags[PICATTR]{
+	Type: "PICATTR",
+	Values: []enum.Name[PICATTR]{
+		{Val: PICATTR_SCALABLE, Name: "PICATTR_SCALABLE"},
+		{Val: PICATTR_TRANSPARENT, Name: "PICATTR_TRANSPARENT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PICATTR_SCALABLE|PICATTR_TRANSPARENT".
+func (v PICATTR) String() string {
+	return _PICATTR_names.String(v)
+}
+
+// Parses names of [PICATTR] flags joined by "|", or numbers.
+func ParsePICATTR(s string) (PICATTR, error) {
+	return _PICATTR_names.Parse(s)
+}
+
+var _PICTYPE_names = enum.Flags[PICTYPE]{
+	Type: "PICTYPE",
+	Values: []enum.Name[PICTYPE]{
+		{Val: PICTYPE_UNINITIALIZED, Name: "PICTYPE_UNINITIALIZED"},
+		{Val: PICTYPE_ICON, Name: "PICTYPE_ICON"},
+		{Val: PICTYPE_BITMAP, Name: "PICTYPE_BITMAP"},
+		{Val: PICTYPE_METAFILE, Name: "PICTYPE_METAFILE"},
+		{Val: PICTYPE_ENHMETAFILE, Name: "PICTYPE_ENHMETAFILE"},
+		{Val: PICTYPE_NONE, Name: "PICTYPE_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "PICTYPE_BITMAP|PICTYPE_METAFILE".
+func (v PICTYPE) String() string {
+	return _PICTYPE_names.String(v)
+}
+
+// Parses names of [PICTYPE] flags joined by "|", or numbers.
+func ParsePICTYPE(s string) (PICTYPE, error) {
+	return _PICTYPE_names.Parse(s)
+}
+
+var _VT_names = enum.Enum[VT]{
+	Type: "VT",
+	Values: []enum.Name[VT]{
+		{Val: VT_EMPTY, Name: "VT_EMPTY"},
+		{Val: VT_NULL, Name: "VT_NULL"},
+		{Val: VT_I2, Name: "VT_I2"},
+		{Val: VT_I4, Name: "VT_I4"},
+		{Val: VT_R4, Name: "VT_R4"},
+		{Val: VT_R8, Name: "VT_R8"},
+		{Val: VT_CY, Name: "VT_CY"},
+		{Val: VT_DATE, Name: "VT_DATE"},
+		{Val: VT_BSTR, Name: "VT_BSTR"},
+		{Val: VT_DISPATCH, Name: "VT_DISPATCH"},
+		{Val: VT_ERROR, Name: "VT_ERROR"},
+		{Val: VT_BOOL, Name: "VT_BOOL"},
+		{Val: VT_VARIANT, Name: "VT_VARIANT"},
+		{Val: VT_UNKNOWN, Name: "VT_UNKNOWN"},
+		{Val: VT_DECIMAL, Name: "VT_DECIMAL"},
+		{Val: VT_I1, Name: "VT_I1"},
+		{Val: VT_UI1, Name: "VT_UI1"},
+		{Val: VT_UI2, Name: "VT_UI2"},
+		{Val: VT_UI4, Name: "VT_UI4"},
+		{Val: VT_I8, Name: "VT_I8"},
+		{Val: VT_UI8, Name: "VT_UI8"},
+		{Val: VT_INT, Name: "VT_INT"},
+		{Val: VT_UINT, Name: "VT_UINT"},
+		{Val: VT_VOID, Name: "VT_VOID"},
+		{Val: VT_HRESULT, Name: "VT_HRESULT"},
+		{Val: VT_PTR, Name: "VT_PTR"},
+		{Val: VT_SAFEARRAY, Name: "VT_SAFEARRAY"},
+		{Val: VT_CARRAY, Name: "VT_CARRAY"},
+		{Val: VT_USERDEFINED, Name: "VT_USERDEFINED"},
+		{Val: VT_LPSTR, Name: "VT_LPSTR"},
+		{Val: VT_LPWSTR, Name: "VT_LPWSTR"},
+		{Val: VT_RECORD, Name: "VT_RECORD"},
+		{Val: VT_INT_PTR, Name: "VT_INT_PTR"},
+		{Val: VT_UINT_PTR, Name: "VT_UINT_PTR"},
+		{Val: VT_FILETIME, Name: "VT_FILETIME"},
+		{Val: VT_BLOB, Name: "VT_BLOB"},
+		{Val: VT_STREAM, Name: "VT_STREAM"},
+		{Val: VT_STORAGE, Name: "VT_STORAGE"},
+		{Val: VT_STREAMED_OBJECT, Name: "VT_STREAMED_OBJECT"},
+		{Val: VT_STORED_OBJECT, Name: "VT_STORED_OBJECT"},
+		{Val: VT_BLOB_OBJECT, Name: "VT_BLOB_OBJECT"},
+		{Val: VT_CF, Name: "VT_CF"},
+		{Val: VT_CLSID, Name: "VT_CLSID"},
+		{Val: VT_VERSIONED_STREAM, Name: "VT_VERSIONED_STREAM"},
+		{Val: VT_BSTR_BLOB, Name: "VT_BSTR_BLOB"},
+		{Val: VT_VECTOR, Name: "VT_VECTOR"},
+		{Val: VT_ARRAY, Name: "VT_ARRAY"},
+		{Val: VT_BYREF, Name: "VT_BYREF"},
+		{Val: VT_RESERVED, Name: "VT_RESERVED"},
+		{Val: VT_ILLEGAL, Name: "VT_ILLEGAL"},
+		{Val: VT_ILLEGALMASKED, Name: "VT_ILLEGALMASKED"},
+		{Val: VT_TYPEMASK, Name: "VT_TYPEMASK"},
+	},
+}
+
+// Returns the name of the constant, like "VT_EMPTY".
+func (v VT) String() string {
+	return _VT_names.String(v)
+}
+
+// Parses the name of a [VT] constant, or a number.
+func ParseVT(s string) (VT, error) {
+	return _VT_names.Parse(s)
+}
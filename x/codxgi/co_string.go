@@ -0,0 +1,417 @@
+// Code generated by internal/cogen; DO NOT EDIT.
+
+//go:build windows
+
+package codxgi
+
+import "github.com/rodrigocfd/windigo/internal/enum"
+
+var _DXGI_ADAPTER_FLAG_names = enum.Flags[DXGI_ADAPTER_FLAG]{
+	Type: "DXGI_ADAPTER_FLAG",
+	Values: []enum.Name[DXGI_ADAPTER_FLAG]{
+		{Val: DXGI_ADAPTER_FLAG_REMOTE, Name: "DXGI_ADAPTER_FLAG_REMOTE"},
+		{Val: DXGI_ADAPTER_FLAG_SOFTWARE, Name: "DXGI_ADAPTER_FLAG_SOFTWARE"},
+		{Val: DXGI_ADAPTER_FLAG_NONE, Name: "DXGI_ADAPTER_FLAG_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_ADAPTER_FLAG_REMOTE|DXGI_ADAPTER_FLAG_SOFTWARE".
+func (v DXGI_ADAPTER_FLAG) String() string {
+	return _DXGI_ADAPTER_FLAG_names.String(v)
+}
+
+// Parses names of [DXGI_ADAPTER_FLAG] flags joined by "|", or numbers.
+func ParseDXGI_ADAPTER_FLAG(s string) (DXGI_ADAPTER_FLAG, error) {
+	return _DXGI_ADAPTER_FLAG_names.Parse(s)
+}
+
+var _DXGI_ALPHA_MODE_names = enum.Enum[DXGI_ALPHA_MODE]{
+	Type: "DXGI_ALPHA_MODE",
+	Values: []enum.Name[DXGI_ALPHA_MODE]{
+		{Val: DXGI_ALPHA_MODE_UNSPECIFIED, Name: "DXGI_ALPHA_MODE_UNSPECIFIED"},
+		{Val: DXGI_ALPHA_MODE_PREMULTIPLIED, Name: "DXGI_ALPHA_MODE_PREMULTIPLIED"},
+		{Val: DXGI_ALPHA_MODE_STRAIGHT, Name: "DXGI_ALPHA_MODE_STRAIGHT"},
+		{Val: DXGI_ALPHA_MODE_IGNORE, Name: "DXGI_ALPHA_MODE_IGNORE"},
+	},
+}
+
+// Returns the name of the constant, like "DXGI_ALPHA_MODE_UNSPECIFIED".
+func (v DXGI_ALPHA_MODE) String() string {
+	return _DXGI_ALPHA_MODE_names.String(v)
+}
+
+// Parses the name of a [DXGI_ALPHA_MODE] constant, or a number.
+func ParseDXGI_ALPHA_MODE(s string) (DXGI_ALPHA_MODE, error) {
+	return _DXGI_ALPHA_MODE_names.Parse(s)
+}
+
+var _DXGI_ENUM_MODES_names = enum.Flags[DXGI_ENUM_MODES]{
+	Type: "DXGI_ENUM_MODES",
+	Values: []enum.Name[DXGI_ENUM_MODES]{
+		{Val: DXGI_ENUM_MODES_INTERLACED, Name: "DXGI_ENUM_MODES_INTERLACED"},
+		{Val: DXGI_ENUM_MODES_SCALING, Name: "DXGI_ENUM_MODES_SCALING"},
+		{Val: DXGI_ENUM_MODES_STEREO, Name: "DXGI_ENUM_MODES_STEREO"},
+		{Val: DXGI_ENUM_MODES_DISABLED_STEREO, Name: "DXGI_ENUM_MODES_DISABLED_STEREO"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_ENUM_MODES_INTERLACED|DXGI_ENUM_MODES_SCALING".
+func (v DXGI_ENUM_MODES) String() string {
+	return _DXGI_ENUM_MODES_names.String(v)
+}
+
+// Parses names of [DXGI_ENUM_MODES] flags joined by "|", or numbers.
+func ParseDXGI_ENUM_MODES(s string) (DXGI_ENUM_MODES, error) {
+	return _DXGI_ENUM_MODES_names.Parse(s)
+}
+
+var _DXGI_FORMAT_names = enum.Enum[DXGI_FORMAT]{
+	Type: "DXGI_FORMAT",
+	Values: []enum.Name[DXGI_FORMAT]{
+		{Val: DXGI_FORMAT_UNKNOWN, Name: "DXGI_FORMAT_UNKNOWN"},
+		{Val: DXGI_FORMAT_R32G32B32A32_TYPELESS, Name: "DXGI_FORMAT_R32G32B32A32_TYPELESS"},
+		{Val: DXGI_FORMAT_R32G32B32A32_FLOAT, Name: "DXGI_FORMAT_R32G32B32A32_FLOAT"},
+		{Val: DXGI_FORMAT_R32G32B32A32_UINT, Name: "DXGI_FORMAT_R32G32B32A32_UINT"},
+		{Val: DXGI_FORMAT_R32G32B32A32_SINT, Name: "DXGI_FORMAT_R32G32B32A32_SINT"},
+		{Val: DXGI_FORMAT_R32G32B32_TYPELESS, Name: "DXGI_FORMAT_R32G32B32_TYPELESS"},
+		{Val: DXGI_FORMAT_R32G32B32_FLOAT, Name: "DXGI_FORMAT_R32G32B32_FLOAT"},
+		{Val: DXGI_FORMAT_R32G32B32_UINT, Name: "DXGI_FORMAT_R32G32B32_UINT"},
+		{Val: DXGI_FORMAT_R32G32B32_SINT, Name: "DXGI_FORMAT_R32G32B32_SINT"},
+		{Val: DXGI_FORMAT_R16G16B16A16_TYPELESS, Name: "DXGI_FORMAT_R16G16B16A16_TYPELESS"},
+		{Val: DXGI_FORMAT_R16G16B16A16_FLOAT, Name: "DXGI_FORMAT_R16G16B16A16_FLOAT"},
+		{Val: DXGI_FORMAT_R16G16B16A16_UNORM, Name: "DXGI_FORMAT_R16G16B16A16_UNORM"},
+		{Val: DXGI_FORMAT_R16G16B16A16_UINT, Name: "DXGI_FORMAT_R16G16B16A16_UINT"},
+		{Val: DXGI_FORMAT_R16G16B16A16_SNORM, Name: "DXGI_FORMAT_R16G16B16A16_SNORM"},
+		{Val: DXGI_FORMAT_R16G16B16A16_SINT, Name: "DXGI_FORMAT_R16G16B16A16_SINT"},
+		{Val: DXGI_FORMAT_R32G32_TYPELESS, Name: "DXGI_FORMAT_R32G32_TYPELESS"},
+		{Val: DXGI_FORMAT_R32G32_FLOAT, Name: "DXGI_FORMAT_R32G32_FLOAT"},
+		{Val: DXGI_FORMAT_R32G32_UINT, Name: "DXGI_FORMAT_R32G32_UINT"},
+		{Val: DXGI_FORMAT_R32G32_SINT, Name: "DXGI_FORMAT_R32G32_SINT"},
+		{Val: DXGI_FORMAT_R32G8X24_TYPELESS, Name: "DXGI_FORMAT_R32G8X24_TYPELESS"},
+		{Val: DXGI_FORMAT_D32_FLOAT_S8X24_UINT, Name: "DXGI_FORMAT_D32_FLOAT_S8X24_UINT"},
+		{Val: DXGI_FORMAT_R32_FLOAT_X8X24_TYPELESS, Name: "DXGI_FORMAT_R32_FLOAT_X8X24_TYPELESS"},
+		{Val: DXGI_FORMAT_X32_TYPELESS_G8X24_UINT, Name: "DXGI_FORMAT_X32_TYPELESS_G8X24_UINT"},
+		{Val: DXGI_FORMAT_R10G10B10A2_TYPELESS, Name: "DXGI_FORMAT_R10G10B10A2_TYPELESS"},
+		{Val: DXGI_FORMAT_R10G10B10A2_UNORM, Name: "DXGI_FORMAT_R10G10B10A2_UNORM"},
+		{Val: DXGI_FORMAT_R10G10B10A2_UINT, Name: "DXGI_FORMAT_R10G10B10A2_UINT"},
+		{Val: DXGI_FORMAT_R11G11B10_FLOAT, Name: "DXGI_FORMAT_R11G11B10_FLOAT"},
+		{Val: DXGI_FORMAT_R8G8B8A8_TYPELESS, Name: "DXGI_FORMAT_R8G8B8A8_TYPELESS"},
+		{Val: DXGI_FORMAT_R8G8B8A8_UNORM, Name: "DXGI_FORMAT_R8G8B8A8_UNORM"},
+		{Val: DXGI_FORMAT_R8G8B8A8_UNORM_SRGB, Name: "DXGI_FORMAT_R8G8B8A8_UNORM_SRGB"},
+		{Val: DXGI_FORMAT_R8G8B8A8_UINT, Name: "DXGI_FORMAT_R8G8B8A8_UINT"},
+		{Val: DXGI_FORMAT_R8G8B8A8_SNORM, Name: "DXGI_FORMAT_R8G8B8A8_SNORM"},
+		{Val: DXGI_FORMAT_R8G8B8A8_SINT, Name: "DXGI_FORMAT_R8G8B8A8_SINT"},
+		{Val: DXGI_FORMAT_R16G16_TYPELESS, Name: "DXGI_FORMAT_R16G16_TYPELESS"},
+		{Val: DXGI_FORMAT_R16G16_FLOAT, Name: "DXGI_FORMAT_R16G16_FLOAT"},
+		{Val: DXGI_FORMAT_R16G16_UNORM, Name: "DXGI_FORMAT_R16G16_UNORM"},
+		{Val: DXGI_FORMAT_R16G16_UINT, Name: "DXGI_FORMAT_R16G16_UINT"},
+		{Val: DXGI_FORMAT_R16G16_SNORM, Name: "DXGI_FORMAT_R16G16_SNORM"},
+		{Val: DXGI_FORMAT_R16G16_SINT, Name: "DXGI_FORMAT_R16G16_SINT"},
+		{Val: DXGI_FORMAT_R32_TYPELESS, Name: "DXGI_FORMAT_R32_TYPELESS"},
+		{Val: DXGI_FORMAT_D32_FLOAT, Name: "DXGI_FORMAT_D32_FLOAT"},
+		{Val: DXGI_FORMAT_R32_FLOAT, Name: "DXGI_FORMAT_R32_FLOAT"},
+		{Val: DXGI_FORMAT_R32_UINT, Name: "DXGI_FORMAT_R32_UINT"},
+		{Val: DXGI_FORMAT_R32_SINT, Name: "DXGI_FORMAT_R32_SINT"},
+		{Val: DXGI_FORMAT_R24G8_TYPELESS, Name: "DXGI_FORMAT_R24G8_TYPELESS"},
+		{Val: DXGI_FORMAT_D24_UNORM_S8_UINT, Name: "DXGI_FORMAT_D24_UNORM_S8_UINT"},
+		{Val: DXGI_FORMAT_R24_UNORM_X8_TYPELESS, Name: "DXGI_FORMAT_R24_UNORM_X8_TYPELESS"},
+		{Val: DXGI_FORMAT_X24_TYPELESS_G8_UINT, Name: "DXGI_FORMAT_X24_TYPELESS_G8_UINT"},
+		{Val: DXGI_FORMAT_R8G8_TYPELESS, Name: "DXGI_FORMAT_R8G8_TYPELESS"},
+		{Val: DXGI_FORMAT_R8G8_UNORM, Name: "DXGI_FORMAT_R8G8_UNORM"},
+		{Val: DXGI_FORMAT_R8G8_UINT, Name: "DXGI_FORMAT_R8G8_UINT"},
+		{Val: DXGI_FORMAT_R8G8_SNORM, Name: "DXGI_FORMAT_R8G8_SNORM"},
+		{Val: DXGI_FORMAT_R8G8_SINT, Name: "DXGI_FORMAT_R8G8_SINT"},
+		{Val: DXGI_FORMAT_R16_TYPELESS, Name: "DXGI_FORMAT_R16_TYPELESS"},
+		{Val: DXGI_FORMAT_R16_FLOAT, Name: "DXGI_FORMAT_R16_FLOAT"},
+		{Val: DXGI_FORMAT_D16_UNORM, Name: "DXGI_FORMAT_D16_UNORM"},
+		{Val: DXGI_FORMAT_R16_UNORM, Name: "DXGI_FORMAT_R16_UNORM"},
+		{Val: DXGI_FORMAT_R16_UINT, Name: "DXGI_FORMAT_R16_UINT"},
+		{Val: DXGI_FORMAT_R16_SNORM, Name: "DXGI_FORMAT_R16_SNORM"},
+		{Val: DXGI_FORMAT_R16_SINT, Name: "DXGI_FORMAT_R16_SINT"},
+		{Val: DXGI_FORMAT_R8_TYPELESS, Name: "DXGI_FORMAT_R8_TYPELESS"},
+		{Val: DXGI_FORMAT_R8_UNORM, Name: "DXGI_FORMAT_R8_UNORM"},
+		{Val: DXGI_FORMAT_R8_UINT, Name: "DXGI_FORMAT_R8_UINT"},
+		{Val: DXGI_FORMAT_R8_SNORM, Name: "DXGI_FORMAT_R8_SNORM"},
+		{Val: DXGI_FORMAT_R8_SINT, Name: "DXGI_FORMAT_R8_SINT"},
+		{Val: DXGI_FORMAT_A8_UNORM, Name: "DXGI_FORMAT_A8_UNORM"},
+		{Val: DXGI_FORMAT_R1_UNORM, Name: "DXGI_FORMAT_R1_UNORM"},
+		{Val: DXGI_FORMAT_R9G9B9E5_SHAREDEXP, Name: "DXGI_FORMAT_R9G9B9E5_SHAREDEXP"},
+		{Val: DXGI_FORMAT_R8G8_B8G8_UNORM, Name: "DXGI_FORMAT_R8G8_B8G8_UNORM"},
+		{Val: DXGI_FORMAT_G8R8_G8B8_UNORM, Name: "DXGI_FORMAT_G8R8_G8B8_UNORM"},
+		{Val: DXGI_FORMAT_BC1_TYPELESS, Name: "DXGI_FORMAT_BC1_TYPELESS"},
+		{Val: DXGI_FORMAT_BC1_UNORM, Name: "DXGI_FORMAT_BC1_UNORM"},
+		{Val: DXGI_FORMAT_BC1_UNORM_SRGB, Name: "DXGI_FORMAT_BC1_UNORM_SRGB"},
+		{Val: DXGI_FORMAT_BC2_TYPELESS, Name: "DXGI_FORMAT_BC2_TYPELESS"},
+		{Val: DXGI_FORMAT_BC2_UNORM, Name: "DXGI_FORMAT_BC2_UNORM"},
+		{Val: DXGI_FORMAT_BC2_UNORM_SRGB, Name: "DXGI_FORMAT_BC2_UNORM_SRGB"},
+		{Val: DXGI_FORMAT_BC3_TYPELESS, Name: "DXGI_FORMAT_BC3_TYPELESS"},
+		{Val: DXGI_FORMAT_BC3_UNORM, Name: "DXGI_FORMAT_BC3_UNORM"},
+		{Val: DXGI_FORMAT_BC3_UNORM_SRGB, Name: "DXGI_FORMAT_BC3_UNORM_SRGB"},
+		{Val: DXGI_FORMAT_BC4_TYPELESS, Name: "DXGI_FORMAT_BC4_TYPELESS"},
+		{Val: DXGI_FORMAT_BC4_UNORM, Name: "DXGI_FORMAT_BC4_UNORM"},
+		{Val: DXGI_FORMAT_BC4_SNORM, Name: "DXGI_FORMAT_BC4_SNORM"},
+		{Val: DXGI_FORMAT_BC5_TYPELESS, Name: "DXGI_FORMAT_BC5_TYPELESS"},
+		{Val: DXGI_FORMAT_BC5_UNORM, Name: "DXGI_FORMAT_BC5_UNORM"},
+		{Val: DXGI_FORMAT_BC5_SNORM, Name: "DXGI_FORMAT_BC5_SNORM"},
+		{Val: DXGI_FORMAT_B5G6R5_UNORM, Name: "DXGI_FORMAT_B5G6R5_UNORM"},
+		{Val: DXGI_FORMAT_B5G5R5A1_UNORM, Name: "DXGI_FORMAT_B5G5R5A1_UNORM"},
+		{Val: DXGI_FORMAT_B8G8R8A8_UNORM, Name: "DXGI_FORMAT_B8G8R8A8_UNORM"},
+		{Val: DXGI_FORMAT_B8G8R8X8_UNORM, Name: "DXGI_FORMAT_B8G8R8X8_UNORM"},
+		{Val: DXGI_FORMAT_R10G10B10_XR_BIAS_A2_UNORM, Name: "DXGI_FORMAT_R10G10B10_XR_BIAS_A2_UNORM"},
+		{Val: DXGI_FORMAT_B8G8R8A8_TYPELESS, Name: "DXGI_FORMAT_B8G8R8A8_TYPELESS"},
+		{Val: DXGI_FORMAT_B8G8R8A8_UNORM_SRGB, Name: "DXGI_FORMAT_B8G8R8A8_UNORM_SRGB"},
+		{Val: DXGI_FORMAT_B8G8R8X8_TYPELESS, Name: "DXGI_FORMAT_B8G8R8X8_TYPELESS"},
+		{Val: DXGI_FORMAT_B8G8R8X8_UNORM_SRGB, Name: "DXGI_FORMAT_B8G8R8X8_UNORM_SRGB"},
+		{Val: DXGI_FORMAT_BC6H_TYPELESS, Name: "DXGI_FORMAT_BC6H_TYPELESS"},
+		{Val: DXGI_FORMAT_BC6H_UF16, Name: "DXGI_FORMAT_BC6H_UF16"},
+		{Val: DXGI_FORMAT_BC6H_SF16, Name: "DXGI_FORMAT_BC6H_SF16"},
+		{Val: DXGI_FORMAT_BC7_TYPELESS, Name: "DXGI_FORMAT_BC7_TYPELESS"},
+		{Val: DXGI_FORMAT_BC7_UNORM, Name: "DXGI_FORMAT_BC7_UNORM"},
+		{Val: DXGI_FORMAT_BC7_UNORM_SRGB, Name: "DXGI_FORMAT_BC7_UNORM_SRGB"},
+		{Val: DXGI_FORMAT_AYUV, Name: "DXGI_FORMAT_AYUV"},
+		{Val: DXGI_FORMAT_Y410, Name: "DXGI_FORMAT_Y410"},
+		{Val: DXGI_FORMAT_Y416, Name: "DXGI_FORMAT_Y416"},
+		{Val: DXGI_FORMAT_NV12, Name: "DXGI_FORMAT_NV12"},
+		{Val: DXGI_FORMAT_P010, Name: "DXGI_FORMAT_P010"},
+		{Val: DXGI_FORMAT_P016, Name: "DXGI_FORMAT_P016"},
+		{Val: DXGI_FORMAT_420_OPAQUE, Name: "DXGI_FORMAT_420_OPAQUE"},
+		{Val: DXGI_FORMAT_YUY2, Name: "DXGI_FORMAT_YUY2"},
+		{Val: DXGI_FORMAT_Y210, Name: "DXGI_FORMAT_Y210"},
+		{Val: DXGI_FORMAT_Y216, Name: "DXGI_FORMAT_Y216"},
+		{Val: DXGI_FORMAT_NV11, Name: "DXGI_FORMAT_NV11"},
+		{Val: DXGI_FORMAT_AI44, Name: "DXGI_FORMAT_AI44"},
+		{Val: DXGI_FORMAT_IA44, Name: "DXGI_FORMAT_IA44"},
+		{Val: DXGI_FORMAT_P8, Name: "DXGI_FORMAT_P8"},
+		{Val: DXGI_FORMAT_A8P8, Name: "DXGI_FORMAT_A8P8"},
+		{Val: DXGI_FORMAT_B4G4R4A4_UNORM, Name: "DXGI_FORMAT_B4G4R4A4_UNORM"},
+		{Val: DXGI_FORMAT_P208, Name: "DXGI_FORMAT_P208"},
+		{Val: DXGI_FORMAT_V208, Name: "DXGI_FORMAT_V208"},
+		{Val: DXGI_FORMAT_V408, Name: "DXGI_FORMAT_V408"},
+		{Val: DXGI_FORMAT_SAMPLER_FEEDBACK_MIN_MIP_OPAQUE, Name: "DXGI_FORMAT_SAMPLER_FEEDBACK_MIN_MIP_OPAQUE"},
+		{Val: DXGI_FORMAT_SAMPLER_FEEDBACK_MIP_REGION_USED_OPAQUE, Name: "DXGI_FORMAT_SAMPLER_FEEDBACK_MIP_REGION_USED_OPAQUE"},
+	},
+}
+
+// Returns the name of the constant, like "DXGI_FORMAT_UNKNOWN".
+func (v DXGI_FORMAT) String() string {
+	return _DXGI_FORMAT_names.String(v)
+}
+
+// Parses the name of a [DXGI_FORMAT] constant, or a number.
+func ParseDXGI_FORMAT(s string) (DXGI_FORMAT, error) {
+	return _DXGI_FORMAT_names.Parse(s)
+}
+
+var _DXGI_MAP_names = enum.Flags[DXGI_MAP]{
+	Type: "DXGI_MAP",
+	Values: []enum.Name[DXGI_MAP]{
+		{Val: DXGI_MAP_READ, Name: "DXGI_MAP_READ"},
+		{Val: DXGI_MAP_WRITE, Name: "DXGI_MAP_WRITE"},
+		{Val: DXGI_MAP_DISCARD, Name: "DXGI_MAP_DISCARD"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_MAP_READ|DXGI_MAP_WRITE".
+func (v DXGI_MAP) String() string {
+	return _DXGI_MAP_names.String(v)
+}
+
+// Parses names of [DXGI_MAP] flags joined by "|", or numbers.
+func ParseDXGI_MAP(s string) (DXGI_MAP, error) {
+	return _DXGI_MAP_names.Parse(s)
+}
+
+var _DXGI_MODE_ROTATION_names = enum.Enum[DXGI_MODE_ROTATION]{
+	Type: "DXGI_MODE_ROTATION",
+	Values: []enum.Name[DXGI_MODE_ROTATION]{
+		{Val: DXGI_MODE_ROTATION_UNSPECIFIED, Name: "DXGI_MODE_ROTATION_UNSPECIFIED"},
+		{Val: DXGI_MODE_ROTATION_IDENTITY, Name: "DXGI_MODE_ROTATION_IDENTITY"},
+		{Val: DXGI_MODE_ROTATION_ROTATE90, Name: "DXGI_MODE_ROTATION_ROTATE90"},
+		{Val: DXGI_MODE_ROTATION_ROTATE180, Name: "DXGI_MODE_ROTATION_ROTATE180"},
+		{Val: DXGI_MODE_ROTATION_ROTATE270, Name: "DXGI_MODE_ROTATION_ROTATE270"},
+	},
+}
+
+// Returns the name of the constant, like "DXGI_MODE_ROTATION_UNSPECIFIED".
+func (v DXGI_MODE_ROTATION) String() string {
+	return _DXGI_MODE_ROTATION_names.String(v)
+}
+
+// Parses the name of a [DXGI_MODE_ROTATION] constant, or a number.
+func ParseDXGI_MODE_ROTATION(s string) (DXGI_MODE_ROTATION, error) {
+	return _DXGI_MODE_ROTATION_names.Parse(s)
+}
+
+var _DXGI_MODE_SCANLINE_ORDER_names = enum.Enum[DXGI_MODE_SCANLINE_ORDER]{
+	Type: "DXGI_MODE_SCANLINE_ORDER",
+	Values: []enum.Name[DXGI_MODE_SCANLINE_ORDER]{
+		{Val: DXGI_MODE_SCANLINE_ORDER_UNSPECIFIED, Name: "DXGI_MODE_SCANLINE_ORDER_UNSPECIFIED"},
+		{Val: DXGI_MODE_SCANLINE_ORDER_PROGRESSIVE, Name: "DXGI_MODE_SCANLINE_ORDER_PROGRESSIVE"},
+		{Val: DXGI_MODE_SCANLINE_ORDER_UPPER_FIELD_FIRST, Name: "DXGI_MODE_SCANLINE_ORDER_UPPER_FIELD_FIRST"},
+		{Val: DXGI_MODE_SCANLINE_ORDER_LOWER_FIELD_FIRST, Name: "DXGI_MODE_SCANLINE_ORDER_LOWER_FIELD_FIRST"},
+	},
+}
+
+// Returns the name of the constant, like "DXGI_MODE_SCANLINE_ORDER_UNSPECIFIED".
+func (v DXGI_MODE_SCANLINE_ORDER) String() string {
+	return _DXGI_MODE_SCANLINE_ORDER_names.String(v)
+}
+
+// Parses the name of a [DXGI_MODE_SCANLINE_ORDER] constant, or a number.
+func ParseDXGI_MODE_SCANLINE_ORDER(s string) (DXGI_MODE_SCANLINE_ORDER, error) {
+	return _DXGI_MODE_SCANLINE_ORDER_names.Parse(s)
+}
+
+var _DXGI_MODE_SCALING_names = enum.Enum[DXGI_MODE_SCALING]{
+	Type: "DXGI_MODE_SCALING",
+	Values: []enum.Name[DXGI_MODE_SCALING]{
+		{Val: DXGI_MODE_SCALING_UNSPECIFIED, Name: "DXGI_MODE_SCALING_UNSPECIFIED"},
+		{Val: DXGI_MODE_SCALING_CENTERED, Name: "DXGI_MODE_SCALING_CENTERED"},
+		{Val: DXGI_MODE_SCALING_STRETCHED, Name: "DXGI_MODE_SCALING_STRETCHED"},
+	},
+}
+
+// Returns the name of the constant, like "DXGI_MODE_SCALING_UNSPECIFIED".
+func (v DXGI_MODE_SCALING) String() string {
+	return _DXGI_MODE_SCALING_names.String(v)
+}
+
+// Parses the name of a [DXGI_MODE_SCALING] constant, or a number.
+func ParseDXGI_MODE_SCALING(s string) (DXGI_MODE_SCALING, error) {
+	return _DXGI_MODE_SCALING_names.Parse(s)
+}
+
+var _DXGI_MWA_names = enum.Flags[DXGI_MWA]{
+	Type: "DXGI_MWA",
+	Values: []enum.Name[DXGI_MWA]{
+		{Val: DXGI_MWA_NO_WINDOW_CHANGES, Name: "DXGI_MWA_NO_WINDOW_CHANGES"},
+		{Val: DXGI_MWA_NO_ALT_ENTER, Name: "DXGI_MWA_NO_ALT_ENTER"},
+		{Val: DXGI_MWA_NO_PRINT_SCREEN, Name: "DXGI_MWA_NO_PRINT_SCREEN"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_MWA_NO_WINDOW_CHANGES|DXGI_MWA_NO_ALT_ENTER".
+func (v DXGI_MWA) String() string {
+	return _DXGI_MWA_names.String(v)
+}
+
+// Parses names of [DXGI_MWA] flags joined by "|", or numbers.
+func ParseDXGI_MWA(s string) (DXGI_MWA, error) {
+	return _DXGI_MWA_names.Parse(s)
+}
+
+var _DXGI_PRESENT_names = enum.Flags[DXGI_PRESENT]{
+	Type: "DXGI_PRESENT",
+	Values: []enum.Name[DXGI_PRESENT]{
+		{Val: DXGI_PRESENT_DO_NOT_SEQUENCE, Name: "DXGI_PRESENT_DO_NOT_SEQUENCE"},
+		{Val: DXGI_PRESENT_TEST, Name: "DXGI_PRESENT_TEST"},
+		{Val: DXGI_PRESENT_RESTART, Name: "DXGI_PRESENT_RESTART"},
+		{Val: DXGI_PRESENT_DO_NOT_WAIT, Name: "DXGI_PRESENT_DO_NOT_WAIT"},
+		{Val: DXGI_PRESENT_RESTRICT_TO_OUTPUT, Name: "DXGI_PRESENT_RESTRICT_TO_OUTPUT"},
+		{Val: DXGI_PRESENT_STEREO_PREFER_RIGHT, Name: "DXGI_PRESENT_STEREO_PREFER_RIGHT"},
+		{Val: DXGI_PRESENT_STEREO_TEMPORARY_MONO, Name: "DXGI_PRESENT_STEREO_TEMPORARY_MONO"},
+		{Val: DXGI_PRESENT_USE_DURATION, Name: "DXGI_PRESENT_USE_DURATION"},
+		{Val: DXGI_PRESENT_ALLOW_TEARING, Name: "DXGI_PRESENT_ALLOW_TEARING"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_PRESENT_DO_NOT_SEQUENCE|DXGI_PRESENT_TEST".
+func (v DXGI_PRESENT) String() string {
+	return _DXGI_PRESENT_names.String(v)
+}
+
+// Parses names of [DXGI_PRESENT] flags joined by "|", or numbers.
+func ParseDXGI_PRESENT(s string) (DXGI_PRESENT, error) {
+	return _DXGI_PRESENT_names.Parse(s)
+}
+
+var _DXGI_SCALING_names = enum.Enum[DXGI_SCALING]{
+	Type: "DXGI_SCALING",
+	Values: []enum.Name[DXGI_SCALING]{
+		{Val: DXGI_SCALING_STRETCH, Name: "DXGI_SCALING_STRETCH"},
+		{Val: DXGI_SCALING_NONE, Name: "DXGI_SCALING_NONE"},
+		{Val: DXGI_SCALING_ASPECT_RATIO_STRETCH, Name: "DXGI_SCALING_ASPECT_RATIO_STRETCH"},
+	},
+}
+
+// Returns the name of the constant, like "DXGI_SCALING_STRETCH".
+func (v DXGI_SCALING) String() string {
+	return _DXGI_SCALING_names.String(v)
+}
+
+// Parses the name of a [DXGI_SCALING] constant, or a number.
+func ParseDXGI_SCALING(s string) (DXGI_SCALING, error) {
+	return _DXGI_SCALING_names.Parse(s)
+}
+
+var _DXGI_SWAP_CHAIN_FLAG_names = enum.Flags[DXGI_SWAP_CHAIN_FLAG]{
+	Type: "DXGI_SWAP_CHAIN_FLAG",
+	Values: []enum.Name[DXGI_SWAP_CHAIN_FLAG]{
+		{Val: DXGI_SWAP_CHAIN_FLAG_NONPREROTATED, Name: "DXGI_SWAP_CHAIN_FLAG_NONPREROTATED"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_ALLOW_MODE_SWITCH, Name: "DXGI_SWAP_CHAIN_FLAG_ALLOW_MODE_SWITCH"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_GDI_COMPATIBLE, Name: "DXGI_SWAP_CHAIN_FLAG_GDI_COMPATIBLE"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_RESTRICTED_CONTENT, Name: "DXGI_SWAP_CHAIN_FLAG_RESTRICTED_CONTENT"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_RESTRICT_SHARED_RESOURCE_DRIVER, Name: "DXGI_SWAP_CHAIN_FLAG_RESTRICT_SHARED_RESOURCE_DRIVER"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_DISPLAY_ONLY, Name: "DXGI_SWAP_CHAIN_FLAG_DISPLAY_ONLY"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_FRAME_LATENCY_WAITABLE_OBJECT, Name: "DXGI_SWAP_CHAIN_FLAG_FRAME_LATENCY_WAITABLE_OBJECT"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_FOREGROUND_LAYER, Name: "DXGI_SWAP_CHAIN_FLAG_FOREGROUND_LAYER"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_FULLSCREEN_VIDEO, Name: "DXGI_SWAP_CHAIN_FLAG_FULLSCREEN_VIDEO"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_YUV_VIDEO, Name: "DXGI_SWAP_CHAIN_FLAG_YUV_VIDEO"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_HW_PROTECTED, Name: "DXGI_SWAP_CHAIN_FLAG_HW_PROTECTED"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_ALLOW_TEARING, Name: "DXGI_SWAP_CHAIN_FLAG_ALLOW_TEARING"},
+		{Val: DXGI_SWAP_CHAIN_FLAG_RESTRICTED_TO_ALL_HOLOGRAPHIC_DISPLAYS, Name: "DXGI_SWAP_CHAIN_FLAG_RESTRICTED_TO_ALL_HOLOGRAPHIC_DISPLAYS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_SWAP_CHAIN_FLAG_NONPREROTATED|DXGI_SWAP_CHAIN_FLAG_ALLOW_MODE_SWITCH".
+func (v DXGI_SWAP_CHAIN_FLAG) String() string {
+	return _DXGI_SWAP_CHAIN_FLAG_names.String(v)
+}
+
+// Parses names of [DXGI_SWAP_CHAIN_FLAG] flags joined by "|", or numbers.
+func ParseDXGI_SWAP_CHAIN_FLAG(s string) (DXGI_SWAP_CHAIN_FLAG, error) {
+	return _DXGI_SWAP_CHAIN_FLAG_names.Parse(s)
+}
+
+var _DXGI_SWAP_EFFECT_names = enum.Flags[DXGI_SWAP_EFFECT]{
+	Type: "DXGI_SWAP_EFFECT",
+	Values: []enum.Name[DXGI_SWAP_EFFECT]{
+		{Val: DXGI_SWAP_EFFECT_FLIP_SEQUENTIAL, Name: "DXGI_SWAP_EFFECT_FLIP_SEQUENTIAL"},
+		{Val: DXGI_SWAP_EFFECT_SEQUENTIAL, Name: "DXGI_SWAP_EFFECT_SEQUENTIAL"},
+		{Val: DXGI_SWAP_EFFECT_FLIP_DISCARD, Name: "DXGI_SWAP_EFFECT_FLIP_DISCARD"},
+		{Val: DXGI_SWAP_EFFECT_DISCARD, Name: "DXGI_SWAP_EFFECT_DISCARD"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_SWAP_EFFECT_SEQUENTIAL|DXGI_SWAP_EFFECT_FLIP_DISCARD".
+func (v DXGI_SWAP_EFFECT) String() string {
+	return _DXGI_SWAP_EFFECT_names.String(v)
+}
+
+// Parses names of [DXGI_SWAP_EFFECT] flags joined by "|", or numbers.
+func ParseDXGI_SWAP_EFFECT(s string) (DXGI_SWAP_EFFECT, error) {
+	return _DXGI_SWAP_EFFECT_names.Parse(s)
+}
+
+var _DXGI_USAGE_names = enum.Flags[DXGI_USAGE]{
+	Type: "DXGI_USAGE",
+	Values: []enum.Name[DXGI_USAGE]{
+		{Val: DXGI_USAGE_SHADER_INPUT, Name: "DXGI_USAGE_SHADER_INPUT"},
+		{Val: DXGI_USAGE_RENDER_TARGET_OUTPUT, Name: "DXGI_USAGE_RENDER_TARGET_OUTPUT"},
+		{Val: DXGI_USAGE_BACK_BUFFER, Name: "DXGI_USAGE_BACK_BUFFER"},
+		{Val: DXGI_USAGE_SHARED, Name: "DXGI_USAGE_SHARED"},
+		{Val: DXGI_USAGE_READ_ONLY, Name: "DXGI_USAGE_READ_ONLY"},
+		{Val: DXGI_USAGE_DISCARD_ON_PRESENT, Name: "DXGI_USAGE_DISCARD_ON_PRESENT"},
+		{Val: DXGI_USAGE_UNORDERED_ACCESS, Name: "DXGI_USAGE_UNORDERED_ACCESS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "DXGI_USAGE_SHADER_INPUT|DXGI_USAGE_RENDER_TARGET_OUTPUT".
+func (v DXGI_USAGE) String() string {
+	return _DXGI_USAGE_names.String(v)
+}
+
+// Parses names of [DXGI_USAGE] flags joined by "|", or numbers.
+func ParseDXGI_USAGE(s string) (DXGI_USAGE, error) {
+	return _DXGI_USAGE_names.Parse(s)
+}
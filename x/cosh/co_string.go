@@ -0,0 +1,731 @@
+// Code generated by internal/cogen; DO NOT EDIT.
+
+//go:build windows
+
+package cosh
+
+import "github.com/rodrigocfd/windigo/internal/enum"
+
+var _FDAP_names = enum.Enum[FDAP]{
+	Type: "FDAP",
+	Values: []enum.Name[FDAP]{
+		{Val: FDAP_BOTTOM, Name: "FDAP_BOTTOM"},
+		{Val: FDAP_TOP, Name: "FDAP_TOP"},
+	},
+}
+
+// Returns the name of the constant, like "FDAP_BOTTOM".
+func (v FDAP) String() string {
+	return _FDAP_names.String(v)
+}
+
+// Parses the name of a [FDAP] constant, or a number.
+func ParseFDAP(s string) (FDAP, error) {
+	return _FDAP_names.Parse(s)
+}
+
+var _FDEOR_names = enum.Enum[FDEOR]{
+	Type: "FDEOR",
+	Values: []enum.Name[FDEOR]{
+		{Val: FDEOR_ACCEPT, Name: "FDEOR_ACCEPT"},
+		{Val: FDEOR_REFUSE, Name: "FDEOR_REFUSE"},
+		{Val: FDEOR_DEFAULT, Name: "FDEOR_DEFAULT"},
+	},
+}
+
+// Returns the name of the constant, like "FDEOR_ACCEPT".
+func (v FDEOR) String() string {
+	return _FDEOR_names.String(v)
+}
+
+// Parses the name of a [FDEOR] constant, or a number.
+func ParseFDEOR(s string) (FDEOR, error) {
+	return _FDEOR_names.Parse(s)
+}
+
+var _FDESVR_names = enum.Enum[FDESVR]{
+	Type: "FDESVR",
+	Values: []enum.Name[FDESVR]{
+		{Val: FDESVR_ACCEPT, Name: "FDESVR_ACCEPT"},
+		{Val: FDESVR_REFUSE, Name: "FDESVR_REFUSE"},
+		{Val: FDESVR_DEFAULT, Name: "FDESVR_DEFAULT"},
+	},
+}
+
+// Returns the name of the constant, like "FDESVR_ACCEPT".
+func (v FDESVR) String() string {
+	return _FDESVR_names.String(v)
+}
+
+// Parses the name of a [FDESVR] constant, or a number.
+func ParseFDESVR(s string) (FDESVR, error) {
+	return _FDESVR_names.Parse(s)
+}
+
+var _FOF_names = enum.Flags[FOF]{
+	Type: "FOF",
+	Values: []enum.Name[FOF]{
+		{Val: FOF_NO_UI, Name: "FOF_NO_UI"},
+		{Val: FOF_MULTIDESTFILES, Name: "FOF_MULTIDESTFILES"},
+		{Val: FOF_CONFIRMMOUSE, Name: "FOF_CONFIRMMOUSE"},
+		{Val: FOF_SILENT, Name: "FOF_SILENT"},
+		{Val: FOF_RENAMEONCOLLISION, Name: "FOF_RENAMEONCOLLISION"},
+		{Val: FOF_NOCONFIRMATION, Name: "FOF_NOCONFIRMATION"},
+		{Val: FOF_WANTMAPPINGHANDLE, Name: "FOF_WANTMAPPINGHANDLE"},
+		{Val: FOF_ALLOWUNDO, Name: "FOF_ALLOWUNDO"},
+		{Val: FOF_FILESONLY, Name: "FOF_FILESONLY"},
+		{Val: FOF_SIMPLEPROGRESS, Name: "FOF_SIMPLEPROGRESS"},
+		{Val: FOF_NOCONFIRMMKDIR, Name: "FOF_NOCONFIRMMKDIR"},
+		{Val: FOF_NOERRORUI, Name: "FOF_NOERRORUI"},
+		{Val: FOF_NOCOPYSECURITYATTRIBS, Name: "FOF_NOCOPYSECURITYATTRIBS"},
+		{Val: FOF_NORECURSION, Name: "FOF_NORECURSION"},
+		{Val: FOF_NO_CONNECTED_ELEMENTS, Name: "FOF_NO_CONNECTED_ELEMENTS"},
+		{Val: FOF_WANTNUKEWARNING, Name: "FOF_WANTNUKEWARNING"},
+		{Val: FOF_NORECURSEREPARSE, Name: "FOF_NORECURSEREPARSE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FOF_MULTIDESTFILES|FOF_CONFIRMMOUSE".
+func (v FOF) String() string {
+	return _FOF_names.String(v)
+}
+
+// Parses names of [FOF] flags joined by "|", or numbers.
+func ParseFOF(s string) (FOF, error) {
+	return _FOF_names.Parse(s)
+}
+
+var _FOS_names = enum.Flags[FOS]{
+	Type: "FOS",
+	Values: []enum.Name[FOS]{
+		{Val: FOS_OVERWRITEPROMPT, Name: "FOS_OVERWRITEPROMPT"},
+		{Val: FOS_STRICTFILETYPES, Name: "FOS_STRICTFILETYPES"},
+		{Val: FOS_NOCHANGEDIR, Name: "FOS_NOCHANGEDIR"},
+		{Val: FOS_PICKFOLDERS, Name: "FOS_PICKFOLDERS"},
+		{Val: FOS_FORCEFILESYSTEM, Name: "FOS_FORCEFILESYSTEM"},
+		{Val: FOS_ALLNONSTORAGEITEMS, Name: "FOS_ALLNONSTORAGEITEMS"},
+		{Val: FOS_NOVALIDATE, Name: "FOS_NOVALIDATE"},
+		{Val: FOS_ALLOWMULTISELECT, Name: "FOS_ALLOWMULTISELECT"},
+		{Val: FOS_PATHMUSTEXIST, Name: "FOS_PATHMUSTEXIST"},
+		{Val: FOS_FILEMUSTEXIST, Name: "FOS_FILEMUSTEXIST"},
+		{Val: FOS_CREATEPROMPT, Name: "FOS_CREATEPROMPT"},
+		{Val: FOS_SHAREAWARE, Name: "FOS_SHAREAWARE"},
+		{Val: FOS_NOREADONLYRETURN, Name: "FOS_NOREADONLYRETURN"},
+		{Val: FOS_NOTESTFILECREATE, Name: "FOS_NOTESTFILECREATE"},
+		{Val: FOS_HIDEMRUPLACES, Name: "FOS_HIDEMRUPLACES"},
+		{Val: FOS_HIDEPINNEDPLACES, Name: "FOS_HIDEPINNEDPLACES"},
+		{Val: FOS_NODEREFERENCELINKS, Name: "FOS_NODEREFERENCELINKS"},
+		{Val: FOS_OKBUTTONNEEDSINTERACTION, Name: "FOS_OKBUTTONNEEDSINTERACTION"},
+		{Val: FOS_DONTADDTORECENT, Name: "FOS_DONTADDTORECENT"},
+		{Val: FOS_FORCESHOWHIDDEN, Name: "FOS_FORCESHOWHIDDEN"},
+		{Val: FOS_DEFAULTNOMINIMODE, Name: "FOS_DEFAULTNOMINIMODE"},
+		{Val: FOS_FORCEPREVIEWPANEON, Name: "FOS_FORCEPREVIEWPANEON"},
+		{Val: FOS_SUPPORTSTREAMABLEITEMS, Name: "FOS_SUPPORTSTREAMABLEITEMS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "FOS_OVERWRITEPROMPT|FOS_STRICTFILETYPES".
+func (v FOS) String() string {
+	return _FOS_names.String(v)
+}
+
+// Parses names of [FOS] flags joined by "|", or numbers.
+func ParseFOS(s string) (FOS, error) {
+	return _FOS_names.Parse(s)
+}
+
+var _GPS_names = enum.Flags[GPS]{
+	Type: "GPS",
+	Values: []enum.Name[GPS]{
+		{Val: GPS_HANDLERPROPERTIESONLY, Name: "GPS_HANDLERPROPERTIESONLY"},
+		{Val: GPS_READWRITE, Name: "GPS_READWRITE"},
+		{Val: GPS_TEMPORARY, Name: "GPS_TEMPORARY"},
+		{Val: GPS_FASTPROPERTIESONLY, Name: "GPS_FASTPROPERTIESONLY"},
+		{Val: GPS_OPENSLOWITEM, Name: "GPS_OPENSLOWITEM"},
+		{Val: GPS_DELAYCREATION, Name: "GPS_DELAYCREATION"},
+		{Val: GPS_BESTEFFORT, Name: "GPS_BESTEFFORT"},
+		{Val: GPS_NO_OPLOCK, Name: "GPS_NO_OPLOCK"},
+		{Val: GPS_PREFERQUERYPROPERTIES, Name: "GPS_PREFERQUERYPROPERTIES"},
+		{Val: GPS_EXTRINSICPROPERTIES, Name: "GPS_EXTRINSICPROPERTIES"},
+		{Val: GPS_EXTRINSICPROPERTIESONLY, Name: "GPS_EXTRINSICPROPERTIESONLY"},
+		{Val: GPS_VOLATILEPROPERTIES, Name: "GPS_VOLATILEPROPERTIES"},
+		{Val: GPS_VOLATILEPROPERTIESONLY, Name: "GPS_VOLATILEPROPERTIESONLY"},
+		{Val: GPS_DEFAULT, Name: "GPS_DEFAULT"},
+	},
+	ParseOnly: []enum.Name[GPS]{
+		{Val: GPS_MASK_VALID, Name: "GPS_MASK_VALID"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "GPS_HANDLERPROPERTIESONLY|GPS_READWRITE".
+func (v GPS) String() string {
+	return _GPS_names.String(v)
+}
+
+// Parses names of [GPS] flags joined by "|", or numbers.
+func ParseGPS(s string) (GPS, error) {
+	return _GPS_names.Parse(s)
+}
+
+var _HOTKEYF_names = enum.Flags[HOTKEYF]{
+	Type: "HOTKEYF",
+	Values: []enum.Name[HOTKEYF]{
+		{Val: HOTKEYF_SHIFT, Name: "HOTKEYF_SHIFT"},
+		{Val: HOTKEYF_CONTROL, Name: "HOTKEYF_CONTROL"},
+		{Val: HOTKEYF_ALT, Name: "HOTKEYF_ALT"},
+		{Val: HOTKEYF_EXT, Name: "HOTKEYF_EXT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "HOTKEYF_SHIFT|HOTKEYF_CONTROL".
+func (v HOTKEYF) String() string {
+	return _HOTKEYF_names.String(v)
+}
+
+// Parses names of [HOTKEYF] flags joined by "|", or numbers.
+func ParseHOTKEYF(s string) (HOTKEYF, error) {
+	return _HOTKEYF_names.Parse(s)
+}
+
+var _KF_names = enum.Flags[KF]{
+	Type: "KF",
+	Values: []enum.Name[KF]{
+		{Val: KF_FORCE_APP_DATA_REDIRECTION, Name: "KF_FORCE_APP_DATA_REDIRECTION"},
+		{Val: KF_RETURN_FILTER_REDIRECTION_TARGET, Name: "KF_RETURN_FILTER_REDIRECTION_TARGET"},
+		{Val: KF_FORCE_PACKAGE_REDIRECTION, Name: "KF_FORCE_PACKAGE_REDIRECTION"},
+		{Val: KF_NO_PACKAGE_REDIRECTION, Name: "KF_NO_PACKAGE_REDIRECTION"},
+		{Val: KF_FORCE_APPCONTAINER_REDIRECTION, Name: "KF_FORCE_APPCONTAINER_REDIRECTION"},
+		{Val: KF_NO_APPCONTAINER_REDIRECTION, Name: "KF_NO_APPCONTAINER_REDIRECTION"},
+		{Val: KF_CREATE, Name: "KF_CREATE"},
+		{Val: KF_DONT_VERIFY, Name: "KF_DONT_VERIFY"},
+		{Val: KF_DONT_UNEXPAND, Name: "KF_DONT_UNEXPAND"},
+		{Val: KF_NO_ALIAS, Name: "KF_NO_ALIAS"},
+		{Val: KF_INIT, Name: "KF_INIT"},
+		{Val: KF_DEFAULT_PATH, Name: "KF_DEFAULT_PATH"},
+		{Val: KF_NOT_PARENT_RELATIVE, Name: "KF_NOT_PARENT_RELATIVE"},
+		{Val: KF_SIMPLE_IDLIST, Name: "KF_SIMPLE_IDLIST"},
+		{Val: KF_ALIAS_ONLY, Name: "KF_ALIAS_ONLY"},
+		{Val: KF_DEFAULT, Name: "KF_DEFAULT"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "KF_FORCE_APP_DATA_REDIRECTION|KF_RETURN_FILTER_REDIRECTION_TARGET".
+func (v KF) String() string {
+	return _KF_names.String(v)
+}
+
+// Parses names of [KF] flags joined by "|", or numbers.
+func ParseKF(s string) (KF, error) {
+	return _KF_names.Parse(s)
+}
+
+var _MDT_names = enum.Enum[MDT]{
+	Type: "MDT",
+	Values: []enum.Name[MDT]{
+		{Val: MDT_EFFECTIVE_DPI, Name: "MDT_EFFECTIVE_DPI"},
+		{Val: MDT_ANGULAR_DPI, Name: "MDT_ANGULAR_DPI"},
+		{Val: MDT_RAW_DPI, Name: "MDT_RAW_DPI"},
+		{Val: MDT_DEFAULT, Name: "MDT_DEFAULT"},
+	},
+}
+
+// Returns the name of the constant, like "MDT_EFFECTIVE_DPI".
+func (v MDT) String() string {
+	return _MDT_names.String(v)
+}
+
+// Parses the name of a [MDT] constant, or a number.
+func ParseMDT(s string) (MDT, error) {
+	return _MDT_names.Parse(s)
+}
+
+var _NIF_names = enum.Flags[NIF]{
+	Type: "NIF",
+	Values: []enum.Name[NIF]{
+		{Val: NIF_MESSAGE, Name: "NIF_MESSAGE"},
+		{Val: NIF_ICON, Name: "NIF_ICON"},
+		{Val: NIF_TIP, Name: "NIF_TIP"},
+		{Val: NIF_STATE, Name: "NIF_STATE"},
+		{Val: NIF_INFO, Name: "NIF_INFO"},
+		{Val: NIF_GUID, Name: "NIF_GUID"},
+		{Val: NIF_REALTIME, Name: "NIF_REALTIME"},
+		{Val: NIF_SHOWTIP, Name: "NIF_SHOWTIP"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "NIF_MESSAGE|NIF_ICON".
+func (v NIF) String() string {
+	return _NIF_names.String(v)
+}
+
+// Parses names of [NIF] flags joined by "|", or numbers.
+func ParseNIF(s string) (NIF, error) {
+	return _NIF_names.Parse(s)
+}
+
+var _NIIF_names = enum.Flags[NIIF]{
+	Type: "NIIF",
+	Values: []enum.Name[NIIF]{
+		{Val: NIIF_ERROR, Name: "NIIF_ERROR"},
+		{Val: NIIF_INFO, Name: "NIIF_INFO"},
+		{Val: NIIF_WARNING, Name: "NIIF_WARNING"},
+		{Val: NIIF_USER, Name: "NIIF_USER"},
+		{Val: NIIF_NOSOUND, Name: "NIIF_NOSOUND"},
+		{Val: NIIF_LARGE_ICON, Name: "NIIF_LARGE_ICON"},
+		{Val: NIIF_RESPECT_QUIET_TIME, Name: "NIIF_RESPECT_QUIET_TIME"},
+		{Val: NIIF_NONE, Name: "NIIF_NONE"},
+	},
+	ParseOnly: []enum.Name[NIIF]{
+		{Val: NIIF_ICON_MASK, Name: "NIIF_ICON_MASK"},
+	},
+	Fields: []NIIF{0xf},
+}
+
+// Returns the names of the flags joined by "|", like "NIIF_NOSOUND|NIIF_LARGE_ICON".
+func (v NIIF) String() string {
+	return _NIIF_names.String(v)
+}
+
+// Parses names of [NIIF] flags joined by "|", or numbers.
+func ParseNIIF(s string) (NIIF, error) {
+	return _NIIF_names.Parse(s)
+}
+
+var _NIM_names = enum.Enum[NIM]{
+	Type: "NIM",
+	Values: []enum.Name[NIM]{
+		{Val: NIM_ADD, Name: "NIM_ADD"},
+		{Val: NIM_MODIFY, Name: "NIM_MODIFY"},
+		{Val: NIM_DELETE, Name: "NIM_DELETE"},
+		{Val: NIM_SETFOCUS, Name: "NIM_SETFOCUS"},
+		{Val: NIM_SETVERSION, Name: "NIM_SETVERSION"},
+	},
+}
+
+// Returns the name of the constant, like "NIM_ADD".
+func (v NIM) String() string {
+	return _NIM_names.String(v)
+}
+
+// Parses the name of a [NIM] constant, or a number.
+func ParseNIM(s string) (NIM, error) {
+	return _NIM_names.Parse(s)
+}
+
+var _NIN_names = enum.Enum[NIN]{
+	Type: "NIN",
+	Values: []enum.Name[NIN]{
+		{Val: NIN_SELECT, Name: "NIN_SELECT"},
+		{Val: NIN_KEYSELECT, Name: "NIN_KEYSELECT"},
+		{Val: NIN_BALLOONSHOW, Name: "NIN_BALLOONSHOW"},
+		{Val: NIN_BALLOONHIDE, Name: "NIN_BALLOONHIDE"},
+		{Val: NIN_BALLOONTIMEOUT, Name: "NIN_BALLOONTIMEOUT"},
+		{Val: NIN_BALLOONUSERCLICK, Name: "NIN_BALLOONUSERCLICK"},
+		{Val: NIN_POPUPOPEN, Name: "NIN_POPUPOPEN"},
+		{Val: NIN_POPUPCLOSE, Name: "NIN_POPUPCLOSE"},
+	},
+}
+
+// Returns the name of the constant, like "NIN_SELECT".
+func (v NIN) String() string {
+	return _NIN_names.String(v)
+}
+
+// Parses the name of a [NIN] constant, or a number.
+func ParseNIN(s string) (NIN, error) {
+	return _NIN_names.Parse(s)
+}
+
+var _NIS_names = enum.Flags[NIS]{
+	Type: "NIS",
+	Values: []enum.Name[NIS]{
+		{Val: NIS_HIDDEN, Name: "NIS_HIDDEN"},
+		{Val: NIS_SHAREDICON, Name: "NIS_SHAREDICON"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "NIS_HIDDEN|NIS_SHAREDICON".
+func (v NIS) String() string {
+	return _NIS_names.String(v)
+}
+
+// Parses names of [NIS] flags joined by "|", or numbers.
+func ParseNIS(s string) (NIS, error) {
+	return _NIS_names.Parse(s)
+}
+
+var _PROCESS_DA_names = enum.Enum[PROCESS_DA]{
+	Type: "PROCESS_DA",
+	Values: []enum.Name[PROCESS_DA]{
+		{Val: PROCESS_DA_UNAWARE, Name: "PROCESS_DA_UNAWARE"},
+		{Val: PROCESS_DA_SYSTEM_AWARE, Name: "PROCESS_DA_SYSTEM_AWARE"},
+		{Val: PROCESS_DA_PER_MONITOR_AWARE, Name: "PROCESS_DA_PER_MONITOR_AWARE"},
+	},
+}
+
+// Returns the name of the constant, like "PROCESS_DA_UNAWARE".
+func (v PROCESS_DA) String() string {
+	return _PROCESS_DA_names.String(v)
+}
+
+// Parses the name of a [PROCESS_DA] constant, or a number.
+func ParsePROCESS_DA(s string) (PROCESS_DA, error) {
+	return _PROCESS_DA_names.Parse(s)
+}
+
+var _SFGAO_names = enum.Flags[SFGAO]{
+	Type: "SFGAO",
+	Values: []enum.Name[SFGAO]{
+		{Val: SFGAO_STORAGE, Name: "SFGAO_STORAGE"},
+		{Val: SFGAO_CANRENAME, Name: "SFGAO_CANRENAME"},
+		{Val: SFGAO_CANDELETE, Name: "SFGAO_CANDELETE"},
+		{Val: SFGAO_HASPROPSHEET, Name: "SFGAO_HASPROPSHEET"},
+		{Val: SFGAO_DROPTARGET, Name: "SFGAO_DROPTARGET"},
+		{Val: SFGAO_PLACEHOLDER, Name: "SFGAO_PLACEHOLDER"},
+		{Val: SFGAO_SYSTEM, Name: "SFGAO_SYSTEM"},
+		{Val: SFGAO_ENCRYPTED, Name: "SFGAO_ENCRYPTED"},
+		{Val: SFGAO_ISSLOW, Name: "SFGAO_ISSLOW"},
+		{Val: SFGAO_GHOSTED, Name: "SFGAO_GHOSTED"},
+		{Val: SFGAO_LINK, Name: "SFGAO_LINK"},
+		{Val: SFGAO_SHARE, Name: "SFGAO_SHARE"},
+		{Val: SFGAO_READONLY, Name: "SFGAO_READONLY"},
+		{Val: SFGAO_HIDDEN, Name: "SFGAO_HIDDEN"},
+		{Val: SFGAO_FILESYSANCESTOR, Name: "SFGAO_FILESYSANCESTOR"},
+		{Val: SFGAO_FOLDER, Name: "SFGAO_FOLDER"},
+		{Val: SFGAO_FILESYSTEM, Name: "SFGAO_FILESYSTEM"},
+		{Val: SFGAO_HASSUBFOLDER, Name: "SFGAO_HASSUBFOLDER"},
+		{Val: SFGAO_VALIDATE, Name: "SFGAO_VALIDATE"},
+		{Val: SFGAO_REMOVABLE, Name: "SFGAO_REMOVABLE"},
+		{Val: SFGAO_COMPRESSED, Name: "SFGAO_COMPRESSED"},
+		{Val: SFGAO_BROWSABLE, Name: "SFGAO_BROWSABLE"},
+		{Val: SFGAO_NONENUMERATED, Name: "SFGAO_NONENUMERATED"},
+		{Val: SFGAO_NEWCONTENT, Name: "SFGAO_NEWCONTENT"},
+		{Val: SFGAO_CANMONIKER, Name: "SFGAO_CANMONIKER"},
+		{Val: SFGAO_HASSTORAGE, Name: "SFGAO_HASSTORAGE"},
+		{Val: SFGAO_STREAM, Name: "SFGAO_STREAM"},
+		{Val: SFGAO_STORAGEANCESTOR, Name: "SFGAO_STORAGEANCESTOR"},
+		{Val: SFGAO_CANCOPY, Name: "SFGAO_CANCOPY"},
+		{Val: SFGAO_CANMOVE, Name: "SFGAO_CANMOVE"},
+		{Val: SFGAO_CANLINK, Name: "SFGAO_CANLINK"},
+	},
+	ParseOnly: []enum.Name[SFGAO]{
+		{Val: SFGAO_CAPABILITYMASK, Name: "SFGAO_CAPABILITYMASK"},
+		{Val: SFGAO_DISPLAYATTRMASK, Name: "SFGAO_DISPLAYATTRMASK"},
+		{Val: SFGAO_CONTENTSMASK, Name: "SFGAO_CONTENTSMASK"},
+		{Val: SFGAO_STORAGECAPMASK, Name: "SFGAO_STORAGECAPMASK"},
+		{Val: SFGAO_PKEYSFGAOMASK, Name: "SFGAO_PKEYSFGAOMASK"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SFGAO_STORAGE|SFGAO_CANRENAME".
+func (v SFGAO) String() string {
+	return _SFGAO_names.String(v)
+}
+
+// Parses names of [SFGAO] flags joined by "|", or numbers.
+func ParseSFGAO(s string) (SFGAO, error) {
+	return _SFGAO_names.Parse(s)
+}
+
+var _SHCIDS_names = enum.Flags[SHCIDS]{
+	Type: "SHCIDS",
+	Values: []enum.Name[SHCIDS]{
+		{Val: SHCIDS_ALLFIELDS, Name: "SHCIDS_ALLFIELDS"},
+		{Val: SHCIDS_CANONICALONLY, Name: "SHCIDS_CANONICALONLY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SHCIDS_ALLFIELDS|SHCIDS_CANONICALONLY".
+func (v SHCIDS) String() string {
+	return _SHCIDS_names.String(v)
+}
+
+// Parses names of [SHCIDS] flags joined by "|", or numbers.
+func ParseSHCIDS(s string) (SHCIDS, error) {
+	return _SHCIDS_names.Parse(s)
+}
+
+var _SHCONTF_names = enum.Flags[SHCONTF]{
+	Type: "SHCONTF",
+	Values: []enum.Name[SHCONTF]{
+		{Val: SHCONTF_CHECKING_FOR_CHILDREN, Name: "SHCONTF_CHECKING_FOR_CHILDREN"},
+		{Val: SHCONTF_FOLDERS, Name: "SHCONTF_FOLDERS"},
+		{Val: SHCONTF_NONFOLDERS, Name: "SHCONTF_NONFOLDERS"},
+		{Val: SHCONTF_INCLUDEHIDDEN, Name: "SHCONTF_INCLUDEHIDDEN"},
+		{Val: SHCONTF_INIT_ON_FIRST_NEXT, Name: "SHCONTF_INIT_ON_FIRST_NEXT"},
+		{Val: SHCONTF_NETPRINTERSRCH, Name: "SHCONTF_NETPRINTERSRCH"},
+		{Val: SHCONTF_SHAREABLE, Name: "SHCONTF_SHAREABLE"},
+		{Val: SHCONTF_STORAGE, Name: "SHCONTF_STORAGE"},
+		{Val: SHCONTF_NAVIGATION_ENUM, Name: "SHCONTF_NAVIGATION_ENUM"},
+		{Val: SHCONTF_FASTITEMS, Name: "SHCONTF_FASTITEMS"},
+		{Val: SHCONTF_FLATLIST, Name: "SHCONTF_FLATLIST"},
+		{Val: SHCONTF_ENABLE_ASYNC, Name: "SHCONTF_ENABLE_ASYNC"},
+		{Val: SHCONTF_INCLUDESUPERHIDDEN, Name: "SHCONTF_INCLUDESUPERHIDDEN"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SHCONTF_CHECKING_FOR_CHILDREN|SHCONTF_FOLDERS".
+func (v SHCONTF) String() string {
+	return _SHCONTF_names.String(v)
+}
+
+// Parses names of [SHCONTF] flags joined by "|", or numbers.
+func ParseSHCONTF(s string) (SHCONTF, error) {
+	return _SHCONTF_names.Parse(s)
+}
+
+var _SHGDN_names = enum.Flags[SHGDN]{
+	Type: "SHGDN",
+	Values: []enum.Name[SHGDN]{
+		{Val: SHGDN_INFOLDER, Name: "SHGDN_INFOLDER"},
+		{Val: SHGDN_FOREDITING, Name: "SHGDN_FOREDITING"},
+		{Val: SHGDN_FORADDRESSBAR, Name: "SHGDN_FORADDRESSBAR"},
+		{Val: SHGDN_FORPARSING, Name: "SHGDN_FORPARSING"},
+		{Val: SHGDN_NORMAL, Name: "SHGDN_NORMAL"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SHGDN_INFOLDER|SHGDN_FOREDITING".
+func (v SHGDN) String() string {
+	return _SHGDN_names.String(v)
+}
+
+// Parses names of [SHGDN] flags joined by "|", or numbers.
+func ParseSHGDN(s string) (SHGDN, error) {
+	return _SHGDN_names.Parse(s)
+}
+
+var _SHGFI_names = enum.Flags[SHGFI]{
+	Type: "SHGFI",
+	Values: []enum.Name[SHGFI]{
+		{Val: SHGFI_ICON, Name: "SHGFI_ICON"},
+		{Val: SHGFI_DISPLAYNAME, Name: "SHGFI_DISPLAYNAME"},
+		{Val: SHGFI_TYPENAME, Name: "SHGFI_TYPENAME"},
+		{Val: SHGFI_ATTRIBUTES, Name: "SHGFI_ATTRIBUTES"},
+		{Val: SHGFI_ICONLOCATION, Name: "SHGFI_ICONLOCATION"},
+		{Val: SHGFI_EXETYPE, Name: "SHGFI_EXETYPE"},
+		{Val: SHGFI_SYSICONINDEX, Name: "SHGFI_SYSICONINDEX"},
+		{Val: SHGFI_LINKOVERLAY, Name: "SHGFI_LINKOVERLAY"},
+		{Val: SHGFI_SELECTED, Name: "SHGFI_SELECTED"},
+		{Val: SHGFI_ATTR_SPECIFIED, Name: "SHGFI_ATTR_SPECIFIED"},
+		{Val: SHGFI_SMALLICON, Name: "SHGFI_SMALLICON"},
+		{Val: SHGFI_OPENICON, Name: "SHGFI_OPENICON"},
+		{Val: SHGFI_SHELLICONSIZE, Name: "SHGFI_SHELLICONSIZE"},
+		{Val: SHGFI_PIDL, Name: "SHGFI_PIDL"},
+		{Val: SHGFI_USEFILEATTRIBUTES, Name: "SHGFI_USEFILEATTRIBUTES"},
+		{Val: SHGFI_ADDOVERLAYS, Name: "SHGFI_ADDOVERLAYS"},
+		{Val: SHGFI_OVERLAYINDEX, Name: "SHGFI_OVERLAYINDEX"},
+		{Val: SHGFI_NONE, Name: "SHGFI_NONE"},
+		{Val: SHGFI_LARGEICON, Name: "SHGFI_LARGEICON"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SHGFI_ICON|SHGFI_DISPLAYNAME".
+func (v SHGFI) String() string {
+	return _SHGFI_names.String(v)
+}
+
+// Parses names of [SHGFI] flags joined by "|", or numbers.
+func ParseSHGFI(s string) (SHGFI, error) {
+	return _SHGFI_names.Parse(s)
+}
+
+var _SICHINT_names = enum.Flags[SICHINT]{
+	Type: "SICHINT",
+	Values: []enum.Name[SICHINT]{
+		{Val: SICHINT_ALLFIELDS, Name: "SICHINT_ALLFIELDS"},
+		{Val: SICHINT_CANONICAL, Name: "SICHINT_CANONICAL"},
+		{Val: SICHINT_TEST_FILESYSPATH_IF_NOT_EQUAL, Name: "SICHINT_TEST_FILESYSPATH_IF_NOT_EQUAL"},
+		{Val: SICHINT_DISPLAY, Name: "SICHINT_DISPLAY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SICHINT_ALLFIELDS|SICHINT_CANONICAL".
+func (v SICHINT) String() string {
+	return _SICHINT_names.String(v)
+}
+
+// Parses names of [SICHINT] flags joined by "|", or numbers.
+func ParseSICHINT(s string) (SICHINT, error) {
+	return _SICHINT_names.Parse(s)
+}
+
+var _SIGDN_names = enum.Enum[SIGDN]{
+	Type: "SIGDN",
+	Values: []enum.Name[SIGDN]{
+		{Val: SIGDN_NORMALDISPLAY, Name: "SIGDN_NORMALDISPLAY"},
+		{Val: SIGDN_PARENTRELATIVEPARSING, Name: "SIGDN_PARENTRELATIVEPARSING"},
+		{Val: SIGDN_DESKTOPABSOLUTEPARSING, Name: "SIGDN_DESKTOPABSOLUTEPARSING"},
+		{Val: SIGDN_PARENTRELATIVEEDITING, Name: "SIGDN_PARENTRELATIVEEDITING"},
+		{Val: SIGDN_DESKTOPABSOLUTEEDITING, Name: "SIGDN_DESKTOPABSOLUTEEDITING"},
+		{Val: SIGDN_FILESYSPATH, Name: "SIGDN_FILESYSPATH"},
+		{Val: SIGDN_URL, Name: "SIGDN_URL"},
+		{Val: SIGDN_PARENTRELATIVEFORADDRESSBAR, Name: "SIGDN_PARENTRELATIVEFORADDRESSBAR"},
+		{Val: SIGDN_PARENTRELATIVE, Name: "SIGDN_PARENTRELATIVE"},
+		{Val: SIGDN_PARENTRELATIVEFORUI, Name: "SIGDN_PARENTRELATIVEFORUI"},
+	},
+}
+
+// Returns the name of the constant, like "SIGDN_NORMALDISPLAY".
+func (v SIGDN) String() string {
+	return _SIGDN_names.String(v)
+}
+
+// Parses the name of a [SIGDN] constant, or a number.
+func ParseSIGDN(s string) (SIGDN, error) {
+	return _SIGDN_names.Parse(s)
+}
+
+var _SLGP_names = enum.Flags[SLGP]{
+	Type: "SLGP",
+	Values: []enum.Name[SLGP]{
+		{Val: SLGP_SHORTPATH, Name: "SLGP_SHORTPATH"},
+		{Val: SLGP_UNCPRIORITY, Name: "SLGP_UNCPRIORITY"},
+		{Val: SLGP_RAWPATH, Name: "SLGP_RAWPATH"},
+		{Val: SLGP_RELATIVEPRIORITY, Name: "SLGP_RELATIVEPRIORITY"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "SLGP_SHORTPATH|SLGP_UNCPRIORITY".
+func (v SLGP) String() string {
+	return _SLGP_names.String(v)
+}
+
+// Parses names of [SLGP] flags joined by "|", or numbers.
+func ParseSLGP(s string) (SLGP, error) {
+	return _SLGP_names.Parse(s)
+}
+
+var _STPFLAG_names = enum.Flags[STPFLAG]{
+	Type: "STPFLAG",
+	Values: []enum.Name[STPFLAG]{
+		{Val: STPFLAG_USEAPPTHUMBNAILALWAYS, Name: "STPFLAG_USEAPPTHUMBNAILALWAYS"},
+		{Val: STPFLAG_USEAPPTHUMBNAILWHENACTIVE, Name: "STPFLAG_USEAPPTHUMBNAILWHENACTIVE"},
+		{Val: STPFLAG_USEAPPPEEKALWAYS, Name: "STPFLAG_USEAPPPEEKALWAYS"},
+		{Val: STPFLAG_USEAPPPEEKWHENACTIVE, Name: "STPFLAG_USEAPPPEEKWHENACTIVE"},
+		{Val: STPFLAG_NONE, Name: "STPFLAG_NONE"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "STPFLAG_USEAPPTHUMBNAILALWAYS|STPFLAG_USEAPPTHUMBNAILWHENACTIVE".
+func (v STPFLAG) String() string {
+	return _STPFLAG_names.String(v)
+}
+
+// Parses names of [STPFLAG] flags joined by "|", or numbers.
+func ParseSTPFLAG(s string) (STPFLAG, error) {
+	return _STPFLAG_names.Parse(s)
+}
+
+var _SVUIA_names = enum.Enum[SVUIA]{
+	Type: "SVUIA",
+	Values: []enum.Name[SVUIA]{
+		{Val: SVUIA_ACTIVATE_NOFOCUS, Name: "SVUIA_ACTIVATE_NOFOCUS"},
+		{Val: SVUIA_ACTIVATE_FOCUS, Name: "SVUIA_ACTIVATE_FOCUS"},
+		{Val: SVUIA_INPLACEACTIVATE, Name: "SVUIA_INPLACEACTIVATE"},
+		{Val: SVUIA_DEACTIVATE, Name: "SVUIA_DEACTIVATE"},
+	},
+}
+
+// Returns the name of the constant, like "SVUIA_ACTIVATE_NOFOCUS".
+func (v SVUIA) String() string {
+	return _SVUIA_names.String(v)
+}
+
+// Parses the name of a [SVUIA] constant, or a number.
+func ParseSVUIA(s string) (SVUIA, error) {
+	return _SVUIA_names.Parse(s)
+}
+
+var _TBPF_names = enum.Flags[TBPF]{
+	Type: "TBPF",
+	Values: []enum.Name[TBPF]{
+		{Val: TBPF_INDETERMINATE, Name: "TBPF_INDETERMINATE"},
+		{Val: TBPF_NORMAL, Name: "TBPF_NORMAL"},
+		{Val: TBPF_ERROR, Name: "TBPF_ERROR"},
+		{Val: TBPF_PAUSED, Name: "TBPF_PAUSED"},
+		{Val: TBPF_NOPROGRESS, Name: "TBPF_NOPROGRESS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TBPF_INDETERMINATE|TBPF_NORMAL".
+func (v TBPF) String() string {
+	return _TBPF_names.String(v)
+}
+
+// Parses names of [TBPF] flags joined by "|", or numbers.
+func ParseTBPF(s string) (TBPF, error) {
+	return _TBPF_names.Parse(s)
+}
+
+var _THB_names = enum.Flags[THB]{
+	Type: "THB",
+	Values: []enum.Name[THB]{
+		{Val: THB_BITMAP, Name: "THB_BITMAP"},
+		{Val: THB_ICON, Name: "THB_ICON"},
+		{Val: THB_TOOLTIP, Name: "THB_TOOLTIP"},
+		{Val: THB_FLAGS, Name: "THB_FLAGS"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "THB_BITMAP|THB_ICON".
+func (v THB) String() string {
+	return _THB_names.String(v)
+}
+
+// Parses names of [THB] flags joined by "|", or numbers.
+func ParseTHB(s string) (THB, error) {
+	return _THB_names.Parse(s)
+}
+
+var _THBF_names = enum.Flags[THBF]{
+	Type: "THBF",
+	Values: []enum.Name[THBF]{
+		{Val: THBF_DISABLED, Name: "THBF_DISABLED"},
+		{Val: THBF_DISMISSONCLICK, Name: "THBF_DISMISSONCLICK"},
+		{Val: THBF_NOBACKGROUND, Name: "THBF_NOBACKGROUND"},
+		{Val: THBF_HIDDEN, Name: "THBF_HIDDEN"},
+		{Val: THBF_NONINTERACTIVE, Name: "THBF_NONINTERACTIVE"},
+		{Val: THBF_ENABLED, Name: "THBF_ENABLED"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "THBF_DISABLED|THBF_DISMISSONCLICK".
+func (v THBF) String() string {
+	return _THBF_names.String(v)
+}
+
+// Parses names of [THBF] flags joined by "|", or numbers.
+func ParseTHBF(s string) (THBF, error) {
+	return _THBF_names.Parse(s)
+}
+
+var _TSF_names = enum.Flags[TSF]{
+	Type: "TSF",
+	Values: []enum.Name[TSF]{
+		{Val: TSF_RENAME_EXIST, Name: "TSF_RENAME_EXIST"},
+		{Val: TSF_OVERWRITE_EXIST, Name: "TSF_OVERWRITE_EXIST"},
+		{Val: TSF_ALLOW_DECRYPTION, Name: "TSF_ALLOW_DECRYPTION"},
+		{Val: TSF_NO_SECURITY, Name: "TSF_NO_SECURITY"},
+		{Val: TSF_COPY_CREATION_TIME, Name: "TSF_COPY_CREATION_TIME"},
+		{Val: TSF_COPY_WRITE_TIME, Name: "TSF_COPY_WRITE_TIME"},
+		{Val: TSF_USE_FULL_ACCESS, Name: "TSF_USE_FULL_ACCESS"},
+		{Val: TSF_DELETE_RECYCLE_IF_POSSIBLE, Name: "TSF_DELETE_RECYCLE_IF_POSSIBLE"},
+		{Val: TSF_COPY_HARD_LINK, Name: "TSF_COPY_HARD_LINK"},
+		{Val: TSF_COPY_LOCALIZED_NAME, Name: "TSF_COPY_LOCALIZED_NAME"},
+		{Val: TSF_MOVE_AS_COPY_DELETE, Name: "TSF_MOVE_AS_COPY_DELETE"},
+		{Val: TSF_SUSPEND_SHELLEVENTS, Name: "TSF_SUSPEND_SHELLEVENTS"},
+		{Val: TSF_NORMAL, Name: "TSF_NORMAL"},
+		{Val: TSF_FAIL_EXIST, Name: "TSF_FAIL_EXIST"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "TSF_RENAME_EXIST|TSF_OVERWRITE_EXIST".
+func (v TSF) String() string {
+	return _TSF_names.String(v)
+}
+
+// Parses names of [TSF] flags joined by "|", or numbers.
+func ParseTSF(s string) (TSF, error) {
+	return _TSF_names.Parse(s)
+}
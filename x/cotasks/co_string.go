@@ -0,0 +1,160 @@
+// Code generated by internal/cogen; DO NOT EDIT.
+
+//go:build windows
+
+package cotasks
+
+import "github.com/rodrigocfd/windigo/internal/enum"
+
+var _TASK_COMPATIBILITY_names = enum.Enum[TASK_COMPATIBILITY]{
+	Type: "TASK_COMPATIBILITY",
+	Values: []enum.Name[TASK_COMPATIBILITY]{
+		{Val: TASK_COMPATIBILITY_AT, Name: "TASK_COMPATIBILITY_AT"},
+		{Val: TASK_COMPATIBILITY_V1, Name: "TASK_COMPATIBILITY_V1"},
+		{Val: TASK_COMPATIBILITY_V2, Name: "TASK_COMPATIBILITY_V2"},
+		{Val: TASK_COMPATIBILITY_V2_1, Name: "TASK_COMPATIBILITY_V2_1"},
+		{Val: TASK_COMPATIBILITY_V2_2, Name: "TASK_COMPATIBILITY_V2_2"},
+		{Val: TASK_COMPATIBILITY_V2_3, Name: "TASK_COMPATIBILITY_V2_3"},
+		{Val: TASK_COMPATIBILITY_V2_4, Name: "TASK_COMPATIBILITY_V2_4"},
+	},
+}
+
+// Returns the name of the constant, like "TASK_COMPATIBILITY_AT".
+func (v TASK_COMPATIBILITY) String() string {
+	return _TASK_COMPATIBILITY_names.String(v)
+}
+
+// Parses the name of a [TASK_COMPATIBILITY] constant, or a number.
+func ParseTASK_COMPATIBILITY(s string) (TASK_COMPATIBILITY, error) {
+	return _TASK_COMPATIBILITY_names.Parse(s)
+}
+
+var _TASK_ACTION_names = enum.Enum[TASK_ACTION]{
+	Type: "TASK_ACTION",
+	Values: []enum.Name[TASK_ACTION]{
+		{Val: TASK_ACTION_EXEC, Name: "TASK_ACTION_EXEC"},
+		{Val: TASK_ACTION_COM_HANDLER, Name: "TASK_ACTION_COM_HANDLER"},
+		{Val: TASK_ACTION_SEND_EMAIL, Name: "TASK_ACTION_SEND_EMAIL"},
+		{Val: TASK_ACTION_SHOW_MESSAGE, Name: "TASK_ACTION_SHOW_MESSAGE"},
+	},
+}
+
+// Returns the name of the constant, like "TASK_ACTION_EXEC".
+func (v TASK_ACTION) String() string {
+	return _TASK_ACTION_names.String(v)
+}
+
+// Parses the name of a [TASK_ACTION] constant, or a number.
+func ParseTASK_ACTION(s string) (TASK_ACTION, error) {
+	return _TASK_ACTION_names.Parse(s)
+}
+
+var _TASK_INSTANCES_names = enum.Enum[TASK_INSTANCES]{
+	Type: "TASK_INSTANCES",
+	Values: []enum.Name[TASK_INSTANCES]{
+		{Val: TASK_INSTANCES_PARALLEL, Name: "TASK_INSTANCES_PARALLEL"},
+		{Val: TASK_INSTANCES_QUEUE, Name: "TASK_INSTANCES_QUEUE"},
+		{Val: TASK_INSTANCES_IGNORE_NEW, Name: "TASK_INSTANCES_IGNORE_NEW"},
+		{Val: TASK_INSTANCES_STOP_EXISTING, Name: "TASK_INSTANCES_STOP_EXISTING"},
+	},
+}
+
+// Returns the name of the constant, like "TASK_INSTANCES_PARALLEL".
+func (v TASK_INSTANCES) String() string {
+	return _TASK_INSTANCES_names.String(v)
+}
+
+// Parses the name of a [TASK_INSTANCES] constant, or a number.
+func ParseTASK_INSTANCES(s string) (TASK_INSTANCES, error) {
+	return _TASK_INSTANCES_names.Parse(s)
+}
+
+var _TASK_LOGON_names = enum.Enum[TASK_LOGON]{
+	Type: "TASK_LOGON",
+	Values: []enum.Name[TASK_LOGON]{
+		{Val: TASK_LOGON_NONE, Name: "TASK_LOGON_NONE"},
+		{Val: TASK_LOGON_PASSWORD, Name: "TASK_LOGON_PASSWORD"},
+		{Val: TASK_LOGON_S4U, Name: "TASK_LOGON_S4U"},
+		{Val: TASK_LOGON_INTERACTIVE_TOKEN, Name: "TASK_LOGON_INTERACTIVE_TOKEN"},
+		{Val: TASK_LOGON_GROUP, Name: "TASK_LOGON_GROUP"},
+		{Val: TASK_LOGON_SERVICE_ACCOUNT, Name: "TASK_LOGON_SERVICE_ACCOUNT"},
+		{Val: TASK_LOGON_INTERACTIVE_TOKEN_OR_PASSWORD, Name: "TASK_LOGON_INTERACTIVE_TOKEN_OR_PASSWORD"},
+	},
+}
+
+// Returns the name of the constant, like "TASK_LOGON_NONE".
+func (v TASK_LOGON) String() string {
+	return _TASK_LOGON_names.String(v)
+}
+
+// Parses the name of a [TASK_LOGON] constant, or a number.
+func ParseTASK_LOGON(s string) (TASK_LOGON, error) {
+	return _TASK_LOGON_names.Parse(s)
+}
+
+var _TASK_RUNLEVEL_names = enum.Enum[TASK_RUNLEVEL]{
+	Type: "TASK_RUNLEVEL",
+	Values: []enum.Name[TASK_RUNLEVEL]{
+		{Val: TASK_RUNLEVEL_LUA, Name: "TASK_RUNLEVEL_LUA"},
+		{Val: TASK_RUNLEVEL_HIGHEST, Name: "TASK_RUNLEVEL_HIGHEST"},
+	},
+}
+
+// Returns the name of the constant, like "TASK_RUNLEVEL_LUA".
+func (v TASK_RUNLEVEL) String() string {
+	return _TASK_RUNLEVEL_names.String(v)
+}
+
+// Parses the name of a [TASK_RUNLEVEL] constant, or a number.
+func ParseTASK_RUNLEVEL(s string) (TASK_RUNLEVEL, error) {
+	return _TASK_RUNLEVEL_names.Parse(s)
+}
+
+var _TASK_STATE_names = enum.Enum[TASK_STATE]{
+	Type: "TASK_STATE",
+	Values: []enum.Name[TASK_STATE]{
+		{Val: TASK_STATE_UNKNOWN, Name: "TASK_STATE_UNKNOWN"},
+		{Val: TASK_STATE_DISABLED, Name: "TASK_STATE_DISABLED"},
+		{Val: TASK_STATE_QUEUED, Name: "TASK_STATE_QUEUED"},
+		{Val: TASK_STATE_READY, Name: "TASK_STATE_READY"},
+		{Val: TASK_STATE_RUNNING, Name: "TASK_STATE_RUNNING"},
+	},
+}
+
+// Returns the name of the constant, like "TASK_STATE_UNKNOWN".
+func (v TASK_STATE) String() string {
+	return _TASK_STATE_names.String(v)
+}
+
+// Parses the name of a [TASK_STATE] constant, or a number.
+func ParseTASK_STATE(s string) (TASK_STATE, error) {
+	return _TASK_STATE_names.Parse(s)
+}
+
+var _TASK_TRIGGER2_names = enum.Enum[TASK_TRIGGER2]{
+	Type: "TASK_TRIGGER2",
+	Values: []enum.Name[TASK_TRIGGER2]{
+		{Val: TASK_TRIGGER2_EVENT, Name: "TASK_TRIGGER2_EVENT"},
+		{Val: TASK_TRIGGER2_TIME, Name: "TASK_TRIGGER2_TIME"},
+		{Val: TASK_TRIGGER2_DAILY, Name: "TASK_TRIGGER2_DAILY"},
+		{Val: TASK_TRIGGER2_WEEKLY, Name: "TASK_TRIGGER2_WEEKLY"},
+		{Val: TASK_TRIGGER2_MONTHLY, Name: "TASK_TRIGGER2_MONTHLY"},
+		{Val: TASK_TRIGGER2_MONTHLYDOW, Name: "TASK_TRIGGER2_MONTHLYDOW"},
+		{Val: TASK_TRIGGER2_IDLE, Name: "TASK_TRIGGER2_IDLE"},
+		{Val: TASK_TRIGGER2_REGISTRATION, Name: "TASK_TRIGGER2_REGISTRATION"},
+		{Val: TASK_TRIGGER2_BOOT, Name: "TASK_TRIGGER2_BOOT"},
+		{Val: TASK_TRIGGER2_LOGON, Name: "TASK_TRIGGER2_LOGON"},
+		{Val: TASK_TRIGGER2_SESSION_STATE_CHANGE, Name: "TASK_TRIGGER2_SESSION_STATE_CHANGE"},
+		{Val: TASK_TRIGGER2_CUSTOM_TRIGGER_01, Name: "TASK_TRIGGER2_CUSTOM_TRIGGER_01"},
+	},
+}
+
+// Returns the name of the constant, like "TASK_TRIGGER2_EVENT".
+func (v TASK_TRIGGER2) String() string {
+	return _TASK_TRIGGER2_names.String(v)
+}
+
+// Parses the name of a [TASK_TRIGGER2] constant, or a number.
+func ParseTASK_TRIGGER2(s string) (TASK_TRIGGER2, error) {
+	return _TASK_TRIGGER2_names.Parse(s)
+}
@@ -0,0 +1,241 @@
+// Code generated by internal/cogen; DO NOT EDIT.
+
+//go:build windows
+
+package cowic
+
+import "github.com/rodrigocfd/windigo/internal/enum"
+
+var _WIC_COMPONENTENUM_names = enum.Flags[WIC_COMPONENTENUM]{
+	Type: "WIC_COMPONENTENUM",
+	Values: []enum.Name[WIC_COMPONENTENUM]{
+		{Val: WIC_COMPONENTENUM_Refresh, Name: "WIC_COMPONENTENUM_Refresh"},
+		{Val: WIC_COMPONENTENUM_Disabled, Name: "WIC_COMPONENTENUM_Disabled"},
+		{Val: WIC_COMPONENTENUM_Unsigned, Name: "WIC_COMPONENTENUM_Unsigned"},
+		{Val: WIC_COMPONENTENUM_BuiltInOnly, Name: "WIC_COMPONENTENUM_BuiltInOnly"},
+		{Val: WIC_COMPONENTENUM_Default, Name: "WIC_COMPONENTENUM_Default"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WIC_COMPONENTENUM_Refresh|WIC_COMPONENTENUM_Disabled".
+func (v WIC_COMPONENTENUM) String() string {
+	return _WIC_COMPONENTENUM_names.String(v)
+}
+
+// Parses names of [WIC_COMPONENTENUM] flags joined by "|", or numbers.
+func ParseWIC_COMPONENTENUM(s string) (WIC_COMPONENTENUM, error) {
+	return _WIC_COMPONENTENUM_names.Parse(s)
+}
+
+var _WIC_COMPONENTSIGN_names = enum.Flags[WIC_COMPONENTSIGN]{
+	Type: "WIC_COMPONENTSIGN",
+	Values: []enum.Name[WIC_COMPONENTSIGN]{
+		{Val: WIC_COMPONENTSIGN_Signed, Name: "WIC_COMPONENTSIGN_Signed"},
+		{Val: WIC_COMPONENTSIGN_Unsigned, Name: "WIC_COMPONENTSIGN_Unsigned"},
+		{Val: WIC_COMPONENTSIGN_Safe, Name: "WIC_COMPONENTSIGN_Safe"},
+		{Val: WIC_COMPONENTSIGN_Disabled, Name: "WIC_COMPONENTSIGN_Disabled"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WIC_COMPONENTSIGN_Signed|WIC_COMPONENTSIGN_Unsigned".
+func (v WIC_COMPONENTSIGN) String() string {
+	return _WIC_COMPONENTSIGN_names.String(v)
+}
+
+// Parses names of [WIC_COMPONENTSIGN] flags joined by "|", or numbers.
+func ParseWIC_COMPONENTSIGN(s string) (WIC_COMPONENTSIGN, error) {
+	return _WIC_COMPONENTSIGN_names.Parse(s)
+}
+
+var _WIC_COMPONENTTYPE_names = enum.Flags[WIC_COMPONENTTYPE]{
+	Type: "WIC_COMPONENTTYPE",
+	Values: []enum.Name[WIC_COMPONENTTYPE]{
+		{Val: WIC_COMPONENTTYPE_AllComponents, Name: "WIC_COMPONENTTYPE_AllComponents"},
+		{Val: WIC_COMPONENTTYPE_Decoder, Name: "WIC_COMPONENTTYPE_Decoder"},
+		{Val: WIC_COMPONENTTYPE_Encoder, Name: "WIC_COMPONENTTYPE_Encoder"},
+		{Val: WIC_COMPONENTTYPE_PixelFormatConverter, Name: "WIC_COMPONENTTYPE_PixelFormatConverter"},
+		{Val: WIC_COMPONENTTYPE_MetadataReader, Name: "WIC_COMPONENTTYPE_MetadataReader"},
+		{Val: WIC_COMPONENTTYPE_MetadataWriter, Name: "WIC_COMPONENTTYPE_MetadataWriter"},
+		{Val: WIC_COMPONENTTYPE_PixelFormat, Name: "WIC_COMPONENTTYPE_PixelFormat"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WIC_COMPONENTTYPE_Decoder|WIC_COMPONENTTYPE_Encoder".
+func (v WIC_COMPONENTTYPE) String() string {
+	return _WIC_COMPONENTTYPE_names.String(v)
+}
+
+// Parses names of [WIC_COMPONENTTYPE] flags joined by "|", or numbers.
+func ParseWIC_COMPONENTTYPE(s string) (WIC_COMPONENTTYPE, error) {
+	return _WIC_COMPONENTTYPE_names.Parse(s)
+}
+
+var _WICBMP_ALPHACH_names = enum.Enum[WICBMP_ALPHACH]{
+	Type: "WICBMP_ALPHACH",
+	Values: []enum.Name[WICBMP_ALPHACH]{
+		{Val: WICBMP_ALPHACH_UseAlpha, Name: "WICBMP_ALPHACH_UseAlpha"},
+		{Val: WICBMP_ALPHACH_UsePremultipliedAlpha, Name: "WICBMP_ALPHACH_UsePremultipliedAlpha"},
+		{Val: WICBMP_ALPHACH_IgnoreAlpha, Name: "WICBMP_ALPHACH_IgnoreAlpha"},
+	},
+}
+
+// Returns the name of the constant, like "WICBMP_ALPHACH_UseAlpha".
+func (v WICBMP_ALPHACH) String() string {
+	return _WICBMP_ALPHACH_names.String(v)
+}
+
+// Parses the name of a [WICBMP_ALPHACH] constant, or a number.
+func ParseWICBMP_ALPHACH(s string) (WICBMP_ALPHACH, error) {
+	return _WICBMP_ALPHACH_names.Parse(s)
+}
+
+var _WICBMP_CACHE_names = enum.Enum[WICBMP_CACHE]{
+	Type: "WICBMP_CACHE",
+	Values: []enum.Name[WICBMP_CACHE]{
+		{Val: WICBMP_CACHE_No, Name: "WICBMP_CACHE_No"},
+		{Val: WICBMP_CACHE_OnDemand, Name: "WICBMP_CACHE_OnDemand"},
+		{Val: WICBMP_CACHE_OnLoad, Name: "WICBMP_CACHE_OnLoad"},
+	},
+}
+
+// Returns the name of the constant, like "WICBMP_CACHE_No".
+func (v WICBMP_CACHE) String() string {
+	return _WICBMP_CACHE_names.String(v)
+}
+
+// Parses the name of a [WICBMP_CACHE] constant, or a number.
+func ParseWICBMP_CACHE(s string) (WICBMP_CACHE, error) {
+	return _WICBMP_CACHE_names.Parse(s)
+}
+
+var _WICBMP_DITHER_names = enum.Enum[WICBMP_DITHER]{
+	Type: "WICBMP_DITHER",
+	Values: []enum.Name[WICBMP_DITHER]{
+		{Val: WICBMP_DITHER_None, Name: "WICBMP_DITHER_None"},
+		{Val: WICBMP_DITHER_Solid, Name: "WICBMP_DITHER_Solid"},
+		{Val: WICBMP_DITHER_Ordered4x4, Name: "WICBMP_DITHER_Ordered4x4"},
+		{Val: WICBMP_DITHER_Ordered8x8, Name: "WICBMP_DITHER_Ordered8x8"},
+		{Val: WICBMP_DITHER_Ordered16x16, Name: "WICBMP_DITHER_Ordered16x16"},
+		{Val: WICBMP_DITHER_Spiral4x4, Name: "WICBMP_DITHER_Spiral4x4"},
+		{Val: WICBMP_DITHER_Spiral8x8, Name: "WICBMP_DITHER_Spiral8x8"},
+		{Val: WICBMP_DITHER_DualSpiral4x4, Name: "WICBMP_DITHER_DualSpiral4x4"},
+		{Val: WICBMP_DITHER_DualSpiral8x8, Name: "WICBMP_DITHER_DualSpiral8x8"},
+		{Val: WICBMP_DITHER_ErrorDiffusion, Name: "WICBMP_DITHER_ErrorDiffusion"},
+	},
+}
+
+// Returns the name of the constant, like "WICBMP_DITHER_None".
+func (v WICBMP_DITHER) String() string {
+	return _WICBMP_DITHER_names.String(v)
+}
+
+// Parses the name of a [WICBMP_DITHER] constant, or a number.
+func ParseWICBMP_DITHER(s string) (WICBMP_DITHER, error) {
+	return _WICBMP_DITHER_names.Parse(s)
+}
+
+var _WICBMP_LOCK_names = enum.Flags[WICBMP_LOCK]{
+	Type: "WICBMP_LOCK",
+	Values: []enum.Name[WICBMP_LOCK]{
+		{Val: WICBMP_LOCK_Read, Name: "WICBMP_LOCK_Read"},
+		{Val: WICBMP_LOCK_Write, Name: "WICBMP_LOCK_Write"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WICBMP_LOCK_Read|WICBMP_LOCK_Write".
+func (v WICBMP_LOCK) String() string {
+	return _WICBMP_LOCK_names.String(v)
+}
+
+// Parses names of [WICBMP_LOCK] flags joined by "|", or numbers.
+func ParseWICBMP_LOCK(s string) (WICBMP_LOCK, error) {
+	return _WICBMP_LOCK_names.Parse(s)
+}
+
+var _WICBMP_PAL_names = enum.Enum[WICBMP_PAL]{
+	Type: "WICBMP_PAL",
+	Values: []enum.Name[WICBMP_PAL]{
+		{Val: WICBMP_PAL_Custom, Name: "WICBMP_PAL_Custom"},
+		{Val: WICBMP_PAL_MedianCut, Name: "WICBMP_PAL_MedianCut"},
+		{Val: WICBMP_PAL_FixedBW, Name: "WICBMP_PAL_FixedBW"},
+		{Val: WICBMP_PAL_FixedHalftone8, Name: "WICBMP_PAL_FixedHalftone8"},
+		{Val: WICBMP_PAL_FixedHalftone27, Name: "WICBMP_PAL_FixedHalftone27"},
+		{Val: WICBMP_PAL_FixedHalftone64, Name: "WICBMP_PAL_FixedHalftone64"},
+		{Val: WICBMP_PAL_FixedHalftone125, Name: "WICBMP_PAL_FixedHalftone125"},
+		{Val: WICBMP_PAL_FixedHalftone216, Name: "WICBMP_PAL_FixedHalftone216"},
+		{Val: WICBMP_PAL_FixedHalftone252, Name: "WICBMP_PAL_FixedHalftone252"},
+		{Val: WICBMP_PAL_FixedHalftone256, Name: "WICBMP_PAL_FixedHalftone256"},
+		{Val: WICBMP_PAL_FixedGray4, Name: "WICBMP_PAL_FixedGray4"},
+		{Val: WICBMP_PAL_FixedGray16, Name: "WICBMP_PAL_FixedGray16"},
+		{Val: WICBMP_PAL_FixedGray256, Name: "WICBMP_PAL_FixedGray256"},
+		{Val: WICBMP_PAL_FixedWebPalette, Name: "WICBMP_PAL_FixedWebPalette"},
+	},
+}
+
+// Returns the name of the constant, like "WICBMP_PAL_Custom".
+func (v WICBMP_PAL) String() string {
+	return _WICBMP_PAL_names.String(v)
+}
+
+// Parses the name of a [WICBMP_PAL] constant, or a number.
+func ParseWICBMP_PAL(s string) (WICBMP_PAL, error) {
+	return _WICBMP_PAL_names.Parse(s)
+}
+
+var _WICDEC_METADATACACHE_names = enum.Enum[WICDEC_METADATACACHE]{
+	Type: "WICDEC_METADATACACHE",
+	Values: []enum.Name[WICDEC_METADATACACHE]{
+		{Val: WICDEC_METADATACACHE_OnDemand, Name: "WICDEC_METADATACACHE_OnDemand"},
+		{Val: WICDEC_METADATACACHE_OnLoad, Name: "WICDEC_METADATACACHE_OnLoad"},
+	},
+}
+
+// Returns the name of the constant, like "WICDEC_METADATACACHE_OnDemand".
+func (v WICDEC_METADATACACHE) String() string {
+	return _WICDEC_METADATACACHE_names.String(v)
+}
+
+// Parses the name of a [WICDEC_METADATACACHE] constant, or a number.
+func ParseWICDEC_METADATACACHE(s string) (WICDEC_METADATACACHE, error) {
+	return _WICDEC_METADATACACHE_names.Parse(s)
+}
+
+var _WICDEC_CAP_names = enum.Flags[WICDEC_CAP]{
+	Type: "WICDEC_CAP",
+	Values: []enum.Name[WICDEC_CAP]{
+		{Val: WICDEC_CAP_SameEncoder, Name: "WICDEC_CAP_SameEncoder"},
+		{Val: WICDEC_CAP_CanDecodeAllImages, Name: "WICDEC_CAP_CanDecodeAllImages"},
+		{Val: WICDEC_CAP_CanDecodeSomeImages, Name: "WICDEC_CAP_CanDecodeSomeImages"},
+		{Val: WICDEC_CAP_CanEnumerateMetadata, Name: "WICDEC_CAP_CanEnumerateMetadata"},
+		{Val: WICDEC_CAP_CanDecodeThumbnail, Name: "WICDEC_CAP_CanDecodeThumbnail"},
+	},
+}
+
+// Returns the names of the flags joined by "|", like "WICDEC_CAP_SameEncoder|WICDEC_CAP_CanDecodeAllImages".
+func (v WICDEC_CAP) String() string {
+	return _WICDEC_CAP_names.String(v)
+}
+
+// Parses names of [WICDEC_CAP] flags joined by "|", or numbers.
+func ParseWICDEC_CAP(s string) (WICDEC_CAP, error) {
+	return _WICDEC_CAP_names.Parse(s)
+}
+
+var _WICENC_CACHE_names = enum.Enum[WICENC_CACHE]{
+	Type: "WICENC_CACHE",
+	Values: []enum.Name[WICENC_CACHE]{
+		{Val: WICENC_CACHE_InMemory, Name: "WICENC_CACHE_InMemory"},
+		{Val: WICENC_CACHE_TempFile, Name: "WICENC_CACHE_TempFile"},
+		{Val: WICENC_CACHE_No, Name: "WICENC_CACHE_No"},
+	},
+}
+
+// Returns the name of the constant, like "WICENC_CACHE_InMemory".
+func (v WICENC_CACHE) String() string {
+	return _WICENC_CACHE_names.String(v)
+}
+
+// Parses the name of a [WICENC_CACHE] constant, or a number.
+func ParseWICENC_CACHE(s string) (WICENC_CACHE, error) {
+	return _WICENC_CACHE_names.Parse(s)
+}
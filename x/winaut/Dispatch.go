@@ -0,0 +1,368 @@
+//go:build windows
+
+package winaut
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/x/coaut"
+)
+
+// Late-bound automation object, a fluent wrapper over [IDispatch] to drive
+// automation servers like Office.
+//
+// Each call returns a new Dispatch with its result, so calls can be chained,
+// and member names can be dotted paths. All objects along the chain are added
+// to the same releaser.
+//
+// If a call fails, the following ones do nothing, and the error is returned
+// by the methods which end the chain: [Dispatch.Err], [Dispatch.Value],
+// [Dispatch.Scan], [Dispatch.IDispatch], [Dispatch.Put] and [Dispatch.PutRef].
+// The error contains the member path, and it wraps the *[EXCEPINFO] raised by
+// the remote call, if any.
+//
+// Example:
+//
+//	_, _ = win.CoInitializeEx(
+//		co.COINIT_APARTMENTTHREADED | co.COINIT_DISABLE_OLE1DDE)
+//	defer win.CoUninitialize()
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	excel, _ := winaut.CreateDispatch(rel, "Excel.Application")
+//	defer excel.Call("Quit")
+//
+//	book := excel.Get("Workbooks").Call("Add")
+//	sheet := book.Get("Worksheets", 1)
+//	_ = sheet.Get("Range", "A1").Put("Value", "Total")
+//	_ = sheet.Get("Cells").Default(1, 2).Put("Formula", "=SUM(B2:B10)")
+//
+//	var name string
+//	_ = sheet.Get("Name").Scan(&name)
+//
+//	if err := book.Call("SaveAs", "C:\\Temp\\report.xlsx").Err(); err != nil {
+//		var excep *winaut.EXCEPINFO
+//		if errors.As(err, &excep) {
+//			println(excep.Description)
+//		}
+//	}
+//	_ = book.Call("Close", winaut.Named("SaveChanges", false)).Err()
+type Dispatch struct {
+	releaser *win.OleReleaser
+	path     string   // member path so far, for error messages
+	value    *VARIANT // result of the last call
+	err      error    // error of the chain
+}
+
+// Wraps the object, which is cloned into the releaser.
+//
+// Example:
+//
+//	var obj *winaut.IDispatch // initialized somewhere
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	d := winaut.NewDispatch(rel, obj)
+func NewDispatch(releaser *win.OleReleaser, obj *IDispatch) *Dispatch {
+	return &Dispatch{
+		releaser: releaser,
+		value:    NewVariant(releaser, obj),
+	}
+}
+
+// Creates the automation object with [win.CLSIDFromProgID] and
+// [win.CoCreateInstance], and wraps it.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	word, _ := winaut.CreateDispatch(rel, "Word.Application")
+//	defer word.Call("Quit")
+func CreateDispatch(releaser *win.OleReleaser, progId string) (*Dispatch, error) {
+	clsId, err := win.CLSIDFromProgID(progId)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDispatch: %w", err)
+	}
+
+	var obj *IDispatch
+	if err := win.CoCreateInstance(releaser, &clsId, nil, co.CLSCTX_SERVER, &obj); err != nil {
+		return nil, fmt.Errorf("CreateDispatch: %w", err)
+	}
+	return NewDispatch(releaser, obj), nil
+}
+
+// Reads the property, or calls the method without arguments, following the
+// dotted path, like "ActiveSheet.UsedRange". The arguments, which can end with
+// [NamedArg] values, are passed to the last member.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	excel, _ := winaut.CreateDispatch(rel, "Excel.Application")
+//	cell := excel.Get("ActiveSheet.Range", "B2")
+func (me *Dispatch) Get(path string, args ...interface{}) *Dispatch {
+	return me.follow(path, coaut.DISPATCH_PROPERTYGET|coaut.DISPATCH_METHOD, args)
+}
+
+// Calls the method, following the dotted path, like "ActiveWorkbook.Save".
+// The arguments, which can end with [NamedArg] values, are passed to the
+// method.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	excel, _ := winaut.CreateDispatch(rel, "Excel.Application")
+//	book := excel.Get("Workbooks").Call("Open", "C:\\Temp\\data.xlsx",
+//		winaut.Named("ReadOnly", true))
+func (me *Dispatch) Call(path string, args ...interface{}) *Dispatch {
+	return me.follow(path, coaut.DISPATCH_METHOD, args)
+}
+
+// Invokes the default member of the object, [coaut.DISPID_VALUE], which is
+// usually the Item of collections, like Cells(1, 2) in VBA. Named arguments
+// are not supported.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	excel, _ := winaut.CreateDispatch(rel, "Excel.Application")
+//	cell := excel.Get("ActiveSheet.Cells").Default(1, 2)
+func (me *Dispatch) Default(args ...interface{}) *Dispatch {
+	return me.invoke("", coaut.DISPATCH_PROPERTYGET|coaut.DISPATCH_METHOD, args)
+}
+
+// Sets the property, following the dotted path. The last argument is the new
+// value, and the preceding ones are passed to the property, like the indexes
+// of a parameterized property.
+//
+// Returns the error of the chain, if any.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	excel, _ := winaut.CreateDispatch(rel, "Excel.Application")
+//	_ = excel.Put("Visible", true)
+//	_ = excel.Get("ActiveSheet.Range", "A1:B2").Put("Value", [][]interface{}{
+//		{"Name", "Age"},
+//		{"Alice", 30},
+//	})
+func (me *Dispatch) Put(path string, args ...interface{}) error {
+	return me.follow(path, coaut.DISPATCH_PROPERTYPUT, args).Err()
+}
+
+// Sets the property by reference, following the dotted path, like a Set
+// statement in VBA. It's used for properties which hold objects. The last
+// argument is the new value.
+//
+// Returns the error of the chain, if any.
+func (me *Dispatch) PutRef(path string, args ...interface{}) error {
+	return me.follow(path, coaut.DISPATCH_PROPERTYPUTREF, args).Err()
+}
+
+// Returns the error of the chain, if any.
+func (me *Dispatch) Err() error {
+	return me.err
+}
+
+// Returns the result of the last call, or the error of the chain.
+//
+// The returned VARIANT is owned by the releaser, so it must not be released.
+func (me *Dispatch) Value() (*VARIANT, error) {
+	if me.err != nil {
+		return nil, me.err
+	}
+	return me.value, nil
+}
+
+// Converts the result of the last call with [VariantTo], returning the error
+// of the chain, if any.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	excel, _ := winaut.CreateDispatch(rel, "Excel.Application")
+//
+//	var rows [][]interface{}
+//	_ = excel.Get("ActiveSheet.UsedRange.Value").Scan(&rows)
+func (me *Dispatch) Scan(pDst interface{}) error {
+	if me.err != nil {
+		return me.err
+	}
+	if err := VariantTo(me.releaser, me.value, pDst); err != nil {
+		return fmt.Errorf("Dispatch %s: %w", me.path, err)
+	}
+	return nil
+}
+
+// Returns the object resulting from the last call, cloned into the releaser,
+// or the error of the chain.
+func (me *Dispatch) IDispatch() (*IDispatch, error) {
+	obj, err := me.object()
+	if err != nil {
+		return nil, err
+	}
+	return obj.AddRef(me.releaser), nil
+}
+
+// Returns an iterator over the items of the collection resulting from the last
+// call, retrieved with its _NewEnum member as an [IEnumVARIANT]. If an error
+// occurs, it's yielded as the last item.
+//
+// The iterator is called with a yield function, which returns false to stop the
+// iteration. The items are added to the releaser.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	excel, _ := winaut.CreateDispatch(rel, "Excel.Application")
+//
+//	excel.Get("ActiveWorkbook.Worksheets").Items()(func(sheet *winaut.Dispatch, err error) bool {
+//		if err != nil {
+//			println(err.Error())
+//			return false
+//		}
+//		var name string
+//		_ = sheet.Get("Name").Scan(&name)
+//		println(name)
+//		return true
+//	})
+func (me *Dispatch) Items() func(yield func(item *Dispatch, err error) bool) {
+	return func(yield func(item *Dispatch, err error) bool) {
+		enum, err := me.newEnum()
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for i := 0; ; i++ {
+			v, err := enum.Next(me.releaser)
+			if err != nil {
+				yield(nil, fmt.Errorf("Dispatch %s: item %d: %w", me.path, i, err))
+				return
+			} else if v == nil { // no more items
+				return
+			}
+
+			item := &Dispatch{
+				releaser: me.releaser,
+				path:     fmt.Sprintf("%s[%d]", me.path, i),
+				value:    v,
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Retrieves the enumerator of the collection, with [coaut.DISPID_NEWENUM].
+func (me *Dispatch) newEnum() (*IEnumVARIANT, error) {
+	obj, err := me.object()
+	if err != nil {
+		return nil, err
+	}
+
+	localRel := win.NewOleReleaser()
+	defer localRel.Release()
+
+	v, err := obj.invokeId(localRel, coaut.DISPATCH_PROPERTYGET|coaut.DISPATCH_METHOD,
+		MEMBERID(coaut.DISPID_NEWENUM), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Dispatch %s._NewEnum: %w", me.path, err)
+	}
+
+	if v.tag == coaut.VT_UNKNOWN || v.tag == coaut.VT_DISPATCH {
+		if rawPpvt := uintptr(binary.LittleEndian.Uint64(v.data[:])); rawPpvt != 0 {
+			pUnk := utl.OleNewWithoutReleaser[*win.IUnknown](rawPpvt) // owned by the VARIANT
+			var enum *IEnumVARIANT
+			if err := pUnk.QueryInterface(me.releaser, &enum); err != nil {
+				return nil, fmt.Errorf("Dispatch %s._NewEnum: %w", me.path, err)
+			}
+			return enum, nil
+		}
+	}
+	return nil, fmt.Errorf("Dispatch %s._NewEnum: %w: not an enumerator", me.path, co.HRESULT_DISP_E_TYPEMISMATCH)
+}
+
+// Follows the dotted path, calling the last member with the flags and
+// arguments, and the preceding ones as property gets.
+func (me *Dispatch) follow(path string, flags coaut.DISPATCH, args []interface{}) *Dispatch {
+	names := strings.Split(path, ".")
+	cur := me
+	for i, name := range names {
+		if i < len(names)-1 {
+			cur = cur.invoke(name, coaut.DISPATCH_PROPERTYGET|coaut.DISPATCH_METHOD, nil)
+		} else {
+			cur = cur.invoke(name, flags, args)
+		}
+	}
+	return cur
+}
+
+// Calls the member of the object resulting from the last call. An empty name
+// calls the default member.
+func (me *Dispatch) invoke(name string, flags coaut.DISPATCH, args []interface{}) *Dispatch {
+	if me.err != nil {
+		return me
+	}
+
+	path := name
+	if name == "" {
+		path = me.path + "()"
+	} else if me.path != "" {
+		path = me.path + "." + name
+	}
+
+	obj, err := me.object()
+	if err != nil {
+		return &Dispatch{releaser: me.releaser, path: path, err: err}
+	}
+
+	var v *VARIANT
+	if name == "" {
+		v, err = obj.invokeId(me.releaser, flags, MEMBERID(coaut.DISPID_VALUE), nil, args)
+	} else {
+		v, err = obj.rawInvoke(me.releaser, flags, name, args...)
+	}
+	if err != nil {
+		return &Dispatch{releaser: me.releaser, path: path, err: fmt.Errorf("Dispatch %s: %w", path, err)}
+	}
+	return &Dispatch{releaser: me.releaser, path: path, value: v}
+}
+
+// Returns the object resulting from the last call, without cloning it, since
+// it's owned by the VARIANT.
+func (me *Dispatch) object() (*IDispatch, error) {
+	if me.err != nil {
+		return nil, me.err
+	}
+	if me.value.tag != coaut.VT_DISPATCH {
+		return nil, fmt.Errorf("Dispatch %s: %w: not an object", me.path, co.HRESULT_DISP_E_TYPEMISMATCH)
+	}
+	rawPpvt := uintptr(binary.LittleEndian.Uint64(me.value.data[:]))
+	if rawPpvt == 0 {
+		return nil, fmt.Errorf("Dispatch %s: %w: object is Nothing", me.path, co.HRESULT_E_POINTER)
+	}
+	return utl.OleNewWithoutReleaser[*IDispatch](rawPpvt), nil
+}
@@ -242,7 +242,10 @@ func (me *IDispatch) InvokeGetAsIDispatch(
 // If the remote call raises an exception, the returned error will be an
 // instance of *[EXCEPINFO].
 //
-// Parameters must be one of the valid [VARIANT] types.
+// Parameters must be one of the valid [VARIANT] types, as accepted by
+// [VariantFrom]. Pointers are passed by reference, and the values written by
+// the remote call are stored back with [VariantTo], as out parameters. The
+// last parameters can be [NamedArg] values.
 //
 // Example:
 //
@@ -383,7 +386,30 @@ func (me *IDispatch) rawInvoke(
 	methodName string,
 	params ...interface{},
 ) (*VARIANT, error) {
-	memberIds, err := me.GetIDsOfNames(win.LCID_USER_DEFAULT, methodName) // will return 1 element
+	var namedNames []string
+	for _, param := range params {
+		if named, ok := param.(NamedArg); ok {
+			namedNames = append(namedNames, named.Name)
+		}
+	}
+
+	memberIds, err := me.GetIDsOfNames(win.LCID_USER_DEFAULT, methodName, namedNames...) // member + named arguments
+	if err != nil {
+		return nil, err
+	}
+	return me.invokeId(releaser, method, memberIds[0], memberIds[1:], params)
+}
+
+// Calls Invoke with the parameters, which can end with [NamedArg] values,
+// whose IDs are given. Parameters passed by reference are written back.
+func (me *IDispatch) invokeId(
+	releaser *win.OleReleaser,
+	method coaut.DISPATCH,
+	memberId MEMBERID,
+	namedIds []MEMBERID,
+	params []interface{},
+) (*VARIANT, error) {
+	args, order, dispIds, err := dispArgs(method, namedIds, params)
 	if err != nil {
 		return nil, err
 	}
@@ -391,22 +417,99 @@ func (me *IDispatch) rawInvoke(
 	localRel := win.NewOleReleaser()
 	defer localRel.Release()
 
-	arrVars := make([]VARIANT, 0, len(params))
-	for i := len(params) - 1; i >= 0; i-- { // in reverse order
-		arrVars = append(arrVars, *NewVariant(localRel, params[i])) // copy bytes, and trust they won't be changed
+	arrVars := make([]VARIANT, 0, len(args))
+	for i, arg := range args {
+		v, err := VariantFrom(localRel, arg)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", order[i], err)
+		}
+		arrVars = append(arrVars, *v) // copy bytes, and trust they won't be changed
 	}
 
 	var dp DISPPARAMS
-	if len(params) > 0 {
+	if len(arrVars) > 0 {
 		dp.SetArgs(arrVars)
 	}
-	if method == coaut.DISPATCH_PROPERTYPUT {
-		dp.SetNamedArgs(coaut.DISPID_PROPERTYPUT)
+	if len(dispIds) > 0 {
+		dp.SetNamedArgs(dispIds...)
 	}
 
-	v, err := me.Invoke(releaser, memberIds[0], win.LCID_USER_DEFAULT, method, &dp)
+	v, err := me.Invoke(releaser, memberId, win.LCID_USER_DEFAULT, method, &dp)
 	if err != nil {
 		return nil, err
 	}
+
+	for i := range arrVars {
+		if arrVars[i].tag&coaut.VT_BYREF != 0 {
+			if err := VariantTo(releaser, &arrVars[i], args[i]); err != nil { // write back out parameters
+				return nil, fmt.Errorf("parameter %d: %w", order[i], err)
+			}
+		}
+	}
 	return v, nil
 }
+
+// Orders the parameters as stored in [DISPPARAMS]: the named ones first,
+// followed by the positional ones in reverse order. In property puts, the value
+// being set is the first named argument, with DISPID_PROPERTYPUT. Returns the
+// argument values, their indexes in params, and the IDs of the named ones.
+func dispArgs(
+	method coaut.DISPATCH,
+	namedIds []MEMBERID,
+	params []interface{},
+) (args []interface{}, order []int, dispIds []coaut.DISPID, err error) {
+	var named, positional []int // indexes of params
+	for i, param := range params {
+		if namedArg, ok := param.(NamedArg); ok {
+			if len(dispIds) == len(namedIds) {
+				return nil, nil, nil, fmt.Errorf("parameter %d: %w: %s", i, co.HRESULT_DISP_E_PARAMNOTFOUND, namedArg.Name)
+			}
+			named = append(named, i)
+			dispIds = append(dispIds, coaut.DISPID(namedIds[len(dispIds)]))
+		} else if len(named) > 0 {
+			return nil, nil, nil, fmt.Errorf("parameter %d: %w: positional after named", i, co.HRESULT_E_INVALIDARG)
+		} else {
+			positional = append(positional, i)
+		}
+	}
+	if method&(coaut.DISPATCH_PROPERTYPUT|coaut.DISPATCH_PROPERTYPUTREF) != 0 && len(positional) > 0 {
+		named = append([]int{positional[len(positional)-1]}, named...) // the value being set
+		dispIds = append([]coaut.DISPID{coaut.DISPID_PROPERTYPUT}, dispIds...)
+		positional = positional[:len(positional)-1]
+	}
+
+	order = named
+	for i := len(positional) - 1; i >= 0; i-- {
+		order = append(order, positional[i])
+	}
+	args = make([]interface{}, 0, len(order))
+	for _, idx := range order {
+		if namedArg, ok := params[idx].(NamedArg); ok {
+			args = append(args, namedArg.Value)
+		} else {
+			args = append(args, params[idx])
+		}
+	}
+	return args, order, dispIds, nil
+}
+
+// Named argument of [IDispatch.InvokeMethod], [IDispatch.InvokeGet] and
+// [Dispatch] calls, created with [Named]. Named arguments must be placed after
+// the positional ones.
+type NamedArg struct {
+	Name  string
+	Value interface{}
+}
+
+// Creates a [NamedArg].
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	_, _ = workbook.InvokeMethod(rel, "Close",
+//		winaut.Named("SaveChanges", false))
+func Named(name string, value interface{}) NamedArg {
+	return NamedArg{name, value}
+}
@@ -0,0 +1,113 @@
+//go:build windows
+
+package winaut
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/x/coaut"
+)
+
+// [IEnumVARIANT] COM interface, returned by the _NewEnum property of
+// automation collections.
+//
+// Prefer using [Dispatch.Items], which retrieves and iterates it.
+//
+// [IEnumVARIANT]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/nn-oaidl-ienumvariant
+type IEnumVARIANT struct{ win.IUnknown }
+
+type _IEnumVARIANTVt struct {
+	utl.IUnknownVt
+	Next  uintptr
+	Skip  uintptr
+	Reset uintptr
+	Clone uintptr
+}
+
+// Returns the unique COM [interface ID].
+//
+// [interface ID]: https://learn.microsoft.com/en-us/office/client-developer/outlook/mapi/iid
+func (*IEnumVARIANT) IID() *co.IID {
+	return &coaut.IID_IEnumVARIANT
+}
+
+// [AddRef] method.
+//
+// [AddRef]: https://learn.microsoft.com/en-us/windows/win32/api/unknwn/nf-unknwn-iunknown-addref
+func (me *IEnumVARIANT) AddRef(releaser *win.OleReleaser) *IEnumVARIANT {
+	return utl.OleNewFromAddRef[*IEnumVARIANT](me, releaser)
+}
+
+// [Clone] method.
+//
+// [Clone]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/nf-oaidl-ienumvariant-clone
+func (me *IEnumVARIANT) Clone(releaser *win.OleReleaser) (*IEnumVARIANT, error) {
+	return utl.OleNewFromCallWithoutParms[*IEnumVARIANT](me, releaser,
+		utl.Vt[_IEnumVARIANTVt](me.Ppvt()).Clone)
+}
+
+// Returns all remaining [VARIANT] values by calling [IEnumVARIANT.Next].
+func (me *IEnumVARIANT) Enum(releaser *win.OleReleaser) ([]*VARIANT, error) {
+	items := make([]*VARIANT, 0)
+	for {
+		item, err := me.Next(releaser)
+		if err != nil { // actual error
+			return nil, err
+		} else if item == nil { // no more items to fetch
+			return items, nil
+		} else { // item fetched
+			items = append(items, item)
+		}
+	}
+}
+
+// [Next] method.
+//
+// If there are no more items, nil is returned.
+//
+// [Next]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/nf-oaidl-ienumvariant-next
+func (me *IEnumVARIANT) Next(releaser *win.OleReleaser) (*VARIANT, error) {
+	v := variantInit()
+	var numFetched uint32
+
+	ret, _, _ := syscall.SyscallN(
+		utl.Vt[_IEnumVARIANTVt](me.Ppvt()).Next,
+		me.Ppvt(),
+		1,
+		uintptr(unsafe.Pointer(v)),
+		uintptr(unsafe.Pointer(&numFetched)))
+
+	if hr := co.HRESULT(ret); hr == co.HRESULT_S_OK && numFetched == 1 {
+		releaser.Add(v)
+		return v, nil
+	} else if hr == co.HRESULT_S_OK || hr == co.HRESULT_S_FALSE {
+		return nil, nil
+	} else {
+		return nil, hr
+	}
+}
+
+// [Reset] method.
+//
+// [Reset]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/nf-oaidl-ienumvariant-reset
+func (me *IEnumVARIANT) Reset() error {
+	return utl.OleCallWithoutParms(me, utl.Vt[_IEnumVARIANTVt](me.Ppvt()).Reset)
+}
+
+// [Skip] method.
+//
+// Panics if count is negative.
+//
+// [Skip]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/nf-oaidl-ienumvariant-skip
+func (me *IEnumVARIANT) Skip(count int) error {
+	utl.PanicNeg(count)
+	ret, _, _ := syscall.SyscallN(
+		utl.Vt[_IEnumVARIANTVt](me.Ppvt()).Skip,
+		me.Ppvt(),
+		uintptr(uint32(count)))
+	return utl.HresultToError(ret)
+}
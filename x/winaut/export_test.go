@@ -0,0 +1,6 @@
+//go:build windows
+
+package winaut
+
+// Exposes the unexported DISPPARAMS ordering to winaut_test.
+var DispArgs = dispArgs
@@ -0,0 +1,542 @@
+//go:build windows
+
+package winaut
+
+import (
+	"encoding/binary"
+	"math/big"
+	"reflect"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/x/coaut"
+)
+
+// [SAFEARRAY] struct, with C memory layout.
+//
+// The struct is always allocated by the OS, so it's used only through
+// pointers, returned by [SafeArrayCreate], [NewSafeArray] and
+// [VARIANT.SafeArray].
+//
+// The indices of all methods are given from the leftmost dimension to the
+// rightmost one, like Go slices; they're reversed internally, as the OS
+// expects.
+//
+// [SAFEARRAY]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/ns-oaidl-safearray
+type SAFEARRAY struct {
+	cDims      uint16
+	fFeatures  uint16
+	cbElements uint32
+	cLocks     uint32
+	pvData     uintptr
+	rgsabound  [1]SAFEARRAYBOUND
+}
+
+// [SafeArrayCreate] function.
+//
+// The bounds are given from the leftmost dimension to the rightmost one.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	arr, _ := winaut.SafeArrayCreate(rel, coaut.VT_VARIANT,
+//		winaut.SAFEARRAYBOUND{CElements: 3, LLbound: 1}, // rows
+//		winaut.SAFEARRAYBOUND{CElements: 2, LLbound: 1}, // columns
+//	)
+//	_ = arr.SafeArrayPutElement([]int{1, 1}, "foo")
+//
+// [SafeArrayCreate]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraycreate
+func SafeArrayCreate(
+	releaser *win.OleReleaser,
+	vt coaut.VT,
+	bounds ...SAFEARRAYBOUND,
+) (*SAFEARRAY, error) {
+	sa, err := safeArrayCreate(vt, bounds)
+	if err != nil {
+		return nil, err
+	}
+	releaser.Add(sa)
+	return sa, nil
+}
+
+// Calls SafeArrayCreate without adding the array to a releaser, so it can be
+// owned by a VARIANT.
+func safeArrayCreate(vt coaut.VT, bounds []SAFEARRAYBOUND) (*SAFEARRAY, error) {
+	if len(bounds) == 0 {
+		return nil, co.HRESULT_E_INVALIDARG
+	}
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayCreate, "SafeArrayCreate"),
+		uintptr(vt),
+		uintptr(uint32(len(bounds))),
+		uintptr(unsafe.Pointer(&bounds[0])))
+	if ret == 0 {
+		return nil, co.HRESULT_E_OUTOFMEMORY
+	}
+	return (*SAFEARRAY)(unsafe.Pointer(ret)), nil
+}
+
+var _oleaut_SafeArrayCreate *syscall.Proc
+
+// Creates a [SAFEARRAY] with the values of a Go slice or array, which can be:
+//   - 1-D, like []string or []interface{};
+//   - 2-D, like [][]float64 or [][]interface{}, whose rows become the leftmost
+//     dimension – the one used by the rows of Excel ranges. Shorter rows are
+//     padded with zero values, or with VT_EMPTY in arrays of VT_VARIANT.
+//
+// The elements must be one of the valid [VARIANT] types, and interface{}
+// elements create an array of [coaut.VT_VARIANT]. The lower bounds are zero.
+//
+// Returns an error wrapping [co.HRESULT_DISP_E_TYPEMISMATCH] if the type of
+// the elements is not allowed.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	arr, _ := winaut.NewSafeArray(rel, [][]interface{}{
+//		{"Name", "Age"},
+//		{"Alice", int32(30)},
+//	})
+//	_, _ = sheetRange.InvokePut(rel, "Value", arr)
+func NewSafeArray(releaser *win.OleReleaser, values interface{}) (*SAFEARRAY, error) {
+	sa, err := newSafeArray(values)
+	if err != nil {
+		return nil, err
+	}
+	releaser.Add(sa)
+	return sa, nil
+}
+
+// Creates the SAFEARRAY without adding it to a releaser, so it can be owned by
+// a VARIANT.
+func newSafeArray(values interface{}) (*SAFEARRAY, error) {
+	val := reflect.ValueOf(values)
+	if k := val.Kind(); k != reflect.Slice && k != reflect.Array {
+		return nil, errVariantType("%T is not a slice", values)
+	}
+
+	elemKind := val.Type().Elem().Kind()
+	is2d := elemKind == reflect.Slice || elemKind == reflect.Array
+	var vt coaut.VT
+	var bounds []SAFEARRAYBOUND
+	var err error
+	if is2d {
+		if vt, err = safeArrayElemVt(val.Type().Elem().Elem()); err != nil {
+			return nil, err
+		}
+		numCols := 0
+		for r := 0; r < val.Len(); r++ {
+			if n := val.Index(r).Len(); n > numCols {
+				numCols = n
+			}
+		}
+		bounds = []SAFEARRAYBOUND{{CElements: uint32(val.Len())}, {CElements: uint32(numCols)}}
+	} else {
+		if vt, err = safeArrayElemVt(val.Type().Elem()); err != nil {
+			return nil, err
+		}
+		bounds = []SAFEARRAYBOUND{{CElements: uint32(val.Len())}}
+	}
+
+	sa, err := safeArrayCreate(vt, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	var putErr error
+	if is2d {
+		for r := 0; r < val.Len() && putErr == nil; r++ {
+			row := val.Index(r)
+			for c := 0; c < row.Len() && putErr == nil; c++ {
+				putErr = sa.SafeArrayPutElement([]int{r, c}, row.Index(c).Interface())
+			}
+		}
+	} else {
+		for i := 0; i < val.Len() && putErr == nil; i++ {
+			putErr = sa.SafeArrayPutElement([]int{i}, val.Index(i).Interface())
+		}
+	}
+	if putErr != nil {
+		sa.Release()
+		return nil, putErr
+	}
+	return sa, nil
+}
+
+// Returns the VT of the SAFEARRAY elements of the given Go type, matching the
+// type set by [VariantFrom].
+func safeArrayElemVt(ty reflect.Type) (coaut.VT, error) {
+	switch ty {
+	case reflect.TypeOf((*interface{})(nil)).Elem():
+		return coaut.VT_VARIANT, nil
+	case reflect.TypeOf((*IDispatch)(nil)):
+		return coaut.VT_DISPATCH, nil
+	case reflect.TypeOf((*win.IUnknown)(nil)):
+		return coaut.VT_UNKNOWN, nil
+	case reflect.TypeOf(time.Time{}):
+		return coaut.VT_DATE, nil
+	case reflect.TypeOf(CY(0)):
+		return coaut.VT_CY, nil
+	case reflect.TypeOf(DECIMAL{}), reflect.TypeOf((*big.Rat)(nil)):
+		return coaut.VT_DECIMAL, nil
+	case reflect.TypeOf(co.HRESULT(0)):
+		return coaut.VT_ERROR, nil
+	}
+	switch ty.Kind() {
+	case reflect.Bool:
+		return coaut.VT_BOOL, nil
+	case reflect.Float32:
+		return coaut.VT_R4, nil
+	case reflect.Float64:
+		return coaut.VT_R8, nil
+	case reflect.Int8:
+		return coaut.VT_I1, nil
+	case reflect.Int16:
+		return coaut.VT_I2, nil
+	case reflect.Int32, reflect.Int:
+		return coaut.VT_I4, nil
+	case reflect.Int64:
+		return coaut.VT_I8, nil
+	case reflect.String:
+		return coaut.VT_BSTR, nil
+	case reflect.Uint8:
+		return coaut.VT_UI1, nil
+	case reflect.Uint16:
+		return coaut.VT_UI2, nil
+	case reflect.Uint32, reflect.Uint:
+		return coaut.VT_UI4, nil
+	case reflect.Uint64:
+		return coaut.VT_UI8, nil
+	}
+	return coaut.VT_EMPTY, errVariantType("SAFEARRAY of %s", ty)
+}
+
+// Calls [SafeArrayDestroy].
+//
+// [SafeArrayDestroy]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraydestroy
+func (sa *SAFEARRAY) Release() {
+	_, _, _ = syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayDestroy, "SafeArrayDestroy"),
+		uintptr(unsafe.Pointer(sa))) // ignore errors
+}
+
+var _oleaut_SafeArrayDestroy *syscall.Proc
+
+// [SafeArrayCopy] function.
+//
+// [SafeArrayCopy]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraycopy
+func (sa *SAFEARRAY) SafeArrayCopy(releaser *win.OleReleaser) (*SAFEARRAY, error) {
+	cp, err := sa.safeArrayCopy()
+	if err != nil {
+		return nil, err
+	}
+	releaser.Add(cp)
+	return cp, nil
+}
+
+// Calls SafeArrayCopy without adding the copy to a releaser, so it can be
+// owned by a VARIANT.
+func (sa *SAFEARRAY) safeArrayCopy() (*SAFEARRAY, error) {
+	var pCopy uintptr
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayCopy, "SafeArrayCopy"),
+		uintptr(unsafe.Pointer(sa)),
+		uintptr(unsafe.Pointer(&pCopy)))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return nil, hr
+	}
+	return (*SAFEARRAY)(unsafe.Pointer(pCopy)), nil
+}
+
+var _oleaut_SafeArrayCopy *syscall.Proc
+
+// [SafeArrayGetDim] function.
+//
+// [SafeArrayGetDim]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraygetdim
+func (sa *SAFEARRAY) SafeArrayGetDim() int {
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayGetDim, "SafeArrayGetDim"),
+		uintptr(unsafe.Pointer(sa)))
+	return int(uint32(ret))
+}
+
+var _oleaut_SafeArrayGetDim *syscall.Proc
+
+// [SafeArrayGetLBound] function.
+//
+// The dimension is one-based, where 1 is the leftmost one.
+//
+// [SafeArrayGetLBound]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraygetlbound
+func (sa *SAFEARRAY) SafeArrayGetLBound(dim int) (int, error) {
+	var lBound int32
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayGetLBound, "SafeArrayGetLBound"),
+		uintptr(unsafe.Pointer(sa)),
+		uintptr(uint32(dim)),
+		uintptr(unsafe.Pointer(&lBound)))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return 0, hr
+	}
+	return int(lBound), nil
+}
+
+var _oleaut_SafeArrayGetLBound *syscall.Proc
+
+// [SafeArrayGetUBound] function.
+//
+// The dimension is one-based, where 1 is the leftmost one.
+//
+// [SafeArrayGetUBound]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraygetubound
+func (sa *SAFEARRAY) SafeArrayGetUBound(dim int) (int, error) {
+	var uBound int32
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayGetUBound, "SafeArrayGetUBound"),
+		uintptr(unsafe.Pointer(sa)),
+		uintptr(uint32(dim)),
+		uintptr(unsafe.Pointer(&uBound)))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return 0, hr
+	}
+	return int(uBound), nil
+}
+
+var _oleaut_SafeArrayGetUBound *syscall.Proc
+
+// [SafeArrayGetVartype] function.
+//
+// [SafeArrayGetVartype]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraygetvartype
+func (sa *SAFEARRAY) SafeArrayGetVartype() (coaut.VT, error) {
+	var vt coaut.VT
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayGetVartype, "SafeArrayGetVartype"),
+		uintptr(unsafe.Pointer(sa)),
+		uintptr(unsafe.Pointer(&vt)))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return coaut.VT_EMPTY, hr
+	}
+	return vt, nil
+}
+
+var _oleaut_SafeArrayGetVartype *syscall.Proc
+
+// Returns the bounds of all dimensions, from the leftmost to the rightmost
+// one, calling [SAFEARRAY.SafeArrayGetLBound] and
+// [SAFEARRAY.SafeArrayGetUBound].
+func (sa *SAFEARRAY) Bounds() ([]SAFEARRAYBOUND, error) {
+	bounds := make([]SAFEARRAYBOUND, sa.SafeArrayGetDim())
+	for i := range bounds {
+		lBound, err := sa.SafeArrayGetLBound(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		uBound, err := sa.SafeArrayGetUBound(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		bounds[i] = SAFEARRAYBOUND{CElements: uint32(uBound - lBound + 1), LLbound: int32(lBound)}
+	}
+	return bounds, nil
+}
+
+// [SafeArrayGetElement] function.
+//
+// The element is returned as a [VARIANT] with the type of the array elements,
+// or as the element itself, if the array holds [coaut.VT_VARIANT].
+//
+// The indices are given from the leftmost dimension to the rightmost one, and
+// they are absolute, that is, they take the lower bounds into account.
+//
+// [SafeArrayGetElement]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearraygetelement
+func (sa *SAFEARRAY) SafeArrayGetElement(releaser *win.OleReleaser, indices ...int) (*VARIANT, error) {
+	vt, err := sa.SafeArrayGetVartype()
+	if err != nil {
+		return nil, err
+	}
+	rgIndices := safeArrayIndices(indices)
+
+	v := NewVariant(releaser, nil)
+	var pv unsafe.Pointer
+	switch vt {
+	case coaut.VT_VARIANT, coaut.VT_DECIMAL: // DECIMAL overlaps the whole VARIANT
+		pv = unsafe.Pointer(v)
+	default:
+		pv = unsafe.Pointer(&v.data[0])
+	}
+
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayGetElement, "SafeArrayGetElement"),
+		uintptr(unsafe.Pointer(sa)),
+		uintptr(unsafe.Pointer(&rgIndices[0])),
+		uintptr(pv))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return nil, hr
+	}
+	if vt != coaut.VT_VARIANT {
+		v.tag = vt
+	}
+	return v, nil
+}
+
+var _oleaut_SafeArrayGetElement *syscall.Proc
+
+// [SafeArrayPutElement] function.
+//
+// The value must be one of the valid [VARIANT] types, matching the type of the
+// array elements, unless the array holds [coaut.VT_VARIANT]. It's copied into
+// the array.
+//
+// The indices are given from the leftmost dimension to the rightmost one, and
+// they are absolute, that is, they take the lower bounds into account.
+//
+// [SafeArrayPutElement]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-safearrayputelement
+func (sa *SAFEARRAY) SafeArrayPutElement(indices []int, value interface{}) error {
+	vt, err := sa.SafeArrayGetVartype()
+	if err != nil {
+		return err
+	}
+	rgIndices := safeArrayIndices(indices)
+
+	localRel := win.NewOleReleaser()
+	defer localRel.Release()
+
+	v, err := VariantFrom(localRel, value)
+	if err != nil {
+		return err
+	}
+	var pv uintptr
+	switch {
+	case vt == coaut.VT_VARIANT:
+		pv = uintptr(unsafe.Pointer(v))
+	case v.tag != vt:
+		return co.HRESULT_DISP_E_TYPEMISMATCH
+	case vt == coaut.VT_BSTR, vt == coaut.VT_DISPATCH, vt == coaut.VT_UNKNOWN:
+		pv = uintptr(binary.LittleEndian.Uint64(v.data[:])) // the pointer itself
+	case vt == coaut.VT_DECIMAL:
+		pv = uintptr(unsafe.Pointer(v))
+	default:
+		pv = uintptr(unsafe.Pointer(&v.data[0]))
+	}
+
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_SafeArrayPutElement, "SafeArrayPutElement"),
+		uintptr(unsafe.Pointer(sa)),
+		uintptr(unsafe.Pointer(&rgIndices[0])),
+		pv)
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return hr
+	}
+	return nil
+}
+
+var _oleaut_SafeArrayPutElement *syscall.Proc
+
+// Converts the indices to int32, in the reverse order expected by the OS.
+func safeArrayIndices(indices []int) []int32 {
+	if len(indices) == 0 {
+		return []int32{0}
+	}
+	rgIndices := make([]int32, len(indices))
+	for i, idx := range indices {
+		rgIndices[len(indices)-1-i] = int32(idx)
+	}
+	return rgIndices
+}
+
+// Returns the elements of a 1-D array as Go values, which have the same types
+// accepted by [NewVariant]; nested arrays are returned as *[SAFEARRAY]. Any
+// other element is returned as a *[VARIANT].
+//
+// Returns [co.HRESULT_DISP_E_BADINDEX] if the array doesn't have 1 dimension.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	arr, _ := winaut.NewSafeArray(rel, []string{"a", "b"})
+//	vals, _ := arr.Values(rel)
+//	for _, val := range vals {
+//		println(val.(string))
+//	}
+func (sa *SAFEARRAY) Values(releaser *win.OleReleaser) ([]interface{}, error) {
+	bounds, err := sa.Bounds()
+	if err != nil {
+		return nil, err
+	} else if len(bounds) != 1 {
+		return nil, co.HRESULT_DISP_E_BADINDEX
+	}
+
+	vals := make([]interface{}, bounds[0].CElements)
+	for i := range vals {
+		if vals[i], err = sa.goValue(releaser, int(bounds[0].LLbound)+i); err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// Returns the elements of a 2-D array as Go values, indexed by the leftmost
+// dimension first – the rows of an Excel range. The elements are converted as
+// in [SAFEARRAY.Values].
+//
+// Returns [co.HRESULT_DISP_E_BADINDEX] if the array doesn't have 2 dimensions.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	varVals, _ := sheetRange.InvokeGet(rel, "Value")
+//	arr, _ := varVals.SafeArray(rel)
+//	rows, _ := arr.Values2D(rel)
+//	for _, row := range rows {
+//		fmt.Println(row...)
+//	}
+func (sa *SAFEARRAY) Values2D(releaser *win.OleReleaser) ([][]interface{}, error) {
+	bounds, err := sa.Bounds()
+	if err != nil {
+		return nil, err
+	} else if len(bounds) != 2 {
+		return nil, co.HRESULT_DISP_E_BADINDEX
+	}
+
+	rows := make([][]interface{}, bounds[0].CElements)
+	for r := range rows {
+		rows[r] = make([]interface{}, bounds[1].CElements)
+		for c := range rows[r] {
+			rows[r][c], err = sa.goValue(releaser, int(bounds[0].LLbound)+r, int(bounds[1].LLbound)+c)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+// Returns the element as a Go value, or as a *VARIANT added to the releaser
+// if it can't be converted.
+func (sa *SAFEARRAY) goValue(releaser *win.OleReleaser, indices ...int) (interface{}, error) {
+	localRel := win.NewOleReleaser()
+	defer localRel.Release()
+
+	v, err := sa.SafeArrayGetElement(localRel, indices...)
+	if err != nil {
+		return nil, err
+	}
+	if val, ok := v.goValue(releaser); ok {
+		return val, nil
+	}
+
+	cp := NewVariant(releaser, nil)
+	*cp = *v       // move ownership to the caller's releaser
+	*v = VARIANT{} // so VariantClear does nothing
+	return cp, nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/rodrigocfd/windigo/co"
 	"github.com/rodrigocfd/windigo/internal/dll"
 	"github.com/rodrigocfd/windigo/internal/utl"
 	"github.com/rodrigocfd/windigo/win"
@@ -44,24 +45,35 @@ func (me *VARIANT) Release() {
 
 var _oleaut_VariantClear *syscall.Proc
 
-// Calls [VariantInit] and sets the type and value.
+// Calls [VariantFrom], panicking on error.
 //
 // Allowed [types]:
 //   - nil ([coaut.VT_EMPTY])
+//   - [Null] ([coaut.VT_NULL])
 //   - bool ([coaut.VT_BOOL])
 //   - float32 ([coaut.VT_R4])
 //   - float64 ([coaut.VT_R8])
 //   - *[IDispatch] ([coaut.VT_DISPATCH])
+//   - *[win.IUnknown] ([coaut.VT_UNKNOWN])
 //   - int8 ([coaut.VT_I1])
 //   - int16 ([coaut.VT_I2])
-//   - int32 ([coaut.VT_I4])
+//   - int32 and int ([coaut.VT_I4])
 //   - int64 ([coaut.VT_I8])
 //   - string ([coaut.VT_BSTR])
 //   - [time.Time] ([coaut.VT_DATE])
 //   - uint8 ([coaut.VT_UI1])
 //   - uint16 ([coaut.VT_UI2])
-//   - uint32 ([coaut.VT_UI4])
+//   - uint32 and uint ([coaut.VT_UI4])
 //   - uint64 ([coaut.VT_UI8])
+//   - [CY] ([coaut.VT_CY])
+//   - [DECIMAL] and *[big.Rat] ([coaut.VT_DECIMAL])
+//   - [co.HRESULT] ([coaut.VT_ERROR])
+//   - *[VARIANT], which is copied
+//   - *[SAFEARRAY] ([coaut.VT_ARRAY] with its element type), which is copied
+//   - slices and arrays of the types above, or [][]interface{} for 2-D arrays,
+//     as accepted by [NewSafeArray] ([coaut.VT_ARRAY])
+//   - types whose underlying type is one of the above, like type Age int32
+//   - pointers to the types above ([coaut.VT_BYREF]), for out parameters
 //
 // Panics if the type of the value is not allowed.
 //
@@ -72,89 +84,34 @@ var _oleaut_VariantClear *syscall.Proc
 //
 //	v := winaut.NewVariant(rel, "foo")
 //
-// [VariantInit]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-variantinit
 // [types]: https://learn.microsoft.com/en-us/windows/win32/api/wtypes/ne-wtypes-varenum
 func NewVariant(releaser *win.OleReleaser, value interface{}) *VARIANT {
+	v, err := VariantFrom(releaser, value)
+	if err != nil {
+		panic("Invalid VARIANT value: " + err.Error())
+	}
+	return v
+}
+
+// Calls [VariantInit] without adding the VARIANT to a releaser.
+//
+// [VariantInit]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-variantinit
+func variantInit() *VARIANT {
 	v := new(VARIANT)
 	_, _, _ = syscall.SyscallN(
 		dll.Oleaut.Load(&_oleaut_VariantInit, "VariantInit"),
 		uintptr(unsafe.Pointer(v)))
-	releaser.Add(v)
-	if utl.IsNil(value) { // no data to be set
-		return v
-	}
-
-	switch val := value.(type) {
-	case bool:
-		v.tag = coaut.VT_BOOL
-		bInt16 := int16(-1)
-		if val {
-			bInt16 = 1
-		}
-		binary.LittleEndian.PutUint16(v.data[:], uint16(bInt16))
-	case float32:
-		v.tag = coaut.VT_R4
-		binary.LittleEndian.PutUint32(v.data[:], math.Float32bits(val))
-	case float64:
-		v.tag = coaut.VT_R8
-		binary.LittleEndian.PutUint64(v.data[:], math.Float64bits(val))
-	case *IDispatch:
-		v.tag = coaut.VT_DISPATCH
-		_, _, _ = syscall.SyscallN(
-			utl.Vt[utl.IDispatchVt](val.Ppvt()).AddRef, // clone, because we'll release it independently
-			val.Ppvt())
-		binary.LittleEndian.PutUint64(v.data[:], uint64(val.Ppvt()))
-	case int8:
-		v.tag = coaut.VT_I1
-		v.data[0] = uint8(val)
-	case int16:
-		v.tag = coaut.VT_I2
-		binary.LittleEndian.PutUint16(v.data[:], uint16(val))
-	case int32:
-		v.tag = coaut.VT_I4
-		binary.LittleEndian.PutUint32(v.data[:], uint32(val))
-	case int64:
-		v.tag = coaut.VT_I8
-		binary.LittleEndian.PutUint64(v.data[:], uint64(val))
-	case string:
-		v.tag = coaut.VT_BSTR
-		bstr, _ := SysAllocString(val) // will be owned by the VARIANT
-		binary.LittleEndian.PutUint64(v.data[:], uint64(bstr))
-	case time.Time:
-		v.tag = coaut.VT_DATE
-		var double float64
-		var st win.SYSTEMTIME
-		st.SetTime(val)
-
-		ret, _, _ := syscall.SyscallN(
-			dll.Oleaut.Load(&_oleaut_SystemTimeToVariantTime, "SystemTimeToVariantTime"),
-			uintptr(unsafe.Pointer(&st)),
-			uintptr(unsafe.Pointer(&double)))
-		if ret == 0 {
-			panic("SystemTimeToVariantTime() failed.") // should never happen, time.Time is always valid
-		}
-		binary.LittleEndian.PutUint64(v.data[:], math.Float64bits(double))
-	case uint8:
-		v.tag = coaut.VT_UI1
-		v.data[0] = val
-	case uint16:
-		v.tag = coaut.VT_UI2
-		binary.LittleEndian.PutUint16(v.data[:], val)
-	case uint32:
-		v.tag = coaut.VT_UI4
-		binary.LittleEndian.PutUint32(v.data[:], val)
-	case uint64:
-		v.tag = coaut.VT_UI8
-		binary.LittleEndian.PutUint64(v.data[:], val)
-	default:
-		panic("Invalid VARIANT value type.")
-	}
-
 	return v
 }
 
 var _oleaut_VariantInit *syscall.Proc
-var _oleaut_SystemTimeToVariantTime *syscall.Proc
+
+// Stores the SAFEARRAY, whose ownership is taken by the VARIANT.
+func (v *VARIANT) setSafeArray(sa *SAFEARRAY) {
+	vt, _ := sa.SafeArrayGetVartype()
+	v.tag = coaut.VT_ARRAY | vt
+	binary.LittleEndian.PutUint64(v.data[:], uint64(uintptr(unsafe.Pointer(sa))))
+}
 
 // Returns the [coaut.VT] type of the VARIANT.
 func (vt *VARIANT) Type() coaut.VT {
@@ -167,6 +124,83 @@ func (v *VARIANT) IsEmpty() bool {
 	return v.tag == coaut.VT_EMPTY
 }
 
+// Returns true if current type is [coaut.VT_NULL], that is, the VARIANT holds
+// a [Null] value.
+func (v *VARIANT) IsNull() bool {
+	return v.tag == coaut.VT_NULL
+}
+
+// [VariantChangeType] function.
+//
+// Returns a new VARIANT with the value converted to the given type, like a
+// string to a number.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	v := winaut.NewVariant(rel, "42")
+//	vNum, _ := v.VariantChangeType(rel, coaut.VT_I4)
+//	n, _ := vNum.Int32()
+//
+// [VariantChangeType]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-variantchangetype
+func (v *VARIANT) VariantChangeType(releaser *win.OleReleaser, vt coaut.VT) (*VARIANT, error) {
+	dest := variantInit()
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_VariantChangeType, "VariantChangeType"),
+		uintptr(unsafe.Pointer(dest)),
+		uintptr(unsafe.Pointer(v)),
+		0,
+		uintptr(vt))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return nil, hr
+	}
+	releaser.Add(dest)
+	return dest, nil
+}
+
+var _oleaut_VariantChangeType *syscall.Proc
+
+// [VariantCopy] function.
+//
+// [VariantCopy]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-variantcopy
+func (v *VARIANT) VariantCopy(releaser *win.OleReleaser) (*VARIANT, error) {
+	dest := variantInit()
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_VariantCopy, "VariantCopy"),
+		uintptr(unsafe.Pointer(dest)),
+		uintptr(unsafe.Pointer(v)))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return nil, hr
+	}
+	releaser.Add(dest)
+	return dest, nil
+}
+
+var _oleaut_VariantCopy *syscall.Proc
+
+// [VariantCopyInd] function.
+//
+// If the VARIANT has [coaut.VT_BYREF], returns a copy of the referenced value.
+// Otherwise, returns a copy of the VARIANT itself.
+//
+// [VariantCopyInd]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-variantcopyind
+func (v *VARIANT) VariantCopyInd(releaser *win.OleReleaser) (*VARIANT, error) {
+	dest := variantInit()
+	ret, _, _ := syscall.SyscallN(
+		dll.Oleaut.Load(&_oleaut_VariantCopyInd, "VariantCopyInd"),
+		uintptr(unsafe.Pointer(dest)),
+		uintptr(unsafe.Pointer(v)))
+	if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+		return nil, hr
+	}
+	releaser.Add(dest)
+	return dest, nil
+}
+
+var _oleaut_VariantCopyInd *syscall.Proc
+
 // If the object has type [coaut.VT_BOOL], returns the value and true.
 // Otherwise, returns a default value and false.
 //
@@ -356,10 +390,8 @@ func (v *VARIANT) Str() (string, bool) {
 }
 
 // If the object has a type [coaut.VT_DATE], returns the [time.Time] and true,
-// calling [VariantTimeToSystemTime]. Otherwise, returns a default value and
-// false.
-//
-// Panics on error.
+// as converted by [VariantTimeToTime]. Otherwise, or if the date is out of
+// range, returns a default value and false.
 //
 // Example:
 //
@@ -371,27 +403,14 @@ func (v *VARIANT) Str() (string, bool) {
 //	if dateVal, ok := v.Date(); ok {
 //		println(dateVal.Format(time.ANSIC))
 //	}
-//
-// [VariantTimeToSystemTime]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-varianttimetosystemtime
 func (v *VARIANT) Date() (time.Time, bool) {
 	if v.tag == coaut.VT_DATE {
-		double := math.Float64frombits(binary.LittleEndian.Uint64(v.data[:]))
-		var st win.SYSTEMTIME
-
-		ret, _, _ := syscall.SyscallN(
-			dll.Oleaut.Load(&_oleaut_VariantTimeToSystemTime, "VariantTimeToSystemTime"),
-			uintptr(math.Float64bits(double)),
-			uintptr(unsafe.Pointer(&st)))
-		if ret == 0 {
-			panic("VariantTimeToSystemTime() failed.") // should never happen, time.Time is always valid
-		}
-		return st.ToTime(), true
+		t, err := VariantTimeToTime(math.Float64frombits(binary.LittleEndian.Uint64(v.data[:])))
+		return t, err == nil
 	}
 	return time.Time{}, false
 }
 
-var _oleaut_VariantTimeToSystemTime *syscall.Proc
-
 // If the object has type [coaut.VT_UI1], returns the value and true. Otherwise,
 // returns a default value and false.
 //
@@ -471,3 +490,187 @@ func (v *VARIANT) Uint64() (uint64, bool) {
 	}
 	return 0, false
 }
+
+// If the object has type [coaut.VT_ARRAY], combined with the type of the
+// elements, returns the array and true. Otherwise, returns nil and false.
+//
+// The returned array is a copy of the stored array.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	v := winaut.NewVariant(rel, []string{"a", "b"})
+//
+//	if arr, ok := v.SafeArray(rel); ok {
+//		vals, _ := arr.Values(rel)
+//		println(len(vals))
+//	}
+func (v *VARIANT) SafeArray(releaser *win.OleReleaser) (*SAFEARRAY, bool) {
+	if v.tag&coaut.VT_ARRAY != 0 && v.tag&coaut.VT_BYREF == 0 {
+		pCurrent := (*SAFEARRAY)(unsafe.Pointer(uintptr(binary.LittleEndian.Uint64(v.data[:]))))
+		sa, err := pCurrent.SafeArrayCopy(releaser) // clone, because we'll release it independently
+		if err != nil {
+			return nil, false
+		}
+		return sa, true
+	}
+	return nil, false
+}
+
+// If the object has type [coaut.VT_CY], returns the value and true. Otherwise,
+// returns a default value and false.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	price, _ := winaut.NewCy(big.NewRat(1999, 100))
+//	v := winaut.NewVariant(rel, price)
+//
+//	if cyVal, ok := v.Cy(); ok {
+//		println(cyVal.String())
+//	}
+func (v *VARIANT) Cy() (CY, bool) {
+	if v.tag == coaut.VT_CY {
+		return CY(binary.LittleEndian.Uint64(v.data[:])), true
+	}
+	return 0, false
+}
+
+// If the object has type [coaut.VT_DECIMAL], returns the value and true.
+// Otherwise, returns a default value and false.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	v := winaut.NewVariant(rel, big.NewRat(314159, 100000))
+//
+//	if decVal, ok := v.Decimal(); ok {
+//		println(decVal.String())
+//	}
+func (v *VARIANT) Decimal() (DECIMAL, bool) {
+	if v.tag == coaut.VT_DECIMAL {
+		dec := *(*DECIMAL)(unsafe.Pointer(v)) // DECIMAL overlaps the whole VARIANT
+		dec.wReserved = 0
+		return dec, true
+	}
+	return DECIMAL{}, false
+}
+
+// If the object has type [coaut.VT_UNKNOWN], returns the value and true.
+// Otherwise, returns a default value and false.
+//
+// The returned object is a clone of the stored object.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	if pUnk, ok := v.IUnknown(rel); ok {
+//		var pDisp *winaut.IDispatch
+//		_ = pUnk.QueryInterface(rel, &pDisp)
+//	}
+func (v *VARIANT) IUnknown(releaser *win.OleReleaser) (*win.IUnknown, bool) {
+	if v.tag == coaut.VT_UNKNOWN {
+		rawPpvt := uintptr(binary.LittleEndian.Uint64(v.data[:]))
+		if rawPpvt == 0 {
+			return nil, false
+		}
+		pCurrent := utl.OleNewWithoutReleaser[*win.IUnknown](rawPpvt)
+		return pCurrent.AddRef(releaser), true // clone, because we'll release it independently
+	}
+	return nil, false
+}
+
+// If the object has type [coaut.VT_ERROR], returns the value and true.
+// Otherwise, returns a default value and false.
+//
+// VT_ERROR VARIANTs are returned by automation servers to report errors in
+// array elements, like #N/A cells of Excel ranges, and they're also used to
+// mark optional parameters as omitted, with [co.HRESULT_DISP_E_PARAMNOTFOUND].
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	v := winaut.NewVariant(rel, co.HRESULT_DISP_E_PARAMNOTFOUND)
+//
+//	if hr, ok := v.Scode(); ok {
+//		println(hr.String())
+//	}
+func (v *VARIANT) Scode() (co.HRESULT, bool) {
+	if v.tag == coaut.VT_ERROR {
+		return co.HRESULT(binary.LittleEndian.Uint32(v.data[:])), true
+	}
+	return co.HRESULT(0), false
+}
+
+// Converts the value to one of the Go types accepted by [NewVariant], adding
+// any COM object to the releaser. References of [coaut.VT_BYREF] are followed.
+// Returns false if the type can't be converted.
+func (v *VARIANT) goValue(releaser *win.OleReleaser) (interface{}, bool) {
+	switch v.tag {
+	case coaut.VT_EMPTY:
+		return nil, true
+	case coaut.VT_NULL:
+		return Null{}, true
+	case coaut.VT_BOOL:
+		return v.Bool()
+	case coaut.VT_R4:
+		return v.Float32()
+	case coaut.VT_R8:
+		return v.Float64()
+	case coaut.VT_CY:
+		return v.Cy()
+	case coaut.VT_DECIMAL:
+		dec, _ := v.Decimal()
+		return dec.Rat(), true
+	case coaut.VT_DISPATCH:
+		return v.IDispatch(releaser)
+	case coaut.VT_UNKNOWN:
+		return v.IUnknown(releaser)
+	case coaut.VT_ERROR:
+		return v.Scode()
+	case coaut.VT_I1:
+		return v.Int8()
+	case coaut.VT_I2:
+		return v.Int16()
+	case coaut.VT_I4:
+		return v.Int32()
+	case coaut.VT_I8:
+		return v.Int64()
+	case coaut.VT_BSTR:
+		return v.Str()
+	case coaut.VT_DATE:
+		return v.Date()
+	case coaut.VT_UI1:
+		return v.Uint8()
+	case coaut.VT_UI2:
+		return v.Uint16()
+	case coaut.VT_UI4:
+		return v.Uint32()
+	case coaut.VT_UI8:
+		return v.Uint64()
+	}
+	if v.tag&coaut.VT_BYREF != 0 {
+		localRel := win.NewOleReleaser()
+		defer localRel.Release()
+
+		ind, err := v.VariantCopyInd(localRel)
+		if err != nil {
+			return nil, false
+		}
+		return ind.goValue(releaser)
+	}
+	if sa, ok := v.SafeArray(releaser); ok {
+		return sa, true
+	}
+	return nil, false
+}
@@ -0,0 +1,586 @@
+//go:build windows
+
+package winaut
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/x/coaut"
+)
+
+// Calls [VariantInit] and sets the type and value, which can be any of the
+// types accepted by [NewVariant]. Go types are mapped by reflection, so named
+// types, slices and arrays of any allowed type are accepted.
+//
+// Pointers create [coaut.VT_BYREF] VARIANTs, which reference a new VARIANT
+// with the pointed value, also added to the releaser. They're used for out
+// parameters of [IDispatch.InvokeMethod], which writes back the values.
+//
+// Returns an error wrapping [co.HRESULT_DISP_E_TYPEMISMATCH] if the type is
+// not allowed, or [co.HRESULT_DISP_E_OVERFLOW] if the value is out of range.
+//
+// Example:
+//
+//	type Level uint8
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	v, _ := winaut.VariantFrom(rel, Level(3)) // VT_UI1
+//
+// [VariantInit]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-variantinit
+func VariantFrom(releaser *win.OleReleaser, value interface{}) (*VARIANT, error) {
+	v := variantInit()
+	releaser.Add(v)
+	if err := v.set(releaser, value); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Sets the type and value of an empty VARIANT.
+func (v *VARIANT) set(releaser *win.OleReleaser, value interface{}) error {
+	if utl.IsNil(value) { // no data to be set
+		return nil
+	}
+
+	switch val := value.(type) {
+	case bool:
+		v.tag = coaut.VT_BOOL
+		bInt16 := int16(0) // VARIANT_FALSE
+		if val {
+			bInt16 = -1 // VARIANT_TRUE
+		}
+		binary.LittleEndian.PutUint16(v.data[:], uint16(bInt16))
+	case float32:
+		v.tag = coaut.VT_R4
+		binary.LittleEndian.PutUint32(v.data[:], math.Float32bits(val))
+	case float64:
+		v.tag = coaut.VT_R8
+		binary.LittleEndian.PutUint64(v.data[:], math.Float64bits(val))
+	case *IDispatch:
+		v.tag = coaut.VT_DISPATCH
+		_, _, _ = syscall.SyscallN(
+			utl.Vt[utl.IDispatchVt](val.Ppvt()).AddRef, // clone, because we'll release it independently
+			val.Ppvt())
+		binary.LittleEndian.PutUint64(v.data[:], uint64(val.Ppvt()))
+	case *win.IUnknown:
+		v.tag = coaut.VT_UNKNOWN
+		_, _, _ = syscall.SyscallN(
+			utl.Vt[utl.IUnknownVt](val.Ppvt()).AddRef, // clone, because we'll release it independently
+			val.Ppvt())
+		binary.LittleEndian.PutUint64(v.data[:], uint64(val.Ppvt()))
+	case int8:
+		v.tag = coaut.VT_I1
+		v.data[0] = uint8(val)
+	case int16:
+		v.tag = coaut.VT_I2
+		binary.LittleEndian.PutUint16(v.data[:], uint16(val))
+	case int32:
+		v.tag = coaut.VT_I4
+		binary.LittleEndian.PutUint32(v.data[:], uint32(val))
+	case int64:
+		v.tag = coaut.VT_I8
+		binary.LittleEndian.PutUint64(v.data[:], uint64(val))
+	case string:
+		v.tag = coaut.VT_BSTR
+		bstr, _ := SysAllocString(val) // will be owned by the VARIANT
+		binary.LittleEndian.PutUint64(v.data[:], uint64(bstr))
+	case time.Time:
+		oaDate, err := TimeToVariantTime(val)
+		if err != nil {
+			return err
+		}
+		v.tag = coaut.VT_DATE
+		binary.LittleEndian.PutUint64(v.data[:], math.Float64bits(oaDate))
+	case uint8:
+		v.tag = coaut.VT_UI1
+		v.data[0] = val
+	case uint16:
+		v.tag = coaut.VT_UI2
+		binary.LittleEndian.PutUint16(v.data[:], val)
+	case uint32:
+		v.tag = coaut.VT_UI4
+		binary.LittleEndian.PutUint32(v.data[:], val)
+	case uint64:
+		v.tag = coaut.VT_UI8
+		binary.LittleEndian.PutUint64(v.data[:], val)
+	case CY:
+		v.tag = coaut.VT_CY
+		binary.LittleEndian.PutUint64(v.data[:], uint64(val))
+	case DECIMAL:
+		*(*DECIMAL)(unsafe.Pointer(v)) = val // DECIMAL overlaps the whole VARIANT
+		v.tag = coaut.VT_DECIMAL
+	case *big.Rat:
+		dec, err := NewDecimal(val)
+		if err != nil {
+			return err
+		}
+		return v.set(releaser, dec)
+	case co.HRESULT:
+		v.tag = coaut.VT_ERROR
+		binary.LittleEndian.PutUint32(v.data[:], uint32(val))
+	case Null:
+		v.tag = coaut.VT_NULL
+	case *VARIANT:
+		ret, _, _ := syscall.SyscallN(
+			dll.Oleaut.Load(&_oleaut_VariantCopy, "VariantCopy"),
+			uintptr(unsafe.Pointer(v)),
+			uintptr(unsafe.Pointer(val)))
+		if hr := co.HRESULT(ret); hr != co.HRESULT_S_OK {
+			return hr
+		}
+	case *SAFEARRAY:
+		sa, err := val.safeArrayCopy() // will be owned by the VARIANT
+		if err != nil {
+			return err
+		}
+		v.setSafeArray(sa)
+	default:
+		return v.setReflect(releaser, reflect.ValueOf(value))
+	}
+	return nil
+}
+
+// Sets the value of a type which is not directly accepted, according to its
+// kind.
+func (v *VARIANT) setReflect(releaser *win.OleReleaser, rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.Bool:
+		return v.set(releaser, rv.Bool())
+	case reflect.Float32:
+		return v.set(releaser, float32(rv.Float()))
+	case reflect.Float64:
+		return v.set(releaser, rv.Float())
+	case reflect.Int:
+		if n := rv.Int(); n < math.MinInt32 || n > math.MaxInt32 {
+			return fmt.Errorf("%w: %d doesn't fit VT_I4", co.HRESULT_DISP_E_OVERFLOW, n)
+		}
+		return v.set(releaser, int32(rv.Int()))
+	case reflect.Int8:
+		return v.set(releaser, int8(rv.Int()))
+	case reflect.Int16:
+		return v.set(releaser, int16(rv.Int()))
+	case reflect.Int32:
+		return v.set(releaser, int32(rv.Int()))
+	case reflect.Int64:
+		return v.set(releaser, rv.Int())
+	case reflect.String:
+		return v.set(releaser, rv.String())
+	case reflect.Uint:
+		if n := rv.Uint(); n > math.MaxUint32 {
+			return fmt.Errorf("%w: %d doesn't fit VT_UI4", co.HRESULT_DISP_E_OVERFLOW, n)
+		}
+		return v.set(releaser, uint32(rv.Uint()))
+	case reflect.Uint8:
+		return v.set(releaser, uint8(rv.Uint()))
+	case reflect.Uint16:
+		return v.set(releaser, uint16(rv.Uint()))
+	case reflect.Uint32:
+		return v.set(releaser, uint32(rv.Uint()))
+	case reflect.Uint64:
+		return v.set(releaser, rv.Uint())
+	case reflect.Slice, reflect.Array:
+		sa, err := newSafeArray(rv.Interface()) // will be owned by the VARIANT
+		if err != nil {
+			return err
+		}
+		v.setSafeArray(sa)
+		return nil
+	case reflect.Ptr:
+		return v.setByRef(releaser, rv)
+	}
+	return errVariantType("%s", rv.Type())
+}
+
+// Creates a new VARIANT with the pointed value, added to the releaser, and
+// stores a reference to it, so the callee can write to it.
+func (v *VARIANT) setByRef(releaser *win.OleReleaser, rv reflect.Value) error {
+	inner, err := VariantFrom(releaser, rv.Elem().Interface())
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case inner.tag&coaut.VT_BYREF != 0:
+		return errVariantType("%s, pointer to pointer", rv.Type())
+	case rv.Elem().Kind() == reflect.Interface,
+		inner.tag == coaut.VT_EMPTY,
+		inner.tag == coaut.VT_NULL:
+		v.tag = coaut.VT_BYREF | coaut.VT_VARIANT
+		binary.LittleEndian.PutUint64(v.data[:], uint64(uintptr(unsafe.Pointer(inner))))
+	case inner.tag == coaut.VT_DECIMAL: // DECIMAL overlaps the whole VARIANT
+		v.tag = coaut.VT_BYREF | coaut.VT_DECIMAL
+		binary.LittleEndian.PutUint64(v.data[:], uint64(uintptr(unsafe.Pointer(inner))))
+	default:
+		v.tag = coaut.VT_BYREF | inner.tag
+		binary.LittleEndian.PutUint64(v.data[:], uint64(uintptr(unsafe.Pointer(&inner.data[0]))))
+	}
+	return nil
+}
+
+// Converts the VARIANT to the Go value pointed by pDst, calling
+// [VARIANT.VariantChangeType] when needed, so a string can be read into an int,
+// for example. References of [coaut.VT_BYREF] are followed. Any COM object is
+// added to the releaser.
+//
+// The destination can be:
+//   - any type accepted by [NewVariant];
+//   - interface{}, which receives the value as returned by [SAFEARRAY.Values];
+//   - a slice or array, from a 1-D array;
+//   - a slice of slices, arrays or structs, from a 2-D array, whose rows are
+//     the leftmost dimension;
+//   - a struct, whose fields are read as properties of a [coaut.VT_DISPATCH]
+//     object, or positionally from a 1-D array. The property name can be set
+//     with an `aut` tag, and `aut:"-"` skips the field;
+//   - a pointer to any of the above, which is allocated.
+//
+// [coaut.VT_EMPTY] and [coaut.VT_NULL] set the zero value.
+//
+// Returns an error wrapping [co.HRESULT_DISP_E_TYPEMISMATCH] if the value
+// can't be converted, or [co.HRESULT_DISP_E_OVERFLOW] if it doesn't fit the
+// destination.
+//
+// Example:
+//
+//	type Workbook struct {
+//		Name     string
+//		Path     string `aut:"FullName"`
+//		ReadOnly bool
+//	}
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	vBook, _ := excel.InvokeGet(rel, "ActiveWorkbook")
+//	var book Workbook
+//	_ = winaut.VariantTo(rel, vBook, &book)
+//
+//	vRows, _ := usedRange.InvokeGet(rel, "Value")
+//	var rows [][]string
+//	_ = winaut.VariantTo(rel, vRows, &rows)
+func VariantTo(releaser *win.OleReleaser, v *VARIANT, pDst interface{}) error {
+	dst := reflect.ValueOf(pDst)
+	if dst.Kind() != reflect.Ptr || dst.IsNil() {
+		return errVariantType("destination %T is not a pointer", pDst)
+	}
+	return v.get(releaser, dst.Elem())
+}
+
+// Converts the VARIANT to the addressable Go value.
+func (v *VARIANT) get(releaser *win.OleReleaser, dst reflect.Value) error {
+	localRel := win.NewOleReleaser()
+	defer localRel.Release()
+
+	if v.tag&coaut.VT_BYREF != 0 {
+		ind, err := v.VariantCopyInd(localRel)
+		if err != nil {
+			return err
+		}
+		v = ind
+	}
+
+	if pDst, ok := dst.Addr().Interface().(**VARIANT); ok {
+		cp, err := v.VariantCopy(releaser)
+		if err != nil {
+			return err
+		}
+		*pDst = cp
+		return nil
+	} else if dst.Kind() == reflect.Interface {
+		return v.getInterface(releaser, dst)
+	} else if v.tag == coaut.VT_EMPTY || v.tag == coaut.VT_NULL {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	switch pDst := dst.Addr().Interface().(type) {
+	case *time.Time:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_DATE)
+		if err != nil {
+			return err
+		}
+		t, ok := conv.Date()
+		if !ok {
+			return co.HRESULT_DISP_E_OVERFLOW
+		}
+		*pDst = t
+		return nil
+	case **big.Rat:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_DECIMAL)
+		if err != nil {
+			return err
+		}
+		dec, _ := conv.Decimal()
+		*pDst = dec.Rat()
+		return nil
+	case *DECIMAL:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_DECIMAL)
+		if err != nil {
+			return err
+		}
+		*pDst, _ = conv.Decimal()
+		return nil
+	case *CY:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_CY)
+		if err != nil {
+			return err
+		}
+		*pDst, _ = conv.Cy()
+		return nil
+	case *co.HRESULT:
+		hr, ok := v.Scode()
+		if !ok {
+			return errVariantType("VT %d to %s", v.tag, dst.Type())
+		}
+		*pDst = hr
+		return nil
+	case **IDispatch:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_DISPATCH) // queries IUnknown objects
+		if err != nil {
+			return err
+		}
+		*pDst, _ = conv.IDispatch(releaser)
+		return nil
+	case **win.IUnknown:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_UNKNOWN)
+		if err != nil {
+			return err
+		}
+		*pDst, _ = conv.IUnknown(releaser)
+		return nil
+	case **SAFEARRAY:
+		sa, ok := v.SafeArray(releaser)
+		if !ok {
+			return errVariantType("VT %d to %s", v.tag, dst.Type())
+		}
+		*pDst = sa
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Bool:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_BOOL)
+		if err != nil {
+			return err
+		}
+		b, _ := conv.Bool()
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_I8)
+		if err != nil {
+			return err
+		}
+		n, _ := conv.Int64()
+		if dst.OverflowInt(n) {
+			return fmt.Errorf("%w: %d doesn't fit %s", co.HRESULT_DISP_E_OVERFLOW, n, dst.Type())
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_UI8)
+		if err != nil {
+			return err
+		}
+		n, _ := conv.Uint64()
+		if dst.OverflowUint(n) {
+			return fmt.Errorf("%w: %d doesn't fit %s", co.HRESULT_DISP_E_OVERFLOW, n, dst.Type())
+		}
+		dst.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_R8)
+		if err != nil {
+			return err
+		}
+		f, _ := conv.Float64()
+		if dst.OverflowFloat(f) {
+			return fmt.Errorf("%w: %g doesn't fit %s", co.HRESULT_DISP_E_OVERFLOW, f, dst.Type())
+		}
+		dst.SetFloat(f)
+	case reflect.String:
+		conv, err := v.VariantChangeType(localRel, coaut.VT_BSTR)
+		if err != nil {
+			return err
+		}
+		s, _ := conv.Str()
+		dst.SetString(s)
+	case reflect.Slice, reflect.Array:
+		return v.getArray(releaser, dst)
+	case reflect.Struct:
+		if pDisp, ok := v.IDispatch(localRel); ok {
+			return getProperties(releaser, pDisp, dst)
+		}
+		return v.getArray(releaser, dst)
+	case reflect.Ptr:
+		pNew := reflect.New(dst.Type().Elem())
+		if err := v.get(releaser, pNew.Elem()); err != nil {
+			return err
+		}
+		dst.Set(pNew)
+	default:
+		return errVariantType("VT %d to %s", v.tag, dst.Type())
+	}
+	return nil
+}
+
+// Converts the VARIANT to an interface{} value, or to a copied *VARIANT if
+// there's no Go equivalent.
+func (v *VARIANT) getInterface(releaser *win.OleReleaser, dst reflect.Value) error {
+	val, ok := v.goValue(releaser)
+	if !ok {
+		cp, err := v.VariantCopy(releaser)
+		if err != nil {
+			return err
+		}
+		val = cp
+	}
+
+	if val == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+	} else if rv := reflect.ValueOf(val); rv.Type().AssignableTo(dst.Type()) {
+		dst.Set(rv)
+	} else {
+		return errVariantType("%T to %s", val, dst.Type())
+	}
+	return nil
+}
+
+// Converts a 1-D array to a slice, array or struct, or a 2-D array to a slice
+// of them.
+func (v *VARIANT) getArray(releaser *win.OleReleaser, dst reflect.Value) error {
+	if v.tag&coaut.VT_ARRAY == 0 {
+		return errVariantType("VT %d to %s", v.tag, dst.Type())
+	}
+	sa := (*SAFEARRAY)(unsafe.Pointer(uintptr(binary.LittleEndian.Uint64(v.data[:])))) // owned by the VARIANT
+	bounds, err := sa.Bounds()
+	if err != nil {
+		return err
+	}
+
+	localRel := win.NewOleReleaser()
+	defer localRel.Release()
+
+	switch len(bounds) {
+	case 1:
+		targets, err := sequenceTargets(dst, int(bounds[0].CElements))
+		if err != nil {
+			return err
+		}
+		for i, target := range targets {
+			elem, err := sa.SafeArrayGetElement(localRel, int(bounds[0].LLbound)+i)
+			if err != nil {
+				return err
+			} else if err := elem.get(releaser, target); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		return nil
+
+	case 2:
+		if dst.Kind() != reflect.Slice {
+			return fmt.Errorf("%w: 2-D array to %s", co.HRESULT_DISP_E_BADINDEX, dst.Type())
+		}
+		numRows := int(bounds[0].CElements)
+		dst.Set(reflect.MakeSlice(dst.Type(), numRows, numRows))
+		for r := 0; r < numRows; r++ {
+			targets, err := sequenceTargets(dst.Index(r), int(bounds[1].CElements))
+			if err != nil {
+				return err
+			}
+			for c, target := range targets {
+				elem, err := sa.SafeArrayGetElement(localRel,
+					int(bounds[0].LLbound)+r, int(bounds[1].LLbound)+c)
+				if err != nil {
+					return err
+				} else if err := elem.get(releaser, target); err != nil {
+					return fmt.Errorf("element %d,%d: %w", r, c, err)
+				}
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %d-D array to %s", co.HRESULT_DISP_E_BADINDEX, len(bounds), dst.Type())
+}
+
+// Returns the values where the elements of a sequence with the given length
+// will be stored: the items of a slice, which is allocated, or an array, or
+// the fields of a struct. Extra elements for a struct are ignored.
+func sequenceTargets(dst reflect.Value, length int) ([]reflect.Value, error) {
+	switch dst.Kind() {
+	case reflect.Slice:
+		dst.Set(reflect.MakeSlice(dst.Type(), length, length))
+		fallthrough
+	case reflect.Array:
+		if dst.Len() != length {
+			return nil, fmt.Errorf("%w: %d elements to %s", co.HRESULT_DISP_E_BADINDEX, length, dst.Type())
+		}
+		targets := make([]reflect.Value, length)
+		for i := range targets {
+			targets[i] = dst.Index(i)
+		}
+		return targets, nil
+	case reflect.Struct:
+		fields := structFields(dst.Type())
+		targets := make([]reflect.Value, 0, len(fields))
+		for _, f := range fields {
+			if len(targets) == length {
+				break
+			}
+			targets = append(targets, dst.Field(f.index))
+		}
+		return targets, nil
+	}
+	return nil, errVariantType("array to %s", dst.Type())
+}
+
+// Reads each struct field from the property of the object.
+func getProperties(releaser *win.OleReleaser, pDisp *IDispatch, dst reflect.Value) error {
+	localRel := win.NewOleReleaser()
+	defer localRel.Release()
+
+	for _, f := range structFields(dst.Type()) {
+		prop, err := pDisp.InvokeGet(localRel, f.name)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.goName, err)
+		} else if err := prop.get(releaser, dst.Field(f.index)); err != nil {
+			return fmt.Errorf("field %s: %w", f.goName, err)
+		}
+	}
+	return nil
+}
+
+// A struct field read by [VariantTo].
+type _Field struct {
+	index  int
+	goName string
+	name   string // property name
+}
+
+func structFields(t reflect.Type) []_Field {
+	fields := make([]_Field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("aut")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, _Field{index: i, goName: sf.Name, name: name})
+	}
+	return fields
+}
@@ -0,0 +1,219 @@
+//go:build windows
+
+package winaut
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/rodrigocfd/windigo/co"
+)
+
+// [CY] currency value, a fixed-point number with 4 decimal places, stored as
+// an integer scaled by 10,000. It's the value of [coaut.VT_CY] VARIANTs.
+//
+// Example:
+//
+//	price, _ := winaut.NewCy(big.NewRat(1999, 100)) // 19.99
+//	println(int64(price))                           // 199900
+//
+// [CY]: https://learn.microsoft.com/en-us/windows/win32/api/wtypes/ns-wtypes-cy-r1
+type CY int64
+
+// Converts the number to [CY], rounding it to 4 decimal places.
+//
+// Returns [co.HRESULT_DISP_E_OVERFLOW] if the number is out of range.
+func NewCy(r *big.Rat) (CY, error) {
+	scaled := new(big.Rat).Mul(r, big.NewRat(10_000, 1))
+	n := roundRat(scaled)
+	if !n.IsInt64() {
+		return 0, co.HRESULT_DISP_E_OVERFLOW
+	}
+	return CY(n.Int64()), nil
+}
+
+// Returns the exact value as a [big.Rat].
+func (cy CY) Rat() *big.Rat {
+	return big.NewRat(int64(cy), 10_000)
+}
+
+// Returns the value with 4 decimal places, like "19.9900".
+func (cy CY) String() string {
+	return cy.Rat().FloatString(4)
+}
+
+// [DECIMAL] struct, with C memory layout. It's a 96-bit integer scaled by a
+// power of 10, from 0 to 28, and it's the value of [coaut.VT_DECIMAL]
+// VARIANTs.
+//
+// Example:
+//
+//	r, _ := new(big.Rat).SetString("3.14159")
+//	dec, _ := winaut.NewDecimal(r)
+//	println(dec.String())
+//
+// [DECIMAL]: https://learn.microsoft.com/en-us/windows/win32/api/wtypes/ns-wtypes-decimal-r1
+type DECIMAL struct {
+	wReserved uint16
+	Scale     uint8
+	Sign      uint8 // 0x80 if negative.
+	Hi32      uint32
+	Lo64      uint64
+}
+
+// Converts the number to [DECIMAL], using the smallest scale which represents
+// it exactly. If there's none, the number is rounded to the largest scale
+// which fits.
+//
+// Returns [co.HRESULT_DISP_E_OVERFLOW] if the number is out of range.
+func NewDecimal(r *big.Rat) (DECIMAL, error) {
+	abs := new(big.Rat).Abs(r)
+	pow10 := big.NewInt(1)
+	ten := big.NewInt(10)
+
+	var mantissa *big.Int
+	var scale int
+	for s := 0; s <= 28; s++ {
+		num := new(big.Int).Mul(abs.Num(), pow10)
+		q, rem := new(big.Int).QuoRem(num, abs.Denom(), new(big.Int))
+		if q.BitLen() > 96 {
+			break // previous scale is the largest which fits
+		}
+		if rem.Sign() == 0 {
+			mantissa, scale = q, s // exact
+			break
+		}
+		if rounded := roundRat(new(big.Rat).SetFrac(num, abs.Denom())); rounded.BitLen() <= 96 {
+			mantissa, scale = rounded, s
+		}
+		pow10.Mul(pow10, ten)
+	}
+	if mantissa == nil {
+		return DECIMAL{}, co.HRESULT_DISP_E_OVERFLOW
+	}
+
+	dec := DECIMAL{
+		Scale: uint8(scale),
+		Hi32:  uint32(new(big.Int).Rsh(mantissa, 64).Uint64()),
+		Lo64:  new(big.Int).And(mantissa, new(big.Int).SetUint64(math.MaxUint64)).Uint64(),
+	}
+	if r.Sign() < 0 && mantissa.Sign() != 0 {
+		dec.Sign = 0x80
+	}
+	return dec, nil
+}
+
+// Returns the exact value as a [big.Rat].
+func (d *DECIMAL) Rat() *big.Rat {
+	mantissa := new(big.Int).SetUint64(uint64(d.Hi32))
+	mantissa.Lsh(mantissa, 64).Or(mantissa, new(big.Int).SetUint64(d.Lo64))
+	if d.Sign&0x80 != 0 {
+		mantissa.Neg(mantissa)
+	}
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.Scale)), nil)
+	return new(big.Rat).SetFrac(mantissa, denom)
+}
+
+// Returns the value with its own scale, like "3.14159".
+func (d *DECIMAL) String() string {
+	return d.Rat().FloatString(int(d.Scale))
+}
+
+// Rounds the number to the nearest integer, with halves away from zero.
+func roundRat(r *big.Rat) *big.Int {
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Abs(rem).Lsh(rem, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+	return q
+}
+
+// The value of [coaut.VT_NULL] VARIANTs, a SQL-style null, as opposed to
+// [coaut.VT_EMPTY], which is a nil value.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	v := winaut.NewVariant(rel, winaut.Null{})
+//	println(v.IsNull())
+type Null struct{}
+
+// Limits of the OLE Automation dates: January 1, 100 and December 31, 9999.
+const (
+	_VARIANT_TIME_MIN = -657_434.0
+	_VARIANT_TIME_MAX = 2_958_466.0 // exclusive
+)
+
+// The day zero of OLE Automation dates.
+var _VARIANT_TIME_EPOCH = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC).Unix()
+
+// Converts the time to an OLE Automation date – the value of
+// [coaut.VT_DATE] VARIANTs – with the same result of
+// [SystemTimeToVariantTime], but in pure Go and with millisecond precision.
+//
+// OLE Automation dates have no timezone, so the time is converted to the
+// current timezone first.
+//
+// Returns [co.HRESULT_DISP_E_OVERFLOW] if the date is before year 100 or after
+// year 9999.
+//
+// Example:
+//
+//	oaDate, _ := winaut.TimeToVariantTime(time.Now())
+//
+// [SystemTimeToVariantTime]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-systemtimetovarianttime
+func TimeToVariantTime(t time.Time) (float64, error) {
+	t = t.In(time.Local)
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	millis := (wall.Unix()-_VARIANT_TIME_EPOCH)*1000 + int64(t.Nanosecond()/1_000_000)
+
+	const msPerDay = 24 * 60 * 60 * 1000
+	day := millis / msPerDay
+	if millis%msPerDay < 0 {
+		day-- // floor
+	}
+	frac := float64(millis-day*msPerDay) / msPerDay
+
+	var oaDate float64
+	if day >= 0 {
+		oaDate = float64(day) + frac
+	} else {
+		oaDate = float64(day) - frac // the time of day is still added
+	}
+	if oaDate < _VARIANT_TIME_MIN || oaDate >= _VARIANT_TIME_MAX {
+		return 0, co.HRESULT_DISP_E_OVERFLOW
+	}
+	return oaDate, nil
+}
+
+// Converts an OLE Automation date – the value of [coaut.VT_DATE] VARIANTs – to
+// a time in the current timezone, with the same result of
+// [VariantTimeToSystemTime], but in pure Go and with millisecond precision.
+//
+// Returns [co.HRESULT_DISP_E_OVERFLOW] if the date is before year 100 or after
+// year 9999.
+//
+// [VariantTimeToSystemTime]: https://learn.microsoft.com/en-us/windows/win32/api/oleauto/nf-oleauto-varianttimetosystemtime
+func VariantTimeToTime(oaDate float64) (time.Time, error) {
+	if math.IsNaN(oaDate) || oaDate < _VARIANT_TIME_MIN || oaDate >= _VARIANT_TIME_MAX {
+		return time.Time{}, co.HRESULT_DISP_E_OVERFLOW
+	}
+
+	const msPerDay = 24 * 60 * 60 * 1000
+	day := math.Trunc(oaDate)
+	timeOfDay := int64(math.Round(math.Abs(oaDate-day) * msPerDay))
+	millis := int64(day)*msPerDay + timeOfDay
+
+	wall := time.UnixMilli(_VARIANT_TIME_EPOCH*1000 + millis).UTC()
+	return time.Date(wall.Year(), wall.Month(), wall.Day(),
+		wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), time.Local), nil
+}
+
+// Error for a Go value or destination which can't be converted.
+func errVariantType(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{co.HRESULT_DISP_E_TYPEMISMATCH}, args...)...)
+}
@@ -3,8 +3,11 @@
 package winaut
 
 import (
+	"fmt"
+	"syscall"
 	"unsafe"
 
+	"github.com/rodrigocfd/windigo/co"
 	"github.com/rodrigocfd/windigo/x/coaut"
 )
 
@@ -72,19 +75,52 @@ func (ed *ELEMDESC) ParmDesc() *PARAMDESC {
 // When [IDispatch.Invoke] remote call fails, the raw EXCEPINFO is converted
 // into this one, then returned as the error.
 //
-// Implements error interface.
+// Implements error interface, and unwraps to the [co.HRESULT] of the error, if
+// any, so it can be checked with errors.Is.
+//
+// Example:
+//
+//	rel := win.NewOleReleaser()
+//	defer rel.Release()
+//
+//	_, err := excel.InvokeMethod(rel, "Run", "MyMacro")
+//	var excep *winaut.EXCEPINFO
+//	if errors.As(err, &excep) {
+//		println(excep.Source, excep.Description, excep.HelpFile, excep.HelpContext)
+//	}
 //
 // [EXCEPINFO]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/ns-oaidl-excepinfo
 type EXCEPINFO struct {
-	Code        int
+	Code        int // The wCode field, or Scode if it's zero.
 	Source      string
 	Description string
 	HelpFile    string
+	HelpContext uint32
+	Scode       co.HRESULT
 }
 
 // Implements error interface.
 func (e *EXCEPINFO) Error() string {
-	return e.Source + ": " + e.Description
+	desc := e.Description
+	if desc == "" {
+		if e.Scode != 0 {
+			desc = e.Scode.String()
+		} else {
+			desc = fmt.Sprintf("exception code %d", e.Code)
+		}
+	}
+	if e.Source == "" {
+		return desc
+	}
+	return e.Source + ": " + desc
+}
+
+// Returns the Scode of the exception, or nil if it's zero.
+func (e *EXCEPINFO) Unwrap() error {
+	if e.Scode != 0 {
+		return e.Scode
+	}
+	return nil
 }
 
 // [EXCEPINFO] struct, with C memory layout.
@@ -98,20 +134,28 @@ type _EXCEPINFO struct {
 	BstrSource        BSTR
 	BstrDescription   BSTR
 	BstrHelpFile      BSTR
-	DwHelpContext     uint16
+	DwHelpContext     uint32
 	pvReserved        uintptr
 	PfnDeferredFillIn uintptr
 	Scode             int32
 }
 
-// Converts the information into the syntactic sugar struct.
+// Converts the information into the syntactic sugar struct, calling the
+// deferred fill-in function first, if any.
 func (e *_EXCEPINFO) Serialize() *EXCEPINFO {
+	if e.PfnDeferredFillIn != 0 {
+		_, _, _ = syscall.SyscallN(e.PfnDeferredFillIn, uintptr(unsafe.Pointer(e)))
+		e.PfnDeferredFillIn = 0
+	}
+
 	var nfo EXCEPINFO
 
 	nfo.Code = int(e.WCode)
 	if nfo.Code == 0 {
 		nfo.Code = int(e.Scode)
 	}
+	nfo.HelpContext = e.DwHelpContext
+	nfo.Scode = co.HRESULT(e.Scode)
 
 	// The BSTRs are freed inside IDispatch.Invoke().
 	if e.BstrSource != 0 {
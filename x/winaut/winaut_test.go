@@ -0,0 +1,321 @@
+//go:build windows
+
+package winaut_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rodrigocfd/windigo/co"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/x/coaut"
+	"github.com/rodrigocfd/windigo/x/winaut"
+)
+
+func TestNewCy(t *testing.T) {
+	tests := []struct {
+		num      string
+		expected int64
+		overflow bool
+	}{
+		{"19.99", 199900, false},
+		{"0", 0, false},
+		{"1.00004", 10000, false},
+		{"1.000049999", 10000, false},
+		{"1.00005", 10001, false}, // halves away from zero
+		{"-1.00005", -10001, false},
+		{"-1.000049999", -10000, false},
+		{"0.00005", 1, false},
+		{"-0.00005", -1, false},
+		{"-0.00004", 0, false},
+		{"2/3", 6667, false},
+		{"-2/3", -6667, false},
+		{"922337203685477.5807", math.MaxInt64, false},
+		{"922337203685477.58074", math.MaxInt64, false},
+		{"922337203685477.58075", 0, true},
+		{"922337203685477.5808", 0, true},
+		{"-922337203685477.5808", math.MinInt64, false},
+		{"-922337203685477.58084", math.MinInt64, false},
+		{"-922337203685477.58085", 0, true},
+		{"1e30", 0, true},
+	}
+
+	for _, test := range tests {
+		r, _ := new(big.Rat).SetString(test.num)
+		cy, err := winaut.NewCy(r)
+		if test.overflow {
+			if !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+				t.Errorf("NewCy(%s): %d, %v; expected overflow", test.num, cy, err)
+			}
+		} else if err != nil || int64(cy) != test.expected {
+			t.Errorf("NewCy(%s): %d, %v; expected %d", test.num, cy, err, test.expected)
+		}
+	}
+
+	if s := winaut.CY(-199900).String(); s != "-19.9900" {
+		t.Errorf("CY.String(): %s", s)
+	}
+}
+
+func TestNewDecimal(t *testing.T) {
+	tests := []struct {
+		num      string
+		scale    uint8
+		value    string // exact value of the DECIMAL, as a big.Rat
+		overflow bool
+	}{
+		{"0", 0, "0", false},
+		{"3.14159", 5, "314159/100000", false},
+		{"-1.5", 1, "-3/2", false},
+		{"100", 0, "100", false},
+		{"1/3", 28, "3333333333333333333333333333/10000000000000000000000000000", false},
+		{"2/3", 28, "6666666666666666666666666667/10000000000000000000000000000", false},
+		{"-2/3", 28, "-6666666666666666666666666667/10000000000000000000000000000", false},
+		{"1.23456789012345678901234567891", 28, "1.2345678901234567890123456789", false},
+		{"1.23456789012345678901234567895", 28, "1.2345678901234567890123456790", false},
+		{"12345678901.23456789012345678901", 18, "12345678901.234567890123456789", false},
+		{"79228162514264337593543950335", 0, "79228162514264337593543950335", false}, // 2^96 - 1
+		{"-79228162514264337593543950335", 0, "-79228162514264337593543950335", false},
+		{"7922816251426433759354395033.5", 1, "7922816251426433759354395033.5", false},
+		{"79228162514264337593543950335.4", 0, "79228162514264337593543950335", false},
+		{"79228162514264337593543950335.5", 0, "", true}, // rounds to 2^96
+		{"79228162514264337593543950336", 0, "", true},   // 2^96
+		{"1e-29", 28, "0", false},
+		{"-1e-29", 28, "0", false}, // no negative zero
+		{"5e-29", 28, "1e-28", false},
+	}
+
+	for _, test := range tests {
+		r, _ := new(big.Rat).SetString(test.num)
+		dec, err := winaut.NewDecimal(r)
+		if test.overflow {
+			if !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+				t.Errorf("NewDecimal(%s): %s, %v; expected overflow", test.num, dec.String(), err)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("NewDecimal(%s): %v", test.num, err)
+			continue
+		}
+
+		expected, _ := new(big.Rat).SetString(test.value)
+		if dec.Scale != test.scale || dec.Rat().Cmp(expected) != 0 {
+			t.Errorf("NewDecimal(%s): %s, scale %d; expected %s, scale %d",
+				test.num, dec.String(), dec.Scale, expected.FloatString(int(test.scale)), test.scale)
+		}
+		if expected.Sign() == 0 && dec.Sign != 0 {
+			t.Errorf("NewDecimal(%s): negative zero", test.num)
+		}
+	}
+
+	r, _ := new(big.Rat).SetString("-3.14159")
+	if dec, _ := winaut.NewDecimal(r); dec.String() != "-3.14159" {
+		t.Errorf("DECIMAL.String(): %s", dec.String())
+	}
+}
+
+func TestVariantTime(t *testing.T) {
+	prevLocal := time.Local
+	time.Local = time.UTC // OLE Automation dates have no timezone
+	defer func() { time.Local = prevLocal }()
+
+	date := func(year int, month time.Month, day, hour, min, sec, ms int) time.Time {
+		return time.Date(year, month, day, hour, min, sec, ms*1_000_000, time.UTC)
+	}
+
+	tests := []struct {
+		t        time.Time
+		oaDate   float64
+		overflow bool
+	}{
+		{date(1899, 12, 30, 0, 0, 0, 0), 0, false},
+		{date(1899, 12, 30, 12, 0, 0, 0), 0.5, false},
+		{date(1899, 12, 31, 0, 0, 0, 0), 1, false},
+		{date(1900, 1, 1, 6, 0, 0, 0), 2.25, false},
+		{date(1899, 12, 29, 0, 0, 0, 0), -1, false},
+		{date(1899, 12, 29, 6, 0, 0, 0), -1.25, false}, // negative day, positive time of day
+		{date(1899, 12, 29, 18, 0, 0, 0), -1.75, false},
+		{date(1800, 1, 1, 12, 0, 0, 0), -36522.5, false},
+		{date(2024, 2, 29, 13, 45, 30, 123), 45351.57326531250, false},
+		{date(100, 1, 1, 0, 0, 0, 0), -657434, false},
+		{date(99, 12, 31, 23, 59, 59, 999), 0, true},
+		{date(9999, 12, 31, 0, 0, 0, 0), 2958465, false},
+		{date(9999, 12, 31, 23, 59, 59, 999), 2958465 + 86_399_999.0/86_400_000, false},
+		{date(10000, 1, 1, 0, 0, 0, 0), 0, true},
+	}
+
+	for _, test := range tests {
+		oaDate, err := winaut.TimeToVariantTime(test.t)
+		if test.overflow {
+			if !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+				t.Errorf("TimeToVariantTime(%s): %v, %v; expected overflow", test.t, oaDate, err)
+			}
+			continue
+		} else if err != nil || math.Abs(oaDate-test.oaDate) > 1e-9 {
+			t.Errorf("TimeToVariantTime(%s): %v, %v; expected %v", test.t, oaDate, err, test.oaDate)
+			continue
+		}
+
+		back, err := winaut.VariantTimeToTime(oaDate)
+		if err != nil || !back.Equal(test.t) {
+			t.Errorf("VariantTimeToTime(%v): %s, %v; expected %s", oaDate, back, err, test.t)
+		}
+	}
+
+	for _, oaDate := range []float64{-657434.000001, -657435, 2958466, math.Inf(1), math.NaN()} {
+		if tm, err := winaut.VariantTimeToTime(oaDate); !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+			t.Errorf("VariantTimeToTime(%v): %s, %v; expected overflow", oaDate, tm, err)
+		}
+	}
+	if tm, _ := winaut.VariantTimeToTime(-0.5); !tm.Equal(date(1899, 12, 30, 12, 0, 0, 0)) {
+		t.Errorf("VariantTimeToTime(-0.5): %s", tm) // same as 0.5
+	}
+}
+
+type _Level uint8
+
+func TestVariantFromTo(t *testing.T) {
+	rel := win.NewOleReleaser()
+	defer rel.Release()
+
+	pi, _ := new(big.Rat).SetString("3.14159")
+	when := time.Date(2024, 2, 29, 13, 45, 30, 0, time.Local)
+
+	tests := []struct {
+		value    interface{}
+		vt       coaut.VT
+		pDst     interface{} // pointer to the Go value read back
+		expected interface{} // value pointed by pDst
+	}{
+		{int8(-5), coaut.VT_I1, new(int8), int8(-5)},
+		{_Level(3), coaut.VT_UI1, new(_Level), _Level(3)},
+		{int(70_000), coaut.VT_I4, new(int64), int64(70_000)},
+		{"ção", coaut.VT_BSTR, new(string), "ção"},
+		{true, coaut.VT_BOOL, new(bool), true},
+		{true, coaut.VT_BOOL, new(int16), int16(-1)}, // VARIANT_TRUE
+		{false, coaut.VT_BOOL, new(int16), int16(0)}, // VARIANT_FALSE
+		{float32(2.5), coaut.VT_R4, new(float64), 2.5},
+		{when, coaut.VT_DATE, new(time.Time), when},
+		{winaut.CY(199_900), coaut.VT_CY, new(winaut.CY), winaut.CY(199_900)},
+		{pi, coaut.VT_DECIMAL, new(*big.Rat), pi},
+		{co.HRESULT_E_FAIL, coaut.VT_ERROR, new(co.HRESULT), co.HRESULT_E_FAIL},
+		{winaut.Null{}, coaut.VT_NULL, new(int), 0},
+		{[]float64{1, 2, 3}, coaut.VT_ARRAY | coaut.VT_R8, new([]float64), []float64{1, 2, 3}},
+		{[][]interface{}{{"a", 1}, {"b"}}, coaut.VT_ARRAY | coaut.VT_VARIANT,
+			new([][]string), [][]string{{"a", "1"}, {"b", ""}}}, // short row padded with VT_EMPTY
+		{new(int32), coaut.VT_BYREF | coaut.VT_I4, new(int32), int32(0)},
+	}
+
+	for _, test := range tests {
+		v, err := winaut.VariantFrom(rel, test.value)
+		if err != nil {
+			t.Errorf("VariantFrom(%T): %v", test.value, err)
+			continue
+		} else if v.Type() != test.vt {
+			t.Errorf("VariantFrom(%T): VT %s, expected %s", test.value, v.Type(), test.vt)
+			continue
+		}
+
+		if err := winaut.VariantTo(rel, v, test.pDst); err != nil {
+			t.Errorf("VariantTo(%s, %T): %v", test.vt, test.pDst, err)
+			continue
+		}
+
+		got := reflect.ValueOf(test.pDst).Elem().Interface()
+		if !variantValueEqual(got, test.expected) {
+			t.Errorf("VariantTo(%s, %T): %v, expected %v", test.vt, test.pDst, got, test.expected)
+		}
+	}
+
+	if _, err := winaut.VariantFrom(rel, make(chan int)); !errors.Is(err, co.HRESULT_DISP_E_TYPEMISMATCH) {
+		t.Errorf("VariantFrom(chan): %v, expected type mismatch", err)
+	}
+	if _, err := winaut.VariantFrom(rel, time.Date(10_000, 1, 1, 0, 0, 0, 0, time.Local)); !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+		t.Errorf("VariantFrom(year 10000): %v, expected overflow", err)
+	}
+	if n := int64(1) << 40; strconv.IntSize == 64 {
+		if _, err := winaut.VariantFrom(rel, int(n)); !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+			t.Errorf("VariantFrom(int 2^40): %v, expected overflow", err)
+		}
+	}
+
+	v := winaut.NewVariant(rel, int32(300))
+	var small int8
+	if err := winaut.VariantTo(rel, v, &small); !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+		t.Errorf("VariantTo(300, *int8): %v, expected overflow", err)
+	}
+	vUint, _ := winaut.VariantFrom(rel, uint(70_000))
+	var smallUint uint16
+	if err := winaut.VariantTo(rel, vUint, &smallUint); !errors.Is(err, co.HRESULT_DISP_E_OVERFLOW) {
+		t.Errorf("VariantTo(70000, *uint16): %v, expected overflow", err)
+	}
+	if err := winaut.VariantTo(rel, v, small); !errors.Is(err, co.HRESULT_DISP_E_TYPEMISMATCH) {
+		t.Errorf("VariantTo(non-pointer): %v, expected type mismatch", err)
+	}
+}
+
+func TestDispArgs(t *testing.T) {
+	tests := []struct {
+		method   coaut.DISPATCH
+		namedIds []winaut.MEMBERID
+		params   []interface{}
+		args     []interface{}
+		order    []int
+		dispIds  []coaut.DISPID
+	}{
+		{coaut.DISPATCH_METHOD, nil, nil,
+			[]interface{}{}, nil, nil},
+		{coaut.DISPATCH_METHOD, nil, []interface{}{"a", 2, 3.5},
+			[]interface{}{3.5, 2, "a"}, []int{2, 1, 0}, nil},
+		{coaut.DISPATCH_METHOD, []winaut.MEMBERID{7, 9},
+			[]interface{}{"a", 2, winaut.Named("x", true), winaut.Named("y", "z")},
+			[]interface{}{true, "z", 2, "a"}, []int{2, 3, 1, 0}, []coaut.DISPID{7, 9}},
+		{coaut.DISPATCH_PROPERTYPUT, nil, []interface{}{"A1", 10},
+			[]interface{}{10, "A1"}, []int{1, 0}, []coaut.DISPID{coaut.DISPID_PROPERTYPUT}},
+		{coaut.DISPATCH_PROPERTYPUTREF, []winaut.MEMBERID{4},
+			[]interface{}{"A1", 10, winaut.Named("x", false)},
+			[]interface{}{10, false, "A1"}, []int{1, 2, 0}, []coaut.DISPID{coaut.DISPID_PROPERTYPUT, 4}},
+		{coaut.DISPATCH_PROPERTYPUT, []winaut.MEMBERID{4},
+			[]interface{}{winaut.Named("x", 1)},
+			[]interface{}{1}, []int{0}, []coaut.DISPID{4}},
+	}
+
+	for _, test := range tests {
+		args, order, dispIds, err := winaut.DispArgs(test.method, test.namedIds, test.params)
+		if err != nil {
+			t.Errorf("DispArgs(%s, %v): %v", test.method, test.params, err)
+			continue
+		}
+		if !reflect.DeepEqual(args, test.args) ||
+			!reflect.DeepEqual(order, test.order) ||
+			!reflect.DeepEqual(dispIds, test.dispIds) {
+			t.Errorf("DispArgs(%s, %v): got %v %v %v, expected %v %v %v", test.method, test.params,
+				args, order, dispIds, test.args, test.order, test.dispIds)
+		}
+	}
+
+	if _, _, _, err := winaut.DispArgs(coaut.DISPATCH_METHOD, nil,
+		[]interface{}{winaut.Named("x", 1)}); !errors.Is(err, co.HRESULT_DISP_E_PARAMNOTFOUND) {
+		t.Errorf("DispArgs without ID: expected DISP_E_PARAMNOTFOUND, got %v", err)
+	}
+	if _, _, _, err := winaut.DispArgs(coaut.DISPATCH_METHOD, []winaut.MEMBERID{7},
+		[]interface{}{winaut.Named("x", 1), 2}); !errors.Is(err, co.HRESULT_E_INVALIDARG) {
+		t.Errorf("DispArgs positional after named: expected E_INVALIDARG, got %v", err)
+	}
+}
+
+// Compares values read by VariantTo, which may be times or big.Rat.
+func variantValueEqual(got, expected interface{}) bool {
+	switch exp := expected.(type) {
+	case time.Time:
+		return got.(time.Time).Equal(exp)
+	case *big.Rat:
+		return got.(*big.Rat).Cmp(exp) == 0
+	}
+	return reflect.DeepEqual(got, expected)
+}